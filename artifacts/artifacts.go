@@ -0,0 +1,38 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifacts lets tests store the large outputs of an Ondatra test run,
+// such as diagnostic bundles, packet captures and reports, in a pluggable sink
+// instead of the local disk, e.g. in a cloud storage bucket. The sink is
+// typically set in TestMain, before calling ondatra.RunTests.
+package artifacts
+
+import (
+	"github.com/openconfig/ondatra/internal/artifacts"
+)
+
+// Sink stores artifacts. An artifact is named by its local path, such as one
+// in the directory of the -diag_dir or -report_dir flag, which the sink maps
+// to its storage, e.g. to the name of an object in a bucket.
+type Sink = artifacts.Sink
+
+// DirSink is a sink that writes artifacts to files in a local directory, at
+// their names relative to the directory.
+type DirSink = artifacts.DirSink
+
+// SetSink sets the sink to which artifacts are written. By default artifacts
+// are written to the local disk at their names.
+func SetSink(s Sink) {
+	artifacts.SetSink(s)
+}
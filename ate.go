@@ -15,8 +15,13 @@
 package ondatra
 
 import (
+	"golang.org/x/net/context"
+	"testing"
+
 	"github.com/open-traffic-generator/snappi/gosnappi"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/binding/ixweb"
+	"github.com/openconfig/ondatra/internal/ate"
 )
 
 // ATEDevice is an automated test equipment.
@@ -44,3 +49,28 @@ func (a *ATEDevice) Topology() *Topology {
 func (a *ATEDevice) Traffic() *Traffic {
 	return &Traffic{a.res.(*binding.ATE)}
 }
+
+// RawAPIs returns a handle to raw vendor APIs on the ATE.
+func (a *ATEDevice) RawAPIs() *ATERawAPIs {
+	return &ATERawAPIs{ate: a.res.(*binding.ATE)}
+}
+
+// ATERawAPIs provides access to raw ATE vendor APIs, for operations that are
+// not yet modeled by the Ondatra ATE API.
+type ATERawAPIs struct {
+	ate *binding.ATE
+}
+
+// IxNetwork returns the IxNetwork session that Ondatra uses to control the ATE,
+// such as to create custom statistics views. Ondatra does not track changes
+// made through the session, so later topology and traffic pushes may overwrite
+// them.
+func (r *ATERawAPIs) IxNetwork(t testing.TB) *ixweb.Session {
+	t.Helper()
+	logAction(t, "Fetching IxNetwork session for %s", r.ate)
+	sess, err := ate.FetchIxNetworkSession(context.Background(), r.ate)
+	if err != nil {
+		t.Fatalf("IxNetwork(t) on %s: %v", r.ate, err)
+	}
+	return sess
+}
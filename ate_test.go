@@ -0,0 +1,67 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/negtest"
+)
+
+func TestIxNetworkError(t *testing.T) {
+	initFakeBinding(t)
+	reserveFakeTestbed(t)
+	wantErr := "bad ixnetwork"
+	fakeBind.IxNetworkDialer = func(context.Context, *binding.ATE) (*binding.IxNetwork, error) {
+		return nil, errors.New(wantErr)
+	}
+	raw := ATE(t, "ate").RawAPIs()
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		raw.IxNetwork(t)
+	})
+	if !strings.Contains(gotErr, wantErr) {
+		t.Errorf("IxNetwork(t) got err %v, want %v", gotErr, wantErr)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	initFakeBinding(t)
+	reserveFakeTestbed(t)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd() got err %v", err)
+	}
+	before, err := os.ReadDir(wd)
+	if err != nil {
+		t.Fatalf("ReadDir(%q) got err %v", wd, err)
+	}
+	top := ATE(t, "ate").Topology().New()
+	top.AddInterface("intf").WithPort(ATE(t, "ate").Port(t, "port1"))
+	if cfg := top.DryRun(t); len(cfg) == 0 {
+		t.Errorf("DryRun(t) got empty config, want non-empty")
+	}
+	after, err := os.ReadDir(wd)
+	if err != nil {
+		t.Fatalf("ReadDir(%q) got err %v", wd, err)
+	}
+	if len(after) != len(before) {
+		t.Errorf("DryRun(t) created files in %q, want none", wd)
+	}
+}
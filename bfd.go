@@ -0,0 +1,80 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// BFD is a representation of the BFD sessions on an ATE interface.
+// A session is established for each IP version configured on the interface.
+type BFD struct {
+	pb *opb.BfdConfig
+}
+
+// WithTxInterval sets the minimum interval in milliseconds between
+// transmitted BFD control packets.
+func (b *BFD) WithTxInterval(intervalMs uint32) *BFD {
+	b.pb.TxIntervalMs = intervalMs
+	return b
+}
+
+// WithRxInterval sets the minimum interval in milliseconds between received
+// BFD control packets.
+func (b *BFD) WithRxInterval(intervalMs uint32) *BFD {
+	b.pb.RxIntervalMs = intervalMs
+	return b
+}
+
+// WithMultiplier sets the number of missed packets after which a session is
+// considered down.
+func (b *BFD) WithMultiplier(multiplier uint32) *BFD {
+	b.pb.Multiplier = multiplier
+	return b
+}
+
+// WithAdminDown sets whether the sessions are administratively down.
+// Use ATETopology.UpdateBFD to change this while protocols are running.
+func (b *BFD) WithAdminDown(down bool) *BFD {
+	b.pb.AdminDown = down
+	return b
+}
+
+// WithSingleHop sets the sessions to be single-hop, which is the default.
+func (b *BFD) WithSingleHop() *BFD {
+	b.pb.MultiHop = false
+	return b
+}
+
+// WithMultiHop sets the sessions to be multi-hop.
+// Multi-hop sessions require remote addresses.
+func (b *BFD) WithMultiHop() *BFD {
+	b.pb.MultiHop = true
+	return b
+}
+
+// WithIPv4RemoteAddress sets the remote address of the IPv4 session.
+// By default, single-hop sessions use the default gateway of the interface.
+func (b *BFD) WithIPv4RemoteAddress(address string) *BFD {
+	b.pb.Ipv4RemoteAddress = address
+	return b
+}
+
+// WithIPv6RemoteAddress sets the remote address of the IPv6 session.
+// By default, single-hop sessions use the default gateway of the interface.
+func (b *BFD) WithIPv6RemoteAddress(address string) *BFD {
+	b.pb.Ipv6RemoteAddress = address
+	return b
+}
@@ -125,6 +125,34 @@ func (b *BGPPeer) WithMD5Key(key string) *BGPPeer {
 	return b
 }
 
+// WithBFDEnabled sets whether the peer registers with the BFD sessions
+// configured on the interface.
+func (b *BGPPeer) WithBFDEnabled(enabled bool) *BGPPeer {
+	b.pb.EnableBfd = enabled
+	return b
+}
+
+// WithAddPathModeReceive sets the enabled add-path capabilities to only
+// receive additional paths.
+func (b *BGPPeer) WithAddPathModeReceive() *BGPPeer {
+	b.pb.AddPathMode = opb.BgpPeer_ADD_PATH_MODE_RECEIVE
+	return b
+}
+
+// WithAddPathModeSend sets the enabled add-path capabilities to only send
+// additional paths.
+func (b *BGPPeer) WithAddPathModeSend() *BGPPeer {
+	b.pb.AddPathMode = opb.BgpPeer_ADD_PATH_MODE_SEND
+	return b
+}
+
+// WithAddPathModeSendReceive sets the enabled add-path capabilities to both
+// send and receive additional paths.
+func (b *BGPPeer) WithAddPathModeSendReceive() *BGPPeer {
+	b.pb.AddPathMode = opb.BgpPeer_ADD_PATH_MODE_SEND_RECEIVE
+	return b
+}
+
 // BGPCapabilities is a representation of BGP capabilities on the ATE.
 type BGPCapabilities struct {
 	pb *opb.BgpPeer_Capabilities
@@ -582,6 +610,36 @@ func (a *BGPAttributes) WithClusterIDs(values ...string) *BGPAttributes {
 	return a
 }
 
+// WithMED sets the multi-exit discriminator of the routes.
+func (a *BGPAttributes) WithMED(med uint32) *BGPAttributes {
+	a.pb.Med = &opb.BgpAttributes_Med{Med: med}
+	return a
+}
+
+// WithAIGP sets the accumulated IGP metric of the routes.
+func (a *BGPAttributes) WithAIGP(metric uint64) *BGPAttributes {
+	a.pb.Aigp = &opb.BgpAttributes_Aigp{Metric: metric}
+	return a
+}
+
+// WithLargeCommunities sets the large communities of the routes, each in the
+// format "global:local1:local2".
+func (a *BGPAttributes) WithLargeCommunities(values ...string) *BGPAttributes {
+	a.pb.LargeCommunities = values
+	return a
+}
+
+// WithAddPaths advertises each route count times, with add-path IDs starting at
+// pathIDStart and incrementing by one. The advertising peer must have an
+// add-path capability enabled in the send direction.
+func (a *BGPAttributes) WithAddPaths(count, pathIDStart uint32) *BGPAttributes {
+	a.pb.AddPaths = &opb.BgpAttributes_AddPaths{
+		Count:       count,
+		PathIdStart: pathIDStart,
+	}
+	return a
+}
+
 // BGPSRTEPolicyGroup is a representation of BGP SR-TE policies on the ATE.
 type BGPSRTEPolicyGroup struct {
 	pb *opb.BgpPeer_SrtePolicyGroup
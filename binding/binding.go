@@ -57,6 +57,17 @@ import (
 // All infrastructure failure errors are passed to ReportInfraFail.
 type Binding interface {
 
+	// SetupTestbed prepares the infrastructure on which the specified testbed
+	// will be reserved, such as by creating an emulated topology. The framework
+	// calls it before Reserve, but not when a reservation is fetched.
+	// Implementations that need no setup should return nil.
+	SetupTestbed(ctx context.Context, tb *opb.Testbed) error
+
+	// TeardownTestbed tears down the infrastructure prepared by SetupTestbed.
+	// The framework calls it after Release, or after Reserve fails, if
+	// SetupTestbed succeeded.
+	TeardownTestbed(ctx context.Context) error
+
 	// Reserve reserves resources matching the criteria in the specified testbed.
 	// The framework has already verified that the testbed is valid, and will
 	// validate that the returned reservation matches the testbed criteria.
@@ -93,20 +104,35 @@ type Binding interface {
 	// otherwise the existing config is replaced with the provided config.
 	PushConfig(ctx context.Context, dut *DUT, config string, opts *ConfigOptions) error
 
+	// CheckpointConfig saves the current config of the specified DUT with a
+	// vendor-native mechanism, such as a config checkpoint, and returns an ID
+	// with which RestoreConfig restores it. Implementations without such a
+	// mechanism should return an empty ID and a nil error, in which case the
+	// framework checkpoints the config of the DUT with gNMI instead.
+	CheckpointConfig(ctx context.Context, dut *DUT) (string, error)
+
+	// RestoreConfig restores the config of the specified DUT saved by
+	// CheckpointConfig with the specified ID.
+	RestoreConfig(ctx context.Context, dut *DUT, id string) error
+
 	// DialGNMI creates a client connection to the specified DUT's gNMI endpoint.
-	// Implementations must append transport security options necessary to reach the server.
+	// Implementations must add transport security options necessary to reach the server
+	// before the specified options, so that tests can override them.
 	DialGNMI(ctx context.Context, dut *DUT, opts ...grpc.DialOption) (gpb.GNMIClient, error)
 
 	// DialGNOI creates a client connection to the specified DUT's gNOI endpoint.
-	// Implementations must append transport security options necessary to reach the server.
+	// Implementations must add transport security options necessary to reach the server
+	// before the specified options, so that tests can override them.
 	DialGNOI(ctx context.Context, dut *DUT, opts ...grpc.DialOption) (GNOIClients, error)
 
 	// DialGRIBI creates a client connection to the specified DUT's gRIBI endpoint.
-	// Implementations must append transport security options necessary to reach the server.
+	// Implementations must add transport security options necessary to reach the server
+	// before the specified options, so that tests can override them.
 	DialGRIBI(ctx context.Context, dut *DUT, opts ...grpc.DialOption) (grpb.GRIBIClient, error)
 
 	// DialP4RT creates a client connection to the specified DUT's P4RT endpoint.
-	// Implementations must append transport security options necessary to reach the server.
+	// Implementations must add transport security options necessary to reach the server
+	// before the specified options, so that tests can override them.
 	DialP4RT(ctx context.Context, dut *DUT, opts ...grpc.DialOption) (p4pb.P4RuntimeClient, error)
 
 	// DialConsole creates a client connection to the specified DUT's Console endpoint.
@@ -117,6 +143,21 @@ type Binding interface {
 	// Implementations must append transport security options necessary to reach the server.
 	DialCLI(ctx context.Context, dut *DUT, opts ...grpc.DialOption) (StreamClient, error)
 
+	// StreamConsoleLogs streams the console output of the specified DUT, such as
+	// crash traces and kernel logs, until the returned reader is closed.
+	// Implementations must not interfere with other clients of the DUT, such as
+	// those created by DialConsole, so that logs can be captured concurrently with
+	// other operations. Implementations that cannot stream console logs must
+	// return an error with the gRPC Unimplemented code.
+	StreamConsoleLogs(ctx context.Context, dut *DUT) (io.ReadCloser, error)
+
+	// StartPacketCapture starts capturing the packets on the specified ports of
+	// the specified DUT, or on all its ports if none are specified, that match
+	// the filter, in tcpdump syntax, if any. The capture runs until it is
+	// stopped. Implementations that cannot capture packets on the DUT, such as
+	// with a gNOI or vendor RPC, should return an error.
+	StartPacketCapture(ctx context.Context, dut *DUT, ports []*Port, filter string) (PacketCapture, error)
+
 	// DialIxNetwork creates a client connection to the specified ATE's IxNetwork endpoint.
 	DialIxNetwork(ctx context.Context, ate *ATE) (*IxNetwork, error)
 
@@ -130,11 +171,19 @@ type Binding interface {
 	SetTestMetadata(md *TestMetadata) error
 }
 
+// PacketCapture is a packet capture running on a DUT.
+type PacketCapture interface {
+	// Stop stops the capture and returns the captured packets in pcap format.
+	Stop(ctx context.Context) ([]byte, error)
+}
+
 // Reservation holds the reserved DUTs and ATEs as an id map.
 type Reservation struct {
 	ID   string
 	DUTs map[string]*DUT
 	ATEs map[string]*ATE
+	// Lab is the name of the lab of the reserved devices, if known.
+	Lab string
 }
 
 // Device is a reserved DUT or ATE.
@@ -149,6 +198,9 @@ type Dims struct {
 	HardwareModel   string
 	SoftwareVersion string
 	Ports           map[string]*Port
+	// Tags are binding-defined labels of the device, such as its location or
+	// the capabilities of its platform.
+	Tags map[string]string
 }
 
 func (d *Dims) String() string {
@@ -187,6 +239,9 @@ func (a *ATE) String() string {
 type Port struct {
 	Name  string
 	Speed opb.Port_Speed
+	// Breakout is the breakout of the physical port to which the port belongs,
+	// or nil if the port is not a breakout port.
+	Breakout *opb.Port_Breakout
 }
 
 func (p *Port) String() string {
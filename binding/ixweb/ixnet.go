@@ -128,6 +128,21 @@ func (s *Session) String() string {
 	return fmt.Sprintf("Session %d %s", s.id, s.name)
 }
 
+// IsActive reports whether the session still exists and is running. It
+// returns false if the state of the session cannot be fetched, such as after
+// the API server restarts.
+func (s *Session) IsActive(ctx context.Context) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := struct {
+		State string `json:"state"`
+	}{}
+	if err := s.ixweb.jsonReq(ctx, get, sessionPath(s.id), nil, &data); err != nil {
+		return false
+	}
+	return strings.EqualFold(data.State, "active")
+}
+
 // AbsPath returns an absolute path, given a path relative to the IxNetwork session.
 // If relPath is already an absolute path, this method returns the input string.
 func (s *Session) AbsPath(relPath string) string {
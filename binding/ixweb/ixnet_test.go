@@ -16,6 +16,9 @@ package ixweb
 
 import (
 	"encoding/json"
+	"errors"
+	"golang.org/x/net/context"
+	"net/http"
 	"testing"
 )
 
@@ -37,3 +40,43 @@ func TestOpArgs(t *testing.T) {
 		t.Errorf("OpArgs unmarshal got [%s, %s], want [%s, %s]", got1, got2, want1, want2)
 	}
 }
+
+func TestIsActive(t *testing.T) {
+	tests := []struct {
+		desc string
+		resp *http.Response
+		err  error
+		want bool
+	}{{
+		desc: "active",
+		resp: fakeResponse(200, `{"state": "ACTIVE"}`),
+		want: true,
+	}, {
+		desc: "stopped",
+		resp: fakeResponse(200, `{"state": "STOPPED"}`),
+	}, {
+		desc: "not found",
+		resp: fakeResponse(404, ""),
+	}, {
+		desc: "request error",
+		err:  errors.New("connection refused"),
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			client := &fakeHTTPClient{}
+			if test.resp != nil {
+				client.doResps = []*http.Response{test.resp}
+			}
+			if test.err != nil {
+				// Fail every retry of the request.
+				for i := 0; i <= RetryLimit; i++ {
+					client.doErrs = append(client.doErrs, test.err)
+				}
+			}
+			sess := &Session{ixweb: &IxWeb{client: client}, id: 1}
+			if got := sess.IsActive(context.Background()); got != test.want {
+				t.Errorf("IsActive() got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
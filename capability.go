@@ -0,0 +1,88 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"testing"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/capability"
+)
+
+// Capability is a service that a DUT may support.
+type Capability capability.Capability
+
+const (
+	// CapabilityGNMI is the gNMI service.
+	CapabilityGNMI = Capability(capability.GNMI)
+	// CapabilityGNOIOS is the gNOI OS service.
+	CapabilityGNOIOS = Capability(capability.GNOIOS)
+	// CapabilityGNOISystem is the gNOI System service.
+	CapabilityGNOISystem = Capability(capability.GNOISystem)
+	// CapabilityGNOICertificate is the gNOI CertificateManagement service.
+	CapabilityGNOICertificate = Capability(capability.GNOICertificate)
+	// CapabilityGRIBI is the gRIBI service.
+	CapabilityGRIBI = Capability(capability.GRIBI)
+	// CapabilityP4RT is the P4Runtime service.
+	CapabilityP4RT = Capability(capability.P4RT)
+)
+
+// String returns the name of the capability.
+func (c Capability) String() string {
+	return capability.Capability(c).String()
+}
+
+// Supports returns whether the DUT supports the capability. The DUT is probed
+// for the capability when it is first queried, unless it was probed when the
+// testbed was reserved with the -discover_capabilities flag, and again if the
+// probe failed. The result is cached for the rest of the test run.
+func (d *DUTDevice) Supports(t testing.TB, c Capability) bool {
+	t.Helper()
+	s, err := capability.Supports(context.Background(), d.res.(*binding.DUT), capability.Capability(c))
+	if err != nil {
+		t.Fatalf("Supports(t, %v) on %v: %v", c, d, err)
+	}
+	return s
+}
+
+// DiscoveredInfo is the information reported by the services of a DUT when it
+// is probed for its capabilities.
+type DiscoveredInfo struct {
+	// OSVersion is the version of the running OS, as reported by gNOI OS.
+	OSVersion string
+	// GNMIVersion is the version of the gNMI service.
+	GNMIVersion string
+	// Models are the versions of the models supported by gNMI, keyed by name.
+	Models map[string]string
+}
+
+// Discovered returns the information reported by the services of the DUT when
+// it is probed for its capabilities. The DUT is probed for the capabilities it
+// has not been probed for yet, so the fields of the services that the DUT does
+// not support, or that could not be probed, are empty.
+func (d *DUTDevice) Discovered() *DiscoveredInfo {
+	dut := d.res.(*binding.DUT)
+	if err := capability.Discover(context.Background(), dut); err != nil {
+		log.Warningf("Error discovering capabilities of %v: %v", d, err)
+	}
+	info := capability.Discovered(dut)
+	return &DiscoveredInfo{
+		OSVersion:   info.OSVersion,
+		GNMIVersion: info.GNMIVersion,
+		Models:      info.Models,
+	}
+}
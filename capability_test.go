@@ -0,0 +1,329 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/negtest"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	cpb "github.com/openconfig/gnoi/cert"
+	ospb "github.com/openconfig/gnoi/os"
+	spb "github.com/openconfig/gnoi/system"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
+)
+
+func TestSupports(t *testing.T) {
+	initOperationFakes(t)
+	tests := []struct {
+		desc      string
+		dut       string
+		verifyErr error
+		want      bool
+	}{{
+		desc:      "unimplemented",
+		dut:       "dut_cisco",
+		verifyErr: status.Error(codes.Unimplemented, "no os service"),
+		want:      false,
+	}, {
+		desc:      "invalid argument",
+		dut:       "dut_juniper",
+		verifyErr: status.Error(codes.InvalidArgument, "bad request"),
+		want:      true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.Verifier = func(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+				return nil, tt.verifyErr
+			}
+			dut := DUT(t, tt.dut)
+			if got := dut.Supports(t, CapabilityGNOIOS); got != tt.want {
+				t.Errorf("Supports(t, %v) got %v, want %v", CapabilityGNOIOS, got, tt.want)
+			}
+			// The result is cached, so the DUT must not be probed again.
+			fakeGNOI.Verifier = func(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+				t.Errorf("Supports(t, %v) probed the DUT again", CapabilityGNOIOS)
+				return nil, nil
+			}
+			if got := dut.Supports(t, CapabilityGNOIOS); got != tt.want {
+				t.Errorf("Supports(t, %v) got cached %v, want %v", CapabilityGNOIOS, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportsError(t *testing.T) {
+	initOperationFakes(t)
+	fakeGNOI.Verifier = func(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	dut := DUT(t, "dut")
+	got := negtest.ExpectFatal(t, func(t testing.TB) {
+		dut.Supports(t, CapabilityGNOIOS)
+	})
+	if want := "connection refused"; !strings.Contains(got, want) {
+		t.Errorf("Supports(t, %v) got error %q, want %q", CapabilityGNOIOS, got, want)
+	}
+}
+
+type fakeCapsGNMIClient struct {
+	gpb.GNMIClient
+	resp *gpb.CapabilityResponse
+	err  error
+}
+
+func (c *fakeCapsGNMIClient) Capabilities(context.Context, *gpb.CapabilityRequest, ...grpc.CallOption) (*gpb.CapabilityResponse, error) {
+	return c.resp, c.err
+}
+
+type fakeCapsGRIBIClient struct {
+	grpb.GRIBIClient
+	resps []*grpb.GetResponse
+	err   error
+}
+
+func (c *fakeCapsGRIBIClient) Get(context.Context, *grpb.GetRequest, ...grpc.CallOption) (grpb.GRIBI_GetClient, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &fakeGetClient{resps: c.resps}, nil
+}
+
+type fakeCapsP4RTClient struct {
+	p4pb.P4RuntimeClient
+	err error
+}
+
+func (c *fakeCapsP4RTClient) Capabilities(context.Context, *p4pb.CapabilitiesRequest, ...grpc.CallOption) (*p4pb.CapabilitiesResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &p4pb.CapabilitiesResponse{P4RuntimeApiVersion: "1.3.0"}, nil
+}
+
+// fakeCapsGNOIClients reports the OS version and that the gNOI System and
+// CertificateManagement services are unimplemented.
+type fakeCapsGNOIClients struct {
+	binding.GNOIClients
+	osVersion string
+}
+
+func (c *fakeCapsGNOIClients) OS() ospb.OSClient {
+	return &fakeCapsOSClient{version: c.osVersion}
+}
+
+func (c *fakeCapsGNOIClients) System() spb.SystemClient {
+	return &fakeCapsSystemClient{}
+}
+
+func (c *fakeCapsGNOIClients) CertificateManagement() cpb.CertificateManagementClient {
+	return &fakeCapsCertClient{}
+}
+
+type fakeCapsOSClient struct {
+	ospb.OSClient
+	version string
+}
+
+func (c *fakeCapsOSClient) Verify(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+	return &ospb.VerifyResponse{Version: c.version}, nil
+}
+
+type fakeCapsSystemClient struct {
+	spb.SystemClient
+}
+
+func (c *fakeCapsSystemClient) Time(context.Context, *spb.TimeRequest, ...grpc.CallOption) (*spb.TimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "no system service")
+}
+
+type fakeCapsCertClient struct {
+	cpb.CertificateManagementClient
+}
+
+func (c *fakeCapsCertClient) GetCertificates(context.Context, *cpb.GetCertificatesRequest, ...grpc.CallOption) (*cpb.GetCertificatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "no cert service")
+}
+
+// fakeCapsDialers makes the fake binding dial the specified clients, and fail
+// to dial them without a deadline.
+func fakeCapsDialers(gnmi gpb.GNMIClient, gribi grpb.GRIBIClient, p4rt p4pb.P4RuntimeClient) {
+	fakeBind.GNMIDialer = func(ctx context.Context, _ *binding.DUT, _ ...grpc.DialOption) (gpb.GNMIClient, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, errors.New("dialed gNMI without a deadline")
+		}
+		return gnmi, nil
+	}
+	fakeBind.GRIBIDialer = func(ctx context.Context, _ *binding.DUT, _ ...grpc.DialOption) (grpb.GRIBIClient, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, errors.New("dialed gRIBI without a deadline")
+		}
+		return gribi, nil
+	}
+	fakeBind.P4RTDialer = func(ctx context.Context, _ *binding.DUT, _ ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, errors.New("dialed P4RT without a deadline")
+		}
+		return p4rt, nil
+	}
+}
+
+func TestSupportsProbes(t *testing.T) {
+	initOperationFakes(t)
+	unimplemented := status.Error(codes.Unimplemented, "no service")
+	tests := []struct {
+		desc  string
+		c     Capability
+		gnmi  *fakeCapsGNMIClient
+		gribi *fakeCapsGRIBIClient
+		p4rt  *fakeCapsP4RTClient
+		// noDialer makes the gRIBI dialer panic, like that of a binding that
+		// does not implement it.
+		noDialer bool
+		want     bool
+	}{{
+		desc: "gNMI supported",
+		c:    CapabilityGNMI,
+		gnmi: &fakeCapsGNMIClient{resp: &gpb.CapabilityResponse{}},
+		want: true,
+	}, {
+		desc: "gNMI unimplemented",
+		c:    CapabilityGNMI,
+		gnmi: &fakeCapsGNMIClient{err: unimplemented},
+		want: false,
+	}, {
+		desc:  "gRIBI with entries",
+		c:     CapabilityGRIBI,
+		gribi: &fakeCapsGRIBIClient{resps: []*grpb.GetResponse{{}}},
+		want:  true,
+	}, {
+		desc:  "gRIBI with empty RIB",
+		c:     CapabilityGRIBI,
+		gribi: &fakeCapsGRIBIClient{},
+		want:  true,
+	}, {
+		desc:  "gRIBI unimplemented",
+		c:     CapabilityGRIBI,
+		gribi: &fakeCapsGRIBIClient{err: unimplemented},
+		want:  false,
+	}, {
+		desc:     "gRIBI dialer unimplemented",
+		c:        CapabilityGRIBI,
+		noDialer: true,
+		want:     false,
+	}, {
+		desc: "P4RT supported",
+		c:    CapabilityP4RT,
+		p4rt: &fakeCapsP4RTClient{},
+		want: true,
+	}, {
+		desc: "P4RT unimplemented",
+		c:    CapabilityP4RT,
+		p4rt: &fakeCapsP4RTClient{err: unimplemented},
+		want: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeCapsDialers(tt.gnmi, tt.gribi, tt.p4rt)
+			if tt.noDialer {
+				var b binding.Binding
+				fakeBind.GRIBIDialer = func(ctx context.Context, dut *binding.DUT, opts ...grpc.DialOption) (grpb.GRIBIClient, error) {
+					return b.DialGRIBI(ctx, dut, opts...)
+				}
+			}
+			// A new DUT, so that it has not been probed by other tests.
+			dut := newDUT("dut", &binding.DUT{fakeRes.DUTs["dut"].Dims})
+			if got := dut.Supports(t, tt.c); got != tt.want {
+				t.Errorf("Supports(t, %v) got %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	initFakeBinding(t)
+	// Reserve new DUTs, so that they have not been probed by other tests.
+	res := &binding.Reservation{ID: fakeRes.ID, DUTs: make(map[string]*binding.DUT), ATEs: fakeRes.ATEs}
+	for id, dut := range fakeRes.DUTs {
+		res.DUTs[id] = &binding.DUT{dut.Dims}
+	}
+	fakeBind.Reservation = res
+	reserveFakeTestbed(t)
+	fakeBind.GNOIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.GNOIClients, error) {
+		return &fakeCapsGNOIClients{osVersion: "4.27.0F"}, nil
+	}
+	fakeCapsDialers(
+		&fakeCapsGNMIClient{resp: &gpb.CapabilityResponse{
+			GNMIVersion:     "0.7.0",
+			SupportedModels: []*gpb.ModelData{{Name: "openconfig-interfaces", Version: "2.4.3"}},
+		}},
+		&fakeCapsGRIBIClient{},
+		&fakeCapsP4RTClient{err: status.Error(codes.Unimplemented, "no p4rt")},
+	)
+	discover()
+
+	dut := DUT(t, "dut")
+	want := &DiscoveredInfo{
+		OSVersion:   "4.27.0F",
+		GNMIVersion: "0.7.0",
+		Models:      map[string]string{"openconfig-interfaces": "2.4.3"},
+	}
+	if diff := cmp.Diff(want, dut.Discovered()); diff != "" {
+		t.Errorf("Discovered() got unexpected diff (-want,+got): %s", diff)
+	}
+	// The capabilities were discovered, so the DUT must not be probed again.
+	fakeCapsDialers(nil, nil, nil)
+	fakeBind.GNOIDialer = nil
+	for c, want := range map[Capability]bool{
+		CapabilityGNMI:            true,
+		CapabilityGNOIOS:          true,
+		CapabilityGNOISystem:      false,
+		CapabilityGNOICertificate: false,
+		CapabilityGRIBI:           true,
+		CapabilityP4RT:            false,
+	} {
+		if got := dut.Supports(t, c); got != want {
+			t.Errorf("Supports(t, %v) got %v, want %v", c, got, want)
+		}
+	}
+}
+
+func TestDiscoveredLazily(t *testing.T) {
+	initOperationFakes(t)
+	fakeBind.GNOIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.GNOIClients, error) {
+		return &fakeCapsGNOIClients{osVersion: "4.27.0F"}, nil
+	}
+	fakeCapsDialers(
+		&fakeCapsGNMIClient{resp: &gpb.CapabilityResponse{GNMIVersion: "0.7.0"}},
+		&fakeCapsGRIBIClient{},
+		&fakeCapsP4RTClient{},
+	)
+	// A new DUT, so that it has not been probed by other tests.
+	dut := newDUT("dut", &binding.DUT{fakeRes.DUTs["dut"].Dims})
+	want := &DiscoveredInfo{OSVersion: "4.27.0F", GNMIVersion: "0.7.0", Models: map[string]string{}}
+	if diff := cmp.Diff(want, dut.Discovered()); diff != "" {
+		t.Errorf("Discovered() got unexpected diff (-want,+got): %s", diff)
+	}
+}
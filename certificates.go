@@ -0,0 +1,154 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const testKeySize = 2048
+
+// TestCA is a certificate authority for testing certificate management
+// operations. It must not be used to secure production devices.
+type TestCA struct {
+	cert    *x509.Certificate
+	certPEM []byte
+	key     *rsa.PrivateKey
+}
+
+// NewTestCA creates a certificate authority with a new self-signed certificate
+// for the common name, which is valid for a day.
+func NewTestCA(t testing.TB, commonName string) *TestCA {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, testKeySize)
+	if err != nil {
+		t.Fatalf("NewTestCA(t, %s): error generating key: %v", commonName, err)
+	}
+	tmpl, err := certTemplate(commonName)
+	if err != nil {
+		t.Fatalf("NewTestCA(t, %s): %v", commonName, err)
+	}
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("NewTestCA(t, %s): error creating certificate: %v", commonName, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("NewTestCA(t, %s): error parsing certificate: %v", commonName, err)
+	}
+	return &TestCA{
+		cert:    cert,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		key:     key,
+	}
+}
+
+// CertificatePEM returns the PEM-encoded certificate of the CA.
+func (ca *TestCA) CertificatePEM() []byte {
+	return ca.certPEM
+}
+
+// SignCSR signs a PEM-encoded CSR and returns the PEM-encoded certificate.
+func (ca *TestCA) SignCSR(t testing.TB, csrPEM []byte) []byte {
+	t.Helper()
+	certPEM, err := ca.signCSR(csrPEM)
+	if err != nil {
+		t.Fatalf("SignCSR(t) on %s: %v", ca.cert.Subject.CommonName, err)
+	}
+	return certPEM
+}
+
+func (ca *TestCA) signCSR(csrPEM []byte) ([]byte, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("CSR is not a PEM-encoded certificate request")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing CSR")
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, errors.Wrap(err, "invalid CSR signature")
+	}
+	tmpl, err := certTemplate(csr.Subject.CommonName)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.Subject = csr.Subject
+	tmpl.DNSNames = csr.DNSNames
+	tmpl.IPAddresses = csr.IPAddresses
+	tmpl.EmailAddresses = csr.EmailAddresses
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating certificate")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// NewCertificate creates a key pair and a certificate for the common name that
+// is signed by the CA, such as for a client of a device, and returns the
+// PEM-encoded certificate and private key.
+func (ca *TestCA) NewCertificate(t testing.TB, commonName string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	csrPEM, keyPEM := NewTestCSR(t, commonName)
+	return ca.SignCSR(t, csrPEM), keyPEM
+}
+
+// NewTestCSR creates a key pair and a CSR for the common name, and returns the
+// PEM-encoded CSR and private key.
+func NewTestCSR(t testing.TB, commonName string) (csrPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, testKeySize)
+	if err != nil {
+		t.Fatalf("NewTestCSR(t, %s): error generating key: %v", commonName, err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	if err != nil {
+		t.Fatalf("NewTestCSR(t, %s): error creating CSR: %v", commonName, err)
+	}
+	csrPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return csrPEM, keyPEM
+}
+
+func certTemplate(commonName string) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating serial number")
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, nil
+}
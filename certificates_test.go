@@ -0,0 +1,65 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ondatra/negtest"
+)
+
+// verifyCert checks that a PEM-encoded certificate is signed by the CA.
+func verifyCert(t *testing.T, ca *TestCA, certPEM []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		t.Fatalf("certificate is not PEM-encoded: %q", certPEM)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("error parsing certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.CertificatePEM())
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: roots}); err != nil {
+		t.Errorf("certificate is not signed by the CA: %v", err)
+	}
+	return cert
+}
+
+func TestTestCA(t *testing.T) {
+	ca := NewTestCA(t, "ca")
+	certPEM, keyPEM := ca.NewCertificate(t, "client")
+	cert := verifyCert(t, ca, certPEM)
+	if got, want := cert.Subject.CommonName, "client"; got != want {
+		t.Errorf("NewCertificate(t) got common name %q, want %q", got, want)
+	}
+	if block, _ := pem.Decode(keyPEM); block == nil || block.Type != "RSA PRIVATE KEY" {
+		t.Errorf("NewCertificate(t) got key %q, want a PEM-encoded RSA private key", keyPEM)
+	}
+}
+
+func TestSignCSRError(t *testing.T) {
+	ca := NewTestCA(t, "ca")
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		ca.SignCSR(t, []byte("not a CSR"))
+	})
+	if want := "not a PEM-encoded certificate request"; !strings.Contains(gotErr, want) {
+		t.Errorf("SignCSR(t) got err %q, want %q", gotErr, want)
+	}
+}
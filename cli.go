@@ -0,0 +1,104 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/cli"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// CLI returns a handle to the DUT CLI API, for tests that must fall back to the
+// CLI for features not yet modeled in OpenConfig.
+func (d *DUTDevice) CLI() *CLI {
+	return &CLI{dut: d.res.(*binding.DUT)}
+}
+
+// CLI is the DUT CLI API.
+type CLI struct {
+	dut     *binding.DUT
+	timeout time.Duration
+}
+
+// WithTimeout returns a CLI handle whose commands fail if they do not complete
+// within the specified timeout.
+func (c *CLI) WithTimeout(timeout time.Duration) *CLI {
+	return &CLI{dut: c.dut, timeout: timeout}
+}
+
+func (c *CLI) context() (context.Context, context.CancelFunc) {
+	if c.timeout == 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// Run runs the command on the DUT CLI and returns its output, without the
+// echoed command and the trailing prompt of the CLI.
+func (c *CLI) Run(t testing.TB, cmd string) string {
+	t.Helper()
+	logAction(t, "Running CLI command on %s", c.dut)
+	ctx, cancel := c.context()
+	defer cancel()
+	out, err := cli.Run(ctx, c.dut, cmd)
+	if err != nil {
+		t.Fatalf("Run(t) on %v: %v", c.dut, err)
+	}
+	return out
+}
+
+// RunAndParse runs the command on the DUT CLI and returns its output parsed
+// by the parser registered for the command and the DUT vendor.
+func (c *CLI) RunAndParse(t testing.TB, cmd string) []map[string]string {
+	t.Helper()
+	logAction(t, "Running and parsing CLI command on %s", c.dut)
+	ctx, cancel := c.context()
+	defer cancel()
+	rows, err := cli.RunAndParse(ctx, c.dut, cmd)
+	if err != nil {
+		t.Fatalf("RunAndParse(t) on %v: %v", c.dut, err)
+	}
+	return rows
+}
+
+// CLIParser parses the output of a CLI command into rows of named values. A
+// parser can wrap any parsing library, such as TextFSM templates.
+type CLIParser func(output string) ([]map[string]string, error)
+
+// RegisterCLIParser registers the parser for the output of the commands that
+// fully match the regular expression on DUTs of the vendor. Parsers registered
+// later take precedence over those registered earlier.
+func RegisterCLIParser(vendor opb.Device_Vendor, cmdPattern string, parser CLIParser) error {
+	return cli.RegisterParser(vendor, cmdPattern, cli.Parser(parser))
+}
+
+// RegexCLIParser returns a parser that returns a row for every match of the
+// regular expression in the output, which maps the names of the subexpressions
+// of the regular expression to their matched values. For example, the pattern
+// `(?m)^(?P<name>Et\S+)\s+(?P<status>\S+)$` returns a row with the name and
+// status of each interface in the output.
+func RegexCLIParser(pattern string) (CLIParser, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return CLIParser(cli.RegexParser(re)), nil
+}
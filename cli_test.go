@@ -0,0 +1,139 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/negtest"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+type fakeCLIClient struct {
+	binding.StreamClient
+	outs        map[string]string
+	gotDeadline bool
+	closed      bool
+}
+
+func (c *fakeCLIClient) SendCommand(ctx context.Context, cmd string) (string, error) {
+	_, c.gotDeadline = ctx.Deadline()
+	out, ok := c.outs[cmd]
+	if !ok {
+		return "", errors.Errorf("unknown command %q", cmd)
+	}
+	return out, nil
+}
+
+func (c *fakeCLIClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func initCLIFakes(t *testing.T, outs map[string]string) *fakeCLIClient {
+	t.Helper()
+	initDUTFakes(t)
+	fc := &fakeCLIClient{outs: outs}
+	fakeBind.CLIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.StreamClient, error) {
+		return fc, nil
+	}
+	return fc
+}
+
+func TestCLIRun(t *testing.T) {
+	fc := initCLIFakes(t, map[string]string{
+		"show version": "show version\nArista vEOS\nswitch1#",
+	})
+	cli := DUT(t, "dut").CLI()
+	if got, want := cli.Run(t, "show version"), "Arista vEOS"; got != want {
+		t.Errorf("Run(t) got %q, want %q", got, want)
+	}
+	if fc.gotDeadline {
+		t.Errorf("Run(t) got command with deadline, want none")
+	}
+	if !fc.closed {
+		t.Errorf("Run(t) did not close the CLI client")
+	}
+	cli.WithTimeout(time.Minute).Run(t, "show version")
+	if !fc.gotDeadline {
+		t.Errorf("WithTimeout(%v).Run(t) got command without deadline", time.Minute)
+	}
+}
+
+func TestCLIRunAndParse(t *testing.T) {
+	initCLIFakes(t, map[string]string{
+		"show interfaces status": "Port Status\nEt1 connected\nEt2 notconnect\nswitch1#",
+	})
+	parser, err := RegexCLIParser(`(?m)^(?P<name>Et\S+)\s+(?P<status>\S+)$`)
+	if err != nil {
+		t.Fatalf("RegexCLIParser() got error %v", err)
+	}
+	if err := RegisterCLIParser(opb.Device_ARISTA, `show interfaces status`, parser); err != nil {
+		t.Fatalf("RegisterCLIParser() got error %v", err)
+	}
+	got := DUT(t, "dut").CLI().RunAndParse(t, "show interfaces status")
+	want := []map[string]string{
+		{"name": "Et1", "status": "connected"},
+		{"name": "Et2", "status": "notconnect"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RunAndParse(t) unexpected rows diff (-want,+got): %s", diff)
+	}
+}
+
+func TestCLIErrors(t *testing.T) {
+	initCLIFakes(t, map[string]string{"show bad": "bad output"})
+	if err := RegisterCLIParser(opb.Device_ARISTA, `show bad`, func(string) ([]map[string]string, error) {
+		return nil, errors.New("unparsable")
+	}); err != nil {
+		t.Fatalf("RegisterCLIParser() got error %v", err)
+	}
+	if _, err := RegexCLIParser(`(`); err == nil {
+		t.Errorf("RegexCLIParser() of invalid pattern got no error")
+	}
+	cli := DUT(t, "dut").CLI()
+	tests := []struct {
+		desc    string
+		f       func(t testing.TB)
+		wantErr string
+	}{{
+		desc:    "unknown command",
+		f:       func(t testing.TB) { cli.Run(t, "show unknown") },
+		wantErr: "unknown command",
+	}, {
+		desc:    "no parser",
+		f:       func(t testing.TB) { cli.RunAndParse(t, "show unknown") },
+		wantErr: "no parser",
+	}, {
+		desc:    "parse error",
+		f:       func(t testing.TB) { cli.RunAndParse(t, "show bad") },
+		wantErr: "unparsable",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if gotErr := negtest.ExpectFatal(t, tt.f); !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("CLI got err %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -20,11 +20,14 @@ import (
 	"math"
 	"sync"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/ate"
 	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
+	"github.com/openconfig/ondatra/internal/gnmilog"
 	"github.com/openconfig/ondatra/internal/testbed"
 	"github.com/openconfig/ondatra/telemetry/device"
 
@@ -79,6 +82,11 @@ func (v Vendor) String() string {
 }
 
 // Telemetry returns a telemetry path root for the device.
+// On an ATE, the root serves flow counters under Flow, port counters under
+// Interface, port CPU utilization under Component, and the session states of
+// and routes learned by emulated BGP peers under the NetworkInstance named
+// after the ATE interface, so the same Lookup, Get, Watch, and Await calls
+// apply to DUTs and ATEs.
 func (d *Device) Telemetry() *device.DevicePath {
 	root := device.DeviceRoot(d.ID())
 	// TODO: Add field to root node in ygot instead of using custom data.
@@ -86,6 +94,24 @@ func (d *Device) Telemetry() *device.DevicePath {
 	return root
 }
 
+// PathRoot is the root path struct of a generated telemetry or config API.
+type PathRoot interface {
+	Id() string
+	PutCustomData(key string, val interface{})
+}
+
+// AttachRoot binds the root path struct of a telemetry or config API
+// generated against another OpenConfig release to the device, so that its
+// paths query the device. The root must have been created with the ID of the
+// device, such as with DeviceRoot(dut.ID()) of the generated device package.
+func (d *Device) AttachRoot(t testing.TB, root PathRoot) {
+	t.Helper()
+	if root.Id() != d.ID() {
+		t.Fatalf("AttachRoot(t) on %s: root has ID %q, want %q", d, root.Id(), d.ID())
+	}
+	root.PutCustomData(genutil.DefaultClientKey, d.clientFn)
+}
+
 // Vendor returns the device vendor.
 func (d *Device) Vendor() Vendor {
 	return Vendor(d.res.Dimensions().Vendor)
@@ -101,6 +127,45 @@ func (d *Device) Version() string {
 	return d.res.Dimensions().SoftwareVersion
 }
 
+// DeviceInfo is the reservation metadata of a device, as populated by the
+// binding, to let tests branch on platform capabilities and reports include
+// information about the test environment.
+type DeviceInfo struct {
+	ID, Name       string
+	Vendor         Vendor
+	Model, Version string
+	// ReservationID and Lab identify the reservation of the device.
+	ReservationID, Lab string
+	// PortSpeeds are the speeds of the reserved ports, keyed by port ID.
+	PortSpeeds map[string]Speed
+	Tags       map[string]string
+}
+
+// Info returns the reservation metadata of the device.
+func (d *Device) Info() *DeviceInfo {
+	dims := d.res.Dimensions()
+	info := &DeviceInfo{
+		ID:         d.ID(),
+		Name:       dims.Name,
+		Vendor:     d.Vendor(),
+		Model:      dims.HardwareModel,
+		Version:    dims.SoftwareVersion,
+		PortSpeeds: make(map[string]Speed),
+		Tags:       make(map[string]string),
+	}
+	if res, err := testbed.Reservation(); err == nil {
+		info.ReservationID = res.ID
+		info.Lab = res.Lab
+	}
+	for id, p := range dims.Ports {
+		info.PortSpeeds[id] = Speed(p.Speed)
+	}
+	for k, v := range dims.Tags {
+		info.Tags[k] = v
+	}
+	return info
+}
+
 // Port returns a port with a given id.
 func (d *Device) Port(t testing.TB, ID string) *Port {
 	t.Helper()
@@ -111,6 +176,16 @@ func (d *Device) Port(t testing.TB, ID string) *Port {
 	return p
 }
 
+// LookupPort returns the port on the device with a given id, and whether it
+// was reserved, such as when the testbed declares the port as optional.
+func (d *Device) LookupPort(ID string) (*Port, bool) {
+	p, err := d.port(ID)
+	if err != nil {
+		return nil, false
+	}
+	return p, true
+}
+
 // Ports returns a slice of all ports configured on the device.
 func (d *Device) Ports() []*Port {
 	var ports []*Port
@@ -134,7 +209,7 @@ func (d *Device) newPort(id string, res *binding.Port) *Port {
 
 // Operations returns a handle to the device operations API.
 func (d *Device) Operations() *Operations {
-	return &Operations{d.res}
+	return &Operations{id: d.id, dev: d.res, telemetry: d.Telemetry}
 }
 
 // Port represents a port.
@@ -180,13 +255,35 @@ func (p *Port) Speed() Speed {
 	return Speed(p.res.Speed)
 }
 
+// Breakout is the breakout of a physical port into multiple channels, such as
+// 4x25G or 2x50G.
+type Breakout struct {
+	NumChannels      uint32
+	ChannelSpeedGbps uint32
+}
+
+// Breakout returns the breakout of the physical port to which the port
+// belongs, or nil if the port is not a breakout port.
+func (p *Port) Breakout() *Breakout {
+	b := p.res.Breakout
+	if b == nil {
+		return nil
+	}
+	return &Breakout{NumChannels: b.GetNumChannels(), ChannelSpeedGbps: b.GetChannelSpeedGbps()}
+}
+
 var (
 	gnmisMu sync.Mutex
 	gnmis   = make(map[binding.Device]gpb.GNMIClient)
+
+	// gnmiKeepalive is the interval at which idle gNMI connections are pinged,
+	// or zero if they are not.
+	gnmiKeepalive time.Duration
 )
 
-// newGNMI creates a new gNMI client for the specified Device.
-func newGNMI(ctx context.Context, dev binding.Device) (gpb.GNMIClient, error) {
+// newGNMI creates a new gNMI client for the specified Device, dialed with the
+// specified options in addition to the default ones.
+func newGNMI(ctx context.Context, dev binding.Device, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
 	dialGNMI := func(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
 		return testbed.Bind().DialGNMI(ctx, dev.(*binding.DUT), opts...)
 	}
@@ -195,9 +292,18 @@ func newGNMI(ctx context.Context, dev binding.Device) (gpb.GNMIClient, error) {
 			return ate.DialGNMI(ctx, rATE, opts...)
 		}
 	}
-	return dialGNMI(ctx,
+	defOpts := append([]grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)))
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
+	}, gnmilog.DialOptions(dev.Dimensions().Name)...)
+	if gnmiKeepalive > 0 {
+		defOpts = append(defOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                gnmiKeepalive,
+			Timeout:             gnmiKeepalive,
+			PermitWithoutStream: true,
+		}))
+	}
+	return dialGNMI(ctx, append(defOpts, opts...)...)
 }
 
 // fetchGNMI fetches the gNMI client for the given device.
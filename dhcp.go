@@ -0,0 +1,110 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// DHCP is a representation of the DHCP clients and servers on an ATE interface.
+type DHCP struct {
+	pb *opb.DhcpConfig
+}
+
+// V4Client creates a DHCPv4 client config or returns the existing config.
+// The default count of clients is 1.
+func (d *DHCP) V4Client() *DHCPClient {
+	if d.pb.V4Client == nil {
+		d.pb.V4Client = &opb.DhcpConfig_Client{Count: 1}
+	}
+	return &DHCPClient{pb: d.pb.V4Client}
+}
+
+// V6Client creates a DHCPv6 client config or returns the existing config.
+// The default count of clients is 1.
+func (d *DHCP) V6Client() *DHCPClient {
+	if d.pb.V6Client == nil {
+		d.pb.V6Client = &opb.DhcpConfig_Client{Count: 1}
+	}
+	return &DHCPClient{pb: d.pb.V6Client}
+}
+
+// V4Server creates a DHCPv4 server config or returns the existing config.
+// The server requires a static IPv4 address on the interface.
+func (d *DHCP) V4Server() *DHCPServer {
+	if d.pb.V4Server == nil {
+		d.pb.V4Server = &opb.DhcpConfig_Server{}
+	}
+	return &DHCPServer{pb: d.pb.V4Server}
+}
+
+// V6Server creates a DHCPv6 server config or returns the existing config.
+// The server requires a static IPv6 address on the interface.
+func (d *DHCP) V6Server() *DHCPServer {
+	if d.pb.V6Server == nil {
+		d.pb.V6Server = &opb.DhcpConfig_Server{}
+	}
+	return &DHCPServer{pb: d.pb.V6Server}
+}
+
+// DHCPClient is a representation of emulated DHCP clients on an interface.
+type DHCPClient struct {
+	pb *opb.DhcpConfig_Client
+}
+
+// WithCount sets the number of emulated clients, each with its own MAC address.
+// A count greater than 1 cannot be combined with a static IP address.
+func (c *DHCPClient) WithCount(count uint32) *DHCPClient {
+	c.pb.Count = count
+	return c
+}
+
+// WithRapidCommit sets whether the clients request rapid commit.
+func (c *DHCPClient) WithRapidCommit(rapidCommit bool) *DHCPClient {
+	c.pb.RapidCommit = rapidCommit
+	return c
+}
+
+// DHCPServer is a representation of an emulated DHCP server on an interface.
+// The lease counts of the servers are returned by Topology.DHCPv4ServerLeases
+// and Topology.DHCPv6ServerLeases.
+type DHCPServer struct {
+	pb *opb.DhcpConfig_Server
+}
+
+// WithPoolAddress sets the first address of the pool leased to clients.
+func (s *DHCPServer) WithPoolAddress(address string) *DHCPServer {
+	s.pb.PoolAddress = address
+	return s
+}
+
+// WithPoolSize sets the number of addresses in the pool.
+func (s *DHCPServer) WithPoolSize(size uint32) *DHCPServer {
+	s.pb.PoolSize = size
+	return s
+}
+
+// WithPrefixLength sets the prefix length of the leased addresses.
+// By default, the prefix length of the interface address is used.
+func (s *DHCPServer) WithPrefixLength(length uint32) *DHCPServer {
+	s.pb.PrefixLength = length
+	return s
+}
+
+// WithLeaseTimeSecs sets the lease time in seconds.
+func (s *DHCPServer) WithLeaseTimeSecs(secs uint32) *DHCPServer {
+	s.pb.LeaseTimeSecs = secs
+	return s
+}
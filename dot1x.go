@@ -0,0 +1,64 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// Dot1X is a representation of an 802.1X supplicant on the ATE, which
+// authenticates the port of the interface to the authenticator on the DUT.
+type Dot1X struct {
+	pb *opb.Dot1XConfig
+}
+
+// WithEAPMD5 sets the supplicant to authenticate with EAP-MD5.
+func (d *Dot1X) WithEAPMD5() *Dot1X {
+	d.pb.EapMethod = opb.Dot1XConfig_MD5
+	return d
+}
+
+// WithPEAPv0 sets the supplicant to authenticate with PEAPv0 (EAP-MSCHAPv2).
+func (d *Dot1X) WithPEAPv0() *Dot1X {
+	d.pb.EapMethod = opb.Dot1XConfig_PEAP_V0
+	return d
+}
+
+// WithPEAPv1 sets the supplicant to authenticate with PEAPv1 (EAP-GTC).
+func (d *Dot1X) WithPEAPv1() *Dot1X {
+	d.pb.EapMethod = opb.Dot1XConfig_PEAP_V1
+	return d
+}
+
+// WithEAPTTLS sets the supplicant to authenticate with EAP-TTLS.
+func (d *Dot1X) WithEAPTTLS() *Dot1X {
+	d.pb.EapMethod = opb.Dot1XConfig_TTLS
+	return d
+}
+
+// WithCredentials sets the username and password of the supplicant.
+func (d *Dot1X) WithCredentials(username, password string) *Dot1X {
+	d.pb.Username = username
+	d.pb.Password = password
+	return d
+}
+
+// WithWaitForIdentityRequest sets whether the supplicant waits for the
+// authenticator to request its identity, instead of starting the
+// authentication with an EAPOL-Start message.
+func (d *Dot1X) WithWaitForIdentityRequest(wait bool) *Dot1X {
+	d.pb.WaitForIdentityRequest = wait
+	return d
+}
@@ -17,17 +17,28 @@ package ondatra
 import (
 	"golang.org/x/net/context"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/config/device"
 	"github.com/openconfig/ondatra/internal/cli"
 	"github.com/openconfig/ondatra/internal/console"
 	"github.com/openconfig/ondatra/internal/dut"
+	"github.com/openconfig/ondatra/internal/events"
 	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
+	"github.com/openconfig/ondatra/internal/gnmisub"
 	"github.com/openconfig/ondatra/internal/gribi"
 	"github.com/openconfig/ondatra/internal/operations"
 	"github.com/openconfig/ondatra/internal/p4rt"
+	"github.com/openconfig/ondatra/internal/report"
+	"github.com/openconfig/ondatra/telemetry"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	grpb "github.com/openconfig/gribi/v1/proto/service"
@@ -47,6 +58,7 @@ func (d *DUTDevice) Config() *Config {
 	// TODO: Add field to root node in ygot instead of using custom data.
 	dev.PutCustomData(genutil.DefaultClientKey, d.Device.clientFn)
 	return &Config{
+		dev:        d,
 		dut:        d.res.(*binding.DUT),
 		DevicePath: dev,
 	}
@@ -54,6 +66,7 @@ func (d *DUTDevice) Config() *Config {
 
 // Config is the DUT configuration API.
 type Config struct {
+	dev *DUTDevice
 	dut *binding.DUT
 	*device.DevicePath
 }
@@ -61,6 +74,7 @@ type Config struct {
 // New returns an empty DUT configuration.
 func (a *Config) New() *DUTConfig {
 	return &DUTConfig{
+		dev: a.dev,
 		dut: a.dut,
 		cfg: &dut.Config{
 			PerVendor: make(map[opb.Device_Vendor]dut.ConfigProvider),
@@ -70,7 +84,13 @@ func (a *Config) New() *DUTConfig {
 }
 
 // DUTConfig is a configuration of a device under test.
+//
+// The config text is a Go template, in which {{ port "portID" }} is replaced
+// with the name of the port of the DUT and {{ var "key" }} with the value of
+// the variable. The reserved dimensions of the DUT are also available, such as
+// {{ .Name }}, {{ .Model }}, {{ .Ports.port1 }}, and {{ .Tags.rack }}.
 type DUTConfig struct {
+	dev *DUTDevice
 	dut *binding.DUT
 	cfg *dut.Config
 }
@@ -144,13 +164,28 @@ func (c *DUTConfig) WithVarMap(m map[string]string) *DUTConfig {
 	return c
 }
 
+// Render returns the config text that Push or Append would push to the device,
+// with its templated variables substituted, without pushing it. It fails the
+// test if the config is not specified for the device or its template is
+// invalid, so it can be used to validate the config as a dry run.
+func (c *DUTConfig) Render(t testing.TB) string {
+	t.Helper()
+	text, err := dut.RenderConfig(c.dut, c.cfg)
+	if err != nil {
+		t.Fatalf("Render(t) on %s: %v", c.dut, err)
+	}
+	return text
+}
+
 // Push replaces the config on the device with the specified config, prepended with the device's base config.
 // It pushes vendor config to the device if it has been set; otherwise, it attempts to push openconfig.
 // If neither vendor config nor openconfig has been specified, it fails the test.
 func (c *DUTConfig) Push(t testing.TB) {
 	t.Helper()
 	logAction(t, "Pushing config to %s", c.dut)
-	if err := dut.PushConfig(context.Background(), c.dut, c.cfg, false); err != nil {
+	err := dut.PushConfig(context.Background(), c.dut, c.cfg, false)
+	events.ConfigPushed(t, c.dev, err)
+	if err != nil {
 		t.Fatalf("Push(t) on %s: %v", c.dut, err)
 	}
 }
@@ -161,11 +196,224 @@ func (c *DUTConfig) Push(t testing.TB) {
 func (c *DUTConfig) Append(t testing.TB) {
 	t.Helper()
 	logAction(t, "Appending config to %s", c.dut)
-	if err := dut.PushConfig(context.Background(), c.dut, c.cfg, true); err != nil {
+	err := dut.PushConfig(context.Background(), c.dut, c.cfg, true)
+	events.ConfigPushed(t, c.dev, err)
+	if err != nil {
 		t.Fatalf("Append(t) on %s: %v", c.dut, err)
 	}
 }
 
+// PushConfigs concurrently replaces the config on every device with its
+// specified config, like DUTConfig.Push, and waits until all pushes complete.
+// If any pushes fail, it fails the test with the errors of all of them.
+func PushConfigs(t testing.TB, cfgs ...*DUTConfig) {
+	t.Helper()
+	if err := pushConfigs(t, "Pushing", cfgs, false); err != nil {
+		t.Fatalf("PushConfigs(t): %v", err)
+	}
+}
+
+// AppendConfigs concurrently appends every specified config to the current
+// config of its device, like DUTConfig.Append, and waits until all appends
+// complete. If any appends fail, it fails the test with the errors of all of
+// them.
+func AppendConfigs(t testing.TB, cfgs ...*DUTConfig) {
+	t.Helper()
+	if err := pushConfigs(t, "Appending", cfgs, true); err != nil {
+		t.Fatalf("AppendConfigs(t): %v", err)
+	}
+}
+
+func pushConfigs(t testing.TB, verb string, cfgs []*DUTConfig, isAppend bool) error {
+	t.Helper()
+	var names []string
+	for _, c := range cfgs {
+		names = append(names, c.dut.Name)
+	}
+	t.Log(actionMsg(fmt.Sprintf("%s config to %s in parallel", verb, strings.Join(names, ", "))))
+	errs := make([]error, len(cfgs))
+	var wg sync.WaitGroup
+	for i, c := range cfgs {
+		wg.Add(1)
+		go func(i int, c *DUTConfig) {
+			defer wg.Done()
+			start := time.Now()
+			errs[i] = dut.PushConfig(context.Background(), c.dut, c.cfg, isAppend)
+			report.Record(t, c.dut.Name, fmt.Sprintf("%s config to %s", verb, c.dut.Name), start, errs[i])
+		}(i, c)
+	}
+	wg.Wait()
+	var msgs []string
+	for i, c := range cfgs {
+		events.ConfigPushed(t, c.dev, errs[i])
+		if errs[i] != nil {
+			msgs = append(msgs, fmt.Sprintf("on %s: %v", c.dut, errs[i]))
+		}
+	}
+	if len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, "\n"))
+	}
+	return nil
+}
+
+// NewMixed returns an empty DUT configuration that combines OpenConfig with
+// vendor-native config blocks, for features that the OpenConfig models do not
+// cover. The OpenConfig and the blocks for the vendor of the DUT are pushed in
+// a single gNMI SetRequest.
+func (a *Config) NewMixed() *MixedConfig {
+	return &MixedConfig{
+		dev: a.dev,
+		cfg: &dut.MixedConfig{
+			Native: make(map[opb.Device_Vendor][]*dut.NativeBlock),
+			Vars:   make(map[string]string),
+		},
+	}
+}
+
+// MixedConfig is a configuration of a device under test that combines
+// OpenConfig with vendor-native config blocks.
+type MixedConfig struct {
+	dev *DUTDevice
+	cfg *dut.MixedConfig
+}
+
+func (c *MixedConfig) String() string {
+	return fmt.Sprintf("MixedConfig%+v", *c)
+}
+
+// WithOpenConfig merges the specified OpenConfig into the config.
+func (c *MixedConfig) WithOpenConfig(t testing.TB, oc *telemetry.Device) *MixedConfig {
+	t.Helper()
+	if c.cfg.OpenConfig == nil {
+		c.cfg.OpenConfig = new(telemetry.Device)
+	}
+	if err := ygot.MergeStructInto(c.cfg.OpenConfig, oc); err != nil {
+		t.Fatalf("WithOpenConfig(t) on %s: %v", c.dev.res, err)
+	}
+	return c
+}
+
+// WithVendorCLI adds a block of CLI config to be pushed if the DUT is of the
+// specified vendor. Blocks are pushed in the order they are added.
+func (c *MixedConfig) WithVendorCLI(vendor Vendor, text string) *MixedConfig {
+	return c.withBlock(vendor, &dut.NativeBlock{Origin: dut.CLIOrigin, Text: text})
+}
+
+// WithVendorJSON adds a block of vendor-native JSON config with the specified
+// gNMI origin to be pushed if the DUT is of the specified vendor. Blocks are
+// pushed in the order they are added.
+func (c *MixedConfig) WithVendorJSON(vendor Vendor, origin, json string) *MixedConfig {
+	return c.withBlock(vendor, &dut.NativeBlock{Origin: origin, Text: json})
+}
+
+func (c *MixedConfig) withBlock(vendor Vendor, block *dut.NativeBlock) *MixedConfig {
+	v := opb.Device_Vendor(vendor)
+	c.cfg.Native[v] = append(c.cfg.Native[v], block)
+	return c
+}
+
+// WithVarValue replaces each occurrence of {{ var "key" }} in the vendor-native
+// config blocks with the specified value. The blocks are templates like the
+// text of a DUTConfig.
+func (c *MixedConfig) WithVarValue(key, value string) *MixedConfig {
+	c.cfg.Vars[key] = value
+	return c
+}
+
+// WithVarMap sets the map used to replace each occurrence of {{ var "key" }} in
+// the vendor-native config blocks.
+func (c *MixedConfig) WithVarMap(m map[string]string) *MixedConfig {
+	c.cfg.Vars = m
+	return c
+}
+
+// Render returns the SetRequest that Push would send to the device, without
+// sending it. It fails the test if the OpenConfig is invalid, if a template of
+// the vendor-native config blocks is invalid, or if neither is specified for
+// the device, so it can be used to validate the config as a dry run.
+func (c *MixedConfig) Render(t testing.TB) *gpb.SetRequest {
+	t.Helper()
+	req, err := dut.RenderMixedConfig(c.dev.res.(*binding.DUT), c.cfg, false)
+	if err != nil {
+		t.Fatalf("Render(t) on %s: %v", c.dev.res, err)
+	}
+	return req
+}
+
+// Push replaces the OpenConfig of the device with the specified OpenConfig and
+// merges the vendor-native config blocks for the vendor of the device into its
+// config. It fails the test if neither is specified for the device.
+func (c *MixedConfig) Push(t testing.TB) {
+	t.Helper()
+	logAction(t, "Pushing mixed config to %s", c.dev.res)
+	err := c.push(false)
+	events.ConfigPushed(t, c.dev, err)
+	if err != nil {
+		t.Fatalf("Push(t) on %s: %v", c.dev.res, err)
+	}
+}
+
+// Append merges the specified OpenConfig and the vendor-native config blocks
+// for the vendor of the device into its config. It fails the test if neither
+// is specified for the device.
+func (c *MixedConfig) Append(t testing.TB) {
+	t.Helper()
+	logAction(t, "Appending mixed config to %s", c.dev.res)
+	err := c.push(true)
+	events.ConfigPushed(t, c.dev, err)
+	if err != nil {
+		t.Fatalf("Append(t) on %s: %v", c.dev.res, err)
+	}
+}
+
+func (c *MixedConfig) push(append bool) error {
+	ctx := context.Background()
+	client, err := c.dev.clientFn(ctx)
+	if err != nil {
+		return err
+	}
+	return dut.PushMixedConfig(ctx, c.dev.res.(*binding.DUT), client, c.cfg, append)
+}
+
+// Checkpoint saves the config of the DUT and returns a checkpoint with which
+// to restore it. The config is also restored automatically when the test and
+// all its subtests complete, so that the test does not leak config into
+// subsequent tests. The config is saved with a vendor-native checkpoint if the
+// binding supports it, and otherwise with a gNMI Get of the OpenConfig tree.
+func (a *Config) Checkpoint(t testing.TB) *ConfigCheckpoint {
+	t.Helper()
+	logAction(t, "Checkpointing config of %s", a.dut)
+	cp, err := dut.CheckpointConfig(context.Background(), a.dut, a.dev.clientFn)
+	if err != nil {
+		t.Fatalf("Checkpoint(t) on %s: %v", a.dut, err)
+	}
+	c := &ConfigCheckpoint{dev: a.dev, dut: a.dut, cp: cp}
+	t.Cleanup(func() { c.Restore(t) })
+	return c
+}
+
+// ConfigCheckpoint is a saved config of a DUT.
+type ConfigCheckpoint struct {
+	dev *DUTDevice
+	dut *binding.DUT
+	cp  *dut.Checkpoint
+}
+
+func (c *ConfigCheckpoint) String() string {
+	return fmt.Sprintf("ConfigCheckpoint%+v", *c)
+}
+
+// Restore restores the saved config of the DUT.
+func (c *ConfigCheckpoint) Restore(t testing.TB) {
+	t.Helper()
+	logAction(t, "Restoring config checkpoint of %s", c.dut)
+	err := dut.RestoreConfig(context.Background(), c.dut, c.dev.clientFn, c.cp)
+	events.ConfigPushed(t, c.dev, err)
+	if err != nil {
+		t.Fatalf("Restore(t) on %s: %v", c.dut, err)
+	}
+}
+
 // RawAPIs returns a handle to raw protocol APIs on the DUT.
 func (d *DUTDevice) RawAPIs() *RawAPIs {
 	return &RawAPIs{dut: d.res.(*binding.DUT)}
@@ -193,24 +441,37 @@ func (r *RawAPIs) GRIBI() *GRIBIAPI {
 
 // GNMIAPI provides access for creating a default or new gNMI client on the DUT.
 type GNMIAPI struct {
-	dut *binding.DUT
+	dut  *binding.DUT
+	opts []grpc.DialOption
 }
 
 // GNOIAPI provides access for creating a default or new gNOI client on the DUT.
 type GNOIAPI struct {
-	dut *binding.DUT
+	dut  *binding.DUT
+	opts []grpc.DialOption
 }
 
 // GRIBIAPI provides access for creating a default or new GRIBI client on the DUT.
 type GRIBIAPI struct {
-	dut *binding.DUT
+	dut  *binding.DUT
+	opts []grpc.DialOption
+}
+
+// WithDialOptions returns a copy of the API whose new clients are dialed with
+// the specified options, such as to test a gNMI port with a different TLS or
+// authentication policy. The options take precedence over the default options
+// of the binding, except that per-RPC credentials are sent in addition to
+// those of the binding rather than instead of them. They do not apply to the
+// default client.
+func (g *GNMIAPI) WithDialOptions(opts ...grpc.DialOption) *GNMIAPI {
+	return &GNMIAPI{dut: g.dut, opts: append(append([]grpc.DialOption{}, g.opts...), opts...)}
 }
 
 // New returns a new gNMI client on the DUT. This client will not be cached.
 func (g *GNMIAPI) New(t testing.TB) gpb.GNMIClient {
 	t.Helper()
 	logAction(t, "Creating gNMI client for %s", g.dut)
-	gnmi, err := newGNMI(context.Background(), g.dut)
+	gnmi, err := newGNMI(context.Background(), g.dut, g.opts...)
 	if err != nil {
 		t.Fatalf("GNMI(t) on %v: %v", g.dut, err)
 	}
@@ -228,11 +489,143 @@ func (g *GNMIAPI) Default(t testing.TB) gpb.GNMIClient {
 	return gnmi
 }
 
+// NewSubscription returns a new subscription to the specified string paths,
+// which need not be in the OpenConfig schema, such as paths with a vendor
+// native origin. The subscription uses a new client if the API has dial
+// options and otherwise the default client.
+func (g *GNMIAPI) NewSubscription(paths ...string) *GNMISubscription {
+	return &GNMISubscription{
+		api:           g,
+		paths:         paths,
+		mode:          gpb.SubscriptionList_STREAM,
+		encoding:      gpb.Encoding_PROTO,
+		maxReconnects: 3,
+	}
+}
+
+// GNMISubscription is a subscription to arbitrary gNMI paths, whose
+// notifications are returned without being unmarshalled into a schema.
+type GNMISubscription struct {
+	api           *GNMIAPI
+	origin        string
+	paths         []string
+	mode          gpb.SubscriptionList_Mode
+	subMode       gpb.SubscriptionMode
+	encoding      gpb.Encoding
+	maxReconnects int
+}
+
+// WithOrigin sets the origin of the subscribed paths.
+func (s *GNMISubscription) WithOrigin(origin string) *GNMISubscription {
+	s.origin = origin
+	return s
+}
+
+// WithMode sets the mode of the subscription. The default is STREAM.
+func (s *GNMISubscription) WithMode(mode gpb.SubscriptionList_Mode) *GNMISubscription {
+	s.mode = mode
+	return s
+}
+
+// WithSubscriptionMode sets the mode of each subscribed path. The default is
+// TARGET_DEFINED.
+func (s *GNMISubscription) WithSubscriptionMode(mode gpb.SubscriptionMode) *GNMISubscription {
+	s.subMode = mode
+	return s
+}
+
+// WithEncoding sets the encoding of the subscription. The default is PROTO.
+func (s *GNMISubscription) WithEncoding(encoding gpb.Encoding) *GNMISubscription {
+	s.encoding = encoding
+	return s
+}
+
+// WithMaxReconnects sets the maximum number of times a STREAM subscription
+// resubscribes after its stream fails. The default is 3.
+func (s *GNMISubscription) WithMaxReconnects(n int) *GNMISubscription {
+	s.maxReconnects = n
+	return s
+}
+
+// Start starts the subscription and returns the stream of notifications. The
+// stream must be closed when the caller is done.
+func (s *GNMISubscription) Start(t testing.TB) *GNMIStream {
+	t.Helper()
+	logAction(t, "Subscribing to gNMI paths on %s", s.api.dut)
+	clientFn := func(ctx context.Context) (gpb.GNMIClient, error) {
+		if len(s.api.opts) > 0 {
+			return newGNMI(ctx, s.api.dut, s.api.opts...)
+		}
+		return fetchGNMI(ctx, s.api.dut, nil)
+	}
+	subList, err := gnmisub.Request(s.api.dut.Name, s.origin, s.paths, s.mode, s.subMode, s.encoding)
+	if err != nil {
+		t.Fatalf("Start(t) on %v: %v", s.api.dut, err)
+	}
+	stream, err := gnmisub.Subscribe(context.Background(), clientFn, subList, s.maxReconnects)
+	if err != nil {
+		t.Fatalf("Start(t) on %v: %v", s.api.dut, err)
+	}
+	return &GNMIStream{dut: s.api.dut, s: stream}
+}
+
+// GNMIStream is a stream of notifications received by a gNMI subscription.
+type GNMIStream struct {
+	dut *binding.DUT
+	s   *gnmisub.Stream
+}
+
+// Next waits up to the specified timeout for the next notification. It
+// returns false if the timeout expires or the subscription ends, and fails
+// fatally if the subscription fails.
+func (s *GNMIStream) Next(t testing.TB, timeout time.Duration) (*gpb.Notification, bool) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	n, err := s.s.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next(t) on %v: %v", s.dut, err)
+	}
+	return n, n != nil
+}
+
+// Collect returns the notifications received within the specified duration,
+// or until the subscription ends, and fails fatally if the subscription fails.
+func (s *GNMIStream) Collect(t testing.TB, duration time.Duration) []*gpb.Notification {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+	var notifs []*gpb.Notification
+	for {
+		n, err := s.s.Next(ctx)
+		if err != nil {
+			t.Fatalf("Collect(t) on %v: %v", s.dut, err)
+		}
+		if n == nil {
+			return notifs
+		}
+		notifs = append(notifs, n)
+	}
+}
+
+// Close ends the subscription.
+func (s *GNMIStream) Close() {
+	s.s.Close()
+}
+
+// WithDialOptions returns a copy of the API whose new clients are dialed with
+// the specified options, which take precedence over the default options of the
+// binding, except that per-RPC credentials are sent in addition to those of the
+// binding. They do not apply to the default client.
+func (g *GNOIAPI) WithDialOptions(opts ...grpc.DialOption) *GNOIAPI {
+	return &GNOIAPI{dut: g.dut, opts: append(append([]grpc.DialOption{}, g.opts...), opts...)}
+}
+
 // New returns a new gNOI client on the DUT.
 func (g *GNOIAPI) New(t testing.TB) GNOI {
 	t.Helper()
 	logAction(t, "Creating gNOI client for %s", g.dut)
-	bgnoi, err := operations.NewGNOI(context.Background(), g.dut)
+	bgnoi, err := operations.NewGNOI(context.Background(), g.dut, g.opts...)
 	if err != nil {
 		t.Fatalf("GNOI(t) on %v: %v", g.dut, err)
 	}
@@ -250,11 +643,19 @@ func (g *GNOIAPI) Default(t testing.TB) GNOI {
 	return bgnoi
 }
 
+// WithDialOptions returns a copy of the API whose new clients and sessions are
+// dialed with the specified options, which take precedence over the default
+// options of the binding, except that per-RPC credentials are sent in addition
+// to those of the binding. They do not apply to the default client.
+func (g *GRIBIAPI) WithDialOptions(opts ...grpc.DialOption) *GRIBIAPI {
+	return &GRIBIAPI{dut: g.dut, opts: append(append([]grpc.DialOption{}, g.opts...), opts...)}
+}
+
 // New returns a new gRIBI client on the DUT.
 func (g *GRIBIAPI) New(t testing.TB) grpb.GRIBIClient {
 	t.Helper()
 	logAction(t, "Creating gRIBI client for %s", g.dut)
-	grc, err := gribi.NewGRIBI(context.Background(), g.dut)
+	grc, err := gribi.NewGRIBI(context.Background(), g.dut, g.opts...)
 	if err != nil {
 		t.Fatalf("GRIBI(t) on %v: %v", g.dut, err)
 	}
@@ -272,6 +673,79 @@ func (g *GRIBIAPI) Default(t testing.TB) grpb.GRIBIClient {
 	return grc
 }
 
+// NewSession returns a new gRIBI session on the DUT with the specified session
+// parameters. If the parameters specify a single primary client, the client of
+// the session becomes the primary client with the election ID. The session
+// must be closed when the client is done.
+func (g *GRIBIAPI) NewSession(t testing.TB, params *grpb.SessionParameters, electionID uint64) *GRIBISession {
+	t.Helper()
+	logAction(t, "Creating gRIBI session for %s", g.dut)
+	s, err := gribi.NewSession(context.Background(), g.dut, params, &grpb.Uint128{Low: electionID}, g.opts...)
+	if err != nil {
+		t.Fatalf("Failed to create gRIBI session on %v: %v", g.dut, err)
+	}
+	return &GRIBISession{dut: g.dut, s: s}
+}
+
+// GRIBISession is a gRIBI session that injects AFT entries on the DUT. The
+// injected entries can be verified with the AFT telemetry paths of the DUT,
+// such as dut.Telemetry().NetworkInstance(ni).Afts().
+type GRIBISession struct {
+	dut *binding.DUT
+	s   *gribi.Session
+}
+
+// Client returns the underlying gRIBI client of the session.
+func (s *GRIBISession) Client() grpb.GRIBIClient {
+	return s.s.Client()
+}
+
+// Modify applies the AFT operations and waits until they are acknowledged.
+func (s *GRIBISession) Modify(t testing.TB, ops ...*grpb.AFTOperation) {
+	t.Helper()
+	logAction(t, "Modifying gRIBI AFT entries on %s", s.dut)
+	if err := s.s.Modify(context.Background(), ops...); err != nil {
+		t.Fatalf("Modify(t) on %v: %v", s.dut, err)
+	}
+}
+
+// Get returns the AFT entries in the network instance, or in all network
+// instances if the name is empty.
+func (s *GRIBISession) Get(t testing.TB, networkInstance string) []*grpb.AFTEntry {
+	t.Helper()
+	logAction(t, "Getting gRIBI AFT entries on %s", s.dut)
+	entries, err := s.s.Get(context.Background(), networkInstance)
+	if err != nil {
+		t.Fatalf("Get(t) on %v: %v", s.dut, err)
+	}
+	return entries
+}
+
+// Flush deletes all AFT entries in the network instance, or in all network
+// instances if the name is empty.
+func (s *GRIBISession) Flush(t testing.TB, networkInstance string) {
+	t.Helper()
+	logAction(t, "Flushing gRIBI AFT entries on %s", s.dut)
+	if err := s.s.Flush(context.Background(), networkInstance); err != nil {
+		t.Fatalf("Flush(t) on %v: %v", s.dut, err)
+	}
+}
+
+// Replay reopens the session and re-adds all the AFT entries installed by the
+// session, such as after the DUT has been rebooted.
+func (s *GRIBISession) Replay(t testing.TB) {
+	t.Helper()
+	logAction(t, "Replaying gRIBI AFT entries on %s", s.dut)
+	if err := s.s.Replay(context.Background()); err != nil {
+		t.Fatalf("Replay(t) on %v: %v", s.dut, err)
+	}
+}
+
+// Close closes the session.
+func (s *GRIBISession) Close() {
+	s.s.Close()
+}
+
 // GNOI stores gNOI clients to a DUT.
 type GNOI interface {
 	// Embed an unexported interface that wraps binding.GNOIClients,
@@ -283,17 +757,97 @@ type privateGNOI interface {
 	binding.GNOIClients
 }
 
-// P4RT returns a P4RT client on the DUT.
-func (r *RawAPIs) P4RT(t testing.TB) p4pb.P4RuntimeClient {
+// P4RT provides access to either a new P4RT client or a new P4RT session.
+func (r *RawAPIs) P4RT() *P4RTAPI {
+	return &P4RTAPI{dut: r.dut}
+}
+
+// P4RTAPI provides access for creating a P4RT client or session on the DUT.
+type P4RTAPI struct {
+	dut  *binding.DUT
+	opts []grpc.DialOption
+}
+
+// WithDialOptions returns a copy of the API whose new clients and sessions are
+// dialed with the specified options, which take precedence over the default
+// options of the binding, except that per-RPC credentials are sent in addition
+// to those of the binding.
+func (p *P4RTAPI) WithDialOptions(opts ...grpc.DialOption) *P4RTAPI {
+	return &P4RTAPI{dut: p.dut, opts: append(append([]grpc.DialOption{}, p.opts...), opts...)}
+}
+
+// New returns a new P4RT client on the DUT.
+func (p *P4RTAPI) New(t testing.TB) p4pb.P4RuntimeClient {
 	t.Helper()
-	logAction(t, "Creating P4RT client for %s", r.dut)
-	p4rtClient, err := p4rt.NewP4RT(context.Background(), r.dut)
+	logAction(t, "Creating P4RT client for %s", p.dut)
+	p4rtClient, err := p4rt.NewP4RT(context.Background(), p.dut, p.opts...)
 	if err != nil {
-		t.Fatalf("Failed to create P4RT client on %v: %v", r.dut, err)
+		t.Fatalf("Failed to create P4RT client on %v: %v", p.dut, err)
 	}
 	return p4rtClient
 }
 
+// NewSession returns a new P4RT session on the DUT, whose client arbitrates for
+// the P4RT device with the specified ID and becomes its primary client with
+// the election ID. The session must be closed when the client is done.
+func (p *P4RTAPI) NewSession(t testing.TB, deviceID, electionID uint64) *P4RTSession {
+	t.Helper()
+	logAction(t, "Creating P4RT session for %s", p.dut)
+	s, err := p4rt.NewSession(context.Background(), p.dut, deviceID, &p4pb.Uint128{Low: electionID}, p.opts...)
+	if err != nil {
+		t.Fatalf("Failed to create P4RT session on %v: %v", p.dut, err)
+	}
+	return &P4RTSession{dut: p.dut, s: s}
+}
+
+// P4RTSession is a P4RT session of the primary client of a P4RT device.
+type P4RTSession struct {
+	dut *binding.DUT
+	s   *p4rt.Session
+}
+
+// Client returns the underlying P4RT client of the session.
+func (s *P4RTSession) Client() p4pb.P4RuntimeClient {
+	return s.s.Client()
+}
+
+// SetForwardingPipelineConfig verifies and commits a forwarding pipeline
+// config on the P4RT device.
+func (s *P4RTSession) SetForwardingPipelineConfig(t testing.TB, cfg *p4pb.ForwardingPipelineConfig) {
+	t.Helper()
+	logAction(t, "Setting P4RT forwarding pipeline config on %s", s.dut)
+	if err := s.s.SetForwardingPipelineConfig(context.Background(), cfg); err != nil {
+		t.Fatalf("SetForwardingPipelineConfig(t) on %v: %v", s.dut, err)
+	}
+}
+
+// Write applies the updates to the entities of the P4RT device.
+func (s *P4RTSession) Write(t testing.TB, updates ...*p4pb.Update) {
+	t.Helper()
+	logAction(t, "Writing P4RT entities on %s", s.dut)
+	if err := s.s.Write(context.Background(), updates...); err != nil {
+		t.Fatalf("Write(t) on %v: %v", s.dut, err)
+	}
+}
+
+// Read returns the entities of the P4RT device that match the specified
+// entities, such as a table entry with only a table ID to read all entries of
+// the table.
+func (s *P4RTSession) Read(t testing.TB, entities ...*p4pb.Entity) []*p4pb.Entity {
+	t.Helper()
+	logAction(t, "Reading P4RT entities on %s", s.dut)
+	got, err := s.s.Read(context.Background(), entities...)
+	if err != nil {
+		t.Fatalf("Read(t) on %v: %v", s.dut, err)
+	}
+	return got
+}
+
+// Close closes the session, so that its client is no longer the primary client.
+func (s *P4RTSession) Close() {
+	s.s.Close()
+}
+
 // StreamClient provides the interface for streaming IO to DUT.
 type StreamClient interface {
 	// Embed an unexported interface that wraps binding.GNOIClients,
@@ -316,6 +870,20 @@ func (r *RawAPIs) CLI(t testing.TB) StreamClient {
 	return c
 }
 
+// ConsoleLogs returns a stream of the console output of the DUT, such as crash
+// traces and kernel logs. The stream can be read concurrently with other
+// operations on the DUT, including those of the Console client, and continues
+// until it is closed.
+func (r *RawAPIs) ConsoleLogs(t testing.TB) io.ReadCloser {
+	t.Helper()
+	logAction(t, "Streaming console logs for %s", r.dut)
+	logs, err := console.StreamLogs(context.Background(), r.dut)
+	if err != nil {
+		t.Fatalf("ConsoleLogs(t) on %v: %v", r.dut, err)
+	}
+	return logs
+}
+
 // Console returns a transactional CLI client on the DUT.
 func (r *RawAPIs) Console(t testing.TB) StreamClient {
 	t.Helper()
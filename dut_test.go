@@ -17,13 +17,19 @@ package ondatra
 import (
 	"bufio"
 	"golang.org/x/net/context"
+	"encoding/json"
+	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
 	"github.com/openconfig/gnmi/errdiff"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	bpb "github.com/openconfig/gnoi/bgp"
@@ -39,10 +45,16 @@ import (
 	otpb "github.com/openconfig/gnoi/otdr"
 	spb "github.com/openconfig/gnoi/system"
 	wpb "github.com/openconfig/gnoi/wavelength_router"
+	aftpb "github.com/openconfig/gribi/v1/proto/gribi_aft"
 	grpb "github.com/openconfig/gribi/v1/proto/service"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/events"
 	"github.com/openconfig/ondatra/fakes/fakestreamclient"
 	"github.com/openconfig/ondatra/negtest"
+	opb "github.com/openconfig/ondatra/proto"
+	"github.com/openconfig/ondatra/telemetry"
+	"github.com/openconfig/ygot/ygot"
 
 )
 
@@ -186,6 +198,127 @@ func TestPushConfigErrors(t *testing.T) {
 	}
 }
 
+func TestRenderConfig(t *testing.T) {
+	initDUTFakes(t)
+	dutArista := DUT(t, "dut")
+	got := dutArista.Config().New().
+		WithAristaText(`hostname {{ .Name }}-{{ .Tags.rack }}
+interface {{ .Ports.port2 }}
+description {{ .Vendor }} {{ .Model }} {{ var "desc" }}`).
+		WithVarValue("desc", "uplink").
+		Render(t)
+	want := `hostname pf01.xxx01-r1
+interface Et4/5/6
+description ARISTA aristaModel uplink`
+	if got != want {
+		t.Errorf("Render(t) got %q, want %q", got, want)
+	}
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		dutArista.Config().New().WithAristaText(`{{ .Tags.row }}`).Render(t)
+	})
+	if wantErr := "row"; !strings.Contains(gotErr, wantErr) {
+		t.Errorf("Render(t) of missing tag got error %q, want %q", gotErr, wantErr)
+	}
+}
+
+func TestRenderMixedConfig(t *testing.T) {
+	initDUTFakes(t)
+	d := DUT(t, "dut")
+	d.clientFn = func(context.Context) (gpb.GNMIClient, error) {
+		t.Fatalf("Render(t) dialed gNMI, want dry run")
+		return nil, nil
+	}
+	got := d.Config().NewMixed().WithVendorCLI(ARISTA, "hostname {{ .Name }}").Render(t)
+	want := &gpb.SetRequest{Update: []*gpb.Update{{
+		Path: &gpb.Path{Origin: "cli"},
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_AsciiVal{AsciiVal: "hostname pf01.xxx01"}},
+	}}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Render(t) got unexpected SetRequest diff (-want,+got):\n%s", diff)
+	}
+	bad := &telemetry.Device{}
+	bad.GetOrCreateInterface("eth0").Name = ygot.String("eth1")
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		d.Config().NewMixed().WithOpenConfig(t, bad).Render(t)
+	})
+	if wantErr := "invalid OpenConfig"; !strings.Contains(gotErr, wantErr) {
+		t.Errorf("Render(t) of invalid OpenConfig got error %q, want %q", gotErr, wantErr)
+	}
+}
+
+func TestPushConfigs(t *testing.T) {
+	initDUTFakes(t)
+	var mu sync.Mutex
+	got := make(map[string]string)
+	started := make(chan struct{})
+	barrier := make(chan struct{})
+	fakeBind.ConfigPusher = func(_ context.Context, dut *binding.DUT, config string, opts *binding.ConfigOptions) error {
+		started <- struct{}{}
+		<-barrier
+		mu.Lock()
+		defer mu.Unlock()
+		got[dut.Name] = config
+		if dut.Vendor == opb.Device_JUNIPER {
+			return errors.New("bad juniper")
+		}
+		return nil
+	}
+	cfgs := []*DUTConfig{
+		DUT(t, "dut").Config().New().WithAristaText("arista config"),
+		DUT(t, "dut_cisco").Config().New().WithCiscoText("cisco config"),
+	}
+	go func() {
+		// Every push must start before any push completes.
+		for range cfgs {
+			<-started
+		}
+		close(barrier)
+	}()
+	PushConfigs(t, cfgs...)
+	want := map[string]string{"pf01.xxx01": "arista config", "pf02.xxx01": "cisco config"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PushConfigs(t) got unexpected configs diff (-want,+got):\n%s", diff)
+	}
+
+	started = make(chan struct{}, 3)
+	barrier = make(chan struct{})
+	close(barrier)
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		AppendConfigs(t,
+			DUT(t, "dut").Config().New().WithAristaText("arista config"),
+			DUT(t, "dut_cisco").Config().New().WithAristaText("missing cisco config"),
+			DUT(t, "dut_juniper").Config().New().WithJuniperText("juniper config"))
+	})
+	for _, want := range []string{"no config specified", "bad juniper"} {
+		if !strings.Contains(gotErr, want) {
+			t.Errorf("AppendConfigs(t) got err %v, want %v", gotErr, want)
+		}
+	}
+	if strings.Contains(gotErr, "pf01.xxx01") {
+		t.Errorf("AppendConfigs(t) got err %v, want no error for successful push", gotErr)
+	}
+}
+
+func TestPushConfigEvent(t *testing.T) {
+	initDUTFakes(t)
+	var gotDUT string
+	var gotErr error
+	events.OnConfigPush(func(_ testing.TB, dut events.Device, err error) {
+		gotDUT, gotErr = dut.ID(), err
+	})
+	dutArista := DUT(t, "dut")
+	dutArista.Config().New().WithAristaText("arista config").Push(t)
+	if gotDUT != "dut" || gotErr != nil {
+		t.Errorf("Push(t) got config push event (%q, %v), want (%q, nil)", gotDUT, gotErr, "dut")
+	}
+	negtest.ExpectFatal(t, func(t testing.TB) {
+		dutArista.Config().New().WithCiscoText("cisco config").Append(t)
+	})
+	if gotErr == nil {
+		t.Errorf("Append(t) got config push event with nil error, want error")
+	}
+}
+
 func TestAppendConfig(t *testing.T) {
 	initDUTFakes(t)
 	gotConfig = ""
@@ -201,6 +334,259 @@ func TestAppendConfig(t *testing.T) {
 	}
 }
 
+type fakeSetClient struct {
+	gpb.GNMIClient
+	gotReq  *gpb.SetRequest
+	err     error
+	getResp *gpb.GetResponse
+}
+
+func (c *fakeSetClient) Get(context.Context, *gpb.GetRequest, ...grpc.CallOption) (*gpb.GetResponse, error) {
+	return c.getResp, c.err
+}
+
+func (c *fakeSetClient) Set(_ context.Context, req *gpb.SetRequest, _ ...grpc.CallOption) (*gpb.SetResponse, error) {
+	c.gotReq = req
+	return &gpb.SetResponse{}, c.err
+}
+
+func TestMixedConfig(t *testing.T) {
+	initDUTFakes(t)
+	oc := &telemetry.Device{}
+	oc.GetOrCreateSystem().Hostname = ygot.String("dut1")
+	ocJSON := `{"openconfig-system:system":{"config":{"hostname":"dut1"}}}`
+	cliVal := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_AsciiVal{AsciiVal: s}}
+	}
+	jsonVal := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(s)}}
+	}
+
+	tests := []struct {
+		desc   string
+		dut    string
+		config func(*DUTDevice) *MixedConfig
+		append bool
+		want   *gpb.SetRequest
+	}{{
+		desc: "openconfig and cli",
+		dut:  "dut",
+		config: func(d *DUTDevice) *MixedConfig {
+			return d.Config().NewMixed().
+				WithOpenConfig(t, oc).
+				WithVendorCLI(ARISTA, `interface {{ port "port1" }}`).
+				WithVendorCLI(ARISTA, `hostname {{ var "name" }}`).
+				WithVendorCLI(CISCO, "cisco config").
+				WithVarValue("name", "dut1")
+		},
+		want: &gpb.SetRequest{
+			Replace: []*gpb.Update{{Path: &gpb.Path{Origin: "openconfig"}, Val: jsonVal(ocJSON)}},
+			Update: []*gpb.Update{
+				{Path: &gpb.Path{Origin: "cli"}, Val: cliVal("interface Et1/2/3")},
+				{Path: &gpb.Path{Origin: "cli"}, Val: cliVal("hostname dut1")},
+			},
+		},
+	}, {
+		desc: "append openconfig and json",
+		dut:  "dut_cisco",
+		config: func(d *DUTDevice) *MixedConfig {
+			return d.Config().NewMixed().
+				WithOpenConfig(t, oc).
+				WithVendorJSON(CISCO, "Cisco-IOS-XR", `{"a":"b"}`)
+		},
+		append: true,
+		want: &gpb.SetRequest{
+			Update: []*gpb.Update{
+				{Path: &gpb.Path{Origin: "openconfig"}, Val: jsonVal(ocJSON)},
+				{Path: &gpb.Path{Origin: "Cisco-IOS-XR"}, Val: jsonVal(`{"a":"b"}`)},
+			},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			client := &fakeSetClient{}
+			d := DUT(t, tt.dut)
+			d.clientFn = func(context.Context) (gpb.GNMIClient, error) { return client, nil }
+			if tt.append {
+				tt.config(d).Append(t)
+			} else {
+				tt.config(d).Push(t)
+			}
+			if diff := cmp.Diff(tt.want, client.gotReq, protocmp.Transform()); diff != "" {
+				t.Errorf("Push(t) got unexpected SetRequest diff (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMixedConfigErrors(t *testing.T) {
+	initDUTFakes(t)
+	tests := []struct {
+		desc         string
+		config       func(*DUTDevice) *MixedConfig
+		setErr       error
+		wantFatalMsg string
+	}{{
+		desc: "no config for vendor",
+		config: func(d *DUTDevice) *MixedConfig {
+			return d.Config().NewMixed().WithVendorCLI(CISCO, "cisco config")
+		},
+		wantFatalMsg: "no config",
+	}, {
+		desc: "var has no value",
+		config: func(d *DUTDevice) *MixedConfig {
+			return d.Config().NewMixed().WithVendorCLI(ARISTA, `{{ var "key1" }}`)
+		},
+		wantFatalMsg: "No value for key",
+	}, {
+		desc: "set error",
+		config: func(d *DUTDevice) *MixedConfig {
+			return d.Config().NewMixed().WithVendorCLI(ARISTA, "arista config")
+		},
+		setErr:       errors.New("bad set"),
+		wantFatalMsg: "bad set",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			client := &fakeSetClient{err: tt.setErr}
+			d := DUT(t, "dut")
+			d.clientFn = func(context.Context) (gpb.GNMIClient, error) { return client, nil }
+			got := negtest.ExpectFatal(t, func(t testing.TB) {
+				tt.config(d).Push(t)
+			})
+			if !strings.Contains(got, tt.wantFatalMsg) {
+				t.Errorf("Push(t) failed with message %q, want %q", got, tt.wantFatalMsg)
+			}
+		})
+	}
+}
+
+func TestConfigCheckpoint(t *testing.T) {
+	initDUTFakes(t)
+	val := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"a":"b"}`)}}
+	client := &fakeSetClient{getResp: &gpb.GetResponse{Notification: []*gpb.Notification{{
+		Prefix: &gpb.Path{Origin: "openconfig"},
+		Update: []*gpb.Update{{Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "system"}}}, Val: val}, {
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth0"}}}},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"config":{"mtu":1500}}`)}},
+		}},
+	}}}}
+	d := DUT(t, "dut")
+	d.clientFn = func(context.Context) (gpb.GNMIClient, error) { return client, nil }
+
+	t.Run("gnmi", func(t *testing.T) {
+		d.Config().Checkpoint(t)
+		if client.gotReq != nil {
+			t.Errorf("Checkpoint(t) sent SetRequest %v, want none", client.gotReq)
+		}
+	})
+	want := &gpb.SetRequest{Replace: []*gpb.Update{{
+		Path: &gpb.Path{Origin: "openconfig"},
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"interfaces":{"interface":[{"config":{"mtu":1500},"name":"eth0"}]},"system":{"a":"b"}}`)}},
+	}}}
+	if diff := cmp.Diff(want, client.gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Checkpoint(t) cleanup got unexpected SetRequest diff (-want,+got):\n%s", diff)
+	}
+
+	var gotID string
+	fakeBind.ConfigCheckpointer = func(context.Context, *binding.DUT) (string, error) {
+		return "cp1", nil
+	}
+	fakeBind.ConfigRestorer = func(_ context.Context, _ *binding.DUT, id string) error {
+		gotID = id
+		return nil
+	}
+	t.Run("binding", func(t *testing.T) {
+		cp := d.Config().Checkpoint(t)
+		cp.Restore(t)
+		if gotID != "cp1" {
+			t.Errorf("Restore(t) restored checkpoint %q, want %q", gotID, "cp1")
+		}
+		gotID = ""
+	})
+	if gotID != "cp1" {
+		t.Errorf("Checkpoint(t) cleanup restored checkpoint %q, want %q", gotID, "cp1")
+	}
+}
+
+// fakeConfigClient is a gNMI client of a device whose config is a JSON
+// object, which it returns as one subtree per top-level container.
+type fakeConfigClient struct {
+	gpb.GNMIClient
+	config map[string]interface{}
+}
+
+func (c *fakeConfigClient) Get(context.Context, *gpb.GetRequest, ...grpc.CallOption) (*gpb.GetResponse, error) {
+	n := &gpb.Notification{Prefix: &gpb.Path{Origin: "openconfig"}}
+	for name, v := range c.config {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		n.Update = append(n.Update, &gpb.Update{
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: name}}},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: b}},
+		})
+	}
+	return &gpb.GetResponse{Notification: []*gpb.Notification{n}}, nil
+}
+
+func (c *fakeConfigClient) Set(_ context.Context, req *gpb.SetRequest, _ ...grpc.CallOption) (*gpb.SetResponse, error) {
+	for _, u := range req.GetReplace() {
+		var v map[string]interface{}
+		if err := json.Unmarshal(u.GetVal().GetJsonIetfVal(), &v); err != nil {
+			return nil, err
+		}
+		if elems := u.GetPath().GetElem(); len(elems) > 0 {
+			c.config[elems[0].GetName()] = v
+		} else {
+			c.config = v
+		}
+	}
+	return &gpb.SetResponse{}, nil
+}
+
+func TestConfigCheckpointRestoresRoot(t *testing.T) {
+	initDUTFakes(t)
+	client := &fakeConfigClient{config: map[string]interface{}{
+		"system": map[string]interface{}{"config": map[string]interface{}{"hostname": "dut1"}},
+	}}
+	d := DUT(t, "dut")
+	d.clientFn = func(context.Context) (gpb.GNMIClient, error) { return client, nil }
+
+	cp := d.Config().Checkpoint(t)
+	// Add config outside of the saved subtrees.
+	intfs := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"interface":[{"name":"eth1"}]}`)}}
+	if _, err := client.Set(context.Background(), &gpb.SetRequest{Replace: []*gpb.Update{{
+		Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}}},
+		Val:  intfs,
+	}}}); err != nil {
+		t.Fatalf("Set() got error: %v", err)
+	}
+	cp.Restore(t)
+	want := map[string]interface{}{
+		"system": map[string]interface{}{"config": map[string]interface{}{"hostname": "dut1"}},
+	}
+	if diff := cmp.Diff(want, client.config); diff != "" {
+		t.Errorf("Restore(t) got unexpected config diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestConfigCheckpointErrors(t *testing.T) {
+	initDUTFakes(t)
+	d := DUT(t, "dut")
+	wantErr := "bad get"
+	d.clientFn = func(context.Context) (gpb.GNMIClient, error) {
+		return &fakeSetClient{err: errors.New(wantErr)}, nil
+	}
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		d.Config().Checkpoint(t)
+	})
+	if !strings.Contains(gotErr, wantErr) {
+		t.Errorf("Checkpoint(t) got err %v, want %v", gotErr, wantErr)
+	}
+}
+
 func TestGNMI(t *testing.T) {
 	initDUTFakes(t)
 	want := struct{ gpb.GNMIClient }{}
@@ -442,6 +828,57 @@ func TestGRIBIError(t *testing.T) {
 	}
 }
 
+func TestDialOptions(t *testing.T) {
+	initDUTFakes(t)
+	wantOpt := grpc.WithUserAgent("test")
+	var gotOpts []grpc.DialOption
+	fakeBind.GNMIDialer = func(_ context.Context, _ *binding.DUT, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
+		gotOpts = opts
+		return struct{ gpb.GNMIClient }{}, nil
+	}
+	fakeBind.GNOIDialer = func(_ context.Context, _ *binding.DUT, opts ...grpc.DialOption) (binding.GNOIClients, error) {
+		gotOpts = opts
+		return struct{ binding.GNOIClients }{}, nil
+	}
+	fakeBind.GRIBIDialer = func(_ context.Context, _ *binding.DUT, opts ...grpc.DialOption) (grpb.GRIBIClient, error) {
+		gotOpts = opts
+		return struct{ grpb.GRIBIClient }{}, nil
+	}
+	fakeBind.P4RTDialer = func(_ context.Context, _ *binding.DUT, opts ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+		gotOpts = opts
+		return struct{ p4pb.P4RuntimeClient }{}, nil
+	}
+	raw := DUT(t, "dut").RawAPIs()
+	tests := []struct {
+		desc string
+		dial func(testing.TB)
+	}{{
+		desc: "gNMI",
+		dial: func(t testing.TB) { raw.GNMI().WithDialOptions(wantOpt).New(t) },
+	}, {
+		desc: "gNOI",
+		dial: func(t testing.TB) { raw.GNOI().WithDialOptions(wantOpt).New(t) },
+	}, {
+		desc: "gRIBI",
+		dial: func(t testing.TB) { raw.GRIBI().WithDialOptions(wantOpt).New(t) },
+	}, {
+		desc: "P4RT",
+		dial: func(t testing.TB) { raw.P4RT().WithDialOptions(wantOpt).New(t) },
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gotOpts = nil
+			test.dial(t)
+			if len(gotOpts) < 2 {
+				t.Fatalf("New(t) got dial options %v, want default options followed by %v", gotOpts, wantOpt)
+			}
+			if got := gotOpts[len(gotOpts)-1]; got != wantOpt {
+				t.Errorf("New(t) got last dial option %v, want %v", got, wantOpt)
+			}
+		})
+	}
+}
+
 func TestStreamingClient(t *testing.T) {
 	initDUTFakes(t)
 	fCLI := fakestreamclient.New()
@@ -556,3 +993,471 @@ func TestSendCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestConsoleLogs(t *testing.T) {
+	initDUTFakes(t)
+	want := "kernel: watchdog timeout\n"
+	fakeBind.ConsoleLogStreamer = func(context.Context, *binding.DUT) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(want)), nil
+	}
+	logs := DUT(t, "dut").RawAPIs().ConsoleLogs(t)
+	defer logs.Close()
+	got, err := io.ReadAll(logs)
+	if err != nil {
+		t.Fatalf("ConsoleLogs(t) failed to read logs: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("ConsoleLogs(t) got %q, want %q", got, want)
+	}
+}
+
+func TestConsoleLogsError(t *testing.T) {
+	initDUTFakes(t)
+	wantErr := "bad console"
+	fakeBind.ConsoleLogStreamer = func(context.Context, *binding.DUT) (io.ReadCloser, error) {
+		return nil, errors.New(wantErr)
+	}
+	raw := DUT(t, "dut").RawAPIs()
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		raw.ConsoleLogs(t)
+	})
+	if !strings.Contains(gotErr, wantErr) {
+		t.Errorf("ConsoleLogs(t) got err %v, want %v", gotErr, wantErr)
+	}
+}
+
+type fakeP4RTClient struct {
+	p4pb.P4RuntimeClient
+	arbCode   codes.Code
+	arbReq    *p4pb.MasterArbitrationUpdate
+	streamCtx context.Context
+	writeReq  *p4pb.WriteRequest
+	readReq   *p4pb.ReadRequest
+	readResps []*p4pb.ReadResponse
+	setReq    *p4pb.SetForwardingPipelineConfigRequest
+}
+
+func (c *fakeP4RTClient) StreamChannel(ctx context.Context, _ ...grpc.CallOption) (p4pb.P4Runtime_StreamChannelClient, error) {
+	c.streamCtx = ctx
+	return &fakeStreamChannel{c: c}, nil
+}
+
+func (c *fakeP4RTClient) Write(_ context.Context, req *p4pb.WriteRequest, _ ...grpc.CallOption) (*p4pb.WriteResponse, error) {
+	c.writeReq = req
+	return &p4pb.WriteResponse{}, nil
+}
+
+func (c *fakeP4RTClient) Read(_ context.Context, req *p4pb.ReadRequest, _ ...grpc.CallOption) (p4pb.P4Runtime_ReadClient, error) {
+	c.readReq = req
+	return &fakeReadClient{resps: c.readResps}, nil
+}
+
+func (c *fakeP4RTClient) SetForwardingPipelineConfig(_ context.Context, req *p4pb.SetForwardingPipelineConfigRequest, _ ...grpc.CallOption) (*p4pb.SetForwardingPipelineConfigResponse, error) {
+	c.setReq = req
+	return &p4pb.SetForwardingPipelineConfigResponse{}, nil
+}
+
+type fakeStreamChannel struct {
+	p4pb.P4Runtime_StreamChannelClient
+	c *fakeP4RTClient
+}
+
+func (s *fakeStreamChannel) Send(req *p4pb.StreamMessageRequest) error {
+	s.c.arbReq = req.GetArbitration()
+	return nil
+}
+
+func (s *fakeStreamChannel) Recv() (*p4pb.StreamMessageResponse, error) {
+	arb := &p4pb.MasterArbitrationUpdate{
+		DeviceId:   s.c.arbReq.GetDeviceId(),
+		ElectionId: s.c.arbReq.GetElectionId(),
+		Status:     status.New(s.c.arbCode, "arbitration result").Proto(),
+	}
+	return &p4pb.StreamMessageResponse{Update: &p4pb.StreamMessageResponse_Arbitration{Arbitration: arb}}, nil
+}
+
+type fakeReadClient struct {
+	p4pb.P4Runtime_ReadClient
+	resps []*p4pb.ReadResponse
+}
+
+func (r *fakeReadClient) Recv() (*p4pb.ReadResponse, error) {
+	if len(r.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := r.resps[0]
+	r.resps = r.resps[1:]
+	return resp, nil
+}
+
+func TestP4RTSession(t *testing.T) {
+	initDUTFakes(t)
+	entity := func(tableID uint32) *p4pb.Entity {
+		return &p4pb.Entity{Entity: &p4pb.Entity_TableEntry{TableEntry: &p4pb.TableEntry{TableId: tableID}}}
+	}
+	fc := &fakeP4RTClient{
+		readResps: []*p4pb.ReadResponse{
+			{Entities: []*p4pb.Entity{entity(1)}},
+			{Entities: []*p4pb.Entity{entity(2)}},
+		},
+	}
+	fakeBind.P4RTDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+		return fc, nil
+	}
+	sess := DUT(t, "dut").RawAPIs().P4RT().NewSession(t, 1, 2)
+	wantArb := &p4pb.MasterArbitrationUpdate{DeviceId: 1, ElectionId: &p4pb.Uint128{Low: 2}}
+	if diff := cmp.Diff(wantArb, fc.arbReq, protocmp.Transform()); diff != "" {
+		t.Errorf("NewSession(t) unexpected arbitration request diff (-want,+got): %s", diff)
+	}
+
+	cfg := &p4pb.ForwardingPipelineConfig{P4DeviceConfig: []byte("config")}
+	sess.SetForwardingPipelineConfig(t, cfg)
+	wantSet := &p4pb.SetForwardingPipelineConfigRequest{
+		DeviceId:   1,
+		ElectionId: &p4pb.Uint128{Low: 2},
+		Action:     p4pb.SetForwardingPipelineConfigRequest_VERIFY_AND_COMMIT,
+		Config:     cfg,
+	}
+	if diff := cmp.Diff(wantSet, fc.setReq, protocmp.Transform()); diff != "" {
+		t.Errorf("SetForwardingPipelineConfig(t) unexpected request diff (-want,+got): %s", diff)
+	}
+
+	update := &p4pb.Update{Type: p4pb.Update_INSERT, Entity: entity(1)}
+	sess.Write(t, update)
+	wantWrite := &p4pb.WriteRequest{
+		DeviceId:   1,
+		ElectionId: &p4pb.Uint128{Low: 2},
+		Updates:    []*p4pb.Update{update},
+	}
+	if diff := cmp.Diff(wantWrite, fc.writeReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Write(t) unexpected request diff (-want,+got): %s", diff)
+	}
+
+	got := sess.Read(t, entity(0))
+	if diff := cmp.Diff([]*p4pb.Entity{entity(1), entity(2)}, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Read(t) unexpected entities diff (-want,+got): %s", diff)
+	}
+	if diff := cmp.Diff(&p4pb.ReadRequest{DeviceId: 1, Entities: []*p4pb.Entity{entity(0)}}, fc.readReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Read(t) unexpected request diff (-want,+got): %s", diff)
+	}
+
+	sess.Close()
+	if fc.streamCtx.Err() == nil {
+		t.Errorf("Close() did not close the stream channel")
+	}
+}
+
+func TestP4RTSessionErrors(t *testing.T) {
+	initDUTFakes(t)
+	tests := []struct {
+		desc    string
+		dialErr error
+		arbCode codes.Code
+		wantErr string
+	}{{
+		desc:    "dial error",
+		dialErr: errors.New("bad p4rt"),
+		wantErr: "bad p4rt",
+	}, {
+		desc:    "not primary",
+		arbCode: codes.AlreadyExists,
+		wantErr: "not the primary client",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeBind.P4RTDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+				return &fakeP4RTClient{arbCode: tt.arbCode}, tt.dialErr
+			}
+			raw := DUT(t, "dut").RawAPIs()
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				raw.P4RT().NewSession(t, 1, 2)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("NewSession(t) got err %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+type fakeGRIBIClient struct {
+	grpb.GRIBIClient
+	primaryID  *grpb.Uint128
+	failID     uint64
+	fibFailID  uint64
+	entries    []*grpb.AFTEntry
+	modifyReqs []*grpb.ModifyRequest
+	getReq     *grpb.GetRequest
+	streamCtx  context.Context
+}
+
+func (c *fakeGRIBIClient) Modify(ctx context.Context, _ ...grpc.CallOption) (grpb.GRIBI_ModifyClient, error) {
+	c.streamCtx = ctx
+	return &fakeModifyClient{c: c}, nil
+}
+
+func (c *fakeGRIBIClient) Get(_ context.Context, req *grpb.GetRequest, _ ...grpc.CallOption) (grpb.GRIBI_GetClient, error) {
+	c.getReq = req
+	return &fakeGetClient{resps: []*grpb.GetResponse{{Entry: c.entries}}}, nil
+}
+
+type fakeModifyClient struct {
+	grpb.GRIBI_ModifyClient
+	c     *fakeGRIBIClient
+	resps []*grpb.ModifyResponse
+}
+
+func (m *fakeModifyClient) Send(req *grpb.ModifyRequest) error {
+	m.c.modifyReqs = append(m.c.modifyReqs, req)
+	switch {
+	case req.GetParams() != nil:
+		m.resps = append(m.resps, &grpb.ModifyResponse{
+			SessionParamsResult: &grpb.SessionParametersResult{Status: grpb.SessionParametersResult_OK},
+		})
+	case len(req.GetOperation()) == 0:
+		primaryID := req.GetElectionId()
+		if m.c.primaryID != nil {
+			primaryID = m.c.primaryID
+		}
+		m.resps = append(m.resps, &grpb.ModifyResponse{ElectionId: primaryID})
+	default:
+		rib, fib := &grpb.ModifyResponse{}, &grpb.ModifyResponse{}
+		for _, op := range req.GetOperation() {
+			if op.GetId() == m.c.failID {
+				rib.Result = append(rib.Result, &grpb.AFTResult{Id: op.GetId(), Status: grpb.AFTResult_FAILED})
+				continue
+			}
+			rib.Result = append(rib.Result, &grpb.AFTResult{Id: op.GetId(), Status: grpb.AFTResult_RIB_PROGRAMMED})
+			fibStatus := grpb.AFTResult_FIB_PROGRAMMED
+			if op.GetId() == m.c.fibFailID {
+				fibStatus = grpb.AFTResult_Status(5) // FIB_FAILED
+			}
+			fib.Result = append(fib.Result, &grpb.AFTResult{Id: op.GetId(), Status: fibStatus})
+		}
+		m.resps = append(m.resps, rib, fib)
+	}
+	return nil
+}
+
+func (m *fakeModifyClient) Recv() (*grpb.ModifyResponse, error) {
+	if len(m.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := m.resps[0]
+	m.resps = m.resps[1:]
+	return resp, nil
+}
+
+type fakeGetClient struct {
+	grpb.GRIBI_GetClient
+	resps []*grpb.GetResponse
+}
+
+func (g *fakeGetClient) Recv() (*grpb.GetResponse, error) {
+	if len(g.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := g.resps[0]
+	g.resps = g.resps[1:]
+	return resp, nil
+}
+
+func TestGRIBISession(t *testing.T) {
+	initDUTFakes(t)
+	nh := &aftpb.Afts_NextHopKey{Index: 1, NextHop: &aftpb.Afts_NextHop{}}
+	nhg := &aftpb.Afts_NextHopGroupKey{Id: 1, NextHopGroup: &aftpb.Afts_NextHopGroup{}}
+	route := &aftpb.Afts_Ipv4EntryKey{Prefix: "1.2.3.4/32", Ipv4Entry: &aftpb.Afts_Ipv4Entry{}}
+	electionID := &grpb.Uint128{Low: 2}
+	op := func(id uint64, op grpb.AFTOperation_Operation, entry interface{}) *grpb.AFTOperation {
+		o := &grpb.AFTOperation{Id: id, NetworkInstance: "default", Op: op, ElectionId: electionID}
+		switch e := entry.(type) {
+		case *aftpb.Afts_NextHopKey:
+			o.Entry = &grpb.AFTOperation_NextHop{NextHop: e}
+		case *aftpb.Afts_NextHopGroupKey:
+			o.Entry = &grpb.AFTOperation_NextHopGroup{NextHopGroup: e}
+		case *aftpb.Afts_Ipv4EntryKey:
+			o.Entry = &grpb.AFTOperation_Ipv4{Ipv4: e}
+		}
+		return o
+	}
+	fc := &fakeGRIBIClient{
+		entries: []*grpb.AFTEntry{
+			{NetworkInstance: "default", Entry: &grpb.AFTEntry_NextHop{NextHop: nh}},
+			{NetworkInstance: "default", Entry: &grpb.AFTEntry_Ipv4{Ipv4: route}},
+			{NetworkInstance: "default", Entry: &grpb.AFTEntry_NextHopGroup{NextHopGroup: nhg}},
+		},
+	}
+	fakeBind.GRIBIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (grpb.GRIBIClient, error) {
+		return fc, nil
+	}
+	params := &grpb.SessionParameters{
+		Redundancy: grpb.SessionParameters_SINGLE_PRIMARY,
+		AckType:    grpb.SessionParameters_RIB_AND_FIB_ACK,
+	}
+	sess := DUT(t, "dut").RawAPIs().GRIBI().NewSession(t, params, 2)
+	wantReqs := []*grpb.ModifyRequest{{Params: params}, {ElectionId: electionID}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("NewSession(t) unexpected requests diff (-want,+got): %s", diff)
+	}
+
+	fc.modifyReqs = nil
+	sess.Modify(t,
+		&grpb.AFTOperation{NetworkInstance: "default", Op: grpb.AFTOperation_ADD, Entry: &grpb.AFTOperation_NextHop{NextHop: nh}},
+		&grpb.AFTOperation{NetworkInstance: "default", Op: grpb.AFTOperation_ADD, Entry: &grpb.AFTOperation_NextHopGroup{NextHopGroup: nhg}},
+		&grpb.AFTOperation{NetworkInstance: "default", Op: grpb.AFTOperation_ADD, Entry: &grpb.AFTOperation_Ipv4{Ipv4: route}},
+	)
+	sess.Modify(t, &grpb.AFTOperation{NetworkInstance: "default", Op: grpb.AFTOperation_REPLACE, Entry: &grpb.AFTOperation_NextHop{NextHop: nh}})
+	wantReqs = []*grpb.ModifyRequest{{
+		Operation:  []*grpb.AFTOperation{op(1, grpb.AFTOperation_ADD, nh), op(2, grpb.AFTOperation_ADD, nhg), op(3, grpb.AFTOperation_ADD, route)},
+		ElectionId: electionID,
+	}, {
+		Operation:  []*grpb.AFTOperation{op(4, grpb.AFTOperation_REPLACE, nh)},
+		ElectionId: electionID,
+	}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Modify(t) unexpected requests diff (-want,+got): %s", diff)
+	}
+
+	fc.modifyReqs = nil
+	closedCtx := fc.streamCtx
+	sess.Replay(t)
+	wantReqs = []*grpb.ModifyRequest{{Params: params}, {ElectionId: electionID}, {
+		Operation:  []*grpb.AFTOperation{op(5, grpb.AFTOperation_ADD, nh), op(6, grpb.AFTOperation_ADD, nhg), op(7, grpb.AFTOperation_ADD, route)},
+		ElectionId: electionID,
+	}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Replay(t) unexpected requests diff (-want,+got): %s", diff)
+	}
+	if closedCtx.Err() == nil {
+		t.Errorf("Replay(t) did not close the previous modify stream")
+	}
+
+	if diff := cmp.Diff(fc.entries, sess.Get(t, ""), protocmp.Transform()); diff != "" {
+		t.Errorf("Get(t) unexpected entries diff (-want,+got): %s", diff)
+	}
+	wantGet := &grpb.GetRequest{NetworkInstance: &grpb.GetRequest_All{All: &grpb.Empty{}}, Aft: grpb.AFTType_ALL}
+	if diff := cmp.Diff(wantGet, fc.getReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Get(t) unexpected request diff (-want,+got): %s", diff)
+	}
+
+	fc.modifyReqs = nil
+	sess.Flush(t, "default")
+	wantReqs = []*grpb.ModifyRequest{{
+		Operation:  []*grpb.AFTOperation{op(8, grpb.AFTOperation_DELETE, route)},
+		ElectionId: electionID,
+	}, {
+		Operation:  []*grpb.AFTOperation{op(9, grpb.AFTOperation_DELETE, nhg)},
+		ElectionId: electionID,
+	}, {
+		Operation:  []*grpb.AFTOperation{op(10, grpb.AFTOperation_DELETE, nh)},
+		ElectionId: electionID,
+	}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Flush(t) unexpected requests diff (-want,+got): %s", diff)
+	}
+	wantGet = &grpb.GetRequest{NetworkInstance: &grpb.GetRequest_Name{Name: "default"}, Aft: grpb.AFTType_ALL}
+	if diff := cmp.Diff(wantGet, fc.getReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Flush(t) unexpected get request diff (-want,+got): %s", diff)
+	}
+
+	fc.modifyReqs = nil
+	sess.Replay(t)
+	wantReqs = []*grpb.ModifyRequest{{Params: params}, {ElectionId: electionID}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Replay(t) after Flush(t) unexpected requests diff (-want,+got): %s", diff)
+	}
+
+	sess.Close()
+	if fc.streamCtx.Err() == nil {
+		t.Errorf("Close() did not close the modify stream")
+	}
+}
+
+func TestGRIBISessionReplayAfterClose(t *testing.T) {
+	initDUTFakes(t)
+	nh := &aftpb.Afts_NextHopKey{Index: 1, NextHop: &aftpb.Afts_NextHop{}}
+	electionID := &grpb.Uint128{Low: 2}
+	fc := &fakeGRIBIClient{}
+	fakeBind.GRIBIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (grpb.GRIBIClient, error) {
+		return fc, nil
+	}
+	params := &grpb.SessionParameters{Redundancy: grpb.SessionParameters_SINGLE_PRIMARY}
+	sess := DUT(t, "dut").RawAPIs().GRIBI().NewSession(t, params, 2)
+	sess.Modify(t, &grpb.AFTOperation{NetworkInstance: "default", Op: grpb.AFTOperation_ADD, Entry: &grpb.AFTOperation_NextHop{NextHop: nh}})
+
+	sess.Close()
+	fc.modifyReqs = nil
+	sess.Replay(t)
+	wantReqs := []*grpb.ModifyRequest{{Params: params}, {ElectionId: electionID}, {
+		Operation: []*grpb.AFTOperation{{
+			Id:              2,
+			NetworkInstance: "default",
+			Op:              grpb.AFTOperation_ADD,
+			Entry:           &grpb.AFTOperation_NextHop{NextHop: nh},
+			ElectionId:      electionID,
+		}},
+		ElectionId: electionID,
+	}}
+	if diff := cmp.Diff(wantReqs, fc.modifyReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Replay(t) after Close() unexpected requests diff (-want,+got): %s", diff)
+	}
+	if fc.streamCtx.Err() != nil {
+		t.Errorf("Replay(t) after Close() did not reopen the modify stream")
+	}
+	sess.Close()
+}
+
+func TestGRIBISessionErrors(t *testing.T) {
+	initDUTFakes(t)
+	params := &grpb.SessionParameters{
+		Redundancy: grpb.SessionParameters_SINGLE_PRIMARY,
+		AckType:    grpb.SessionParameters_RIB_AND_FIB_ACK,
+	}
+	op := &grpb.AFTOperation{
+		NetworkInstance: "default",
+		Op:              grpb.AFTOperation_ADD,
+		Entry:           &grpb.AFTOperation_NextHop{NextHop: &aftpb.Afts_NextHopKey{Index: 1}},
+	}
+	tests := []struct {
+		desc    string
+		client  *fakeGRIBIClient
+		dialErr error
+		modify  bool
+		wantErr string
+	}{{
+		desc:    "dial error",
+		client:  &fakeGRIBIClient{},
+		dialErr: errors.New("bad gribi"),
+		wantErr: "bad gribi",
+	}, {
+		desc:    "not primary",
+		client:  &fakeGRIBIClient{primaryID: &grpb.Uint128{Low: 3}},
+		wantErr: "not the primary client",
+	}, {
+		desc:    "failed operation",
+		client:  &fakeGRIBIClient{failID: 1},
+		modify:  true,
+		wantErr: "failed",
+	}, {
+		desc:    "failed FIB programming",
+		client:  &fakeGRIBIClient{fibFailID: 1},
+		modify:  true,
+		wantErr: "failed to be programmed in the FIB",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeBind.GRIBIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (grpb.GRIBIClient, error) {
+				return tt.client, tt.dialErr
+			}
+			raw := DUT(t, "dut").RawAPIs()
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				sess := raw.GRIBI().NewSession(t, params, 2)
+				if tt.modify {
+					sess.Modify(t, op)
+				}
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("GRIBI session got err %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
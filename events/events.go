@@ -0,0 +1,52 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events lets tests register listeners for the events of an Ondatra
+// test run, such as to collect tech-support bundles from the devices or flush
+// ATE statistics whenever a test fails. Listeners are typically registered in
+// TestMain, before calling ondatra.RunTests, and apply to every test.
+package events
+
+import (
+	"testing"
+
+	"github.com/openconfig/ondatra/internal/events"
+)
+
+// Device is a reserved device, either an *ondatra.DUTDevice or an
+// *ondatra.ATEDevice, which a listener can access with a type switch.
+type Device = events.Device
+
+// OnTestStart registers a listener that is called at the start of every test.
+func OnTestStart(fn func(t testing.TB)) {
+	events.AddTestStartListener(fn)
+}
+
+// OnTestEnd registers a listener that is called at the end of every test,
+// after any failure listeners.
+func OnTestEnd(fn func(t testing.TB)) {
+	events.AddTestEndListener(fn)
+}
+
+// OnFailure registers a listener that is called at the end of every test that
+// failed, including by a fatal error or panic, with all the reserved devices.
+func OnFailure(fn func(t testing.TB, devs []Device)) {
+	events.AddFailureListener(fn)
+}
+
+// OnConfigPush registers a listener that is called after every push of config
+// to a DUT, with the error of the push, if any.
+func OnConfigPush(fn func(t testing.TB, dut Device, err error)) {
+	events.AddConfigPushListener(fn)
+}
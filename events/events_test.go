@@ -0,0 +1,60 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/internal/events"
+)
+
+type fakeDevice struct {
+	id string
+}
+
+func (d *fakeDevice) ID() string {
+	return d.id
+}
+
+func (d *fakeDevice) Name() string {
+	return d.id
+}
+
+func TestListeners(t *testing.T) {
+	var got []string
+	OnTestStart(func(testing.TB) { got = append(got, "start") })
+	OnTestEnd(func(testing.TB) { got = append(got, "end") })
+	OnFailure(func(_ testing.TB, devs []Device) {
+		for _, d := range devs {
+			got = append(got, "failure "+d.ID())
+		}
+	})
+	OnConfigPush(func(_ testing.TB, dut Device, err error) {
+		got = append(got, "push "+dut.ID()+" "+err.Error())
+	})
+
+	dut, ate := &fakeDevice{id: "dut"}, &fakeDevice{id: "ate"}
+	events.TestStarted(t)
+	events.ConfigPushed(t, dut, errors.New("bad config"))
+	events.TestFailed(t, []events.Device{ate, dut})
+	events.TestEnded(t)
+
+	want := []string{"start", "push dut bad config", "failure ate", "failure dut", "end"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Listeners got unexpected calls diff (-want,+got): %s", diff)
+	}
+}
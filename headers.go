@@ -82,6 +82,14 @@ func (h *EthernetHeader) WithBadCRC(bad bool) *EthernetHeader {
 	return h
 }
 
+// WithEtherType sets the EtherType of the Ethernet header to the specified
+// value. By default, the EtherType is that of the next header; set it when the
+// next header is a custom header.
+func (h *EthernetHeader) WithEtherType(etherType uint16) *EthernetHeader {
+	h.pb.EtherType = uint32(etherType)
+	return h
+}
+
 func (h *EthernetHeader) asPB() *opb.Header {
 	return &opb.Header{Type: &opb.Header_Eth{h.pb}}
 }
@@ -425,6 +433,199 @@ func (h *UDPHeader) asPB() *opb.Header {
 	return &opb.Header{Type: &opb.Header_Udp{h.pb}}
 }
 
+// NewVXLANHeader returns a new VXLAN header.
+// The header is initialized with none of its properties specified.
+// The VXLAN header must follow a UDP header and precede the inner Ethernet
+// header of the encapsulated frame. Unless specified, the destination port of
+// the UDP header is set to 4789.
+func NewVXLANHeader() *VXLANHeader {
+	return &VXLANHeader{&opb.VxlanHeader{}}
+}
+
+// VXLANHeader is a Virtual eXtensible Local Area Network packet header.
+type VXLANHeader struct {
+	pb *opb.VxlanHeader
+}
+
+// WithVNI sets the VXLAN network identifier of the VXLAN header to the specified value.
+// To generate a range of identifiers, use VNIRange() instead.
+func (h *VXLANHeader) WithVNI(vni uint32) *VXLANHeader {
+	h.pb.Vni = intRangeSingle(vni)
+	return h
+}
+
+// VNIRange returns the range of VXLAN network identifiers of the VXLAN header for further configuration.
+// By default, the range will be nonrandom values in the interval [0, 2^24).
+// The count of values in the range is not set by default; the user must set it explicitly.
+func (h *VXLANHeader) VNIRange() *UIntRange {
+	if h.pb.Vni == nil {
+		h.pb.Vni = newVNIRange()
+	}
+	return &UIntRange{pb: h.pb.Vni}
+}
+
+func (h *VXLANHeader) asPB() *opb.Header {
+	return &opb.Header{Type: &opb.Header_Vxlan{h.pb}}
+}
+
+// NewGENEVEHeader returns a new GENEVE header.
+// The header is initialized with a protocol type of 0x6558 (Transparent
+// Ethernet Bridging), so the encapsulated payload is an Ethernet frame.
+// The GENEVE header must follow a UDP header. Unless specified, the destination
+// port of the UDP header is set to 6081.
+func NewGENEVEHeader() *GENEVEHeader {
+	return &GENEVEHeader{&opb.GeneveHeader{ProtocolType: 0x6558}}
+}
+
+// GENEVEHeader is a Generic Network Virtualization Encapsulation packet header.
+type GENEVEHeader struct {
+	pb *opb.GeneveHeader
+}
+
+// WithVNI sets the virtual network identifier of the GENEVE header to the specified value.
+// To generate a range of identifiers, use VNIRange() instead.
+func (h *GENEVEHeader) WithVNI(vni uint32) *GENEVEHeader {
+	h.pb.Vni = intRangeSingle(vni)
+	return h
+}
+
+// VNIRange returns the range of virtual network identifiers of the GENEVE header for further configuration.
+// By default, the range will be nonrandom values in the interval [0, 2^24).
+// The count of values in the range is not set by default; the user must set it explicitly.
+func (h *GENEVEHeader) VNIRange() *UIntRange {
+	if h.pb.Vni == nil {
+		h.pb.Vni = newVNIRange()
+	}
+	return &UIntRange{pb: h.pb.Vni}
+}
+
+// WithProtocolType sets the EtherType of the payload encapsulated by the GENEVE header.
+func (h *GENEVEHeader) WithProtocolType(etherType uint16) *GENEVEHeader {
+	h.pb.ProtocolType = uint32(etherType)
+	return h
+}
+
+// WithControlPacket sets whether the GENEVE header marks the packet as a control packet.
+func (h *GENEVEHeader) WithControlPacket(control bool) *GENEVEHeader {
+	h.pb.ControlPacket = control
+	return h
+}
+
+func (h *GENEVEHeader) asPB() *opb.Header {
+	return &opb.Header{Type: &opb.Header_Geneve{h.pb}}
+}
+
+// NewGTPUHeader returns a new GTPv1-U header.
+// The header is initialized with a message type of 255 (G-PDU).
+// The GTP-U header must follow a UDP header and precede the encapsulated IP
+// header. Unless specified, the destination port of the UDP header is set to 2152.
+func NewGTPUHeader() *GTPUHeader {
+	return &GTPUHeader{&opb.GtpuHeader{MessageType: 255}}
+}
+
+// GTPUHeader is a GPRS Tunnelling Protocol user plane packet header.
+type GTPUHeader struct {
+	pb *opb.GtpuHeader
+}
+
+// WithTEID sets the tunnel endpoint identifier of the GTP-U header to the specified value.
+// To generate a range of identifiers, use TEIDRange() instead.
+func (h *GTPUHeader) WithTEID(teid uint32) *GTPUHeader {
+	h.pb.Teid = intRangeSingle(teid)
+	return h
+}
+
+// TEIDRange returns the range of tunnel endpoint identifiers of the GTP-U header for further configuration.
+// By default, the range will be nonrandom values in the interval [1, 2^32).
+// The count of values in the range is not set by default; the user must set it explicitly.
+func (h *GTPUHeader) TEIDRange() *UIntRange {
+	if h.pb.Teid == nil {
+		h.pb.Teid = newTEIDRange()
+	}
+	return &UIntRange{pb: h.pb.Teid}
+}
+
+// WithMessageType sets the message type of the GTP-U header.
+func (h *GTPUHeader) WithMessageType(msgType uint8) *GTPUHeader {
+	h.pb.MessageType = uint32(msgType)
+	return h
+}
+
+// PDUSessionContainer returns the PDU session container extension header of
+// the GTP-U header for further configuration, creating it if needed.
+// By default, the container is for downlink traffic with a QFI of 0.
+func (h *GTPUHeader) PDUSessionContainer() *GTPUPDUSessionContainer {
+	if h.pb.PduSessionContainer == nil {
+		h.pb.PduSessionContainer = &opb.GtpuHeader_PduSessionContainer{
+			PduType: opb.GtpuHeader_PduSessionContainer_DOWNLINK,
+		}
+	}
+	return &GTPUPDUSessionContainer{pb: h.pb.PduSessionContainer}
+}
+
+func (h *GTPUHeader) asPB() *opb.Header {
+	return &opb.Header{Type: &opb.Header_Gtpu{h.pb}}
+}
+
+// GTPUPDUSessionContainer is a PDU session container extension header, which
+// carries the QoS flow identifier of 5G user plane traffic.
+type GTPUPDUSessionContainer struct {
+	pb *opb.GtpuHeader_PduSessionContainer
+}
+
+// WithDownlink sets the PDU type of the container to downlink.
+func (c *GTPUPDUSessionContainer) WithDownlink() *GTPUPDUSessionContainer {
+	c.pb.PduType = opb.GtpuHeader_PduSessionContainer_DOWNLINK
+	return c
+}
+
+// WithUplink sets the PDU type of the container to uplink.
+func (c *GTPUPDUSessionContainer) WithUplink() *GTPUPDUSessionContainer {
+	c.pb.PduType = opb.GtpuHeader_PduSessionContainer_UPLINK
+	return c
+}
+
+// WithQFI sets the 6-bit QoS flow identifier of the container to the specified value.
+// To generate a range of identifiers, use QFIRange() instead.
+func (c *GTPUPDUSessionContainer) WithQFI(qfi uint8) *GTPUPDUSessionContainer {
+	c.pb.Qfi = intRangeSingle(uint32(qfi))
+	return c
+}
+
+// QFIRange returns the range of QoS flow identifiers of the container for further configuration.
+// By default, the range will be nonrandom values in the interval [0, 2^6).
+// The count of values in the range is not set by default; the user must set it explicitly.
+func (c *GTPUPDUSessionContainer) QFIRange() *UIntRange {
+	if c.pb.Qfi == nil {
+		c.pb.Qfi = newQFIRange()
+	}
+	return &UIntRange{pb: c.pb.Qfi}
+}
+
+// CustomHeader is a packet header of arbitrary bytes, used to craft packets,
+// such as malformed or unexpected protocol packets, that the other headers
+// cannot express.
+type CustomHeader struct {
+	pb *opb.CustomHeader
+}
+
+// NewCustomHeader returns a new custom header.
+// The header is initialized with no bytes, so the bytes must be set explicitly.
+func NewCustomHeader() *CustomHeader {
+	return &CustomHeader{&opb.CustomHeader{}}
+}
+
+// WithBytes sets the bytes of the custom header, such as those of a packet
+// serialized by a packet crafting library.
+func (h *CustomHeader) WithBytes(data []byte) *CustomHeader {
+	h.pb.Data = data
+	return h
+}
+
+func (h *CustomHeader) asPB() *opb.Header {
+	return &opb.Header{Type: &opb.Header_Custom{h.pb}}
+}
+
 // HTTPHeader is an HTTP packet header.
 type HTTPHeader struct {
 	pb *opb.HttpHeader
@@ -0,0 +1,114 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openconfig/ondatra/internal/ate"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// Impairment is a network impairment of the traffic through the ATE, such as
+// dropped, duplicated, reordered, or delayed packets.
+type Impairment struct {
+	pb *opb.Impairment
+}
+
+// NewImpairment returns a new impairment. By default, the impairment applies
+// to all impairment links of the ATE and does not impair any traffic.
+func (tr *Traffic) NewImpairment(name string) *Impairment {
+	return &Impairment{pb: &opb.Impairment{Name: name}}
+}
+
+func (i *Impairment) String() string {
+	return fmt.Sprintf("Impairment%+v", i.pb)
+}
+
+// WithPorts sets the ports on whose links traffic is impaired.
+func (i *Impairment) WithPorts(ports ...*Port) *Impairment {
+	i.pb.Ports = nil
+	for _, p := range ports {
+		i.pb.Ports = append(i.pb.Ports, p.Name())
+	}
+	return i
+}
+
+// WithFlows sets the flows whose packets are impaired. The packets of each
+// flow are matched by the source and destination addresses of its first IP
+// header, so each endpoint of the flow must have a single address.
+func (i *Impairment) WithFlows(flows ...*Flow) *Impairment {
+	i.pb.Flows = nil
+	for _, f := range flows {
+		i.pb.Flows = append(i.pb.Flows, f.pb)
+	}
+	return i
+}
+
+// WithDropPct sets the percentage of packets that are dropped.
+func (i *Impairment) WithDropPct(pct float32) *Impairment {
+	i.pb.DropPct = pct
+	return i
+}
+
+// WithDuplicatePct sets the percentage of packets that are duplicated.
+func (i *Impairment) WithDuplicatePct(pct float32) *Impairment {
+	i.pb.DuplicatePct = pct
+	return i
+}
+
+// WithReorderPct sets the percentage of packets that are reordered, each by
+// being delayed past the given number of subsequent packets.
+func (i *Impairment) WithReorderPct(pct float32, skipCount uint32) *Impairment {
+	i.pb.ReorderPct = pct
+	i.pb.ReorderSkipCount = skipCount
+	return i
+}
+
+// WithDelay sets the fixed latency added to every packet.
+func (i *Impairment) WithDelay(delay time.Duration) *Impairment {
+	i.pb.DelayUs = uint32(delay.Microseconds())
+	return i
+}
+
+// WithJitter sets the spread of a uniformly random latency added to every
+// packet on top of the fixed delay.
+func (i *Impairment) WithJitter(jitter time.Duration) *Impairment {
+	i.pb.JitterUs = uint32(jitter.Microseconds())
+	return i
+}
+
+// SetImpairment starts impairing traffic through the ATE, replacing any
+// impairment of the same name. The ATE must have impairment capable ports.
+func (tr *Traffic) SetImpairment(t testing.TB, imp *Impairment) {
+	t.Helper()
+	logAction(t, "Setting impairment on %s", tr.ate)
+	if err := ate.SetImpairment(context.Background(), tr.ate, imp.pb); err != nil {
+		t.Fatalf("SetImpairment(t, %s) on %s: %v", imp, tr, err)
+	}
+}
+
+// ClearImpairment stops impairing traffic with the given impairment.
+func (tr *Traffic) ClearImpairment(t testing.TB, imp *Impairment) {
+	t.Helper()
+	logAction(t, "Clearing impairment on %s", tr.ate)
+	if err := ate.ClearImpairment(context.Background(), tr.ate, imp.pb.GetName()); err != nil {
+		t.Fatalf("ClearImpairment(t, %s) on %s: %v", imp, tr, err)
+	}
+}
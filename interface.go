@@ -131,6 +131,110 @@ func (i *Interface) BGP() *BGP {
 	return &BGP{pb: i.pb.Bgp}
 }
 
+// DHCP creates a DHCP config for the interface or returns the existing config.
+func (i *Interface) DHCP() *DHCP {
+	if i.pb.Dhcp == nil {
+		i.pb.Dhcp = &opb.DhcpConfig{}
+	}
+	return &DHCP{pb: i.pb.Dhcp}
+}
+
+// BFD creates a BFD config for the interface or returns the existing config.
+// The default config params are:
+// Tx Interval: 1000 milliseconds
+// Rx Interval: 1000 milliseconds
+// Multiplier: 3
+func (i *Interface) BFD() *BFD {
+	if i.pb.Bfd == nil {
+		i.pb.Bfd = &opb.BfdConfig{
+			TxIntervalMs: 1000,
+			RxIntervalMs: 1000,
+			Multiplier:   3,
+		}
+	}
+	return &BFD{pb: i.pb.Bfd}
+}
+
+// IGMPHost creates an IGMP host config for the interface or returns the
+// existing config. The default version is IGMPv2.
+func (i *Interface) IGMPHost() *IGMPHost {
+	if i.pb.IgmpHost == nil {
+		i.pb.IgmpHost = &opb.IgmpHostConfig{Version: opb.IgmpHostConfig_V2}
+	}
+	return &IGMPHost{pb: i.pb.IgmpHost, intfName: i.pb.GetName()}
+}
+
+// MLDHost creates an MLD host config for the interface or returns the existing
+// config. The default version is MLDv1.
+func (i *Interface) MLDHost() *MLDHost {
+	if i.pb.MldHost == nil {
+		i.pb.MldHost = &opb.MldHostConfig{Version: opb.MldHostConfig_V1}
+	}
+	return &MLDHost{pb: i.pb.MldHost, intfName: i.pb.GetName()}
+}
+
+// PIM creates a PIM-SM config for the interface or returns the existing config.
+// The default config params are:
+// Hello Interval: 30 seconds
+// Hello Hold Time: 105 seconds
+func (i *Interface) PIM() *PIM {
+	if i.pb.Pim == nil {
+		i.pb.Pim = &opb.PimConfig{
+			HelloIntervalSec: 30,
+			HelloHoldTimeSec: 105,
+		}
+	}
+	return &PIM{pb: i.pb.Pim}
+}
+
+// LDP creates an LDP config for the interface or returns the existing config.
+// The default config params are:
+// Hello Interval: 5 seconds
+// Hello Hold Time: 15 seconds
+// Keepalive Interval: 10 seconds
+// Keepalive Hold Time: 30 seconds
+func (i *Interface) LDP() *LDP {
+	if i.pb.Ldp == nil {
+		i.pb.Ldp = &opb.LdpConfig{
+			HelloIntervalSec:     5,
+			HelloHoldTimeSec:     15,
+			KeepaliveIntervalSec: 10,
+			KeepaliveHoldTimeSec: 30,
+		}
+	}
+	return &LDP{pb: i.pb.Ldp}
+}
+
+// Dot1X creates an 802.1X supplicant config for the interface or returns the
+// existing config. The default EAP method is EAP-MD5.
+func (i *Interface) Dot1X() *Dot1X {
+	if i.pb.Dot1X == nil {
+		i.pb.Dot1X = &opb.Dot1XConfig{EapMethod: opb.Dot1XConfig_MD5}
+	}
+	return &Dot1X{pb: i.pb.Dot1X}
+}
+
+// PTP creates a PTP config for the interface or returns the existing config.
+// The default config params are:
+// Role: master
+// Transport: Ethernet
+// Delay Mechanism: end-to-end
+// Priority1 and Priority2: 128
+// Log Sync Interval: 0 (one message per second)
+// Log Announce Interval: 1 (one message every two seconds)
+// Log Delay Request Interval: 0 (one message per second)
+func (i *Interface) PTP() *PTP {
+	if i.pb.Ptp == nil {
+		i.pb.Ptp = &opb.PtpConfig{
+			Role:                opb.PtpConfig_MASTER,
+			Priority1:           128,
+			Priority2:           128,
+			LogAnnounceInterval: 1,
+		}
+	}
+	return &PTP{pb: i.pb.Ptp}
+}
+
 // AddRSVP adds an RSVP config to the interface.
 func (i *Interface) AddRSVP(name string) *RSVP {
 	rpb := &opb.RsvpConfig{Name: name, InterfaceName: i.pb.Name}
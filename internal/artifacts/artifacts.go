@@ -0,0 +1,80 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifacts writes the outputs of tests, such as diagnostic bundles,
+// packet captures and reports, to the sink set with the public artifacts
+// package.
+package artifacts
+
+import (
+	"golang.org/x/net/context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/openconfig/ondatra/internal/closer"
+)
+
+// Sink stores artifacts.
+type Sink interface {
+	// Create creates the artifact with the specified name and returns a writer
+	// of its content, which is complete when the writer is closed. The name is
+	// the local path of the artifact, such as one in the directory of the
+	// -diag_dir flag, which the sink maps to its storage.
+	Create(ctx context.Context, name string) (io.WriteCloser, error)
+}
+
+var (
+	mu   sync.Mutex
+	sink Sink = DirSink("")
+)
+
+// SetSink sets the sink to which artifacts are written.
+func SetSink(s Sink) {
+	mu.Lock()
+	defer mu.Unlock()
+	sink = s
+}
+
+// Write writes the data as the artifact with the specified name.
+func Write(ctx context.Context, name string, data []byte) (rerr error) {
+	mu.Lock()
+	s := sink
+	mu.Unlock()
+	w, err := s.Create(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "error creating artifact %s", name)
+	}
+	defer closer.Close(&rerr, w.Close, "error closing artifact "+name)
+	if _, err := w.Write(data); err != nil {
+		return errors.Wrapf(err, "error writing artifact %s", name)
+	}
+	return nil
+}
+
+// DirSink is a sink that writes artifacts to files in a local directory, at
+// their names relative to the directory. An empty directory writes the
+// artifacts at their names, which is the default.
+type DirSink string
+
+// Create creates the file of the artifact, and any missing parent directories.
+func (d DirSink) Create(_ context.Context, name string) (io.WriteCloser, error) {
+	file := filepath.Join(string(d), name)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return nil, err
+	}
+	return os.Create(file)
+}
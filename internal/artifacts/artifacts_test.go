@@ -0,0 +1,98 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"bytes"
+	"golang.org/x/net/context"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+type fakeSink struct {
+	artifacts map[string]*fakeArtifact
+}
+
+type fakeArtifact struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (a *fakeArtifact) Close() error {
+	a.closed = true
+	return nil
+}
+
+func (s *fakeSink) Create(_ context.Context, name string) (io.WriteCloser, error) {
+	a := &fakeArtifact{}
+	s.artifacts[name] = a
+	return a, nil
+}
+
+func TestWriteDefault(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "diag", "TestFoo", "dut", "gnmi.txt")
+	if err := Write(context.Background(), name, []byte("data")); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+	got, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatalf("Write() did not write the file: %v", err)
+	}
+	if want := "data"; string(got) != want {
+		t.Errorf("Write() wrote %q, want %q", got, want)
+	}
+}
+
+func TestWriteSink(t *testing.T) {
+	s := &fakeSink{artifacts: make(map[string]*fakeArtifact)}
+	SetSink(s)
+	defer SetSink(DirSink(""))
+	if err := Write(context.Background(), "report/report.json", []byte("{}")); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+	a, ok := s.artifacts["report/report.json"]
+	if !ok {
+		t.Fatalf("Write() did not create the artifact, got %v", s.artifacts)
+	}
+	if got, want := a.String(), "{}"; got != want {
+		t.Errorf("Write() wrote %q, want %q", got, want)
+	}
+	if !a.closed {
+		t.Errorf("Write() did not close the artifact")
+	}
+}
+
+func TestDirSink(t *testing.T) {
+	dir := t.TempDir()
+	w, err := DirSink(dir).Create(context.Background(), "a/b.pcap")
+	if err != nil {
+		t.Fatalf("Create() got error: %v", err)
+	}
+	if _, err := w.Write([]byte("pcap")); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() got error: %v", err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(dir, "a", "b.pcap"))
+	if err != nil {
+		t.Fatalf("Create() did not create the file: %v", err)
+	}
+	if want := "pcap"; string(got) != want {
+		t.Errorf("Create() wrote %q, want %q", got, want)
+	}
+}
@@ -17,12 +17,16 @@ package ate
 
 import (
 	"golang.org/x/net/context"
+	"encoding/json"
 	"net"
 	"sync"
+	"time"
 
+	"github.com/openconfig/gnmi/errlist"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/binding/ixweb"
 	"github.com/openconfig/ondatra/binding/usererr"
 	"github.com/openconfig/ondatra/internal/testbed"
 
@@ -33,8 +37,24 @@ import (
 var (
 	mu    sync.Mutex
 	ixias = make(map[*binding.ATE]*ixATE)
+
+	// chassisLocks are the locks of the chassis that the IxNetwork sessions in
+	// this process use, keyed by chassis host. The config imports and traffic
+	// operations of the sessions that share a chassis are serialized by them,
+	// so that tests sharing the chassis do not interleave them.
+	chassisLocks = make(map[string]*sync.Mutex)
+
+	// configDir is the directory in which the pushed IxNetwork configs are
+	// written, or empty if they are not written.
+	configDir string
 )
 
+// SetConfigDir sets the directory in which the IxNetwork configs pushed to the
+// ATEs are written as test artifacts. If empty, the configs are not written.
+func SetConfigDir(dir string) {
+	configDir = dir
+}
+
 func ixiaForATE(ctx context.Context, ate *binding.ATE) (*ixATE, error) {
 	mu.Lock()
 	defer mu.Unlock()
@@ -48,11 +68,35 @@ func ixiaForATE(ctx context.Context, ate *binding.ATE) (*ixATE, error) {
 		if err != nil {
 			return nil, err
 		}
+		ix.portBreakouts = portBreakouts(ate)
 		ixias[ate] = ix
 	}
 	return ix, nil
 }
 
+// chassisLock returns the lock of the chassis with the given host, creating it
+// if needed. It must be called with mu held.
+func chassisLock(host string) *sync.Mutex {
+	l, ok := chassisLocks[host]
+	if !ok {
+		l = new(sync.Mutex)
+		chassisLocks[host] = l
+	}
+	return l
+}
+
+// portBreakouts returns the breakouts of the breakout ports of an ATE, keyed
+// by port name.
+func portBreakouts(ate *binding.ATE) map[string]*opb.Port_Breakout {
+	breakouts := make(map[string]*opb.Port_Breakout)
+	for _, p := range ate.Dimensions().Ports {
+		if p.Breakout != nil {
+			breakouts[p.Name] = p.Breakout
+		}
+	}
+	return breakouts
+}
+
 // PushTopology pushes a topology to an ATE.
 func PushTopology(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
 	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
@@ -69,6 +113,21 @@ func PushTopology(ctx context.Context, ate *binding.ATE, top *opb.Topology) erro
 	return nil
 }
 
+// DryRunTopology returns the IxNetwork JSON config that pushing the topology
+// to an ATE would import, without contacting the ATE. Because the chassis is
+// not contacted, ports are located on a chassis host named after the ATE.
+func DryRunTopology(ate *binding.ATE, top *opb.Topology) ([]byte, error) {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return nil, err
+	}
+	ix := &ixATE{name: ate.Name, chassisHost: ate.Name, portBreakouts: portBreakouts(ate)}
+	cfg, err := ix.dryRunTopology(top)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(cfg, "", "   ")
+}
+
 // UpdateTopology updates a topology on an ATE.
 func UpdateTopology(ctx context.Context, ate *binding.ATE, top *opb.Topology, bgpPeerStateOnly bool) error {
 	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
@@ -91,6 +150,121 @@ func UpdateTopology(ctx context.Context, ate *binding.ATE, top *opb.Topology, bg
 	return nil
 }
 
+// UpdateInterfaces adds and removes the interfaces of a topology on an ATE,
+// without changing the other interfaces.
+func UpdateInterfaces(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateInterfaces(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdateNetworks updates the networks of a topology on an ATE on the fly.
+func UpdateNetworks(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateNetworks(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdateBFD updates the BFD sessions of a topology on an ATE on the fly.
+func UpdateBFD(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateBFD(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdateMulticastGroups updates the IGMP and MLD host groups of a topology on an
+// ATE on the fly.
+func UpdateMulticastGroups(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateMulticastGroups(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdatePIM updates the PIM join/prunes of a topology on an ATE on the fly.
+func UpdatePIM(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdatePIM(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdateISIS updates the IS-IS routers of a topology on an ATE on the fly.
+func UpdateISIS(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateISIS(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// UpdateBGPRoutes updates the BGP attributes of the routes of a topology on an
+// ATE on the fly.
+func UpdateBGPRoutes(ctx context.Context, ate *binding.ATE, top *opb.Topology) error {
+	if err := validateInterfaces(ate, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.UpdateBGPRoutes(ctx, top.GetInterfaces()); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
 // StartProtocols starts control plane protocols on an ATE.
 func StartProtocols(ctx context.Context, ate *binding.ATE) error {
 	ix, err := ixiaForATE(ctx, ate)
@@ -149,6 +323,16 @@ func UpdateTraffic(ctx context.Context, ate *binding.ATE, flows []*opb.Flow) err
 	return nil
 }
 
+// UpdateFrameRates changes the frame rates of running traffic flows on an ATE
+// on the fly.
+func UpdateFrameRates(ctx context.Context, ate *binding.ATE, flows []*opb.Flow) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.UpdateFrameRates(ctx, flows)
+}
+
 // StopTraffic stops traffic flows on an ATE.
 func StopTraffic(ctx context.Context, ate *binding.ATE) error {
 	ix, err := ixiaForATE(ctx, ate)
@@ -162,6 +346,157 @@ func StopTraffic(ctx context.Context, ate *binding.ATE) error {
 	return nil
 }
 
+// AwaitTrafficStopped waits for traffic flows on an ATE to stop on their own.
+func AwaitTrafficStopped(ctx context.Context, ate *binding.ATE, timeout time.Duration) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	if err := ix.AwaitTrafficStopped(ctx, timeout); err != nil {
+		return err
+	}
+	ix.FlushStats()
+	return nil
+}
+
+// SetImpairment sets an impairment of traffic on an ATE.
+func SetImpairment(ctx context.Context, ate *binding.ATE, imp *opb.Impairment) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.SetImpairment(ctx, imp)
+}
+
+// ClearImpairment clears an impairment of traffic on an ATE.
+func ClearImpairment(ctx context.Context, ate *binding.ATE, name string) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.ClearImpairment(ctx, name)
+}
+
+// FetchConvergenceTimes returns the control plane/data plane convergence times
+// of the traffic flows on an ATE that measure convergence, keyed by flow name.
+func FetchConvergenceTimes(ctx context.Context, ate *binding.ATE) (map[string]time.Duration, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchConvergenceTimes(ctx)
+}
+
+// FetchFlowLatencies returns the store-and-forward latency and jitter of the
+// traffic flows on an ATE that measure latency, keyed by flow name.
+func FetchFlowLatencies(ctx context.Context, ate *binding.ATE) (map[string]*FlowLatency, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchFlowLatencies(ctx)
+}
+
+// FetchDHCPServerLeases returns the lease counts of the emulated DHCPv4
+// servers, or the DHCPv6 servers if isV6 is true, on an ATE, keyed by port name.
+func FetchDHCPServerLeases(ctx context.Context, ate *binding.ATE, isV6 bool) (map[string]*DHCPServerLeases, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchDHCPServerLeases(ctx, isV6)
+}
+
+// FetchIntegrityErrors returns the number of received frames that failed the
+// payload integrity check, for the traffic flows on an ATE, keyed by flow name.
+func FetchIntegrityErrors(ctx context.Context, ate *binding.ATE) (map[string]uint64, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchIntegrityErrors(ctx)
+}
+
+// FetchPTPMeasurements returns the measurements of the emulated PTP clocks on
+// an ATE.
+func FetchPTPMeasurements(ctx context.Context, ate *binding.ATE) ([]*PTPMeasurement, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchPTPMeasurements(ctx)
+}
+
+// AwaitNoLoss waits up to the specified window for the frame counts of traffic
+// flows on an ATE to stabilize and checks that their loss is within tolerance.
+func AwaitNoLoss(ctx context.Context, ate *binding.ATE, flows []string, tolerancePct float64, window time.Duration) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.AwaitNoLoss(ctx, flows, tolerancePct, window)
+}
+
+// FetchLoadDistribution returns the distribution of the frames of a flow
+// received across the specified ports of an ATE.
+func FetchLoadDistribution(ctx context.Context, ate *binding.ATE, flow string, ports []string) (*LoadDistribution, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchLoadDistribution(ctx, flow, ports)
+}
+
+// FetchProtocolSessions returns the states of the emulated protocol sessions
+// on an ATE.
+func FetchProtocolSessions(ctx context.Context, ate *binding.ATE) (*ProtocolSessions, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchProtocolSessions(ctx)
+}
+
+// FetchLearnedRoutes returns the routes learned by the emulated routing
+// protocols on an ATE.
+func FetchLearnedRoutes(ctx context.Context, ate *binding.ATE) ([]*LearnedRoute, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchLearnedRoutes(ctx)
+}
+
+// AwaitProtocolSessionsUp waits up to the specified timeout for all emulated
+// protocol sessions on an ATE to come up.
+func AwaitProtocolSessionsUp(ctx context.Context, ate *binding.ATE, timeout time.Duration) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.AwaitProtocolSessionsUp(ctx, timeout)
+}
+
+// FetchNeighborResolutions returns the resolution states of the default
+// gateways of the emulated interfaces on an ATE.
+func FetchNeighborResolutions(ctx context.Context, ate *binding.ATE) ([]*NeighborResolution, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.FetchNeighborResolutions(ctx)
+}
+
+// ResolveNeighbors forces the resolution of the default gateways of the
+// emulated interfaces on an ATE.
+func ResolveNeighbors(ctx context.Context, ate *binding.ATE) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.ResolveNeighbors(ctx)
+}
+
 // SetInterfaceState sets the state of a specified interface on the ATE.
 func SetInterfaceState(ctx context.Context, ate *binding.ATE, intf string, enabled bool) error {
 	ix, err := ixiaForATE(ctx, ate)
@@ -171,6 +506,142 @@ func SetInterfaceState(ctx context.Context, ate *binding.ATE, intf string, enabl
 	return ix.SetPortState(ctx, intf, enabled)
 }
 
+// SetPortLoopback sets the loopback mode of a port on the ATE.
+func SetPortLoopback(ctx context.Context, ate *binding.ATE, port string, mode opb.PortLoopbackMode) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.SetPortLoopback(ctx, port, mode)
+}
+
+// SetPortLinkFault sets the link fault signaled by a port on the ATE.
+func SetPortLinkFault(ctx context.Context, ate *binding.ATE, port string, fault opb.PortLinkFault) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.SetPortLinkFault(ctx, port, fault)
+}
+
+// SetPortL1Config sets the L1 config of a port on the ATE.
+func SetPortL1Config(ctx context.Context, ate *binding.ATE, port string, l1 *opb.PortL1Config) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.SetPortL1Config(ctx, port, l1)
+}
+
+// TakePortOwnership takes ownership of the chassis port of a port on the ATE.
+func TakePortOwnership(ctx context.Context, ate *binding.ATE, port string) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.TakePortOwnership(ctx, port)
+}
+
+// ReleasePort releases the chassis port of a port on the ATE.
+func ReleasePort(ctx context.Context, ate *binding.ATE, port string) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.ReleasePort(ctx, port)
+}
+
+// RebootPortCPU reboots the CPU of the chassis port of a port on the ATE.
+func RebootPortCPU(ctx context.Context, ate *binding.ATE, port string) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.RebootPortCPU(ctx, port)
+}
+
+// StartCapture starts capturing the frames received on the given ports of an
+// ATE.
+func StartCapture(ctx context.Context, ate *binding.ATE, ports []string) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.StartCapture(ctx, ports)
+}
+
+// StopCapture stops capturing frames on an ATE and returns the captured
+// frames of each port in pcap format, keyed by port name.
+func StopCapture(ctx context.Context, ate *binding.ATE) (map[string][]byte, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.StopCapture(ctx)
+}
+
+// ReleaseAllPorts releases the chassis ports configured on all ATEs, such as
+// when the reservation is torn down.
+func ReleaseAllPorts(ctx context.Context) error {
+	mu.Lock()
+	defer mu.Unlock()
+	errs := &errlist.List{}
+	for ate, ix := range ixias {
+		if err := ix.ReleasePorts(ctx); err != nil {
+			errs.Add(errors.Wrapf(err, "error releasing ports of ATE %s", ate.Name))
+		}
+	}
+	return errs.Err()
+}
+
+// StopAllTraffic stops the traffic flows on all ATEs, such as when the test
+// run is about to time out.
+func StopAllTraffic(ctx context.Context) error {
+	mu.Lock()
+	defer mu.Unlock()
+	errs := &errlist.List{}
+	for ate, ix := range ixias {
+		if err := ix.StopAllTraffic(ctx); err != nil {
+			errs.Add(errors.Wrapf(err, "error stopping traffic of ATE %s", ate.Name))
+		}
+	}
+	return errs.Err()
+}
+
+// ateWithIntf returns the name of an ATE other than ix whose pushed topology
+// has the named interface, or the empty string if there is none.
+func ateWithIntf(ix *ixATE, intfName string) string {
+	mu.Lock()
+	defer mu.Unlock()
+	for ate, other := range ixias {
+		if other == ix {
+			continue
+		}
+		if _, ok := other.intfs[intfName]; ok {
+			return ate.Name
+		}
+	}
+	return ""
+}
+
+// SetLAGMemberState sets the state of a member port of a LAG on the ATE.
+func SetLAGMemberState(ctx context.Context, ate *binding.ATE, lag, port string, enabled bool) error {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return err
+	}
+	return ix.SetLAGMemberState(ctx, lag, port, enabled)
+}
+
+// FetchIxNetworkSession returns the IxNetwork session used to control an ATE.
+func FetchIxNetworkSession(ctx context.Context, ate *binding.ATE) (*ixweb.Session, error) {
+	ix, err := ixiaForATE(ctx, ate)
+	if err != nil {
+		return nil, err
+	}
+	return ix.IxNetworkSession(), nil
+}
+
 // DialGNMI constructs and returns a GNMI client for the Ixia.
 func DialGNMI(ctx context.Context, ate *binding.ATE, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
 	ix, err := ixiaForATE(ctx, ate)
@@ -197,6 +668,8 @@ func validateInterfaces(ate *binding.ATE, ifs []*opb.InterfaceConfig) error {
 		return usererr.New("zero interfaces to configure, need at least one")
 	}
 	intfs := make(map[string]bool)
+	// Interfaces that set neighbor solicitation settings, by port or LAG.
+	nsIntfs := make(map[string]*opb.InterfaceConfig)
 
 	for _, i := range ifs {
 		if i.GetPort() == "" && i.GetLag() == "" {
@@ -222,6 +695,19 @@ func validateInterfaces(ate *binding.ATE, ifs []*opb.InterfaceConfig) error {
 		if err := validateIP(i.GetIpv6(), "ipv6 on "+i.GetName()); err != nil {
 			return err
 		}
+		if nd := i.GetIpv6().GetNeighborDiscovery(); nd.GetNsCount() != 0 || nd.GetNsRetransmitMs() != 0 {
+			link := "port " + i.GetPort()
+			if i.GetLag() != "" {
+				link = "lag " + i.GetLag()
+			}
+			if other, ok := nsIntfs[link]; ok {
+				otherND := other.GetIpv6().GetNeighborDiscovery()
+				if nd.GetNsCount() != otherND.GetNsCount() || nd.GetNsRetransmitMs() != otherND.GetNsRetransmitMs() {
+					return usererr.New("interfaces %s and %s on %s have different neighbor solicitation settings", other.GetName(), i.GetName(), link)
+				}
+			}
+			nsIntfs[link] = i
+		}
 	}
 	return nil
 }
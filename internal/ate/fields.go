@@ -92,6 +92,17 @@ func setUintRangeField(field *ixconfig.TrafficField, r *opb.UIntRange) error {
 		field.Auto = ixconfig.Bool(true)
 		return nil
 	}
+	if len(r.GetValues()) > 0 {
+		if r.GetCount() != 0 || r.GetRandom() {
+			return usererr.New("values in range cannot be set with a count or random values")
+		}
+		var vals []string
+		for _, v := range r.GetValues() {
+			vals = append(vals, *uintToStr(v))
+		}
+		setList(field, vals)
+		return nil
+	}
 	if r.GetCount() == 0 {
 		return usererr.New("count in range is not set or zero")
 	}
@@ -131,6 +142,18 @@ func setUintRangeField(field *ixconfig.TrafficField, r *opb.UIntRange) error {
 }
 
 func setAddrRangeField(field *ixconfig.TrafficField, t addrType, r *opb.AddressRange) error {
+	if len(r.GetValues()) > 0 {
+		if r.GetCount() != 0 || r.GetRandom() {
+			return usererr.New("values in range cannot be set with a count or random values")
+		}
+		for _, v := range r.GetValues() {
+			if _, err := parseAddr(v, t); err != nil {
+				return errors.Wrapf(err, "value %q in range is invalid", v)
+			}
+		}
+		setList(field, r.GetValues())
+		return nil
+	}
 	step, err := addrRangeToStep(r, t)
 	if err != nil {
 		return err
@@ -85,6 +85,15 @@ func TestSetUintRangeField(t *testing.T) {
 			StepValue:  ixconfig.String("5"),
 			Seed:       uintToStr(fakeSeed),
 		},
+	}, {
+		desc: "value list",
+		ints: &opb.UIntRange{Min: 1, Max: 65535, Values: []uint32{80, 443, 8080}},
+		want: &ixconfig.TrafficField{
+			Auto:      ixconfig.Bool(false),
+			FullMesh:  ixconfig.Bool(false),
+			ValueType: ixconfig.String("valueList"),
+			ValueList: []string{"80", "443", "8080"},
+		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -120,6 +129,10 @@ func TestSetUintRangeFieldErrors(t *testing.T) {
 		desc:    "count cannot fit, default step",
 		ints:    &opb.UIntRange{Min: 1, Max: 1, Step: 0, Count: 2},
 		wantErr: "cannot fit",
+	}, {
+		desc:    "value list with count",
+		ints:    &opb.UIntRange{Min: 1, Max: 9, Count: 2, Values: []uint32{1, 2}},
+		wantErr: "cannot be set with a count",
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -137,6 +150,16 @@ func TestSetAddrRangeField(t *testing.T) {
 		at    addrType
 		want  *ixconfig.TrafficField
 	}{{
+		desc:  "IPv4 value list",
+		addrs: &opb.AddressRange{Min: "0.0.0.1", Max: "255.255.255.254", Values: []string{"1.2.3.4", "5.6.7.8"}},
+		at:    ipv4AddrType,
+		want: &ixconfig.TrafficField{
+			Auto:      ixconfig.Bool(false),
+			FullMesh:  ixconfig.Bool(false),
+			ValueType: ixconfig.String("valueList"),
+			ValueList: []string{"1.2.3.4", "5.6.7.8"},
+		},
+	}, {
 		desc:  "single MAC-48",
 		addrs: &opb.AddressRange{Min: "01:02:03:04:05:06", Max: "01:02:03:04:05:06", Step: "00:00:00:00:00:01", Count: 1},
 		at:    mac48AddrType,
@@ -290,6 +313,11 @@ func TestAddrRangeToTrafficValueErrors(t *testing.T) {
 		addrs:   &opb.AddressRange{Min: "1.2.3.4", Max: "2.3.4", Step: "0.0.0.1", Count: 1},
 		at:      ipv4AddrType,
 		wantErr: "not an IPv4 address",
+	}, {
+		desc:    "invalid IPv4 value",
+		addrs:   &opb.AddressRange{Values: []string{"1.2.3.4", "2.3.4"}},
+		at:      ipv4AddrType,
+		wantErr: "not an IPv4 address",
 	}, {
 		desc:    "invalid IPv6",
 		addrs:   &opb.AddressRange{Min: "1:2:3:4:5:6:7:8", Max: "2:3:4:5:6:7:8:9", Step: "::z", Count: 1},
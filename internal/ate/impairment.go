@@ -0,0 +1,318 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ate
+
+import (
+	"golang.org/x/net/context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/openconfig/ondatra/binding/ixweb"
+	"github.com/openconfig/ondatra/binding/usererr"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+const (
+	impairmentProfilePath = "impairment/profile"
+	impairmentLinkPath    = "impairment/link"
+	impairmentPatternPath = "classifier/pattern"
+	impairmentApplyOp     = "impairment/operations/apply"
+)
+
+// ixLinks is the list of links returned by IxNetwork when creating or listing
+// objects.
+type ixLinks []struct {
+	Href string `json:"href"`
+}
+
+// impairmentProfile is the IxNetwork representation of an impairment profile.
+type impairmentProfile struct {
+	Name     string   `json:"name"`
+	Enabled  bool     `json:"enabled"`
+	AllLinks bool     `json:"allLinks"`
+	Links    []string `json:"links,omitempty"`
+}
+
+// impairmentPattern is the IxNetwork representation of a pattern of the
+// classifier of an impairment profile, which matches the bytes of a packet at
+// an offset. A profile with patterns impairs only the packets matching all of
+// them.
+type impairmentPattern struct {
+	Name   string `json:"name"`
+	Offset int    `json:"offset"`
+	Value  string `json:"value"`
+	Mask   string `json:"mask"`
+}
+
+// impairmentClassifier is the name and the classifier patterns of a profile.
+type impairmentClassifier struct {
+	name     string
+	patterns []*impairmentPattern
+}
+
+// SetImpairment creates impairment profiles from the given impairment and
+// applies them, replacing any existing profiles of the same impairment.
+func (ix *ixATE) SetImpairment(ctx context.Context, imp *opb.Impairment) error {
+	if imp.GetName() == "" {
+		return usererr.New("impairment must have a name")
+	}
+	for _, pct := range []float32{imp.GetDropPct(), imp.GetDuplicatePct(), imp.GetReorderPct()} {
+		if pct < 0 || pct > 100 {
+			return usererr.New("impairment %q has percentage %v, want between 0 and 100", imp.GetName(), pct)
+		}
+	}
+	var links []string
+	if ports := imp.GetPorts(); len(ports) > 0 {
+		var err error
+		if links, err = ix.impairmentLinks(ctx, ports); err != nil {
+			return err
+		}
+	}
+	// The packets of each flow are impaired by a separate profile, because a
+	// packet must match all patterns of a profile.
+	classifiers := []*impairmentClassifier{{name: imp.GetName()}}
+	if flows := imp.GetFlows(); len(flows) > 0 {
+		classifiers = nil
+		for _, f := range flows {
+			patterns, err := ix.flowPatterns(f)
+			if err != nil {
+				return err
+			}
+			classifiers = append(classifiers, &impairmentClassifier{
+				name:     fmt.Sprintf("%s/%s", imp.GetName(), f.GetName()),
+				patterns: patterns,
+			})
+		}
+	}
+	if err := ix.deleteImpairment(ctx, imp.GetName()); err != nil {
+		return err
+	}
+	for _, c := range classifiers {
+		if err := ix.addImpairmentProfile(ctx, imp, links, c); err != nil {
+			return err
+		}
+	}
+	return ix.applyImpairments(ctx)
+}
+
+// addImpairmentProfile creates an impairment profile of the impairment on the
+// given links, or on all links if there are none, with the given classifier.
+func (ix *ixATE) addImpairmentProfile(ctx context.Context, imp *opb.Impairment, links []string, c *impairmentClassifier) error {
+	profile := impairmentProfile{
+		Name:     c.name,
+		Enabled:  true,
+		AllLinks: len(links) == 0,
+		Links:    links,
+	}
+	rsp := struct{ Links ixLinks }{}
+	if err := ix.c.Session().Post(ctx, impairmentProfilePath, profile, &rsp); err != nil {
+		return errors.Wrapf(err, "could not create impairment profile %q", c.name)
+	}
+	if len(rsp.Links) != 1 {
+		return errors.Errorf("expected one link to impairment profile %q, got %v", c.name, rsp.Links)
+	}
+	profileID := rsp.Links[0].Href
+	if ix.impairmentProfiles == nil {
+		ix.impairmentProfiles = make(map[string][]string)
+	}
+	ix.impairmentProfiles[imp.GetName()] = append(ix.impairmentProfiles[imp.GetName()], profileID)
+
+	for _, p := range c.patterns {
+		if err := ix.c.Session().Post(ctx, path.Join(profileID, impairmentPatternPath), p, nil); err != nil {
+			return errors.Wrapf(err, "could not add %s pattern to impairment profile %q", p.Name, c.name)
+		}
+	}
+	attrs := map[string]map[string]interface{}{}
+	if pct := imp.GetDropPct(); pct > 0 {
+		attrs["drop"] = map[string]interface{}{"enabled": true, "percentTime": pct}
+	}
+	if pct := imp.GetDuplicatePct(); pct > 0 {
+		attrs["duplicate"] = map[string]interface{}{"enabled": true, "percentTime": pct, "duplicateCount": 1}
+	}
+	if pct := imp.GetReorderPct(); pct > 0 {
+		attrs["reorder"] = map[string]interface{}{"enabled": true, "percentTime": pct, "skipCount": imp.GetReorderSkipCount()}
+	}
+	if delay := imp.GetDelayUs(); delay > 0 {
+		attrs["delay"] = map[string]interface{}{"enabled": true, "value": delay, "units": "microseconds"}
+	}
+	if jitter := imp.GetJitterUs(); jitter > 0 {
+		attrs["delayVariation"] = map[string]interface{}{"enabled": true, "uniformSpread": jitter, "units": "microseconds"}
+	}
+	for name, a := range attrs {
+		if err := ix.c.Session().Patch(ctx, path.Join(profileID, name), a); err != nil {
+			return errors.Wrapf(err, "could not set %s of impairment profile %q", name, c.name)
+		}
+	}
+	return nil
+}
+
+// flowPatterns returns the classifier patterns that match the packets of the
+// flow by the source and destination addresses of its first IP header. Only
+// Ethernet and MPLS headers may precede the IP header.
+func (ix *ixATE) flowPatterns(flow *opb.Flow) ([]*impairmentPattern, error) {
+	offset := 0
+	for _, h := range flow.GetHeaders() {
+		switch t := h.GetType().(type) {
+		case *opb.Header_Eth:
+			offset += 14
+			if t.Eth.GetVlanId() != 0 {
+				offset += 4
+			}
+		case *opb.Header_Mpls:
+			offset += 4
+		case *opb.Header_Ipv4:
+			return ix.addrPatterns(flow, t.Ipv4.GetSrcAddr(), t.Ipv4.GetDstAddr(), offset+12, offset+16, false)
+		case *opb.Header_Ipv6:
+			return ix.addrPatterns(flow, t.Ipv6.GetSrcAddr(), t.Ipv6.GetDstAddr(), offset+8, offset+24, true)
+		default:
+			return nil, usererr.New("cannot impair flow %q by its IP header after a %T header", flow.GetName(), t)
+		}
+	}
+	return nil, usererr.New("cannot impair flow %q without an IP header", flow.GetName())
+}
+
+// addrPatterns returns the patterns that match the source and destination
+// addresses of the flow at the given offsets.
+func (ix *ixATE) addrPatterns(flow *opb.Flow, src, dst *opb.AddressRange, srcOffset, dstOffset int, isV6 bool) ([]*impairmentPattern, error) {
+	srcIP, err := ix.flowAddr(flow, src, flow.GetSrcEndpoints(), isV6)
+	if err != nil {
+		return nil, err
+	}
+	dstIP, err := ix.flowAddr(flow, dst, flow.GetDstEndpoints(), isV6)
+	if err != nil {
+		return nil, err
+	}
+	pattern := func(name string, offset int, ip net.IP) *impairmentPattern {
+		return &impairmentPattern{
+			Name:   name,
+			Offset: offset,
+			Value:  hex.EncodeToString(ip),
+			Mask:   strings.Repeat("ff", len(ip)),
+		}
+	}
+	return []*impairmentPattern{
+		pattern("source address", srcOffset, srcIP),
+		pattern("destination address", dstOffset, dstIP),
+	}, nil
+}
+
+// flowAddr returns the single address of the range, or the address of the
+// single interface endpoint if the range is not set.
+func (ix *ixATE) flowAddr(flow *opb.Flow, r *opb.AddressRange, eps []*opb.Flow_Endpoint, isV6 bool) (net.IP, error) {
+	var addr string
+	switch {
+	case r.GetMin() == "":
+		if len(eps) == 1 && eps[0].GetGenerated() == nil {
+			ic := ix.intfCfgs[eps[0].GetInterfaceName()]
+			cidr := ic.GetIpv4().GetAddressCidr()
+			if isV6 {
+				cidr = ic.GetIpv6().GetAddressCidr()
+			}
+			addr = strings.Split(cidr, "/")[0]
+		}
+	case r.GetCount() <= 1 && (r.GetMax() == "" || r.GetMax() == r.GetMin()) && len(r.GetValues()) == 0 && !r.GetRandom():
+		addr = r.GetMin()
+	}
+	ip := net.ParseIP(addr)
+	if !isV6 {
+		ip = ip.To4()
+	}
+	if ip == nil {
+		return nil, usererr.New("cannot impair flow %q without a single address of each of its endpoints", flow.GetName())
+	}
+	return ip, nil
+}
+
+// ClearImpairment removes the impairment profiles of the impairment with the
+// given name, so that traffic is no longer impaired by it.
+func (ix *ixATE) ClearImpairment(ctx context.Context, name string) error {
+	if _, ok := ix.impairmentProfiles[name]; !ok {
+		return usererr.New("impairment %q does not exist", name)
+	}
+	if err := ix.deleteImpairment(ctx, name); err != nil {
+		return err
+	}
+	return ix.applyImpairments(ctx)
+}
+
+// deleteImpairment deletes the impairment profiles of the impairment with the
+// given name, if any.
+func (ix *ixATE) deleteImpairment(ctx context.Context, name string) error {
+	profileIDs := ix.impairmentProfiles[name]
+	for len(profileIDs) > 0 {
+		if err := ix.c.Session().Delete(ctx, profileIDs[0]); err != nil {
+			ix.impairmentProfiles[name] = profileIDs
+			return errors.Wrapf(err, "could not delete impairment profile of %q", name)
+		}
+		profileIDs = profileIDs[1:]
+	}
+	delete(ix.impairmentProfiles, name)
+	return nil
+}
+
+// impairmentLinks returns the IDs of the impairment links of the given ports.
+// IxNetwork names each impairment link after the vports at its ends, in the
+// form "<vport> - <vport>".
+func (ix *ixATE) impairmentLinks(ctx context.Context, ports []string) ([]string, error) {
+	var links []struct {
+		Name  string  `json:"name"`
+		Links ixLinks `json:"links"`
+	}
+	if err := ix.c.Session().Get(ctx, impairmentLinkPath, &links); err != nil {
+		return nil, errors.Wrap(err, "could not fetch impairment links")
+	}
+	var ids []string
+	for _, port := range ports {
+		vport, ok := ix.ports[port]
+		if !ok {
+			return nil, usererr.New("port %q does not exist in current configuration", port)
+		}
+		var found bool
+		for _, l := range links {
+			if len(l.Links) > 0 && linkHasPort(l.Name, *vport.Name) {
+				ids = append(ids, l.Links[0].Href)
+				found = true
+			}
+		}
+		if !found {
+			return nil, usererr.New("no impairment link found for port %q", port)
+		}
+	}
+	return ids, nil
+}
+
+// linkHasPort returns whether the impairment link with the given name has
+// the vport with the given name at one of its ends.
+func linkHasPort(linkName, vportName string) bool {
+	for _, end := range strings.Split(linkName, " - ") {
+		if strings.TrimSpace(end) == vportName {
+			return true
+		}
+	}
+	return false
+}
+
+func (ix *ixATE) applyImpairments(ctx context.Context) error {
+	args := ixweb.OpArgs{ix.c.Session().AbsPath("impairment")}
+	if err := ix.c.Session().Post(ctx, impairmentApplyOp, args, nil); err != nil {
+		return errors.Wrap(err, "could not apply impairments")
+	}
+	return nil
+}
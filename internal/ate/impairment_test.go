@@ -0,0 +1,238 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ate
+
+import (
+	"golang.org/x/net/context"
+	"errors"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/binding/ixweb"
+	"github.com/openconfig/ondatra/internal/ixconfig"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestSetImpairment(t *testing.T) {
+	const (
+		port      = "1/1"
+		profileID = "/impairment/profile/1"
+	)
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
+	}
+	intfCfgs := map[string]*opb.InterfaceConfig{
+		"intf1": {Ipv4: &opb.IpConfig{AddressCidr: "192.0.2.1/30"}},
+		"intf2": {Ipv4: &opb.IpConfig{AddressCidr: "192.0.2.5/30"}},
+	}
+	ethHdr := &opb.Header{Type: &opb.Header_Eth{&opb.EthernetHeader{}}}
+	vlanHdr := &opb.Header{Type: &opb.Header_Eth{&opb.EthernetHeader{VlanId: 10}}}
+	tests := []struct {
+		desc         string
+		imp          *opb.Impairment
+		postErr      error
+		patchErr     error
+		wantLinks    []string
+		wantPatch    map[string]interface{}
+		wantPatterns []*impairmentPattern
+		wantErr      string
+	}{{
+		desc:    "no name",
+		imp:     &opb.Impairment{DropPct: 10},
+		wantErr: "must have a name",
+	}, {
+		desc:    "bad percentage",
+		imp:     &opb.Impairment{Name: "imp", DuplicatePct: 101},
+		wantErr: "want between 0 and 100",
+	}, {
+		desc:    "unknown port",
+		imp:     &opb.Impairment{Name: "imp", Ports: []string{"2/2"}},
+		wantErr: "does not exist",
+	}, {
+		desc:    "error creating profile",
+		imp:     &opb.Impairment{Name: "imp"},
+		postErr: errors.New("post error"),
+		wantErr: "could not create impairment profile",
+	}, {
+		desc:     "error setting attributes",
+		imp:      &opb.Impairment{Name: "imp", DropPct: 10},
+		patchErr: errors.New("patch error"),
+		wantErr:  "could not set drop",
+	}, {
+		desc: "all links",
+		imp:  &opb.Impairment{Name: "imp", DropPct: 10, DelayUs: 100, JitterUs: 20},
+		wantPatch: map[string]interface{}{
+			profileID + "/drop":           map[string]interface{}{"enabled": true, "percentTime": float32(10)},
+			profileID + "/delay":          map[string]interface{}{"enabled": true, "value": uint32(100), "units": "microseconds"},
+			profileID + "/delayVariation": map[string]interface{}{"enabled": true, "uniformSpread": uint32(20), "units": "microseconds"},
+		},
+	}, {
+		desc:      "port links",
+		imp:       &opb.Impairment{Name: "imp", Ports: []string{port}, DuplicatePct: 5, ReorderPct: 2, ReorderSkipCount: 3},
+		wantLinks: []string{"/impairment/link/1"},
+		wantPatch: map[string]interface{}{
+			profileID + "/duplicate": map[string]interface{}{"enabled": true, "percentTime": float32(5), "duplicateCount": 1},
+			profileID + "/reorder":   map[string]interface{}{"enabled": true, "percentTime": float32(2), "skipCount": uint32(3)},
+		},
+	}, {
+		desc: "flow without IP header",
+		imp: &opb.Impairment{Name: "imp", Flows: []*opb.Flow{{
+			Name:    "flow",
+			Headers: []*opb.Header{ethHdr},
+		}}},
+		wantErr: "without an IP header",
+	}, {
+		desc: "flow with address range",
+		imp: &opb.Impairment{Name: "imp", Flows: []*opb.Flow{{
+			Name: "flow",
+			Headers: []*opb.Header{ethHdr, {Type: &opb.Header_Ipv4{&opb.Ipv4Header{
+				SrcAddr: &opb.AddressRange{Min: "198.51.100.1", Count: 10},
+			}}}},
+		}}},
+		wantErr: "without a single address",
+	}, {
+		desc: "flow",
+		imp: &opb.Impairment{Name: "imp", DropPct: 10, Flows: []*opb.Flow{{
+			Name:         "flow",
+			SrcEndpoints: []*opb.Flow_Endpoint{{InterfaceName: "intf1"}},
+			DstEndpoints: []*opb.Flow_Endpoint{{InterfaceName: "intf2"}},
+			Headers:      []*opb.Header{vlanHdr, {Type: &opb.Header_Ipv4{&opb.Ipv4Header{}}}},
+		}}},
+		wantPatch: map[string]interface{}{
+			profileID + "/drop": map[string]interface{}{"enabled": true, "percentTime": float32(10)},
+		},
+		wantPatterns: []*impairmentPattern{
+			{Name: "source address", Offset: 30, Value: "c0000201", Mask: "ffffffff"},
+			{Name: "destination address", Offset: 34, Value: "c0000205", Mask: "ffffffff"},
+		},
+	}, {
+		desc: "IPv6 flow with header addresses",
+		imp: &opb.Impairment{Name: "imp", DropPct: 10, Flows: []*opb.Flow{{
+			Name: "flow",
+			Headers: []*opb.Header{ethHdr, {Type: &opb.Header_Ipv6{&opb.Ipv6Header{
+				SrcAddr: &opb.AddressRange{Min: "2001:db8::1", Max: "2001:db8::1"},
+				DstAddr: &opb.AddressRange{Min: "2001:db8::2", Count: 1},
+			}}}},
+		}}},
+		wantPatch: map[string]interface{}{
+			profileID + "/drop": map[string]interface{}{"enabled": true, "percentTime": float32(10)},
+		},
+		wantPatterns: []*impairmentPattern{
+			{Name: "source address", Offset: 22, Value: "20010db8000000000000000000000001", Mask: strings.Repeat("ff", 16)},
+			{Name: "destination address", Offset: 38, Value: "20010db8000000000000000000000002", Mask: strings.Repeat("ff", 16)},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{
+				getRsps: map[string]string{
+					impairmentLinkPath: `[
+						{"name": "1/10 - 1/11", "links": [{"href": "/impairment/link/2"}]},
+						{"name": "1/1 - 1/2", "links": [{"href": "/impairment/link/1"}]}
+					]`,
+				},
+				postRsps:  map[string]string{impairmentProfilePath: `{"links": [{"href": "` + profileID + `"}]}`},
+				postErrs:  map[string]error{impairmentProfilePath: test.postErr},
+				patchErrs: map[string]error{profileID + "/drop": test.patchErr},
+				patches:   map[string]interface{}{},
+				posts:     map[string]interface{}{},
+				allPosts:  map[string][]interface{}{},
+			}
+			c := &ixATE{
+				cfg:      cfg,
+				ports:    map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c:        &fakeCfgClient{session: sess},
+				intfCfgs: intfCfgs,
+			}
+			gotErr := c.SetImpairment(context.Background(), test.imp)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("SetImpairment: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, sess.patches); diff != "" {
+				t.Errorf("SetImpairment: unexpected patches (-want +got): %s", diff)
+			}
+			gotLinks := sess.posts[impairmentProfilePath].(impairmentProfile).Links
+			if diff := cmp.Diff(test.wantLinks, gotLinks); diff != "" {
+				t.Errorf("SetImpairment: unexpected profile links (-want +got): %s", diff)
+			}
+			if _, ok := sess.posts[impairmentApplyOp]; !ok {
+				t.Errorf("SetImpairment: impairments not applied")
+			}
+			if diff := cmp.Diff([]string{profileID}, c.impairmentProfiles[test.imp.GetName()]); diff != "" {
+				t.Errorf("SetImpairment: unexpected profile IDs (-want +got): %s", diff)
+			}
+			var gotPatterns []*impairmentPattern
+			for _, p := range sess.allPosts[path.Join(profileID, impairmentPatternPath)] {
+				gotPatterns = append(gotPatterns, p.(*impairmentPattern))
+			}
+			if diff := cmp.Diff(test.wantPatterns, gotPatterns); diff != "" {
+				t.Errorf("SetImpairment: unexpected patterns (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClearImpairment(t *testing.T) {
+	const profileID = "/impairment/profile/1"
+	tests := []struct {
+		desc      string
+		name      string
+		deleteErr error
+		wantErr   string
+	}{{
+		desc:    "unknown impairment",
+		name:    "other",
+		wantErr: "does not exist",
+	}, {
+		desc:      "error deleting profile",
+		name:      "imp",
+		deleteErr: errors.New("delete error"),
+		wantErr:   "could not delete impairment profile",
+	}, {
+		desc: "success",
+		name: "imp",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{
+				deleteErrs: map[string]error{profileID: test.deleteErr},
+				posts:      map[string]interface{}{},
+			}
+			c := &ixATE{
+				c:                  &fakeCfgClient{session: sess},
+				impairmentProfiles: map[string][]string{"imp": {"/impairment/profile/0", profileID}},
+			}
+			gotErr := c.ClearImpairment(context.Background(), test.name)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("ClearImpairment: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if _, ok := c.impairmentProfiles[test.name]; ok {
+				t.Errorf("ClearImpairment: profile %q not removed", test.name)
+			}
+			if diff := cmp.Diff(ixweb.OpArgs{"impairment"}, sess.posts[impairmentApplyOp]); diff != "" {
+				t.Errorf("ClearImpairment: unexpected apply args (-want +got): %s", diff)
+			}
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io/ioutil"
+	"math"
 	"path"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
@@ -31,9 +33,11 @@ import (
 	log "github.com/golang/glog"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/binding/ixweb"
 	"github.com/openconfig/ondatra/binding/usererr"
+	"github.com/openconfig/ondatra/internal/artifacts"
 	"github.com/openconfig/ondatra/internal/ixconfig"
 	"github.com/openconfig/ondatra/internal/ixgnmi"
 
@@ -52,6 +56,29 @@ const (
 	routeTableFormatJuniper routeTableFormat = "juniper"
 
 	importRetries = 5
+	// Maximum number of config nodes in a list imported in a single request.
+	importChunkSize = 1000
+
+	trafficItemStatsCaption = "Traffic Item Statistics"
+	flowStatsCaption        = "Flow Statistics"
+	trafficItemColumn       = "Traffic Item"
+	convergenceTimeColumn   = "CP/DP Convergence Time (ms)"
+	integrityErrorsColumn   = "Data Integrity Errors"
+	minLatencyColumn        = "Store-Forward Min Latency (ns)"
+	avgLatencyColumn        = "Store-Forward Avg Latency (ns)"
+	maxLatencyColumn        = "Store-Forward Max Latency (ns)"
+	jitterColumn            = "Avg Delay Variation (ns)"
+	ptpStatsCaption         = "PTP Per Port"
+	dhcpv4ServerCaption     = "DHCPv4 Server Per Port"
+	dhcpv6ServerCaption     = "DHCPv6 Server Per Port"
+	dhcpPortColumn          = "Port"
+	portNameColumn          = "Port Name"
+	ptpOffsetColumn         = "Offset [ns]"
+	ptpPathDelayColumn      = "Path Delay [ns]"
+	captureDir              = "capture"
+	txFramesColumn          = "Tx Frames"
+	rxFramesColumn          = "Rx Frames"
+	rxPortColumn            = "Rx Port"
 )
 
 var (
@@ -78,8 +105,10 @@ type cfgClient interface {
 	Session() session
 	NodeID(ixconfig.IxiaCfgNode) (string, error)
 	ExportConfig(context.Context) (*ixconfig.Ixnetwork, error)
-	ImportConfig(context.Context, *ixconfig.Ixnetwork, ixconfig.IxiaCfgNode, bool) error
+	ImportConfigChunked(context.Context, *ixconfig.Ixnetwork, ixconfig.IxiaCfgNode, bool, int, func(int, int)) error
 	UpdateIDs(context.Context, *ixconfig.Ixnetwork, ...ixconfig.IxiaCfgNode) error
+	DeleteNodes(context.Context, ...ixconfig.IxiaCfgNode) error
+	LastImportedConfig() *ixconfig.Ixnetwork
 }
 
 type session interface {
@@ -96,6 +125,7 @@ type session interface {
 type files interface {
 	List(context.Context, string) ([]string, error)
 	Upload(context.Context, string, []byte) error
+	Download(context.Context, string) ([]byte, error)
 	Delete(context.Context, string) error
 }
 
@@ -169,6 +199,8 @@ type intf struct {
 	ipv4Loopback      *ixconfig.TopologyIpv4Loopback
 	ipv6Loopback      *ixconfig.TopologyIpv6Loopback
 	rsvpLSPs          map[string]*ixconfig.TopologyRsvpteLsps
+	igmpGroups        *ixconfig.TopologyIgmpMcastIPv4GroupList
+	mldGroups         *ixconfig.TopologyMldMcastIPv6GroupList
 	link              ixconfig.IxiaCfgNode
 	isrToNetworkGroup map[string]*ixconfig.TopologyNetworkGroup
 	netToNetworkGroup map[string]*ixconfig.TopologyNetworkGroup
@@ -192,6 +224,7 @@ func newIxATE(ctx context.Context, name string, ixn *binding.IxNetwork) (*ixATE,
 	if ix.chassisHost == "" {
 		ix.chassisHost = name
 	}
+	ix.chassisMu = chassisLock(ix.chassisHost)
 	ix.resetClientCfg()
 	// Merge the initial config to set global preferences like syslog streaming,
 	// but do not overwrite to avoid intefering with interactive debugging.
@@ -207,6 +240,7 @@ type ixATE struct {
 	name                 string
 	syslogHost           string
 	chassisHost          string
+	chassisMu            *sync.Mutex // Lock of the chassis, shared with the other sessions on it.
 	cfgPushCount         int
 	cfg                  *ixconfig.Ixnetwork
 	routeTableToIxFile   map[string]string // Mapping of route tables (by local path) to IxNetwork file name.
@@ -214,9 +248,14 @@ type ixATE struct {
 	lags                 map[string]*ixconfig.Lag
 	lagPorts             map[*ixconfig.Lag][]*ixconfig.Vport
 	intfs                map[string]*intf
+	intfOrder            []string                        // Names of interfaces in the order they are configured.
+	intfCfgs             map[string]*opb.InterfaceConfig // Last configured interfaces by name.
 	flowToTrafficItem    map[string]*ixconfig.TrafficTrafficItem
 	ingressTrackingFlows []string
 	egressTrackingFlows  []string
+	impairmentProfiles   map[string][]string           // Mapping of impairment name to IxNetwork profile IDs.
+	capturePorts         []string                      // Ports on which a capture is started.
+	portBreakouts        map[string]*opb.Port_Breakout // Breakouts of the breakout ports by port name.
 	// Operational state is updated as needed on successful API calls.
 	operState operState
 
@@ -262,36 +301,60 @@ func (ix *ixATE) resetClientCfg() {
 	ix.lags = make(map[string]*ixconfig.Lag)
 	ix.lagPorts = make(map[*ixconfig.Lag][]*ixconfig.Vport)
 	ix.intfs = make(map[string]*intf)
+	ix.intfOrder = nil
+	ix.intfCfgs = nil
 	ix.resetClientTrafficCfg()
 }
 
-// importConfig is a wrapper around the config client ImportConfig method.
-// It writes configs as test artifacts before pushing.
+// importConfig is a wrapper around the config client ImportConfigChunked method.
+// It writes configs as test artifacts after pushing, if a config directory is set.
+// lockChassis locks the chassis of the session for a config import or traffic
+// operation, so that it does not interleave with those of other sessions on
+// the chassis, and returns a func that unlocks it.
+func (ix *ixATE) lockChassis() func() {
+	if ix.chassisMu == nil {
+		return func() {}
+	}
+	ix.chassisMu.Lock()
+	return ix.chassisMu.Unlock
+}
+
 func (ix *ixATE) importConfig(ctx context.Context, node ixconfig.IxiaCfgNode, overwrite bool, timeout time.Duration) error {
 	ix.cfgPushCount++
-	fileSuffix := ".json"
-	if overwrite {
-		fileSuffix = "_overwrite" + fileSuffix
-	}
-	filePath := fmt.Sprintf("ixnetwork-config-%s-%02d%s", ix.name, ix.cfgPushCount, fileSuffix)
-	defer func() {
-		// Record the pushed config after XPaths have been updated by ix.c.ImportConfig.
-		jsonStr, err := json.MarshalIndent(node, "", "   ")
-		if err != nil {
-			log.Errorf("could not marshal IxNetwork config for logging to file: %v", err)
-		}
-		if err := ioutil.WriteFile(filePath, jsonStr, 0644); err != nil {
-			log.Errorf("could not log IxNetwork config to file: %v", err)
+	if configDir != "" {
+		fileSuffix := ".json"
+		if overwrite {
+			fileSuffix = "_overwrite" + fileSuffix
 		}
-		log.Infof("IxNetwork config logged to file %s", filePath)
-	}()
+		filePath := filepath.Join(configDir, fmt.Sprintf("ixnetwork-config-%s-%02d%s", ix.name, ix.cfgPushCount, fileSuffix))
+		defer func() {
+			// Record the pushed config after XPaths have been updated by ix.c.ImportConfigChunked.
+			jsonStr, err := json.MarshalIndent(node, "", "   ")
+			if err != nil {
+				log.Errorf("could not marshal IxNetwork config for logging to file: %v", err)
+				return
+			}
+			if err := artifacts.Write(ctx, filePath, jsonStr); err != nil {
+				log.Errorf("could not log IxNetwork config to file: %v", err)
+				return
+			}
+			log.Infof("IxNetwork config logged to file %s", filePath)
+		}()
+	}
+
+	if node == ix.cfg && !overwrite {
+		ix.logConfigChanges()
+	}
+
+	unlock := ix.lockChassis()
+	defer unlock()
 
 	const importDelay = 15 * time.Second
 	importCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	for i := 0; i < importRetries; i++ {
-		err := ix.c.ImportConfig(importCtx, ix.cfg, node, overwrite)
+		err := ix.c.ImportConfigChunked(importCtx, ix.cfg, node, overwrite, importChunkSize, logImportProgress)
 		// If no error or if there is an error and the request did not timeout.
 		if err == nil || importCtx.Err() != context.DeadlineExceeded {
 			return err
@@ -304,22 +367,77 @@ func (ix *ixATE) importConfig(ctx context.Context, node ixconfig.IxiaCfgNode, ov
 	return errors.New("timeout importing config")
 }
 
+// logConfigChanges logs the changes to the config since the last import, to
+// help debug unexpected config drift.
+func (ix *ixATE) logConfigChanges() {
+	last := ix.c.LastImportedConfig()
+	if last == nil {
+		return
+	}
+	changes, err := ixconfig.Diff(last, ix.cfg)
+	if err != nil {
+		log.Warningf("could not diff IxNetwork config with last imported config: %v", err)
+		return
+	}
+	log.Infof("Importing IxNetwork config with %d changes since the last import", len(changes))
+	if log.V(1) {
+		for _, c := range changes {
+			log.Infof("IxNetwork config change: %v", c)
+		}
+	}
+}
+
+func logImportProgress(done, total int) {
+	if total > 1 {
+		log.Infof("Imported IxNetwork config chunk %d of %d", done, total)
+	}
+}
+
 func (ix *ixATE) configureTopology(ics []*opb.InterfaceConfig) error {
 	ix.cfg.Topology = nil
-	ifsByLink := groupByLink(ics)
+	ifsByLink := groupByLink(ics, ix.intfOrder)
+	ix.intfOrder = nil
+	ix.intfCfgs = make(map[string]*opb.InterfaceConfig)
+	// Interfaces may override the neighbor solicitation settings of their ports.
+	for _, vport := range ix.ports {
+		setNSOptions(vport, defaultNSCount, defaultNSRetransmitMs)
+	}
 	for _, ifs := range ifsByLink {
 		ix.addTopology(ifs)
 		for _, ifc := range ifs {
+			ix.intfOrder = append(ix.intfOrder, ifc.GetName())
+			ix.intfCfgs[ifc.GetName()] = proto.Clone(ifc).(*opb.InterfaceConfig)
 			// TODO: Add MACsec to the 'golden' ixiajsoncfg PushTopology tests.
 			if err := ix.addMACsecProtocol(ifc); err != nil {
 				return err
 			}
+			if err := ix.addDot1xProtocol(ifc); err != nil {
+				return err
+			}
 			if err := ix.addIPProtocols(ifc); err != nil {
 				return err
 			}
 			if err := ix.addIPLoopbackProtocols(ifc); err != nil {
 				return err
 			}
+			if err := ix.addDHCPProtocols(ifc); err != nil {
+				return err
+			}
+			if err := ix.addBFDProtocols(ifc); err != nil {
+				return err
+			}
+			if err := ix.addPTPProtocol(ifc); err != nil {
+				return err
+			}
+			if err := ix.addMulticastHosts(ifc); err != nil {
+				return err
+			}
+			if err := ix.addPIMProtocols(ifc); err != nil {
+				return err
+			}
+			if err := ix.addLDPProtocols(ifc); err != nil {
+				return err
+			}
 			if err := ix.addISISProtocols(ifc); err != nil {
 				return err
 			}
@@ -337,16 +455,38 @@ func (ix *ixATE) configureTopology(ics []*opb.InterfaceConfig) error {
 	return nil
 }
 
-func groupByLink(ics []*opb.InterfaceConfig) [][]*opb.InterfaceConfig {
+// linkKey returns a key identifying the link of the interface.
+func linkKey(ic *opb.InterfaceConfig) string {
+	switch link := ic.GetLink().(type) {
+	// Add "port/" or "link/" prefix so they are sorted deterministically.
+	case *opb.InterfaceConfig_Port:
+		return "port/" + link.Port
+	case *opb.InterfaceConfig_Lag:
+		return "link/" + link.Lag
+	}
+	return ""
+}
+
+// groupByLink groups the interfaces by link. Interfaces in the specified order
+// come first, in that order, so that the config of interfaces that are already
+// configured keeps its position. Other interfaces and links are sorted by name.
+func groupByLink(ics []*opb.InterfaceConfig, order []string) [][]*opb.InterfaceConfig {
+	rank := make(map[string]int)
+	for i, name := range order {
+		rank[name] = i
+	}
+	rankOf := func(name string) int {
+		if r, ok := rank[name]; ok {
+			return r
+		}
+		return len(order)
+	}
 	linkToIntfs := make(map[string][]*opb.InterfaceConfig)
+	linkRank := make(map[string]int)
 	for _, ic := range ics {
-		var linkStr string
-		switch link := ic.GetLink().(type) {
-		// Add "port/" or "link/" prefix so they are sorted deterministically below.
-		case *opb.InterfaceConfig_Port:
-			linkStr = "port/" + link.Port
-		case *opb.InterfaceConfig_Lag:
-			linkStr = "link/" + link.Lag
+		linkStr := linkKey(ic)
+		if r, ok := linkRank[linkStr]; !ok || rankOf(ic.GetName()) < r {
+			linkRank[linkStr] = rankOf(ic.GetName())
 		}
 		linkToIntfs[linkStr] = append(linkToIntfs[linkStr], ic)
 	}
@@ -354,11 +494,20 @@ func groupByLink(ics []*opb.InterfaceConfig) [][]*opb.InterfaceConfig {
 	for link := range linkToIntfs {
 		links = append(links, link)
 	}
-	sort.Strings(links) // Deterministic ordering for deterministic configs.
+	// Deterministic ordering for deterministic configs.
+	sort.Slice(links, func(i, j int) bool {
+		if ri, rj := linkRank[links[i]], linkRank[links[j]]; ri != rj {
+			return ri < rj
+		}
+		return links[i] < links[j]
+	})
 	var icGroups [][]*opb.InterfaceConfig
 	for _, fp := range links {
 		intfs := linkToIntfs[fp]
 		sort.Slice(intfs, func(i, j int) bool {
+			if ri, rj := rankOf(intfs[i].GetName()), rankOf(intfs[j].GetName()); ri != rj {
+				return ri < rj
+			}
 			return strings.Compare(intfs[i].GetName(), intfs[j].GetName()) < 0
 		})
 		icGroups = append(icGroups, intfs)
@@ -523,6 +672,30 @@ func (ix *ixATE) PushTopology(ctx context.Context, top *opb.Topology) error {
 	return nil
 }
 
+// dryRunTopology translates the topology to the IxNetwork config that
+// PushTopology would import, without making any changes on the Ixia.
+// It resets the client config, so it must not be called on a client that is
+// bound to a session.
+func (ix *ixATE) dryRunTopology(top *opb.Topology) (*ixconfig.Ixnetwork, error) {
+	ix.resetClientCfg()
+	if err := ix.addPorts(top); err != nil {
+		return nil, err
+	}
+	// Update XPaths where PushTopology would import, as later steps refer to them.
+	ix.cfg.UpdateXPaths()
+	if lags := top.GetLags(); len(lags) > 0 {
+		if err := ix.addLAGs(lags); err != nil {
+			return nil, err
+		}
+		ix.cfg.UpdateXPaths()
+	}
+	if err := ix.configureTopology(top.GetInterfaces()); err != nil {
+		return nil, err
+	}
+	ix.cfg.UpdateXPaths()
+	return ix.cfg, nil
+}
+
 // UpdateTopology updates IxNetwork session to the specified topology.
 func (ix *ixATE) UpdateTopology(ctx context.Context, top *opb.Topology) error {
 	if err := ix.updateTopology(ctx, top.GetInterfaces()); err != nil {
@@ -545,6 +718,168 @@ func (ix *ixATE) UpdateTopology(ctx context.Context, top *opb.Topology) error {
 	return nil
 }
 
+// UpdateInterfaces adds and removes interfaces in the IxNetwork session to
+// match the specified interface configs, without changing the config of the
+// other interfaces or restarting their protocols. Interfaces whose config
+// changed since it was last configured are removed and added again. If
+// protocols are running, they are started on the added interfaces.
+func (ix *ixATE) UpdateInterfaces(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	newCfgs := make(map[string]*opb.InterfaceConfig)
+	for _, ifc := range ifs {
+		newCfgs[ifc.GetName()] = ifc
+	}
+	changed := func(name string) bool {
+		old, ok := ix.intfCfgs[name]
+		return !ok || !proto.Equal(old, newCfgs[name])
+	}
+	var removed, added []string
+	for _, name := range ix.intfOrder {
+		if _, ok := newCfgs[name]; !ok || changed(name) {
+			removed = append(removed, name)
+		}
+	}
+	for _, ifc := range ifs {
+		if changed(ifc.GetName()) {
+			added = append(added, ifc.GetName())
+		}
+	}
+	if len(removed) == 0 && len(added) == 0 {
+		return nil
+	}
+	if err := ix.removeInterfaces(ctx, removed); err != nil {
+		return err
+	}
+	// Topologies are rebuilt below, so identify the existing ones by link.
+	existingLinks := make(map[string]bool)
+	for _, name := range ix.intfOrder {
+		existingLinks[linkKey(ix.intfCfgs[name])] = true
+	}
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+
+	// Import new topologies whole and new device groups of existing topologies.
+	var nodes []ixconfig.IxiaCfgNode
+	var addedDGs []ixconfig.IxiaCfgNode
+	importedTopos := make(map[*ixconfig.Topology]bool)
+	hasRouteTables := false
+	for _, name := range added {
+		topo, dg := ix.topologyDeviceGroup(name)
+		addedDGs = append(addedDGs, dg)
+		hasRouteTables = hasRouteTables || len(ix.intfs[name].netToRouteTables) > 0
+		switch {
+		case existingLinks[linkKey(newCfgs[name])]:
+			nodes = append(nodes, dg)
+		case !importedTopos[topo]:
+			importedTopos[topo] = true
+			nodes = append(nodes, topo)
+		}
+	}
+	for _, node := range nodes {
+		if err := ix.importConfig(ctx, node, false, topoImportTimeout); err != nil {
+			return errors.Wrap(err, "could not add interfaces")
+		}
+	}
+	if hasRouteTables {
+		if err := syncRouteTableFilesAndImportFn(ctx, ix); err != nil {
+			return err
+		}
+	}
+	if ix.operState == operStateOff {
+		return nil
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, addedDGs...); err != nil {
+		return errors.Wrap(err, "could not update IDs of added interfaces")
+	}
+	var ids []string
+	for _, dg := range addedDGs {
+		id, err := ix.c.NodeID(dg)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err := ix.c.Session().Post(ctx, "topology/deviceGroup/operations/start", ixweb.OpArgs{ids}, nil); err != nil {
+		return errors.Wrap(err, "could not start protocols on added interfaces")
+	}
+	return nil
+}
+
+// removeInterfaces deletes the named interfaces from the IxNetwork session,
+// along with their topologies if no other interfaces remain on them.
+func (ix *ixATE) removeInterfaces(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	toRemove := make(map[string]bool)
+	for _, name := range names {
+		toRemove[name] = true
+	}
+	remainingDGs := make(map[*ixconfig.Topology]int)
+	for _, topo := range ix.cfg.Topology {
+		remainingDGs[topo] = len(topo.DeviceGroup)
+	}
+	var nodes []ixconfig.IxiaCfgNode
+	var dgs []ixconfig.IxiaCfgNode
+	for _, name := range names {
+		topo, dg := ix.topologyDeviceGroup(name)
+		if topo == nil {
+			return errors.Errorf("interface %q is not configured", name)
+		}
+		remainingDGs[topo]--
+		dgs = append(dgs, dg)
+	}
+	for _, topo := range ix.cfg.Topology {
+		if remainingDGs[topo] == 0 {
+			nodes = append(nodes, topo)
+		}
+	}
+	for i, name := range names {
+		if topo, _ := ix.topologyDeviceGroup(name); remainingDGs[topo] > 0 {
+			nodes = append(nodes, dgs[i])
+		}
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, nodes...); err != nil {
+		return errors.Wrap(err, "could not update IDs of removed interfaces")
+	}
+	if err := ix.c.DeleteNodes(ctx, nodes...); err != nil {
+		return errors.Wrap(err, "could not remove interfaces")
+	}
+	var order []string
+	for _, name := range ix.intfOrder {
+		if !toRemove[name] {
+			order = append(order, name)
+		}
+	}
+	ix.intfOrder = order
+	for name := range toRemove {
+		delete(ix.intfs, name)
+	}
+	return nil
+}
+
+// topologyDeviceGroup returns the topology of the named interface and its
+// device group directly under the topology, or nils if it is not configured.
+func (ix *ixATE) topologyDeviceGroup(name string) (*ixconfig.Topology, *ixconfig.TopologyDeviceGroup) {
+	intf, ok := ix.intfs[name]
+	if !ok {
+		return nil, nil
+	}
+	for _, topo := range ix.cfg.Topology {
+		for _, dg := range topo.DeviceGroup {
+			if dg == intf.deviceGroup {
+				return topo, dg
+			}
+			for _, child := range dg.DeviceGroup {
+				if child == intf.deviceGroup {
+					return topo, dg
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
 type stateRsp interface {
 	Up() bool
 }
@@ -806,6 +1141,293 @@ func (ix *ixATE) SetPortState(ctx context.Context, port string, enabled bool) er
 	return nil
 }
 
+// TakePortOwnership connects the vport of the given port to its chassis port,
+// taking ownership of the chassis port even if it is owned by another user.
+func (ix *ixATE) TakePortOwnership(ctx context.Context, port string) error {
+	if err := ix.postPortsOp(ctx, "vport/operations/connectports", []string{port}, true); err != nil {
+		return errors.Wrapf(err, "error taking ownership of port %q", port)
+	}
+	return nil
+}
+
+// ReleasePort releases the chassis port of the given port, so that it may be
+// used by other IxNetwork sessions.
+func (ix *ixATE) ReleasePort(ctx context.Context, port string) error {
+	if err := ix.postPortsOp(ctx, "vport/operations/releaseport", []string{port}); err != nil {
+		return errors.Wrapf(err, "error releasing port %q", port)
+	}
+	return nil
+}
+
+// RebootPortCPU reboots the CPU of the chassis port of the given port, such as
+// to recover a port that is stuck.
+func (ix *ixATE) RebootPortCPU(ctx context.Context, port string) error {
+	if err := ix.postPortsOp(ctx, "vport/operations/resetportcpu", []string{port}); err != nil {
+		return errors.Wrapf(err, "error rebooting CPU of port %q", port)
+	}
+	return nil
+}
+
+// ReleasePorts releases the chassis ports of all configured ports.
+func (ix *ixATE) ReleasePorts(ctx context.Context) error {
+	if len(ix.ports) == 0 {
+		return nil
+	}
+	ports := make([]string, 0, len(ix.ports))
+	for port := range ix.ports {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+	if err := ix.postPortsOp(ctx, "vport/operations/releaseport", ports); err != nil {
+		return errors.Wrapf(err, "error releasing ports %v", ports)
+	}
+	return nil
+}
+
+// postPortsOp posts an operation on the vports of the given ports, with the
+// list of vport IDs as the first argument followed by the given arguments.
+func (ix *ixATE) postPortsOp(ctx context.Context, op string, ports []string, args ...interface{}) error {
+	var vports []ixconfig.IxiaCfgNode
+	for _, port := range ports {
+		vport, ok := ix.ports[port]
+		if !ok {
+			return usererr.New("port %q does not exist in current configuration", port)
+		}
+		vports = append(vports, vport)
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, vports...); err != nil {
+		return errors.Wrap(err, "could not fetch IDs for vports")
+	}
+	var vportIDs []string
+	for _, vport := range vports {
+		vportID, err := ix.c.NodeID(vport)
+		if err != nil {
+			return err
+		}
+		vportIDs = append(vportIDs, vportID)
+	}
+	return ix.c.Session().Post(ctx, op, append(ixweb.OpArgs{vportIDs}, args...), nil)
+}
+
+// SetPortLoopback sets the loopback mode of a port.
+func (ix *ixATE) SetPortLoopback(ctx context.Context, port string, mode opb.PortLoopbackMode) error {
+	var attrs map[string]interface{}
+	switch mode {
+	case opb.PortLoopbackMode_PORT_LOOPBACK_MODE_NONE:
+		attrs = map[string]interface{}{"loopback": false}
+	case opb.PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL:
+		attrs = map[string]interface{}{"loopback": true, "loopbackMode": "internalLoopback"}
+	case opb.PortLoopbackMode_PORT_LOOPBACK_MODE_LINE:
+		attrs = map[string]interface{}{"loopback": true, "loopbackMode": "lineLoopback"}
+	default:
+		return errors.Errorf("unrecognized port loopback mode %s", mode)
+	}
+	if err := ix.patchL1Config(ctx, port, attrs); err != nil {
+		return errors.Wrapf(err, "error setting loopback mode for %q", port)
+	}
+	return nil
+}
+
+// SetPortLinkFault starts or stops signaling a link fault from a port to its
+// link partner, by inserting local or remote fault ordered sets.
+func (ix *ixATE) SetPortLinkFault(ctx context.Context, port string, fault opb.PortLinkFault) error {
+	var attrs map[string]interface{}
+	switch fault {
+	case opb.PortLinkFault_PORT_LINK_FAULT_NONE:
+		attrs = map[string]interface{}{"startErrorInsertion": false}
+	case opb.PortLinkFault_PORT_LINK_FAULT_LOCAL:
+		attrs = map[string]interface{}{"sendSetsMode": "typeAOnly", "typeAOrderedSets": "localFault", "startErrorInsertion": true}
+	case opb.PortLinkFault_PORT_LINK_FAULT_REMOTE:
+		attrs = map[string]interface{}{"sendSetsMode": "typeAOnly", "typeAOrderedSets": "remoteFault", "startErrorInsertion": true}
+	default:
+		return errors.Errorf("unrecognized port link fault %s", fault)
+	}
+	if err := ix.patchL1Config(ctx, port, attrs); err != nil {
+		return errors.Wrapf(err, "error setting link fault for %q", port)
+	}
+	return nil
+}
+
+// l1Speeds maps port speeds in Gbps to IxNetwork L1 speeds.
+var l1Speeds = map[uint32]string{
+	10:  "speed10g",
+	25:  "speed25g",
+	40:  "speed40g",
+	50:  "speed50g",
+	100: "speed100g",
+	200: "speed200g",
+	400: "speed400g",
+}
+
+// SetPortL1Config sets the speed, FEC mode, and auto-negotiation of a port,
+// leaving the unspecified settings unchanged.
+func (ix *ixATE) SetPortL1Config(ctx context.Context, port string, l1 *opb.PortL1Config) error {
+	attrs := make(map[string]interface{})
+	if speed := l1.GetSpeedGbps(); speed != 0 {
+		s, ok := l1Speeds[speed]
+		if !ok {
+			return usererr.New("unsupported port speed %d Gbps", speed)
+		}
+		attrs["speed"] = s
+	}
+	switch l1.GetFecMode() {
+	case opb.PortL1Config_FEC_MODE_UNSPECIFIED:
+	case opb.PortL1Config_FEC_MODE_NONE:
+		attrs["forceDisableFEC"] = true
+		attrs["enableRsFec"] = false
+		attrs["rsFecForceOn"] = false
+		attrs["firecodeForceOn"] = false
+	case opb.PortL1Config_FEC_MODE_RS:
+		attrs["forceDisableFEC"] = false
+		attrs["enableRsFec"] = true
+		attrs["rsFecForceOn"] = true
+		attrs["firecodeForceOn"] = false
+	case opb.PortL1Config_FEC_MODE_FIRECODE:
+		attrs["forceDisableFEC"] = false
+		attrs["enableRsFec"] = false
+		attrs["rsFecForceOn"] = false
+		attrs["firecodeForceOn"] = true
+	default:
+		return errors.Errorf("unrecognized FEC mode %s", l1.GetFecMode())
+	}
+	switch l1.GetAutoNegotiation() {
+	case opb.PortL1Config_AUTO_NEGOTIATION_UNSPECIFIED:
+	case opb.PortL1Config_AUTO_NEGOTIATION_ENABLED:
+		attrs["enableAutoNegotiation"] = true
+	case opb.PortL1Config_AUTO_NEGOTIATION_DISABLED:
+		attrs["enableAutoNegotiation"] = false
+	default:
+		return errors.Errorf("unrecognized auto-negotiation setting %s", l1.GetAutoNegotiation())
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+	if l1.GetFecMode() != opb.PortL1Config_FEC_MODE_UNSPECIFIED || l1.GetAutoNegotiation() != opb.PortL1Config_AUTO_NEGOTIATION_UNSPECIFIED {
+		// The IEEE defaults override the FEC and auto-negotiation settings.
+		attrs["ieeeL1Defaults"] = false
+	}
+	if err := ix.patchL1Config(ctx, port, attrs); err != nil {
+		return errors.Wrapf(err, "error setting L1 config for %q", port)
+	}
+	return nil
+}
+
+// patchL1Config patches the attributes of the L1 config of the current card
+// type of a port.
+func (ix *ixATE) patchL1Config(ctx context.Context, port string, attrs map[string]interface{}) error {
+	vport, ok := ix.ports[port]
+	if !ok {
+		return usererr.New("port %q does not exist in current configuration", port)
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, vport); err != nil {
+		return errors.Wrapf(err, "could not fetch ID for vport for %q", port)
+	}
+	vportID, err := ix.c.NodeID(vport)
+	if err != nil {
+		return err
+	}
+	l1Path := path.Join(vportID, "l1Config")
+	l1 := struct{ CurrentType string }{}
+	if err := ix.c.Session().Get(ctx, l1Path, &l1); err != nil {
+		return errors.Wrap(err, "could not fetch L1 config type")
+	}
+	return ix.c.Session().Patch(ctx, path.Join(l1Path, l1.CurrentType), attrs)
+}
+
+// StartCapture starts capturing the frames received on the given ports, such
+// as the responses of a DUT to crafted packets, discarding any frames captured
+// by a previous capture.
+func (ix *ixATE) StartCapture(ctx context.Context, ports []string) error {
+	if len(ports) == 0 {
+		return usererr.New("no ports to capture on")
+	}
+	var vports []ixconfig.IxiaCfgNode
+	for _, port := range ports {
+		vport, ok := ix.ports[port]
+		if !ok {
+			return usererr.New("port %q does not exist in current configuration", port)
+		}
+		vports = append(vports, vport)
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, vports...); err != nil {
+		return errors.Wrap(err, "could not fetch IDs for vports")
+	}
+	for i, vport := range vports {
+		vportID, err := ix.c.NodeID(vport)
+		if err != nil {
+			return err
+		}
+		if err := ix.c.Session().Patch(ctx, path.Join(vportID, "capture"), map[string]interface{}{"hardwareEnabled": true}); err != nil {
+			return errors.Wrapf(err, "could not enable capture on port %q", ports[i])
+		}
+	}
+	if err := ix.c.Session().Post(ctx, "operations/startcapture", ixweb.OpArgs{}, nil); err != nil {
+		return errors.Wrap(err, "could not start capture")
+	}
+	ix.capturePorts = ports
+	return nil
+}
+
+// StopCapture stops the capture started by StartCapture and returns the
+// captured frames of each port in pcap format, keyed by port name.
+func (ix *ixATE) StopCapture(ctx context.Context) (map[string][]byte, error) {
+	if len(ix.capturePorts) == 0 {
+		return nil, usererr.New("no capture started")
+	}
+	if err := ix.c.Session().Post(ctx, "operations/stopcapture", ixweb.OpArgs{}, nil); err != nil {
+		return nil, errors.Wrap(err, "could not stop capture")
+	}
+	globals := struct{ PersistencePath string }{}
+	if err := ix.c.Session().Get(ctx, "globals", &globals); err != nil {
+		return nil, errors.Wrap(err, "could not fetch persistence path")
+	}
+	if err := ix.c.Session().Post(ctx, "operations/savecapturefiles", ixweb.OpArgs{path.Join(globals.PersistencePath, captureDir)}, nil); err != nil {
+		return nil, errors.Wrap(err, "could not save capture files")
+	}
+	captures := make(map[string][]byte)
+	for _, port := range ix.capturePorts {
+		vport, ok := ix.ports[port]
+		if !ok {
+			return nil, usererr.New("port %q does not exist in current configuration", port)
+		}
+		fn := path.Join(captureDir, captureFileName(*vport.Name))
+		b, err := ix.c.Session().Files().Download(ctx, fn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not download capture file %q of port %q", fn, port)
+		}
+		captures[port] = b
+	}
+	ix.capturePorts = nil
+	return captures, nil
+}
+
+// captureFileName returns the name of the file to which IxNetwork saves the
+// hardware capture of the vport with the given name.
+func captureFileName(vportName string) string {
+	return strings.ReplaceAll(vportName, "/", "_") + "_HW.cap"
+}
+
+// SetLAGMemberState sets the state of a member port of a LAG, such as to flap
+// a single member while the rest of the LAG stays up.
+func (ix *ixATE) SetLAGMemberState(ctx context.Context, lagName, port string, enabled bool) error {
+	lag, ok := ix.lags[lagName]
+	if !ok {
+		return usererr.New("LAG %q does not exist in current configuration", lagName)
+	}
+	vport := ix.ports[port]
+	var isMember bool
+	for _, p := range ix.lagPorts[lag] {
+		if vport != nil && p == vport {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return usererr.New("port %q is not a member of LAG %q", port, lagName)
+	}
+	return ix.SetPortState(ctx, port, enabled)
+}
+
 func resolveMacs(ctx context.Context, ix *ixATE) error {
 	var nodes []ixconfig.IxiaCfgNode
 	for _, intf := range ix.intfs {
@@ -886,22 +1508,29 @@ func genTraffic(ctx context.Context, ix *ixATE) error {
 		}
 		tiIDs = append(tiIDs, tiID)
 	}
+	unlock := ix.lockChassis()
+	defer unlock()
 	if err := ix.c.Session().Post(ctx, "traffic/trafficItem/operations/generate", ixweb.OpArgs{tiIDs}, nil); err != nil {
 		return errors.Wrap(err, "could not generate traffic flows")
 	}
 	return nil
 }
 
+// hlsSuffix is the path of the high-level stream of a traffic item.
+const hlsSuffix = "highLevelStream/1"
+
 // updateFlows updates frame size/rate configuration for flows after generation.
 // Assumes that IxNetwork traffic items corresponding to the flows have updated
 // REST IDs.
 func updateFlows(ctx context.Context, ix *ixATE, flows []*opb.Flow) error {
-	const hlsSuffix = "highLevelStream/1"
 	for _, f := range flows {
 		ti := ix.flowToTrafficItem[f.GetName()]
 		if ti == nil {
 			return usererr.New("flow %q does not exist", f.GetName())
 		}
+		if ti.AppLibProfile != nil {
+			return usererr.New("cannot update flow %q since it has application traffic", f.GetName())
+		}
 		tiID, err := ix.c.NodeID(ti)
 		if err != nil {
 			return err
@@ -924,21 +1553,34 @@ func updateFlows(ctx context.Context, ix *ixATE, flows []*opb.Flow) error {
 			}
 		}
 
-		_, frMap, err := frameRate(f.GetFrameRate())
-		if err != nil {
-			return errors.Wrapf(err, "could not compute new frame rate for flow %q", f.GetName())
+		if err := ix.patchFrameRate(ctx, tiID, f); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if frMap != nil {
-			if err := ix.c.Session().Patch(ctx, path.Join(tiID, hlsSuffix, "frameRate"), frMap); err != nil {
-				return errors.Wrapf(err, "could not patch frame rate for flow %q", f.GetName())
-			}
-		}
+// patchFrameRate patches the frame rate of the high-level stream of a traffic
+// item, if the flow specifies a frame rate. The rate type is patched as well,
+// so that a running flow can switch between rate types.
+func (ix *ixATE) patchFrameRate(ctx context.Context, tiID string, f *opb.Flow) error {
+	fr, frMap, err := frameRate(f.GetFrameRate())
+	if err != nil {
+		return errors.Wrapf(err, "could not compute new frame rate for flow %q", f.GetName())
+	}
+	if frMap == nil {
+		return nil
+	}
+	frMap["type"] = *(fr.Type_)
+	if err := ix.c.Session().Patch(ctx, path.Join(tiID, hlsSuffix, "frameRate"), frMap); err != nil {
+		return errors.Wrapf(err, "could not patch frame rate for flow %q", f.GetName())
 	}
 	return nil
 }
 
 func startTraffic(ctx context.Context, ix *ixATE) error {
+	unlock := ix.lockChassis()
+	defer unlock()
 	trafficArgs := ixweb.OpArgs{ix.c.Session().AbsPath("traffic")}
 	if err := ix.c.Session().Post(ctx, "traffic/operations/apply", trafficArgs, nil); err != nil {
 		return errors.Wrap(err, "could not apply traffic config")
@@ -965,6 +1607,9 @@ func (ix *ixATE) StartTraffic(ctx context.Context, flows []*opb.Flow) error {
 		log.Infof("Traffic already running, not running operation on Ixia.")
 		return nil
 	}
+	if err := ix.validateTraffic(flows); err != nil {
+		return err
+	}
 	ix.resetClientTrafficCfg()
 	if err := resetIxiaTrafficCfgFn(ctx, ix); err != nil {
 		return errors.Wrap(err, "could not reset traffic config on Ixia")
@@ -1020,9 +1665,40 @@ func (ix *ixATE) UpdateTraffic(ctx context.Context, flows []*opb.Flow) error {
 	return nil
 }
 
+// UpdateFrameRates changes the frame rates of running traffic flows on the fly,
+// without stopping or regenerating traffic.
+func (ix *ixATE) UpdateFrameRates(ctx context.Context, flows []*opb.Flow) error {
+	if ix.operState != operStateTrafficOn {
+		return usererr.New("cannot update frame rates before traffic has been started")
+	}
+	for _, f := range flows {
+		if f.GetFrameRate().GetType() == nil {
+			return usererr.New("no frame rate specified for flow %q", f.GetName())
+		}
+		ti := ix.flowToTrafficItem[f.GetName()]
+		if ti == nil {
+			return usererr.New("flow %q does not exist", f.GetName())
+		}
+		if ti.AppLibProfile != nil {
+			return usererr.New("cannot update flow %q since it has application traffic", f.GetName())
+		}
+		tiID, err := ix.c.NodeID(ti)
+		if err != nil {
+			return err
+		}
+		if err := ix.patchFrameRate(ctx, tiID, f); err != nil {
+			return errors.Wrap(err, "could not update frame rates of running traffic flows")
+		}
+	}
+	return nil
+}
+
 func (ix *ixATE) stopAllTraffic(ctx context.Context) error {
 	trafficArgs := ixweb.OpArgs{ix.c.Session().AbsPath("traffic")}
-	if err := ix.c.Session().Post(ctx, "traffic/operations/stop", trafficArgs, nil); err != nil {
+	unlock := ix.lockChassis()
+	err := ix.c.Session().Post(ctx, "traffic/operations/stop", trafficArgs, nil)
+	unlock()
+	if err != nil {
 		return errors.Wrap(err, "could not stop traffic")
 	}
 	// Wait a sufficient amount of time to ensure that traffic is stopped.
@@ -1043,18 +1719,52 @@ func (ix *ixATE) StopAllTraffic(ctx context.Context) error {
 	return nil
 }
 
-// DialGNMI constructs and returns a GNMI client for the Ixia.
-func (ix *ixATE) DialGNMI(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
-	ix.mu.Lock()
-	defer ix.mu.Unlock()
-	if ix.gclient != nil {
-		return nil, errors.Errorf("GNMI client for Ixia %s already dialed", ix.name)
+// AwaitTrafficStopped waits for traffic that stops on its own, such as traffic
+// with a fixed packet count or duration, to finish transmitting.
+func (ix *ixATE) AwaitTrafficStopped(ctx context.Context, timeout time.Duration) error {
+	if ix.operState != operStateTrafficOn {
+		return usererr.New("cannot await traffic stop when traffic is not running")
+	}
+	const retryWait = 5 * time.Second
+	traffic := struct{ State string }{}
+	stopped := func() (bool, error) {
+		if err := ix.c.Session().Get(ctx, "traffic", &traffic); err != nil {
+			return false, errors.Wrap(err, "could not fetch traffic to check traffic state")
+		}
+		return traffic.State == "stopped", nil
+	}
+	stop, err := stopped()
+	for i := 0; i < int(timeout/retryWait) && err == nil && !stop; i++ {
+		sleepFn(retryWait)
+		stop, err = stopped()
 	}
-	gclient, err := ixgnmi.NewClient(ctx, ix.name, ix.readStats, unwrapClient(ix.c), opts...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	ix.gclient = gclient
+	if !stop {
+		return errors.Errorf("traffic did not stop within %v, last state was %q", timeout, traffic.State)
+	}
+	ix.operState = operStateProtocolsOn
+	return nil
+}
+
+// IxNetworkSession returns the underlying IxNetwork session.
+func (ix *ixATE) IxNetworkSession() *ixweb.Session {
+	return unwrapClient(ix.c).Session()
+}
+
+// DialGNMI constructs and returns a GNMI client for the Ixia.
+func (ix *ixATE) DialGNMI(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if ix.gclient != nil {
+		return nil, errors.Errorf("GNMI client for Ixia %s already dialed", ix.name)
+	}
+	gclient, err := ixgnmi.NewClient(ctx, ix.name, ix.readStats, unwrapClient(ix.c), opts...)
+	if err != nil {
+		return nil, err
+	}
+	ix.gclient = gclient
 	return gclient, nil
 }
 
@@ -1096,6 +1806,763 @@ func (ix *ixATE) readStats(ctx context.Context, captions []string) (*ixgnmi.Stat
 	}, nil
 }
 
+// FetchConvergenceTimes returns the control plane/data plane convergence times
+// of the traffic items that measure convergence, keyed by traffic item name.
+func (ix *ixATE) FetchConvergenceTimes(ctx context.Context) (map[string]time.Duration, error) {
+	stats, err := ix.readStats(ctx, []string{trafficItemStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	times := make(map[string]time.Duration)
+	for _, row := range stats.Tables[trafficItemStatsCaption] {
+		ms := row[convergenceTimeColumn]
+		if ms == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(ms, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected convergence time %q for traffic item %q", ms, row[trafficItemColumn])
+		}
+		times[row[trafficItemColumn]] = time.Duration(v * float64(time.Millisecond))
+	}
+	return times, nil
+}
+
+// FlowLatency is the store-and-forward latency and jitter of a traffic item.
+type FlowLatency struct {
+	Min, Avg, Max time.Duration
+	// Jitter is the average variation of the latency.
+	Jitter time.Duration
+}
+
+// FetchFlowLatencies returns the store-and-forward latency and jitter of the
+// traffic items that measure latency, keyed by traffic item name.
+func (ix *ixATE) FetchFlowLatencies(ctx context.Context) (map[string]*FlowLatency, error) {
+	stats, err := ix.readStats(ctx, []string{trafficItemStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	toDuration := func(row map[string]string, col string) (time.Duration, error) {
+		if row[col] == "" {
+			return 0, nil
+		}
+		ns, err := strconv.ParseUint(row[col], 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unexpected %q value %q for traffic item %q", col, row[col], row[trafficItemColumn])
+		}
+		return time.Duration(ns), nil
+	}
+	latencies := make(map[string]*FlowLatency)
+	for _, row := range stats.Tables[trafficItemStatsCaption] {
+		if row[trafficItemColumn] == "" {
+			return nil, errors.Errorf("no traffic item in %q view row %v", trafficItemStatsCaption, row)
+		}
+		// Traffic items that do not measure latency have no latency values.
+		if row[avgLatencyColumn] == "" {
+			continue
+		}
+		minLat, err := toDuration(row, minLatencyColumn)
+		if err != nil {
+			return nil, err
+		}
+		avgLat, err := toDuration(row, avgLatencyColumn)
+		if err != nil {
+			return nil, err
+		}
+		maxLat, err := toDuration(row, maxLatencyColumn)
+		if err != nil {
+			return nil, err
+		}
+		jitter, err := toDuration(row, jitterColumn)
+		if err != nil {
+			return nil, err
+		}
+		latencies[row[trafficItemColumn]] = &FlowLatency{Min: minLat, Avg: avgLat, Max: maxLat, Jitter: jitter}
+	}
+	return latencies, nil
+}
+
+// DHCPServerLeases are the lease counts of the emulated DHCP servers on a port.
+type DHCPServerLeases struct {
+	// Current is the number of leases currently allocated to clients.
+	Current uint64
+	// Allocated and Renewed are the total numbers of leases allocated and
+	// renewed since the servers started.
+	Allocated, Renewed uint64
+}
+
+// FetchDHCPServerLeases returns the lease counts of the emulated DHCPv4
+// servers, or the DHCPv6 servers if isV6 is true, keyed by port name.
+func (ix *ixATE) FetchDHCPServerLeases(ctx context.Context, isV6 bool) (map[string]*DHCPServerLeases, error) {
+	caption := dhcpv4ServerCaption
+	currentCol, allocatedCol, renewedCol := "Current Leases Allocated", "Total Leases Allocated", "Total Leases Renewed"
+	if isV6 {
+		caption = dhcpv6ServerCaption
+		currentCol, allocatedCol, renewedCol = "Current Prefixes Allocated", "Total Prefixes Allocated", "Total Prefixes Renewed"
+	}
+	stats, err := ix.readStats(ctx, []string{caption})
+	if err != nil {
+		return nil, err
+	}
+	toCount := func(row map[string]string, col string) (uint64, error) {
+		if row[col] == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseUint(row[col], 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unexpected %q value %q for port %q", col, row[col], row[dhcpPortColumn])
+		}
+		return n, nil
+	}
+	leases := make(map[string]*DHCPServerLeases)
+	for _, row := range stats.Tables[caption] {
+		// The port column holds the chassis name followed by the port name.
+		parts := strings.SplitN(row[dhcpPortColumn], "/", 2)
+		if len(parts) < 2 || parts[1] == "" {
+			return nil, errors.Errorf("unexpected port %q in %q view, want [chassis]/[port]", row[dhcpPortColumn], caption)
+		}
+		current, err := toCount(row, currentCol)
+		if err != nil {
+			return nil, err
+		}
+		allocated, err := toCount(row, allocatedCol)
+		if err != nil {
+			return nil, err
+		}
+		renewed, err := toCount(row, renewedCol)
+		if err != nil {
+			return nil, err
+		}
+		leases[parts[1]] = &DHCPServerLeases{Current: current, Allocated: allocated, Renewed: renewed}
+	}
+	return leases, nil
+}
+
+// FetchIntegrityErrors returns the number of received frames that failed the
+// payload integrity check, keyed by traffic item name. Only traffic items are
+// included for which the statistic is reported, which requires an integrity
+// check to be enabled on some traffic item.
+func (ix *ixATE) FetchIntegrityErrors(ctx context.Context) (map[string]uint64, error) {
+	stats, err := ix.readStats(ctx, []string{trafficItemStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	errs := make(map[string]uint64)
+	for _, row := range stats.Tables[trafficItemStatsCaption] {
+		n := row[integrityErrorsColumn]
+		if n == "" {
+			continue
+		}
+		v, err := strconv.ParseUint(n, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected integrity errors %q for traffic item %q", n, row[trafficItemColumn])
+		}
+		errs[row[trafficItemColumn]] = v
+	}
+	return errs, nil
+}
+
+// PTPMeasurement is the offset from the master clock and the mean path delay
+// to it, as measured by an emulated PTP clock.
+type PTPMeasurement struct {
+	Interface         string
+	Offset, PathDelay time.Duration
+}
+
+// FetchPTPMeasurements returns the measurements of the emulated PTP clocks,
+// in the order their interfaces were configured. Clocks for which the ATE
+// reports no measurement, such as those that are not synchronized, are omitted.
+func (ix *ixATE) FetchPTPMeasurements(ctx context.Context) ([]*PTPMeasurement, error) {
+	portToIntf := make(map[string]string)
+	for _, name := range ix.intfOrder {
+		if ix.intfCfgs[name].GetPtp() == nil {
+			continue
+		}
+		if vport, ok := ix.intfs[name].link.(*ixconfig.Vport); ok {
+			portToIntf[*vport.Name] = name
+		}
+	}
+	if len(portToIntf) == 0 {
+		return nil, usererr.New("no PTP clocks configured")
+	}
+	stats, err := ix.readStats(ctx, []string{ptpStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	toDuration := func(row map[string]string, col string) (time.Duration, error) {
+		ns, err := strconv.ParseFloat(row[col], 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unexpected %q value %q for port %q", col, row[col], row[portNameColumn])
+		}
+		return time.Duration(ns), nil
+	}
+	intfToMeas := make(map[string]*PTPMeasurement)
+	for _, row := range stats.Tables[ptpStatsCaption] {
+		name, ok := portToIntf[row[portNameColumn]]
+		if !ok || row[ptpOffsetColumn] == "" {
+			continue
+		}
+		offset, err := toDuration(row, ptpOffsetColumn)
+		if err != nil {
+			return nil, err
+		}
+		delay, err := toDuration(row, ptpPathDelayColumn)
+		if err != nil {
+			return nil, err
+		}
+		intfToMeas[name] = &PTPMeasurement{Interface: name, Offset: offset, PathDelay: delay}
+	}
+	var meas []*PTPMeasurement
+	for _, name := range ix.intfOrder {
+		if m, ok := intfToMeas[name]; ok {
+			meas = append(meas, m)
+		}
+	}
+	return meas, nil
+}
+
+// frameCounts are the frames transmitted and received by a traffic item.
+type frameCounts struct {
+	tx, rx uint64
+}
+
+// fetchFrameCounts returns the frames transmitted and received by each traffic
+// item, keyed by traffic item name.
+func (ix *ixATE) fetchFrameCounts(ctx context.Context) (map[string]frameCounts, error) {
+	stats, err := ix.readStats(ctx, []string{trafficItemStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]frameCounts)
+	for _, row := range stats.Tables[trafficItemStatsCaption] {
+		name := row[trafficItemColumn]
+		tx, err := strconv.ParseUint(row[txFramesColumn], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected Tx frames %q for traffic item %q", row[txFramesColumn], name)
+		}
+		rx, err := strconv.ParseUint(row[rxFramesColumn], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected Rx frames %q for traffic item %q", row[rxFramesColumn], name)
+		}
+		counts[name] = frameCounts{tx: tx, rx: rx}
+	}
+	return counts, nil
+}
+
+// AwaitNoLoss waits up to the specified window for the frame counts of the
+// flows to stop changing, so that frames still in flight are counted as
+// received, and then checks that each flow lost at most tolerancePct percent
+// of the frames it transmitted.
+func (ix *ixATE) AwaitNoLoss(ctx context.Context, flows []string, tolerancePct float64, window time.Duration) error {
+	if len(flows) == 0 {
+		return usererr.New("no flows specified")
+	}
+	const pollWait = 5 * time.Second
+	var prev map[string]frameCounts
+	counts, err := ix.fetchFrameCounts(ctx)
+	for i := 0; i < int(window/pollWait) && err == nil && !framesStable(flows, prev, counts); i++ {
+		sleepFn(pollWait)
+		prev = counts
+		counts, err = ix.fetchFrameCounts(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	var losses []string
+	for _, f := range flows {
+		c, ok := counts[f]
+		if !ok {
+			return usererr.New("no statistics for flow %q", f)
+		}
+		if c.tx == 0 {
+			losses = append(losses, fmt.Sprintf("flow %q transmitted no frames", f))
+			continue
+		}
+		var lossPct float64
+		if c.rx < c.tx {
+			lossPct = float64(c.tx-c.rx) / float64(c.tx) * 100
+		}
+		if lossPct > tolerancePct {
+			losses = append(losses, fmt.Sprintf("flow %q lost %.3f%% of frames (Tx %d, Rx %d)", f, lossPct, c.tx, c.rx))
+		}
+	}
+	if len(losses) > 0 {
+		return errors.Errorf("traffic loss exceeds %v%%: %s", tolerancePct, strings.Join(losses, "; "))
+	}
+	return nil
+}
+
+// framesStable returns whether the frame counts of the flows are unchanged.
+func framesStable(flows []string, prev, counts map[string]frameCounts) bool {
+	if prev == nil {
+		return false
+	}
+	for _, f := range flows {
+		if prev[f] != counts[f] {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadDistribution is the distribution of the frames of a flow received across
+// a set of ATE ports.
+type LoadDistribution struct {
+	// Frames is the number of frames received on each port, keyed by port name.
+	Frames map[string]uint64
+	// ChiSquare is the chi-square statistic of the frame counts against an even
+	// distribution across the ports.
+	ChiSquare float64
+	// MaxDeviationPct is the largest deviation of the frames received on a port
+	// from an even share, as a percentage of the even share.
+	MaxDeviationPct float64
+}
+
+// FetchLoadDistribution returns the distribution of the frames of a flow
+// received across the specified ports. The flow must be ingress tracked by
+// ports.
+func (ix *ixATE) FetchLoadDistribution(ctx context.Context, flow string, ports []string) (*LoadDistribution, error) {
+	if len(ports) == 0 {
+		return nil, usererr.New("no ports specified")
+	}
+	stats, err := ix.readStats(ctx, []string{flowStatsCaption})
+	if err != nil {
+		return nil, err
+	}
+	frames := make(map[string]uint64)
+	for _, p := range ports {
+		frames[p] = 0
+	}
+	var found bool
+	for _, row := range stats.Tables[flowStatsCaption] {
+		if row[trafficItemColumn] != flow {
+			continue
+		}
+		port, ok := row[rxPortColumn]
+		if !ok {
+			return nil, usererr.New("flow %q is not ingress tracked by ports", flow)
+		}
+		found = true
+		if _, ok := frames[port]; !ok {
+			continue
+		}
+		rx, err := strconv.ParseUint(row[rxFramesColumn], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected Rx frames %q for flow %q on port %q", row[rxFramesColumn], flow, port)
+		}
+		frames[port] += rx
+	}
+	if !found {
+		return nil, usererr.New("no statistics for flow %q", flow)
+	}
+	var total uint64
+	for _, n := range frames {
+		total += n
+	}
+	if total == 0 {
+		return nil, errors.Errorf("flow %q received no frames on ports %v", flow, ports)
+	}
+	ld := &LoadDistribution{Frames: frames}
+	expected := float64(total) / float64(len(frames))
+	for _, n := range frames {
+		dev := float64(n) - expected
+		ld.ChiSquare += dev * dev / expected
+		if pct := math.Abs(dev) / expected * 100; pct > ld.MaxDeviationPct {
+			ld.MaxDeviationPct = pct
+		}
+	}
+	return ld, nil
+}
+
+// BGPSession is the state of an emulated BGP session on the ATE.
+type BGPSession struct {
+	Interface   string
+	PeerAddress string
+	// State is the IxNetwork session status, such as "up" or "down".
+	State string
+	// LearnedRoutes is the number of routes learned from the peer, which is
+	// only fetched for sessions that are up.
+	LearnedRoutes int
+}
+
+// Up returns whether the BGP session is established.
+func (s *BGPSession) Up() bool {
+	return s.State == "up"
+}
+
+// ISISAdjacency is the state of an emulated IS-IS adjacency on the ATE.
+type ISISAdjacency struct {
+	Interface string
+	// State is the IxNetwork session status, such as "up" or "down".
+	State string
+}
+
+// Up returns whether the IS-IS adjacency is up.
+func (a *ISISAdjacency) Up() bool {
+	return a.State == "up"
+}
+
+// ProtocolSessions are the states of the emulated protocol sessions on the ATE.
+type ProtocolSessions struct {
+	BGP  []*BGPSession
+	ISIS []*ISISAdjacency
+}
+
+// notUp returns descriptions of the sessions that are not up.
+func (ps *ProtocolSessions) notUp() []string {
+	var descs []string
+	for _, s := range ps.BGP {
+		if !s.Up() {
+			descs = append(descs, fmt.Sprintf("BGP peer %s on %s is %q", s.PeerAddress, s.Interface, s.State))
+		}
+	}
+	for _, a := range ps.ISIS {
+		if !a.Up() {
+			descs = append(descs, fmt.Sprintf("IS-IS adjacency on %s is %q", a.Interface, a.State))
+		}
+	}
+	return descs
+}
+
+// FetchProtocolSessions returns the states of the emulated BGP sessions and
+// IS-IS adjacencies, and the number of routes learned over each BGP session.
+func (ix *ixATE) FetchProtocolSessions(ctx context.Context) (*ProtocolSessions, error) {
+	return ix.fetchProtocolSessions(ctx, true)
+}
+
+func (ix *ixATE) fetchProtocolSessions(ctx context.Context, learnedRoutes bool) (*ProtocolSessions, error) {
+	rns, err := ix.routingNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := &ProtocolSessions{}
+	for _, rn := range rns {
+		nodeID, err := ix.c.NodeID(rn.node)
+		if err != nil {
+			return nil, err
+		}
+		rsp := &protocolRsp{}
+		if err := ix.c.Session().Get(ctx, nodeID, rsp); err != nil {
+			return nil, errors.Wrapf(err, "could not fetch element at %q to check session status", nodeID)
+		}
+		var state string
+		if len(rsp.SessionStatus) > 0 {
+			state = rsp.SessionStatus[0]
+		}
+		if rn.isis {
+			sessions.ISIS = append(sessions.ISIS, &ISISAdjacency{Interface: rn.intf, State: state})
+			continue
+		}
+		s := &BGPSession{Interface: rn.intf, PeerAddress: rn.peer, State: state}
+		if learnedRoutes && s.Up() {
+			tables, err := ix.fetchLearnedInfo(ctx, rn.learnedInfoOp, nodeID)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range tables {
+				s.LearnedRoutes += len(t.Values)
+			}
+		}
+		sessions.BGP = append(sessions.BGP, s)
+	}
+	return sessions, nil
+}
+
+// routingNode is the config node of an emulated BGP peer or IS-IS interface.
+type routingNode struct {
+	intf          string
+	peer          string
+	isis          bool
+	node          ixconfig.IxiaCfgNode
+	learnedInfoOp string
+}
+
+// routingNodes returns the routing nodes of all interfaces, ordered by
+// interface name, with their IDs updated.
+func (ix *ixATE) routingNodes(ctx context.Context) ([]*routingNode, error) {
+	const (
+		bgpV4LearnedInfoOp   = "topology/deviceGroup/ethernet/ipv4/bgpIpv4Peer/operations/getAllLearnedInfo"
+		bgpV6LearnedInfoOp   = "topology/deviceGroup/ethernet/ipv6/bgpIpv6Peer/operations/getAllLearnedInfo"
+		bgpV4LBLearnedInfoOp = "topology/deviceGroup/ipv4Loopback/bgpIpv4Peer/operations/getAllLearnedInfo"
+		bgpV6LBLearnedInfoOp = "topology/deviceGroup/ipv6Loopback/bgpIpv6Peer/operations/getAllLearnedInfo"
+		isisLearnedInfoOp    = "topology/deviceGroup/ethernet/isisL3/operations/getLearnedInfo"
+	)
+	var names []string
+	for name := range ix.intfs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var rns []*routingNode
+	var nodes []ixconfig.IxiaCfgNode
+	for _, name := range names {
+		intf := ix.intfs[name]
+		if v4 := intf.ipv4; v4 != nil {
+			for _, p := range v4.BgpIpv4Peer {
+				rns = append(rns, &routingNode{intf: name, peer: *p.DutIp.SingleValue.Value, node: p, learnedInfoOp: bgpV4LearnedInfoOp})
+				nodes = append(nodes, p)
+			}
+		}
+		if v6 := intf.ipv6; v6 != nil {
+			for _, p := range v6.BgpIpv6Peer {
+				rns = append(rns, &routingNode{intf: name, peer: *p.DutIp.SingleValue.Value, node: p, learnedInfoOp: bgpV6LearnedInfoOp})
+				nodes = append(nodes, p)
+			}
+		}
+		if v4lb := intf.ipv4Loopback; v4lb != nil {
+			for _, p := range v4lb.BgpIpv4Peer {
+				rns = append(rns, &routingNode{intf: name, peer: *p.DutIp.SingleValue.Value, node: p, learnedInfoOp: bgpV4LBLearnedInfoOp})
+				nodes = append(nodes, p)
+			}
+		}
+		if v6lb := intf.ipv6Loopback; v6lb != nil {
+			for _, p := range v6lb.BgpIpv6Peer {
+				rns = append(rns, &routingNode{intf: name, peer: *p.DutIp.SingleValue.Value, node: p, learnedInfoOp: bgpV6LBLearnedInfoOp})
+				nodes = append(nodes, p)
+			}
+		}
+		if eths := intf.deviceGroup.Ethernet; len(eths) > 0 {
+			for _, isis := range eths[0].IsisL3 {
+				rns = append(rns, &routingNode{intf: name, isis: true, node: isis, learnedInfoOp: isisLearnedInfoOp})
+				nodes = append(nodes, isis)
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, nodes...); err != nil {
+		return nil, errors.Wrap(err, "could not update IDs of routing protocol nodes")
+	}
+	return rns, nil
+}
+
+// learnedInfoTable is a table of learned info of a routing protocol node.
+type learnedInfoTable struct {
+	Columns []string
+	Values  [][]string
+}
+
+// fetchLearnedInfo runs the specified learned info operation on the node and
+// returns the resulting learned info tables.
+func (ix *ixATE) fetchLearnedInfo(ctx context.Context, op, nodeID string) ([]*learnedInfoTable, error) {
+	if err := ix.c.Session().Post(ctx, op, ixweb.OpArgs{[]string{nodeID}}, nil); err != nil {
+		return nil, errors.Wrapf(err, "could not fetch learned info of node at %q", nodeID)
+	}
+	var tables []*learnedInfoTable
+	if err := ix.c.Session().Get(ctx, path.Join(nodeID, "learnedInfo/1/table"), &tables); err != nil {
+		return nil, errors.Wrapf(err, "could not fetch learned info of node at %q", nodeID)
+	}
+	return tables, nil
+}
+
+// LearnedRoute is a route learned by an emulated routing protocol on the ATE.
+type LearnedRoute struct {
+	Interface string
+	// Protocol is either "BGP" or "ISIS".
+	Protocol string
+	// Peer is the address of the BGP peer the route was learned from, or empty
+	// for IS-IS routes.
+	Peer    string
+	Prefix  string
+	NextHop string
+	// Attrs are the other attributes of the route, keyed by IxNetwork column
+	// name, such as "AS Path" or "Metric".
+	Attrs map[string]string
+}
+
+// FetchLearnedRoutes returns the routes learned by the emulated BGP peers and
+// IS-IS interfaces, ordered by interface name.
+func (ix *ixATE) FetchLearnedRoutes(ctx context.Context) ([]*LearnedRoute, error) {
+	rns, err := ix.routingNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var routes []*LearnedRoute
+	for _, rn := range rns {
+		nodeID, err := ix.c.NodeID(rn.node)
+		if err != nil {
+			return nil, err
+		}
+		tables, err := ix.fetchLearnedInfo(ctx, rn.learnedInfoOp, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		protocol := "BGP"
+		if rn.isis {
+			protocol = "ISIS"
+		}
+		for _, t := range tables {
+			for _, row := range t.Values {
+				r, err := learnedRoute(t.Columns, row)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unexpected learned info of node at %q", nodeID)
+				}
+				if r == nil {
+					continue
+				}
+				r.Interface = rn.intf
+				r.Protocol = protocol
+				r.Peer = rn.peer
+				routes = append(routes, r)
+			}
+		}
+	}
+	return routes, nil
+}
+
+// learnedRoute converts a row of a learned info table to a route, or returns
+// nil if the row has no prefix.
+func learnedRoute(cols, row []string) (*LearnedRoute, error) {
+	if len(cols) != len(row) {
+		return nil, errors.Errorf("row has %d values, want %d", len(row), len(cols))
+	}
+	r := &LearnedRoute{Attrs: make(map[string]string)}
+	var length string
+	for i, col := range cols {
+		// Some IxNetwork column names have trailing spaces.
+		switch col = strings.TrimSpace(col); col {
+		case "IPv4 Prefix", "IPv6 Prefix":
+			if row[i] != "" {
+				r.Prefix = row[i]
+			}
+		case "Prefix Length":
+			length = row[i]
+		case "IPv4 Next Hop", "IPv6 Next Hop":
+			if row[i] != "" && r.NextHop == "" {
+				r.NextHop = row[i]
+			}
+		default:
+			if row[i] != "" {
+				r.Attrs[col] = row[i]
+			}
+		}
+	}
+	if r.Prefix == "" {
+		return nil, nil
+	}
+	if length != "" {
+		r.Prefix = r.Prefix + "/" + length
+	}
+	return r, nil
+}
+
+// AwaitProtocolSessionsUp waits up to the specified timeout for all emulated
+// BGP sessions and IS-IS adjacencies to come up.
+func (ix *ixATE) AwaitProtocolSessionsUp(ctx context.Context, timeout time.Duration) error {
+	if ix.operState == operStateOff {
+		return usererr.New("cannot await protocol sessions when protocols are not running")
+	}
+	const retryWait = 5 * time.Second
+	notUp := func() ([]string, error) {
+		sessions, err := ix.fetchProtocolSessions(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+		return sessions.notUp(), nil
+	}
+	down, err := notUp()
+	for i := 0; i < int(timeout/retryWait) && err == nil && len(down) > 0; i++ {
+		sleepFn(retryWait)
+		down, err = notUp()
+	}
+	if err != nil {
+		return err
+	}
+	if len(down) > 0 {
+		return errors.Errorf("protocol sessions not up within %v: %s", timeout, strings.Join(down, ", "))
+	}
+	return nil
+}
+
+// NeighborResolution is the state of the resolution of the default gateways of
+// an emulated interface.
+type NeighborResolution struct {
+	Interface string
+	// IPv4GatewayMAC and IPv6GatewayMAC are the resolved MAC addresses of the
+	// default gateways, or empty if they are unresolved or not configured.
+	IPv4GatewayMAC string
+	IPv6GatewayMAC string
+	hasIPv4        bool
+	hasIPv6        bool
+}
+
+// Resolved returns whether all the configured default gateways are resolved.
+func (nr *NeighborResolution) Resolved() bool {
+	return (!nr.hasIPv4 || nr.IPv4GatewayMAC != "") && (!nr.hasIPv6 || nr.IPv6GatewayMAC != "")
+}
+
+// FetchNeighborResolutions returns the resolution states of the default
+// gateways of the emulated interfaces, in the order they were configured.
+func (ix *ixATE) FetchNeighborResolutions(ctx context.Context) ([]*NeighborResolution, error) {
+	if ix.operState == operStateOff {
+		return nil, usererr.New("cannot fetch neighbor resolutions when protocols are not running")
+	}
+	// Forget previously resolved MACs, so gateways that are no longer resolved
+	// are reported as such.
+	for _, intf := range ix.intfs {
+		intf.resolvedIpv4Mac, intf.resolvedIpv6Mac = "", ""
+	}
+	if err := resolveMacsFn(ctx, ix); err != nil {
+		return nil, err
+	}
+	var nrs []*NeighborResolution
+	for _, name := range ix.intfOrder {
+		intf := ix.intfs[name]
+		nrs = append(nrs, &NeighborResolution{
+			Interface:      name,
+			IPv4GatewayMAC: intf.resolvedIpv4Mac,
+			IPv6GatewayMAC: intf.resolvedIpv6Mac,
+			hasIPv4:        intf.ipv4 != nil,
+			hasIPv6:        intf.ipv6 != nil,
+		})
+	}
+	return nrs, nil
+}
+
+// ResolveNeighbors sends ARP requests and IPv6 neighbor solicitations for the
+// default gateways of all the emulated interfaces, to force their resolution.
+func (ix *ixATE) ResolveNeighbors(ctx context.Context) error {
+	if ix.operState == operStateOff {
+		return usererr.New("cannot resolve neighbors when protocols are not running")
+	}
+	var ipv4s, ipv6s []ixconfig.IxiaCfgNode
+	for _, name := range ix.intfOrder {
+		intf := ix.intfs[name]
+		if intf.ipv4 != nil {
+			ipv4s = append(ipv4s, intf.ipv4)
+		}
+		if intf.ipv6 != nil {
+			ipv6s = append(ipv6s, intf.ipv6)
+		}
+	}
+	if err := ix.c.UpdateIDs(ctx, ix.cfg, append(ipv4s, ipv6s...)...); err != nil {
+		return errors.Wrap(err, "could not update IDs for resolving neighbors")
+	}
+	for _, op := range []struct {
+		path  string
+		nodes []ixconfig.IxiaCfgNode
+	}{
+		{"topology/deviceGroup/ethernet/ipv4/operations/sendarp", ipv4s},
+		{"topology/deviceGroup/ethernet/ipv6/operations/sendns", ipv6s},
+	} {
+		if len(op.nodes) == 0 {
+			continue
+		}
+		var ids []string
+		for _, n := range op.nodes {
+			id, err := ix.c.NodeID(n)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		if err := ix.c.Session().Post(ctx, op.path, ixweb.OpArgs{ids}, nil); err != nil {
+			return errors.Wrapf(err, "could not resolve neighbors of %v", ids)
+		}
+	}
+	return nil
+}
+
 // FlushStats will remove all data from the cache for the Ixia and marks all stats as stale.
 // This ensures that future telemetry calls attempt to fetch data and that stale data doesn't persist.
 func (ix *ixATE) FlushStats() {
@@ -1144,6 +2611,164 @@ func (ix *ixATE) UpdateBGPPeerStates(ctx context.Context, ifs []*opb.InterfaceCo
 			}
 		}
 	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdateNetworks updates the network groups in the IxNetwork session and
+// applies the changes on the fly, such as to withdraw or re-advertise routes.
+// It assumes that the only changes in the provided interface configs are
+// updates to network configs.
+func (ix *ixATE) UpdateNetworks(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		intf := ix.intfs[ifc.GetName()]
+		for _, n := range ifc.GetNetworks() {
+			if err := ix.importConfig(ctx, intf.netToNetworkGroup[n.GetName()], false, peersImportTimeout); err != nil {
+				return errors.Wrapf(err, "could not update network %q", n.GetName())
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdateBFD updates the BFD interfaces in the IxNetwork session and applies
+// the changes on the fly, such as to bring sessions administratively down.
+// It assumes that the only changes in the provided interface configs are
+// updates to BFD configs.
+func (ix *ixATE) UpdateBFD(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		intf := ix.intfs[ifc.GetName()]
+		if v4 := intf.ipv4; v4 != nil {
+			for _, bfd := range v4.Bfdv4Interface {
+				if err := ix.importConfig(ctx, bfd, false, peersImportTimeout); err != nil {
+					return errors.Wrapf(err, "could not update BFDv4 on interface %q", ifc.GetName())
+				}
+			}
+		}
+		if v6 := intf.ipv6; v6 != nil {
+			for _, bfd := range v6.Bfdv6Interface {
+				if err := ix.importConfig(ctx, bfd, false, peersImportTimeout); err != nil {
+					return errors.Wrapf(err, "could not update BFDv6 on interface %q", ifc.GetName())
+				}
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdateMulticastGroups updates the IGMP and MLD host groups in the IxNetwork
+// session and applies the changes on the fly, such as to join or leave groups.
+// It assumes that the only changes in the provided interface configs are
+// updates to IGMP and MLD host configs.
+func (ix *ixATE) UpdateMulticastGroups(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		intf := ix.intfs[ifc.GetName()]
+		if intf.igmpGroups != nil {
+			if err := ix.importConfig(ctx, intf.igmpGroups, false, peersImportTimeout); err != nil {
+				return errors.Wrapf(err, "could not update IGMP groups on interface %q", ifc.GetName())
+			}
+		}
+		if intf.mldGroups != nil {
+			if err := ix.importConfig(ctx, intf.mldGroups, false, peersImportTimeout); err != nil {
+				return errors.Wrapf(err, "could not update MLD groups on interface %q", ifc.GetName())
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdatePIM updates the PIM interfaces in the IxNetwork session and applies
+// the changes on the fly, such as to join or prune groups.
+// It assumes that the only changes in the provided interface configs are
+// updates to PIM configs.
+func (ix *ixATE) UpdatePIM(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		intf := ix.intfs[ifc.GetName()]
+		if v4 := intf.ipv4; v4 != nil {
+			for _, pim := range v4.PimV4Interface {
+				if err := ix.importConfig(ctx, pim, false, peersImportTimeout); err != nil {
+					return errors.Wrapf(err, "could not update PIMv4 on interface %q", ifc.GetName())
+				}
+			}
+		}
+		if v6 := intf.ipv6; v6 != nil {
+			for _, pim := range v6.PimV6Interface {
+				if err := ix.importConfig(ctx, pim, false, peersImportTimeout); err != nil {
+					return errors.Wrapf(err, "could not update PIMv6 on interface %q", ifc.GetName())
+				}
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdateISIS updates the IS-IS routers of the specified interfaces and applies
+// the changes on the fly, such as to set or clear the overload bit.
+// It assumes that the only changes in the provided interface configs are
+// updates to IS-IS router configs.
+func (ix *ixATE) UpdateISIS(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		for _, rtr := range ix.intfs[ifc.GetName()].deviceGroup.IsisL3Router {
+			if err := ix.importConfig(ctx, rtr, false, peersImportTimeout); err != nil {
+				return errors.Wrapf(err, "could not update IS-IS on interface %q", ifc.GetName())
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+// UpdateBGPRoutes updates the BGP route properties of the networks of the
+// specified interfaces and applies the changes on the fly, such as to change
+// the AS path or local preference of routes that are already advertised.
+// It assumes that the only changes in the provided interface configs are
+// updates to the BGP attributes of networks.
+func (ix *ixATE) UpdateBGPRoutes(ctx context.Context, ifs []*opb.InterfaceConfig) error {
+	if err := ix.configureTopology(ifs); err != nil {
+		return err
+	}
+	for _, ifc := range ifs {
+		intf := ix.intfs[ifc.GetName()]
+		for _, n := range ifc.GetNetworks() {
+			if n.GetBgpAttributes() == nil {
+				continue
+			}
+			var rps []ixconfig.IxiaCfgNode
+			ng := intf.netToNetworkGroup[n.GetName()]
+			for _, p := range ng.Ipv4PrefixPools {
+				for _, rp := range p.BgpIPRouteProperty {
+					rps = append(rps, rp)
+				}
+			}
+			for _, p := range ng.Ipv6PrefixPools {
+				for _, rp := range p.BgpV6IPRouteProperty {
+					rps = append(rps, rp)
+				}
+			}
+			for _, rp := range rps {
+				if err := ix.importConfig(ctx, rp, false, peersImportTimeout); err != nil {
+					return errors.Wrapf(err, "could not update BGP routes of network %q", n.GetName())
+				}
+			}
+		}
+	}
+	return ix.applyOnTheFly(ctx)
+}
+
+func (ix *ixATE) applyOnTheFly(ctx context.Context) error {
 	const (
 		applyOnTheFlyArg = "globals/topology"
 		applyOnTheFlyOp  = "globals/topology/operations/applyonthefly"
@@ -20,9 +20,11 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -60,10 +62,15 @@ func updateXPaths(cfg *ixconfig.Ixnetwork) error {
 	for i, t := range cfg.Topology {
 		for j, dg := range t.DeviceGroup {
 			dg.Xpath = toXPath("/topology[%d]/deviceGroup[%d]", i+1, j+1)
-			for _, eth := range dg.Ethernet {
-				for _, ipv4 := range eth.Ipv4 {
-					for k, bgpv4 := range ipv4.BgpIpv4Peer {
-						bgpv4.Active.SingleValue.Xpath = toXPath("/topology[%d]/deviceGroup[%d]/bgpv4Peer[%d]", i+1, j+1, k+1)
+			for k, eth := range dg.Ethernet {
+				for l, ipv4 := range eth.Ipv4 {
+					for m, bgpv4 := range ipv4.BgpIpv4Peer {
+						bgpv4.Active.SingleValue.Xpath = toXPath("/topology[%d]/deviceGroup[%d]/bgpv4Peer[%d]", i+1, j+1, m+1)
+					}
+					for m, igmp := range ipv4.IgmpHost {
+						if igmp.IgmpMcastIPv4GroupList != nil {
+							igmp.IgmpMcastIPv4GroupList.Xpath = toXPath("/topology[%d]/deviceGroup[%d]/ethernet[%d]/ipv4[%d]/igmpHost[%d]/igmpMcastIPv4GroupList", i+1, j+1, k+1, l+1, m+1)
+						}
 					}
 				}
 				for _, ipv6 := range eth.Ipv6 {
@@ -96,6 +103,9 @@ type fakeCfgClient struct {
 	updateIDErr   error
 	importErrs    []error
 	lastImportCfg ixconfig.IxiaCfgNode
+	lastImported  *ixconfig.Ixnetwork
+	deleteErr     error
+	deleted       []ixconfig.IxiaCfgNode
 	session       *fakeSession
 }
 
@@ -116,7 +126,7 @@ func (c *fakeCfgClient) NodeID(node ixconfig.IxiaCfgNode) (string, error) {
 	return id, nil
 }
 
-func (c *fakeCfgClient) ImportConfig(ctx context.Context, cfg *ixconfig.Ixnetwork, node ixconfig.IxiaCfgNode, _ bool) error {
+func (c *fakeCfgClient) ImportConfigChunked(ctx context.Context, cfg *ixconfig.Ixnetwork, node ixconfig.IxiaCfgNode, _ bool, chunkSize int, _ func(int, int)) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
@@ -129,13 +139,31 @@ func (c *fakeCfgClient) ImportConfig(ctx context.Context, cfg *ixconfig.Ixnetwor
 	return updateXPaths(cfg)
 }
 
+func (c *fakeCfgClient) LastImportedConfig() *ixconfig.Ixnetwork {
+	return c.lastImported
+}
+
 type fakeDelayImportClient struct {
 	cfgClient
-	delays    int
-	importErr error
+	delays       int
+	importErr    error
+	chunkSize    int
+	lastImported *ixconfig.Ixnetwork
+	chassisMu    *sync.Mutex
+	heldChassis  bool
 }
 
-func (c *fakeDelayImportClient) ImportConfig(ctx context.Context, _ *ixconfig.Ixnetwork, _ ixconfig.IxiaCfgNode, _ bool) error {
+func (c *fakeDelayImportClient) LastImportedConfig() *ixconfig.Ixnetwork {
+	return c.lastImported
+}
+
+func (c *fakeDelayImportClient) ImportConfigChunked(ctx context.Context, _ *ixconfig.Ixnetwork, _ ixconfig.IxiaCfgNode, _ bool, chunkSize int, _ func(int, int)) error {
+	c.chunkSize = chunkSize
+	if c.chassisMu != nil && c.chassisMu.TryLock() {
+		c.chassisMu.Unlock()
+	} else if c.chassisMu != nil {
+		c.heldChassis = true
+	}
 	if c.delays == 0 {
 		return c.importErr
 	}
@@ -151,14 +179,25 @@ func (c *fakeCfgClient) UpdateIDs(_ context.Context, cfg *ixconfig.Ixnetwork, cn
 	return c.updateIDErr
 }
 
+func (c *fakeCfgClient) DeleteNodes(_ context.Context, nodes ...ixconfig.IxiaCfgNode) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
+	c.deleted = append(c.deleted, nodes...)
+	return nil
+}
+
 type fakeSession struct {
 	session
 	deleteErrs map[string]error
 	getRsps    map[string]string
 	getErrs    map[string]error
 	patchErrs  map[string]error
+	patches    map[string]interface{}
 	postRsps   map[string]string
 	postErrs   map[string]error
+	posts      map[string]interface{}
+	allPosts   map[string][]interface{}
 	files      *fakeFiles
 	stats      *fakeStats
 }
@@ -178,11 +217,20 @@ func (s *fakeSession) Get(_ context.Context, p string, v interface{}) error {
 	return s.getErrs[p]
 }
 
-func (s *fakeSession) Patch(_ context.Context, p string, _ interface{}) error {
+func (s *fakeSession) Patch(_ context.Context, p string, v interface{}) error {
+	if s.patches != nil {
+		s.patches[p] = v
+	}
 	return s.patchErrs[p]
 }
 
-func (s *fakeSession) Post(_ context.Context, p string, _, out interface{}) error {
+func (s *fakeSession) Post(_ context.Context, p string, in, out interface{}) error {
+	if s.posts != nil {
+		s.posts[p] = in
+	}
+	if s.allPosts != nil {
+		s.allPosts[p] = append(s.allPosts[p], in)
+	}
 	if s.postRsps[p] != "" && out != nil {
 		if err := json.Unmarshal([]byte(s.postRsps[p]), out); err != nil {
 			return err
@@ -201,10 +249,12 @@ func (s *fakeSession) Stats() stats {
 
 type fakeFiles struct {
 	files
-	listRes   []string
-	listErr   error
-	uploadErr error
-	deleteErr error
+	listRes     []string
+	listErr     error
+	uploadErr   error
+	downloadRes map[string][]byte
+	downloadErr error
+	deleteErr   error
 }
 
 func (f *fakeFiles) List(context.Context, string) ([]string, error) {
@@ -215,6 +265,10 @@ func (f *fakeFiles) Upload(context.Context, string, []byte) error {
 	return f.uploadErr
 }
 
+func (f *fakeFiles) Download(_ context.Context, name string) ([]byte, error) {
+	return f.downloadRes[name], f.downloadErr
+}
+
 func (f *fakeFiles) Delete(context.Context, string) error {
 	return f.deleteErr
 }
@@ -320,17 +374,19 @@ func restoreStubs() {
 	genTrafficFn = genTraffic
 	updateFlowsFn = updateFlows
 	startTrafficFn = startTraffic
+	routeTableDirFn = os.TempDir
 }
 
 func TestImportConfig(t *testing.T) {
 	defer restoreStubs()
 	sleepFn = func(time.Duration) {}
 	tests := []struct {
-		desc      string
-		timeout   bool
-		delays    int
-		importErr error
-		wantErr   string
+		desc         string
+		timeout      bool
+		delays       int
+		importErr    error
+		lastImported *ixconfig.Ixnetwork
+		wantErr      string
 	}{{
 		desc:      "non-timeout error on import",
 		importErr: errors.New("some import error"),
@@ -346,6 +402,9 @@ func TestImportConfig(t *testing.T) {
 		delays:  1,
 	}, {
 		desc: "successful import",
+	}, {
+		desc:         "successful import with changes since last import",
+		lastImported: &ixconfig.Ixnetwork{Vport: []*ixconfig.Vport{{Name: ixconfig.String("port1")}}},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -353,21 +412,76 @@ func TestImportConfig(t *testing.T) {
 			if test.timeout {
 				importTimeout = 0
 			}
+			fc := &fakeDelayImportClient{
+				delays:       test.delays,
+				importErr:    test.importErr,
+				lastImported: test.lastImported,
+			}
 			c := &ixATE{
-				c: &fakeDelayImportClient{
-					delays:    test.delays,
-					importErr: test.importErr,
-				},
+				c:   fc,
 				cfg: &ixconfig.Ixnetwork{},
 			}
 			gotErr := c.importConfig(context.Background(), c.cfg, false, importTimeout)
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
 				t.Errorf("importConfig: got err: %v, want err %q", gotErr, test.wantErr)
 			}
+			if fc.chunkSize != importChunkSize {
+				t.Errorf("importConfig: got chunk size %d, want %d", fc.chunkSize, importChunkSize)
+			}
 		})
 	}
 }
 
+func TestImportConfigLocksChassis(t *testing.T) {
+	mu.Lock()
+	chassisMu := chassisLock("192.168.1.1")
+	sameMu := chassisLock("192.168.1.1")
+	otherMu := chassisLock("192.168.1.2")
+	mu.Unlock()
+	if sameMu != chassisMu {
+		t.Errorf("chassisLock: got a new lock for the same chassis")
+	}
+	if otherMu == chassisMu {
+		t.Errorf("chassisLock: got the same lock for different chassis")
+	}
+	fc := &fakeDelayImportClient{chassisMu: chassisMu}
+	c := &ixATE{
+		c:         fc,
+		cfg:       &ixconfig.Ixnetwork{},
+		chassisMu: chassisMu,
+	}
+	if err := c.importConfig(context.Background(), c.cfg, false, time.Minute); err != nil {
+		t.Fatalf("importConfig: got err %v", err)
+	}
+	if !fc.heldChassis {
+		t.Errorf("importConfig: chassis not locked during import")
+	}
+	if !chassisMu.TryLock() {
+		t.Fatalf("importConfig: chassis still locked after import")
+	}
+	chassisMu.Unlock()
+}
+
+func TestImportConfigWritesArtifact(t *testing.T) {
+	defer func() { configDir = "" }()
+	configDir = t.TempDir()
+	c := &ixATE{
+		name: "ixia1",
+		c:    &fakeDelayImportClient{},
+		cfg:  &ixconfig.Ixnetwork{Vport: []*ixconfig.Vport{{Name: ixconfig.String("ixia1/1/1")}}},
+	}
+	if err := c.importConfig(context.Background(), c.cfg, true, time.Minute); err != nil {
+		t.Fatalf("importConfig: got err %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(configDir, "ixnetwork-config-ixia1-01_overwrite.json"))
+	if err != nil {
+		t.Fatalf("importConfig did not write the config artifact: %v", err)
+	}
+	if !strings.Contains(string(b), "ixia1/1/1") {
+		t.Errorf("importConfig wrote config %s, want it to contain the port", b)
+	}
+}
+
 func TestPushTopology(t *testing.T) {
 	const (
 		ixiaName    = "ixia1"
@@ -448,6 +562,42 @@ func TestPushTopology(t *testing.T) {
 	}
 }
 
+func TestDryRunTopology(t *testing.T) {
+	tests := []struct {
+		desc, reqFile, wantCfgFile string
+	}{{
+		desc:        "Base config",
+		reqFile:     "no_intfs.textproto",
+		wantCfgFile: "no_intfs_cfg.json",
+	}, {
+		desc:        "IS-IS config with no traffic",
+		reqFile:     "isis_no_traffic.textproto",
+		wantCfgFile: "isis_no_traffic_cfg.json",
+	}, {
+		desc:        "FEC disabled",
+		reqFile:     "fec_disabled.textproto",
+		wantCfgFile: "fec_disabled.json",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				name:        "ixia1",
+				chassisHost: "192.168.1.1",
+			}
+			gotCfg, err := c.dryRunTopology(readTopology(t, test.reqFile))
+			if err != nil {
+				t.Fatalf("dryRunTopology: unexpected error: %v", err)
+			}
+			// The golden configs only have the XPaths set by the fake import.
+			wantCfg := toCfg(t, test.wantCfgFile)
+			wantCfg.UpdateXPaths()
+			if diff := jsonCfgDiff(t, wantCfg, gotCfg); diff != "" {
+				t.Errorf("dryRunTopology: unexpected topology config, diff (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
 // Config generation is tested in TestPushTopology, this test is only for Ixnetwork interactions.
 func TestUpdateTopology(t *testing.T) {
 	tests := []struct {
@@ -965,768 +1115,2601 @@ func TestSetPortState(t *testing.T) {
 	}
 }
 
-func TestResolveMacs(t *testing.T) {
-	const (
-		intfName = "someIntf"
-		ethMac   = "11:11:11:11:11:11"
-		ipv4Mac  = "22:22:22:22:22:22"
-		ipv6Mac  = "33:33:33:33:33:33"
-		valuesOp = "multivalue/operations/getvalues"
-		ipv4ID   = "id/to/ipv4"
-		ipv6ID   = "id/to/ipv6"
-	)
-	macXP := parseXPath(t, "/fake/xpath/mac")
-	ipv4XP := parseXPath(t, "/fake/xpath/ipv4")
-	ipv6XP := parseXPath(t, "/fake/xpath/ipv6")
+func TestPortOwnership(t *testing.T) {
+	const port = "1/1"
 	cfg := &ixconfig.Ixnetwork{
-		Topology: []*ixconfig.Topology{{
-			DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
-				Ethernet: []*ixconfig.TopologyEthernet{{
-					Mac:  &ixconfig.Multivalue{Xpath: macXP},
-					Ipv4: []*ixconfig.TopologyIpv4{{Xpath: ipv4XP}},
-					Ipv6: []*ixconfig.TopologyIpv6{{Xpath: ipv6XP}},
-				}},
-			}},
-		}},
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
 	}
-	dg := cfg.Topology[0].DeviceGroup[0]
-	eth := dg.Ethernet[0]
-
 	tests := []struct {
-		desc                                 string
-		ethMacRsp, ipv4MacRsp, ipv6MacRsp    string
-		idErr, ethErr, ipv4Err, ipv6Err      error
-		wantErr                              bool
-		wantEthMac, wantIpv4Mac, wantIpv6Mac string
+		desc     string
+		op       func(*ixATE, context.Context, string) error
+		port     string
+		opPath   string
+		opErr    error
+		wantArgs ixweb.OpArgs
+		wantErr  string
 	}{{
-		desc:    "failed ID update",
-		idErr:   errors.New("failed to update IDs"),
-		wantErr: true,
-	}, {
-		desc:    "failed eth mac resolution",
-		ethErr:  errors.New("failed eth mac multivalue fetch"),
-		wantErr: true,
-	}, {
-		desc:    "failed IPv4 mac resolution",
-		ipv4Err: errors.New("failed IPv4 mac resolution"),
-		wantErr: true,
-	}, {
-		desc:    "failed IPv6 mac resolution",
-		ipv6Err: errors.New("failed IPv6 mac resolution"),
-		wantErr: true,
-	}, {
-		desc:        "ignore invalid mac",
-		ethMacRsp:   ethMac,
-		ipv4MacRsp:  "removePacket[Unresolved]",
-		ipv6MacRsp:  ipv6Mac,
-		wantEthMac:  ethMac,
-		wantIpv6Mac: ipv6Mac,
+		desc:    "invalid port",
+		op:      (*ixATE).ReleasePort,
+		port:    "2/2",
+		wantErr: "does not exist in current config",
 	}, {
-		desc:        "successful mac resolution",
-		ethMacRsp:   ethMac,
-		ipv4MacRsp:  ipv4Mac,
-		ipv6MacRsp:  ipv6Mac,
-		wantEthMac:  ethMac,
-		wantIpv4Mac: ipv4Mac,
-		wantIpv6Mac: ipv6Mac,
+		desc:    "error taking ownership",
+		op:      (*ixATE).TakePortOwnership,
+		port:    port,
+		opPath:  "vport/operations/connectports",
+		opErr:   errors.New("connect error"),
+		wantErr: "error taking ownership",
+	}, {
+		desc:     "take ownership",
+		op:       (*ixATE).TakePortOwnership,
+		port:     port,
+		opPath:   "vport/operations/connectports",
+		wantArgs: ixweb.OpArgs{[]string{"/id/to/vport"}, true},
+	}, {
+		desc:     "release port",
+		op:       (*ixATE).ReleasePort,
+		port:     port,
+		opPath:   "vport/operations/releaseport",
+		wantArgs: ixweb.OpArgs{[]string{"/id/to/vport"}},
+	}, {
+		desc:    "error rebooting port CPU",
+		op:      (*ixATE).RebootPortCPU,
+		port:    port,
+		opPath:  "vport/operations/resetportcpu",
+		opErr:   errors.New("reset error"),
+		wantErr: "error rebooting CPU",
+	}, {
+		desc:     "reboot port CPU",
+		op:       (*ixATE).RebootPortCPU,
+		port:     port,
+		opPath:   "vport/operations/resetportcpu",
+		wantArgs: ixweb.OpArgs{[]string{"/id/to/vport"}},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			fs := &fakeSession{
+				postErrs: map[string]error{test.opPath: test.opErr},
+				posts:    map[string]interface{}{},
+			}
 			c := &ixATE{
-				cfg: cfg,
+				cfg:   cfg,
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
 				c: &fakeCfgClient{
-					xPathToID: map[string]string{
-						macXP.String():  "id/to/mac",
-						ipv4XP.String(): ipv4ID,
-						ipv6XP.String(): ipv6ID,
-					},
-					updateIDErr: test.idErr,
-					session: &fakeSession{
-						getRsps: map[string]string{
-							ipv4ID: fmt.Sprintf("{\"resolvedGatewayMac\": [\"%s\"]}", test.ipv4MacRsp),
-							ipv6ID: fmt.Sprintf("{\"resolvedGatewayMac\": [\"%s\"]}", test.ipv6MacRsp),
-						},
-						getErrs: map[string]error{
-							ipv4ID: test.ipv4Err,
-							ipv6ID: test.ipv6Err,
-						},
-						postRsps: map[string]string{
-							valuesOp: fmt.Sprintf("[\"%s\"]", test.ethMacRsp),
-						},
-						postErrs: map[string]error{
-							valuesOp: test.ethErr,
-						},
-					},
-				},
-				intfs: map[string]*intf{
-					intfName: &intf{
-						deviceGroup: dg,
-						ipv4:        eth.Ipv4[0],
-						ipv6:        eth.Ipv6[0],
-					},
+					session:   fs,
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
 				},
 			}
-
-			gotErr := resolveMacs(context.Background(), c)
-			if (gotErr != nil) != test.wantErr {
-				t.Errorf("resolveMacs: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
-			}
-			intf := c.intfs[intfName]
-			if intf.ethMac != test.wantEthMac {
-				t.Errorf("resolveMacs: wrong value for resolved eth mac: got %q, want %q", intf.ethMac, test.wantEthMac)
+			gotErr := test.op(c, context.Background(), test.port)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("%s: got err: %v, want err %q", test.desc, gotErr, test.wantErr)
 			}
-			if intf.resolvedIpv4Mac != test.wantIpv4Mac {
-				t.Errorf("resolveMacs: wrong value for resolved IPv4 mac: got %q, want %q", intf.resolvedIpv4Mac, test.wantIpv4Mac)
+			if gotErr != nil {
+				return
 			}
-			if intf.resolvedIpv6Mac != test.wantIpv6Mac {
-				t.Errorf("resolveMacs: wrong value for resolved IPv6 mac: got %q, want %q", intf.resolvedIpv6Mac, test.wantIpv6Mac)
+			if diff := cmp.Diff(test.wantArgs, fs.posts[test.opPath]); diff != "" {
+				t.Errorf("%s: unexpected op args (-want, +got): %s", test.desc, diff)
 			}
 		})
 	}
 }
 
-func TestResetTrafficCfg(t *testing.T) {
+func TestReleasePorts(t *testing.T) {
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String("1/1")}, {Name: ixconfig.String("1/2")}},
+	}
+	fs := &fakeSession{posts: map[string]interface{}{}}
+	c := &ixATE{
+		cfg:   cfg,
+		ports: map[string]*ixconfig.Vport{"1/2": cfg.Vport[1], "1/1": cfg.Vport[0]},
+		c: &fakeCfgClient{
+			session: fs,
+			xPathToID: map[string]string{
+				"/vport[1]": "/id/to/vport1",
+				"/vport[2]": "/id/to/vport2",
+			},
+		},
+	}
+	if err := c.ReleasePorts(context.Background()); err != nil {
+		t.Fatalf("ReleasePorts: unexpected error: %v", err)
+	}
+	want := ixweb.OpArgs{[]string{"/id/to/vport1", "/id/to/vport2"}}
+	if diff := cmp.Diff(want, fs.posts["vport/operations/releaseport"]); diff != "" {
+		t.Errorf("ReleasePorts: unexpected op args (-want, +got): %s", diff)
+	}
+}
+
+func TestSetPortLoopback(t *testing.T) {
 	const (
-		stopTrafficOp     = "traffic/operations/stop"
-		clearStatsOp      = "operations/clearstats"
-		deleteTrafficPath = "traffic/trafficItem"
+		port   = "1/1"
+		l1Path = "/id/to/vport/l1Config"
 	)
-	defer restoreStubs()
-	sleepFn = func(time.Duration) {}
-
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
+	}
 	tests := []struct {
-		desc                          string
-		stopTrafficErr, clearStatsErr error
-		deleteTrafficErr              error
-		wantErr                       bool
+		desc      string
+		port      string
+		mode      opb.PortLoopbackMode
+		getErr    error
+		patchErr  error
+		wantPatch map[string]interface{}
+		wantErr   string
 	}{{
-		desc:           "failed to stop traffic",
-		stopTrafficErr: errors.New("stop traffic error"),
-		wantErr:        true,
+		desc:    "invalid port",
+		port:    "2/2",
+		mode:    opb.PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL,
+		wantErr: "does not exist in current config",
 	}, {
-		desc:          "failed to clear stats",
-		clearStatsErr: errors.New("clear stats error"),
-		wantErr:       true,
+		desc:    "invalid mode",
+		port:    port,
+		mode:    opb.PortLoopbackMode(100),
+		wantErr: "unrecognized port loopback mode",
 	}, {
-		desc:             "failed to delete traffic config",
-		deleteTrafficErr: errors.New("delete traffic error"),
-		wantErr:          true,
+		desc:    "error fetching L1 config type",
+		port:    port,
+		mode:    opb.PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL,
+		getErr:  errors.New("get error"),
+		wantErr: "could not fetch L1 config type",
 	}, {
-		desc: "successful traffic reset",
+		desc:     "error patching L1 config",
+		port:     port,
+		mode:     opb.PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL,
+		patchErr: errors.New("patch error"),
+		wantErr:  "error setting loopback mode",
+	}, {
+		desc: "line loopback",
+		port: port,
+		mode: opb.PortLoopbackMode_PORT_LOOPBACK_MODE_LINE,
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{"loopback": true, "loopbackMode": "lineLoopback"},
+		},
+	}, {
+		desc: "no loopback",
+		port: port,
+		mode: opb.PortLoopbackMode_PORT_LOOPBACK_MODE_NONE,
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{"loopback": false},
+		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{
+				getRsps:   map[string]string{l1Path: `{"currentType": "novusHundredGigLan"}`},
+				getErrs:   map[string]error{l1Path: test.getErr},
+				patchErrs: map[string]error{l1Path + "/novusHundredGigLan": test.patchErr},
+				patches:   map[string]interface{}{},
+			}
 			c := &ixATE{
+				cfg:   cfg,
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
 				c: &fakeCfgClient{
-					session: &fakeSession{
-						deleteErrs: map[string]error{
-							deleteTrafficPath: test.deleteTrafficErr,
-						},
-						postErrs: map[string]error{
-							stopTrafficOp: test.stopTrafficErr,
-							clearStatsOp:  test.clearStatsErr,
-						},
-					},
+					session:   sess,
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
 				},
-				cfg:       &ixconfig.Ixnetwork{},
-				operState: operStateTrafficOn,
 			}
-
-			gotErr := resetIxiaTrafficCfg(context.Background(), c)
-			if (gotErr != nil) != test.wantErr {
-				t.Errorf("resetIxiaTrafficCfg: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			gotErr := c.SetPortLoopback(context.Background(), test.port, test.mode)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("SetPortLoopback: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, sess.patches); diff != "" {
+				t.Errorf("SetPortLoopback: unexpected patches (-want +got): %s", diff)
 			}
 		})
 	}
 }
 
-func TestGenTraffic(t *testing.T) {
-	const generateOp = "traffic/trafficItem/operations/generate"
-	tiXP := parseXPath(t, "/fake/xpath/trafficItem")
+func TestSetPortLinkFault(t *testing.T) {
+	const (
+		port   = "1/1"
+		l1Path = "/id/to/vport/l1Config"
+	)
 	cfg := &ixconfig.Ixnetwork{
-		Traffic: &ixconfig.Traffic{
-			TrafficItem: []*ixconfig.TrafficTrafficItem{{Xpath: tiXP}},
-		},
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
 	}
-
 	tests := []struct {
-		desc        string
-		generateErr error
-		wantErr     string
+		desc      string
+		fault     opb.PortLinkFault
+		wantPatch map[string]interface{}
+		wantErr   string
 	}{{
-		desc:        "failed traffic item generate op",
-		generateErr: errors.New("failed generate op on traffic items"),
-		wantErr:     "could not generate",
+		desc:    "invalid fault",
+		fault:   opb.PortLinkFault(100),
+		wantErr: "unrecognized port link fault",
+	}, {
+		desc:  "remote fault",
+		fault: opb.PortLinkFault_PORT_LINK_FAULT_REMOTE,
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{
+				"sendSetsMode":        "typeAOnly",
+				"typeAOrderedSets":    "remoteFault",
+				"startErrorInsertion": true,
+			},
+		},
 	}, {
-		desc: "no errors",
+		desc:  "no fault",
+		fault: opb.PortLinkFault_PORT_LINK_FAULT_NONE,
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{"startErrorInsertion": false},
+		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			defer restoreStubs()
+			sess := &fakeSession{
+				getRsps: map[string]string{l1Path: `{"currentType": "novusHundredGigLan"}`},
+				patches: map[string]interface{}{},
+			}
 			c := &ixATE{
-				cfg: cfg,
+				cfg:   cfg,
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
 				c: &fakeCfgClient{
-					xPathToID: map[string]string{tiXP.String(): "/id/to/trafficitem"},
-					session: &fakeSession{
-						postErrs: map[string]error{generateOp: test.generateErr},
-					},
+					session:   sess,
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
 				},
-				egressTrackingFlows: []string{"someFlow"},
 			}
-			gotErr := genTraffic(context.Background(), c)
+			gotErr := c.SetPortLinkFault(context.Background(), port, test.fault)
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("genTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+				t.Fatalf("SetPortLinkFault: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, sess.patches); diff != "" {
+				t.Errorf("SetPortLinkFault: unexpected patches (-want +got): %s", diff)
 			}
 		})
 	}
 }
 
-func TestUpdateFlows(t *testing.T) {
+func TestSetPortL1Config(t *testing.T) {
 	const (
-		flowName = "someFlow"
-		tiID     = "id/to/traffic/item"
+		port   = "1/1"
+		l1Path = "/id/to/vport/l1Config"
 	)
-	tiXP := parseXPath(t, "/fake/xpath/trafficItem")
-	baseClient := func() *ixATE {
-		cfg := &ixconfig.Ixnetwork{
-			Traffic: &ixconfig.Traffic{
-				TrafficItem: []*ixconfig.TrafficTrafficItem{{
-					Xpath: tiXP,
-					ConfigElement: []*ixconfig.TrafficConfigElement{{
-						FrameSize: &ixconfig.TrafficFrameSize{
-							Type_:     ixconfig.String("fixed"),
-							FixedSize: ixconfig.NumberInt(1000),
-						},
-						FrameRate: &ixconfig.TrafficFrameRate{
-							Type_: ixconfig.String("percentLineRate"),
-							Rate:  ixconfig.NumberUint32(20),
-						},
-					}},
-				}},
-			},
-		}
-		return &ixATE{
-			cfg: cfg,
-			flowToTrafficItem: map[string]*ixconfig.TrafficTrafficItem{
-				flowName: cfg.Traffic.TrafficItem[0],
-			},
-		}
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
 	}
-
 	tests := []struct {
-		desc         string
-		flow         *opb.Flow
-		patchSizeErr error
-		patchRateErr error
-		wantErr      string
+		desc      string
+		l1        *opb.PortL1Config
+		wantPatch map[string]interface{}
+		wantErr   string
 	}{{
-		desc:    "non-existent flow",
-		flow:    &opb.Flow{Name: "invalid"},
-		wantErr: "does not exist",
-	}, {
-		desc: "bad frame size for flow",
-		flow: &opb.Flow{
-			Name: flowName,
-			FrameSize: &opb.FrameSize{
-				Type: &opb.FrameSize_ImixPreset_{
-					ImixPreset: opb.FrameSize_IMIX_DEFAULT,
-				},
-			},
-			FrameRate: &opb.FrameRate{
-				Type: &opb.FrameRate_Bps{Bps: 1500},
-			},
+		desc:    "unsupported speed",
+		l1:      &opb.PortL1Config{SpeedGbps: 1},
+		wantErr: "unsupported port speed",
+	}, {
+		desc:    "invalid FEC mode",
+		l1:      &opb.PortL1Config{FecMode: opb.PortL1Config_FecMode(100)},
+		wantErr: "unrecognized FEC mode",
+	}, {
+		desc:      "no settings",
+		l1:        &opb.PortL1Config{},
+		wantPatch: map[string]interface{}{},
+	}, {
+		desc: "speed only",
+		l1:   &opb.PortL1Config{SpeedGbps: 100},
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{"speed": "speed100g"},
 		},
-		wantErr: "cannot switch to",
 	}, {
-		desc: "frame size patch error",
-		flow: &opb.Flow{
-			Name: flowName,
-			FrameSize: &opb.FrameSize{
-				Type: &opb.FrameSize_Fixed{
-					Fixed: 1500,
-				},
-			},
-			FrameRate: &opb.FrameRate{
-				Type: &opb.FrameRate_Bps{Bps: 1500},
+		desc: "RS FEC and auto-negotiation",
+		l1: &opb.PortL1Config{
+			SpeedGbps:       25,
+			FecMode:         opb.PortL1Config_FEC_MODE_RS,
+			AutoNegotiation: opb.PortL1Config_AUTO_NEGOTIATION_ENABLED,
+		},
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{
+				"speed":                 "speed25g",
+				"forceDisableFEC":       false,
+				"enableRsFec":           true,
+				"rsFecForceOn":          true,
+				"firecodeForceOn":       false,
+				"enableAutoNegotiation": true,
+				"ieeeL1Defaults":        false,
 			},
 		},
-		patchSizeErr: errors.New("error patching size"),
-		wantErr:      "error patching size",
 	}, {
-		desc: "frame rate patch error",
-		flow: &opb.Flow{
-			Name: flowName,
-			FrameSize: &opb.FrameSize{
-				Type: &opb.FrameSize_Fixed{
-					Fixed: 1500,
-				},
-			},
-			FrameRate: &opb.FrameRate{
-				Type: &opb.FrameRate_Bps{Bps: 1500},
+		desc: "firecode FEC",
+		l1:   &opb.PortL1Config{FecMode: opb.PortL1Config_FEC_MODE_FIRECODE},
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{
+				"forceDisableFEC": false,
+				"enableRsFec":     false,
+				"rsFecForceOn":    false,
+				"firecodeForceOn": true,
+				"ieeeL1Defaults":  false,
 			},
 		},
-		patchRateErr: errors.New("error patching rate"),
-		wantErr:      "error patching rate",
 	}, {
-		desc: "successful update",
-		flow: &opb.Flow{
-			Name: flowName,
-			FrameSize: &opb.FrameSize{
-				Type: &opb.FrameSize_Fixed{
-					Fixed: 1500,
-				},
-			},
-			FrameRate: &opb.FrameRate{
-				Type: &opb.FrameRate_Bps{Bps: 1500},
+		desc: "FEC and auto-negotiation disabled",
+		l1: &opb.PortL1Config{
+			FecMode:         opb.PortL1Config_FEC_MODE_NONE,
+			AutoNegotiation: opb.PortL1Config_AUTO_NEGOTIATION_DISABLED,
+		},
+		wantPatch: map[string]interface{}{
+			l1Path + "/novusHundredGigLan": map[string]interface{}{
+				"forceDisableFEC":       true,
+				"enableRsFec":           false,
+				"rsFecForceOn":          false,
+				"firecodeForceOn":       false,
+				"enableAutoNegotiation": false,
+				"ieeeL1Defaults":        false,
 			},
 		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			defer restoreStubs()
-			c := baseClient()
-			c.c = &fakeCfgClient{
-				session: &fakeSession{
-					patchErrs: map[string]error{
-						path.Join(tiID, "highLevelStream/1", "frameSize"): test.patchSizeErr,
-						path.Join(tiID, "highLevelStream/1", "frameRate"): test.patchRateErr,
-					},
+			sess := &fakeSession{
+				getRsps: map[string]string{l1Path: `{"currentType": "novusHundredGigLan"}`},
+				patches: map[string]interface{}{},
+			}
+			c := &ixATE{
+				cfg:   cfg,
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c: &fakeCfgClient{
+					session:   sess,
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
 				},
-				xPathToID: map[string]string{tiXP.String(): tiID},
 			}
-
-			gotErr := updateFlows(context.Background(), c, []*opb.Flow{test.flow})
+			gotErr := c.SetPortL1Config(context.Background(), port, test.l1)
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("updateFlows: got err: %v, want err %q", gotErr, test.wantErr)
+				t.Fatalf("SetPortL1Config: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, sess.patches); diff != "" {
+				t.Errorf("SetPortL1Config: unexpected patches (-want +got): %s", diff)
 			}
 		})
 	}
 }
 
-func TestStart(t *testing.T) {
-	const (
-		applyTrafficOp = "traffic/operations/apply"
-		startTrafficOp = "traffic/operations/start"
-	)
+func TestStartCapture(t *testing.T) {
+	const port = "1/1"
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}},
+	}
 	tests := []struct {
-		desc                          string
-		applyErr, startErr, egressErr error
-		wantErr                       string
+		desc      string
+		ports     []string
+		patchErr  error
+		postErr   error
+		wantPatch map[string]interface{}
+		wantErr   string
 	}{{
-		desc:     "failed traffic apply op",
-		applyErr: errors.New("failed apply op on traffic items"),
-		wantErr:  "could not apply",
+		desc:    "no ports",
+		wantErr: "no ports",
 	}, {
-		desc:     "failed start traffic op",
-		startErr: errors.New("failed to start traffic"),
-		wantErr:  "could not start traffic",
+		desc:    "invalid port",
+		ports:   []string{"2/2"},
+		wantErr: "does not exist in current config",
 	}, {
-		desc:      "failed to configure egress tracking",
-		egressErr: errors.New("failed to config egress tracking"),
-		wantErr:   "could not set egress stat tracking",
+		desc:     "error enabling capture",
+		ports:    []string{port},
+		patchErr: errors.New("patch error"),
+		wantErr:  "could not enable capture",
 	}, {
-		desc: "no errors",
+		desc:    "error starting capture",
+		ports:   []string{port},
+		postErr: errors.New("post error"),
+		wantErr: "could not start capture",
+	}, {
+		desc:  "success",
+		ports: []string{port},
+		wantPatch: map[string]interface{}{
+			"/id/to/vport/capture": map[string]interface{}{"hardwareEnabled": true},
+		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			defer restoreStubs()
+			sess := &fakeSession{
+				patchErrs: map[string]error{"/id/to/vport/capture": test.patchErr},
+				patches:   map[string]interface{}{},
+				postErrs:  map[string]error{"operations/startcapture": test.postErr},
+			}
 			c := &ixATE{
-				cfg: &ixconfig.Ixnetwork{
-					Traffic: &ixconfig.Traffic{
-						TrafficItem: []*ixconfig.TrafficTrafficItem{{}},
-					},
+				cfg:   cfg,
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c: &fakeCfgClient{
+					session:   sess,
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
 				},
-				c: &fakeCfgClient{session: &fakeSession{
-					postErrs: map[string]error{
-						applyTrafficOp: test.applyErr,
-						startTrafficOp: test.startErr,
-					},
-					stats: &fakeStats{
-						egressErr: test.egressErr,
-					},
-				}},
-				egressTrackingFlows: []string{"someFlow"},
 			}
-			gotErr := startTraffic(context.Background(), c)
+			gotErr := c.StartCapture(context.Background(), test.ports)
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("startTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+				t.Fatalf("StartCapture: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, sess.patches); diff != "" {
+				t.Errorf("StartCapture: unexpected patches (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff(test.ports, c.capturePorts); diff != "" {
+				t.Errorf("StartCapture: unexpected capture ports (-want +got): %s", diff)
 			}
 		})
 	}
 }
 
-func TestStartTraffic(t *testing.T) {
+func TestStopCapture(t *testing.T) {
+	const (
+		port    = "1/1"
+		capFile = "capture/ate_1_1_HW.cap"
+	)
+	capBytes := []byte{0xd4, 0xc3, 0xb2, 0xa1}
+	vport := &ixconfig.Vport{Name: ixconfig.String("ate/" + port)}
 	tests := []struct {
-		desc                               string
-		operState                          operState
-		intfsFile, reqFile, wantCfgFile    string
-		resetTrafficCfgErr, resolveMacsErr error
-		importErr                          error
-		updateIDsErr                       error
-		genErr                             error
-		flowsErr                           error
-		startErr                           error
-		wantErr                            bool
+		desc         string
+		capturePorts []string
+		postErrs     map[string]error
+		downloadErr  error
+		wantSaveArgs interface{}
+		want         map[string][]byte
+		wantErr      string
 	}{{
-		desc:      "protocols not running",
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-		wantErr:   true,
-	}, {
-		desc:      "traffic already started",
-		operState: operStateTrafficOn,
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-	}, {
-		desc:               "failed traffic reset",
-		operState:          operStateProtocolsOn,
-		resetTrafficCfgErr: errors.New("failed to reset traffic config"),
-		intfsFile:          "no_intfs.textproto",
-		reqFile:            "no_flows.textproto",
-		wantErr:            true,
+		desc:    "no capture started",
+		wantErr: "no capture started",
+	}, {
+		desc:         "error stopping capture",
+		capturePorts: []string{port},
+		postErrs:     map[string]error{"operations/stopcapture": errors.New("post error")},
+		wantErr:      "could not stop capture",
+	}, {
+		desc:         "error saving capture files",
+		capturePorts: []string{port},
+		postErrs:     map[string]error{"operations/savecapturefiles": errors.New("post error")},
+		wantErr:      "could not save capture files",
+	}, {
+		desc:         "error downloading capture file",
+		capturePorts: []string{port},
+		downloadErr:  errors.New("download error"),
+		wantErr:      "could not download capture file",
+	}, {
+		desc:         "success",
+		capturePorts: []string{port},
+		wantSaveArgs: ixweb.OpArgs{"/persist/capture"},
+		want:         map[string][]byte{port: capBytes},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{
+				getRsps:  map[string]string{"globals": `{"persistencePath": "/persist"}`},
+				postErrs: test.postErrs,
+				posts:    map[string]interface{}{},
+				files: &fakeFiles{
+					downloadRes: map[string][]byte{capFile: capBytes},
+					downloadErr: test.downloadErr,
+				},
+			}
+			c := &ixATE{
+				ports:        map[string]*ixconfig.Vport{port: vport},
+				capturePorts: test.capturePorts,
+				c:            &fakeCfgClient{session: sess},
+			}
+			got, gotErr := c.StopCapture(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("StopCapture: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(test.wantSaveArgs, sess.posts["operations/savecapturefiles"]); diff != "" {
+				t.Errorf("StopCapture: unexpected save args (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("StopCapture: unexpected captures (-want +got): %s", diff)
+			}
+			if c.capturePorts != nil {
+				t.Errorf("StopCapture: got capture ports %v, want none", c.capturePorts)
+			}
+		})
+	}
+}
+
+func TestSetLAGMemberState(t *testing.T) {
+	const (
+		lagName = "someLAG"
+		port    = "1/1"
+		other   = "2/2"
+	)
+	cfg := &ixconfig.Ixnetwork{
+		Vport: []*ixconfig.Vport{{Name: ixconfig.String(port)}, {Name: ixconfig.String(other)}},
+		Lag:   []*ixconfig.Lag{{Name: ixconfig.String(lagName)}},
+	}
+
+	tests := []struct {
+		desc, lag, port string
+		wantErr         string
+	}{{
+		desc:    "invalid LAG",
+		lag:     "otherLAG",
+		port:    port,
+		wantErr: "LAG \"otherLAG\" does not exist",
 	}, {
-		desc:           "failed mac resolution",
-		operState:      operStateProtocolsOn,
-		resolveMacsErr: errors.New("failed to resolve macs"),
-		intfsFile:      "no_intfs.textproto",
-		reqFile:        "no_flows.textproto",
-		wantErr:        true,
+		desc:    "port not in LAG",
+		lag:     lagName,
+		port:    other,
+		wantErr: "not a member",
 	}, {
-		desc:      "failed traffic config push",
-		operState: operStateProtocolsOn,
-		importErr: errors.New("traffic config push failed"),
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-		wantErr:   true,
+		desc:    "invalid port",
+		lag:     lagName,
+		port:    "3/3",
+		wantErr: "not a member",
 	}, {
-		desc:         "failed traffic item ID update",
-		operState:    operStateProtocolsOn,
+		desc: "successfully set member state",
+		lag:  lagName,
+		port: port,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				cfg:      cfg,
+				ports:    map[string]*ixconfig.Vport{port: cfg.Vport[0], other: cfg.Vport[1]},
+				lags:     map[string]*ixconfig.Lag{lagName: cfg.Lag[0]},
+				lagPorts: map[*ixconfig.Lag][]*ixconfig.Vport{cfg.Lag[0]: {cfg.Vport[0]}},
+				c: &fakeCfgClient{
+					session:   &fakeSession{},
+					xPathToID: map[string]string{"/vport[1]": "/id/to/vport"},
+				},
+			}
+
+			gotErr := c.SetLAGMemberState(context.Background(), test.lag, test.port, false)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("SetLAGMemberState: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveMacs(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		ethMac   = "11:11:11:11:11:11"
+		ipv4Mac  = "22:22:22:22:22:22"
+		ipv6Mac  = "33:33:33:33:33:33"
+		valuesOp = "multivalue/operations/getvalues"
+		ipv4ID   = "id/to/ipv4"
+		ipv6ID   = "id/to/ipv6"
+	)
+	macXP := parseXPath(t, "/fake/xpath/mac")
+	ipv4XP := parseXPath(t, "/fake/xpath/ipv4")
+	ipv6XP := parseXPath(t, "/fake/xpath/ipv6")
+	cfg := &ixconfig.Ixnetwork{
+		Topology: []*ixconfig.Topology{{
+			DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+				Ethernet: []*ixconfig.TopologyEthernet{{
+					Mac:  &ixconfig.Multivalue{Xpath: macXP},
+					Ipv4: []*ixconfig.TopologyIpv4{{Xpath: ipv4XP}},
+					Ipv6: []*ixconfig.TopologyIpv6{{Xpath: ipv6XP}},
+				}},
+			}},
+		}},
+	}
+	dg := cfg.Topology[0].DeviceGroup[0]
+	eth := dg.Ethernet[0]
+
+	tests := []struct {
+		desc                                 string
+		ethMacRsp, ipv4MacRsp, ipv6MacRsp    string
+		idErr, ethErr, ipv4Err, ipv6Err      error
+		wantErr                              bool
+		wantEthMac, wantIpv4Mac, wantIpv6Mac string
+	}{{
+		desc:    "failed ID update",
+		idErr:   errors.New("failed to update IDs"),
+		wantErr: true,
+	}, {
+		desc:    "failed eth mac resolution",
+		ethErr:  errors.New("failed eth mac multivalue fetch"),
+		wantErr: true,
+	}, {
+		desc:    "failed IPv4 mac resolution",
+		ipv4Err: errors.New("failed IPv4 mac resolution"),
+		wantErr: true,
+	}, {
+		desc:    "failed IPv6 mac resolution",
+		ipv6Err: errors.New("failed IPv6 mac resolution"),
+		wantErr: true,
+	}, {
+		desc:        "ignore invalid mac",
+		ethMacRsp:   ethMac,
+		ipv4MacRsp:  "removePacket[Unresolved]",
+		ipv6MacRsp:  ipv6Mac,
+		wantEthMac:  ethMac,
+		wantIpv6Mac: ipv6Mac,
+	}, {
+		desc:        "successful mac resolution",
+		ethMacRsp:   ethMac,
+		ipv4MacRsp:  ipv4Mac,
+		ipv6MacRsp:  ipv6Mac,
+		wantEthMac:  ethMac,
+		wantIpv4Mac: ipv4Mac,
+		wantIpv6Mac: ipv6Mac,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				cfg: cfg,
+				c: &fakeCfgClient{
+					xPathToID: map[string]string{
+						macXP.String():  "id/to/mac",
+						ipv4XP.String(): ipv4ID,
+						ipv6XP.String(): ipv6ID,
+					},
+					updateIDErr: test.idErr,
+					session: &fakeSession{
+						getRsps: map[string]string{
+							ipv4ID: fmt.Sprintf("{\"resolvedGatewayMac\": [\"%s\"]}", test.ipv4MacRsp),
+							ipv6ID: fmt.Sprintf("{\"resolvedGatewayMac\": [\"%s\"]}", test.ipv6MacRsp),
+						},
+						getErrs: map[string]error{
+							ipv4ID: test.ipv4Err,
+							ipv6ID: test.ipv6Err,
+						},
+						postRsps: map[string]string{
+							valuesOp: fmt.Sprintf("[\"%s\"]", test.ethMacRsp),
+						},
+						postErrs: map[string]error{
+							valuesOp: test.ethErr,
+						},
+					},
+				},
+				intfs: map[string]*intf{
+					intfName: &intf{
+						deviceGroup: dg,
+						ipv4:        eth.Ipv4[0],
+						ipv6:        eth.Ipv6[0],
+					},
+				},
+			}
+
+			gotErr := resolveMacs(context.Background(), c)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("resolveMacs: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			}
+			intf := c.intfs[intfName]
+			if intf.ethMac != test.wantEthMac {
+				t.Errorf("resolveMacs: wrong value for resolved eth mac: got %q, want %q", intf.ethMac, test.wantEthMac)
+			}
+			if intf.resolvedIpv4Mac != test.wantIpv4Mac {
+				t.Errorf("resolveMacs: wrong value for resolved IPv4 mac: got %q, want %q", intf.resolvedIpv4Mac, test.wantIpv4Mac)
+			}
+			if intf.resolvedIpv6Mac != test.wantIpv6Mac {
+				t.Errorf("resolveMacs: wrong value for resolved IPv6 mac: got %q, want %q", intf.resolvedIpv6Mac, test.wantIpv6Mac)
+			}
+		})
+	}
+}
+
+func TestResetTrafficCfg(t *testing.T) {
+	const (
+		stopTrafficOp     = "traffic/operations/stop"
+		clearStatsOp      = "operations/clearstats"
+		deleteTrafficPath = "traffic/trafficItem"
+	)
+	defer restoreStubs()
+	sleepFn = func(time.Duration) {}
+
+	tests := []struct {
+		desc                          string
+		stopTrafficErr, clearStatsErr error
+		deleteTrafficErr              error
+		wantErr                       bool
+	}{{
+		desc:           "failed to stop traffic",
+		stopTrafficErr: errors.New("stop traffic error"),
+		wantErr:        true,
+	}, {
+		desc:          "failed to clear stats",
+		clearStatsErr: errors.New("clear stats error"),
+		wantErr:       true,
+	}, {
+		desc:             "failed to delete traffic config",
+		deleteTrafficErr: errors.New("delete traffic error"),
+		wantErr:          true,
+	}, {
+		desc: "successful traffic reset",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{
+						deleteErrs: map[string]error{
+							deleteTrafficPath: test.deleteTrafficErr,
+						},
+						postErrs: map[string]error{
+							stopTrafficOp: test.stopTrafficErr,
+							clearStatsOp:  test.clearStatsErr,
+						},
+					},
+				},
+				cfg:       &ixconfig.Ixnetwork{},
+				operState: operStateTrafficOn,
+			}
+
+			gotErr := resetIxiaTrafficCfg(context.Background(), c)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("resetIxiaTrafficCfg: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenTraffic(t *testing.T) {
+	const generateOp = "traffic/trafficItem/operations/generate"
+	tiXP := parseXPath(t, "/fake/xpath/trafficItem")
+	cfg := &ixconfig.Ixnetwork{
+		Traffic: &ixconfig.Traffic{
+			TrafficItem: []*ixconfig.TrafficTrafficItem{{Xpath: tiXP}},
+		},
+	}
+
+	tests := []struct {
+		desc        string
+		generateErr error
+		wantErr     string
+	}{{
+		desc:        "failed traffic item generate op",
+		generateErr: errors.New("failed generate op on traffic items"),
+		wantErr:     "could not generate",
+	}, {
+		desc: "no errors",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer restoreStubs()
+			c := &ixATE{
+				cfg: cfg,
+				c: &fakeCfgClient{
+					xPathToID: map[string]string{tiXP.String(): "/id/to/trafficitem"},
+					session: &fakeSession{
+						postErrs: map[string]error{generateOp: test.generateErr},
+					},
+				},
+				egressTrackingFlows: []string{"someFlow"},
+			}
+			gotErr := genTraffic(context.Background(), c)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("genTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateFlows(t *testing.T) {
+	const (
+		flowName = "someFlow"
+		tiID     = "id/to/traffic/item"
+	)
+	tiXP := parseXPath(t, "/fake/xpath/trafficItem")
+	baseClient := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Traffic: &ixconfig.Traffic{
+				TrafficItem: []*ixconfig.TrafficTrafficItem{{
+					Xpath: tiXP,
+					ConfigElement: []*ixconfig.TrafficConfigElement{{
+						FrameSize: &ixconfig.TrafficFrameSize{
+							Type_:     ixconfig.String("fixed"),
+							FixedSize: ixconfig.NumberInt(1000),
+						},
+						FrameRate: &ixconfig.TrafficFrameRate{
+							Type_: ixconfig.String("percentLineRate"),
+							Rate:  ixconfig.NumberUint32(20),
+						},
+					}},
+				}},
+			},
+		}
+		return &ixATE{
+			cfg: cfg,
+			flowToTrafficItem: map[string]*ixconfig.TrafficTrafficItem{
+				flowName: cfg.Traffic.TrafficItem[0],
+			},
+		}
+	}
+
+	tests := []struct {
+		desc         string
+		flow         *opb.Flow
+		patchSizeErr error
+		patchRateErr error
+		wantErr      string
+	}{{
+		desc:    "non-existent flow",
+		flow:    &opb.Flow{Name: "invalid"},
+		wantErr: "does not exist",
+	}, {
+		desc: "bad frame size for flow",
+		flow: &opb.Flow{
+			Name: flowName,
+			FrameSize: &opb.FrameSize{
+				Type: &opb.FrameSize_ImixPreset_{
+					ImixPreset: opb.FrameSize_IMIX_DEFAULT,
+				},
+			},
+			FrameRate: &opb.FrameRate{
+				Type: &opb.FrameRate_Bps{Bps: 1500},
+			},
+		},
+		wantErr: "cannot switch to",
+	}, {
+		desc: "frame size patch error",
+		flow: &opb.Flow{
+			Name: flowName,
+			FrameSize: &opb.FrameSize{
+				Type: &opb.FrameSize_Fixed{
+					Fixed: 1500,
+				},
+			},
+			FrameRate: &opb.FrameRate{
+				Type: &opb.FrameRate_Bps{Bps: 1500},
+			},
+		},
+		patchSizeErr: errors.New("error patching size"),
+		wantErr:      "error patching size",
+	}, {
+		desc: "frame rate patch error",
+		flow: &opb.Flow{
+			Name: flowName,
+			FrameSize: &opb.FrameSize{
+				Type: &opb.FrameSize_Fixed{
+					Fixed: 1500,
+				},
+			},
+			FrameRate: &opb.FrameRate{
+				Type: &opb.FrameRate_Bps{Bps: 1500},
+			},
+		},
+		patchRateErr: errors.New("error patching rate"),
+		wantErr:      "error patching rate",
+	}, {
+		desc: "successful update",
+		flow: &opb.Flow{
+			Name: flowName,
+			FrameSize: &opb.FrameSize{
+				Type: &opb.FrameSize_Fixed{
+					Fixed: 1500,
+				},
+			},
+			FrameRate: &opb.FrameRate{
+				Type: &opb.FrameRate_Bps{Bps: 1500},
+			},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer restoreStubs()
+			c := baseClient()
+			c.c = &fakeCfgClient{
+				session: &fakeSession{
+					patchErrs: map[string]error{
+						path.Join(tiID, "highLevelStream/1", "frameSize"): test.patchSizeErr,
+						path.Join(tiID, "highLevelStream/1", "frameRate"): test.patchRateErr,
+					},
+				},
+				xPathToID: map[string]string{tiXP.String(): tiID},
+			}
+
+			gotErr := updateFlows(context.Background(), c, []*opb.Flow{test.flow})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("updateFlows: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateFrameRates(t *testing.T) {
+	const (
+		flowName = "someFlow"
+		tiID     = "id/to/traffic/item"
+	)
+	tiXP := parseXPath(t, "/fake/xpath/trafficItem")
+	ratePath := path.Join(tiID, "highLevelStream/1", "frameRate")
+
+	tests := []struct {
+		desc         string
+		operState    operState
+		flow         *opb.Flow
+		patchRateErr error
+		wantPatch    map[string]interface{}
+		wantErr      string
+	}{{
+		desc:      "traffic not started",
+		operState: operStateProtocolsOn,
+		flow:      &opb.Flow{Name: flowName, FrameRate: &opb.FrameRate{Type: &opb.FrameRate_Percent{Percent: 50}}},
+		wantErr:   "before traffic has been started",
+	}, {
+		desc:      "no frame rate",
+		operState: operStateTrafficOn,
+		flow:      &opb.Flow{Name: flowName},
+		wantErr:   "no frame rate specified",
+	}, {
+		desc:      "non-existent flow",
+		operState: operStateTrafficOn,
+		flow:      &opb.Flow{Name: "invalid", FrameRate: &opb.FrameRate{Type: &opb.FrameRate_Percent{Percent: 50}}},
+		wantErr:   "does not exist",
+	}, {
+		desc:         "frame rate patch error",
+		operState:    operStateTrafficOn,
+		flow:         &opb.Flow{Name: flowName, FrameRate: &opb.FrameRate{Type: &opb.FrameRate_Percent{Percent: 50}}},
+		patchRateErr: errors.New("error patching rate"),
+		wantErr:      "error patching rate",
+	}, {
+		desc:      "switch rate type",
+		operState: operStateTrafficOn,
+		flow:      &opb.Flow{Name: flowName, FrameRate: &opb.FrameRate{Type: &opb.FrameRate_Fps{Fps: 1000}}},
+		wantPatch: map[string]interface{}{
+			"type": "framesPerSecond",
+			"rate": uint64(1000),
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			fs := &fakeSession{
+				patchErrs: map[string]error{ratePath: test.patchRateErr},
+				patches:   make(map[string]interface{}),
+			}
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session:   fs,
+					xPathToID: map[string]string{tiXP.String(): tiID},
+				},
+				operState: test.operState,
+				flowToTrafficItem: map[string]*ixconfig.TrafficTrafficItem{
+					flowName: {Xpath: tiXP},
+				},
+			}
+			gotErr := c.UpdateFrameRates(context.Background(), []*opb.Flow{test.flow})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("UpdateFrameRates: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if diff := cmp.Diff(test.wantPatch, fs.patches[ratePath]); diff != "" {
+				t.Errorf("UpdateFrameRates: unexpected frame rate patch (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	const (
+		applyTrafficOp = "traffic/operations/apply"
+		startTrafficOp = "traffic/operations/start"
+	)
+	tests := []struct {
+		desc                          string
+		applyErr, startErr, egressErr error
+		wantErr                       string
+	}{{
+		desc:     "failed traffic apply op",
+		applyErr: errors.New("failed apply op on traffic items"),
+		wantErr:  "could not apply",
+	}, {
+		desc:     "failed start traffic op",
+		startErr: errors.New("failed to start traffic"),
+		wantErr:  "could not start traffic",
+	}, {
+		desc:      "failed to configure egress tracking",
+		egressErr: errors.New("failed to config egress tracking"),
+		wantErr:   "could not set egress stat tracking",
+	}, {
+		desc: "no errors",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer restoreStubs()
+			c := &ixATE{
+				cfg: &ixconfig.Ixnetwork{
+					Traffic: &ixconfig.Traffic{
+						TrafficItem: []*ixconfig.TrafficTrafficItem{{}},
+					},
+				},
+				c: &fakeCfgClient{session: &fakeSession{
+					postErrs: map[string]error{
+						applyTrafficOp: test.applyErr,
+						startTrafficOp: test.startErr,
+					},
+					stats: &fakeStats{
+						egressErr: test.egressErr,
+					},
+				}},
+				egressTrackingFlows: []string{"someFlow"},
+			}
+			gotErr := startTraffic(context.Background(), c)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("startTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestStartTraffic(t *testing.T) {
+	tests := []struct {
+		desc                               string
+		operState                          operState
+		intfsFile, reqFile, wantCfgFile    string
+		resetTrafficCfgErr, resolveMacsErr error
+		importErr                          error
+		updateIDsErr                       error
+		genErr                             error
+		flowsErr                           error
+		startErr                           error
+		wantErr                            bool
+	}{{
+		desc:      "protocols not running",
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+		wantErr:   true,
+	}, {
+		desc:      "traffic already started",
+		operState: operStateTrafficOn,
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+	}, {
+		desc:               "failed traffic reset",
+		operState:          operStateProtocolsOn,
+		resetTrafficCfgErr: errors.New("failed to reset traffic config"),
+		intfsFile:          "no_intfs.textproto",
+		reqFile:            "no_flows.textproto",
+		wantErr:            true,
+	}, {
+		desc:           "failed mac resolution",
+		operState:      operStateProtocolsOn,
+		resolveMacsErr: errors.New("failed to resolve macs"),
+		intfsFile:      "no_intfs.textproto",
+		reqFile:        "no_flows.textproto",
+		wantErr:        true,
+	}, {
+		desc:      "failed traffic config push",
+		operState: operStateProtocolsOn,
+		importErr: errors.New("traffic config push failed"),
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+		wantErr:   true,
+	}, {
+		desc:         "failed traffic item ID update",
+		operState:    operStateProtocolsOn,
 		updateIDsErr: errors.New("traffic item IDs not updated"),
 		intfsFile:    "no_intfs.textproto",
 		reqFile:      "no_flows.textproto",
 		wantErr:      true,
 	}, {
-		desc:      "failed to generate traffic",
+		desc:      "failed to generate traffic",
+		operState: operStateProtocolsOn,
+		genErr:    errors.New("failed to generate traffic items"),
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+		wantErr:   true,
+	}, {
+		desc:      "failed to update traffic flows",
+		operState: operStateProtocolsOn,
+		flowsErr:  errors.New("failed to update traffic flows"),
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+		wantErr:   true,
+	}, {
+		desc:      "failed to start traffic flows",
+		operState: operStateProtocolsOn,
+		startErr:  errors.New("failed to start traffic flows"),
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "no_flows.textproto",
+		wantErr:   true,
+	}, {
+		desc:      "invalid flows",
+		operState: operStateProtocolsOn,
+		intfsFile: "no_intfs.textproto",
+		reqFile:   "ipv4_flow_with_egress.textproto",
+		wantErr:   true,
+	}, {
+		desc:        "no flows configured",
+		operState:   operStateProtocolsOn,
+		intfsFile:   "no_intfs.textproto",
+		reqFile:     "no_flows.textproto",
+		wantCfgFile: "no_flows_cfg.json",
+	}, {
+		desc:        "ipv4 with egress tracking",
+		operState:   operStateProtocolsOn,
+		intfsFile:   "intfs_for_flows.textproto",
+		reqFile:     "ipv4_flow_with_egress.textproto",
+		wantCfgFile: "ipv4_flow_with_egress.json",
+	}, {
+		desc:        "ipv6 with flow labels",
+		operState:   operStateProtocolsOn,
+		intfsFile:   "intfs_for_flows.textproto",
+		reqFile:     "ipv6_flow_with_labels.textproto",
+		wantCfgFile: "ipv6_flow_with_labels.json",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer restoreStubs()
+			sleepFn = func(time.Duration) {}
+			resetIxiaTrafficCfgFn = func(_ context.Context, ix *ixATE) error {
+				ix.flowToTrafficItem = map[string]*ixconfig.TrafficTrafficItem{}
+				return nil
+			}
+			c := &ixATE{
+				c: &fakeCfgClient{importErrs: []error{nil, nil, nil}},
+			}
+			top := readTopology(t, test.intfsFile)
+			if err := c.PushTopology(context.Background(), top); err != nil {
+				t.Fatalf("Failed to configure initial topology for StartTraffic test: %v", err)
+			}
+
+			// Swap out no-op config client with the one to use for StartTraffic testing.
+			fc := &fakeCfgClient{
+				importErrs:  []error{test.importErr},
+				updateIDErr: test.updateIDsErr,
+			}
+			c.c = fc
+			c.operState = test.operState
+			// Initialize stub functions for StartTraffic testing.
+			resetIxiaTrafficCfgFn = func(context.Context, *ixATE) error {
+				return test.resetTrafficCfgErr
+			}
+			resolveMacsFn = func(context.Context, *ixATE) error {
+				return test.resolveMacsErr
+			}
+			genTrafficFn = func(context.Context, *ixATE) error {
+				return test.genErr
+			}
+			updateFlowsFn = func(context.Context, *ixATE, []*opb.Flow) error {
+				return test.flowsErr
+			}
+			startTrafficFn = func(context.Context, *ixATE) error {
+				return test.startErr
+			}
+
+			flows := toFlows(t, test.reqFile)
+			gotErr := c.StartTraffic(context.Background(), flows)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("StartTraffic: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			}
+
+			if !test.wantErr && test.wantCfgFile != "" {
+				wantCfg := toTrafficCfg(t, test.wantCfgFile)
+				if diff := jsonCfgDiff(t, wantCfg, fc.lastImportCfg); diff != "" {
+					t.Fatalf("StartTraffic: Unexpected traffic config pushed, diff (-want, +got)\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateTraffic(t *testing.T) {
+	tests := []struct {
+		desc      string
+		operState operState
+		flowsErr  error
+		wantErr   string
+	}{{
+		desc:    "traffic not started",
+		wantErr: "cannot update traffic before it has been started",
+	}, {
+		desc:      "non-existent flow",
+		operState: operStateTrafficOn,
+		flowsErr:  errors.New("failed to update traffic flows"),
+		wantErr:   "failed to update",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer restoreStubs()
+			c := &ixATE{operState: test.operState}
+			updateFlowsFn = func(context.Context, *ixATE, []*opb.Flow) error {
+				return test.flowsErr
+			}
+			gotErr := c.UpdateTraffic(context.Background(), nil)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("UpdateTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestStopAllTraffic(t *testing.T) {
+	const op = "traffic/operations/stop"
+	defer restoreStubs()
+	sleepFn = func(time.Duration) {}
+	tests := []struct {
+		desc      string
+		operState operState
+		opErr     error
+		wantErr   bool
+	}{{
+		desc: "traffic not started",
+	}, {
+		desc:      "Error from stop traffic op",
+		operState: operStateTrafficOn,
+		opErr:     errors.New("someError"),
+		wantErr:   true,
+	}, {
+		desc:      "Successfully stopped",
+		operState: operStateTrafficOn,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{postErrs: map[string]error{op: test.opErr}},
+				},
+				operState: test.operState,
+			}
+			gotErr := c.StopAllTraffic(context.Background())
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("StopAllTraffic: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestAwaitTrafficStopped(t *testing.T) {
+	defer restoreStubs()
+	sleepFn = func(time.Duration) {}
+	tests := []struct {
+		desc      string
+		operState operState
+		rsp       string
+		getErr    error
+		wantErr   string
+	}{{
+		desc:    "traffic not started",
+		wantErr: "traffic is not running",
+	}, {
+		desc:      "error fetching traffic state",
+		operState: operStateTrafficOn,
+		getErr:    errors.New("someError"),
+		wantErr:   "someError",
+	}, {
+		desc:      "traffic does not stop",
+		operState: operStateTrafficOn,
+		rsp:       `{"state": "started"}`,
+		wantErr:   "did not stop",
+	}, {
+		desc:      "traffic stopped",
+		operState: operStateTrafficOn,
+		rsp:       `{"state": "stopped"}`,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{
+						getRsps: map[string]string{"traffic": test.rsp},
+						getErrs: map[string]error{"traffic": test.getErr},
+					},
+				},
+				operState: test.operState,
+			}
+			gotErr := c.AwaitTrafficStopped(context.Background(), time.Minute)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("AwaitTrafficStopped: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr == "" && c.operState != operStateProtocolsOn {
+				t.Errorf("AwaitTrafficStopped: got operState %v, want %v", c.operState, operStateProtocolsOn)
+			}
+		})
+	}
+}
+
+func TestReadStats(t *testing.T) {
+	defer restoreStubs()
+	captions := []string{"view1", "view2"}
+
+	tests := []struct {
+		desc     string
+		viewsOut map[string]view
+		viewsErr error
+		want     *ixgnmi.Stats
+		wantErr  bool
+	}{{
+		desc:     "error fetching views",
+		viewsErr: errors.New("someError"),
+		wantErr:  true,
+	}, {
+		desc: "error fetching table",
+		viewsOut: map[string]view{
+			"view1": &fakeView{tableOut: ixweb.StatTable{{"k1": "v1"}}},
+			"view2": &fakeView{tableErr: errors.New("someError")},
+		},
+		wantErr: true,
+	}, {
+		desc: "no error",
+		viewsOut: map[string]view{
+			"view1": &fakeView{tableOut: ixweb.StatTable{{"k1": "v1"}}},
+			"view2": &fakeView{tableOut: ixweb.StatTable{{"k2": "v2"}}},
+		},
+		want: &ixgnmi.Stats{
+			EgressTrackFlows: []string{"someFlow"},
+			Tables: map[string]ixweb.StatTable{
+				"view1": ixweb.StatTable{{"k1": "v1"}},
+				"view2": ixweb.StatTable{{"k2": "v2"}},
+			},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				egressTrackingFlows: []string{"someFlow"},
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: test.viewsOut,
+						viewsErr: test.viewsErr,
+					}},
+				},
+			}
+			got, gotErr := c.readStats(context.Background(), captions)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("readStats unexpected error, got err: %v, want err? %t", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("readStats unexpected diff, got (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchConvergenceTimes(t *testing.T) {
+	tests := []struct {
+		desc     string
+		viewsOut map[string]view
+		viewsErr error
+		want     map[string]time.Duration
+		wantErr  string
+	}{{
+		desc:     "error fetching views",
+		viewsErr: errors.New("someError"),
+		wantErr:  "someError",
+	}, {
+		desc: "bad convergence time",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:     "flow1",
+				convergenceTimeColumn: "bad",
+			}}},
+		},
+		wantErr: "unexpected convergence time",
+	}, {
+		desc: "convergence times",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:     "flow1",
+				convergenceTimeColumn: "12.5",
+			}, {
+				trafficItemColumn:     "flow2",
+				convergenceTimeColumn: "",
+			}}},
+		},
+		want: map[string]time.Duration{"flow1": 12500 * time.Microsecond},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: test.viewsOut,
+						viewsErr: test.viewsErr,
+					}},
+				},
+			}
+			got, gotErr := c.FetchConvergenceTimes(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchConvergenceTimes: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchConvergenceTimes: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchFlowLatencies(t *testing.T) {
+	tests := []struct {
+		desc     string
+		viewsOut map[string]view
+		viewsErr error
+		want     map[string]*FlowLatency
+		wantErr  string
+	}{{
+		desc:     "error fetching views",
+		viewsErr: errors.New("someError"),
+		wantErr:  "someError",
+	}, {
+		desc: "latencies",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:                "flow1",
+				"Store-Forward Min Latency (ns)": "1000",
+				"Store-Forward Avg Latency (ns)": "1500",
+				"Store-Forward Max Latency (ns)": "3000",
+				"Avg Delay Variation (ns)":       "20",
+			}, {
+				trafficItemColumn: "flow2",
+				rxFramesColumn:    "10",
+			}}},
+		},
+		want: map[string]*FlowLatency{
+			"flow1": {Min: time.Microsecond, Avg: 1500, Max: 3 * time.Microsecond, Jitter: 20},
+		},
+	}, {
+		desc: "missing traffic item",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Store-Forward Avg Latency (ns)": "1500",
+			}}},
+		},
+		wantErr: "no traffic item",
+	}, {
+		desc: "invalid latency",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:                "flow1",
+				"Store-Forward Avg Latency (ns)": "slow",
+			}}},
+		},
+		wantErr: "Store-Forward Avg Latency (ns)",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: test.viewsOut,
+						viewsErr: test.viewsErr,
+					}},
+				},
+			}
+			got, gotErr := c.FetchFlowLatencies(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchFlowLatencies: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchFlowLatencies: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchDHCPServerLeases(t *testing.T) {
+	tests := []struct {
+		desc     string
+		isV6     bool
+		viewsOut map[string]view
+		viewsErr error
+		want     map[string]*DHCPServerLeases
+		wantErr  string
+	}{{
+		desc:     "error fetching views",
+		viewsErr: errors.New("someError"),
+		wantErr:  "someError",
+	}, {
+		desc: "DHCPv4 leases",
+		viewsOut: map[string]view{
+			dhcpv4ServerCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Port":                     "ixia/1/1",
+				"Current Leases Allocated": "8",
+				"Total Leases Allocated":   "10",
+				"Total Leases Renewed":     "4",
+			}}},
+			dhcpv6ServerCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Port":                       "ixia/1/1",
+				"Current Prefixes Allocated": "2",
+			}}},
+		},
+		want: map[string]*DHCPServerLeases{
+			"1/1": {Current: 8, Allocated: 10, Renewed: 4},
+		},
+	}, {
+		desc: "DHCPv6 leases",
+		isV6: true,
+		viewsOut: map[string]view{
+			dhcpv6ServerCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Port":                       "ixia/1/1",
+				"Current Prefixes Allocated": "2",
+			}}},
+		},
+		want: map[string]*DHCPServerLeases{
+			"1/1": {Current: 2},
+		},
+	}, {
+		desc: "no DHCP servers",
+		want: map[string]*DHCPServerLeases{},
+	}, {
+		desc: "invalid port",
+		viewsOut: map[string]view{
+			dhcpv4ServerCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Port": "1",
+			}}},
+		},
+		wantErr: "unexpected port",
+	}, {
+		desc: "invalid lease count",
+		viewsOut: map[string]view{
+			dhcpv4ServerCaption: &fakeView{tableOut: ixweb.StatTable{{
+				"Port":                   "ixia/1/1",
+				"Total Leases Allocated": "many",
+			}}},
+		},
+		wantErr: "Total Leases Allocated",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: test.viewsOut,
+						viewsErr: test.viewsErr,
+					}},
+				},
+			}
+			got, gotErr := c.FetchDHCPServerLeases(context.Background(), test.isV6)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchDHCPServerLeases: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchDHCPServerLeases: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchIntegrityErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		viewsOut map[string]view
+		want     map[string]uint64
+		wantErr  string
+	}{{
+		desc: "bad integrity errors",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:     "flow1",
+				integrityErrorsColumn: "bad",
+			}}},
+		},
+		wantErr: "unexpected integrity errors",
+	}, {
+		desc: "integrity errors",
+		viewsOut: map[string]view{
+			trafficItemStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				trafficItemColumn:     "flow1",
+				integrityErrorsColumn: "3",
+			}, {
+				trafficItemColumn: "flow2",
+			}}},
+		},
+		want: map[string]uint64{"flow1": 3},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{viewsOut: test.viewsOut}},
+				},
+			}
+			got, gotErr := c.FetchIntegrityErrors(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchIntegrityErrors: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchIntegrityErrors: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchPTPMeasurements(t *testing.T) {
+	const (
+		ptpIntf   = "ptpIntf"
+		otherIntf = "otherIntf"
+		ptpPort   = "ixia/1/1"
+		otherPort = "ixia/1/2"
+	)
+	tests := []struct {
+		desc     string
+		noPTP    bool
+		viewsOut map[string]view
+		want     []*PTPMeasurement
+		wantErr  string
+	}{{
+		desc:    "no PTP clocks",
+		noPTP:   true,
+		wantErr: "no PTP clocks",
+	}, {
+		desc: "bad offset",
+		viewsOut: map[string]view{
+			ptpStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				portNameColumn:     ptpPort,
+				ptpOffsetColumn:    "bad",
+				ptpPathDelayColumn: "100",
+			}}},
+		},
+		wantErr: "unexpected",
+	}, {
+		desc: "measurements",
+		viewsOut: map[string]view{
+			ptpStatsCaption: &fakeView{tableOut: ixweb.StatTable{{
+				portNameColumn:     ptpPort,
+				ptpOffsetColumn:    "-12",
+				ptpPathDelayColumn: "350.4",
+			}, {
+				portNameColumn:     otherPort,
+				ptpOffsetColumn:    "5",
+				ptpPathDelayColumn: "5",
+			}}},
+		},
+		want: []*PTPMeasurement{{
+			Interface: ptpIntf,
+			Offset:    -12 * time.Nanosecond,
+			PathDelay: 350 * time.Nanosecond,
+		}},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ptp := &opb.PtpConfig{Role: opb.PtpConfig_SLAVE}
+			if test.noPTP {
+				ptp = nil
+			}
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{viewsOut: test.viewsOut}},
+				},
+				intfOrder: []string{ptpIntf, otherIntf},
+				intfs: map[string]*intf{
+					ptpIntf:   &intf{link: &ixconfig.Vport{Name: ixconfig.String(ptpPort)}},
+					otherIntf: &intf{link: &ixconfig.Vport{Name: ixconfig.String(otherPort)}},
+				},
+				intfCfgs: map[string]*opb.InterfaceConfig{
+					ptpIntf:   {Name: ptpIntf, Ptp: ptp},
+					otherIntf: {Name: otherIntf},
+				},
+			}
+			got, gotErr := c.FetchPTPMeasurements(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchPTPMeasurements: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchPTPMeasurements: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAwaitNoLoss(t *testing.T) {
+	defer restoreStubs()
+	row := func(flow, tx, rx string) map[string]string {
+		return map[string]string{trafficItemColumn: flow, txFramesColumn: tx, rxFramesColumn: rx}
+	}
+	tests := []struct {
+		desc      string
+		flows     []string
+		tolerance float64
+		tables    []ixweb.StatTable
+		wantPolls int
+		wantErr   string
+	}{{
+		desc:    "no flows",
+		tables:  []ixweb.StatTable{nil},
+		wantErr: "no flows",
+	}, {
+		desc:    "bad frame count",
+		flows:   []string{"flow1"},
+		tables:  []ixweb.StatTable{{row("flow1", "bad", "0")}},
+		wantErr: "unexpected Tx frames",
+	}, {
+		desc:    "missing flow",
+		flows:   []string{"flow2"},
+		tables:  []ixweb.StatTable{{row("flow1", "10", "10")}, {row("flow1", "10", "10")}},
+		wantErr: `no statistics for flow "flow2"`,
+	}, {
+		desc:  "in-flight frames received",
+		flows: []string{"flow1"},
+		tables: []ixweb.StatTable{
+			{row("flow1", "1000", "900")},
+			{row("flow1", "1000", "1000")},
+			{row("flow1", "1000", "1000")},
+		},
+		wantPolls: 3,
+	}, {
+		desc:      "loss within tolerance",
+		flows:     []string{"flow1"},
+		tolerance: 1,
+		tables:    []ixweb.StatTable{{row("flow1", "1000", "995")}, {row("flow1", "1000", "995")}},
+		wantPolls: 2,
+	}, {
+		desc:      "loss exceeds tolerance",
+		flows:     []string{"flow1", "flow2"},
+		tolerance: 1,
+		tables: []ixweb.StatTable{
+			{row("flow1", "1000", "1000"), row("flow2", "1000", "900")},
+			{row("flow1", "1000", "1000"), row("flow2", "1000", "900")},
+		},
+		wantPolls: 2,
+		wantErr:   `flow "flow2" lost 10.000% of frames`,
+	}, {
+		desc:    "no frames transmitted",
+		flows:   []string{"flow1"},
+		tables:  []ixweb.StatTable{{row("flow1", "0", "0")}, {row("flow1", "0", "0")}},
+		wantErr: "transmitted no frames",
+	}, {
+		desc:  "counters never stable",
+		flows: []string{"flow1"},
+		tables: []ixweb.StatTable{
+			{row("flow1", "100", "100")},
+			{row("flow1", "200", "100")},
+			{row("flow1", "300", "200")},
+		},
+		wantPolls: 3,
+		wantErr:   "lost 33.333% of frames",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			v := &fakeView{tableOut: test.tables[0]}
+			polls := 1
+			sleepFn = func(time.Duration) {
+				v.tableOut = test.tables[polls]
+				polls++
+			}
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: map[string]view{trafficItemStatsCaption: v},
+					}},
+				},
+			}
+			window := time.Duration(len(test.tables)-1) * 5 * time.Second
+			gotErr := c.AwaitNoLoss(context.Background(), test.flows, test.tolerance, window)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("AwaitNoLoss: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantPolls != 0 && polls != test.wantPolls {
+				t.Errorf("AwaitNoLoss: got %d polls, want %d", polls, test.wantPolls)
+			}
+		})
+	}
+}
+
+func TestFetchLoadDistribution(t *testing.T) {
+	row := func(flow, port, rx string) map[string]string {
+		return map[string]string{trafficItemColumn: flow, rxPortColumn: port, rxFramesColumn: rx}
+	}
+	tests := []struct {
+		desc    string
+		ports   []string
+		table   ixweb.StatTable
+		want    *LoadDistribution
+		wantErr string
+	}{{
+		desc:    "no ports",
+		wantErr: "no ports",
+	}, {
+		desc:    "flow not tracked by ports",
+		ports:   []string{"1/1"},
+		table:   ixweb.StatTable{{trafficItemColumn: "flow1", rxFramesColumn: "10"}},
+		wantErr: "not ingress tracked by ports",
+	}, {
+		desc:    "no statistics for flow",
+		ports:   []string{"1/1"},
+		table:   ixweb.StatTable{row("flow2", "1/1", "10")},
+		wantErr: "no statistics",
+	}, {
+		desc:    "no frames received",
+		ports:   []string{"1/1", "1/2"},
+		table:   ixweb.StatTable{row("flow1", "1/1", "0"), row("flow1", "1/3", "10")},
+		wantErr: "received no frames",
+	}, {
+		desc:  "even",
+		ports: []string{"1/1", "1/2"},
+		table: ixweb.StatTable{row("flow1", "1/1", "50"), row("flow1", "1/2", "50"), row("flow2", "1/1", "100")},
+		want: &LoadDistribution{
+			Frames: map[string]uint64{"1/1": 50, "1/2": 50},
+		},
+	}, {
+		desc:  "uneven",
+		ports: []string{"1/1", "1/2", "1/3", "1/4"},
+		table: ixweb.StatTable{
+			row("flow1", "1/1", "30"),
+			row("flow1", "1/1", "30"),
+			row("flow1", "1/2", "60"),
+			row("flow1", "1/3", "40"),
+			row("flow1", "1/4", "40"),
+		},
+		want: &LoadDistribution{
+			Frames:          map[string]uint64{"1/1": 60, "1/2": 60, "1/3": 40, "1/4": 40},
+			ChiSquare:       8,
+			MaxDeviationPct: 20,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				c: &fakeCfgClient{
+					session: &fakeSession{stats: &fakeStats{
+						viewsOut: map[string]view{flowStatsCaption: &fakeView{tableOut: test.table}},
+					}},
+				},
+			}
+			got, gotErr := c.FetchLoadDistribution(context.Background(), "flow1", test.ports)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchLoadDistribution: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchLoadDistribution: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func protocolSessionsATE(t *testing.T, sess *fakeSession) *ixATE {
+	t.Helper()
+	v4Peer := &ixconfig.TopologyBgpIpv4Peer{
+		Xpath: parseXPath(t, "/topology[1]/deviceGroup[1]/ethernet[1]/ipv4[1]/bgpIpv4Peer[1]"),
+		DutIp: ixconfig.MultivalueStr("192.168.0.2"),
+	}
+	v6Peer := &ixconfig.TopologyBgpIpv6Peer{
+		Xpath: parseXPath(t, "/topology[1]/deviceGroup[1]/ethernet[1]/ipv6[1]/bgpIpv6Peer[1]"),
+		DutIp: ixconfig.MultivalueStr("aa::2"),
+	}
+	lbPeer := &ixconfig.TopologyBgpIpv4Peer{
+		Xpath: parseXPath(t, "/topology[2]/deviceGroup[1]/ipv4Loopback[1]/bgpIpv4Peer[1]"),
+		DutIp: ixconfig.MultivalueStr("192.168.1.2"),
+	}
+	isis := &ixconfig.TopologyIsisL3{
+		Xpath: parseXPath(t, "/topology[2]/deviceGroup[1]/ethernet[1]/isisL3[1]"),
+	}
+	return &ixATE{
+		cfg: &ixconfig.Ixnetwork{},
+		c: &fakeCfgClient{
+			xPathToID: map[string]string{
+				v4Peer.XPath().String(): "v4peer",
+				v6Peer.XPath().String(): "v6peer",
+				lbPeer.XPath().String(): "lbpeer",
+				isis.XPath().String():   "isis",
+			},
+			session: sess,
+		},
+		intfs: map[string]*intf{
+			"intf1": {
+				deviceGroup: &ixconfig.TopologyDeviceGroup{Ethernet: []*ixconfig.TopologyEthernet{{}}},
+				ipv4:        &ixconfig.TopologyIpv4{BgpIpv4Peer: []*ixconfig.TopologyBgpIpv4Peer{v4Peer}},
+				ipv6:        &ixconfig.TopologyIpv6{BgpIpv6Peer: []*ixconfig.TopologyBgpIpv6Peer{v6Peer}},
+			},
+			"intf2": {
+				deviceGroup: &ixconfig.TopologyDeviceGroup{Ethernet: []*ixconfig.TopologyEthernet{{
+					IsisL3: []*ixconfig.TopologyIsisL3{isis},
+				}}},
+				ipv4Loopback: &ixconfig.TopologyIpv4Loopback{BgpIpv4Peer: []*ixconfig.TopologyBgpIpv4Peer{lbPeer}},
+			},
+		},
 		operState: operStateProtocolsOn,
-		genErr:    errors.New("failed to generate traffic items"),
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-		wantErr:   true,
+	}
+}
+
+func TestFetchProtocolSessions(t *testing.T) {
+	const (
+		v4LearnedInfoOp   = "topology/deviceGroup/ethernet/ipv4/bgpIpv4Peer/operations/getAllLearnedInfo"
+		v4LBLearnedInfoOp = "topology/deviceGroup/ipv4Loopback/bgpIpv4Peer/operations/getAllLearnedInfo"
+	)
+	tests := []struct {
+		desc     string
+		getRsps  map[string]string
+		getErrs  map[string]error
+		postErrs map[string]error
+		want     *ProtocolSessions
+		wantErr  string
+	}{{
+		desc:    "error fetching session status",
+		getErrs: map[string]error{"isis": errors.New("get error")},
+		wantErr: "get error",
 	}, {
-		desc:      "failed to update traffic flows",
+		desc: "error fetching learned info",
+		getRsps: map[string]string{
+			"v4peer": `{"sessionStatus": ["up"]}`,
+		},
+		postErrs: map[string]error{v4LearnedInfoOp: errors.New("op error")},
+		wantErr:  "op error",
+	}, {
+		desc: "error fetching loopback learned info",
+		getRsps: map[string]string{
+			"lbpeer": `{"sessionStatus": ["up"]}`,
+		},
+		postErrs: map[string]error{v4LBLearnedInfoOp: errors.New("op error")},
+		wantErr:  "op error",
+	}, {
+		desc: "session states",
+		getRsps: map[string]string{
+			"v4peer":                     `{"sessionStatus": ["up"]}`,
+			"v4peer/learnedInfo/1/table": `[{"values": [["10.0.0.0"], ["10.0.1.0"]]}]`,
+			"v6peer":                     `{"sessionStatus": ["down"]}`,
+			"lbpeer":                     `{"sessionStatus": ["up"]}`,
+			"lbpeer/learnedInfo/1/table": `[{"values": [["10.2.0.0"]]}]`,
+			"isis":                       `{"sessionStatus": ["up"]}`,
+		},
+		want: &ProtocolSessions{
+			BGP: []*BGPSession{
+				{Interface: "intf1", PeerAddress: "192.168.0.2", State: "up", LearnedRoutes: 2},
+				{Interface: "intf1", PeerAddress: "aa::2", State: "down"},
+				{Interface: "intf2", PeerAddress: "192.168.1.2", State: "up", LearnedRoutes: 1},
+			},
+			ISIS: []*ISISAdjacency{{Interface: "intf2", State: "up"}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := protocolSessionsATE(t, &fakeSession{
+				getRsps:  test.getRsps,
+				getErrs:  test.getErrs,
+				postErrs: test.postErrs,
+			})
+			got, gotErr := c.FetchProtocolSessions(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchProtocolSessions: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchProtocolSessions: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchLearnedRoutes(t *testing.T) {
+	const isisLearnedInfoOp = "topology/deviceGroup/ethernet/isisL3/operations/getLearnedInfo"
+	tests := []struct {
+		desc     string
+		getRsps  map[string]string
+		postErrs map[string]error
+		want     []*LearnedRoute
+		wantErr  string
+	}{{
+		desc:     "error fetching learned info",
+		postErrs: map[string]error{isisLearnedInfoOp: errors.New("op error")},
+		wantErr:  "op error",
+	}, {
+		desc: "bad learned info",
+		getRsps: map[string]string{
+			"v4peer/learnedInfo/1/table": `[{"columns": ["IPv4 Prefix ", "Prefix Length"], "values": [["10.0.0.0"]]}]`,
+		},
+		wantErr: "row has 1 values",
+	}, {
+		desc: "learned routes",
+		getRsps: map[string]string{
+			"v4peer/learnedInfo/1/table": `[{
+				"columns": ["IPv4 Prefix ", "Prefix Length", "IPv4 Next Hop", "AS Path", "MED"],
+				"values": [["10.0.0.0", "24", "192.168.0.2", "<65001>", ""], ["10.0.1.0", "24", "192.168.0.2", "<65001 65002>", "10"]]
+			}]`,
+			"v6peer/learnedInfo/1/table": `[]`,
+			"lbpeer/learnedInfo/1/table": `[{
+				"columns": ["IPv4 Prefix ", "Prefix Length", "IPv4 Next Hop"],
+				"values": [["10.2.0.0", "16", "192.168.1.2"]]
+			}]`,
+			"isis/learnedInfo/1/table": `[{
+				"columns": ["IPv4 Prefix", "Prefix Length", "Metric"],
+				"values": [["10.1.0.0", "16", "20"]]
+			}, {
+				"columns": ["IPv6 Prefix", "Prefix Length", "Metric"],
+				"values": [["", "", ""]]
+			}]`,
+		},
+		want: []*LearnedRoute{{
+			Interface: "intf1",
+			Protocol:  "BGP",
+			Peer:      "192.168.0.2",
+			Prefix:    "10.0.0.0/24",
+			NextHop:   "192.168.0.2",
+			Attrs:     map[string]string{"AS Path": "<65001>"},
+		}, {
+			Interface: "intf1",
+			Protocol:  "BGP",
+			Peer:      "192.168.0.2",
+			Prefix:    "10.0.1.0/24",
+			NextHop:   "192.168.0.2",
+			Attrs:     map[string]string{"AS Path": "<65001 65002>", "MED": "10"},
+		}, {
+			Interface: "intf2",
+			Protocol:  "BGP",
+			Peer:      "192.168.1.2",
+			Prefix:    "10.2.0.0/16",
+			NextHop:   "192.168.1.2",
+			Attrs:     map[string]string{},
+		}, {
+			Interface: "intf2",
+			Protocol:  "ISIS",
+			Prefix:    "10.1.0.0/16",
+			Attrs:     map[string]string{"Metric": "20"},
+		}},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := protocolSessionsATE(t, &fakeSession{
+				getRsps:  test.getRsps,
+				postErrs: test.postErrs,
+			})
+			got, gotErr := c.FetchLearnedRoutes(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("FetchLearnedRoutes: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FetchLearnedRoutes: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAwaitProtocolSessionsUp(t *testing.T) {
+	defer restoreStubs()
+	sleepFn = func(time.Duration) {}
+	tests := []struct {
+		desc      string
+		operState operState
+		getRsps   map[string]string
+		wantErr   string
+	}{{
+		desc:    "protocols not running",
+		wantErr: "protocols are not running",
+	}, {
+		desc:      "sessions down",
 		operState: operStateProtocolsOn,
-		flowsErr:  errors.New("failed to update traffic flows"),
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-		wantErr:   true,
+		getRsps: map[string]string{
+			"v4peer": `{"sessionStatus": ["up"]}`,
+			"v6peer": `{"sessionStatus": ["down"]}`,
+			"lbpeer": `{"sessionStatus": ["idle"]}`,
+			"isis":   `{"sessionStatus": ["notStarted"]}`,
+		},
+		wantErr: `BGP peer aa::2 on intf1 is "down", BGP peer 192.168.1.2 on intf2 is "idle", IS-IS adjacency on intf2 is "notStarted"`,
 	}, {
-		desc:      "failed to start traffic flows",
+		desc:      "sessions up",
 		operState: operStateProtocolsOn,
-		startErr:  errors.New("failed to start traffic flows"),
-		intfsFile: "no_intfs.textproto",
-		reqFile:   "no_flows.textproto",
-		wantErr:   true,
+		getRsps: map[string]string{
+			"v4peer": `{"sessionStatus": ["up"]}`,
+			"v6peer": `{"sessionStatus": ["up"]}`,
+			"lbpeer": `{"sessionStatus": ["up"]}`,
+			"isis":   `{"sessionStatus": ["up"]}`,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := protocolSessionsATE(t, &fakeSession{getRsps: test.getRsps})
+			c.operState = test.operState
+			gotErr := c.AwaitProtocolSessionsUp(context.Background(), time.Minute)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("AwaitProtocolSessionsUp: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateBGPPeerStates(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	tests := []struct {
+		desc       string
+		ifc        *opb.InterfaceConfig
+		importErrs []error
+		applyErr   error
+		wantErr    string
+	}{{
+		desc: "BGP v4 peer config failure",
+		ifc: &opb.InterfaceConfig{
+			Name:     intfName,
+			Link:     &opb.InterfaceConfig_Port{port},
+			Ethernet: &opb.EthernetConfig{Mtu: 1500},
+			Ipv4: &opb.IpConfig{
+				AddressCidr:    "192.168.1.1/30",
+				DefaultGateway: "192.168.1.2",
+			},
+			Bgp: &opb.BgpConfig{
+				BgpPeers: []*opb.BgpPeer{{PeerAddress: "1.1.1.1"}},
+			},
+		},
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update BGP v4 peer",
 	}, {
-		desc:        "no flows configured",
-		operState:   operStateProtocolsOn,
-		intfsFile:   "no_intfs.textproto",
-		reqFile:     "no_flows.textproto",
-		wantCfgFile: "no_flows_cfg.json",
+		desc: "BGP v6 peer config failure",
+		ifc: &opb.InterfaceConfig{
+			Name:     intfName,
+			Link:     &opb.InterfaceConfig_Port{port},
+			Ethernet: &opb.EthernetConfig{Mtu: 1500},
+			Ipv6: &opb.IpConfig{
+				AddressCidr:    "aa::/127",
+				DefaultGateway: "aa::2",
+			},
+			Bgp: &opb.BgpConfig{
+				BgpPeers: []*opb.BgpPeer{{PeerAddress: "bb::"}},
+			},
+		},
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update BGP v6 peer",
 	}, {
-		desc:        "ipv4 with egress tracking",
-		operState:   operStateProtocolsOn,
-		intfsFile:   "intfs_for_flows.textproto",
-		reqFile:     "ipv4_flow_with_egress.textproto",
-		wantCfgFile: "ipv4_flow_with_egress.json",
+		desc: "BGP v4 loopback peer config failure",
+		ifc: &opb.InterfaceConfig{
+			Name:             intfName,
+			Link:             &opb.InterfaceConfig_Port{port},
+			Ethernet:         &opb.EthernetConfig{Mtu: 1500},
+			Ipv4LoopbackCidr: "192.168.2.1/30",
+			Bgp: &opb.BgpConfig{
+				BgpPeers: []*opb.BgpPeer{{
+					PeerAddress: "2.2.2.2",
+					OnLoopback:  true,
+				}},
+			},
+		},
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update BGP v4 loopback peer",
 	}, {
-		desc:        "ipv6 with flow labels",
-		operState:   operStateProtocolsOn,
-		intfsFile:   "intfs_for_flows.textproto",
-		reqFile:     "ipv6_flow_with_labels.textproto",
-		wantCfgFile: "ipv6_flow_with_labels.json",
+		desc: "BGP v6 loopback peer config failure",
+		ifc: &opb.InterfaceConfig{
+			Name:             intfName,
+			Link:             &opb.InterfaceConfig_Port{port},
+			Ethernet:         &opb.EthernetConfig{Mtu: 1500},
+			Ipv6LoopbackCidr: "cafe:beef::1/128",
+			Bgp: &opb.BgpConfig{
+				BgpPeers: []*opb.BgpPeer{{
+					PeerAddress: "cafe::1",
+					OnLoopback:  true,
+				}},
+			},
+		},
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update BGP v6 loopback peer",
+	}, {
+		desc: "config apply failure",
+		ifc: &opb.InterfaceConfig{
+			Name:     intfName,
+			Link:     &opb.InterfaceConfig_Port{port},
+			Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		},
+		applyErr: errors.New("apply on the fly failure"),
+		wantErr:  "could not apply",
+	}, {
+		desc: "successful update",
+		ifc: &opb.InterfaceConfig{
+			Name:     intfName,
+			Link:     &opb.InterfaceConfig_Port{port},
+			Ethernet: &opb.EthernetConfig{Mtu: 1500},
+			Ipv4: &opb.IpConfig{
+				AddressCidr:    "192.168.1.1/30",
+				DefaultGateway: "192.168.1.2",
+			},
+			Ipv6: &opb.IpConfig{
+				AddressCidr:    "aa::/127",
+				DefaultGateway: "aa::2",
+			},
+			Bgp: &opb.BgpConfig{
+				BgpPeers: []*opb.BgpPeer{{PeerAddress: "1.1.1.1"}, {PeerAddress: "bb::"}},
+			},
+		},
+		importErrs: []error{nil, nil},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			defer restoreStubs()
-			sleepFn = func(time.Duration) {}
-			resetIxiaTrafficCfgFn = func(_ context.Context, ix *ixATE) error {
-				ix.flowToTrafficItem = map[string]*ixconfig.TrafficTrafficItem{}
-				return nil
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
 			}
+			updateXPaths(cfg)
 			c := &ixATE{
-				c: &fakeCfgClient{importErrs: []error{nil, nil, nil}},
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c: &fakeCfgClient{
+					importErrs: test.importErrs,
+					session: &fakeSession{postErrs: map[string]error{
+						"globals/topology/operations/applyonthefly": test.applyErr,
+					}},
+				},
 			}
-			top := readTopology(t, test.intfsFile)
-			if err := c.PushTopology(context.Background(), top); err != nil {
-				t.Fatalf("Failed to configure initial topology for StartTraffic test: %v", err)
+			gotErr := c.UpdateBGPPeerStates(context.Background(), []*opb.InterfaceConfig{test.ifc})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("UpdateBGPPeerStates: got err: %v, want err %q", gotErr, test.wantErr)
 			}
+		})
+	}
+}
 
-			// Swap out no-op config client with the one to use for StartTraffic testing.
-			fc := &fakeCfgClient{
-				importErrs:  []error{test.importErr},
-				updateIDErr: test.updateIDsErr,
-			}
-			c.c = fc
-			c.operState = test.operState
-			// Initialize stub functions for StartTraffic testing.
-			resetIxiaTrafficCfgFn = func(context.Context, *ixATE) error {
-				return test.resetTrafficCfgErr
-			}
-			resolveMacsFn = func(context.Context, *ixATE) error {
-				return test.resolveMacsErr
-			}
-			genTrafficFn = func(context.Context, *ixATE) error {
-				return test.genErr
-			}
-			updateFlowsFn = func(context.Context, *ixATE, []*opb.Flow) error {
-				return test.flowsErr
-			}
-			startTrafficFn = func(context.Context, *ixATE) error {
-				return test.startErr
+func TestUpdateNetworks(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
+		},
+		Networks: []*opb.Network{{
+			Name:          "someNet",
+			InterfaceName: intfName,
+			Ipv4: &opb.NetworkIp{
+				AddressCidr: "10.0.0.0/8",
+				Count:       1,
+			},
+			Isis:      &opb.IPReachability{RouteOrigin: opb.IPReachability_INTERNAL},
+			Withdrawn: true,
+		}},
+	}
+	tests := []struct {
+		desc       string
+		importErrs []error
+		applyErr   error
+		wantErr    string
+	}{{
+		desc:       "network config failure",
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update network",
+	}, {
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
+	}, {
+		desc:       "successful update",
+		importErrs: []error{nil},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
 			}
-
-			flows := toFlows(t, test.reqFile)
-			gotErr := c.StartTraffic(context.Background(), flows)
-			if (gotErr != nil) != test.wantErr {
-				t.Errorf("StartTraffic: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			updateXPaths(cfg)
+			c := &ixATE{
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c: &fakeCfgClient{
+					importErrs: test.importErrs,
+					session: &fakeSession{postErrs: map[string]error{
+						"globals/topology/operations/applyonthefly": test.applyErr,
+					}},
+				},
 			}
-
-			if !test.wantErr && test.wantCfgFile != "" {
-				wantCfg := toTrafficCfg(t, test.wantCfgFile)
-				if diff := jsonCfgDiff(t, wantCfg, fc.lastImportCfg); diff != "" {
-					t.Fatalf("StartTraffic: Unexpected traffic config pushed, diff (-want, +got)\n%s", diff)
-				}
+			gotErr := c.UpdateNetworks(context.Background(), []*opb.InterfaceConfig{ifc})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("UpdateNetworks: got err: %v, want err %q", gotErr, test.wantErr)
 			}
 		})
 	}
 }
 
-func TestUpdateTraffic(t *testing.T) {
+func TestUpdateBFD(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
+		},
+		Bfd: &opb.BfdConfig{
+			TxIntervalMs: 1000,
+			RxIntervalMs: 1000,
+			Multiplier:   3,
+			AdminDown:    true,
+		},
+	}
 	tests := []struct {
-		desc      string
-		operState operState
-		flowsErr  error
-		wantErr   string
+		desc       string
+		importErrs []error
+		applyErr   error
+		wantErr    string
 	}{{
-		desc:    "traffic not started",
-		wantErr: "cannot update traffic before it has been started",
+		desc:       "BFD config failure",
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update BFDv4",
 	}, {
-		desc:      "non-existent flow",
-		operState: operStateTrafficOn,
-		flowsErr:  errors.New("failed to update traffic flows"),
-		wantErr:   "failed to update",
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
+	}, {
+		desc:       "successful update",
+		importErrs: []error{nil},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			defer restoreStubs()
-			c := &ixATE{operState: test.operState}
-			updateFlowsFn = func(context.Context, *ixATE, []*opb.Flow) error {
-				return test.flowsErr
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
 			}
-			gotErr := c.UpdateTraffic(context.Background(), nil)
+			updateXPaths(cfg)
+			c := &ixATE{
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c: &fakeCfgClient{
+					importErrs: test.importErrs,
+					session: &fakeSession{postErrs: map[string]error{
+						"globals/topology/operations/applyonthefly": test.applyErr,
+					}},
+				},
+			}
+			gotErr := c.UpdateBFD(context.Background(), []*opb.InterfaceConfig{ifc})
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("UpdateTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+				t.Errorf("UpdateBFD: got err: %v, want err %q", gotErr, test.wantErr)
 			}
 		})
 	}
 }
 
-func TestStopAllTraffic(t *testing.T) {
-	const op = "traffic/operations/stop"
-	defer restoreStubs()
-	sleepFn = func(time.Duration) {}
+func TestUpdateMulticastGroups(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
+		},
+		IgmpHost: &opb.IgmpHostConfig{
+			Version: opb.IgmpHostConfig_V2,
+			Groups:  []*opb.MulticastGroup{{Address: "239.1.1.1", Left: true}},
+		},
+	}
 	tests := []struct {
-		desc      string
-		operState operState
-		opErr     error
-		wantErr   bool
+		desc       string
+		importErrs []error
+		applyErr   error
+		wantErr    string
 	}{{
-		desc: "traffic not started",
+		desc:       "group config failure",
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update IGMP groups",
 	}, {
-		desc:      "Error from stop traffic op",
-		operState: operStateTrafficOn,
-		opErr:     errors.New("someError"),
-		wantErr:   true,
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
 	}, {
-		desc:      "Successfully stopped",
-		operState: operStateTrafficOn,
+		desc:       "successful update",
+		importErrs: []error{nil},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
+			}
+			updateXPaths(cfg)
 			c := &ixATE{
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
 				c: &fakeCfgClient{
-					session: &fakeSession{postErrs: map[string]error{op: test.opErr}},
+					importErrs: test.importErrs,
+					session: &fakeSession{postErrs: map[string]error{
+						"globals/topology/operations/applyonthefly": test.applyErr,
+					}},
 				},
-				operState: test.operState,
 			}
-			gotErr := c.StopAllTraffic(context.Background())
-			if (gotErr != nil) != test.wantErr {
-				t.Errorf("StopAllTraffic: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
+			gotErr := c.UpdateMulticastGroups(context.Background(), []*opb.InterfaceConfig{ifc})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("UpdateMulticastGroups: got err: %v, want err %q", gotErr, test.wantErr)
 			}
 		})
 	}
 }
 
-func TestReadStats(t *testing.T) {
-	defer restoreStubs()
-	captions := []string{"view1", "view2"}
-
+func TestUpdatePIM(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
+		},
+		Pim: &opb.PimConfig{
+			HelloIntervalSec: 30,
+			HelloHoldTimeSec: 105,
+			JoinPrunes: []*opb.PimConfig_JoinPrune{{
+				RangeType:    opb.PimConfig_JoinPrune_STAR_G,
+				RpAddress:    "10.0.0.1",
+				GroupAddress: "239.1.1.1",
+				Pruned:       true,
+			}},
+		},
+	}
 	tests := []struct {
-		desc     string
-		viewsOut map[string]view
-		viewsErr error
-		want     *ixgnmi.Stats
-		wantErr  bool
+		desc       string
+		importErrs []error
+		applyErr   error
+		wantErr    string
 	}{{
-		desc:     "error fetching views",
-		viewsErr: errors.New("someError"),
-		wantErr:  true,
+		desc:       "PIM config failure",
+		importErrs: []error{errors.New("error pushing config")},
+		wantErr:    "could not update PIMv4",
 	}, {
-		desc: "error fetching table",
-		viewsOut: map[string]view{
-			"view1": &fakeView{tableOut: ixweb.StatTable{{"k1": "v1"}}},
-			"view2": &fakeView{tableErr: errors.New("someError")},
-		},
-		wantErr: true,
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
 	}, {
-		desc: "no error",
-		viewsOut: map[string]view{
-			"view1": &fakeView{tableOut: ixweb.StatTable{{"k1": "v1"}}},
-			"view2": &fakeView{tableOut: ixweb.StatTable{{"k2": "v2"}}},
-		},
-		want: &ixgnmi.Stats{
-			EgressTrackFlows: []string{"someFlow"},
-			Tables: map[string]ixweb.StatTable{
-				"view1": ixweb.StatTable{{"k1": "v1"}},
-				"view2": ixweb.StatTable{{"k2": "v2"}},
-			},
-		},
+		desc:       "successful update",
+		importErrs: []error{nil},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
+			}
+			updateXPaths(cfg)
 			c := &ixATE{
-				egressTrackingFlows: []string{"someFlow"},
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
 				c: &fakeCfgClient{
-					session: &fakeSession{stats: &fakeStats{
-						viewsOut: test.viewsOut,
-						viewsErr: test.viewsErr,
+					importErrs: test.importErrs,
+					session: &fakeSession{postErrs: map[string]error{
+						"globals/topology/operations/applyonthefly": test.applyErr,
 					}},
 				},
 			}
-			got, gotErr := c.readStats(context.Background(), captions)
-			if (gotErr != nil) != test.wantErr {
-				t.Errorf("readStats unexpected error, got err: %v, want err? %t", gotErr, test.wantErr)
-			}
-			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("readStats unexpected diff, got (-want +got): %s", diff)
+			gotErr := c.UpdatePIM(context.Background(), []*opb.InterfaceConfig{ifc})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("UpdatePIM: got err: %v, want err %q", gotErr, test.wantErr)
 			}
 		})
 	}
 }
 
-func TestUpdateBGPPeerStates(t *testing.T) {
+func TestUpdateISIS(t *testing.T) {
 	const (
 		intfName = "someIntf"
 		port     = "1/1"
 	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
+		},
+		Isis: &opb.ISISConfig{
+			Level:       opb.ISISConfig_L2,
+			NetworkType: opb.ISISConfig_POINT_TO_POINT,
+			AreaId:      "490001",
+			Overloaded:  true,
+		},
+	}
 	tests := []struct {
 		desc       string
-		ifc        *opb.InterfaceConfig
 		importErrs []error
 		applyErr   error
 		wantErr    string
 	}{{
-		desc: "BGP v4 peer config failure",
-		ifc: &opb.InterfaceConfig{
-			Name:     intfName,
-			Link:     &opb.InterfaceConfig_Port{port},
-			Ethernet: &opb.EthernetConfig{Mtu: 1500},
-			Ipv4: &opb.IpConfig{
-				AddressCidr:    "192.168.1.1/30",
-				DefaultGateway: "192.168.1.2",
-			},
-			Bgp: &opb.BgpConfig{
-				BgpPeers: []*opb.BgpPeer{{PeerAddress: "1.1.1.1"}},
-			},
-		},
+		desc:       "IS-IS config failure",
 		importErrs: []error{errors.New("error pushing config")},
-		wantErr:    "could not update BGP v4 peer",
+		wantErr:    "could not update IS-IS",
 	}, {
-		desc: "BGP v6 peer config failure",
-		ifc: &opb.InterfaceConfig{
-			Name:     intfName,
-			Link:     &opb.InterfaceConfig_Port{port},
-			Ethernet: &opb.EthernetConfig{Mtu: 1500},
-			Ipv6: &opb.IpConfig{
-				AddressCidr:    "aa::/127",
-				DefaultGateway: "aa::2",
-			},
-			Bgp: &opb.BgpConfig{
-				BgpPeers: []*opb.BgpPeer{{PeerAddress: "bb::"}},
-			},
-		},
-		importErrs: []error{errors.New("error pushing config")},
-		wantErr:    "could not update BGP v6 peer",
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
 	}, {
-		desc: "BGP v4 loopback peer config failure",
-		ifc: &opb.InterfaceConfig{
-			Name:             intfName,
-			Link:             &opb.InterfaceConfig_Port{port},
-			Ethernet:         &opb.EthernetConfig{Mtu: 1500},
-			Ipv4LoopbackCidr: "192.168.2.1/30",
-			Bgp: &opb.BgpConfig{
-				BgpPeers: []*opb.BgpPeer{{
-					PeerAddress: "2.2.2.2",
-					OnLoopback:  true,
+		desc:       "successful update",
+		importErrs: []error{nil},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port),
+					L1Config: &ixconfig.VportL1Config{},
 				}},
-			},
-		},
-		importErrs: []error{errors.New("error pushing config")},
-		wantErr:    "could not update BGP v4 loopback peer",
-	}, {
-		desc: "BGP v6 loopback peer config failure",
-		ifc: &opb.InterfaceConfig{
-			Name:             intfName,
-			Link:             &opb.InterfaceConfig_Port{port},
-			Ethernet:         &opb.EthernetConfig{Mtu: 1500},
-			Ipv6LoopbackCidr: "cafe:beef::1/128",
-			Bgp: &opb.BgpConfig{
-				BgpPeers: []*opb.BgpPeer{{
-					PeerAddress: "cafe::1",
-					OnLoopback:  true,
+			}
+			updateXPaths(cfg)
+			fc := &fakeCfgClient{
+				importErrs: test.importErrs,
+				session: &fakeSession{postErrs: map[string]error{
+					"globals/topology/operations/applyonthefly": test.applyErr,
 				}},
-			},
+			}
+			c := &ixATE{
+				cfg:   cfg,
+				intfs: map[string]*intf{},
+				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
+				c:     fc,
+			}
+			gotErr := c.UpdateISIS(context.Background(), []*opb.InterfaceConfig{ifc})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("UpdateISIS: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			rtr, ok := fc.lastImportCfg.(*ixconfig.TopologyIsisL3Router)
+			if !ok {
+				t.Fatalf("UpdateISIS: got import of %T, want IS-IS router", fc.lastImportCfg)
+			}
+			if got := *rtr.Overloaded.SingleValue.Value; got != "true" {
+				t.Errorf("UpdateISIS: got overloaded %q, want %q", got, "true")
+			}
+		})
+	}
+}
+
+func TestUpdateBGPRoutes(t *testing.T) {
+	const (
+		intfName = "someIntf"
+		port     = "1/1"
+	)
+	ifc := &opb.InterfaceConfig{
+		Name:     intfName,
+		Link:     &opb.InterfaceConfig_Port{port},
+		Ethernet: &opb.EthernetConfig{Mtu: 1500},
+		Ipv4: &opb.IpConfig{
+			AddressCidr:    "192.168.1.1/30",
+			DefaultGateway: "192.168.1.2",
 		},
+		Networks: []*opb.Network{{
+			Name: "isisNet",
+			Ipv4: &opb.NetworkIp{AddressCidr: "10.0.0.0/24", Count: 1},
+			Isis: &opb.IPReachability{RouteOrigin: opb.IPReachability_INTERNAL},
+		}, {
+			Name: "bgpNet",
+			Ipv4: &opb.NetworkIp{AddressCidr: "20.0.0.0/24", Count: 1},
+			BgpAttributes: &opb.BgpAttributes{
+				Active:          true,
+				Origin:          opb.BgpAttributes_ORIGIN_IGP,
+				AsnSetMode:      opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+				LocalPreference: 200,
+			},
+		}},
+	}
+	tests := []struct {
+		desc       string
+		importErrs []error
+		applyErr   error
+		wantErr    string
+	}{{
+		desc:       "route config failure",
 		importErrs: []error{errors.New("error pushing config")},
-		wantErr:    "could not update BGP v6 loopback peer",
+		wantErr:    "could not update BGP routes",
 	}, {
-		desc: "config apply failure",
-		ifc: &opb.InterfaceConfig{
-			Name:     intfName,
-			Link:     &opb.InterfaceConfig_Port{port},
-			Ethernet: &opb.EthernetConfig{Mtu: 1500},
-		},
-		applyErr: errors.New("apply on the fly failure"),
-		wantErr:  "could not apply",
+		desc:       "config apply failure",
+		importErrs: []error{nil},
+		applyErr:   errors.New("apply on the fly failure"),
+		wantErr:    "could not apply",
 	}, {
-		desc: "successful update",
-		ifc: &opb.InterfaceConfig{
-			Name:     intfName,
-			Link:     &opb.InterfaceConfig_Port{port},
-			Ethernet: &opb.EthernetConfig{Mtu: 1500},
-			Ipv4: &opb.IpConfig{
-				AddressCidr:    "192.168.1.1/30",
-				DefaultGateway: "192.168.1.2",
-			},
-			Ipv6: &opb.IpConfig{
-				AddressCidr:    "aa::/127",
-				DefaultGateway: "aa::2",
-			},
-			Bgp: &opb.BgpConfig{
-				BgpPeers: []*opb.BgpPeer{{PeerAddress: "1.1.1.1"}, {PeerAddress: "bb::"}},
-			},
-		},
-		importErrs: []error{nil, nil},
+		desc:       "successful update",
+		importErrs: []error{nil},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -1737,20 +3720,31 @@ func TestUpdateBGPPeerStates(t *testing.T) {
 				}},
 			}
 			updateXPaths(cfg)
+			fc := &fakeCfgClient{
+				importErrs: test.importErrs,
+				session: &fakeSession{postErrs: map[string]error{
+					"globals/topology/operations/applyonthefly": test.applyErr,
+				}},
+			}
 			c := &ixATE{
 				cfg:   cfg,
 				intfs: map[string]*intf{},
 				ports: map[string]*ixconfig.Vport{port: cfg.Vport[0]},
-				c: &fakeCfgClient{
-					importErrs: test.importErrs,
-					session: &fakeSession{postErrs: map[string]error{
-						"globals/topology/operations/applyonthefly": test.applyErr,
-					}},
-				},
+				c:     fc,
 			}
-			gotErr := c.UpdateBGPPeerStates(context.Background(), []*opb.InterfaceConfig{test.ifc})
+			gotErr := c.UpdateBGPRoutes(context.Background(), []*opb.InterfaceConfig{ifc})
 			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("UpdateBGPPeerStates: got err: %v, want err %q", gotErr, test.wantErr)
+				t.Fatalf("UpdateBGPRoutes: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			rp, ok := fc.lastImportCfg.(*ixconfig.TopologyBgpIpRouteProperty)
+			if !ok {
+				t.Fatalf("UpdateBGPRoutes: got import of %T, want BGP route property", fc.lastImportCfg)
+			}
+			if got, want := *rp.LocalPreference.SingleValue.Value, "200"; got != want {
+				t.Errorf("UpdateBGPRoutes: got local preference %q, want %q", got, want)
 			}
 		})
 	}
@@ -1763,3 +3757,278 @@ func parseXPath(t *testing.T, str string) *ixconfig.XPath {
 	}
 	return xp
 }
+
+func TestUpdateInterfaces(t *testing.T) {
+	const (
+		port1 = "1/1"
+		port2 = "1/2"
+	)
+	newIntf := func(name, port, addr string) *opb.InterfaceConfig {
+		return &opb.InterfaceConfig{
+			Name:     name,
+			Link:     &opb.InterfaceConfig_Port{port},
+			Ethernet: &opb.EthernetConfig{Mtu: 1500},
+			Ipv4:     &opb.IpConfig{AddressCidr: addr, DefaultGateway: "192.168.0.254"},
+		}
+	}
+	intf1 := newIntf("intf1", port1, "192.168.0.1/24")
+	intf2 := newIntf("intf2", port1, "192.168.0.2/24")
+	intf3 := newIntf("intf3", port2, "192.168.0.3/24")
+	const startOp = "topology/deviceGroup/operations/start"
+
+	tests := []struct {
+		desc        string
+		ifs         []*opb.InterfaceConfig
+		operState   operState
+		deleteErr   error
+		importErr   error
+		startErr    error
+		wantImport  string
+		wantDeleted []string
+		wantOrder   []string
+		wantErr     string
+	}{{
+		desc:      "unchanged",
+		ifs:       []*opb.InterfaceConfig{intf1, intf2},
+		wantOrder: []string{"intf1", "intf2"},
+	}, {
+		desc:       "add interface on new link",
+		ifs:        []*opb.InterfaceConfig{intf1, intf2, intf3},
+		wantImport: "/topology[2]",
+		wantOrder:  []string{"intf1", "intf2", "intf3"},
+	}, {
+		desc:       "add interface on existing link",
+		ifs:        []*opb.InterfaceConfig{intf1, newIntf("intf3", port1, "192.168.0.3/24"), intf2},
+		wantImport: "/topology[1]/deviceGroup[3]",
+		wantOrder:  []string{"intf1", "intf2", "intf3"},
+	}, {
+		desc:        "remove interface",
+		ifs:         []*opb.InterfaceConfig{intf2},
+		wantDeleted: []string{"/topology[1]/deviceGroup[1]"},
+		wantOrder:   []string{"intf2"},
+	}, {
+		desc:        "remove all interfaces of link",
+		ifs:         []*opb.InterfaceConfig{intf3},
+		wantDeleted: []string{"/topology[1]"},
+		wantImport:  "/topology[1]",
+		wantOrder:   []string{"intf3"},
+	}, {
+		desc:        "change interface",
+		ifs:         []*opb.InterfaceConfig{newIntf("intf1", port1, "192.168.0.9/24"), intf2},
+		wantDeleted: []string{"/topology[1]/deviceGroup[1]"},
+		wantImport:  "/topology[1]/deviceGroup[2]",
+		wantOrder:   []string{"intf2", "intf1"},
+	}, {
+		desc:       "start protocols on added interface",
+		ifs:        []*opb.InterfaceConfig{intf1, intf2, intf3},
+		operState:  operStateProtocolsOn,
+		wantImport: "/topology[2]",
+		wantOrder:  []string{"intf1", "intf2", "intf3"},
+	}, {
+		desc:      "error deleting interface",
+		ifs:       []*opb.InterfaceConfig{intf1},
+		deleteErr: errors.New("delete error"),
+		wantErr:   "delete error",
+	}, {
+		desc:      "error importing interface",
+		ifs:       []*opb.InterfaceConfig{intf1, intf2, intf3},
+		importErr: errors.New("import error"),
+		wantErr:   "import error",
+	}, {
+		desc:      "error starting protocols",
+		ifs:       []*opb.InterfaceConfig{intf1, intf2, intf3},
+		operState: operStateProtocolsOn,
+		startErr:  errors.New("start error"),
+		wantErr:   "start error",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &ixconfig.Ixnetwork{
+				Vport: []*ixconfig.Vport{{
+					Name:     ixconfig.String(port1),
+					L1Config: &ixconfig.VportL1Config{},
+				}, {
+					Name:     ixconfig.String(port2),
+					L1Config: &ixconfig.VportL1Config{},
+				}},
+			}
+			updateXPaths(cfg)
+			fc := &fakeCfgClient{
+				importErrs: []error{test.importErr},
+				deleteErr:  test.deleteErr,
+				xPathToID:  map[string]string{"/topology[2]/deviceGroup[1]": "id"},
+				session: &fakeSession{
+					postErrs: map[string]error{startOp: test.startErr},
+				},
+			}
+			c := &ixATE{
+				c:         fc,
+				cfg:       cfg,
+				intfs:     map[string]*intf{},
+				ports:     map[string]*ixconfig.Vport{port1: cfg.Vport[0], port2: cfg.Vport[1]},
+				operState: test.operState,
+			}
+			if err := c.configureTopology([]*opb.InterfaceConfig{intf1, intf2}); err != nil {
+				t.Fatalf("configureTopology() got error: %v", err)
+			}
+			updateXPaths(cfg)
+			oldTopos := cfg.Topology
+
+			gotErr := c.UpdateInterfaces(context.Background(), test.ifs)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("UpdateInterfaces: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			var gotImport string
+			if fc.lastImportCfg != nil {
+				gotImport = nodePath(c.cfg.Topology, fc.lastImportCfg)
+			}
+			if gotImport != test.wantImport {
+				t.Errorf("UpdateInterfaces: got import of %q, want %q", gotImport, test.wantImport)
+			}
+			var gotDeleted []string
+			for _, n := range fc.deleted {
+				gotDeleted = append(gotDeleted, nodePath(oldTopos, n))
+			}
+			if diff := cmp.Diff(test.wantDeleted, gotDeleted); diff != "" {
+				t.Errorf("UpdateInterfaces: unexpected deleted nodes (-want,+got): %s", diff)
+			}
+			if diff := cmp.Diff(test.wantOrder, c.intfOrder); diff != "" {
+				t.Errorf("UpdateInterfaces: unexpected interface order (-want,+got): %s", diff)
+			}
+			if len(c.intfs) != len(test.ifs) {
+				t.Errorf("UpdateInterfaces: got %d interfaces, want %d", len(c.intfs), len(test.ifs))
+			}
+		})
+	}
+}
+
+// nodePath returns the path of a topology or device group node by its position
+// in the topologies, as the fake client does not set topology XPaths.
+func nodePath(topos []*ixconfig.Topology, node ixconfig.IxiaCfgNode) string {
+	for i, topo := range topos {
+		if node == ixconfig.IxiaCfgNode(topo) {
+			return fmt.Sprintf("/topology[%d]", i+1)
+		}
+		for j, dg := range topo.DeviceGroup {
+			if node == ixconfig.IxiaCfgNode(dg) {
+				return fmt.Sprintf("/topology[%d]/deviceGroup[%d]", i+1, j+1)
+			}
+		}
+	}
+	return fmt.Sprintf("unknown %T", node)
+}
+
+func TestFetchNeighborResolutions(t *testing.T) {
+	defer restoreStubs()
+	const mac = "aa:bb:cc:dd:ee:ff"
+	ix := &ixATE{
+		operState: operStateProtocolsOn,
+		intfOrder: []string{"dual", "v4"},
+		intfs: map[string]*intf{
+			"dual": {
+				ipv4:            &ixconfig.TopologyIpv4{},
+				ipv6:            &ixconfig.TopologyIpv6{},
+				resolvedIpv6Mac: "stale",
+			},
+			"v4": {ipv4: &ixconfig.TopologyIpv4{}},
+		},
+	}
+	resolveMacsFn = func(_ context.Context, ix *ixATE) error {
+		ix.intfs["dual"].resolvedIpv4Mac = mac
+		ix.intfs["v4"].resolvedIpv4Mac = mac
+		return nil
+	}
+	got, err := ix.FetchNeighborResolutions(context.Background())
+	if err != nil {
+		t.Fatalf("FetchNeighborResolutions: unexpected error: %v", err)
+	}
+	want := []*NeighborResolution{
+		{Interface: "dual", IPv4GatewayMAC: mac, hasIPv4: true, hasIPv6: true},
+		{Interface: "v4", IPv4GatewayMAC: mac, hasIPv4: true},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(NeighborResolution{})); diff != "" {
+		t.Errorf("FetchNeighborResolutions: unexpected diff (-want, +got)\n%s", diff)
+	}
+	if got[0].Resolved() {
+		t.Errorf("FetchNeighborResolutions: got %v resolved, want unresolved IPv6 gateway", got[0])
+	}
+	if !got[1].Resolved() {
+		t.Errorf("FetchNeighborResolutions: got %v unresolved, want resolved", got[1])
+	}
+
+	ix.operState = operStateOff
+	if _, err := ix.FetchNeighborResolutions(context.Background()); err == nil {
+		t.Errorf("FetchNeighborResolutions: got no error with protocols off, want error")
+	}
+}
+
+func TestResolveNeighbors(t *testing.T) {
+	const (
+		arpOp = "topology/deviceGroup/ethernet/ipv4/operations/sendarp"
+		nsOp  = "topology/deviceGroup/ethernet/ipv6/operations/sendns"
+	)
+	ipv4XP := parseXPath(t, "/fake/xpath/ipv4")
+	ipv6XP := parseXPath(t, "/fake/xpath/ipv6")
+	tests := []struct {
+		desc      string
+		operState operState
+		postErr   error
+		wantPosts map[string]interface{}
+		wantErr   string
+	}{{
+		desc:      "protocols off",
+		operState: operStateOff,
+		wantErr:   "protocols are not running",
+	}, {
+		desc:      "post error",
+		operState: operStateProtocolsOn,
+		postErr:   errors.New("post failed"),
+		wantErr:   "post failed",
+	}, {
+		desc:      "success",
+		operState: operStateProtocolsOn,
+		wantPosts: map[string]interface{}{
+			arpOp: ixweb.OpArgs{[]string{"id/ipv4"}},
+			nsOp:  ixweb.OpArgs{[]string{"id/ipv6"}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{
+				posts:    map[string]interface{}{},
+				postErrs: map[string]error{arpOp: test.postErr},
+			}
+			ix := &ixATE{
+				c: &fakeCfgClient{
+					xPathToID: map[string]string{
+						ipv4XP.String(): "id/ipv4",
+						ipv6XP.String(): "id/ipv6",
+					},
+					session: sess,
+				},
+				cfg:       &ixconfig.Ixnetwork{},
+				operState: test.operState,
+				intfOrder: []string{"intf"},
+				intfs: map[string]*intf{
+					"intf": {
+						ipv4: &ixconfig.TopologyIpv4{Xpath: ipv4XP},
+						ipv6: &ixconfig.TopologyIpv6{Xpath: ipv6XP},
+					},
+				},
+			}
+			gotErr := ix.ResolveNeighbors(context.Background())
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("ResolveNeighbors: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if diff := cmp.Diff(test.wantPosts, sess.posts); diff != "" {
+				t.Errorf("ResolveNeighbors: unexpected posts, diff (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package ate
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/openconfig/ondatra/internal/ixconfig"
@@ -54,6 +55,7 @@ func (ix *ixATE) addNetworks(ifc *opb.InterfaceConfig) error {
 
 	hasIsisCfg := false
 	hasBgpCfg := false
+	hasLdpCfg := false
 	for _, netCfg := range ifc.GetNetworks() {
 		if netCfg.GetIsis() != nil {
 			hasIsisCfg = true
@@ -61,6 +63,9 @@ func (ix *ixATE) addNetworks(ifc *opb.InterfaceConfig) error {
 		if netCfg.GetBgpAttributes() != nil {
 			hasBgpCfg = true
 		}
+		if netCfg.GetLdp() != nil {
+			hasLdpCfg = true
+		}
 	}
 
 	for _, netCfg := range ifc.GetNetworks() {
@@ -87,13 +92,13 @@ func (ix *ixATE) addNetworks(ifc *opb.InterfaceConfig) error {
 		if (intf.ipv6 != nil && len(intf.ipv6.BgpIpv6Peer) > 0) || (intf.ipv6Loopback != nil && len(intf.ipv6Loopback.BgpIpv6Peer) > 0) {
 			hasBGPv6Peer = true
 		}
-		if netCfg.GetImportedBgpRoutes() != nil {
+		if netCfg.GetImportedBgpRoutes() != nil || netCfg.GetGeneratedBgpRoutes() != nil {
 			v4Pools, v6Pools, err = importedBGPRoutePools(intf.netToRouteTables, netCfg, hasBGPv4Peer, hasBGPv6Peer)
 			if err != nil {
 				return err
 			}
 		} else {
-			v4Pools, err = ipv4Pools(netCfg, hasIsisCfg, hasBgpCfg)
+			v4Pools, err = ipv4Pools(netCfg, hasIsisCfg, hasBgpCfg, hasLdpCfg)
 			if err != nil {
 				return err
 			}
@@ -103,6 +108,23 @@ func (ix *ixATE) addNetworks(ifc *opb.InterfaceConfig) error {
 				return err
 			}
 		}
+		if netCfg.GetLdp() != nil && (netCfg.GetIpv4() == nil || intf.ipv4 == nil || len(intf.ipv4.LdpConnectedInterface) == 0) {
+			return usererr.New("LDP FEC config on network %q requires an IPv4 network and LDP configured on interface %q", netCfg.GetName(), ifc.GetName())
+		}
+		if netCfg.GetWithdrawn() {
+			withdrawRoutes(v4Pools, v6Pools)
+		}
+		if addPaths := netCfg.GetBgpAttributes().GetAddPaths(); addPaths != nil {
+			if addPaths.GetCount() == 0 {
+				return usererr.New("BGP add-paths on network %q must have a non-zero count", netCfg.GetName())
+			}
+			if netCfg.GetIsis() != nil || netCfg.GetLdp() != nil {
+				return usererr.New("BGP add-paths on network %q cannot be combined with IS-IS or LDP routes", netCfg.GetName())
+			}
+			// Each copy of the network group advertises the same prefixes with
+			// the next path ID.
+			ng.Multiplier = ixconfig.NumberUint32(addPaths.GetCount())
+		}
 		ng.Ipv4PrefixPools = v4Pools
 		ng.Ipv6PrefixPools = v6Pools
 
@@ -112,6 +134,36 @@ func (ix *ixATE) addNetworks(ifc *opb.InterfaceConfig) error {
 	return nil
 }
 
+// withdrawRoutes deactivates all the route properties of the given pools, so
+// that none of their routes are advertised.
+func withdrawRoutes(v4Pools []*ixconfig.TopologyIpv4PrefixPools, v6Pools []*ixconfig.TopologyIpv6PrefixPools) {
+	for _, p := range v4Pools {
+		for _, rp := range p.IsisL3RouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+		for _, rp := range p.BgpIPRouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+		for _, rp := range p.BgpV6IPRouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+		for _, rp := range p.LdpFECProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+	}
+	for _, p := range v6Pools {
+		for _, rp := range p.IsisL3RouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+		for _, rp := range p.BgpIPRouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+		for _, rp := range p.BgpV6IPRouteProperty {
+			rp.Active = ixconfig.MultivalueFalse()
+		}
+	}
+}
+
 func routeOriginStr(origin opb.IPReachability_RouteOrigin) (string, error) {
 	switch origin {
 	case opb.IPReachability_ROUTE_ORIGIN_UNSPECIFIED:
@@ -131,6 +183,7 @@ func isisRouteProp(ipr *opb.IPReachability) (*ixconfig.TopologyIsisL3RouteProper
 		return nil, err
 	}
 	return &ixconfig.TopologyIsisL3RouteProperty{
+		Active:                 ixconfig.MultivalueTrue(),
 		Metric:                 ixconfig.MultivalueUint32(ipr.GetMetric()),
 		Algorithm:              ixconfig.MultivalueUint32(ipr.GetAlgorithm()),
 		RouteOrigin:            ixconfig.MultivalueStr(origin),
@@ -210,6 +263,32 @@ func bgpExtComms(extCommPBs []*opb.BgpAttributes_ExtendedCommunity) ([]*ixconfig
 	return extComms, nil
 }
 
+// maxLargeComms is the maximum number of large communities IxNetwork supports
+// per route.
+const maxLargeComms = 32
+
+func bgpLargeComms(largeComms []string) ([]*ixconfig.TopologyBgpNonVpnrrLargeCommunitiesList, error) {
+	if len(largeComms) > maxLargeComms {
+		return nil, usererr.New("%d BGP large communities specified, at most %d are allowed", len(largeComms), maxLargeComms)
+	}
+	var comms []*ixconfig.TopologyBgpNonVpnrrLargeCommunitiesList
+	for _, comm := range largeComms {
+		commSplit := strings.Split(comm, ":")
+		if len(commSplit) != 3 {
+			return nil, usererr.New("invalid format for BGP large community %q", comm)
+		}
+		for _, part := range commSplit {
+			if _, err := strconv.ParseUint(part, 10, 32); err != nil {
+				return nil, usererr.Wrapf(err, "invalid format for BGP large community %q", comm)
+			}
+		}
+		comms = append(comms, &ixconfig.TopologyBgpNonVpnrrLargeCommunitiesList{
+			LargeCommunity: ixconfig.MultivalueStr(comm),
+		})
+	}
+	return comms, nil
+}
+
 func bgpASPathSegments(asPathSegPBs []*opb.BgpAttributes_AsPathSegment) ([]*ixconfig.TopologyBgpAsPathSegmentList, error) {
 	var asPathSegs []*ixconfig.TopologyBgpAsPathSegmentList
 	for _, asPathSegPB := range asPathSegPBs {
@@ -283,6 +362,29 @@ func bgpV4RouteProp(bgp *opb.BgpAttributes) (*ixconfig.TopologyBgpIpRoutePropert
 	brp.NoOfExternalCommunities = ixconfig.NumberInt(len(extComms))
 	brp.BgpExtendedCommunitiesList = extComms
 
+	largeComms, err := bgpLargeComms(bgp.GetLargeCommunities())
+	if err != nil {
+		return nil, err
+	}
+	if len(largeComms) != 0 {
+		brp.EnableLargeCommunities = ixconfig.MultivalueTrue()
+		brp.NoOfLargeCommunities = ixconfig.NumberInt(len(largeComms))
+		brp.BgpNonVPNRRLargeCommunitiesList = largeComms
+	}
+
+	if med := bgp.GetMed(); med != nil {
+		brp.EnableMultiExitDiscriminator = ixconfig.MultivalueTrue()
+		brp.MultiExitDiscriminator = ixconfig.MultivalueUint32(med.GetMed())
+	}
+
+	if aigp := bgp.GetAigp(); aigp != nil {
+		brp.EnableAigp = ixconfig.MultivalueTrue()
+		brp.BgpAigpList = []*ixconfig.TopologyBgpAigpList{{
+			Type_: ixconfig.MultivalueStr("aigptlv"),
+			Value: ixconfig.MultivalueStr(strconv.FormatUint(aigp.GetMetric(), 10)),
+		}}
+	}
+
 	asnSetMode, ok := asnSetModeToStr[bgp.GetAsnSetMode()]
 	if !ok {
 		return nil, usererr.New("invalid BGP ASN set mode %s", bgp.GetAsnSetMode())
@@ -317,6 +419,11 @@ func bgpV4RouteProp(bgp *opb.BgpAttributes) (*ixconfig.TopologyBgpIpRoutePropert
 			brp.BgpClusterIdList = append(brp.BgpClusterIdList, &ixconfig.TopologyBgpClusterIdList{ClusterId: ixconfig.MultivalueStr(ci)})
 		}
 	}
+
+	if addPaths := bgp.GetAddPaths(); addPaths != nil {
+		brp.EnableAddPath = ixconfig.MultivalueTrue()
+		brp.AddPathId = addPathID(addPaths)
+	}
 	return brp, nil
 }
 
@@ -367,6 +474,29 @@ func bgpV6RouteProp(bgp *opb.BgpAttributes) (*ixconfig.TopologyBgpV6IpRoutePrope
 	brp.NoOfExternalCommunities = ixconfig.NumberInt(len(extComms))
 	brp.BgpExtendedCommunitiesList = extComms
 
+	largeComms, err := bgpLargeComms(bgp.GetLargeCommunities())
+	if err != nil {
+		return nil, err
+	}
+	if len(largeComms) != 0 {
+		brp.EnableLargeCommunities = ixconfig.MultivalueTrue()
+		brp.NoOfLargeCommunities = ixconfig.NumberInt(len(largeComms))
+		brp.BgpNonVPNRRLargeCommunitiesList = largeComms
+	}
+
+	if med := bgp.GetMed(); med != nil {
+		brp.EnableMultiExitDiscriminator = ixconfig.MultivalueTrue()
+		brp.MultiExitDiscriminator = ixconfig.MultivalueUint32(med.GetMed())
+	}
+
+	if aigp := bgp.GetAigp(); aigp != nil {
+		brp.EnableAigp = ixconfig.MultivalueTrue()
+		brp.BgpAigpList = []*ixconfig.TopologyBgpAigpList{{
+			Type_: ixconfig.MultivalueStr("aigptlv"),
+			Value: ixconfig.MultivalueStr(strconv.FormatUint(aigp.GetMetric(), 10)),
+		}}
+	}
+
 	asnSetMode, ok := asnSetModeToStr[bgp.GetAsnSetMode()]
 	if !ok {
 		return nil, usererr.New("invalid BGP ASN set mode %s", bgp.GetAsnSetMode())
@@ -401,10 +531,20 @@ func bgpV6RouteProp(bgp *opb.BgpAttributes) (*ixconfig.TopologyBgpV6IpRoutePrope
 			brp.BgpClusterIdList = append(brp.BgpClusterIdList, &ixconfig.TopologyBgpClusterIdList{ClusterId: ixconfig.MultivalueStr(ci)})
 		}
 	}
+
+	if addPaths := bgp.GetAddPaths(); addPaths != nil {
+		brp.EnableAddPath = ixconfig.MultivalueTrue()
+		brp.AddPathId = addPathID(addPaths)
+	}
 	return brp, nil
 }
 
-func ipv4Pools(netCfg *opb.Network, hasIsisCfg, hasBgpCfg bool) ([]*ixconfig.TopologyIpv4PrefixPools, error) {
+// addPathID returns an add-path ID incremented for each copy of the prefixes.
+func addPathID(addPaths *opb.BgpAttributes_AddPaths) *ixconfig.Multivalue {
+	return ixconfig.MultivalueStrIncCounter(strconv.FormatUint(uint64(addPaths.GetPathIdStart()), 10), "1")
+}
+
+func ipv4Pools(netCfg *opb.Network, hasIsisCfg, hasBgpCfg, hasLdpCfg bool) ([]*ixconfig.TopologyIpv4PrefixPools, error) {
 	isis := netCfg.GetIsis()
 	bgp := netCfg.GetBgpAttributes()
 	ipv4 := netCfg.GetIpv4()
@@ -453,12 +593,33 @@ func ipv4Pools(netCfg *opb.Network, hasIsisCfg, hasBgpCfg bool) ([]*ixconfig.Top
 		}}
 	}
 
+	var lfps []*ixconfig.TopologyLdpFecProperty
+	if ldp := netCfg.GetLdp(); ldp != nil {
+		incrMode := "fixed"
+		if ldp.GetLabelIncrement() {
+			incrMode = "increment"
+		}
+		lfps = []*ixconfig.TopologyLdpFecProperty{{
+			Active:             ixconfig.MultivalueTrue(),
+			LabelValue:         ixconfig.MultivalueUint32(ldp.GetLabelStart()),
+			LabelIncrementMode: ixconfig.MultivalueStr(incrMode),
+		}}
+	} else if hasLdpCfg {
+		// LDP FEC config needs to be present if configured on _any_ network for this interface.
+		// Otherwise, Ixnetwork will autocreate an active config.
+		lfps = []*ixconfig.TopologyLdpFecProperty{{
+			Name:   ixconfig.String(fmt.Sprintf("%s LDP Inactive", netCfg.GetName())),
+			Active: ixconfig.MultivalueFalse(),
+		}}
+	}
+
 	return []*ixconfig.TopologyIpv4PrefixPools{{
 		NetworkAddress:       ixconfig.MultivalueStr(ip.String()),
 		PrefixLength:         ixconfig.MultivalueUint32(uint32(mask)),
 		NumberOfAddressesAsy: ixconfig.MultivalueUint32(ipv4.GetCount()),
 		IsisL3RouteProperty:  irps,
 		BgpIPRouteProperty:   brps,
+		LdpFECProperty:       lfps,
 	}}, nil
 }
 
@@ -525,24 +686,36 @@ func importedBGPRoutePools(netToRouteTables map[string]*routeTables, netCfg *opb
 		return nil, nil, usererr.New("cannot import routes for network group %q with any other routes/attributes configured", netCfg.GetName())
 	}
 
+	if imported != nil && netCfg.GetGeneratedBgpRoutes() != nil {
+		return nil, nil, usererr.New("cannot both import and generate routes for network group %q", netCfg.GetName())
+	}
+
 	if !hasBGPv4Peer && !hasBGPv6Peer {
 		return nil, nil, usererr.New("cannot import routes for network group %q without associated BGP peer", netCfg.GetName())
 	}
 
-	rts := &routeTables{
-		ipv4: imported.GetIpv4RoutesPath(),
-		ipv6: imported.GetIpv6RoutesPath(),
-	}
+	var rts *routeTables
+	if gen := netCfg.GetGeneratedBgpRoutes(); gen != nil {
+		var err error
+		if rts, err = generateRouteTables(gen); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		rts = &routeTables{
+			ipv4: imported.GetIpv4RoutesPath(),
+			ipv6: imported.GetIpv6RoutesPath(),
+		}
 
-	switch imported.GetRouteTableFormat() {
-	case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_UNSPECIFIED:
-		return nil, nil, usererr.New("route table format not specified")
-	case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_CISCO:
-		rts.format = routeTableFormatCisco
-	case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_JUNIPER:
-		rts.format = routeTableFormatJuniper
-	default:
-		return nil, nil, fmt.Errorf("unrecognized route table format %s", imported.GetRouteTableFormat())
+		switch imported.GetRouteTableFormat() {
+		case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_UNSPECIFIED:
+			return nil, nil, usererr.New("route table format not specified")
+		case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_CISCO:
+			rts.format = routeTableFormatCisco
+		case opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_JUNIPER:
+			rts.format = routeTableFormatJuniper
+		default:
+			return nil, nil, fmt.Errorf("unrecognized route table format %s", imported.GetRouteTableFormat())
+		}
 	}
 
 	netToRouteTables[netCfg.GetName()] = rts
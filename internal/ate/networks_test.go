@@ -32,7 +32,7 @@ func TestAddNetworks(t *testing.T) {
 		net1Name = "someNet"
 		net2Name = "anotherNet"
 	)
-	baseClient := func(withBGPv4Peer, withBGPv6Peer bool) *ixATE {
+	baseClient := func(withBGPv4Peer, withBGPv6Peer, withLDP bool) *ixATE {
 		cfg := &ixconfig.Ixnetwork{
 			Topology: []*ixconfig.Topology{{
 				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
@@ -53,6 +53,13 @@ func TestAddNetworks(t *testing.T) {
 			}}
 			ifc.ipv6 = ifc.deviceGroup.Ethernet[0].Ipv6[0]
 		}
+		if withLDP {
+			if ifc.ipv4 == nil {
+				ifc.deviceGroup.Ethernet[0].Ipv4 = []*ixconfig.TopologyIpv4{{}}
+				ifc.ipv4 = ifc.deviceGroup.Ethernet[0].Ipv4[0]
+			}
+			ifc.ipv4.LdpConnectedInterface = []*ixconfig.TopologyLdpConnectedInterface{{}}
+		}
 		return &ixATE{
 			cfg:   cfg,
 			intfs: map[string]*intf{ifName: ifc},
@@ -61,6 +68,7 @@ func TestAddNetworks(t *testing.T) {
 	tests := []struct {
 		desc                         string
 		withBGPv4Peer, withBGPv6Peer bool
+		withLDP                      bool
 		ifc                          *opb.InterfaceConfig
 		wantErr                      bool
 		wantNgs                      []*ixconfig.TopologyNetworkGroup
@@ -205,6 +213,7 @@ func TestAddNetworks(t *testing.T) {
 					Active: ixconfig.MultivalueFalse(),
 				}},
 				IsisL3RouteProperty: []*ixconfig.TopologyIsisL3RouteProperty{{
+					Active:                 ixconfig.MultivalueTrue(),
 					Metric:                 ixconfig.MultivalueUint32(0),
 					Algorithm:              ixconfig.MultivalueUint32(0),
 					RouteOrigin:            ixconfig.MultivalueStr("internal"),
@@ -227,6 +236,7 @@ func TestAddNetworks(t *testing.T) {
 					Active: ixconfig.MultivalueFalse(),
 				}},
 				IsisL3RouteProperty: []*ixconfig.TopologyIsisL3RouteProperty{{
+					Active:                 ixconfig.MultivalueTrue(),
 					Metric:                 ixconfig.MultivalueUint32(0),
 					Algorithm:              ixconfig.MultivalueUint32(0),
 					RouteOrigin:            ixconfig.MultivalueStr("internal"),
@@ -295,6 +305,239 @@ func TestAddNetworks(t *testing.T) {
 				}},
 			}},
 		}},
+	}, {
+		desc: "Withdrawn IS-IS and BGP pools",
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "10.0.0.0/8",
+					Count:       1,
+				},
+				Isis: &opb.IPReachability{
+					RouteOrigin: opb.IPReachability_INTERNAL,
+				},
+				Withdrawn: true,
+			}, {
+				Name:          net2Name,
+				InterfaceName: ifName,
+				Ipv6: &opb.NetworkIp{
+					AddressCidr: "2002::4860:10:0:0:0/127",
+					Count:       1,
+				},
+				BgpAttributes: &opb.BgpAttributes{
+					Active:     true,
+					Origin:     opb.BgpAttributes_ORIGIN_IGP,
+					AsnSetMode: opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+				},
+				Withdrawn: true,
+			}},
+		},
+		wantNgs: []*ixconfig.TopologyNetworkGroup{{
+			Name: ixconfig.String(net1Name),
+			Ipv4PrefixPools: []*ixconfig.TopologyIpv4PrefixPools{{
+				NetworkAddress:       ixconfig.MultivalueStr("10.0.0.0"),
+				PrefixLength:         ixconfig.MultivalueUint32(8),
+				NumberOfAddressesAsy: ixconfig.MultivalueUint32(1),
+				BgpIPRouteProperty: []*ixconfig.TopologyBgpIpRouteProperty{{
+					Name:   ixconfig.String(fmt.Sprintf("%s BGP Inactive", net1Name)),
+					Active: ixconfig.MultivalueFalse(),
+				}},
+				IsisL3RouteProperty: []*ixconfig.TopologyIsisL3RouteProperty{{
+					Active:                 ixconfig.MultivalueFalse(),
+					Metric:                 ixconfig.MultivalueUint32(0),
+					Algorithm:              ixconfig.MultivalueUint32(0),
+					RouteOrigin:            ixconfig.MultivalueStr("internal"),
+					ConfigureSIDIndexLabel: ixconfig.MultivalueFalse(),
+					SIDIndexLabel:          ixconfig.MultivalueUint32(0),
+					RFlag:                  ixconfig.MultivalueFalse(),
+					NFlag:                  ixconfig.MultivalueFalse(),
+					PFlag:                  ixconfig.MultivalueFalse(),
+					EFlag:                  ixconfig.MultivalueFalse(),
+					VFlag:                  ixconfig.MultivalueFalse(),
+					LFlag:                  ixconfig.MultivalueFalse(),
+				}},
+			}},
+		}, {
+			Name: ixconfig.String(net2Name),
+			Ipv6PrefixPools: []*ixconfig.TopologyIpv6PrefixPools{{
+				NetworkAddress:       ixconfig.MultivalueStr("2002:0:0:4860:10::"),
+				PrefixLength:         ixconfig.MultivalueUint32(127),
+				NumberOfAddressesAsy: ixconfig.MultivalueUint32(1),
+				BgpV6IPRouteProperty: []*ixconfig.TopologyBgpV6IpRouteProperty{{
+					Active:                          ixconfig.MultivalueFalse(),
+					EnableNextHop:                   ixconfig.MultivalueTrue(),
+					EnableOrigin:                    ixconfig.MultivalueTrue(),
+					EnableLocalPreference:           ixconfig.MultivalueTrue(),
+					LocalPreference:                 ixconfig.MultivalueUint32(0),
+					NoOfLargeCommunities:            ixconfig.NumberUint32(0),
+					NextHopType:                     ixconfig.MultivalueStr("sameaslocalip"),
+					Origin:                          ixconfig.MultivalueStr("igp"),
+					EnableCommunity:                 ixconfig.MultivalueFalse(),
+					NoOfCommunities:                 ixconfig.NumberInt(0),
+					EnableExtendedCommunity:         ixconfig.MultivalueFalse(),
+					NoOfExternalCommunities:         ixconfig.NumberInt(0),
+					AsSetMode:                       ixconfig.MultivalueStr("dontincludelocalas"),
+					EnableAsPathSegments:            ixconfig.MultivalueFalse(),
+					NoOfASPathSegmentsPerRouteRange: ixconfig.NumberInt(0),
+				}},
+				IsisL3RouteProperty: []*ixconfig.TopologyIsisL3RouteProperty{{
+					Name:   ixconfig.String(fmt.Sprintf("%s IS-IS Inactive", net2Name)),
+					Active: ixconfig.MultivalueFalse(),
+				}},
+			}},
+		}},
+	}, {
+		desc: "LDP FEC without LDP on interface",
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "10.0.0.0/8",
+					Count:       1,
+				},
+				Ldp: &opb.LdpFec{LabelStart: 16},
+			}},
+		},
+		wantErr: true,
+	}, {
+		desc:    "LDP FEC without IPv4 network",
+		withLDP: true,
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv6: &opb.NetworkIp{
+					AddressCidr: "aa::/64",
+					Count:       1,
+				},
+				Ldp: &opb.LdpFec{LabelStart: 16},
+			}},
+		},
+		wantErr: true,
+	}, {
+		desc:    "LDP FEC pools",
+		withLDP: true,
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "10.0.0.0/8",
+					Count:       10,
+				},
+				Ldp: &opb.LdpFec{LabelStart: 16, LabelIncrement: true},
+			}, {
+				Name:          net2Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "20.0.0.0/8",
+					Count:       1,
+				},
+				Withdrawn: true,
+			}},
+		},
+		wantNgs: []*ixconfig.TopologyNetworkGroup{{
+			Name: ixconfig.String(net1Name),
+			Ipv4PrefixPools: []*ixconfig.TopologyIpv4PrefixPools{{
+				NetworkAddress:       ixconfig.MultivalueStr("10.0.0.0"),
+				PrefixLength:         ixconfig.MultivalueUint32(8),
+				NumberOfAddressesAsy: ixconfig.MultivalueUint32(10),
+				LdpFECProperty: []*ixconfig.TopologyLdpFecProperty{{
+					Active:             ixconfig.MultivalueTrue(),
+					LabelValue:         ixconfig.MultivalueUint32(16),
+					LabelIncrementMode: ixconfig.MultivalueStr("increment"),
+				}},
+			}},
+		}, {
+			Name: ixconfig.String(net2Name),
+			Ipv4PrefixPools: []*ixconfig.TopologyIpv4PrefixPools{{
+				NetworkAddress:       ixconfig.MultivalueStr("20.0.0.0"),
+				PrefixLength:         ixconfig.MultivalueUint32(8),
+				NumberOfAddressesAsy: ixconfig.MultivalueUint32(1),
+				LdpFECProperty: []*ixconfig.TopologyLdpFecProperty{{
+					Name:   ixconfig.String(fmt.Sprintf("%s LDP Inactive", net2Name)),
+					Active: ixconfig.MultivalueFalse(),
+				}},
+			}},
+		}},
+	}, {
+		desc: "BGP add-paths with zero count",
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "10.0.0.0/8",
+					Count:       1,
+				},
+				BgpAttributes: &opb.BgpAttributes{
+					Origin:     opb.BgpAttributes_ORIGIN_IGP,
+					AsnSetMode: opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+					AddPaths:   &opb.BgpAttributes_AddPaths{},
+				},
+			}},
+		},
+		wantErr: true,
+	}, {
+		desc: "BGP add-paths",
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				Ipv4: &opb.NetworkIp{
+					AddressCidr: "10.0.0.0/8",
+					Count:       1,
+				},
+				BgpAttributes: &opb.BgpAttributes{
+					Active:     true,
+					Origin:     opb.BgpAttributes_ORIGIN_IGP,
+					AsnSetMode: opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+					AddPaths:   &opb.BgpAttributes_AddPaths{Count: 4, PathIdStart: 10},
+				},
+			}},
+		},
+		wantNgs: []*ixconfig.TopologyNetworkGroup{{
+			Name:       ixconfig.String(net1Name),
+			Multiplier: ixconfig.NumberUint32(4),
+			Ipv4PrefixPools: []*ixconfig.TopologyIpv4PrefixPools{{
+				NetworkAddress:       ixconfig.MultivalueStr("10.0.0.0"),
+				PrefixLength:         ixconfig.MultivalueUint32(8),
+				NumberOfAddressesAsy: ixconfig.MultivalueUint32(1),
+				BgpIPRouteProperty: []*ixconfig.TopologyBgpIpRouteProperty{{
+					Active:        ixconfig.MultivalueTrue(),
+					EnableNextHop: ixconfig.MultivalueTrue(),
+					NextHopType:   ixconfig.MultivalueStr("sameaslocalip"),
+
+					EnableOrigin: ixconfig.MultivalueTrue(),
+					Origin:       ixconfig.MultivalueStr("igp"),
+
+					EnableLocalPreference: ixconfig.MultivalueTrue(),
+					LocalPreference:       ixconfig.MultivalueUint32(0),
+
+					AsSetMode:                       ixconfig.MultivalueStr("dontincludelocalas"),
+					NoOfASPathSegmentsPerRouteRange: ixconfig.NumberUint32(0),
+					EnableAsPathSegments:            ixconfig.MultivalueFalse(),
+
+					EnableCommunity:         ixconfig.MultivalueFalse(),
+					NoOfCommunities:         ixconfig.NumberUint32(0),
+					EnableExtendedCommunity: ixconfig.MultivalueFalse(),
+					NoOfExternalCommunities: ixconfig.NumberUint32(0),
+					NoOfLargeCommunities:    ixconfig.NumberUint32(0),
+
+					EnableAddPath: ixconfig.MultivalueTrue(),
+					AddPathId:     ixconfig.MultivalueStrIncCounter("10", "1"),
+				}},
+			}},
+		}},
 	}, {
 		desc: "Importing routes with conflicting config",
 		ifc: &opb.InterfaceConfig{
@@ -333,6 +576,22 @@ func TestAddNetworks(t *testing.T) {
 			}},
 		},
 		wantErr: true,
+	}, {
+		desc:          "Imported and generated routes",
+		withBGPv4Peer: true,
+		ifc: &opb.InterfaceConfig{
+			Name: ifName,
+			Networks: []*opb.Network{{
+				Name:          net1Name,
+				InterfaceName: ifName,
+				ImportedBgpRoutes: &opb.Network_ImportedBgpRoutes{
+					RouteTableFormat: opb.Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_CISCO,
+					Ipv4RoutesPath:   "ipv4",
+				},
+				GeneratedBgpRoutes: &opb.GeneratedBgpRoutes{},
+			}},
+		},
+		wantErr: true,
 	}, {
 		desc:          "Imported IPv4 routes w/o v6 peer",
 		withBGPv4Peer: true,
@@ -440,7 +699,7 @@ func TestAddNetworks(t *testing.T) {
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			c := baseClient(test.withBGPv4Peer, test.withBGPv6Peer)
+			c := baseClient(test.withBGPv4Peer, test.withBGPv6Peer, test.withLDP)
 			gotErr := c.addNetworks(test.ifc)
 			if (gotErr != nil) != test.wantErr {
 				t.Errorf("addNetworks: unexpected error result, got err: %v, want err? %t", gotErr, test.wantErr)
@@ -691,6 +950,60 @@ func TestBgpV4RouteProp(t *testing.T) {
 			EnableOriginatorId: ixconfig.MultivalueTrue(),
 			OriginatorId:       ixconfig.MultivalueStrIncCounter("201.0.0.1", "0.0.0.1"),
 		},
+	}, {
+		desc: "bad large community",
+		bgpAttr: &opb.BgpAttributes{
+			Origin:           opb.BgpAttributes_ORIGIN_IGP,
+			AsnSetMode:       opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+			LargeCommunities: []string{"1:2"},
+		},
+		wantErr: "invalid format for BGP large community",
+	}, {
+		desc: "with MED, AIGP, and large communities",
+		bgpAttr: &opb.BgpAttributes{
+			Origin:           opb.BgpAttributes_ORIGIN_IGP,
+			AsnSetMode:       opb.BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE,
+			Med:              &opb.BgpAttributes_Med{Med: 100},
+			Aigp:             &opb.BgpAttributes_Aigp{Metric: 5000000000},
+			LargeCommunities: []string{"65535:100:10", "4294967295:1:65535"},
+		},
+		wantRouteProp: &ixconfig.TopologyBgpIpRouteProperty{
+			Active:        ixconfig.MultivalueFalse(),
+			EnableNextHop: ixconfig.MultivalueTrue(),
+			NextHopType:   ixconfig.MultivalueStr("sameaslocalip"),
+
+			EnableOrigin: ixconfig.MultivalueTrue(),
+			Origin:       ixconfig.MultivalueStr("igp"),
+
+			EnableLocalPreference: ixconfig.MultivalueTrue(),
+			LocalPreference:       ixconfig.MultivalueUint32(0),
+
+			AsSetMode:                       ixconfig.MultivalueStr("dontincludelocalas"),
+			NoOfASPathSegmentsPerRouteRange: ixconfig.NumberUint32(0),
+			EnableAsPathSegments:            ixconfig.MultivalueFalse(),
+
+			EnableCommunity:         ixconfig.MultivalueFalse(),
+			NoOfCommunities:         ixconfig.NumberUint32(0),
+			EnableExtendedCommunity: ixconfig.MultivalueFalse(),
+			NoOfExternalCommunities: ixconfig.NumberUint32(0),
+
+			EnableLargeCommunities: ixconfig.MultivalueTrue(),
+			NoOfLargeCommunities:   ixconfig.NumberUint32(2),
+			BgpNonVPNRRLargeCommunitiesList: []*ixconfig.TopologyBgpNonVpnrrLargeCommunitiesList{{
+				LargeCommunity: ixconfig.MultivalueStr("65535:100:10"),
+			}, {
+				LargeCommunity: ixconfig.MultivalueStr("4294967295:1:65535"),
+			}},
+
+			EnableMultiExitDiscriminator: ixconfig.MultivalueTrue(),
+			MultiExitDiscriminator:       ixconfig.MultivalueUint32(100),
+
+			EnableAigp: ixconfig.MultivalueTrue(),
+			BgpAigpList: []*ixconfig.TopologyBgpAigpList{{
+				Type_: ixconfig.MultivalueStr("aigptlv"),
+				Value: ixconfig.MultivalueStr("5000000000"),
+			}},
+		},
 	}, {
 		desc: "bad cluster ID",
 		bgpAttr: &opb.BgpAttributes{
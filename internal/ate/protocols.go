@@ -37,6 +37,11 @@ var (
 		opb.BgpAsnSetMode_ASN_SET_MODE_AS_SET_CONFEDERATION: "includelocalasasassetconfederation",
 		opb.BgpAsnSetMode_ASN_SET_MODE_PREPEND:              "prependlocalastofirstsegment",
 	}
+	addPathModeToStr = map[opb.BgpPeer_AddPathMode]string{
+		opb.BgpPeer_ADD_PATH_MODE_RECEIVE:      "receiveonly",
+		opb.BgpPeer_ADD_PATH_MODE_SEND:         "sendonly",
+		opb.BgpPeer_ADD_PATH_MODE_SEND_RECEIVE: "both",
+	}
 )
 
 // Returns the string representing the given cipher suite and the length of keys in bits.
@@ -174,6 +179,106 @@ func (ix *ixATE) addMACsecProtocol(ifc *opb.InterfaceConfig) error {
 	return nil
 }
 
+var dot1xProtocols = map[opb.Dot1XConfig_EapMethod]string{
+	opb.Dot1XConfig_MD5:     "eapmd5",
+	opb.Dot1XConfig_PEAP_V0: "peapv0",
+	opb.Dot1XConfig_PEAP_V1: "peapv1",
+	opb.Dot1XConfig_TTLS:    "eapttls",
+}
+
+// addDot1xProtocol adds an IxNetwork 802.1X supplicant, assuming the device
+// group for the given interface already exists.
+func (ix *ixATE) addDot1xProtocol(ifc *opb.InterfaceConfig) error {
+	dot1x := ifc.GetDot1X()
+	if dot1x == nil {
+		return nil
+	}
+	protocol, ok := dot1xProtocols[dot1x.GetEapMethod()]
+	if !ok {
+		return usererr.New("unsupported 802.1X EAP method %v on interface %q", dot1x.GetEapMethod(), ifc.GetName())
+	}
+	if dot1x.GetUsername() == "" {
+		return usererr.New("no 802.1X username on interface %q", ifc.GetName())
+	}
+	eth := ix.intfs[ifc.GetName()].deviceGroup.Ethernet[0]
+	eth.DotOneX = append(eth.DotOneX, &ixconfig.TopologyDotOneX{
+		Name:       ixconfig.String(fmt.Sprintf("802.1X supplicant on %s", ifc.GetName())),
+		Multiplier: ixconfig.NumberUint32(1),
+		Protocol:   ixconfig.MultivalueStr(protocol),
+		UserName:   ixconfig.MultivalueStr(dot1x.GetUsername()),
+		UserPwd:    ixconfig.MultivalueStr(dot1x.GetPassword()),
+		WaitId:     ixconfig.MultivalueBool(dot1x.GetWaitForIdentityRequest()),
+	})
+	return nil
+}
+
+var (
+	ptpRoles = map[opb.PtpConfig_Role]string{
+		opb.PtpConfig_MASTER: "master",
+		opb.PtpConfig_SLAVE:  "slave",
+	}
+	ptpDelayMechanisms = map[opb.PtpConfig_DelayMechanism]string{
+		opb.PtpConfig_END_TO_END:   "requestResponse",
+		opb.PtpConfig_PEER_TO_PEER: "peerDelay",
+	}
+)
+
+// addPTPProtocol adds an IxNetwork PTP clock, assuming the IP protocols for
+// the given interface have already been added.
+// Returns an error if the PTP configuration does not validate.
+func (ix *ixATE) addPTPProtocol(ifc *opb.InterfaceConfig) error {
+	ptp := ifc.GetPtp()
+	if ptp == nil {
+		return nil
+	}
+	intf := ix.intfs[ifc.GetName()]
+	if _, ok := intf.link.(*ixconfig.Vport); !ok {
+		return usererr.New("cannot configure PTP on interface %q, which is on a LAG", ifc.GetName())
+	}
+	role, ok := ptpRoles[ptp.GetRole()]
+	if !ok {
+		return usererr.New("unrecognized PTP role %v on interface %q", ptp.GetRole(), ifc.GetName())
+	}
+	delayMechanism, ok := ptpDelayMechanisms[ptp.GetDelayMechanism()]
+	if !ok {
+		return usererr.New("unrecognized PTP delay mechanism %v on interface %q", ptp.GetDelayMechanism(), ifc.GetName())
+	}
+	logInterval := func(v int32) *ixconfig.Multivalue {
+		return ixconfig.MultivalueStr(strconv.Itoa(int(v)))
+	}
+	clock := &ixconfig.TopologyPtp{
+		Name:                ixconfig.String(fmt.Sprintf("PTP on %s", ifc.GetName())),
+		Multiplier:          ixconfig.NumberUint32(1),
+		Profile:             ixconfig.MultivalueStr("ieee1588"),
+		Role:                ixconfig.MultivalueStr(role),
+		DelayMechanism:      ixconfig.MultivalueStr(delayMechanism),
+		Domain:              ixconfig.MultivalueUint32(ptp.GetDomain()),
+		Priority1:           ixconfig.MultivalueUint32(ptp.GetPriority1()),
+		Priority2:           ixconfig.MultivalueUint32(ptp.GetPriority2()),
+		LogSyncInterval:     logInterval(ptp.GetLogSyncInterval()),
+		LogAnnounceInterval: logInterval(ptp.GetLogAnnounceInterval()),
+		LogDelayReqInterval: logInterval(ptp.GetLogDelayReqInterval()),
+	}
+	switch ptp.GetTransport() {
+	case opb.PtpConfig_ETHERNET:
+		eth := intf.deviceGroup.Ethernet[0]
+		eth.Ptp = append(eth.Ptp, clock)
+	case opb.PtpConfig_IPV4:
+		if intf.ipv4 == nil {
+			return usererr.New("need a static IPv4 address for PTP over IPv4 on interface %q", ifc.GetName())
+		}
+		intf.ipv4.Ptp = append(intf.ipv4.Ptp, clock)
+	case opb.PtpConfig_IPV6:
+		if intf.ipv6 == nil {
+			return usererr.New("need a static IPv6 address for PTP over IPv6 on interface %q", ifc.GetName())
+		}
+		intf.ipv6.Ptp = append(intf.ipv6.Ptp, clock)
+	default:
+		return usererr.New("unrecognized PTP transport %v on interface %q", ptp.GetTransport(), ifc.GetName())
+	}
+	return nil
+}
+
 // addIPProtocols adds IxNetwork IP protocols, assuming the device group for the given interface already exsts.
 // Returns an error if the IP configuration does not validate.
 func (ix *ixATE) addIPProtocols(ifc *opb.InterfaceConfig) error {
@@ -184,6 +289,9 @@ func (ix *ixATE) addIPProtocols(ifc *opb.InterfaceConfig) error {
 		if ipv4.GetAddressCidr() == "" || ipv4.GetDefaultGateway() == "" {
 			return usererr.New("need both address and default gateway defined for IP V4 layer")
 		}
+		if ipv4.GetNeighborDiscovery() != nil {
+			return usererr.New("neighbor discovery is only supported for IP V6 layer")
+		}
 		ip, netw, err := net.ParseCIDR(ipv4.GetAddressCidr())
 		if err != nil {
 			return err
@@ -219,6 +327,9 @@ func (ix *ixATE) addIPProtocols(ifc *opb.InterfaceConfig) error {
 			GatewayIp:      ixconfig.MultivalueStr(ipv6.GetDefaultGateway()),
 			ResolveGateway: ixconfig.MultivalueTrue(),
 		}
+		if nd := ipv6.GetNeighborDiscovery(); nd != nil {
+			ix.addNeighborDiscovery(intf, nd)
+		}
 		if hasMacSec {
 			eth.Macsec[0].Ipv6 = append(eth.Macsec[0].Ipv6, intf.ipv6)
 		} else {
@@ -228,6 +339,48 @@ func (ix *ixATE) addIPProtocols(ifc *opb.InterfaceConfig) error {
 	return nil
 }
 
+// addNeighborDiscovery configures IPv6 neighbor discovery on the interface,
+// and on its ports if it overrides the neighbor solicitation settings.
+func (ix *ixATE) addNeighborDiscovery(intf *intf, nd *opb.NeighborDiscoveryConfig) {
+	intf.ipv6.SendRa = ixconfig.MultivalueBool(nd.GetSendRouterAdvertisements())
+	intf.ipv6.IncludeRaPrefix = ixconfig.MultivalueBool(nd.GetIncludeRaPrefix())
+	if nd.GetDisableGatewayResolution() {
+		intf.ipv6.ResolveGateway = ixconfig.MultivalueFalse()
+	}
+	if nd.GetNsCount() == 0 && nd.GetNsRetransmitMs() == 0 {
+		return
+	}
+	count, retransmitMs := nd.GetNsCount(), nd.GetNsRetransmitMs()
+	if count == 0 {
+		count = defaultNSCount
+	}
+	if retransmitMs == 0 {
+		retransmitMs = defaultNSRetransmitMs
+	}
+	var vports []*ixconfig.Vport
+	switch l := intf.link.(type) {
+	case *ixconfig.Vport:
+		vports = []*ixconfig.Vport{l}
+	case *ixconfig.Lag:
+		vports = ix.lagPorts[l]
+	}
+	for _, vport := range vports {
+		setNSOptions(vport, count, retransmitMs)
+	}
+}
+
+func setNSOptions(vport *ixconfig.Vport, count, retransmitMs uint32) {
+	if vport.ProtocolStack == nil {
+		vport.ProtocolStack = &ixconfig.VportProtocolStack{}
+	}
+	if vport.ProtocolStack.Options == nil {
+		vport.ProtocolStack.Options = &ixconfig.VportProtocolStackOptions{}
+	}
+	opts := vport.ProtocolStack.Options
+	opts.McastSolicit = ixconfig.NumberUint32(count)
+	opts.RetransTime = ixconfig.NumberUint32(retransmitMs)
+}
+
 // addIPLoopbackProtocols adds IxNetwork IP loopback protocols, assuming the
 // device group for the given interface already exsts.
 // Returns an error if the IP configuration does not validate.
@@ -261,6 +414,557 @@ func (ix *ixATE) addIPLoopbackProtocols(ifc *opb.InterfaceConfig) error {
 	return nil
 }
 
+// addDHCPProtocols adds IxNetwork DHCP client and server protocols, assuming
+// the IP protocols for the given interface have already been added.
+// Returns an error if the DHCP configuration does not validate.
+func (ix *ixATE) addDHCPProtocols(ifc *opb.InterfaceConfig) error {
+	dhcp := ifc.GetDhcp()
+	if dhcp == nil {
+		return nil
+	}
+	intf := ix.intfs[ifc.GetName()]
+	eth := intf.deviceGroup.Ethernet[0]
+
+	v4Count, v6Count := dhcp.GetV4Client().GetCount(), dhcp.GetV6Client().GetCount()
+	if dhcp.GetV4Client() != nil && dhcp.GetV6Client() != nil && v4Count != v6Count {
+		return usererr.New("DHCPv4 client count %d and DHCPv6 client count %d differ on interface %q", v4Count, v6Count, ifc.GetName())
+	}
+	if count := max(int(v4Count), int(v6Count)); count > 1 {
+		if intf.ipv4 != nil || intf.ipv6 != nil {
+			return usererr.New("cannot configure multiple DHCP clients on interface %q with a static IP address", ifc.GetName())
+		}
+		// Each client needs its own MAC address, so scale the device group.
+		intf.deviceGroup.Multiplier = ixconfig.NumberUint32(uint32(count))
+	}
+
+	if c := dhcp.GetV4Client(); c != nil {
+		if intf.ipv4 != nil {
+			return usererr.New("cannot configure both a static IPv4 address and a DHCPv4 client on interface %q", ifc.GetName())
+		}
+		eth.Dhcpv4client = append(eth.Dhcpv4client, &ixconfig.TopologyDhcpv4client{
+			Name:           ixconfig.String(fmt.Sprintf("DHCPv4 client on %s", ifc.GetName())),
+			Multiplier:     ixconfig.NumberUint32(1),
+			UseRapidCommit: ixconfig.MultivalueBool(c.GetRapidCommit()),
+		})
+	}
+	if c := dhcp.GetV6Client(); c != nil {
+		if intf.ipv6 != nil {
+			return usererr.New("cannot configure both a static IPv6 address and a DHCPv6 client on interface %q", ifc.GetName())
+		}
+		eth.Dhcpv6client = append(eth.Dhcpv6client, &ixconfig.TopologyDhcpv6client{
+			Name:           ixconfig.String(fmt.Sprintf("DHCPv6 client on %s", ifc.GetName())),
+			Multiplier:     ixconfig.NumberUint32(1),
+			Dhcp6IaType:    ixconfig.MultivalueStr("iana"),
+			UseRapidCommit: ixconfig.MultivalueBool(c.GetRapidCommit()),
+		})
+	}
+
+	if s := dhcp.GetV4Server(); s != nil {
+		if intf.ipv4 == nil {
+			return usererr.New("need a static IPv4 address for the DHCPv4 server on interface %q", ifc.GetName())
+		}
+		ip, prefix, err := dhcpPool(s, ifc.GetIpv4(), false)
+		if err != nil {
+			return err
+		}
+		gateway, _, _, err := parseCIDR(ifc.GetIpv4().GetAddressCidr())
+		if err != nil {
+			return err
+		}
+		sessions := &ixconfig.TopologyDhcp4ServerSessions{
+			IpAddress: ixconfig.MultivalueStr(ip),
+			IpPrefix:  ixconfig.MultivalueUint32(prefix),
+			IpGateway: ixconfig.MultivalueStr(gateway),
+			PoolSize:  ixconfig.MultivalueUint32(s.GetPoolSize()),
+		}
+		if lt := s.GetLeaseTimeSecs(); lt != 0 {
+			sessions.DefaultLeaseTime = ixconfig.MultivalueUint32(lt)
+		}
+		intf.ipv4.Dhcpv4server = append(intf.ipv4.Dhcpv4server, &ixconfig.TopologyDhcpv4server{
+			Name:                ixconfig.String(fmt.Sprintf("DHCPv4 server on %s", ifc.GetName())),
+			Multiplier:          ixconfig.NumberUint32(1),
+			PoolCount:           ixconfig.NumberUint32(1),
+			Dhcp4ServerSessions: sessions,
+		})
+	}
+	if s := dhcp.GetV6Server(); s != nil {
+		if intf.ipv6 == nil {
+			return usererr.New("need a static IPv6 address for the DHCPv6 server on interface %q", ifc.GetName())
+		}
+		ip, prefix, err := dhcpPool(s, ifc.GetIpv6(), true)
+		if err != nil {
+			return err
+		}
+		sessions := &ixconfig.TopologyDhcp6ServerSessions{
+			IaType:    ixconfig.MultivalueStr("iana"),
+			IpAddress: ixconfig.MultivalueStr(ip),
+			IpPrefix:  ixconfig.MultivalueUint32(prefix),
+			PoolSize:  ixconfig.MultivalueUint32(s.GetPoolSize()),
+		}
+		if lt := s.GetLeaseTimeSecs(); lt != 0 {
+			sessions.DefaultLeaseTime = ixconfig.MultivalueUint32(lt)
+		}
+		intf.ipv6.Dhcpv6server = append(intf.ipv6.Dhcpv6server, &ixconfig.TopologyDhcpv6server{
+			Name:                ixconfig.String(fmt.Sprintf("DHCPv6 server on %s", ifc.GetName())),
+			Multiplier:          ixconfig.NumberUint32(1),
+			PoolCount:           ixconfig.NumberUint32(1),
+			Dhcp6ServerSessions: sessions,
+		})
+	}
+	return nil
+}
+
+// dhcpPool returns the first address and prefix length of a DHCP server pool.
+// The prefix length defaults to that of the server's own address.
+func dhcpPool(s *opb.DhcpConfig_Server, ipc *opb.IpConfig, isV6 bool) (string, uint32, error) {
+	ip := net.ParseIP(s.GetPoolAddress())
+	if ip == nil || (ip.To4() == nil) != isV6 {
+		return "", 0, usererr.New("invalid DHCP server pool address: %q", s.GetPoolAddress())
+	}
+	if s.GetPoolSize() == 0 {
+		return "", 0, usererr.New("DHCP server pool size must be positive")
+	}
+	prefix := s.GetPrefixLength()
+	if prefix == 0 {
+		_, mask, _, err := parseCIDR(ipc.GetAddressCidr())
+		if err != nil {
+			return "", 0, err
+		}
+		prefix = mask
+	}
+	return ip.String(), prefix, nil
+}
+
+// addBFDProtocols adds IxNetwork BFD interfaces and sessions, assuming the IP
+// protocols for the given interface have already been added.
+// Returns an error if the BFD configuration does not validate.
+func (ix *ixATE) addBFDProtocols(ifc *opb.InterfaceConfig) error {
+	bfd := ifc.GetBfd()
+	if bfd == nil {
+		return nil
+	}
+	intf := ix.intfs[ifc.GetName()]
+	if intf.ipv4 == nil && intf.ipv6 == nil {
+		return usererr.New("need a static IP address for BFD on interface %q", ifc.GetName())
+	}
+	sessionType := "singlehop"
+	if bfd.GetMultiHop() {
+		sessionType = "multiplehop"
+	}
+
+	if intf.ipv4 != nil {
+		remote, err := bfdRemoteAddress(bfd, bfd.GetIpv4RemoteAddress(), ifc.GetIpv4(), false)
+		if err != nil {
+			return err
+		}
+		intf.ipv4.Bfdv4Interface = append(intf.ipv4.Bfdv4Interface, &ixconfig.TopologyBfdv4Interface{
+			Name:              ixconfig.String(fmt.Sprintf("BFDv4 on %s", ifc.GetName())),
+			Active:            ixconfig.MultivalueBool(!bfd.GetAdminDown()),
+			TxInterval:        ixconfig.MultivalueUint32(bfd.GetTxIntervalMs()),
+			MinRxInterval:     ixconfig.MultivalueUint32(bfd.GetRxIntervalMs()),
+			TimeoutMultiplier: ixconfig.MultivalueUint32(bfd.GetMultiplier()),
+			Bfdv4Session: &ixconfig.TopologyBfdv4Session{
+				RemoteIp4:   ixconfig.MultivalueStr(remote),
+				SessionType: ixconfig.MultivalueStr(sessionType),
+			},
+		})
+	} else if bfd.GetIpv4RemoteAddress() != "" {
+		return usererr.New("specified a BFDv4 remote address without IPv4 configured on interface %q", ifc.GetName())
+	}
+	if intf.ipv6 != nil {
+		remote, err := bfdRemoteAddress(bfd, bfd.GetIpv6RemoteAddress(), ifc.GetIpv6(), true)
+		if err != nil {
+			return err
+		}
+		intf.ipv6.Bfdv6Interface = append(intf.ipv6.Bfdv6Interface, &ixconfig.TopologyBfdv6Interface{
+			Name:              ixconfig.String(fmt.Sprintf("BFDv6 on %s", ifc.GetName())),
+			Active:            ixconfig.MultivalueBool(!bfd.GetAdminDown()),
+			TxInterval:        ixconfig.MultivalueUint32(bfd.GetTxIntervalMs()),
+			MinRxInterval:     ixconfig.MultivalueUint32(bfd.GetRxIntervalMs()),
+			TimeoutMultiplier: ixconfig.MultivalueUint32(bfd.GetMultiplier()),
+			Bfdv6Session: &ixconfig.TopologyBfdv6Session{
+				RemoteIp6:   ixconfig.MultivalueStr(remote),
+				SessionType: ixconfig.MultivalueStr(sessionType),
+			},
+		})
+	} else if bfd.GetIpv6RemoteAddress() != "" {
+		return usererr.New("specified a BFDv6 remote address without IPv6 configured on interface %q", ifc.GetName())
+	}
+	return nil
+}
+
+// bfdRemoteAddress returns the remote address of a BFD session.
+// Single-hop sessions default to the default gateway of the interface.
+func bfdRemoteAddress(bfd *opb.BfdConfig, remote string, ipc *opb.IpConfig, isV6 bool) (string, error) {
+	if remote == "" {
+		if bfd.GetMultiHop() {
+			return "", usererr.New("need a remote address for multi-hop BFD sessions")
+		}
+		return ipc.GetDefaultGateway(), nil
+	}
+	if ip, v6 := parseIP(remote); ip == nil || v6 != isV6 {
+		return "", usererr.New("invalid BFD remote address %q", remote)
+	}
+	return remote, nil
+}
+
+// addMulticastHosts adds IxNetwork IGMP and MLD hosts, assuming the IP
+// protocols for the given interface have already been added.
+// Returns an error if the host configuration does not validate.
+func (ix *ixATE) addMulticastHosts(ifc *opb.InterfaceConfig) error {
+	intf := ix.intfs[ifc.GetName()]
+	if igmp := ifc.GetIgmpHost(); igmp != nil {
+		if intf.ipv4 == nil {
+			return usererr.New("need a static IPv4 address for the IGMP host on interface %q", ifc.GetName())
+		}
+		var version string
+		switch igmp.GetVersion() {
+		case opb.IgmpHostConfig_VERSION_UNSPECIFIED:
+			return usererr.New("IGMP version not specified")
+		case opb.IgmpHostConfig_V2:
+			version = "version2"
+		case opb.IgmpHostConfig_V3:
+			version = "version3"
+		default:
+			return fmt.Errorf("unrecognized IGMP version %s", igmp.GetVersion())
+		}
+		grps, err := mcastGroups(igmp.GetGroups(), false, igmp.GetVersion() == opb.IgmpHostConfig_V3)
+		if err != nil {
+			return err
+		}
+		host := &ixconfig.TopologyIgmpHost{
+			Name:          ixconfig.String(fmt.Sprintf("IGMP host on %s", ifc.GetName())),
+			VersionType:   ixconfig.MultivalueStr(version),
+			NoOfGrpRanges: ixconfig.NumberInt(len(igmp.GetGroups())),
+			IgmpMcastIPv4GroupList: &ixconfig.TopologyIgmpMcastIPv4GroupList{
+				Active:         grps.active,
+				StartMcastAddr: grps.addrs,
+				McastAddrCnt:   grps.counts,
+				McastAddrIncr:  ixconfig.MultivalueStr("0.0.0.1"),
+				SourceMode:     grps.sourceModes,
+				NoOfSrcRanges:  ixconfig.NumberUint32(1),
+				IgmpUcastIPv4SourceList: &ixconfig.TopologyIgmpUcastIPv4SourceList{
+					StartUcastAddr:  grps.sources,
+					UcastSrcAddrCnt: ixconfig.MultivalueUint32(1),
+				},
+			},
+		}
+		intf.ipv4.IgmpHost = append(intf.ipv4.IgmpHost, host)
+		intf.igmpGroups = host.IgmpMcastIPv4GroupList
+	}
+	if mld := ifc.GetMldHost(); mld != nil {
+		if intf.ipv6 == nil {
+			return usererr.New("need a static IPv6 address for the MLD host on interface %q", ifc.GetName())
+		}
+		var version string
+		switch mld.GetVersion() {
+		case opb.MldHostConfig_VERSION_UNSPECIFIED:
+			return usererr.New("MLD version not specified")
+		case opb.MldHostConfig_V1:
+			version = "version1"
+		case opb.MldHostConfig_V2:
+			version = "version2"
+		default:
+			return fmt.Errorf("unrecognized MLD version %s", mld.GetVersion())
+		}
+		grps, err := mcastGroups(mld.GetGroups(), true, mld.GetVersion() == opb.MldHostConfig_V2)
+		if err != nil {
+			return err
+		}
+		host := &ixconfig.TopologyMldHost{
+			Name:          ixconfig.String(fmt.Sprintf("MLD host on %s", ifc.GetName())),
+			VersionType:   ixconfig.MultivalueStr(version),
+			NoOfGrpRanges: ixconfig.NumberInt(len(mld.GetGroups())),
+			MldMcastIPv6GroupList: &ixconfig.TopologyMldMcastIPv6GroupList{
+				Active:         grps.active,
+				StartMcastAddr: grps.addrs,
+				McastAddrCnt:   grps.counts,
+				McastAddrIncr:  ixconfig.MultivalueStr("::1"),
+				SourceMode:     grps.sourceModes,
+				NoOfSrcRanges:  ixconfig.NumberUint32(1),
+				MldUcastIPv6SourceList: &ixconfig.TopologyMldUcastIPv6SourceList{
+					StartUcastAddr:  grps.sources,
+					UcastSrcAddrCnt: ixconfig.MultivalueUint32(1),
+				},
+			},
+		}
+		intf.ipv6.MldHost = append(intf.ipv6.MldHost, host)
+		intf.mldGroups = host.MldMcastIPv6GroupList
+	}
+	return nil
+}
+
+type mcastGroupCfg struct {
+	active, addrs, counts, sourceModes, sources *ixconfig.Multivalue
+}
+
+// mcastGroups returns the multivalues for a list of IGMP or MLD group ranges.
+func mcastGroups(groups []*opb.MulticastGroup, isV6, ssm bool) (*mcastGroupCfg, error) {
+	if len(groups) == 0 {
+		return nil, usererr.New("need at least one multicast group")
+	}
+	anySource := "0.0.0.0"
+	if isV6 {
+		anySource = "::"
+	}
+	var (
+		active             []bool
+		addrs, modes, srcs []string
+		counts             []uint32
+	)
+	for _, g := range groups {
+		ip, v6 := parseIP(g.GetAddress())
+		if ip == nil || v6 != isV6 || !ip.IsMulticast() {
+			return nil, usererr.New("invalid multicast group address %q", g.GetAddress())
+		}
+		mode, src := "exclude", anySource
+		if s := g.GetSourceAddress(); s != "" {
+			if !ssm {
+				return nil, usererr.New("source-specific multicast group %q requires IGMPv3 or MLDv2", g.GetAddress())
+			}
+			if ip, v6 := parseIP(s); ip == nil || v6 != isV6 {
+				return nil, usererr.New("invalid multicast source address %q", s)
+			}
+			mode, src = "include", s
+		}
+		active = append(active, !g.GetLeft())
+		addrs = append(addrs, g.GetAddress())
+		counts = append(counts, uint32(max(int(g.GetCount()), 1)))
+		modes = append(modes, mode)
+		srcs = append(srcs, src)
+	}
+	return &mcastGroupCfg{
+		active:      ixconfig.MultivalueBoolList(active...),
+		addrs:       ixconfig.MultivalueStrList(addrs...),
+		counts:      ixconfig.MultivalueUintList(counts...),
+		sourceModes: ixconfig.MultivalueStrList(modes...),
+		sources:     ixconfig.MultivalueStrList(srcs...),
+	}, nil
+}
+
+// addPIMProtocols adds IxNetwork PIM-SM interfaces, assuming the IP protocols
+// for the given interface have already been added.
+// Returns an error if the PIM configuration does not validate.
+func (ix *ixATE) addPIMProtocols(ifc *opb.InterfaceConfig) error {
+	pim := ifc.GetPim()
+	if pim == nil {
+		return nil
+	}
+	intf := ix.intfs[ifc.GetName()]
+	if intf.ipv4 == nil && intf.ipv6 == nil {
+		return usererr.New("need a static IP address for PIM on interface %q", ifc.GetName())
+	}
+	v4JPs, v6JPs, err := pimJoinPrunes(pim.GetJoinPrunes())
+	if err != nil {
+		return err
+	}
+	v4RPs, v6RPs, err := pimCandidateRPs(pim.GetCandidateRps())
+	if err != nil {
+		return err
+	}
+	var bfd *ixconfig.Multivalue
+	if pim.GetEnableBfd() {
+		bfd = ixconfig.MultivalueTrue()
+	}
+
+	if intf.ipv4 != nil {
+		pimIntf := &ixconfig.TopologyPimV4Interface{
+			Name:                  ixconfig.String(fmt.Sprintf("PIMv4 on %s", ifc.GetName())),
+			HelloInterval:         ixconfig.MultivalueUint32(pim.GetHelloIntervalSec()),
+			HelloHoldTime:         ixconfig.MultivalueUint32(pim.GetHelloHoldTimeSec()),
+			AutoPickNeighbor:      ixconfig.MultivalueTrue(),
+			EnableBfdRegistration: bfd,
+			JoinPrunes:            ixconfig.NumberInt(len(v4JPs.active)),
+			CrpRanges:             ixconfig.NumberInt(len(v4RPs.addrs)),
+		}
+		if len(v4JPs.active) > 0 {
+			pimIntf.PimV4JoinPruneList = &ixconfig.TopologyPimV4JoinPruneList{
+				Active:             ixconfig.MultivalueBoolList(v4JPs.active...),
+				RangeType:          ixconfig.MultivalueStrList(v4JPs.rangeTypes...),
+				RpV4Address:        ixconfig.MultivalueStrList(v4JPs.rps...),
+				GroupV4Address:     ixconfig.MultivalueStrList(v4JPs.groups...),
+				GroupAddressCount:  ixconfig.MultivalueUintList(v4JPs.groupCounts...),
+				SourceV4Address:    ixconfig.MultivalueStrList(v4JPs.sources...),
+				SourceAddressCount: ixconfig.MultivalueUintList(v4JPs.sourceCounts...),
+			}
+		}
+		if len(v4RPs.addrs) > 0 {
+			pimIntf.PimV4CandidateRPsList = &ixconfig.TopologyPimV4CandidateRPsList{
+				CrpAddress:      ixconfig.MultivalueStrList(v4RPs.addrs...),
+				CrpAddressCount: ixconfig.MultivalueUint32(1),
+				GroupAddress:    ixconfig.MultivalueStrList(v4RPs.groups...),
+				GroupMaskLen:    ixconfig.MultivalueUintList(v4RPs.maskLens...),
+				GroupCount:      ixconfig.MultivalueUint32(1),
+				Priority:        ixconfig.MultivalueUintList(v4RPs.priorities...),
+			}
+		}
+		intf.ipv4.PimV4Interface = append(intf.ipv4.PimV4Interface, pimIntf)
+	} else if len(v4JPs.active) > 0 || len(v4RPs.addrs) > 0 {
+		return usererr.New("specified IPv4 PIM join/prunes or candidate RPs without IPv4 configured on interface %q", ifc.GetName())
+	}
+	if intf.ipv6 != nil {
+		pimIntf := &ixconfig.TopologyPimV6Interface{
+			Name:                  ixconfig.String(fmt.Sprintf("PIMv6 on %s", ifc.GetName())),
+			HelloInterval:         ixconfig.MultivalueUint32(pim.GetHelloIntervalSec()),
+			HelloHoldTime:         ixconfig.MultivalueUint32(pim.GetHelloHoldTimeSec()),
+			AutoPickNeighbor:      ixconfig.MultivalueTrue(),
+			EnableBfdRegistration: bfd,
+			JoinPrunes:            ixconfig.NumberInt(len(v6JPs.active)),
+			CrpRanges:             ixconfig.NumberInt(len(v6RPs.addrs)),
+		}
+		if len(v6JPs.active) > 0 {
+			pimIntf.PimV6JoinPruneList = &ixconfig.TopologyPimV6JoinPruneList{
+				Active:             ixconfig.MultivalueBoolList(v6JPs.active...),
+				RangeType:          ixconfig.MultivalueStrList(v6JPs.rangeTypes...),
+				RpV6Address:        ixconfig.MultivalueStrList(v6JPs.rps...),
+				GroupV6Address:     ixconfig.MultivalueStrList(v6JPs.groups...),
+				GroupAddressCount:  ixconfig.MultivalueUintList(v6JPs.groupCounts...),
+				SourceV6Address:    ixconfig.MultivalueStrList(v6JPs.sources...),
+				SourceAddressCount: ixconfig.MultivalueUintList(v6JPs.sourceCounts...),
+			}
+		}
+		if len(v6RPs.addrs) > 0 {
+			pimIntf.PimV6CandidateRPsList = &ixconfig.TopologyPimV6CandidateRPsList{
+				CrpAddress:      ixconfig.MultivalueStrList(v6RPs.addrs...),
+				CrpAddressCount: ixconfig.MultivalueUint32(1),
+				GroupAddress:    ixconfig.MultivalueStrList(v6RPs.groups...),
+				GroupMaskLen:    ixconfig.MultivalueUintList(v6RPs.maskLens...),
+				GroupCount:      ixconfig.MultivalueUint32(1),
+				Priority:        ixconfig.MultivalueUintList(v6RPs.priorities...),
+			}
+		}
+		intf.ipv6.PimV6Interface = append(intf.ipv6.PimV6Interface, pimIntf)
+	} else if len(v6JPs.active) > 0 || len(v6RPs.addrs) > 0 {
+		return usererr.New("specified IPv6 PIM join/prunes or candidate RPs without IPv6 configured on interface %q", ifc.GetName())
+	}
+	return nil
+}
+
+type pimJoinPruneCfg struct {
+	active                           []bool
+	rangeTypes, rps, groups, sources []string
+	groupCounts, sourceCounts        []uint32
+}
+
+// pimJoinPrunes validates the join/prunes and splits them by IP version.
+func pimJoinPrunes(jps []*opb.PimConfig_JoinPrune) (*pimJoinPruneCfg, *pimJoinPruneCfg, error) {
+	v4, v6 := &pimJoinPruneCfg{}, &pimJoinPruneCfg{}
+	for _, jp := range jps {
+		group, isV6 := parseIP(jp.GetGroupAddress())
+		if group == nil || !group.IsMulticast() {
+			return nil, nil, usererr.New("invalid PIM join/prune group address %q", jp.GetGroupAddress())
+		}
+		if rp, rpV6 := parseIP(jp.GetRpAddress()); rp == nil || rpV6 != isV6 {
+			return nil, nil, usererr.New("invalid PIM join/prune RP address %q", jp.GetRpAddress())
+		}
+		cfg, source := v4, "0.0.0.0"
+		if isV6 {
+			cfg, source = v6, "::"
+		}
+		var rangeType string
+		var sourceCount uint32
+		switch jp.GetRangeType() {
+		case opb.PimConfig_JoinPrune_RANGE_TYPE_UNSPECIFIED:
+			return nil, nil, usererr.New("PIM join/prune range type not specified")
+		case opb.PimConfig_JoinPrune_STAR_G:
+			if jp.GetSourceAddress() != "" {
+				return nil, nil, usererr.New("source address specified for (*,G) PIM join/prune of group %q", jp.GetGroupAddress())
+			}
+			rangeType = "startogroup"
+		case opb.PimConfig_JoinPrune_S_G:
+			if src, srcV6 := parseIP(jp.GetSourceAddress()); src == nil || srcV6 != isV6 {
+				return nil, nil, usererr.New("invalid PIM join/prune source address %q", jp.GetSourceAddress())
+			}
+			rangeType = "sourcetogroup"
+			source = jp.GetSourceAddress()
+			sourceCount = 1
+		default:
+			return nil, nil, fmt.Errorf("unrecognized PIM join/prune range type %s", jp.GetRangeType())
+		}
+		cfg.active = append(cfg.active, !jp.GetPruned())
+		cfg.rangeTypes = append(cfg.rangeTypes, rangeType)
+		cfg.rps = append(cfg.rps, jp.GetRpAddress())
+		cfg.groups = append(cfg.groups, jp.GetGroupAddress())
+		cfg.groupCounts = append(cfg.groupCounts, uint32(max(int(jp.GetGroupCount()), 1)))
+		cfg.sources = append(cfg.sources, source)
+		cfg.sourceCounts = append(cfg.sourceCounts, sourceCount)
+	}
+	return v4, v6, nil
+}
+
+type pimCandidateRPCfg struct {
+	addrs, groups        []string
+	maskLens, priorities []uint32
+}
+
+// pimCandidateRPs validates the candidate RPs and splits them by IP version.
+func pimCandidateRPs(rps []*opb.PimConfig_CandidateRp) (*pimCandidateRPCfg, *pimCandidateRPCfg, error) {
+	v4, v6 := &pimCandidateRPCfg{}, &pimCandidateRPCfg{}
+	for _, rp := range rps {
+		addr, isV6 := parseIP(rp.GetAddress())
+		if addr == nil {
+			return nil, nil, usererr.New("invalid PIM candidate RP address %q", rp.GetAddress())
+		}
+		group, maskLen, groupV6, err := parseCIDR(rp.GetGroupPrefix())
+		if err != nil {
+			return nil, nil, err
+		}
+		if ip := net.ParseIP(group); groupV6 != isV6 || !ip.IsMulticast() {
+			return nil, nil, usererr.New("invalid PIM candidate RP group prefix %q", rp.GetGroupPrefix())
+		}
+		cfg := v4
+		if isV6 {
+			cfg = v6
+		}
+		cfg.addrs = append(cfg.addrs, rp.GetAddress())
+		cfg.groups = append(cfg.groups, group)
+		cfg.maskLens = append(cfg.maskLens, maskLen)
+		cfg.priorities = append(cfg.priorities, rp.GetPriority())
+	}
+	return v4, v6, nil
+}
+
+// addLDPProtocols adds an IxNetwork LDP router and LDP interface, assuming the
+// IP protocols for the given interface have already been added.
+// Returns an error if the LDP configuration does not validate.
+func (ix *ixATE) addLDPProtocols(ifc *opb.InterfaceConfig) error {
+	ldp := ifc.GetLdp()
+	if ldp == nil {
+		return nil
+	}
+	intf := ix.intfs[ifc.GetName()]
+	if intf.ipv4 == nil {
+		return usererr.New("need a static IPv4 address for LDP on interface %q", ifc.GetName())
+	}
+	var bfd *ixconfig.Multivalue
+	if ldp.GetEnableBfd() {
+		bfd = ixconfig.MultivalueTrue()
+	}
+	intf.ipv4.LdpConnectedInterface = append(intf.ipv4.LdpConnectedInterface, &ixconfig.TopologyLdpConnectedInterface{
+		Name:                  ixconfig.String(fmt.Sprintf("LDP Interface on %s", ifc.GetName())),
+		LabelSpaceID:          ixconfig.MultivalueUint32(ldp.GetLabelSpaceId()),
+		BasicHelloInterval:    ixconfig.MultivalueUint32(ldp.GetHelloIntervalSec()),
+		BasicHoldTime:         ixconfig.MultivalueUint32(ldp.GetHelloHoldTimeSec()),
+		EnableBfdRegistration: bfd,
+	})
+
+	dg := intf.deviceGroup
+	if id := ldp.GetLsrId(); id != "" {
+		if ip, isV6 := parseIP(id); ip == nil || isV6 {
+			return usererr.New("invalid LDP LSR ID %q", id)
+		}
+		dg.RouterData = []*ixconfig.TopologyRouterData{{
+			RouterId: ixconfig.MultivalueStr(id),
+		}}
+	}
+	dg.LdpBasicRouter = append(dg.LdpBasicRouter, &ixconfig.TopologyLdpBasicRouter{
+		Name:              ixconfig.String(fmt.Sprintf("LDP Router on %s", ifc.GetName())),
+		KeepAliveInterval: ixconfig.MultivalueUint32(ldp.GetKeepaliveIntervalSec()),
+		KeepAliveHoldTime: ixconfig.MultivalueUint32(ldp.GetKeepaliveHoldTimeSec()),
+	})
+	return nil
+}
+
 // addISISProtocols adds IxNetwork IS-IS protocols, assuming the device group for the given interface already exists.
 // Each interface config must apply to the same set of ports. Returns an error if the
 // interface configs are not validated as a set.
@@ -270,7 +974,7 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 		return nil
 	}
 
-	var level, networkType, authType string
+	var level, networkType string
 	var enable3WayHandshake bool
 	switch isis.GetLevel() {
 	case opb.ISISConfig_LEVEL_UNSPECIFIED:
@@ -279,6 +983,8 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 		level = "level1"
 	case opb.ISISConfig_L2:
 		level = "level2"
+	case opb.ISISConfig_L1L2:
+		level = "l1l2"
 	default:
 		return fmt.Errorf("unrecognized level %s", isis.GetLevel())
 	}
@@ -295,15 +1001,17 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 		return fmt.Errorf("unrecognized network type %s", isis.GetNetworkType())
 	}
 
-	switch isis.GetAuthType() {
-	case opb.ISISConfig_AUTH_TYPE_UNSPECIFIED:
-		authType = "none"
-	case opb.ISISConfig_MD5:
-		authType = "md5"
-	case opb.ISISConfig_PASSWORD:
-		authType = "password"
-	default:
-		return fmt.Errorf("unrecognized auth type %s", isis.GetAuthType())
+	authType, err := isisAuthType(isis.GetAuthType())
+	if err != nil {
+		return err
+	}
+	areaAuthType, err := isisAuthType(isis.GetAreaAuthType())
+	if err != nil {
+		return err
+	}
+	domainAuthType, err := isisAuthType(isis.GetDomainAuthType())
+	if err != nil {
+		return err
 	}
 
 	areaID, err := areaIDToIxHex(isis.GetAreaId())
@@ -318,9 +1026,46 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 		AuthType:                       ixconfig.MultivalueStr(authType),
 		CircuitTranmitPasswordOrMD5Key: ixconfig.MultivalueStr(isis.GetAuthKey()),
 		InterfaceMetric:                ixconfig.MultivalueUint32(isis.GetMetric()),
-		Level2Priority:                 ixconfig.MultivalueUint32(isis.GetInterfacePriority()),
-		Level2HelloInterval:            ixconfig.MultivalueUint32(isis.GetHelloIntervalSec()),
-		Level2DeadInterval:             ixconfig.MultivalueUint32(isis.GetDeadIntervalSec()),
+	}
+	if isis.GetLevel() != opb.ISISConfig_L2 {
+		isisIntf.Level1Priority = ixconfig.MultivalueUint32(isis.GetInterfacePriority())
+		isisIntf.Level1HelloInterval = ixconfig.MultivalueUint32(isis.GetHelloIntervalSec())
+		isisIntf.Level1DeadInterval = ixconfig.MultivalueUint32(isis.GetDeadIntervalSec())
+	}
+	if isis.GetLevel() != opb.ISISConfig_L1 {
+		isisIntf.Level2Priority = ixconfig.MultivalueUint32(isis.GetInterfacePriority())
+		isisIntf.Level2HelloInterval = ixconfig.MultivalueUint32(isis.GetHelloIntervalSec())
+		isisIntf.Level2DeadInterval = ixconfig.MultivalueUint32(isis.GetDeadIntervalSec())
+	}
+	if isis.GetEnableBfd() {
+		isisIntf.EnableBfdRegistration = ixconfig.MultivalueTrue()
+	}
+	if te := isis.GetTeAttributes(); te != nil {
+		v, err := isisTE(te)
+		if err != nil {
+			return err
+		}
+		ixTE := &ixconfig.TopologyIsisTrafficEngineering{
+			MetricLevel:            ixconfig.MultivalueUint32(v.metric),
+			AdministratorGroup:     ixconfig.MultivalueStr(v.adminGroup),
+			MaxBandwidth:           ixconfig.MultivalueStr(v.maxBW),
+			MaxReservableBandwidth: ixconfig.MultivalueStr(v.maxResBW),
+		}
+		unresBWs := []**ixconfig.Multivalue{
+			&ixTE.BandwidthPriority0, &ixTE.BandwidthPriority1, &ixTE.BandwidthPriority2, &ixTE.BandwidthPriority3,
+			&ixTE.BandwidthPriority4, &ixTE.BandwidthPriority5, &ixTE.BandwidthPriority6, &ixTE.BandwidthPriority7,
+		}
+		for i, bw := range v.unresBW {
+			*unresBWs[i] = ixconfig.MultivalueStr(bw)
+		}
+		isisIntf.IsisTrafficEngineering = ixTE
+		if len(v.srlgs) > 0 {
+			isisIntf.EnableSRLG = ixconfig.MultivalueTrue()
+			isisIntf.SrlgCount = ixconfig.NumberInt(len(v.srlgs))
+			for _, srlg := range v.srlgs {
+				isisIntf.SrlgValueList = append(isisIntf.SrlgValueList, &ixconfig.TopologySrlgValueList{SrlgValue: ixconfig.MultivalueUint32(srlg)})
+			}
+		}
 	}
 	isisRtr := &ixconfig.TopologyIsisL3Router{
 		Name:               ixconfig.String(fmt.Sprintf("IS-IS Router on %s", ifc.GetName())),
@@ -331,6 +1076,15 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 		AreaAddresses:      ixconfig.MultivalueStr(areaID),
 		TERouterId:         ixconfig.MultivalueStr(isis.GetTeRouterId()),
 		RtrcapId:           ixconfig.MultivalueStr(isis.GetCapabilityRouterId()),
+		Overloaded:         ixconfig.MultivalueBool(isis.GetOverloaded()),
+	}
+	if areaAuthType != "none" {
+		isisRtr.AreaAuthenticationType = ixconfig.MultivalueStr(areaAuthType)
+		isisRtr.AreaTransmitPasswordOrMD5Key = ixconfig.MultivalueStr(isis.GetAreaAuthKey())
+	}
+	if domainAuthType != "none" {
+		isisRtr.DomainAuthenticationType = ixconfig.MultivalueStr(domainAuthType)
+		isisRtr.DomainTransmitPasswordOrMD5Key = ixconfig.MultivalueStr(isis.GetDomainAuthKey())
 	}
 
 	isisSegmentRouting(isisIntf, isisRtr, isis.GetSegmentRouting())
@@ -349,6 +1103,56 @@ func (ix *ixATE) addISISProtocols(ifc *opb.InterfaceConfig) error {
 	return nil
 }
 
+// isisPriorities is the number of priorities of IS-IS unreserved bandwidth.
+const isisPriorities = 8
+
+// isisTEValues are the IxNetwork values of the TE attributes of an IS-IS link.
+type isisTEValues struct {
+	metric          uint32
+	adminGroup      string
+	maxBW, maxResBW string
+	unresBW         [isisPriorities]string
+	srlgs           []uint32
+}
+
+// isisTE returns the IxNetwork values of the TE attributes, which may be nil.
+func isisTE(te *opb.ISISTrafficEngineering) (*isisTEValues, error) {
+	if n := len(te.GetUnreservedBandwidth()); n > isisPriorities {
+		return nil, usererr.New("%d unreserved bandwidths specified, at most %d are allowed", n, isisPriorities)
+	}
+	bwStr := func(bw float32) string {
+		return strconv.FormatFloat(float64(bw), 'f', -1, 32)
+	}
+	v := &isisTEValues{
+		metric:     te.GetMetric(),
+		adminGroup: fmt.Sprintf("%08x", te.GetAdminGroup()),
+		maxBW:      bwStr(te.GetMaxBandwidth()),
+		maxResBW:   bwStr(te.GetMaxReservableBandwidth()),
+		srlgs:      te.GetSrlgs(),
+	}
+	for i := range v.unresBW {
+		v.unresBW[i] = "0"
+	}
+	for i, bw := range te.GetUnreservedBandwidth() {
+		v.unresBW[i] = bwStr(bw)
+	}
+	return v, nil
+}
+
+// isisAuthType returns the IxNetwork IS-IS authentication type.
+func isisAuthType(authType opb.ISISConfig_AuthType) (string, error) {
+	switch authType {
+	case opb.ISISConfig_AUTH_TYPE_UNSPECIFIED:
+		return "none", nil
+	case opb.ISISConfig_MD5:
+		return "md5", nil
+	case opb.ISISConfig_PASSWORD:
+		return "password", nil
+	default:
+		return "", fmt.Errorf("unrecognized auth type %s", authType)
+	}
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -491,11 +1295,20 @@ func parseLink(toCIDR, fromCIDR string, asV6 bool) (string, string, uint32, erro
 func isisReachability(ifcName string, isrs []*opb.ISReachability) (map[string]*ixconfig.TopologyNetworkGroup, error) {
 	isisNetwGrps := make(map[string]*ixconfig.TopologyNetworkGroup)
 	for i, cfg := range isrs {
-		var maxLinks, maxAlgos, numSRGBRanges, numSRLBRanges int
+		var maxLinks, maxAlgos, maxSRLGs, numSRGBRanges, numSRLBRanges int
+		var hasTE bool
 		for j, node := range cfg.GetNodes() {
 			if numLinks := len(node.GetLinks()); numLinks > maxLinks {
 				maxLinks = numLinks
 			}
+			for _, link := range node.GetLinks() {
+				if te := link.GetTeAttributes(); te != nil {
+					hasTE = true
+					if numSRLGs := len(te.GetSrlgs()); numSRLGs > maxSRLGs {
+						maxSRLGs = numSRLGs
+					}
+				}
+			}
 			if sr := node.GetSegmentRouting(); sr != nil {
 				if numAlgos := len(sr.GetAlgorithms()); numAlgos > maxAlgos {
 					maxAlgos = numAlgos
@@ -547,6 +1360,16 @@ func isisReachability(ifcName string, isrs []*opb.ISReachability) (map[string]*i
 			IsisL3PseudoIfaceAttPoint1Config: []*ixconfig.TopologyIsisL3PseudoIfaceAttPoint1Config{attPoint1},
 			IsisL3PseudoIfaceAttPoint2Config: []*ixconfig.TopologyIsisL3PseudoIfaceAttPoint2Config{attPoint2},
 		}
+		unresBWs := []**ixconfig.Multivalue{
+			&isisIntf.BandwidthPriority0Bps, &isisIntf.BandwidthPriority1Bps, &isisIntf.BandwidthPriority2Bps, &isisIntf.BandwidthPriority3Bps,
+			&isisIntf.BandwidthPriority4Bps, &isisIntf.BandwidthPriority5Bps, &isisIntf.BandwidthPriority6Bps, &isisIntf.BandwidthPriority7Bps,
+		}
+		if maxSRLGs > 0 {
+			isisIntf.SrlgCount = ixconfig.NumberInt(maxSRLGs)
+			for j := 0; j < maxSRLGs; j++ {
+				isisIntf.SrlgValueList = append(isisIntf.SrlgValueList, &ixconfig.TopologySrlgValueList{})
+			}
+		}
 		for _, node := range cfg.GetNodes() {
 			sysID, err := sysIDToIxHex(node.GetSystemId())
 			if err != nil {
@@ -620,6 +1443,34 @@ func isisReachability(ifcName string, isrs []*opb.ISReachability) (map[string]*i
 				}
 				attPoint1.LinkMetric = appendUintToMultivalueList(attPoint1.LinkMetric, inMetric)
 				attPoint2.LinkMetric = appendUintToMultivalueList(attPoint2.LinkMetric, egMetric)
+
+				if hasTE {
+					var te *opb.ISISTrafficEngineering
+					if k < len(links) {
+						te = links[k].GetTeAttributes()
+					}
+					v, err := isisTE(te)
+					if err != nil {
+						return nil, err
+					}
+					isisIntf.MetricLevel = appendUintToMultivalueList(isisIntf.MetricLevel, v.metric)
+					isisIntf.AdministratorGroup = appendStrToMultivalueList(isisIntf.AdministratorGroup, v.adminGroup)
+					isisIntf.MaxBandwidthBps = appendStrToMultivalueList(isisIntf.MaxBandwidthBps, v.maxBW)
+					isisIntf.MaxReservableBandwidthBps = appendStrToMultivalueList(isisIntf.MaxReservableBandwidthBps, v.maxResBW)
+					for i, bw := range v.unresBW {
+						*unresBWs[i] = appendStrToMultivalueList(*unresBWs[i], bw)
+					}
+					if maxSRLGs > 0 {
+						isisIntf.EnableSRLG = appendBoolToMultivalueList(isisIntf.EnableSRLG, len(v.srlgs) > 0)
+						for j, srlgs := range isisIntf.SrlgValueList {
+							var srlg uint32
+							if j < len(v.srlgs) {
+								srlg = v.srlgs[j]
+							}
+							srlgs.SrlgValue = appendUintToMultivalueList(srlgs.SrlgValue, srlg)
+						}
+					}
+				}
 			}
 
 			// Route export
@@ -1434,6 +2285,19 @@ func bgpV4Peers(v4Peers []*opb.BgpPeer) ([]*ixconfig.TopologyBgpIpv4Peer, error)
 			peer.Md5Key = ixconfig.MultivalueStr(p.GetMd5Key())
 		}
 
+		if p.GetEnableBfd() {
+			peer.EnableBfdRegistration = ixconfig.MultivalueTrue()
+		}
+
+		if mode := p.GetAddPathMode(); mode != opb.BgpPeer_ADD_PATH_MODE_UNSPECIFIED {
+			modeStr, ok := addPathModeToStr[mode]
+			if !ok {
+				return nil, usererr.New("invalid BGP add-path mode %s", mode)
+			}
+			peer.Ipv4UnicastAddPathMode = ixconfig.MultivalueStr(modeStr)
+			peer.Ipv6UnicastAddPathMode = ixconfig.MultivalueStr(modeStr)
+		}
+
 		policyList, numPolicies, err := srtePolicyListV4(p.GetSrtePolicyGroups())
 		if err != nil {
 			return nil, err
@@ -1499,6 +2363,19 @@ func bgpV6Peers(v6Peers []*opb.BgpPeer) ([]*ixconfig.TopologyBgpIpv6Peer, error)
 			peer.Md5Key = ixconfig.MultivalueStr(p.GetMd5Key())
 		}
 
+		if p.GetEnableBfd() {
+			peer.EnableBfdRegistration = ixconfig.MultivalueTrue()
+		}
+
+		if mode := p.GetAddPathMode(); mode != opb.BgpPeer_ADD_PATH_MODE_UNSPECIFIED {
+			modeStr, ok := addPathModeToStr[mode]
+			if !ok {
+				return nil, usererr.New("invalid BGP add-path mode %s", mode)
+			}
+			peer.Ipv4UnicastAddPathMode = ixconfig.MultivalueStr(modeStr)
+			peer.Ipv6UnicastAddPathMode = ixconfig.MultivalueStr(modeStr)
+		}
+
 		policyList, numPolicies, err := srtePolicyListV6(p.GetSrtePolicyGroups())
 		if err != nil {
 			return nil, err
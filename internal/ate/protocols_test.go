@@ -331,124 +331,1208 @@ func TestAddIpProtocols(t *testing.T) {
 			Ipv6: &opb.IpConfig{},
 		},
 		wantErr: "need both address and default gateway defined for IP V6 layer",
+	}, {
+		desc: "IPv4 neighbor discovery",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: &opb.IpConfig{
+				AddressCidr:       "192.168.1.1/30",
+				DefaultGateway:    "192.168.1.2",
+				NeighborDiscovery: &opb.NeighborDiscoveryConfig{},
+			},
+		},
+		wantErr: "only supported for IP V6 layer",
 	}, {
 		desc: "Valid config",
 		ifcfg: &opb.InterfaceConfig{
 			Name: ifName,
-			Ipv4: &opb.IpConfig{
-				AddressCidr:    "192.168.1.1/30",
-				DefaultGateway: "192.168.1.2",
+			Ipv4: &opb.IpConfig{
+				AddressCidr:    "192.168.1.1/30",
+				DefaultGateway: "192.168.1.2",
+			},
+			Ipv6: &opb.IpConfig{
+				AddressCidr:    "2001::4860:193:168:1:1/126",
+				DefaultGateway: "2001::4860:193:168:1:2",
+			},
+		},
+		wantCfg: &ixconfig.TopologyEthernet{
+			Ipv4: []*ixconfig.TopologyIpv4{{
+				Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+				Address:        ixconfig.MultivalueStr("192.168.1.1"),
+				GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+				Prefix:         ixconfig.MultivalueUint32(30),
+				ResolveGateway: ixconfig.MultivalueTrue(),
+			}},
+			Ipv6: []*ixconfig.TopologyIpv6{{
+				Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
+				Address:        ixconfig.MultivalueStr("2001::4860:193:168:1:1"),
+				GatewayIp:      ixconfig.MultivalueStr("2001::4860:193:168:1:2"),
+				Prefix:         ixconfig.MultivalueUint32(126),
+				ResolveGateway: ixconfig.MultivalueTrue(),
+			}},
+		},
+		wantIpv4Name: fmt.Sprintf("IPv4 on %s", ifName),
+		wantIpv6Name: fmt.Sprintf("IPv6 on %s", ifName),
+	}, {
+		desc: "Valid config on Macsec",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: &opb.IpConfig{
+				AddressCidr:    "192.168.1.1/30",
+				DefaultGateway: "192.168.1.2",
+			},
+			Ipv6: &opb.IpConfig{
+				AddressCidr:    "2001::4860:193:168:1:1/126",
+				DefaultGateway: "2001::4860:193:168:1:2",
+			},
+		},
+		hasMacSec: true,
+		wantCfg: &ixconfig.TopologyEthernet{
+			Macsec: []*ixconfig.TopologyMacsec{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					Prefix:         ixconfig.MultivalueUint32(30),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+				}},
+				Ipv6: []*ixconfig.TopologyIpv6{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("2001::4860:193:168:1:1"),
+					GatewayIp:      ixconfig.MultivalueStr("2001::4860:193:168:1:2"),
+					Prefix:         ixconfig.MultivalueUint32(126),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+				}},
+			}},
+		},
+		wantIpv4Name: fmt.Sprintf("IPv4 on %s", ifName),
+		wantIpv6Name: fmt.Sprintf("IPv6 on %s", ifName),
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo(ifName, test.hasMacSec)
+			gotErr := c.addIPProtocols(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addIPProtocols got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+
+			ifc := c.intfs[ifName]
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup.Ethernet[0]); diff != "" {
+				t.Fatalf("addIPProtocols unexpected config diff (-want/+got): %s", diff)
+			}
+
+			var gotIpv4Name, gotIpv6Name string
+			hasIpv4 := ifc.ipv4 != nil
+			if hasIpv4 {
+				gotIpv4Name = *(ifc.ipv4.Name)
+			}
+			hasIpv6 := ifc.ipv6 != nil
+			if hasIpv6 {
+				gotIpv6Name = *(ifc.ipv6.Name)
+			}
+
+			wantIpv4 := test.wantIpv4Name != ""
+			if hasIpv4 != wantIpv4 {
+				t.Errorf("addIPProtocols: unexpected IPv4 config, was configured: %t, wanted configured? %t", hasIpv4, wantIpv4)
+			}
+			wantIpv6 := test.wantIpv6Name != ""
+			if hasIpv6 != wantIpv6 {
+				t.Errorf("addIPProtocols: unexpected IPv6 config, was configured: %t, wanted configured? %t", hasIpv6, wantIpv6)
+			}
+
+			if test.wantIpv4Name != gotIpv4Name {
+				t.Errorf("addIPProtocols: did not find expected IPv4 config: got name %q, wanted name %q", gotIpv4Name, test.wantIpv4Name)
+			}
+			if test.wantIpv6Name != gotIpv6Name {
+				t.Errorf("addIPProtocols: did not find expected IPv6 config: got name %q, wanted name %q", gotIpv6Name, test.wantIpv6Name)
+			}
+		})
+	}
+}
+
+func TestAddNeighborDiscovery(t *testing.T) {
+	tests := []struct {
+		desc                         string
+		nd                           *opb.NeighborDiscoveryConfig
+		wantIPv6                     *ixconfig.TopologyIpv6
+		wantNSCount, wantNSRetransMs uint32
+	}{{
+		desc: "router advertisements",
+		nd: &opb.NeighborDiscoveryConfig{
+			SendRouterAdvertisements: true,
+			IncludeRaPrefix:          true,
+		},
+		wantIPv6: &ixconfig.TopologyIpv6{
+			ResolveGateway:  ixconfig.MultivalueTrue(),
+			SendRa:          ixconfig.MultivalueTrue(),
+			IncludeRaPrefix: ixconfig.MultivalueTrue(),
+		},
+		wantNSCount:     defaultNSCount,
+		wantNSRetransMs: defaultNSRetransmitMs,
+	}, {
+		desc: "no gateway resolution",
+		nd:   &opb.NeighborDiscoveryConfig{DisableGatewayResolution: true},
+		wantIPv6: &ixconfig.TopologyIpv6{
+			ResolveGateway:  ixconfig.MultivalueFalse(),
+			SendRa:          ixconfig.MultivalueFalse(),
+			IncludeRaPrefix: ixconfig.MultivalueFalse(),
+		},
+		wantNSCount:     defaultNSCount,
+		wantNSRetransMs: defaultNSRetransmitMs,
+	}, {
+		desc: "neighbor solicitation count",
+		nd:   &opb.NeighborDiscoveryConfig{NsCount: 3},
+		wantIPv6: &ixconfig.TopologyIpv6{
+			ResolveGateway:  ixconfig.MultivalueTrue(),
+			SendRa:          ixconfig.MultivalueFalse(),
+			IncludeRaPrefix: ixconfig.MultivalueFalse(),
+		},
+		wantNSCount:     3,
+		wantNSRetransMs: defaultNSRetransmitMs,
+	}, {
+		desc: "neighbor solicitation count and interval",
+		nd:   &opb.NeighborDiscoveryConfig{NsCount: 3, NsRetransmitMs: 1000},
+		wantIPv6: &ixconfig.TopologyIpv6{
+			ResolveGateway:  ixconfig.MultivalueTrue(),
+			SendRa:          ixconfig.MultivalueFalse(),
+			IncludeRaPrefix: ixconfig.MultivalueFalse(),
+		},
+		wantNSCount:     3,
+		wantNSRetransMs: 1000,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			vport := &ixconfig.Vport{}
+			setNSOptions(vport, defaultNSCount, defaultNSRetransmitMs)
+			ix := &ixATE{}
+			i := &intf{
+				ipv6: &ixconfig.TopologyIpv6{ResolveGateway: ixconfig.MultivalueTrue()},
+				link: vport,
+			}
+			ix.addNeighborDiscovery(i, test.nd)
+			if diff := jsonCfgDiff(t, test.wantIPv6, i.ipv6); diff != "" {
+				t.Errorf("addNeighborDiscovery unexpected IPv6 config diff (-want/+got): %s", diff)
+			}
+			wantOpts := &ixconfig.VportProtocolStackOptions{
+				McastSolicit: ixconfig.NumberUint32(test.wantNSCount),
+				RetransTime:  ixconfig.NumberUint32(test.wantNSRetransMs),
+			}
+			if diff := jsonCfgDiff(t, wantOpts, vport.ProtocolStack.Options); diff != "" {
+				t.Errorf("addNeighborDiscovery unexpected port options diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddIPLoopbackProtocols(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc: "Invalid IPv4 address.",
+		ifcfg: &opb.InterfaceConfig{
+			Name:             ifName,
+			Ipv4LoopbackCidr: "aa:bb:cc::",
+		},
+		wantErr: "invalid IPv4 CIDR",
+	}, {
+		desc: "Invalid IPv6 address.",
+		ifcfg: &opb.InterfaceConfig{
+			Name:             ifName,
+			Ipv6LoopbackCidr: "1.2.3.4",
+		},
+		wantErr: "invalid IPv6 CIDR",
+	}, {
+		desc: "Valid IPv4 address.",
+		ifcfg: &opb.InterfaceConfig{
+			Name:             ifName,
+			Ipv4LoopbackCidr: "1.2.3.4/30",
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ipv4Loopback: []*ixconfig.TopologyIpv4Loopback{{
+				Name:    ixconfig.String(fmt.Sprintf("IPv4 loopback on %s", ifName)),
+				Address: ixconfig.MultivalueStr("1.2.3.4"),
+				Prefix:  ixconfig.MultivalueUint32(30),
+			}},
+		},
+	}, {
+		desc: "Valid IPv6 address.",
+		ifcfg: &opb.InterfaceConfig{
+			Name:             ifName,
+			Ipv6LoopbackCidr: "cafe:beef::1/128",
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ipv6Loopback: []*ixconfig.TopologyIpv6Loopback{{
+				Name:    ixconfig.String(fmt.Sprintf("IPv6 loopback on %s", ifName)),
+				Address: ixconfig.MultivalueStr("cafe:beef::1"),
+				Prefix:  ixconfig.MultivalueUint32(128),
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			gotErr := c.addIPLoopbackProtocols(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addIPLoopbackProtocols got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+
+			ifc := c.intfs[ifName]
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
+				t.Fatalf("addIPLoopbackProtocols unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddDot1xProtocol(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+
+	tests := []struct {
+		desc    string
+		dot1x   *opb.Dot1XConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc:    "unspecified EAP method",
+		dot1x:   &opb.Dot1XConfig{Username: "user"},
+		wantErr: "unsupported 802.1X EAP method",
+	}, {
+		desc:    "no username",
+		dot1x:   &opb.Dot1XConfig{EapMethod: opb.Dot1XConfig_MD5},
+		wantErr: "no 802.1X username",
+	}, {
+		desc: "PEAPv0 supplicant",
+		dot1x: &opb.Dot1XConfig{
+			EapMethod:              opb.Dot1XConfig_PEAP_V0,
+			Username:               "user",
+			Password:               "pass",
+			WaitForIdentityRequest: true,
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				DotOneX: []*ixconfig.TopologyDotOneX{{
+					Name:       ixconfig.String(fmt.Sprintf("802.1X supplicant on %s", ifName)),
+					Multiplier: ixconfig.NumberUint32(1),
+					Protocol:   ixconfig.MultivalueStr("peapv0"),
+					UserName:   ixconfig.MultivalueStr("user"),
+					UserPwd:    ixconfig.MultivalueStr("pass"),
+					WaitId:     ixconfig.MultivalueTrue(),
+				}},
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			gotErr := c.addDot1xProtocol(&opb.InterfaceConfig{Name: ifName, Dot1X: test.dot1x})
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addDot1xProtocol got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if diff := jsonCfgDiff(t, test.wantCfg, c.intfs[ifName].deviceGroup); diff != "" {
+				t.Fatalf("addDot1xProtocol unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddDHCPProtocols(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
+	ipv6 := &opb.IpConfig{AddressCidr: "aa::1/64", DefaultGateway: "aa::2"}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc: "DHCPv4 client with static IPv4",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Dhcp: &opb.DhcpConfig{V4Client: &opb.DhcpConfig_Client{}},
+		},
+		wantErr: "both a static IPv4 address and a DHCPv4 client",
+	}, {
+		desc: "mismatched client counts",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Dhcp: &opb.DhcpConfig{
+				V4Client: &opb.DhcpConfig_Client{Count: 10},
+				V6Client: &opb.DhcpConfig_Client{Count: 20},
+			},
+		},
+		wantErr: "differ",
+	}, {
+		desc: "multiple clients with static IPv6",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv6: ipv6,
+			Dhcp: &opb.DhcpConfig{V4Client: &opb.DhcpConfig_Client{Count: 10}},
+		},
+		wantErr: "multiple DHCP clients",
+	}, {
+		desc: "DHCPv4 server without static IPv4",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Dhcp: &opb.DhcpConfig{V4Server: &opb.DhcpConfig_Server{PoolAddress: "192.168.1.10", PoolSize: 10}},
+		},
+		wantErr: "need a static IPv4 address",
+	}, {
+		desc: "DHCPv4 server with IPv6 pool",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Dhcp: &opb.DhcpConfig{V4Server: &opb.DhcpConfig_Server{PoolAddress: "aa::10", PoolSize: 10}},
+		},
+		wantErr: "invalid DHCP server pool address",
+	}, {
+		desc: "DHCPv6 server with empty pool",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv6: ipv6,
+			Dhcp: &opb.DhcpConfig{V6Server: &opb.DhcpConfig_Server{PoolAddress: "aa::10"}},
+		},
+		wantErr: "pool size",
+	}, {
+		desc: "DHCP clients",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Dhcp: &opb.DhcpConfig{
+				V4Client: &opb.DhcpConfig_Client{Count: 10, RapidCommit: true},
+				V6Client: &opb.DhcpConfig_Client{Count: 10},
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Multiplier: ixconfig.NumberUint32(10),
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Dhcpv4client: []*ixconfig.TopologyDhcpv4client{{
+					Name:           ixconfig.String(fmt.Sprintf("DHCPv4 client on %s", ifName)),
+					Multiplier:     ixconfig.NumberUint32(1),
+					UseRapidCommit: ixconfig.MultivalueTrue(),
+				}},
+				Dhcpv6client: []*ixconfig.TopologyDhcpv6client{{
+					Name:           ixconfig.String(fmt.Sprintf("DHCPv6 client on %s", ifName)),
+					Multiplier:     ixconfig.NumberUint32(1),
+					Dhcp6IaType:    ixconfig.MultivalueStr("iana"),
+					UseRapidCommit: ixconfig.MultivalueFalse(),
+				}},
+			}},
+		},
+	}, {
+		desc: "DHCP servers",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Ipv6: ipv6,
+			Dhcp: &opb.DhcpConfig{
+				V4Server: &opb.DhcpConfig_Server{PoolAddress: "192.168.1.10", PoolSize: 100, LeaseTimeSecs: 3600},
+				V6Server: &opb.DhcpConfig_Server{PoolAddress: "aa::10", PoolSize: 100, PrefixLength: 96},
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Dhcpv4server: []*ixconfig.TopologyDhcpv4server{{
+						Name:       ixconfig.String(fmt.Sprintf("DHCPv4 server on %s", ifName)),
+						Multiplier: ixconfig.NumberUint32(1),
+						PoolCount:  ixconfig.NumberUint32(1),
+						Dhcp4ServerSessions: &ixconfig.TopologyDhcp4ServerSessions{
+							IpAddress:        ixconfig.MultivalueStr("192.168.1.10"),
+							IpPrefix:         ixconfig.MultivalueUint32(24),
+							IpGateway:        ixconfig.MultivalueStr("192.168.1.1"),
+							PoolSize:         ixconfig.MultivalueUint32(100),
+							DefaultLeaseTime: ixconfig.MultivalueUint32(3600),
+						},
+					}},
+				}},
+				Ipv6: []*ixconfig.TopologyIpv6{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("aa::1"),
+					Prefix:         ixconfig.MultivalueUint32(64),
+					GatewayIp:      ixconfig.MultivalueStr("aa::2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Dhcpv6server: []*ixconfig.TopologyDhcpv6server{{
+						Name:       ixconfig.String(fmt.Sprintf("DHCPv6 server on %s", ifName)),
+						Multiplier: ixconfig.NumberUint32(1),
+						PoolCount:  ixconfig.NumberUint32(1),
+						Dhcp6ServerSessions: &ixconfig.TopologyDhcp6ServerSessions{
+							IaType:    ixconfig.MultivalueStr("iana"),
+							IpAddress: ixconfig.MultivalueStr("aa::10"),
+							IpPrefix:  ixconfig.MultivalueUint32(96),
+							PoolSize:  ixconfig.MultivalueUint32(100),
+						},
+					}},
+				}},
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addDHCPProtocols(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addDHCPProtocols got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+
+			ifc := c.intfs[ifName]
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
+				t.Fatalf("addDHCPProtocols unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddPTPProtocol(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+			Vport: []*ixconfig.Vport{{}},
+			Lag:   []*ixconfig.Lag{{}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0], link: cfg.Vport[0]},
+			},
+		}
+	}
+	ptpClock := &ixconfig.TopologyPtp{
+		Name:                ixconfig.String(fmt.Sprintf("PTP on %s", ifName)),
+		Multiplier:          ixconfig.NumberUint32(1),
+		Profile:             ixconfig.MultivalueStr("ieee1588"),
+		Role:                ixconfig.MultivalueStr("slave"),
+		DelayMechanism:      ixconfig.MultivalueStr("peerDelay"),
+		Domain:              ixconfig.MultivalueUint32(24),
+		Priority1:           ixconfig.MultivalueUint32(128),
+		Priority2:           ixconfig.MultivalueUint32(255),
+		LogSyncInterval:     ixconfig.MultivalueStr("-3"),
+		LogAnnounceInterval: ixconfig.MultivalueStr("1"),
+		LogDelayReqInterval: ixconfig.MultivalueStr("0"),
+	}
+	ptp := func(transport opb.PtpConfig_Transport) *opb.PtpConfig {
+		return &opb.PtpConfig{
+			Role:                opb.PtpConfig_SLAVE,
+			Transport:           transport,
+			DelayMechanism:      opb.PtpConfig_PEER_TO_PEER,
+			Domain:              24,
+			Priority1:           128,
+			Priority2:           255,
+			LogSyncInterval:     -3,
+			LogAnnounceInterval: 1,
+		}
+	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		onLAG   bool
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc:    "no role",
+		ifcfg:   &opb.InterfaceConfig{Name: ifName, Ptp: &opb.PtpConfig{}},
+		wantErr: "unrecognized PTP role",
+	}, {
+		desc:    "on LAG",
+		ifcfg:   &opb.InterfaceConfig{Name: ifName, Ptp: ptp(opb.PtpConfig_ETHERNET)},
+		onLAG:   true,
+		wantErr: "on a LAG",
+	}, {
+		desc:    "IPv6 without address",
+		ifcfg:   &opb.InterfaceConfig{Name: ifName, Ipv4: ipv4, Ptp: ptp(opb.PtpConfig_IPV6)},
+		wantErr: "need a static IPv6 address",
+	}, {
+		desc:  "over Ethernet",
+		ifcfg: &opb.InterfaceConfig{Name: ifName, Ptp: ptp(opb.PtpConfig_ETHERNET)},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ptp: []*ixconfig.TopologyPtp{ptpClock},
+			}},
+		},
+	}, {
+		desc:  "over IPv4",
+		ifcfg: &opb.InterfaceConfig{Name: ifName, Ipv4: ipv4, Ptp: ptp(opb.PtpConfig_IPV4)},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Ptp:            []*ixconfig.TopologyPtp{ptpClock},
+				}},
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			if test.onLAG {
+				c.intfs[ifName].link = c.cfg.Lag[0]
+			}
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addPTPProtocol(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addPTPProtocol got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if diff := jsonCfgDiff(t, test.wantCfg, c.intfs[ifName].deviceGroup); diff != "" {
+				t.Fatalf("addPTPProtocol unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddBFDProtocols(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
+	ipv6 := &opb.IpConfig{AddressCidr: "aa::1/64", DefaultGateway: "aa::2"}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc: "no static IP",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Bfd:  &opb.BfdConfig{},
+		},
+		wantErr: "need a static IP address",
+	}, {
+		desc: "multi-hop without remote address",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Bfd:  &opb.BfdConfig{MultiHop: true},
+		},
+		wantErr: "need a remote address",
+	}, {
+		desc: "IPv6 remote address for BFDv4",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Bfd:  &opb.BfdConfig{Ipv4RemoteAddress: "bb::1"},
+		},
+		wantErr: "invalid BFD remote address",
+	}, {
+		desc: "BFDv6 remote address without IPv6",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Bfd:  &opb.BfdConfig{Ipv6RemoteAddress: "bb::1"},
+		},
+		wantErr: "without IPv6",
+	}, {
+		desc: "single-hop sessions",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Ipv6: ipv6,
+			Bfd: &opb.BfdConfig{
+				TxIntervalMs: 300,
+				RxIntervalMs: 300,
+				Multiplier:   3,
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Bfdv4Interface: []*ixconfig.TopologyBfdv4Interface{{
+						Name:              ixconfig.String(fmt.Sprintf("BFDv4 on %s", ifName)),
+						Active:            ixconfig.MultivalueTrue(),
+						TxInterval:        ixconfig.MultivalueUint32(300),
+						MinRxInterval:     ixconfig.MultivalueUint32(300),
+						TimeoutMultiplier: ixconfig.MultivalueUint32(3),
+						Bfdv4Session: &ixconfig.TopologyBfdv4Session{
+							RemoteIp4:   ixconfig.MultivalueStr("192.168.1.2"),
+							SessionType: ixconfig.MultivalueStr("singlehop"),
+						},
+					}},
+				}},
+				Ipv6: []*ixconfig.TopologyIpv6{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("aa::1"),
+					Prefix:         ixconfig.MultivalueUint32(64),
+					GatewayIp:      ixconfig.MultivalueStr("aa::2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Bfdv6Interface: []*ixconfig.TopologyBfdv6Interface{{
+						Name:              ixconfig.String(fmt.Sprintf("BFDv6 on %s", ifName)),
+						Active:            ixconfig.MultivalueTrue(),
+						TxInterval:        ixconfig.MultivalueUint32(300),
+						MinRxInterval:     ixconfig.MultivalueUint32(300),
+						TimeoutMultiplier: ixconfig.MultivalueUint32(3),
+						Bfdv6Session: &ixconfig.TopologyBfdv6Session{
+							RemoteIp6:   ixconfig.MultivalueStr("aa::2"),
+							SessionType: ixconfig.MultivalueStr("singlehop"),
+						},
+					}},
+				}},
+			}},
+		},
+	}, {
+		desc: "multi-hop session admin down",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Bfd: &opb.BfdConfig{
+				TxIntervalMs:      1000,
+				RxIntervalMs:      1000,
+				Multiplier:        3,
+				AdminDown:         true,
+				MultiHop:          true,
+				Ipv4RemoteAddress: "10.0.0.1",
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					Bfdv4Interface: []*ixconfig.TopologyBfdv4Interface{{
+						Name:              ixconfig.String(fmt.Sprintf("BFDv4 on %s", ifName)),
+						Active:            ixconfig.MultivalueFalse(),
+						TxInterval:        ixconfig.MultivalueUint32(1000),
+						MinRxInterval:     ixconfig.MultivalueUint32(1000),
+						TimeoutMultiplier: ixconfig.MultivalueUint32(3),
+						Bfdv4Session: &ixconfig.TopologyBfdv4Session{
+							RemoteIp4:   ixconfig.MultivalueStr("10.0.0.1"),
+							SessionType: ixconfig.MultivalueStr("multiplehop"),
+						},
+					}},
+				}},
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addBFDProtocols(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addBFDProtocols got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+
+			ifc := c.intfs[ifName]
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
+				t.Fatalf("addBFDProtocols unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMulticastHosts(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
+	ipv6 := &opb.IpConfig{AddressCidr: "aa::1/64", DefaultGateway: "aa::2"}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc: "IGMP host without static IPv4",
+		ifcfg: &opb.InterfaceConfig{
+			Name:     ifName,
+			IgmpHost: &opb.IgmpHostConfig{Version: opb.IgmpHostConfig_V2},
+		},
+		wantErr: "need a static IPv4 address",
+	}, {
+		desc: "IGMP version unspecified",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			IgmpHost: &opb.IgmpHostConfig{
+				Groups: []*opb.MulticastGroup{{Address: "239.1.1.1"}},
+			},
+		},
+		wantErr: "version not specified",
+	}, {
+		desc: "no groups",
+		ifcfg: &opb.InterfaceConfig{
+			Name:     ifName,
+			Ipv4:     ipv4,
+			IgmpHost: &opb.IgmpHostConfig{Version: opb.IgmpHostConfig_V2},
+		},
+		wantErr: "at least one multicast group",
+	}, {
+		desc: "unicast group address",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			IgmpHost: &opb.IgmpHostConfig{
+				Version: opb.IgmpHostConfig_V2,
+				Groups:  []*opb.MulticastGroup{{Address: "10.1.1.1"}},
+			},
+		},
+		wantErr: "invalid multicast group address",
+	}, {
+		desc: "source-specific group with IGMPv2",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			IgmpHost: &opb.IgmpHostConfig{
+				Version: opb.IgmpHostConfig_V2,
+				Groups:  []*opb.MulticastGroup{{Address: "232.1.1.1", SourceAddress: "10.1.1.1"}},
+			},
+		},
+		wantErr: "requires IGMPv3",
+	}, {
+		desc: "IPv4 group for MLD host",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv6: ipv6,
+			MldHost: &opb.MldHostConfig{
+				Version: opb.MldHostConfig_V1,
+				Groups:  []*opb.MulticastGroup{{Address: "239.1.1.1"}},
+			},
+		},
+		wantErr: "invalid multicast group address",
+	}, {
+		desc: "IGMP and MLD hosts",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Ipv6: ipv6,
+			IgmpHost: &opb.IgmpHostConfig{
+				Version: opb.IgmpHostConfig_V3,
+				Groups: []*opb.MulticastGroup{
+					{Address: "239.1.1.1", Count: 10},
+					{Address: "232.1.1.1", SourceAddress: "10.1.1.1", Left: true},
+				},
+			},
+			MldHost: &opb.MldHostConfig{
+				Version: opb.MldHostConfig_V1,
+				Groups:  []*opb.MulticastGroup{{Address: "ff0e::1"}},
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					IgmpHost: []*ixconfig.TopologyIgmpHost{{
+						Name:          ixconfig.String(fmt.Sprintf("IGMP host on %s", ifName)),
+						VersionType:   ixconfig.MultivalueStr("version3"),
+						NoOfGrpRanges: ixconfig.NumberInt(2),
+						IgmpMcastIPv4GroupList: &ixconfig.TopologyIgmpMcastIPv4GroupList{
+							Active:         ixconfig.MultivalueBoolList(true, false),
+							StartMcastAddr: ixconfig.MultivalueStrList("239.1.1.1", "232.1.1.1"),
+							McastAddrCnt:   ixconfig.MultivalueUintList(10, 1),
+							McastAddrIncr:  ixconfig.MultivalueStr("0.0.0.1"),
+							SourceMode:     ixconfig.MultivalueStrList("exclude", "include"),
+							NoOfSrcRanges:  ixconfig.NumberUint32(1),
+							IgmpUcastIPv4SourceList: &ixconfig.TopologyIgmpUcastIPv4SourceList{
+								StartUcastAddr:  ixconfig.MultivalueStrList("0.0.0.0", "10.1.1.1"),
+								UcastSrcAddrCnt: ixconfig.MultivalueUint32(1),
+							},
+						},
+					}},
+				}},
+				Ipv6: []*ixconfig.TopologyIpv6{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("aa::1"),
+					Prefix:         ixconfig.MultivalueUint32(64),
+					GatewayIp:      ixconfig.MultivalueStr("aa::2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					MldHost: []*ixconfig.TopologyMldHost{{
+						Name:          ixconfig.String(fmt.Sprintf("MLD host on %s", ifName)),
+						VersionType:   ixconfig.MultivalueStr("version1"),
+						NoOfGrpRanges: ixconfig.NumberInt(1),
+						MldMcastIPv6GroupList: &ixconfig.TopologyMldMcastIPv6GroupList{
+							Active:         ixconfig.MultivalueBoolList(true),
+							StartMcastAddr: ixconfig.MultivalueStrList("ff0e::1"),
+							McastAddrCnt:   ixconfig.MultivalueUintList(1),
+							McastAddrIncr:  ixconfig.MultivalueStr("::1"),
+							SourceMode:     ixconfig.MultivalueStrList("exclude"),
+							NoOfSrcRanges:  ixconfig.NumberUint32(1),
+							MldUcastIPv6SourceList: &ixconfig.TopologyMldUcastIPv6SourceList{
+								StartUcastAddr:  ixconfig.MultivalueStrList("::"),
+								UcastSrcAddrCnt: ixconfig.MultivalueUint32(1),
+							},
+						},
+					}},
+				}},
+			}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopo()
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addMulticastHosts(test.ifcfg)
+			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("addMulticastHosts got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+
+			ifc := c.intfs[ifName]
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
+				t.Fatalf("addMulticastHosts unexpected config diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddPIMProtocols(t *testing.T) {
+	const ifName = "someIntf"
+
+	clientWithTopo := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
+			}},
+		}
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ifName: &intf{deviceGroup: cfg.Topology[0].DeviceGroup[0]},
+			},
+		}
+	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
+	ipv6 := &opb.IpConfig{AddressCidr: "aa::1/64", DefaultGateway: "aa::2"}
+
+	tests := []struct {
+		desc    string
+		ifcfg   *opb.InterfaceConfig
+		wantErr string
+		wantCfg *ixconfig.TopologyDeviceGroup
+	}{{
+		desc: "no static IP",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Pim:  &opb.PimConfig{},
+		},
+		wantErr: "need a static IP address",
+	}, {
+		desc: "range type unspecified",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				JoinPrunes: []*opb.PimConfig_JoinPrune{{RpAddress: "10.0.0.1", GroupAddress: "239.1.1.1"}},
 			},
-			Ipv6: &opb.IpConfig{
-				AddressCidr:    "2001::4860:193:168:1:1/126",
-				DefaultGateway: "2001::4860:193:168:1:2",
+		},
+		wantErr: "range type not specified",
+	}, {
+		desc: "RP address of other IP version",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				JoinPrunes: []*opb.PimConfig_JoinPrune{{
+					RangeType:    opb.PimConfig_JoinPrune_STAR_G,
+					RpAddress:    "bb::1",
+					GroupAddress: "239.1.1.1",
+				}},
 			},
 		},
-		wantCfg: &ixconfig.TopologyEthernet{
-			Ipv4: []*ixconfig.TopologyIpv4{{
-				Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
-				Address:        ixconfig.MultivalueStr("192.168.1.1"),
-				GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
-				Prefix:         ixconfig.MultivalueUint32(30),
-				ResolveGateway: ixconfig.MultivalueTrue(),
-			}},
-			Ipv6: []*ixconfig.TopologyIpv6{{
-				Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
-				Address:        ixconfig.MultivalueStr("2001::4860:193:168:1:1"),
-				GatewayIp:      ixconfig.MultivalueStr("2001::4860:193:168:1:2"),
-				Prefix:         ixconfig.MultivalueUint32(126),
-				ResolveGateway: ixconfig.MultivalueTrue(),
-			}},
+		wantErr: "invalid PIM join/prune RP address",
+	}, {
+		desc: "(S,G) without source",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				JoinPrunes: []*opb.PimConfig_JoinPrune{{
+					RangeType:    opb.PimConfig_JoinPrune_S_G,
+					RpAddress:    "10.0.0.1",
+					GroupAddress: "232.1.1.1",
+				}},
+			},
 		},
-		wantIpv4Name: fmt.Sprintf("IPv4 on %s", ifName),
-		wantIpv6Name: fmt.Sprintf("IPv6 on %s", ifName),
+		wantErr: "invalid PIM join/prune source address",
 	}, {
-		desc: "Valid config on Macsec",
+		desc: "unicast candidate RP group prefix",
 		ifcfg: &opb.InterfaceConfig{
 			Name: ifName,
-			Ipv4: &opb.IpConfig{
-				AddressCidr:    "192.168.1.1/30",
-				DefaultGateway: "192.168.1.2",
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				CandidateRps: []*opb.PimConfig_CandidateRp{{Address: "10.0.0.1", GroupPrefix: "10.0.0.0/8"}},
 			},
-			Ipv6: &opb.IpConfig{
-				AddressCidr:    "2001::4860:193:168:1:1/126",
-				DefaultGateway: "2001::4860:193:168:1:2",
+		},
+		wantErr: "invalid PIM candidate RP group prefix",
+	}, {
+		desc: "IPv6 join/prune without IPv6",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				JoinPrunes: []*opb.PimConfig_JoinPrune{{
+					RangeType:    opb.PimConfig_JoinPrune_STAR_G,
+					RpAddress:    "bb::1",
+					GroupAddress: "ff0e::1",
+				}},
 			},
 		},
-		hasMacSec: true,
-		wantCfg: &ixconfig.TopologyEthernet{
-			Macsec: []*ixconfig.TopologyMacsec{{
+		wantErr: "without IPv6",
+	}, {
+		desc: "IPv4 join/prunes and candidate RP",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv4: ipv4,
+			Pim: &opb.PimConfig{
+				HelloIntervalSec: 30,
+				HelloHoldTimeSec: 105,
+				EnableBfd:        true,
+				JoinPrunes: []*opb.PimConfig_JoinPrune{{
+					RangeType:    opb.PimConfig_JoinPrune_STAR_G,
+					RpAddress:    "10.0.0.1",
+					GroupAddress: "239.1.1.1",
+					GroupCount:   10,
+				}, {
+					RangeType:     opb.PimConfig_JoinPrune_S_G,
+					RpAddress:     "10.0.0.1",
+					GroupAddress:  "232.1.1.1",
+					SourceAddress: "10.1.1.1",
+					Pruned:        true,
+				}},
+				CandidateRps: []*opb.PimConfig_CandidateRp{{
+					Address:     "10.0.0.1",
+					GroupPrefix: "239.0.0.0/8",
+					Priority:    192,
+				}},
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
 				Ipv4: []*ixconfig.TopologyIpv4{{
 					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
 					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
 					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
-					Prefix:         ixconfig.MultivalueUint32(30),
 					ResolveGateway: ixconfig.MultivalueTrue(),
+					PimV4Interface: []*ixconfig.TopologyPimV4Interface{{
+						Name:                  ixconfig.String(fmt.Sprintf("PIMv4 on %s", ifName)),
+						HelloInterval:         ixconfig.MultivalueUint32(30),
+						HelloHoldTime:         ixconfig.MultivalueUint32(105),
+						AutoPickNeighbor:      ixconfig.MultivalueTrue(),
+						EnableBfdRegistration: ixconfig.MultivalueTrue(),
+						JoinPrunes:            ixconfig.NumberInt(2),
+						CrpRanges:             ixconfig.NumberInt(1),
+						PimV4JoinPruneList: &ixconfig.TopologyPimV4JoinPruneList{
+							Active:             ixconfig.MultivalueBoolList(true, false),
+							RangeType:          ixconfig.MultivalueStrList("startogroup", "sourcetogroup"),
+							RpV4Address:        ixconfig.MultivalueStrList("10.0.0.1", "10.0.0.1"),
+							GroupV4Address:     ixconfig.MultivalueStrList("239.1.1.1", "232.1.1.1"),
+							GroupAddressCount:  ixconfig.MultivalueUintList(10, 1),
+							SourceV4Address:    ixconfig.MultivalueStrList("0.0.0.0", "10.1.1.1"),
+							SourceAddressCount: ixconfig.MultivalueUintList(0, 1),
+						},
+						PimV4CandidateRPsList: &ixconfig.TopologyPimV4CandidateRPsList{
+							CrpAddress:      ixconfig.MultivalueStrList("10.0.0.1"),
+							CrpAddressCount: ixconfig.MultivalueUint32(1),
+							GroupAddress:    ixconfig.MultivalueStrList("239.0.0.0"),
+							GroupMaskLen:    ixconfig.MultivalueUintList(8),
+							GroupCount:      ixconfig.MultivalueUint32(1),
+							Priority:        ixconfig.MultivalueUintList(192),
+						},
+					}},
 				}},
+			}},
+		},
+	}, {
+		desc: "IPv6 hellos only",
+		ifcfg: &opb.InterfaceConfig{
+			Name: ifName,
+			Ipv6: ipv6,
+			Pim: &opb.PimConfig{
+				HelloIntervalSec: 30,
+				HelloHoldTimeSec: 105,
+			},
+		},
+		wantCfg: &ixconfig.TopologyDeviceGroup{
+			Ethernet: []*ixconfig.TopologyEthernet{{
 				Ipv6: []*ixconfig.TopologyIpv6{{
 					Name:           ixconfig.String(fmt.Sprintf("IPv6 on %s", ifName)),
-					Address:        ixconfig.MultivalueStr("2001::4860:193:168:1:1"),
-					GatewayIp:      ixconfig.MultivalueStr("2001::4860:193:168:1:2"),
-					Prefix:         ixconfig.MultivalueUint32(126),
+					Address:        ixconfig.MultivalueStr("aa::1"),
+					Prefix:         ixconfig.MultivalueUint32(64),
+					GatewayIp:      ixconfig.MultivalueStr("aa::2"),
 					ResolveGateway: ixconfig.MultivalueTrue(),
+					PimV6Interface: []*ixconfig.TopologyPimV6Interface{{
+						Name:             ixconfig.String(fmt.Sprintf("PIMv6 on %s", ifName)),
+						HelloInterval:    ixconfig.MultivalueUint32(30),
+						HelloHoldTime:    ixconfig.MultivalueUint32(105),
+						AutoPickNeighbor: ixconfig.MultivalueTrue(),
+						JoinPrunes:       ixconfig.NumberInt(0),
+						CrpRanges:        ixconfig.NumberInt(0),
+					}},
 				}},
 			}},
 		},
-		wantIpv4Name: fmt.Sprintf("IPv4 on %s", ifName),
-		wantIpv6Name: fmt.Sprintf("IPv6 on %s", ifName),
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			c := clientWithTopo(ifName, test.hasMacSec)
-			gotErr := c.addIPProtocols(test.ifcfg)
+			c := clientWithTopo()
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addPIMProtocols(test.ifcfg)
 			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("addIPProtocols got err: %v, want err %q", gotErr, test.wantErr)
+				t.Errorf("addPIMProtocols got err: %v, want err %q", gotErr, test.wantErr)
 			}
 			if gotErr != nil {
 				return
 			}
 
 			ifc := c.intfs[ifName]
-			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup.Ethernet[0]); diff != "" {
-				t.Fatalf("addIPProtocols unexpected config diff (-want/+got): %s", diff)
-			}
-
-			var gotIpv4Name, gotIpv6Name string
-			hasIpv4 := ifc.ipv4 != nil
-			if hasIpv4 {
-				gotIpv4Name = *(ifc.ipv4.Name)
-			}
-			hasIpv6 := ifc.ipv6 != nil
-			if hasIpv6 {
-				gotIpv6Name = *(ifc.ipv6.Name)
-			}
-
-			wantIpv4 := test.wantIpv4Name != ""
-			if hasIpv4 != wantIpv4 {
-				t.Errorf("addIPProtocols: unexpected IPv4 config, was configured: %t, wanted configured? %t", hasIpv4, wantIpv4)
-			}
-			wantIpv6 := test.wantIpv6Name != ""
-			if hasIpv6 != wantIpv6 {
-				t.Errorf("addIPProtocols: unexpected IPv6 config, was configured: %t, wanted configured? %t", hasIpv6, wantIpv6)
-			}
-
-			if test.wantIpv4Name != gotIpv4Name {
-				t.Errorf("addIPProtocols: did not find expected IPv4 config: got name %q, wanted name %q", gotIpv4Name, test.wantIpv4Name)
-			}
-			if test.wantIpv6Name != gotIpv6Name {
-				t.Errorf("addIPProtocols: did not find expected IPv6 config: got name %q, wanted name %q", gotIpv6Name, test.wantIpv6Name)
+			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
+				t.Fatalf("addPIMProtocols unexpected config diff (-want/+got): %s", diff)
 			}
 		})
 	}
 }
 
-func TestAddIPLoopbackProtocols(t *testing.T) {
+func TestAddLDPProtocols(t *testing.T) {
 	const ifName = "someIntf"
 
 	clientWithTopo := func() *ixATE {
 		cfg := &ixconfig.Ixnetwork{
 			Topology: []*ixconfig.Topology{{
-				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{}},
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+					Ethernet: []*ixconfig.TopologyEthernet{{}},
+				}},
 			}},
 		}
 		return &ixATE{
@@ -458,6 +1542,7 @@ func TestAddIPLoopbackProtocols(t *testing.T) {
 			},
 		}
 	}
+	ipv4 := &opb.IpConfig{AddressCidr: "192.168.1.1/24", DefaultGateway: "192.168.1.2"}
 
 	tests := []struct {
 		desc    string
@@ -465,52 +1550,71 @@ func TestAddIPLoopbackProtocols(t *testing.T) {
 		wantErr string
 		wantCfg *ixconfig.TopologyDeviceGroup
 	}{{
-		desc: "Invalid IPv4 address.",
+		desc: "no static IPv4",
 		ifcfg: &opb.InterfaceConfig{
-			Name:             ifName,
-			Ipv4LoopbackCidr: "aa:bb:cc::",
+			Name: ifName,
+			Ipv6: &opb.IpConfig{AddressCidr: "aa::1/64", DefaultGateway: "aa::2"},
+			Ldp:  &opb.LdpConfig{},
 		},
-		wantErr: "invalid IPv4 CIDR",
+		wantErr: "need a static IPv4 address",
 	}, {
-		desc: "Invalid IPv6 address.",
+		desc: "bad LSR ID",
 		ifcfg: &opb.InterfaceConfig{
-			Name:             ifName,
-			Ipv6LoopbackCidr: "1.2.3.4",
+			Name: ifName,
+			Ipv4: ipv4,
+			Ldp:  &opb.LdpConfig{LsrId: "aa::1"},
 		},
-		wantErr: "invalid IPv6 CIDR",
+		wantErr: "invalid LDP LSR ID",
 	}, {
-		desc: "Valid IPv4 address.",
+		desc: "LDP with LSR ID and BFD",
 		ifcfg: &opb.InterfaceConfig{
-			Name:             ifName,
-			Ipv4LoopbackCidr: "1.2.3.4/30",
+			Name: ifName,
+			Ipv4: ipv4,
+			Ldp: &opb.LdpConfig{
+				LsrId:                "1.1.1.1",
+				HelloIntervalSec:     5,
+				HelloHoldTimeSec:     15,
+				KeepaliveIntervalSec: 10,
+				KeepaliveHoldTimeSec: 30,
+				EnableBfd:            true,
+			},
 		},
 		wantCfg: &ixconfig.TopologyDeviceGroup{
-			Ipv4Loopback: []*ixconfig.TopologyIpv4Loopback{{
-				Name:    ixconfig.String(fmt.Sprintf("IPv4 loopback on %s", ifName)),
-				Address: ixconfig.MultivalueStr("1.2.3.4"),
-				Prefix:  ixconfig.MultivalueUint32(30),
+			Ethernet: []*ixconfig.TopologyEthernet{{
+				Ipv4: []*ixconfig.TopologyIpv4{{
+					Name:           ixconfig.String(fmt.Sprintf("IPv4 on %s", ifName)),
+					Address:        ixconfig.MultivalueStr("192.168.1.1"),
+					Prefix:         ixconfig.MultivalueUint32(24),
+					GatewayIp:      ixconfig.MultivalueStr("192.168.1.2"),
+					ResolveGateway: ixconfig.MultivalueTrue(),
+					LdpConnectedInterface: []*ixconfig.TopologyLdpConnectedInterface{{
+						Name:                  ixconfig.String(fmt.Sprintf("LDP Interface on %s", ifName)),
+						LabelSpaceID:          ixconfig.MultivalueUint32(0),
+						BasicHelloInterval:    ixconfig.MultivalueUint32(5),
+						BasicHoldTime:         ixconfig.MultivalueUint32(15),
+						EnableBfdRegistration: ixconfig.MultivalueTrue(),
+					}},
+				}},
 			}},
-		},
-	}, {
-		desc: "Valid IPv6 address.",
-		ifcfg: &opb.InterfaceConfig{
-			Name:             ifName,
-			Ipv6LoopbackCidr: "cafe:beef::1/128",
-		},
-		wantCfg: &ixconfig.TopologyDeviceGroup{
-			Ipv6Loopback: []*ixconfig.TopologyIpv6Loopback{{
-				Name:    ixconfig.String(fmt.Sprintf("IPv6 loopback on %s", ifName)),
-				Address: ixconfig.MultivalueStr("cafe:beef::1"),
-				Prefix:  ixconfig.MultivalueUint32(128),
+			RouterData: []*ixconfig.TopologyRouterData{{
+				RouterId: ixconfig.MultivalueStr("1.1.1.1"),
+			}},
+			LdpBasicRouter: []*ixconfig.TopologyLdpBasicRouter{{
+				Name:              ixconfig.String(fmt.Sprintf("LDP Router on %s", ifName)),
+				KeepAliveInterval: ixconfig.MultivalueUint32(10),
+				KeepAliveHoldTime: ixconfig.MultivalueUint32(30),
 			}},
 		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
 			c := clientWithTopo()
-			gotErr := c.addIPLoopbackProtocols(test.ifcfg)
+			if err := c.addIPProtocols(test.ifcfg); err != nil {
+				t.Fatalf("addIPProtocols got err: %v", err)
+			}
+			gotErr := c.addLDPProtocols(test.ifcfg)
 			if (gotErr == nil && test.wantErr != "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
-				t.Errorf("addIPLoopbackProtocols got err: %v, want err %q", gotErr, test.wantErr)
+				t.Errorf("addLDPProtocols got err: %v, want err %q", gotErr, test.wantErr)
 			}
 			if gotErr != nil {
 				return
@@ -518,7 +1622,7 @@ func TestAddIPLoopbackProtocols(t *testing.T) {
 
 			ifc := c.intfs[ifName]
 			if diff := jsonCfgDiff(t, test.wantCfg, ifc.deviceGroup); diff != "" {
-				t.Fatalf("addIPLoopbackProtocols unexpected config diff (-want/+got): %s", diff)
+				t.Fatalf("addLDPProtocols unexpected config diff (-want/+got): %s", diff)
 			}
 		})
 	}
@@ -673,6 +1777,95 @@ func TestAddISISProtocols(t *testing.T) {
 	}
 }
 
+func TestAddISISLevelsAndAuth(t *testing.T) {
+	const ifName = "someIntf"
+	tests := []struct {
+		desc    string
+		isis    *opb.ISISConfig
+		wantL1  bool
+		wantL2  bool
+		wantRtr *ixconfig.TopologyIsisL3Router
+		wantErr string
+	}{{
+		desc: "level 1",
+		isis: &opb.ISISConfig{
+			Level:       opb.ISISConfig_L1,
+			NetworkType: opb.ISISConfig_BROADCAST,
+		},
+		wantL1:  true,
+		wantRtr: &ixconfig.TopologyIsisL3Router{},
+	}, {
+		desc: "level 2",
+		isis: &opb.ISISConfig{
+			Level:       opb.ISISConfig_L2,
+			NetworkType: opb.ISISConfig_BROADCAST,
+		},
+		wantL2:  true,
+		wantRtr: &ixconfig.TopologyIsisL3Router{},
+	}, {
+		desc: "levels 1 and 2 with auth and overload",
+		isis: &opb.ISISConfig{
+			Level:          opb.ISISConfig_L1L2,
+			NetworkType:    opb.ISISConfig_BROADCAST,
+			AreaAuthType:   opb.ISISConfig_MD5,
+			AreaAuthKey:    "areaKey",
+			DomainAuthType: opb.ISISConfig_PASSWORD,
+			DomainAuthKey:  "domainKey",
+			Overloaded:     true,
+		},
+		wantL1: true,
+		wantL2: true,
+		wantRtr: &ixconfig.TopologyIsisL3Router{
+			AreaAuthenticationType:         ixconfig.MultivalueStr("md5"),
+			AreaTransmitPasswordOrMD5Key:   ixconfig.MultivalueStr("areaKey"),
+			DomainAuthenticationType:       ixconfig.MultivalueStr("password"),
+			DomainTransmitPasswordOrMD5Key: ixconfig.MultivalueStr("domainKey"),
+			Overloaded:                     ixconfig.MultivalueTrue(),
+		},
+	}, {
+		desc: "bad area auth type",
+		isis: &opb.ISISConfig{
+			Level:        opb.ISISConfig_L1,
+			NetworkType:  opb.ISISConfig_BROADCAST,
+			AreaAuthType: opb.ISISConfig_AuthType(42),
+		},
+		wantErr: "unrecognized auth type",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := clientWithTopoCfg(ifName)
+			gotErr := c.addISISProtocols(&opb.InterfaceConfig{Name: ifName, Isis: test.isis})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("addISISProtocols: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			dg := c.cfg.Topology[0].DeviceGroup[0]
+			isisL3 := dg.Ethernet[0].IsisL3[0]
+			if got := isisL3.Level1Priority != nil; got != test.wantL1 {
+				t.Errorf("addISISProtocols: got level 1 configured %t, want %t", got, test.wantL1)
+			}
+			if got := isisL3.Level2Priority != nil; got != test.wantL2 {
+				t.Errorf("addISISProtocols: got level 2 configured %t, want %t", got, test.wantL2)
+			}
+			rtr := dg.IsisL3Router[0]
+			gotRtr := &ixconfig.TopologyIsisL3Router{
+				AreaAuthenticationType:         rtr.AreaAuthenticationType,
+				AreaTransmitPasswordOrMD5Key:   rtr.AreaTransmitPasswordOrMD5Key,
+				DomainAuthenticationType:       rtr.DomainAuthenticationType,
+				DomainTransmitPasswordOrMD5Key: rtr.DomainTransmitPasswordOrMD5Key,
+			}
+			if *rtr.Overloaded.SingleValue.Value == "true" {
+				gotRtr.Overloaded = rtr.Overloaded
+			}
+			if diff := jsonCfgDiff(t, test.wantRtr, gotRtr); diff != "" {
+				t.Errorf("addISISProtocols: unexpected IS-IS router config diff (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestISISReachability(t *testing.T) {
 	tests := []struct {
 		desc         string
@@ -1375,6 +2568,128 @@ func TestISISReachability(t *testing.T) {
 	}
 }
 
+func TestISISTEAttributes(t *testing.T) {
+	const ifName = "someIntf"
+	te := &opb.ISISTrafficEngineering{
+		Metric:                 20,
+		AdminGroup:             0xff,
+		MaxBandwidth:           1e9,
+		MaxReservableBandwidth: 5e8,
+		UnreservedBandwidth:    []float32{100, 200},
+		Srlgs:                  []uint32{7, 8},
+	}
+
+	t.Run("interface", func(t *testing.T) {
+		c := clientWithTopoCfg(ifName)
+		isis := &opb.ISISConfig{
+			Level:        opb.ISISConfig_L2,
+			NetworkType:  opb.ISISConfig_POINT_TO_POINT,
+			TeAttributes: te,
+		}
+		if err := c.addISISProtocols(&opb.InterfaceConfig{Name: ifName, Isis: isis}); err != nil {
+			t.Fatalf("addISISProtocols: unexpected error: %v", err)
+		}
+		isisL3 := c.cfg.Topology[0].DeviceGroup[0].Ethernet[0].IsisL3[0]
+		wantTE := &ixconfig.TopologyIsisTrafficEngineering{
+			MetricLevel:            ixconfig.MultivalueUint32(20),
+			AdministratorGroup:     ixconfig.MultivalueStr("000000ff"),
+			MaxBandwidth:           ixconfig.MultivalueStr("1000000000"),
+			MaxReservableBandwidth: ixconfig.MultivalueStr("500000000"),
+			BandwidthPriority0:     ixconfig.MultivalueStr("100"),
+			BandwidthPriority1:     ixconfig.MultivalueStr("200"),
+			BandwidthPriority2:     ixconfig.MultivalueStr("0"),
+			BandwidthPriority3:     ixconfig.MultivalueStr("0"),
+			BandwidthPriority4:     ixconfig.MultivalueStr("0"),
+			BandwidthPriority5:     ixconfig.MultivalueStr("0"),
+			BandwidthPriority6:     ixconfig.MultivalueStr("0"),
+			BandwidthPriority7:     ixconfig.MultivalueStr("0"),
+		}
+		if diff := jsonCfgDiff(t, wantTE, isisL3.IsisTrafficEngineering); diff != "" {
+			t.Errorf("addISISProtocols: unexpected TE config diff (-want, +got): %s", diff)
+		}
+		wantSRLGs := &ixconfig.TopologyIsisL3{
+			EnableSRLG: ixconfig.MultivalueTrue(),
+			SrlgCount:  ixconfig.NumberInt(2),
+			SrlgValueList: []*ixconfig.TopologySrlgValueList{
+				{SrlgValue: ixconfig.MultivalueUint32(7)},
+				{SrlgValue: ixconfig.MultivalueUint32(8)},
+			},
+		}
+		gotSRLGs := &ixconfig.TopologyIsisL3{
+			EnableSRLG:    isisL3.EnableSRLG,
+			SrlgCount:     isisL3.SrlgCount,
+			SrlgValueList: isisL3.SrlgValueList,
+		}
+		if diff := jsonCfgDiff(t, wantSRLGs, gotSRLGs); diff != "" {
+			t.Errorf("addISISProtocols: unexpected SRLG config diff (-want, +got): %s", diff)
+		}
+	})
+
+	t.Run("too many unreserved bandwidths", func(t *testing.T) {
+		c := clientWithTopoCfg(ifName)
+		isis := &opb.ISISConfig{
+			Level:        opb.ISISConfig_L2,
+			NetworkType:  opb.ISISConfig_POINT_TO_POINT,
+			TeAttributes: &opb.ISISTrafficEngineering{UnreservedBandwidth: make([]float32, 9)},
+		}
+		gotErr := c.addISISProtocols(&opb.InterfaceConfig{Name: ifName, Isis: isis})
+		if wantErr := "at most 8"; gotErr == nil || !strings.Contains(gotErr.Error(), wantErr) {
+			t.Errorf("addISISProtocols: got err: %v, want err %q", gotErr, wantErr)
+		}
+	})
+
+	t.Run("simulated links", func(t *testing.T) {
+		isr := &opb.ISReachability{
+			Nodes: []*opb.ISReachability_Node{{
+				SystemId: "6b",
+				Links: []*opb.ISReachability_Node_Link{{
+					FromIpv4:     "1.1.1.1/31",
+					ToIpv4:       "2.2.2.2/31",
+					TeAttributes: te,
+				}, {
+					FromIpv4: "1.1.1.3/31",
+					ToIpv4:   "2.2.2.3/31",
+				}},
+				IngressMetric: 10,
+				EgressMetric:  10,
+			}},
+		}
+		netwGrps, err := isisReachability(ifName, []*opb.ISReachability{isr})
+		if err != nil {
+			t.Fatalf("isisReachability: unexpected error: %v", err)
+		}
+		got := netwGrps["IS-IS Topology 0 for "+ifName].NetworkTopology.SimInterface[0].IsisL3PseudoInterface[0]
+		gotTE := &ixconfig.TopologyIsisL3PseudoInterface{
+			MetricLevel:               got.MetricLevel,
+			AdministratorGroup:        got.AdministratorGroup,
+			MaxBandwidthBps:           got.MaxBandwidthBps,
+			MaxReservableBandwidthBps: got.MaxReservableBandwidthBps,
+			BandwidthPriority0Bps:     got.BandwidthPriority0Bps,
+			BandwidthPriority7Bps:     got.BandwidthPriority7Bps,
+			EnableSRLG:                got.EnableSRLG,
+			SrlgCount:                 got.SrlgCount,
+			SrlgValueList:             got.SrlgValueList,
+		}
+		wantTE := &ixconfig.TopologyIsisL3PseudoInterface{
+			MetricLevel:               ixconfig.MultivalueUintList(20, 0),
+			AdministratorGroup:        ixconfig.MultivalueStrList("000000ff", "00000000"),
+			MaxBandwidthBps:           ixconfig.MultivalueStrList("1000000000", "0"),
+			MaxReservableBandwidthBps: ixconfig.MultivalueStrList("500000000", "0"),
+			BandwidthPriority0Bps:     ixconfig.MultivalueStrList("100", "0"),
+			BandwidthPriority7Bps:     ixconfig.MultivalueStrList("0", "0"),
+			EnableSRLG:                ixconfig.MultivalueBoolList(true, false),
+			SrlgCount:                 ixconfig.NumberInt(2),
+			SrlgValueList: []*ixconfig.TopologySrlgValueList{
+				{SrlgValue: ixconfig.MultivalueUintList(7, 0)},
+				{SrlgValue: ixconfig.MultivalueUintList(8, 0)},
+			},
+		}
+		if diff := jsonCfgDiff(t, wantTE, gotTE); diff != "" {
+			t.Errorf("isisReachability: unexpected TE config diff (-want, +got): %s", diff)
+		}
+	})
+}
+
 func TestAddBGPProtocols(t *testing.T) {
 	const ifName = "someIntf"
 	baseClient := func(withIPv4, withIPv6, withIPv4Loopback, withIPv6Loopback bool) *ixATE {
@@ -1694,6 +3009,28 @@ func TestBgpV4Peers(t *testing.T) {
 			NumberSRTEPolicies: ixconfig.NumberUint32(0),
 			FilterIpV4Unicast:  ixconfig.MultivalueTrue(),
 		},
+	}, {
+		desc: "with add-path mode",
+		peerCfg: &opb.BgpPeer{
+			Active:      true,
+			Type:        opb.BgpPeer_TYPE_EXTERNAL,
+			PeerAddress: "1.1.1.1",
+			LocalAsn:    15169,
+			AddPathMode: opb.BgpPeer_ADD_PATH_MODE_SEND_RECEIVE,
+		},
+		wantPeer: &ixconfig.TopologyBgpIpv4Peer{
+			Active:                 ixconfig.MultivalueTrue(),
+			Type_:                  ixconfig.MultivalueStr("external"),
+			DutIp:                  ixconfig.MultivalueStr("1.1.1.1"),
+			HoldTimer:              ixconfig.MultivalueUint32(0),
+			KeepaliveTimer:         ixconfig.MultivalueUint32(0),
+			Enable4ByteAs:          ixconfig.MultivalueFalse(),
+			LocalAs2Bytes:          ixconfig.MultivalueUint32(15169),
+			NumberSRTEPolicies:     ixconfig.NumberUint32(0),
+			FilterIpV4Unicast:      ixconfig.MultivalueTrue(),
+			Ipv4UnicastAddPathMode: ixconfig.MultivalueStr("both"),
+			Ipv6UnicastAddPathMode: ixconfig.MultivalueStr("both"),
+		},
 	}, {
 		desc: "with MD5 key",
 		peerCfg: &opb.BgpPeer{
@@ -1716,6 +3053,27 @@ func TestBgpV4Peers(t *testing.T) {
 			NumberSRTEPolicies: ixconfig.NumberUint32(0),
 			FilterIpV4Unicast:  ixconfig.MultivalueTrue(),
 		},
+	}, {
+		desc: "with BFD",
+		peerCfg: &opb.BgpPeer{
+			Active:      true,
+			Type:        opb.BgpPeer_TYPE_EXTERNAL,
+			PeerAddress: "1.1.1.1",
+			LocalAsn:    15169,
+			EnableBfd:   true,
+		},
+		wantPeer: &ixconfig.TopologyBgpIpv4Peer{
+			Active:                ixconfig.MultivalueTrue(),
+			Type_:                 ixconfig.MultivalueStr("external"),
+			DutIp:                 ixconfig.MultivalueStr("1.1.1.1"),
+			HoldTimer:             ixconfig.MultivalueUint32(0),
+			KeepaliveTimer:        ixconfig.MultivalueUint32(0),
+			Enable4ByteAs:         ixconfig.MultivalueFalse(),
+			LocalAs2Bytes:         ixconfig.MultivalueUint32(15169),
+			EnableBfdRegistration: ixconfig.MultivalueTrue(),
+			NumberSRTEPolicies:    ixconfig.NumberUint32(0),
+			FilterIpV4Unicast:     ixconfig.MultivalueTrue(),
+		},
 	}, {
 		desc: "with SRTE policies",
 		peerCfg: &opb.BgpPeer{
@@ -0,0 +1,185 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ate
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/ondatra/binding/usererr"
+	"github.com/pkg/errors"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+const ciscoRouteTableHeader = `BGP table version is 1, local router ID is 0.0.0.0
+Status codes: s suppressed, d damped, h history, * valid, > best, i - internal
+Origin codes: i - IGP, e - EGP, ? - incomplete
+
+   Network          Next Hop            Metric LocPrf Weight Path
+`
+
+var routeTableDirFn = os.TempDir
+
+// generateRouteTables generates Cisco-format route tables for the generated BGP
+// routes of a network and writes them to local files, so that they can be
+// synced to the Ixia and imported like any other route table files.
+func generateRouteTables(gen *opb.GeneratedBgpRoutes) (*routeTables, error) {
+	if gen.GetIpv4() == nil && gen.GetIpv6() == nil {
+		return nil, usererr.New("no IPv4 or IPv6 prefixes specified for generated BGP routes")
+	}
+	if gen.GetAsPathMinLength() == 0 || gen.GetAsPathMinLength() > gen.GetAsPathMaxLength() {
+		return nil, usererr.New("invalid AS path length range [%d, %d] for generated BGP routes", gen.GetAsPathMinLength(), gen.GetAsPathMaxLength())
+	}
+	if gen.GetAsnMin() == 0 || gen.GetAsnMin() > gen.GetAsnMax() {
+		return nil, usererr.New("invalid ASN range [%d, %d] for generated BGP routes", gen.GetAsnMin(), gen.GetAsnMax())
+	}
+	rts := &routeTables{format: routeTableFormatCisco}
+	rng := rand.New(rand.NewSource(gen.GetSeed()))
+	if gen.GetIpv4() != nil {
+		b, err := generateRouteTable(gen, gen.GetIpv4(), false, rng)
+		if err != nil {
+			return nil, err
+		}
+		if rts.ipv4, err = writeRouteTable("ipv4", b); err != nil {
+			return nil, err
+		}
+	}
+	if gen.GetIpv6() != nil {
+		b, err := generateRouteTable(gen, gen.GetIpv6(), true, rng)
+		if err != nil {
+			return nil, err
+		}
+		if rts.ipv6, err = writeRouteTable("ipv6", b); err != nil {
+			return nil, err
+		}
+	}
+	return rts, nil
+}
+
+// generateRouteTable allocates consecutive, non-overlapping prefixes from the
+// start address, from the shortest prefix length to the longest, and returns a
+// route table of the prefixes with randomized AS paths.
+func generateRouteTable(gen *opb.GeneratedBgpRoutes, pfxs *opb.GeneratedBgpRoutes_Prefixes, isV6 bool, rng *rand.Rand) ([]byte, error) {
+	family, bits, nextHop := "IPv4", 32, "0.0.0.0"
+	if isV6 {
+		family, bits, nextHop = "IPv6", 128, "::"
+	}
+	ip := net.ParseIP(pfxs.GetStartAddress())
+	if ip == nil || (ip.To4() == nil) != isV6 {
+		return nil, usererr.New("invalid %s start address %q for generated BGP routes", family, pfxs.GetStartAddress())
+	}
+	if isV6 {
+		ip = ip.To16()
+	} else {
+		ip = ip.To4()
+	}
+	counts, err := prefixLengthCounts(pfxs, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	var lens []int
+	for l := range counts {
+		lens = append(lens, l)
+	}
+	sort.Ints(lens)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(ciscoRouteTableHeader)
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	cursor := new(big.Int).SetBytes(ip)
+	for _, l := range lens {
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-l))
+		// Align the cursor to the next multiple of the prefix size.
+		if rem := new(big.Int).Mod(cursor, size); rem.Sign() != 0 {
+			cursor.Add(cursor, size).Sub(cursor, rem)
+		}
+		for i := uint64(0); i < counts[l]; i++ {
+			if new(big.Int).Add(cursor, size).Cmp(limit) > 0 {
+				return nil, usererr.New("%s address space exhausted from start address %s after %d generated prefixes", family, pfxs.GetStartAddress(), i)
+			}
+			addr := make(net.IP, len(ip))
+			cursor.FillBytes(addr)
+			fmt.Fprintf(buf, "*> %-18s %-19s %6d %6d %6d %s i\n", fmt.Sprintf("%s/%d", addr, l), nextHop, 0, 100, 0, asPath(gen, rng))
+			cursor.Add(cursor, size)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// prefixLengthCounts splits the prefix count between the prefix lengths in
+// proportion to their weights, assigning any rounding remainder to the length
+// with the greatest weight.
+func prefixLengthCounts(pfxs *opb.GeneratedBgpRoutes_Prefixes, bits int) (map[int]uint64, error) {
+	if pfxs.GetCount() == 0 {
+		return nil, usererr.New("prefix count must be a positive value for generated BGP routes")
+	}
+	weights := pfxs.GetPrefixLengthWeights()
+	if len(weights) == 0 {
+		return nil, usererr.New("no prefix length weights specified for generated BGP routes")
+	}
+	var total uint64
+	maxLen := -1
+	for l, w := range weights {
+		if l == 0 || int(l) > bits {
+			return nil, usererr.New("invalid prefix length %d for generated BGP routes", l)
+		}
+		total += uint64(w)
+		if maxLen < 0 || w > weights[uint32(maxLen)] || (w == weights[uint32(maxLen)] && int(l) < maxLen) {
+			maxLen = int(l)
+		}
+	}
+	if total == 0 {
+		return nil, usererr.New("prefix length weights must not all be zero for generated BGP routes")
+	}
+	counts := make(map[int]uint64)
+	var sum uint64
+	for l, w := range weights {
+		n := uint64(pfxs.GetCount()) * uint64(w) / total
+		counts[int(l)] = n
+		sum += n
+	}
+	counts[maxLen] += uint64(pfxs.GetCount()) - sum
+	return counts, nil
+}
+
+func asPath(gen *opb.GeneratedBgpRoutes, rng *rand.Rand) string {
+	minLen, maxLen := gen.GetAsPathMinLength(), gen.GetAsPathMaxLength()
+	asns := make([]string, minLen+uint32(rng.Int63n(int64(maxLen-minLen)+1)))
+	for i := range asns {
+		asns[i] = fmt.Sprint(int64(gen.GetAsnMin()) + rng.Int63n(int64(gen.GetAsnMax()-gen.GetAsnMin())+1))
+	}
+	return strings.Join(asns, " ")
+}
+
+// writeRouteTable writes a generated route table to a file named by its
+// checksum, so regenerating the same table reuses the same file.
+func writeRouteTable(family string, b []byte) (string, error) {
+	f := filepath.Join(routeTableDirFn(), fmt.Sprintf("ondatra_generated_%s_routes_%d", family, crc32.ChecksumIEEE(b)))
+	if err := ioutil.WriteFile(f, b, 0644); err != nil {
+		return "", errors.Wrapf(err, "could not write generated route table file %s", f)
+	}
+	return f, nil
+}
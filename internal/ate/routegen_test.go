@@ -0,0 +1,156 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ate
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestGenerateRouteTables(t *testing.T) {
+	defer restoreStubs()
+	dir := t.TempDir()
+	routeTableDirFn = func() string { return dir }
+
+	validGen := func() *opb.GeneratedBgpRoutes {
+		return &opb.GeneratedBgpRoutes{
+			AsPathMinLength: 2,
+			AsPathMaxLength: 2,
+			AsnMin:          100,
+			AsnMax:          100,
+		}
+	}
+	tests := []struct {
+		desc     string
+		gen      func(*opb.GeneratedBgpRoutes)
+		wantIPv4 []string
+		wantIPv6 []string
+		wantErr  string
+	}{{
+		desc:    "no prefixes",
+		gen:     func(*opb.GeneratedBgpRoutes) {},
+		wantErr: "no IPv4 or IPv6 prefixes",
+	}, {
+		desc: "bad AS path length range",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.AsPathMinLength = 3
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.0", Count: 1, PrefixLengthWeights: map[uint32]uint32{24: 1}}
+		},
+		wantErr: "invalid AS path length range",
+	}, {
+		desc: "bad ASN range",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.AsnMin = 0
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.0", Count: 1, PrefixLengthWeights: map[uint32]uint32{24: 1}}
+		},
+		wantErr: "invalid ASN range",
+	}, {
+		desc: "IPv6 start address for IPv4 prefixes",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "2001::", Count: 1, PrefixLengthWeights: map[uint32]uint32{24: 1}}
+		},
+		wantErr: "invalid IPv4 start address",
+	}, {
+		desc: "no prefix count",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.0", PrefixLengthWeights: map[uint32]uint32{24: 1}}
+		},
+		wantErr: "prefix count must be a positive value",
+	}, {
+		desc: "no prefix length weights",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.0", Count: 1}
+		},
+		wantErr: "no prefix length weights",
+	}, {
+		desc: "invalid prefix length",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.0", Count: 1, PrefixLengthWeights: map[uint32]uint32{33: 1}}
+		},
+		wantErr: "invalid prefix length 33",
+	}, {
+		desc: "address space exhausted",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "255.255.255.0", Count: 2, PrefixLengthWeights: map[uint32]uint32{24: 1}}
+		},
+		wantErr: "address space exhausted",
+	}, {
+		desc: "IPv4 and IPv6 prefixes",
+		gen: func(g *opb.GeneratedBgpRoutes) {
+			g.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "1.0.0.1", Count: 5, PrefixLengthWeights: map[uint32]uint32{16: 1, 24: 3}}
+			g.Ipv6 = &opb.GeneratedBgpRoutes_Prefixes{StartAddress: "2001::", Count: 2, PrefixLengthWeights: map[uint32]uint32{32: 1, 48: 1}}
+		},
+		wantIPv4: []string{
+			"*> 1.1.0.0/16         0.0.0.0                  0    100      0 100 100 i",
+			"*> 1.2.0.0/24         0.0.0.0                  0    100      0 100 100 i",
+			"*> 1.2.1.0/24         0.0.0.0                  0    100      0 100 100 i",
+			"*> 1.2.2.0/24         0.0.0.0                  0    100      0 100 100 i",
+			"*> 1.2.3.0/24         0.0.0.0                  0    100      0 100 100 i",
+		},
+		wantIPv6: []string{
+			"*> 2001::/32          ::                       0    100      0 100 100 i",
+			"*> 2001:1::/48        ::                       0    100      0 100 100 i",
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gen := validGen()
+			test.gen(gen)
+			got, gotErr := generateRouteTables(gen)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("generateRouteTables: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if got.format != routeTableFormatCisco {
+				t.Errorf("generateRouteTables: got format %q, want %q", got.format, routeTableFormatCisco)
+			}
+			for _, rt := range []struct {
+				path string
+				want []string
+			}{{got.ipv4, test.wantIPv4}, {got.ipv6, test.wantIPv6}} {
+				b, err := ioutil.ReadFile(rt.path)
+				if err != nil {
+					t.Fatalf("could not read generated route table file: %v", err)
+				}
+				lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+				if gotRoutes := lines[len(lines)-len(rt.want):]; !cmp.Equal(gotRoutes, rt.want) {
+					t.Errorf("generateRouteTables: got routes %q, want %q", gotRoutes, rt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrefixLengthCounts(t *testing.T) {
+	pfxs := &opb.GeneratedBgpRoutes_Prefixes{
+		Count:               800000,
+		PrefixLengthWeights: map[uint32]uint32{22: 12, 23: 10, 24: 60, 20: 18},
+	}
+	got, err := prefixLengthCounts(pfxs, 32)
+	if err != nil {
+		t.Fatalf("prefixLengthCounts: unexpected error: %v", err)
+	}
+	want := map[int]uint64{20: 144000, 22: 96000, 23: 80000, 24: 480000}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("prefixLengthCounts: unexpected diff (-want +got):\n%s", diff)
+	}
+}
@@ -15,6 +15,7 @@
 package ate
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net"
 
@@ -112,6 +113,26 @@ func headerStacks(hdr *opb.Header, idx int) ([]*ixconfig.TrafficStack, error) {
 			return nil, err
 		}
 		return []*ixconfig.TrafficStack{s}, nil
+	case *opb.Header_Vxlan:
+		s, err := vxlanStack(v.Vxlan, idx)
+		if err != nil {
+			return nil, err
+		}
+		return []*ixconfig.TrafficStack{s}, nil
+	case *opb.Header_Geneve:
+		s, err := geneveStack(v.Geneve, idx)
+		if err != nil {
+			return nil, err
+		}
+		return []*ixconfig.TrafficStack{s}, nil
+	case *opb.Header_Gtpu:
+		return gtpuStacks(v.Gtpu, idx)
+	case *opb.Header_Custom:
+		s, err := customStack(v.Custom, idx)
+		if err != nil {
+			return nil, err
+		}
+		return []*ixconfig.TrafficStack{s}, nil
 	default:
 		return nil, fmt.Errorf("unrecognized header type: %v", hdr)
 	}
@@ -132,6 +153,12 @@ func ethStack(eth *opb.EthernetHeader, idx int) (*ixconfig.TrafficStack, error)
 			return nil, errors.Wrap(err, "could not set destination MAC address")
 		}
 	}
+	if et := eth.GetEtherType(); et != 0 {
+		if et > 0xffff {
+			return nil, errors.Errorf("ether type %d is not a 16-bit value", et)
+		}
+		setSingleValue(stack.EtherType(), uintToHexStr(et))
+	}
 	return stack.TrafficStack(), nil
 }
 
@@ -243,6 +270,85 @@ func udpStack(udp *opb.UdpHeader, idx int) (*ixconfig.TrafficStack, error) {
 	return stack.TrafficStack(), nil
 }
 
+func vxlanStack(vxlan *opb.VxlanHeader, idx int) (*ixconfig.TrafficStack, error) {
+	stack := ixconfig.NewVxlanStack(idx)
+	if vxlan.GetVni() != nil {
+		if err := setUintRangeField(stack.Vni(), vxlan.GetVni()); err != nil {
+			return nil, errors.Wrap(err, "could not set VXLAN VNI")
+		}
+	}
+	return stack.TrafficStack(), nil
+}
+
+func geneveStack(geneve *opb.GeneveHeader, idx int) (*ixconfig.TrafficStack, error) {
+	stack := ixconfig.NewGeneveStack(idx)
+	if geneve.GetVni() != nil {
+		if err := setUintRangeField(stack.Vni(), geneve.GetVni()); err != nil {
+			return nil, errors.Wrap(err, "could not set GENEVE VNI")
+		}
+	}
+	var controlPacket uint32
+	if geneve.GetControlPacket() {
+		controlPacket = 1
+	}
+	setSingleValue(stack.ProtocolType(), uintToHexStr(geneve.GetProtocolType()))
+	setSingleValue(stack.ControlPacket(), uintToStr(controlPacket))
+	return stack.TrafficStack(), nil
+}
+
+// gtpuPDUSessionContainerType is the next extension header type that
+// identifies a PDU session container.
+const gtpuPDUSessionContainerType = 0x85
+
+var gtpuPDUTypeToNum = map[opb.GtpuHeader_PduSessionContainer_PduType]uint32{
+	opb.GtpuHeader_PduSessionContainer_DOWNLINK: 0,
+	opb.GtpuHeader_PduSessionContainer_UPLINK:   1,
+}
+
+// gtpuStacks returns the stacks of a GTPv1-U header. A PDU session container
+// requires the optional fields stack to chain the extension header.
+func gtpuStacks(gtpu *opb.GtpuHeader, idx int) ([]*ixconfig.TrafficStack, error) {
+	stack := ixconfig.NewGtpuStack(idx)
+	if gtpu.GetTeid() != nil {
+		if err := setUintRangeField(stack.Teid(), gtpu.GetTeid()); err != nil {
+			return nil, errors.Wrap(err, "could not set GTP-U TEID")
+		}
+	}
+	setSingleValue(stack.Type(), uintToStr(gtpu.GetMessageType()))
+	psc := gtpu.GetPduSessionContainer()
+	if psc == nil {
+		return []*ixconfig.TrafficStack{stack.TrafficStack()}, nil
+	}
+
+	pduType, ok := gtpuPDUTypeToNum[psc.GetPduType()]
+	if !ok {
+		return nil, errors.Errorf("invalid GTP-U PDU session container type %v", psc.GetPduType())
+	}
+	setSingleValue(stack.E(), uintToStr(1))
+	optStack := ixconfig.NewGtpuOptionalFieldsStack(idx + 1)
+	setSingleValue(optStack.NextExtHdrField(), uintToHexStr(gtpuPDUSessionContainerType))
+	pscStack := ixconfig.NewGtpuExtensionPduSessionContainerStack(idx + 2)
+	setSingleValue(pscStack.PduType(), uintToStr(pduType))
+	if psc.GetQfi() != nil {
+		if err := setUintRangeField(pscStack.Qfi(), psc.GetQfi()); err != nil {
+			return nil, errors.Wrap(err, "could not set GTP-U QFI")
+		}
+	}
+	return []*ixconfig.TrafficStack{stack.TrafficStack(), optStack.TrafficStack(), pscStack.TrafficStack()}, nil
+}
+
+func customStack(custom *opb.CustomHeader, idx int) (*ixconfig.TrafficStack, error) {
+	data := custom.GetData()
+	if len(data) == 0 {
+		return nil, errors.Errorf("custom header has no data: %v", custom)
+	}
+	stack := ixconfig.NewCustomStack(idx)
+	// The length of a custom header is in bits.
+	setSingleValue(stack.Length(), uintToStr(uint32(len(data)*8)))
+	setSingleValue(stack.Data(), ixconfig.String(hex.EncodeToString(data)))
+	return stack.TrafficStack(), nil
+}
+
 func httpStack(http *opb.HttpHeader, idx int) (*ixconfig.TrafficStack, error) {
 	return ixconfig.NewHTTP_GETStack(idx).TrafficStack(), nil
 }
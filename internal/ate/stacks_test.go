@@ -923,6 +923,182 @@ func TestHeaderStacks(t *testing.T) {
 				},
 			}},
 		},
+	}, {
+		desc: "VXLAN header",
+		hdr: &opb.Header{
+			Type: &opb.Header_Vxlan{
+				&opb.VxlanHeader{
+					Vni: &opb.UIntRange{Min: 5000, Max: 5000, Count: 1},
+				},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "vni",
+				wantVal: ixconfig.String(strconv.Itoa(5000)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					v := ixconfig.VxlanStack(*s)
+					return (&v).Vni()
+				},
+			}},
+		},
+	}, {
+		desc: "GENEVE header",
+		hdr: &opb.Header{
+			Type: &opb.Header_Geneve{
+				&opb.GeneveHeader{
+					Vni:           &opb.UIntRange{Min: 5000, Max: 5000, Count: 1},
+					ProtocolType:  0x6558,
+					ControlPacket: true,
+				},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "vni",
+				wantVal: ixconfig.String(strconv.Itoa(5000)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GeneveStack(*s)
+					return (&g).Vni()
+				},
+			}, {
+				name:    "protocol type",
+				wantVal: ixconfig.String("6558"),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GeneveStack(*s)
+					return (&g).ProtocolType()
+				},
+			}, {
+				name:    "control packet",
+				wantVal: ixconfig.String(strconv.Itoa(1)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GeneveStack(*s)
+					return (&g).ControlPacket()
+				},
+			}},
+		},
+	}, {
+		desc: "GTP-U header",
+		hdr: &opb.Header{
+			Type: &opb.Header_Gtpu{
+				&opb.GtpuHeader{
+					Teid:        &opb.UIntRange{Min: 100, Max: 100, Count: 1},
+					MessageType: 255,
+				},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "teid",
+				wantVal: ixconfig.String(strconv.Itoa(100)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuStack(*s)
+					return (&g).Teid()
+				},
+			}, {
+				name:    "message type",
+				wantVal: ixconfig.String(strconv.Itoa(255)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuStack(*s)
+					return (&g).Type()
+				},
+			}},
+		},
+	}, {
+		desc: "GTP-U header with PDU session container",
+		hdr: &opb.Header{
+			Type: &opb.Header_Gtpu{
+				&opb.GtpuHeader{
+					Teid:        &opb.UIntRange{Min: 100, Max: 100, Count: 1},
+					MessageType: 255,
+					PduSessionContainer: &opb.GtpuHeader_PduSessionContainer{
+						PduType: opb.GtpuHeader_PduSessionContainer_UPLINK,
+						Qfi:     &opb.UIntRange{Min: 9, Max: 9, Count: 1},
+					},
+				},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "teid",
+				wantVal: ixconfig.String(strconv.Itoa(100)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuStack(*s)
+					return (&g).Teid()
+				},
+			}, {
+				name:    "extension header flag",
+				wantVal: ixconfig.String(strconv.Itoa(1)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuStack(*s)
+					return (&g).E()
+				},
+			}},
+			[]wantField{{
+				name:    "next extension header type",
+				wantVal: ixconfig.String("85"),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuOptionalFieldsStack(*s)
+					return (&g).NextExtHdrField()
+				},
+			}},
+			[]wantField{{
+				name:    "pdu type",
+				wantVal: ixconfig.String(strconv.Itoa(1)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuExtensionPduSessionContainerStack(*s)
+					return (&g).PduType()
+				},
+			}, {
+				name:    "qfi",
+				wantVal: ixconfig.String(strconv.Itoa(9)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					g := ixconfig.GtpuExtensionPduSessionContainerStack(*s)
+					return (&g).Qfi()
+				},
+			}},
+		},
+	}, {
+		desc: "ethernet header w/ ether type",
+		hdr: &opb.Header{
+			Type: &opb.Header_Eth{
+				&opb.EthernetHeader{EtherType: 0x88b5},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "ether type",
+				wantVal: ixconfig.String("88b5"),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					e := ixconfig.EthernetStack(*s)
+					return (&e).EtherType()
+				},
+			}},
+		},
+	}, {
+		desc: "custom header",
+		hdr: &opb.Header{
+			Type: &opb.Header_Custom{
+				&opb.CustomHeader{Data: []byte{0xde, 0xad, 0xbe, 0xef}},
+			},
+		},
+		wantFields: [][]wantField{
+			[]wantField{{
+				name:    "length",
+				wantVal: ixconfig.String(strconv.Itoa(32)),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					c := ixconfig.CustomStack(*s)
+					return (&c).Length()
+				},
+			}, {
+				name:    "data",
+				wantVal: ixconfig.String("deadbeef"),
+				toField: func(s *ixconfig.TrafficStack) *ixconfig.TrafficField {
+					c := ixconfig.CustomStack(*s)
+					return (&c).Data()
+				},
+			}},
+		},
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -976,6 +1152,28 @@ func TestHeaderStacksError(t *testing.T) {
 		},
 		idx:     1,
 		wantErr: "bad CRC",
+	}, {
+		desc: "GTP-U PDU session container type unspecified",
+		hdr: &opb.Header{
+			Type: &opb.Header_Gtpu{
+				&opb.GtpuHeader{PduSessionContainer: &opb.GtpuHeader_PduSessionContainer{}},
+			},
+		},
+		wantErr: "PDU session container type",
+	}, {
+		desc: "ether type too large",
+		hdr: &opb.Header{
+			Type: &opb.Header_Eth{
+				&opb.EthernetHeader{EtherType: 0x10000},
+			},
+		},
+		wantErr: "16-bit",
+	}, {
+		desc: "custom header without data",
+		hdr: &opb.Header{
+			Type: &opb.Header_Custom{&opb.CustomHeader{}},
+		},
+		wantErr: "no data",
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
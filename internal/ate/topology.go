@@ -25,6 +25,12 @@ import (
 	opb "github.com/openconfig/ondatra/proto"
 )
 
+// (b/192980845) Configure more neighbor solicitations spaced further apart to workaround IPv6 failures behind LACP.
+const (
+	defaultNSCount        = 8
+	defaultNSRetransmitMs = 7000
+)
+
 func (ix *ixATE) addPorts(top *opb.Topology) error {
 	ports := make(map[string]bool)
 	portToLag := make(map[string]*opb.Lag)
@@ -59,9 +65,8 @@ func (ix *ixATE) addPorts(top *opb.Topology) error {
 			L1Config: &ixconfig.VportL1Config{},
 			ProtocolStack: &ixconfig.VportProtocolStack{
 				Options: &ixconfig.VportProtocolStackOptions{
-					// (b/192980845) Configure more neighbor solicitations spaced further apart to workaround IPv6 failures behind LACP.
-					McastSolicit: ixconfig.NumberUint32(8),
-					RetransTime:  ixconfig.NumberUint32(7000),
+					McastSolicit: ixconfig.NumberUint32(defaultNSCount),
+					RetransTime:  ixconfig.NumberUint32(defaultNSRetransmitMs),
 				},
 			},
 		}
@@ -70,6 +75,17 @@ func (ix *ixATE) addPorts(top *opb.Topology) error {
 		aresOneFourHundredGigLan(vport.L1Config).AutoInstrumentation = ixconfig.String(portInstrumentation)
 		novusHundredGigLan(vport.L1Config).AutoInstrumentation = ixconfig.String(portInstrumentation)
 		novusTenGigLan(vport.L1Config).AutoInstrumentation = ixconfig.String(portInstrumentation)
+		if b := ix.portBreakouts[port]; b != nil {
+			speed, ok := l1Speeds[b.GetChannelSpeedGbps()]
+			if !ok {
+				return usererr.New("unsupported channel speed %d Gbps of breakout port %s", b.GetChannelSpeedGbps(), port)
+			}
+			aresOneFourHundredGigLan(vport.L1Config).Speed = ixconfig.String(speed)
+			atlasFourHundredGigLan(vport.L1Config).Speed = ixconfig.String(speed)
+			krakenFourHundredGigLan(vport.L1Config).Speed = ixconfig.String(speed)
+			novusHundredGigLan(vport.L1Config).Speed = ixconfig.String(speed)
+			uhdOneHundredGigLan(vport.L1Config).Speed = ixconfig.String(speed)
+		}
 		ix.cfg.Vport = append(ix.cfg.Vport, vport)
 		ix.ports[port] = vport
 	}
@@ -90,9 +106,7 @@ func (ix *ixATE) addLAGs(lags []*opb.Lag) error {
 		}
 		if ol.GetLacp().GetEnabled() {
 			lag.LagMode.LagProtocol = ixconfig.MultivalueStr("lacp")
-			lag.ProtocolStack.Ethernet[0].Lagportlacp = []*ixconfig.LagLagportlacp{{
-				Multiplier: ixconfig.NumberFloat64(1),
-			}}
+			lag.ProtocolStack.Ethernet[0].Lagportlacp = []*ixconfig.LagLagportlacp{lacpCfg(ol.GetLacp())}
 		} else {
 			lag.LagMode.LagProtocol = ixconfig.MultivalueStr("staticlag")
 			lag.ProtocolStack.Ethernet[0].Lagportstaticlag = []*ixconfig.LagLagportstaticlag{{
@@ -123,6 +137,21 @@ func (ix *ixATE) addLAGs(lags []*opb.Lag) error {
 	return nil
 }
 
+// lacpCfg returns the LACP config of a LAG, only setting the LACP options that
+// are set, so the IxNetwork defaults apply otherwise.
+func lacpCfg(lacp *opb.Lag_Lacp) *ixconfig.LagLagportlacp {
+	cfg := &ixconfig.LagLagportlacp{Multiplier: ixconfig.NumberFloat64(1)}
+	if lacp.GetPassive() {
+		cfg.LacpActivity = ixconfig.MultivalueStr("passive")
+	}
+	if lacp.GetSlowRate() {
+		// The periodic interval and timeout are in seconds.
+		cfg.LacpduPeriodicTimeInterval = ixconfig.MultivalueUint32(30)
+		cfg.LacpduTimeout = ixconfig.MultivalueUint32(90)
+	}
+	return cfg
+}
+
 // addTopology adds an IxNetwork topology with ports assigned and device groups created with ethernet configuration.
 // Each interface config must apply to the same set of ports.
 func (ix *ixATE) addTopology(ifs []*opb.InterfaceConfig) {
@@ -141,6 +141,56 @@ func TestAddPortsErrors(t *testing.T) {
 	}
 }
 
+func TestAddBreakoutPort(t *testing.T) {
+	const port = "1/1.2"
+	tests := []struct {
+		desc      string
+		breakout  *opb.Port_Breakout
+		wantSpeed string
+		wantErr   string
+	}{{
+		desc:      "4x25G",
+		breakout:  &opb.Port_Breakout{NumChannels: 4, ChannelSpeedGbps: 25},
+		wantSpeed: "speed25g",
+	}, {
+		desc:     "unsupported channel speed",
+		breakout: &opb.Port_Breakout{NumChannels: 4, ChannelSpeedGbps: 1},
+		wantErr:  "unsupported channel speed",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := &ixATE{
+				name:          "ixia1",
+				cfg:           &ixconfig.Ixnetwork{},
+				ports:         make(map[string]*ixconfig.Vport),
+				portBreakouts: map[string]*opb.Port_Breakout{port: test.breakout},
+			}
+			top := &opb.Topology{
+				Interfaces: []*opb.InterfaceConfig{{Link: &opb.InterfaceConfig_Port{port}}},
+			}
+			err := c.addPorts(top)
+			if (err == nil) != (test.wantErr == "") || (err != nil && !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("addPorts: got err %v, want err %q", err, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			l1 := c.ports[port].L1Config
+			for _, got := range []*string{
+				l1.AresOneFourHundredGigLan.Speed,
+				l1.AtlasFourHundredGigLan.Speed,
+				l1.KrakenFourHundredGigLan.Speed,
+				l1.NovusHundredGigLan.Speed,
+				l1.UhdOneHundredGigLan.Speed,
+			} {
+				if got == nil || *got != test.wantSpeed {
+					t.Errorf("addPorts: got L1 speed %v, want %q", got, test.wantSpeed)
+				}
+			}
+		})
+	}
+}
+
 func TestAddLAGs(t *testing.T) {
 	vport1 := &ixconfig.Vport{
 		Name:     ixconfig.String("ixia1/1/1"),
@@ -205,6 +255,34 @@ func TestAddLAGs(t *testing.T) {
 				Vports: []string{"/vport[1]", "/vport[2]"},
 			}},
 		},
+	}, {
+		desc: "passive lacp at slow rate",
+		lags: []*opb.Lag{{
+			Name:  "lacpLAG",
+			Ports: []string{"1/1", "2/2"},
+			Lacp:  &opb.Lag_Lacp{Enabled: true, Passive: true, SlowRate: true},
+		}},
+		wantCfg: &ixconfig.Ixnetwork{
+			Vport: []*ixconfig.Vport{vport1, vport2},
+			Lag: []*ixconfig.Lag{{
+				Name:    ixconfig.String("ixia1/lacpLAG"),
+				LagMode: &ixconfig.LagLagMode{LagProtocol: ixconfig.MultivalueStr("lacp")},
+				ProtocolStack: &ixconfig.LagProtocolStack{
+					Enabled: ixconfig.MultivalueBool(true),
+					Ethernet: []*ixconfig.LagEthernet{{
+						Multiplier: ixconfig.NumberFloat64(1),
+						Lagportlacp: []*ixconfig.LagLagportlacp{{
+							Multiplier:                 ixconfig.NumberFloat64(1),
+							LacpActivity:               ixconfig.MultivalueStr("passive"),
+							LacpduPeriodicTimeInterval: ixconfig.MultivalueUint32(30),
+							LacpduTimeout:              ixconfig.MultivalueUint32(90),
+						}},
+					}},
+					Multiplier: ixconfig.NumberFloat64(1),
+				},
+				Vports: []string{"/vport[1]", "/vport[2]"},
+			}},
+		},
 	}, {
 		desc: "port in multiple lags",
 		lags: []*opb.Lag{{
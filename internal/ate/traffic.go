@@ -15,7 +15,9 @@
 package ate
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net"
 	"path"
 
 	"github.com/pkg/errors"
@@ -32,6 +34,24 @@ const (
 	ipv4Traffic trafficType = "ipv4"
 	ipv6Traffic trafficType = "ipv6"
 	rawTraffic  trafficType = "raw"
+
+	ipv4AppTraffic trafficType = "ipv4ApplicationTraffic"
+	ipv6AppTraffic trafficType = "ipv6ApplicationTraffic"
+)
+
+// appLibFlows maps application protocols to the IxNetwork application library
+// flows that emulate them.
+var appLibFlows = map[opb.ApplicationTraffic_Protocol]string{
+	opb.ApplicationTraffic_HTTP_GET: "Bandwidth_HTTP",
+	opb.ApplicationTraffic_TCP:      "Bandwidth_Raw",
+	opb.ApplicationTraffic_UDP:      "Echo_UDP",
+}
+
+// IANA-assigned UDP ports of tunnel encapsulations.
+const (
+	vxlanPort  = 4789
+	genevePort = 6081
+	gtpuPort   = 2152
 )
 
 var imixPreset = map[opb.FrameSize_ImixPreset]string{
@@ -52,17 +72,154 @@ type headers struct {
 	ipv6 *opb.Ipv6Header
 }
 
+// validateTraffic checks the flows against the pushed topology, so that
+// misconfigured flows fail with an actionable error before any traffic
+// configuration is sent to the Ixia.
+func (ix *ixATE) validateTraffic(flows []*opb.Flow) error {
+	names := make(map[string]bool)
+	for _, f := range flows {
+		if f.GetName() == "" {
+			return usererr.New("flow has no name: %v", f)
+		}
+		if names[f.GetName()] {
+			return usererr.New("duplicate flow name %q", f.GetName())
+		}
+		names[f.GetName()] = true
+		hdrs := &headers{}
+		if f.GetApplication() == nil {
+			var err error
+			if hdrs, err = resolveHeaders(f.GetHeaders()); err != nil {
+				return usererr.Wrapf(err, "bad header spec for flow %q", f.GetName())
+			}
+		}
+		if err := ix.validateEndpoints(f, hdrs, f.GetSrcEndpoints(), true); err != nil {
+			return err
+		}
+		if err := ix.validateEndpoints(f, hdrs, f.GetDstEndpoints(), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ix *ixATE) validateEndpoints(f *opb.Flow, hdrs *headers, eps []*opb.Flow_Endpoint, isSrcEP bool) error {
+	kind := "destination"
+	if isSrcEP {
+		kind = "source"
+	}
+	if len(eps) == 0 {
+		return usererr.New("flow %q has no %s endpoints", f.GetName(), kind)
+	}
+	// IP addresses left unset in the headers are inferred from the endpoint
+	// interfaces, so the interfaces need an address of that IP family.
+	ipv4HdrAddr, ipv6HdrAddr := hdrs.ipv4.GetDstAddr(), hdrs.ipv6.GetDstAddr()
+	if isSrcEP {
+		ipv4HdrAddr, ipv6HdrAddr = hdrs.ipv4.GetSrcAddr(), hdrs.ipv6.GetSrcAddr()
+	}
+	for _, ep := range eps {
+		intf, ok := ix.intfs[ep.GetInterfaceName()]
+		if !ok {
+			// Traffic items are scoped to an IxNetwork session, which spans a
+			// single ATE, so a flow cannot send or receive on another ATE.
+			if other := ateWithIntf(ix, ep.GetInterfaceName()); other != "" {
+				return usererr.New("flow %q has %s endpoint on interface %q of ATE %s, but flows on ATE %s can only have endpoints on that ATE", f.GetName(), kind, ep.GetInterfaceName(), other, ix.name)
+			}
+			return usererr.New("flow %q has %s endpoint on interface %q, which is not in the pushed topology", f.GetName(), kind, ep.GetInterfaceName())
+		}
+		switch ep.GetGenerated().(type) {
+		case nil:
+			if hdrs.ipv4 != nil && ipv4HdrAddr == nil && ipv4Addr(intf) == "" {
+				return usererr.New("flow %q has an IPv4 header but %s endpoint interface %q has no IPv4 address", f.GetName(), kind, ep.GetInterfaceName())
+			}
+			if hdrs.ipv6 != nil && ipv6HdrAddr == nil && ipv6Addr(intf) == "" {
+				return usererr.New("flow %q has an IPv6 header but %s endpoint interface %q has no IPv6 address", f.GetName(), kind, ep.GetInterfaceName())
+			}
+		case *opb.Flow_Endpoint_NetworkName:
+			if _, ok := intf.netToNetworkGroup[ep.GetNetworkName()]; !ok {
+				return usererr.New("flow %q has %s endpoint on network %q, which is not on interface %q", f.GetName(), kind, ep.GetNetworkName(), ep.GetInterfaceName())
+			}
+		case *opb.Flow_Endpoint_RsvpName:
+			if _, ok := intf.rsvpLSPs[ep.GetRsvpName()]; !ok {
+				return usererr.New("flow %q has %s endpoint on RSVP LSP %q, which is not on interface %q", f.GetName(), kind, ep.GetRsvpName(), ep.GetInterfaceName())
+			}
+		case *opb.Flow_Endpoint_IgmpGroups, *opb.Flow_Endpoint_MldGroups:
+			if isSrcEP {
+				return usererr.New("flow %q has multicast groups on interface %q as a source endpoint, but they can only be destination endpoints", f.GetName(), ep.GetInterfaceName())
+			}
+			if ep.GetIgmpGroups() && intf.igmpGroups == nil {
+				return usererr.New("flow %q has IGMP groups endpoint on interface %q, which has no IGMP host", f.GetName(), ep.GetInterfaceName())
+			}
+			if ep.GetMldGroups() && intf.mldGroups == nil {
+				return usererr.New("flow %q has MLD groups endpoint on interface %q, which has no MLD host", f.GetName(), ep.GetInterfaceName())
+			}
+		}
+	}
+	return nil
+}
+
 func (ix *ixATE) addTraffic(flows []*opb.Flow) error {
 	ix.cfg.Traffic = &ixconfig.Traffic{UseRfc5952: ixconfig.Bool(true)}
+	var measureLatency, measureConvergence, checkIntegrity bool
 	for _, f := range flows {
 		if err := ix.addTrafficItem(f); err != nil {
 			return err
 		}
+		measureLatency = measureLatency || f.GetMeasureLatency()
+		measureConvergence = measureConvergence || f.GetMeasureConvergence()
+		checkIntegrity = checkIntegrity || f.GetCheckIntegrity()
+	}
+	// Ixia measures latency and convergence for all traffic items or none of them.
+	if measureLatency {
+		ix.cfg.Traffic.Statistics = latencyStatsCfg()
+	}
+	if measureConvergence {
+		if ix.cfg.Traffic.Statistics == nil {
+			ix.cfg.Traffic.Statistics = &ixconfig.TrafficStatistics{}
+		}
+		ix.cfg.Traffic.Statistics.CpdpConvergence = convergenceStatsCfg()
+	}
+	// Ixia checks payload integrity for all traffic items or none of them.
+	if checkIntegrity {
+		if ix.cfg.Traffic.Statistics == nil {
+			ix.cfg.Traffic.Statistics = &ixconfig.TrafficStatistics{}
+		}
+		ix.cfg.Traffic.Statistics.DataIntegrity = &ixconfig.TrafficDataIntegrity{Enabled: ixconfig.Bool(true)}
 	}
 	return nil
 }
 
+// convergenceStatsCfg returns the traffic statistics config that enables
+// control plane/data plane convergence measurement. The data plane is
+// considered converged when the receive rate recovers to 95% of the expected
+// rate.
+func convergenceStatsCfg() *ixconfig.TrafficCpdpConvergence {
+	return &ixconfig.TrafficCpdpConvergence{
+		Enabled:                          ixconfig.Bool(true),
+		EnableControlPlaneEvents:         ixconfig.Bool(true),
+		EnableDataPlaneEventsRateMonitor: ixconfig.Bool(true),
+		DataPlaneThreshold:               ixconfig.NumberInt(95),
+	}
+}
+
+// latencyStatsCfg returns the traffic statistics config that enables
+// store-and-forward latency and delay variation (jitter) measurement.
+func latencyStatsCfg() *ixconfig.TrafficStatistics {
+	return &ixconfig.TrafficStatistics{
+		// Latency must be disabled when delay variation is enabled; the delay
+		// variation statistics include the min, average, and max latency.
+		Latency: &ixconfig.TrafficLatency{Enabled: ixconfig.Bool(false)},
+		DelayVariation: &ixconfig.TrafficDelayVariation{
+			Enabled:        ixconfig.Bool(true),
+			LatencyMode:    ixconfig.String("storeForward"),
+			StatisticsMode: ixconfig.String("rxDelayVariationAverage"),
+		},
+	}
+}
+
 func (ix *ixATE) addTrafficItem(f *opb.Flow) error {
+	if f.GetApplication() != nil {
+		return ix.addAppTrafficItem(f)
+	}
 	hdrs, err := resolveHeaders(f.GetHeaders())
 	if err != nil {
 		return usererr.Wrapf(err, "bad header spec for flow %q", f.GetName())
@@ -116,6 +273,10 @@ func (ix *ixATE) addTrafficItem(f *opb.Flow) error {
 	if err != nil {
 		return errors.Wrapf(err, "could not compute transmission control for flow %q", f.GetName())
 	}
+	fp, err := framePayload(f.GetPayload())
+	if err != nil {
+		return errors.Wrapf(err, "could not compute payload for flow %q", f.GetName())
+	}
 	var crc *string
 	if hdrs.eth.GetBadCrc() {
 		crc = ixconfig.String("badCrc")
@@ -136,6 +297,7 @@ func (ix *ixATE) addTrafficItem(f *opb.Flow) error {
 			FrameRate:           fr,
 			FrameSize:           fs,
 			TransmissionControl: tc,
+			FramePayload:        fp,
 			Crc:                 crc,
 		}},
 	}
@@ -150,15 +312,16 @@ func (ix *ixATE) addTrafficItem(f *opb.Flow) error {
 	ti.Tracking = []*ixconfig.TrafficTracking{ingressTracking}
 
 	if f.GetEgressTracking() != nil {
+		egressTracking, err := egressTrackingCfg(f)
+		if err != nil {
+			return errors.Wrapf(err, "could not configure egress tracking for flow %q", f.GetName())
+		}
 		ix.egressTrackingFlows = append(ix.egressTrackingFlows, f.GetName())
 		ti.EgressEnabled = ixconfig.Bool(true)
-		ti.EgressTracking = []*ixconfig.TrafficEgressTracking{{
-			Encapsulation:    ixconfig.String("Any: Use Custom Settings"),
-			CustomOffsetBits: ixconfig.NumberUint32(f.GetEgressTracking().GetCustomOffset()),
-			CustomWidthBits:  ixconfig.NumberUint32(f.GetEgressTracking().GetCustomWidth()),
-		}}
+		ti.EgressTracking = []*ixconfig.TrafficEgressTracking{egressTracking}
 	}
 
+	inferTunnelPorts(f.GetHeaders())
 	stacks := make([]*ixconfig.TrafficStack, 0)
 	for _, hdr := range f.GetHeaders() {
 		s, err := headerStacks(hdr, len(stacks))
@@ -174,6 +337,84 @@ func (ix *ixATE) addTrafficItem(f *opb.Flow) error {
 	return nil
 }
 
+// addAppTrafficItem adds a traffic item that emulates the application sessions
+// of the flow with the application library. The library builds the packets,
+// so the headers, frame rate, frame size, and tracking of the flow do not apply.
+func (ix *ixATE) addAppTrafficItem(f *opb.Flow) error {
+	app := f.GetApplication()
+	if len(f.GetHeaders()) > 0 {
+		return usererr.New("flow %q has both headers and application traffic", f.GetName())
+	}
+	if len(app.GetProtocols()) == 0 {
+		return usererr.New("application traffic of flow %q has no protocols", f.GetName())
+	}
+	var appFlows []string
+	for _, p := range app.GetProtocols() {
+		appFlow, ok := appLibFlows[p]
+		if !ok {
+			return usererr.New("application traffic of flow %q has unsupported protocol %v", f.GetName(), p)
+		}
+		appFlows = append(appFlows, appFlow)
+	}
+	srcEPs := f.GetSrcEndpoints()
+	dstEPs := f.GetDstEndpoints()
+	if len(srcEPs) == 0 || len(dstEPs) == 0 {
+		return usererr.New("flow %q needs both source and destination endpoints defined", f.GetName())
+	}
+	eps := append(append([]*opb.Flow_Endpoint{}, srcEPs...), dstEPs...)
+	hasIPv4, hasIPv6 := true, true
+	for _, ep := range eps {
+		intf, ok := ix.intfs[ep.GetInterfaceName()]
+		if !ok {
+			return usererr.New("no interface configured for endpoint %v", ep)
+		}
+		if ep.GetGenerated() != nil {
+			return usererr.New("application traffic of flow %q can only have interface endpoints, got %v", f.GetName(), ep)
+		}
+		hasIPv4 = hasIPv4 && ipv4Addr(intf) != ""
+		hasIPv6 = hasIPv6 && ipv6Addr(intf) != ""
+	}
+	var trafType trafficType
+	switch {
+	case hasIPv4:
+		trafType = ipv4AppTraffic
+	case hasIPv6:
+		trafType = ipv6AppTraffic
+	default:
+		return usererr.New("application traffic of flow %q needs endpoint interfaces that all have an IPv4 or all have an IPv6 address", f.GetName())
+	}
+	srcs, err := devOrGeneratedEPs(srcEPs, ix.intfs, true)
+	if err != nil {
+		return errors.Wrapf(err, "could not find source endpoint paths for flow %q", f.GetName())
+	}
+	dsts, err := devOrGeneratedEPs(dstEPs, ix.intfs, false)
+	if err != nil {
+		return errors.Wrapf(err, "could not find dest endpoint paths for flow %q", f.GetName())
+	}
+
+	profile := &ixconfig.TrafficAppLibProfile{ConfiguredFlows: appFlows}
+	if n := app.GetConcurrentSessions(); n > 0 {
+		profile.ObjectiveType = ixconfig.String("simulatedUsers")
+		profile.ObjectiveValue = ixconfig.NumberUint32(n)
+		profile.ObjectiveDistribution = ixconfig.String("applyFullObjectiveToEachPort")
+	}
+	ti := &ixconfig.TrafficTrafficItem{
+		Name:        ixconfig.String(f.GetName()),
+		TrafficType: ixconfig.String(string(trafType)),
+		EndpointSet: []*ixconfig.TrafficEndpointSet{{
+			Name:                 ixconfig.String(f.GetName()),
+			Sources:              srcs,
+			Destinations:         dsts,
+			FullyMeshedEndpoints: []string{},
+			TrafficGroups:        []string{},
+		}},
+		AppLibProfile: []*ixconfig.TrafficAppLibProfile{profile},
+	}
+	ix.flowToTrafficItem[f.GetName()] = ti
+	ix.cfg.Traffic.TrafficItem = append(ix.cfg.Traffic.TrafficItem, ti)
+	return nil
+}
+
 func resolveHeaders(flowHdrs []*opb.Header) (*headers, error) {
 	if len(flowHdrs) == 0 {
 		return nil, usererr.New("must specify at least one header")
@@ -210,6 +451,10 @@ func resolveTrafficType(hdrs *headers, srcEPs, dstEPs []*opb.Flow_Endpoint) (tra
 		return "", usererr.New("cannot use RSVP endpoint for non-IP traffic")
 	}
 
+	if hasMulticastEP(dstEPs) {
+		return "", usererr.New("cannot use multicast group endpoint for non-IP traffic")
+	}
+
 	// Traffic with a Network endpoint cannot not have any addresses set.
 	if hasNetworkEP(srcEPs) || hasNetworkEP(dstEPs) {
 		if ethAddrsSet {
@@ -243,16 +488,30 @@ func hasRSVPEP(eps []*opb.Flow_Endpoint) bool {
 	return false
 }
 
+func hasMulticastEP(eps []*opb.Flow_Endpoint) bool {
+	for _, ep := range eps {
+		switch ep.GetGenerated().(type) {
+		case *opb.Flow_Endpoint_IgmpGroups, *opb.Flow_Endpoint_MldGroups:
+			return true
+		}
+	}
+	return false
+}
+
 func inferAddresses(hdrs *headers, srcEPs, dstEPs []*opb.Flow_Endpoint, intfs map[string]*intf) {
 	if hdrs.eth.GetSrcAddr() == nil {
 		hdrs.eth.SrcAddr = inferAddr(srcEPs, intfs, func(i *intf) string { return i.ethMac })
 	}
 	if hdrs.eth.GetDstAddr() == nil {
-		gatewayMac := inferAddr(srcEPs, intfs, func(i *intf) string { return i.resolvedIpv4Mac })
-		if gatewayMac == nil {
-			gatewayMac = inferAddr(srcEPs, intfs, func(i *intf) string { return i.resolvedIpv6Mac })
+		if mac := multicastMAC(hdrs); mac != "" {
+			hdrs.eth.DstAddr = &opb.AddressRange{Min: mac, Max: mac, Count: 1}
+		} else {
+			gatewayMac := inferAddr(srcEPs, intfs, func(i *intf) string { return i.resolvedIpv4Mac })
+			if gatewayMac == nil {
+				gatewayMac = inferAddr(srcEPs, intfs, func(i *intf) string { return i.resolvedIpv6Mac })
+			}
+			hdrs.eth.DstAddr = gatewayMac
 		}
-		hdrs.eth.DstAddr = gatewayMac
 	}
 	if hdrs.ipv4 != nil {
 		if hdrs.ipv4.GetSrcAddr() == nil {
@@ -272,6 +531,48 @@ func inferAddresses(hdrs *headers, srcEPs, dstEPs []*opb.Flow_Endpoint, intfs ma
 	}
 }
 
+// inferTunnelPorts sets the destination port of each UDP header that precedes
+// a VXLAN, GENEVE, or GTP-U header to the IANA-assigned port, unless already set.
+func inferTunnelPorts(hdrs []*opb.Header) {
+	for i := 1; i < len(hdrs); i++ {
+		udp := hdrs[i-1].GetUdp()
+		if udp == nil || udp.GetDstPort() != nil {
+			continue
+		}
+		switch hdrs[i].Type.(type) {
+		case *opb.Header_Vxlan:
+			udp.DstPort = &opb.UIntRange{Min: vxlanPort, Max: vxlanPort, Count: 1}
+		case *opb.Header_Geneve:
+			udp.DstPort = &opb.UIntRange{Min: genevePort, Max: genevePort, Count: 1}
+		case *opb.Header_Gtpu:
+			udp.DstPort = &opb.UIntRange{Min: gtpuPort, Max: gtpuPort, Count: 1}
+		}
+	}
+}
+
+// multicastMAC returns the MAC address mapped to the IP destination of the
+// headers, if it is a single multicast address, per RFC 1112 and RFC 2464.
+func multicastMAC(hdrs *headers) string {
+	var dst *opb.AddressRange
+	switch {
+	case hdrs.ipv4 != nil:
+		dst = hdrs.ipv4.GetDstAddr()
+	case hdrs.ipv6 != nil:
+		dst = hdrs.ipv6.GetDstAddr()
+	}
+	if dst.GetCount() > 1 || len(dst.GetValues()) > 0 || dst.GetMin() != dst.GetMax() {
+		return ""
+	}
+	ip := net.ParseIP(dst.GetMin())
+	if ip == nil || !ip.IsMulticast() {
+		return ""
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return net.HardwareAddr{0x01, 0x00, 0x5e, ip4[1] & 0x7f, ip4[2], ip4[3]}.String()
+	}
+	return net.HardwareAddr{0x33, 0x33, ip[12], ip[13], ip[14], ip[15]}.String()
+}
+
 func inferAddr(eps []*opb.Flow_Endpoint, intfs map[string]*intf, addrFn func(*intf) string) *opb.AddressRange {
 	for _, ep := range eps {
 		intf, _ := intfs[ep.GetInterfaceName()]
@@ -348,6 +649,22 @@ func devOrGeneratedEPs(eps []*opb.Flow_Endpoint, intfs map[string]*intf, isSrcEP
 			if isSrcEP {
 				p = rsvp.RsvpP2PIngressLsps.XPath().String()
 			}
+		case *opb.Flow_Endpoint_IgmpGroups:
+			if isSrcEP {
+				return nil, usererr.New("multicast groups can only be destination endpoints: %v", ep)
+			}
+			if intf.igmpGroups == nil {
+				return nil, usererr.New("no IGMP host associated with endpoint %v", ep)
+			}
+			p = intf.igmpGroups.XPath().String()
+		case *opb.Flow_Endpoint_MldGroups:
+			if isSrcEP {
+				return nil, usererr.New("multicast groups can only be destination endpoints: %v", ep)
+			}
+			if intf.mldGroups == nil {
+				return nil, usererr.New("no MLD host associated with endpoint %v", ep)
+			}
+			p = intf.mldGroups.XPath().String()
 		default:
 			return nil, usererr.New("unrecognized endpoint type %T", ept)
 		}
@@ -420,6 +737,21 @@ func frameSize(fs *opb.FrameSize) (*ixconfig.TrafficFrameSize, map[string]interf
 		tfs.RandomMax = ixconfig.NumberUint32(fs.GetRandom().GetMax())
 		fsMap["randomMin"] = fs.GetRandom().GetMin()
 		fsMap["randomMax"] = fs.GetRandom().GetMax()
+	case *opb.FrameSize_Increment_:
+		inc := fs.GetIncrement()
+		if inc.GetStep() == 0 {
+			return nil, nil, usererr.New("frame size increment step must be positive")
+		}
+		if inc.GetMin() > inc.GetMax() {
+			return nil, nil, usererr.New("frame size increment min %d is greater than max %d", inc.GetMin(), inc.GetMax())
+		}
+		tfs.Type_ = ixconfig.String("increment")
+		tfs.IncrementFrom = ixconfig.NumberUint32(inc.GetMin())
+		tfs.IncrementTo = ixconfig.NumberUint32(inc.GetMax())
+		tfs.IncrementStep = ixconfig.NumberUint32(inc.GetStep())
+		fsMap["incrementFrom"] = inc.GetMin()
+		fsMap["incrementTo"] = inc.GetMax()
+		fsMap["incrementStep"] = inc.GetStep()
 	case *opb.FrameSize_ImixCustom_:
 		tfs.Type_ = ixconfig.String("weightedPairs")
 		for _, entry := range fs.GetImixCustom().GetEntries() {
@@ -432,12 +764,44 @@ func frameSize(fs *opb.FrameSize) (*ixconfig.TrafficFrameSize, map[string]interf
 	return tfs, fsMap, nil
 }
 
+func framePayload(p *opb.Payload) (*ixconfig.TrafficFramePayload, error) {
+	switch p.GetType() {
+	case opb.Payload_TYPE_UNSPECIFIED:
+		if p.GetFixedPattern() != "" {
+			return nil, usererr.New("fixed pattern set for payload of type %v", p.GetType())
+		}
+		return nil, nil
+	case opb.Payload_FIXED:
+		pattern := p.GetFixedPattern()
+		if pattern == "" {
+			return nil, usererr.New("no pattern specified for fixed payload")
+		}
+		if _, err := hex.DecodeString(pattern); err != nil {
+			return nil, usererr.Wrapf(err, "fixed payload pattern %q is not an even number of hex digits", pattern)
+		}
+		return &ixconfig.TrafficFramePayload{
+			Type_:         ixconfig.String("custom"),
+			CustomPattern: ixconfig.String(pattern),
+			CustomRepeat:  ixconfig.Bool(true),
+		}, nil
+	case opb.Payload_INCREMENTING:
+		return &ixconfig.TrafficFramePayload{Type_: ixconfig.String("incrementByte")}, nil
+	case opb.Payload_PRBS:
+		return &ixconfig.TrafficFramePayload{Type_: ixconfig.String("PRBS")}, nil
+	default:
+		return nil, usererr.New("unrecognized payload type %v", p.GetType())
+	}
+}
+
 func transmissionControl(tc *opb.Transmission) (*ixconfig.TrafficTransmissionControl, error) {
 	if tc == nil {
 		return nil, nil
 	}
 	switch tcp := tc.GetPattern(); tcp {
 	case opb.Transmission_CONTINUOUS:
+		if tc.GetBurstCount() != 0 {
+			return nil, usererr.New("burst count should not be set for continuous transmissions")
+		}
 		if tc.GetPacketsPerBurst() != 0 {
 			return nil, usererr.New("burst packet count should not be set for continuous transmissions")
 		}
@@ -467,33 +831,49 @@ func transmissionControl(tc *opb.Transmission) (*ixconfig.TrafficTransmissionCon
 		default:
 			return nil, fmt.Errorf("unrecognized burst gap type %T", ibg)
 		}
-		return &ixconfig.TrafficTransmissionControl{
+		txc := &ixconfig.TrafficTransmissionControl{
 			Type_:               ixconfig.String("custom"),
 			MinGapBytes:         ixconfig.NumberUint32(tc.GetMinGapBytes()),
 			BurstPacketCount:    ixconfig.NumberUint32(tc.GetPacketsPerBurst()),
 			EnableInterBurstGap: ixconfig.Bool(true),
 			InterBurstGap:       ixconfig.NumberUint32(burstGap),
 			InterBurstGapUnits:  ixconfig.String(burstGapUnits),
-		}, nil
+		}
+		if bc := tc.GetBurstCount(); bc != 0 {
+			txc.RepeatBurst = ixconfig.NumberUint32(bc)
+		}
+		return txc, nil
 	case opb.Transmission_FIXED_FRAME_COUNT:
+		if tc.GetBurstCount() != 0 {
+			return nil, usererr.New("burst count should not be set for fixed packet count transmissions")
+		}
 		if tc.GetPacketsPerBurst() != 0 {
 			return nil, usererr.New("burst packet count should not be set for fixed packet count transmissions")
 		}
 		if tc.GetInterburstGap() != nil {
 			return nil, usererr.New("burst gap should not be set for fixed packet count transmissions")
 		}
+		if tc.GetFrameCount() == 0 {
+			return nil, usererr.New("frame count must be a positive value for fixed packet count transmissions")
+		}
 		return &ixconfig.TrafficTransmissionControl{
 			Type_:       ixconfig.String("fixedFrameCount"),
 			MinGapBytes: ixconfig.NumberUint32(tc.GetMinGapBytes()),
 			FrameCount:  ixconfig.NumberUint32(tc.GetFrameCount()),
 		}, nil
 	case opb.Transmission_FIXED_DURATION:
+		if tc.GetBurstCount() != 0 {
+			return nil, usererr.New("burst count should not be set for fixed duration transmissions")
+		}
 		if tc.GetPacketsPerBurst() != 0 {
 			return nil, usererr.New("burst packet count should not be set for fixed duration transmissions")
 		}
 		if tc.GetInterburstGap() != nil {
 			return nil, usererr.New("burst gap should not be set for fixed duration transmissions")
 		}
+		if tc.GetDurationSecs() == 0 {
+			return nil, usererr.New("duration must be a positive value for fixed duration transmissions")
+		}
 		return &ixconfig.TrafficTransmissionControl{
 			Type_:       ixconfig.String("fixedDuration"),
 			MinGapBytes: ixconfig.NumberUint32(tc.GetMinGapBytes()),
@@ -542,3 +922,66 @@ func ingressTrackingCfg(f *opb.Flow, trafType trafficType) (*ixconfig.TrafficTra
 	}
 	return tracking, len(tracking.TrackBy) > 1, nil
 }
+
+const (
+	ethHeaderBits  = 112
+	vlanTagBits    = 32
+	mplsHeaderBits = 32
+)
+
+// egressTrackingCfg returns the egress tracking config for the flow. Filters
+// other than the custom filter are translated to a custom offset and width,
+// based on the position of the tracked field within the flow's headers.
+func egressTrackingCfg(f *opb.Flow) (*ixconfig.TrafficEgressTracking, error) {
+	et := f.GetEgressTracking()
+	offset, width := et.GetCustomOffset(), et.GetCustomWidth()
+	if et.GetFilter() != opb.EgressTracking_FILTER_CUSTOM {
+		var err error
+		offset, width, err = egressFilterField(f.GetHeaders(), et.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ixconfig.TrafficEgressTracking{
+		Encapsulation:    ixconfig.String("Any: Use Custom Settings"),
+		CustomOffsetBits: ixconfig.NumberUint32(offset),
+		CustomWidthBits:  ixconfig.NumberUint32(width),
+	}, nil
+}
+
+// egressFilterField returns the bit offset and width of the field tracked by
+// the specified filter, which is the first such field in the headers.
+func egressFilterField(hdrs []*opb.Header, filter opb.EgressTracking_Filter) (offset, width uint32, err error) {
+	for _, hdr := range hdrs {
+		switch v := hdr.GetType().(type) {
+		case *opb.Header_Eth:
+			if filter == opb.EgressTracking_FILTER_VLAN_ID && v.Eth.GetVlanId() != 0 {
+				// The 802.1Q tag displaces the EtherType, so its control information
+				// starts where the EtherType ends; the VLAN ID follows its first 4 bits.
+				return offset + ethHeaderBits + 4, 12, nil
+			}
+			offset += ethHeaderBits
+			if v.Eth.GetVlanId() != 0 {
+				offset += vlanTagBits
+			}
+		case *opb.Header_Mpls:
+			if filter == opb.EgressTracking_FILTER_MPLS_LABEL {
+				return offset, 20, nil
+			}
+			offset += mplsHeaderBits
+		case *opb.Header_Ipv4:
+			if filter == opb.EgressTracking_FILTER_DSCP {
+				return offset + 8, 6, nil
+			}
+			return 0, 0, usererr.New("no header with a field to track by egress filter %v", filter)
+		case *opb.Header_Ipv6:
+			if filter == opb.EgressTracking_FILTER_DSCP {
+				return offset + 4, 6, nil
+			}
+			return 0, 0, usererr.New("no header with a field to track by egress filter %v", filter)
+		default:
+			return 0, 0, usererr.New("cannot compute egress filter %v offset past header %v", filter, hdr)
+		}
+	}
+	return 0, 0, usererr.New("no header with a field to track by egress filter %v", filter)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
+	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/ixconfig"
 
 	opb "github.com/openconfig/ondatra/proto"
@@ -79,6 +80,28 @@ func TestInferAddresses(t *testing.T) {
 		wantHdrs: &headers{eth: &opb.EthernetHeader{
 			DstAddr: singleAddr(mac),
 		}},
+	}, {
+		desc:    "multicast ipv4 dst mac for eth",
+		ipv4Mac: mac,
+		hdrs: &headers{
+			eth:  &opb.EthernetHeader{},
+			ipv4: &opb.Ipv4Header{DstAddr: singleAddr("239.129.2.3")},
+		},
+		wantHdrs: &headers{
+			eth:  &opb.EthernetHeader{DstAddr: singleAddr("01:00:5e:01:02:03")},
+			ipv4: &opb.Ipv4Header{DstAddr: singleAddr("239.129.2.3")},
+		},
+	}, {
+		desc:    "multicast ipv6 dst mac for eth",
+		ipv6Mac: mac,
+		hdrs: &headers{
+			eth:  &opb.EthernetHeader{},
+			ipv6: &opb.Ipv6Header{DstAddr: singleAddr("ff0e::1:203:405")},
+		},
+		wantHdrs: &headers{
+			eth:  &opb.EthernetHeader{DstAddr: singleAddr("33:33:02:03:04:05")},
+			ipv6: &opb.Ipv6Header{DstAddr: singleAddr("ff0e::1:203:405")},
+		},
 	}, {
 		desc: "ipv4 addrs (same source/destination EP)",
 		ipv4: ipv4Addr,
@@ -137,12 +160,17 @@ func TestAddTraffic(t *testing.T) {
 		InterfaceName: ifName,
 		Generated:     &opb.Flow_Endpoint_RsvpName{RsvpName: rsvpName},
 	}}
+	igmpEPs := []*opb.Flow_Endpoint{{
+		InterfaceName: ifName,
+		Generated:     &opb.Flow_Endpoint_IgmpGroups{IgmpGroups: true},
+	}}
 	vportEPs := []string{"/vport[1]/protocols"}
 	lagEPs := []string{"/lag[1]"}
 	devGrpEPs := []string{"/topology[1]/deviceGroup[1]"}
 	netGrpEPs := []string{"/topology[1]/deviceGroup[1]/networkGroup[1]"}
 	egressLSPEPs := []string{"/topology[1]/deviceGroup[1]/networkGroup[2]/deviceGroup[1]/ipv4Loopback[1]/rsvpteLsps[1]/rsvpP2PEgressLsps"}
 	ingressLSPEPs := []string{"/topology[1]/deviceGroup[1]/networkGroup[2]/deviceGroup[1]/ipv4Loopback[1]/rsvpteLsps[1]/rsvpP2PIngressLsps"}
+	igmpGrpEPs := []string{"/topology[1]/deviceGroup[1]/ethernet[1]/ipv4[1]/igmpHost[1]/igmpMcastIPv4GroupList"}
 	baseClient := func() *ixATE {
 		cfg := &ixconfig.Ixnetwork{
 			Topology: []*ixconfig.Topology{{
@@ -174,6 +202,11 @@ func TestAddTraffic(t *testing.T) {
 			}},
 		}
 		dg.NetworkGroup = append(dg.NetworkGroup, rsvpNg)
+		// IGMP configuration
+		igmpGrps := &ixconfig.TopologyIgmpMcastIPv4GroupList{}
+		dg.Ethernet[0].Ipv4 = []*ixconfig.TopologyIpv4{{
+			IgmpHost: []*ixconfig.TopologyIgmpHost{{IgmpMcastIPv4GroupList: igmpGrps}},
+		}}
 		updateXPaths(cfg)
 		return &ixATE{
 			cfg: cfg,
@@ -186,7 +219,8 @@ func TestAddTraffic(t *testing.T) {
 					rsvpLSPs: map[string]*ixconfig.TopologyRsvpteLsps{
 						rsvpName: rsvpLSP,
 					},
-					link: cfg.Vport[0],
+					igmpGroups: igmpGrps,
+					link:       cfg.Vport[0],
 				},
 				lagIfName: &intf{
 					deviceGroup: cfg.Topology[1].DeviceGroup[0],
@@ -205,6 +239,9 @@ func TestAddTraffic(t *testing.T) {
 		wantIngressTracking, wantEgressTracking bool
 		wantFrameRateType, wantFrameSizeType    string
 		wantCRC                                 string
+		wantLatency                             bool
+		wantConvergence                         bool
+		wantIntegrity                           bool
 		wantErr                                 bool
 	}{{
 		desc: "no flow headers specified",
@@ -357,6 +394,44 @@ func TestAddTraffic(t *testing.T) {
 		wantSrcEPs:      ingressLSPEPs,
 		wantDstEPs:      egressLSPEPs,
 		wantStackCount:  3,
+	}, {
+		desc: "multicast group source endpoint",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: igmpEPs,
+			DstEndpoints: intfEPs,
+			Headers: []*opb.Header{{
+				Type: &opb.Header_Eth{&opb.EthernetHeader{}},
+			}, {
+				Type: &opb.Header_Ipv4{&opb.Ipv4Header{}},
+			}},
+		},
+		wantErr: true,
+	}, {
+		desc: "non-IP multicast traffic flow",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: intfEPs,
+			DstEndpoints: igmpEPs,
+			Headers:      []*opb.Header{{Type: &opb.Header_Eth{&opb.EthernetHeader{}}}},
+		},
+		wantErr: true,
+	}, {
+		desc: "multicast traffic flow",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: intfEPs,
+			DstEndpoints: igmpEPs,
+			Headers: []*opb.Header{{
+				Type: &opb.Header_Eth{&opb.EthernetHeader{}},
+			}, {
+				Type: &opb.Header_Ipv4{&opb.Ipv4Header{}},
+			}},
+		},
+		wantTrafficType: ipv4Traffic,
+		wantSrcEPs:      devGrpEPs,
+		wantDstEPs:      igmpGrpEPs,
+		wantStackCount:  2,
 	}, {
 		desc: "attempted ingress tracking by endpoint for raw traffic flow",
 		flow: &opb.Flow{
@@ -512,6 +587,61 @@ func TestAddTraffic(t *testing.T) {
 		wantDstEPs:      vportEPs,
 		wantCRC:         "badCrc",
 		wantStackCount:  1,
+	}, {
+		desc: "latency measurement",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: intfEPs,
+			DstEndpoints: intfEPs,
+			Headers: []*opb.Header{{Type: &opb.Header_Eth{
+				&opb.EthernetHeader{
+					SrcAddr: singleAddr("01:02:03:04:05:06"),
+				},
+			}}},
+			MeasureLatency: true,
+		},
+		wantTrafficType: rawTraffic,
+		wantSrcEPs:      vportEPs,
+		wantDstEPs:      vportEPs,
+		wantStackCount:  1,
+		wantLatency:     true,
+	}, {
+		desc: "convergence measurement",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: intfEPs,
+			DstEndpoints: intfEPs,
+			Headers: []*opb.Header{{Type: &opb.Header_Eth{
+				&opb.EthernetHeader{
+					SrcAddr: singleAddr("01:02:03:04:05:06"),
+				},
+			}}},
+			MeasureConvergence: true,
+		},
+		wantTrafficType: rawTraffic,
+		wantSrcEPs:      vportEPs,
+		wantDstEPs:      vportEPs,
+		wantStackCount:  1,
+		wantConvergence: true,
+	}, {
+		desc: "integrity check",
+		flow: &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: intfEPs,
+			DstEndpoints: intfEPs,
+			Headers: []*opb.Header{{Type: &opb.Header_Eth{
+				&opb.EthernetHeader{
+					SrcAddr: singleAddr("01:02:03:04:05:06"),
+				},
+			}}},
+			Payload:        &opb.Payload{Type: opb.Payload_PRBS},
+			CheckIntegrity: true,
+		},
+		wantTrafficType: rawTraffic,
+		wantSrcEPs:      vportEPs,
+		wantDstEPs:      vportEPs,
+		wantStackCount:  1,
+		wantIntegrity:   true,
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -584,6 +714,178 @@ func TestAddTraffic(t *testing.T) {
 			if test.wantCRC != gotCRC {
 				t.Errorf("addTraffic: unexpected CRC: got %q, want %q", gotCRC, test.wantCRC)
 			}
+
+			stats := c.cfg.Traffic.Statistics
+			if gotLatency := stats != nil && stats.DelayVariation != nil && *(stats.DelayVariation.Enabled); test.wantLatency != gotLatency {
+				t.Errorf("addTraffic: unexpected latency measurement state: got enabled: %t, want enabled: %t",
+					gotLatency, test.wantLatency)
+			}
+			if gotConvergence := stats != nil && stats.CpdpConvergence != nil && *(stats.CpdpConvergence.Enabled); test.wantConvergence != gotConvergence {
+				t.Errorf("addTraffic: unexpected convergence measurement state: got enabled: %t, want enabled: %t",
+					gotConvergence, test.wantConvergence)
+			}
+			if gotIntegrity := stats != nil && stats.DataIntegrity != nil && *(stats.DataIntegrity.Enabled); test.wantIntegrity != gotIntegrity {
+				t.Errorf("addTraffic: unexpected integrity check state: got enabled: %t, want enabled: %t",
+					gotIntegrity, test.wantIntegrity)
+			}
+		})
+	}
+}
+
+func TestAddAppTraffic(t *testing.T) {
+	const (
+		ipv4Name = "ipv4Intf"
+		ipv6Name = "ipv6Intf"
+		flowName = "someFlow"
+	)
+	baseClient := func() *ixATE {
+		cfg := &ixconfig.Ixnetwork{
+			Topology: []*ixconfig.Topology{{
+				DeviceGroup: []*ixconfig.TopologyDeviceGroup{{}, {}},
+			}},
+		}
+		updateXPaths(cfg)
+		dgs := cfg.Topology[0].DeviceGroup
+		return &ixATE{
+			cfg: cfg,
+			intfs: map[string]*intf{
+				ipv4Name: &intf{
+					deviceGroup: dgs[0],
+					ipv4:        &ixconfig.TopologyIpv4{Address: ixconfig.MultivalueStr("192.0.2.1")},
+				},
+				ipv6Name: &intf{
+					deviceGroup: dgs[1],
+					ipv6:        &ixconfig.TopologyIpv6{Address: ixconfig.MultivalueStr("2001:db8::1")},
+				},
+			},
+			flowToTrafficItem: map[string]*ixconfig.TrafficTrafficItem{},
+		}
+	}
+	appFlow := func(src, dst string, app *opb.ApplicationTraffic) *opb.Flow {
+		return &opb.Flow{
+			Name:         flowName,
+			SrcEndpoints: []*opb.Flow_Endpoint{{InterfaceName: src}},
+			DstEndpoints: []*opb.Flow_Endpoint{{InterfaceName: dst}},
+			Application:  app,
+		}
+	}
+	tests := []struct {
+		desc            string
+		flow            *opb.Flow
+		wantTrafficType trafficType
+		wantProfile     *ixconfig.TrafficAppLibProfile
+		wantErr         string
+	}{{
+		desc: "ipv4 http",
+		flow: appFlow(ipv4Name, ipv4Name, &opb.ApplicationTraffic{
+			Protocols: []opb.ApplicationTraffic_Protocol{opb.ApplicationTraffic_HTTP_GET},
+		}),
+		wantTrafficType: ipv4AppTraffic,
+		wantProfile:     &ixconfig.TrafficAppLibProfile{ConfiguredFlows: []string{"Bandwidth_HTTP"}},
+	}, {
+		desc: "ipv6 tcp and udp with sessions",
+		flow: appFlow(ipv6Name, ipv6Name, &opb.ApplicationTraffic{
+			Protocols:          []opb.ApplicationTraffic_Protocol{opb.ApplicationTraffic_TCP, opb.ApplicationTraffic_UDP},
+			ConcurrentSessions: 100,
+		}),
+		wantTrafficType: ipv6AppTraffic,
+		wantProfile: &ixconfig.TrafficAppLibProfile{
+			ConfiguredFlows:       []string{"Bandwidth_Raw", "Echo_UDP"},
+			ObjectiveType:         ixconfig.String("simulatedUsers"),
+			ObjectiveValue:        ixconfig.NumberUint32(100),
+			ObjectiveDistribution: ixconfig.String("applyFullObjectiveToEachPort"),
+		},
+	}, {
+		desc:    "no protocols",
+		flow:    appFlow(ipv4Name, ipv4Name, &opb.ApplicationTraffic{}),
+		wantErr: "no protocols",
+	}, {
+		desc: "mixed ip families",
+		flow: appFlow(ipv4Name, ipv6Name, &opb.ApplicationTraffic{
+			Protocols: []opb.ApplicationTraffic_Protocol{opb.ApplicationTraffic_HTTP_GET},
+		}),
+		wantErr: "all have an IPv4 or all have an IPv6",
+	}, {
+		desc: "headers",
+		flow: func() *opb.Flow {
+			f := appFlow(ipv4Name, ipv4Name, &opb.ApplicationTraffic{
+				Protocols: []opb.ApplicationTraffic_Protocol{opb.ApplicationTraffic_HTTP_GET},
+			})
+			f.Headers = []*opb.Header{{Type: &opb.Header_Eth{&opb.EthernetHeader{}}}}
+			return f
+		}(),
+		wantErr: "both headers and application traffic",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := baseClient()
+			gotErr := c.addTraffic([]*opb.Flow{test.flow})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("addTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			ti := c.cfg.Traffic.TrafficItem[0]
+			if got := trafficType(*ti.TrafficType); got != test.wantTrafficType {
+				t.Errorf("addTraffic: unexpected traffic type: got %q, want %q", got, test.wantTrafficType)
+			}
+			if diff := cmp.Diff(test.wantProfile, ti.AppLibProfile[0]); diff != "" {
+				t.Errorf("addTraffic: unexpected application library profile diff (-want +got): %s", diff)
+			}
+			if len(ti.ConfigElement) != 0 {
+				t.Errorf("addTraffic: got config elements %v, want none", ti.ConfigElement)
+			}
+		})
+	}
+}
+
+func TestInferTunnelPorts(t *testing.T) {
+	const userPort = 1234
+	tests := []struct {
+		desc        string
+		hdrs        []*opb.Header
+		wantDstPort *opb.UIntRange
+	}{{
+		desc: "UDP without tunnel",
+		hdrs: []*opb.Header{
+			{Type: &opb.Header_Udp{&opb.UdpHeader{}}},
+		},
+	}, {
+		desc: "VXLAN",
+		hdrs: []*opb.Header{
+			{Type: &opb.Header_Udp{&opb.UdpHeader{}}},
+			{Type: &opb.Header_Vxlan{&opb.VxlanHeader{}}},
+		},
+		wantDstPort: &opb.UIntRange{Min: vxlanPort, Max: vxlanPort, Count: 1},
+	}, {
+		desc: "GENEVE",
+		hdrs: []*opb.Header{
+			{Type: &opb.Header_Udp{&opb.UdpHeader{}}},
+			{Type: &opb.Header_Geneve{&opb.GeneveHeader{}}},
+		},
+		wantDstPort: &opb.UIntRange{Min: genevePort, Max: genevePort, Count: 1},
+	}, {
+		desc: "GTP-U",
+		hdrs: []*opb.Header{
+			{Type: &opb.Header_Udp{&opb.UdpHeader{}}},
+			{Type: &opb.Header_Gtpu{&opb.GtpuHeader{}}},
+		},
+		wantDstPort: &opb.UIntRange{Min: gtpuPort, Max: gtpuPort, Count: 1},
+	}, {
+		desc: "VXLAN with user-specified port",
+		hdrs: []*opb.Header{
+			{Type: &opb.Header_Udp{&opb.UdpHeader{DstPort: &opb.UIntRange{Min: userPort, Max: userPort, Count: 1}}}},
+			{Type: &opb.Header_Vxlan{&opb.VxlanHeader{}}},
+		},
+		wantDstPort: &opb.UIntRange{Min: userPort, Max: userPort, Count: 1},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			inferTunnelPorts(test.hdrs)
+			if diff := cmp.Diff(test.wantDstPort, test.hdrs[0].GetUdp().GetDstPort(), protocmp.Transform()); diff != "" {
+				t.Errorf("inferTunnelPorts got unexpected UDP dst port diff (-want,+got): %s", diff)
+			}
 		})
 	}
 }
@@ -651,6 +953,92 @@ func TestFrameRate(t *testing.T) {
 	}
 }
 
+func TestEgressTrackingCfg(t *testing.T) {
+	eth := &opb.Header{Type: &opb.Header_Eth{Eth: &opb.EthernetHeader{}}}
+	vlanEth := &opb.Header{Type: &opb.Header_Eth{Eth: &opb.EthernetHeader{VlanId: 10}}}
+	mpls := &opb.Header{Type: &opb.Header_Mpls{Mpls: &opb.MplsHeader{}}}
+	ipv4 := &opb.Header{Type: &opb.Header_Ipv4{Ipv4: &opb.Ipv4Header{}}}
+	ipv6 := &opb.Header{Type: &opb.Header_Ipv6{Ipv6: &opb.Ipv6Header{}}}
+	udp := &opb.Header{Type: &opb.Header_Udp{Udp: &opb.UdpHeader{}}}
+	tests := []struct {
+		desc                  string
+		headers               []*opb.Header
+		tracking              *opb.EgressTracking
+		wantOffset, wantWidth uint32
+		wantErr               string
+	}{{
+		desc:       "custom",
+		headers:    []*opb.Header{eth},
+		tracking:   &opb.EgressTracking{CustomOffset: 128, CustomWidth: 4},
+		wantOffset: 128,
+		wantWidth:  4,
+	}, {
+		desc:       "MPLS label",
+		headers:    []*opb.Header{eth, mpls, mpls, ipv4},
+		tracking:   &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_MPLS_LABEL},
+		wantOffset: 112,
+		wantWidth:  20,
+	}, {
+		desc:       "MPLS label after VLAN",
+		headers:    []*opb.Header{vlanEth, mpls},
+		tracking:   &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_MPLS_LABEL},
+		wantOffset: 144,
+		wantWidth:  20,
+	}, {
+		desc:       "IPv4 DSCP under MPLS",
+		headers:    []*opb.Header{eth, mpls, ipv4, udp},
+		tracking:   &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_DSCP},
+		wantOffset: 152,
+		wantWidth:  6,
+	}, {
+		desc:       "IPv6 DSCP",
+		headers:    []*opb.Header{vlanEth, ipv6},
+		tracking:   &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_DSCP},
+		wantOffset: 148,
+		wantWidth:  6,
+	}, {
+		desc:       "VLAN ID",
+		headers:    []*opb.Header{vlanEth, ipv4},
+		tracking:   &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_VLAN_ID},
+		wantOffset: 116,
+		wantWidth:  12,
+	}, {
+		desc:     "no VLAN",
+		headers:  []*opb.Header{eth, ipv4},
+		tracking: &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_VLAN_ID},
+		wantErr:  "no header",
+	}, {
+		desc:     "no MPLS",
+		headers:  []*opb.Header{eth, ipv6, udp},
+		tracking: &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_MPLS_LABEL},
+		wantErr:  "no header",
+	}, {
+		desc:     "no IP",
+		headers:  []*opb.Header{eth, udp},
+		tracking: &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_DSCP},
+		wantErr:  "cannot compute",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, gotErr := egressTrackingCfg(&opb.Flow{Headers: test.headers, EgressTracking: test.tracking})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("egressTrackingCfg: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			want := &ixconfig.TrafficEgressTracking{
+				Encapsulation:    ixconfig.String("Any: Use Custom Settings"),
+				CustomOffsetBits: ixconfig.NumberUint32(test.wantOffset),
+				CustomWidthBits:  ixconfig.NumberUint32(test.wantWidth),
+			}
+			if diff := jsonCfgDiff(t, want, got); diff != "" {
+				t.Errorf("egressTrackingCfg: unexpected TrafficEgressTracking diff (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestFrameSize(t *testing.T) {
 	tests := []struct {
 		desc             string
@@ -714,6 +1102,42 @@ func TestFrameSize(t *testing.T) {
 			"randomMin": uint32(64),
 			"randomMax": uint32(512),
 		},
+	}, {
+		desc: "incrementing frame size",
+		frameSizePB: &opb.FrameSize{
+			Type: &opb.FrameSize_Increment_{
+				Increment: &opb.FrameSize_Increment{Min: 1400, Max: 1600, Step: 10},
+			},
+		},
+		wantFrameSize: &ixconfig.TrafficFrameSize{
+			Type_:         ixconfig.String("increment"),
+			IncrementFrom: ixconfig.NumberUint32(1400),
+			IncrementTo:   ixconfig.NumberUint32(1600),
+			IncrementStep: ixconfig.NumberUint32(10),
+			QuadGaussian:  []float32{},
+			WeightedPairs: []float32{},
+		},
+		wantFrameSizeMap: map[string]interface{}{
+			"incrementFrom": uint32(1400),
+			"incrementTo":   uint32(1600),
+			"incrementStep": uint32(10),
+		},
+	}, {
+		desc: "zero increment step",
+		frameSizePB: &opb.FrameSize{
+			Type: &opb.FrameSize_Increment_{
+				Increment: &opb.FrameSize_Increment{Min: 1400, Max: 1600},
+			},
+		},
+		wantErr: "step",
+	}, {
+		desc: "increment min greater than max",
+		frameSizePB: &opb.FrameSize{
+			Type: &opb.FrameSize_Increment_{
+				Increment: &opb.FrameSize_Increment{Min: 1600, Max: 1400, Step: 10},
+			},
+		},
+		wantErr: "greater than max",
 	}, {
 		desc: "custom frame sizes as weighted pairs",
 		frameSizePB: &opb.FrameSize{
@@ -754,6 +1178,56 @@ func TestFrameSize(t *testing.T) {
 	}
 }
 
+func TestFramePayload(t *testing.T) {
+	tests := []struct {
+		desc    string
+		payload *opb.Payload
+		want    *ixconfig.TrafficFramePayload
+		wantErr string
+	}{{
+		desc: "no payload set",
+	}, {
+		desc:    "fixed without pattern",
+		payload: &opb.Payload{Type: opb.Payload_FIXED},
+		wantErr: "no pattern",
+	}, {
+		desc:    "fixed with bad pattern",
+		payload: &opb.Payload{Type: opb.Payload_FIXED, FixedPattern: "abc"},
+		wantErr: "hex digits",
+	}, {
+		desc:    "pattern without fixed type",
+		payload: &opb.Payload{FixedPattern: "abcd"},
+		wantErr: "fixed pattern set",
+	}, {
+		desc:    "fixed",
+		payload: &opb.Payload{Type: opb.Payload_FIXED, FixedPattern: "DEADBEEF"},
+		want: &ixconfig.TrafficFramePayload{
+			Type_:         ixconfig.String("custom"),
+			CustomPattern: ixconfig.String("DEADBEEF"),
+			CustomRepeat:  ixconfig.Bool(true),
+		},
+	}, {
+		desc:    "incrementing",
+		payload: &opb.Payload{Type: opb.Payload_INCREMENTING},
+		want:    &ixconfig.TrafficFramePayload{Type_: ixconfig.String("incrementByte")},
+	}, {
+		desc:    "prbs",
+		payload: &opb.Payload{Type: opb.Payload_PRBS},
+		want:    &ixconfig.TrafficFramePayload{Type_: ixconfig.String("PRBS")},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, gotErr := framePayload(test.payload)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("framePayload: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("framePayload: unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestTransmissionControl(t *testing.T) {
 	tests := []struct {
 		desc             string
@@ -835,6 +1309,42 @@ func TestTransmissionControl(t *testing.T) {
 			InterBurstGapUnits:  ixconfig.String("nanoseconds"),
 			InterBurstGap:       ixconfig.NumberUint32(30),
 		},
+	}, {
+		desc: "fixed burst count",
+		transmissionPB: &opb.Transmission{
+			Pattern:         opb.Transmission_BURST,
+			PacketsPerBurst: 100,
+			InterburstGap:   &opb.Transmission_Bytes{Bytes: 64},
+			BurstCount:      10,
+		},
+		wantTransmission: &ixconfig.TrafficTransmissionControl{
+			Type_:               ixconfig.String("custom"),
+			EnableInterBurstGap: ixconfig.Bool(true),
+			MinGapBytes:         ixconfig.NumberUint32(0),
+			BurstPacketCount:    ixconfig.NumberUint32(100),
+			InterBurstGapUnits:  ixconfig.String("bytes"),
+			InterBurstGap:       ixconfig.NumberUint32(64),
+			RepeatBurst:         ixconfig.NumberUint32(10),
+		},
+	}, {
+		desc: "burst count set for continuous",
+		transmissionPB: &opb.Transmission{
+			Pattern:    opb.Transmission_CONTINUOUS,
+			BurstCount: 10,
+		},
+		wantErr: "burst count should not be set",
+	}, {
+		desc: "frame count not set for fixed frame count",
+		transmissionPB: &opb.Transmission{
+			Pattern: opb.Transmission_FIXED_FRAME_COUNT,
+		},
+		wantErr: "frame count must be a positive value",
+	}, {
+		desc: "duration not set for fixed duration",
+		transmissionPB: &opb.Transmission{
+			Pattern: opb.Transmission_FIXED_DURATION,
+		},
+		wantErr: "duration must be a positive value",
 	}, {
 		desc: "burst packet count set for fixed frame count",
 		transmissionPB: &opb.Transmission{
@@ -849,6 +1359,22 @@ func TestTransmissionControl(t *testing.T) {
 			InterburstGap: &opb.Transmission_Bytes{Bytes: 64},
 		},
 		wantErr: "burst gap should not be set",
+	}, {
+		desc: "burst count set for fixed frame count",
+		transmissionPB: &opb.Transmission{
+			Pattern:    opb.Transmission_FIXED_FRAME_COUNT,
+			FrameCount: 1000,
+			BurstCount: 10,
+		},
+		wantErr: "burst count should not be set",
+	}, {
+		desc: "burst count set for duration transmission",
+		transmissionPB: &opb.Transmission{
+			Pattern:      opb.Transmission_FIXED_DURATION,
+			DurationSecs: 100,
+			BurstCount:   10,
+		},
+		wantErr: "burst count should not be set",
 	}, {
 		desc: "burst packet count set for duration transmission",
 		transmissionPB: &opb.Transmission{
@@ -900,3 +1426,103 @@ func TestTransmissionControl(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTraffic(t *testing.T) {
+	v4Intf := &intf{
+		ipv4: &ixconfig.TopologyIpv4{Address: ixconfig.MultivalueStr("192.0.2.1")},
+		netToNetworkGroup: map[string]*ixconfig.TopologyNetworkGroup{
+			"net": &ixconfig.TopologyNetworkGroup{},
+		},
+	}
+	ix := &ixATE{name: "ate1", intfs: map[string]*intf{"v4": v4Intf, "none": &intf{}}}
+	ate2 := &binding.ATE{Dims: &binding.Dims{Name: "ate2"}}
+	ixias[ate2] = &ixATE{name: "ate2", intfs: map[string]*intf{"remote": &intf{}}}
+	defer delete(ixias, ate2)
+	ep := func(intf string) *opb.Flow_Endpoint {
+		return &opb.Flow_Endpoint{InterfaceName: intf}
+	}
+	ethHdr := &opb.Header{Type: &opb.Header_Eth{&opb.EthernetHeader{}}}
+	ipv4Hdr := &opb.Header{Type: &opb.Header_Ipv4{&opb.Ipv4Header{}}}
+	ipv6Hdr := &opb.Header{Type: &opb.Header_Ipv6{&opb.Ipv6Header{}}}
+	flow := func(name string, src, dst *opb.Flow_Endpoint, hdrs ...*opb.Header) *opb.Flow {
+		f := &opb.Flow{Name: name, Headers: hdrs}
+		if src != nil {
+			f.SrcEndpoints = []*opb.Flow_Endpoint{src}
+		}
+		if dst != nil {
+			f.DstEndpoints = []*opb.Flow_Endpoint{dst}
+		}
+		return f
+	}
+
+	tests := []struct {
+		desc    string
+		flows   []*opb.Flow
+		wantErr string
+	}{{
+		desc:  "valid",
+		flows: []*opb.Flow{flow("f", ep("v4"), ep("v4"), ethHdr, ipv4Hdr)},
+	}, {
+		desc:    "no name",
+		flows:   []*opb.Flow{flow("", ep("v4"), ep("v4"), ethHdr)},
+		wantErr: "no name",
+	}, {
+		desc:    "duplicate name",
+		flows:   []*opb.Flow{flow("f", ep("v4"), ep("v4"), ethHdr), flow("f", ep("v4"), ep("v4"), ethHdr)},
+		wantErr: `duplicate flow name "f"`,
+	}, {
+		desc:    "no headers",
+		flows:   []*opb.Flow{flow("f", ep("v4"), ep("v4"))},
+		wantErr: "bad header spec",
+	}, {
+		desc:    "no source endpoints",
+		flows:   []*opb.Flow{flow("f", nil, ep("v4"), ethHdr)},
+		wantErr: "no source endpoints",
+	}, {
+		desc:    "endpoint not in topology",
+		flows:   []*opb.Flow{flow("f", ep("v4"), ep("missing"), ethHdr)},
+		wantErr: `destination endpoint on interface "missing", which is not in the pushed topology`,
+	}, {
+		desc:    "endpoint on another ATE",
+		flows:   []*opb.Flow{flow("f", ep("v4"), ep("remote"), ethHdr)},
+		wantErr: `destination endpoint on interface "remote" of ATE ate2, but flows on ATE ate1 can only have endpoints on that ATE`,
+	}, {
+		desc:    "ip family mismatch",
+		flows:   []*opb.Flow{flow("f", ep("v4"), ep("v4"), ethHdr, ipv6Hdr)},
+		wantErr: `IPv6 header but source endpoint interface "v4" has no IPv6 address`,
+	}, {
+		desc: "ip family mismatch with explicit address",
+		flows: []*opb.Flow{flow("f", ep("none"), ep("v4"), ethHdr, &opb.Header{Type: &opb.Header_Ipv4{&opb.Ipv4Header{
+			SrcAddr: &opb.AddressRange{Min: "198.51.100.1", Max: "198.51.100.1", Count: 1},
+		}}})},
+	}, {
+		desc: "network not on interface",
+		flows: []*opb.Flow{flow("f", ep("v4"), &opb.Flow_Endpoint{
+			InterfaceName: "v4",
+			Generated:     &opb.Flow_Endpoint_NetworkName{NetworkName: "other"},
+		}, ethHdr)},
+		wantErr: `network "other", which is not on interface "v4"`,
+	}, {
+		desc: "multicast source",
+		flows: []*opb.Flow{flow("f", &opb.Flow_Endpoint{
+			InterfaceName: "v4",
+			Generated:     &opb.Flow_Endpoint_IgmpGroups{IgmpGroups: true},
+		}, ep("v4"), ethHdr)},
+		wantErr: "can only be destination endpoints",
+	}, {
+		desc: "no IGMP host",
+		flows: []*opb.Flow{flow("f", ep("v4"), &opb.Flow_Endpoint{
+			InterfaceName: "v4",
+			Generated:     &opb.Flow_Endpoint_IgmpGroups{IgmpGroups: true},
+		}, ethHdr)},
+		wantErr: "has no IGMP host",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gotErr := ix.validateTraffic(test.flows)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("validateTraffic: got err: %v, want err %q", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capability discovers the services supported by a DUT.
+package capability
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/gnmi/errlist"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/gribi"
+	"github.com/openconfig/ondatra/internal/operations"
+	"github.com/openconfig/ondatra/internal/p4rt"
+	"github.com/openconfig/ondatra/internal/testbed"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	cpb "github.com/openconfig/gnoi/cert"
+	ospb "github.com/openconfig/gnoi/os"
+	spb "github.com/openconfig/gnoi/system"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
+)
+
+// Capability is a service that a DUT may support.
+type Capability int
+
+const (
+	// GNMI is the gNMI service.
+	GNMI Capability = iota
+	// GNOIOS is the gNOI OS service.
+	GNOIOS
+	// GNOISystem is the gNOI System service.
+	GNOISystem
+	// GNOICertificate is the gNOI CertificateManagement service.
+	GNOICertificate
+	// GRIBI is the gRIBI service.
+	GRIBI
+	// P4RT is the P4Runtime service.
+	P4RT
+)
+
+var names = map[Capability]string{
+	GNMI:            "gNMI",
+	GNOIOS:          "gNOI OS",
+	GNOISystem:      "gNOI System",
+	GNOICertificate: "gNOI CertificateManagement",
+	GRIBI:           "gRIBI",
+	P4RT:            "P4RT",
+}
+
+func (c Capability) String() string {
+	if name, ok := names[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Capability(%d)", int(c))
+}
+
+// probeTimeout bounds the time that a probe takes, including the time to
+// connect to the service.
+var probeTimeout = 30 * time.Second
+
+// Info is the information reported by the services of a DUT when it is probed.
+type Info struct {
+	// OSVersion is the version of the running OS, as reported by gNOI OS, or
+	// empty if the DUT does not support gNOI OS.
+	OSVersion string
+	// GNMIVersion is the version of the gNMI service, or empty if the DUT does
+	// not support gNMI.
+	GNMIVersion string
+	// Models are the versions of the models supported by gNMI, keyed by name.
+	Models map[string]string
+}
+
+// merge sets the fields of the info to the fields of other that are set.
+func (i *Info) merge(other *Info) {
+	if other.OSVersion != "" {
+		i.OSVersion = other.OSVersion
+	}
+	if other.GNMIVersion != "" {
+		i.GNMIVersion = other.GNMIVersion
+	}
+	if other.Models != nil {
+		i.Models = other.Models
+	}
+}
+
+// connCloser is a unary interceptor that records the connection of the RPCs it
+// intercepts, so that a probe can close the connection of a client it dialed.
+type connCloser struct {
+	conn *grpc.ClientConn
+}
+
+func (c *connCloser) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	c.conn = cc
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (c *connCloser) close() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
+
+// probes issue a read-only RPC of each service, whose error, if any, reveals
+// whether the DUT implements the service, and record what the service reports
+// about the DUT in the info.
+var probes = map[Capability]func(context.Context, *binding.DUT, *Info) error{
+	GNMI: func(ctx context.Context, dut *binding.DUT, info *Info) error {
+		cc := &connCloser{}
+		defer cc.close()
+		c, err := testbed.Bind().DialGNMI(ctx, dut, grpc.WithBlock(), grpc.WithChainUnaryInterceptor(cc.intercept))
+		if err != nil {
+			return err
+		}
+		resp, err := c.Capabilities(ctx, &gpb.CapabilityRequest{})
+		if err != nil {
+			return err
+		}
+		info.GNMIVersion = resp.GetGNMIVersion()
+		info.Models = make(map[string]string)
+		for _, m := range resp.GetSupportedModels() {
+			info.Models[m.GetName()] = m.GetVersion()
+		}
+		return nil
+	},
+	GNOIOS: func(ctx context.Context, dut *binding.DUT, info *Info) error {
+		c, err := operations.FetchGNOI(ctx, dut)
+		if err != nil {
+			return err
+		}
+		resp, err := c.OS().Verify(ctx, &ospb.VerifyRequest{})
+		if err != nil {
+			return err
+		}
+		info.OSVersion = resp.GetVersion()
+		return nil
+	},
+	GNOISystem: func(ctx context.Context, dut *binding.DUT, _ *Info) error {
+		c, err := operations.FetchGNOI(ctx, dut)
+		if err != nil {
+			return err
+		}
+		_, err = c.System().Time(ctx, &spb.TimeRequest{})
+		return err
+	},
+	GNOICertificate: func(ctx context.Context, dut *binding.DUT, _ *Info) error {
+		c, err := operations.FetchGNOI(ctx, dut)
+		if err != nil {
+			return err
+		}
+		_, err = c.CertificateManagement().GetCertificates(ctx, &cpb.GetCertificatesRequest{})
+		return err
+	},
+	GRIBI: func(ctx context.Context, dut *binding.DUT, _ *Info) error {
+		c, err := gribi.FetchGRIBI(ctx, dut)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := c.Get(ctx, &grpb.GetRequest{
+			NetworkInstance: &grpb.GetRequest_All{All: &grpb.Empty{}},
+			Aft:             grpb.AFTType_ALL,
+		})
+		if err != nil {
+			return err
+		}
+		// An empty RIB ends the stream without any response.
+		if _, err := stream.Recv(); err != nil && err != io.EOF {
+			return err
+		}
+		return nil
+	},
+	P4RT: func(ctx context.Context, dut *binding.DUT, _ *Info) error {
+		cc := &connCloser{}
+		defer cc.close()
+		c, err := p4rt.NewP4RT(ctx, dut, grpc.WithChainUnaryInterceptor(cc.intercept))
+		if err != nil {
+			return err
+		}
+		_, err = c.Capabilities(ctx, &p4pb.CapabilitiesRequest{})
+		return err
+	},
+}
+
+var (
+	mu       sync.Mutex
+	supports = make(map[*binding.DUT]map[Capability]bool)
+	infos    = make(map[*binding.DUT]*Info)
+)
+
+// Supports returns whether the DUT supports the capability. The DUT is probed
+// for the capability the first time it is queried and the result is cached.
+// A service is unsupported if the DUT reports that it is unimplemented, or if
+// the binding does not implement the dialer of the service; other RPC errors,
+// such as invalid arguments, indicate that the service exists.
+func Supports(ctx context.Context, dut *binding.DUT, c Capability) (bool, error) {
+	probe, ok := probes[c]
+	if !ok {
+		return false, errors.Errorf("unknown capability %v", c)
+	}
+	mu.Lock()
+	s, ok := supports[dut][c]
+	mu.Unlock()
+	if ok {
+		return s, nil
+	}
+	// Probe without holding the lock, so an unresponsive DUT does not block
+	// the queries of other DUTs and capabilities.
+	info := &Info{}
+	s = true
+	switch err := runProbe(ctx, probe, dut, info); status.Code(err) {
+	case codes.Unimplemented:
+		s = false
+	case codes.Unknown, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return false, errors.Wrapf(err, "error probing %v for %v", dut.Name, c)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if supports[dut] == nil {
+		supports[dut] = make(map[Capability]bool)
+		infos[dut] = &Info{}
+	}
+	supports[dut][c] = s
+	infos[dut].merge(info)
+	return s, nil
+}
+
+// runProbe runs the probe within the probe timeout. A panic in the probe, such
+// as from a binding that does not implement the dialer of the service, is
+// returned as an unimplemented error.
+func runProbe(ctx context.Context, probe func(context.Context, *binding.DUT, *Info) error, dut *binding.DUT, info *Info) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Warningf("Probe of %v panicked, treating it as unimplemented: %v", dut.Name, r)
+			rerr = status.Errorf(codes.Unimplemented, "probe panicked: %v", r)
+		}
+	}()
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	return probe(ctx, dut, info)
+}
+
+// Discover probes the DUTs for all capabilities concurrently and caches the
+// results, such as when the testbed is reserved. A capability whose probe
+// fails is probed again when it is queried.
+func Discover(ctx context.Context, duts ...*binding.DUT) error {
+	var (
+		wg    sync.WaitGroup
+		errMu sync.Mutex
+		errs  = &errlist.List{}
+	)
+	for _, dut := range duts {
+		for c := range probes {
+			wg.Add(1)
+			go func(dut *binding.DUT, c Capability) {
+				defer wg.Done()
+				if _, err := Supports(ctx, dut, c); err != nil {
+					errMu.Lock()
+					defer errMu.Unlock()
+					errs.Add(err)
+				}
+			}(dut, c)
+		}
+	}
+	wg.Wait()
+	return errs.Err()
+}
+
+// Discovered returns the information reported by the services of the DUT that
+// it has been probed for so far.
+func Discovered(dut *binding.DUT) *Info {
+	mu.Lock()
+	defer mu.Unlock()
+	info := &Info{}
+	if i, ok := infos[dut]; ok {
+		info.merge(i)
+	}
+	if info.Models != nil {
+		models := make(map[string]string)
+		for name, version := range info.Models {
+			models[name] = version
+		}
+		info.Models = models
+	}
+	return info
+}
@@ -17,13 +17,147 @@ package cli
 
 import (
 	"golang.org/x/net/context"
+	"regexp"
+	"strings"
+	"sync"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/testbed"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+var (
+	mu      sync.Mutex
+	parsers []*registeredParser
+
+	// prompts match the trailing prompt lines of the CLIs of each vendor.
+	prompts = map[opb.Device_Vendor]*regexp.Regexp{
+		opb.Device_ARISTA:  regexp.MustCompile(`^[\w.\-@()/: ]+[>#]\s*$`),
+		opb.Device_CISCO:   regexp.MustCompile(`^[\w.\-@()/:]+[>#]\s*$`),
+		opb.Device_JUNIPER: regexp.MustCompile(`^(\{\w+\}\s*)?[\w.\-@]+[>#%]\s*$`),
+	}
+	defaultPrompt = regexp.MustCompile(`^\S*[>#$%]\s*$`)
+
+	// headers match the leading lines the CLIs of each vendor print before the
+	// output of every command, such as the timestamps of Cisco IOS XR.
+	headers = map[opb.Device_Vendor]*regexp.Regexp{
+		opb.Device_CISCO: regexp.MustCompile(`^\w{3} \w{3} +\d+ \d+:\d+:\d+(\.\d+)? \w+$`),
+	}
 )
 
 // NewCLI creates a CLI client for the specified DUT.
 func NewCLI(ctx context.Context, dut *binding.DUT) (binding.StreamClient, error) {
 	return testbed.Bind().DialCLI(ctx, dut, grpc.WithBlock())
 }
+
+// Run runs the command on a new CLI client for the specified DUT and returns its
+// output, without the echoed command, the headers and the trailing prompt of
+// the CLI of the DUT vendor. The client is closed when the command completes.
+func Run(ctx context.Context, dut *binding.DUT, cmd string) (string, error) {
+	c, err := NewCLI(ctx, dut)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+	out, err := c.SendCommand(ctx, cmd)
+	if err != nil {
+		return "", errors.Wrapf(err, "error running command %q", cmd)
+	}
+	return trimOutput(dut.Vendor, cmd, out), nil
+}
+
+func trimOutput(vendor opb.Device_Vendor, cmd, out string) string {
+	lines := strings.Split(strings.ReplaceAll(out, "\r\n", "\n"), "\n")
+	if len(lines) > 0 && strings.HasSuffix(strings.TrimSpace(lines[0]), strings.TrimSpace(cmd)) {
+		lines = lines[1:]
+	}
+	if header, ok := headers[vendor]; ok && len(lines) > 0 && header.MatchString(strings.TrimSpace(lines[0])) {
+		lines = lines[1:]
+	}
+	prompt, ok := prompts[vendor]
+	if !ok {
+		prompt = defaultPrompt
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > 0 && prompt.MatchString(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Parser parses the output of a CLI command into rows of named values.
+type Parser func(output string) ([]map[string]string, error)
+
+type registeredParser struct {
+	vendor opb.Device_Vendor
+	cmd    *regexp.Regexp
+	parser Parser
+}
+
+// RegisterParser registers the parser for the output of the commands that fully
+// match the regular expression on DUTs of the vendor. Parsers registered later
+// take precedence over those registered earlier.
+func RegisterParser(vendor opb.Device_Vendor, cmdPattern string, parser Parser) error {
+	re, err := regexp.Compile("^(?:" + cmdPattern + ")$")
+	if err != nil {
+		return errors.Wrapf(err, "invalid command pattern %q", cmdPattern)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	parsers = append(parsers, &registeredParser{vendor: vendor, cmd: re, parser: parser})
+	return nil
+}
+
+func parserFor(vendor opb.Device_Vendor, cmd string) Parser {
+	mu.Lock()
+	defer mu.Unlock()
+	for i := len(parsers) - 1; i >= 0; i-- {
+		if p := parsers[i]; p.vendor == vendor && p.cmd.MatchString(cmd) {
+			return p.parser
+		}
+	}
+	return nil
+}
+
+// RunAndParse runs the command on the CLI of the specified DUT and parses its
+// output with the parser registered for the command and the DUT vendor.
+func RunAndParse(ctx context.Context, dut *binding.DUT, cmd string) ([]map[string]string, error) {
+	parser := parserFor(dut.Vendor, cmd)
+	if parser == nil {
+		return nil, errors.Errorf("no parser registered for command %q on vendor %v", cmd, dut.Vendor)
+	}
+	out, err := Run(ctx, dut, cmd)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := parser(out)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing output of command %q", cmd)
+	}
+	return rows, nil
+}
+
+// RegexParser returns a parser that returns a row for every match of the
+// regular expression in the output, which maps the names of the subexpressions
+// of the regular expression to their matched values.
+func RegexParser(re *regexp.Regexp) Parser {
+	names := re.SubexpNames()
+	return func(output string) ([]map[string]string, error) {
+		var rows []map[string]string
+		for _, match := range re.FindAllStringSubmatch(output, -1) {
+			row := make(map[string]string)
+			for i, name := range names {
+				if name != "" {
+					row[name] = match[i]
+				}
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+}
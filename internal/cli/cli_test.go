@@ -0,0 +1,115 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestTrimOutput(t *testing.T) {
+	tests := []struct {
+		desc   string
+		vendor opb.Device_Vendor
+		out    string
+		want   string
+	}{{
+		desc:   "arista",
+		vendor: opb.Device_ARISTA,
+		out:    "show version\r\nArista vEOS\r\nSoftware image version: 4.26\r\nswitch1#",
+		want:   "Arista vEOS\nSoftware image version: 4.26",
+	}, {
+		desc:   "cisco",
+		vendor: opb.Device_CISCO,
+		out:    "show version\nThu Oct 15 13:00:00.123 UTC\nCisco IOS XR Software\nRP/0/RP0/CPU0:router#\n",
+		want:   "Cisco IOS XR Software",
+	}, {
+		desc:   "juniper",
+		vendor: opb.Device_JUNIPER,
+		out:    "admin@router> show version\nJunos: 21.1R1\n\n{master}\nadmin@router> ",
+		want:   "Junos: 21.1R1\n\n{master}",
+	}, {
+		desc:   "no echo or prompt",
+		vendor: opb.Device_ARISTA,
+		out:    "Arista vEOS",
+		want:   "Arista vEOS",
+	}, {
+		desc:   "default prompt",
+		vendor: opb.Device_UNKNOWN,
+		out:    "show version\nversion 1\nhost$ ",
+		want:   "version 1",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := trimOutput(tt.vendor, "show version", tt.out); got != tt.want {
+				t.Errorf("trimOutput(%v, %q) got %q, want %q", tt.vendor, tt.out, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegexParser(t *testing.T) {
+	parse := RegexParser(regexp.MustCompile(`(?m)^(?P<name>Et\S+)\s+(?P<status>\S+)$`))
+	got, err := parse("Port Status\nEt1 connected\nEt2 notconnect\n")
+	if err != nil {
+		t.Fatalf("RegexParser() got error %v", err)
+	}
+	want := []map[string]string{
+		{"name": "Et1", "status": "connected"},
+		{"name": "Et2", "status": "notconnect"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RegexParser() unexpected rows diff (-want,+got): %s", diff)
+	}
+}
+
+func TestParserFor(t *testing.T) {
+	first := func(string) ([]map[string]string, error) { return nil, nil }
+	second := func(string) ([]map[string]string, error) { return []map[string]string{{}}, nil }
+	if err := RegisterParser(opb.Device_ARISTA, `show interfaces( status)?`, first); err != nil {
+		t.Fatalf("RegisterParser() got error %v", err)
+	}
+	if err := RegisterParser(opb.Device_ARISTA, `show interfaces status`, second); err != nil {
+		t.Fatalf("RegisterParser() got error %v", err)
+	}
+	if err := RegisterParser(opb.Device_ARISTA, `show (`, first); err == nil {
+		t.Errorf("RegisterParser() of invalid pattern got no error")
+	}
+	tests := []struct {
+		vendor opb.Device_Vendor
+		cmd    string
+		want   int
+	}{
+		{opb.Device_ARISTA, "show interfaces", 0},
+		{opb.Device_ARISTA, "show interfaces status", 1},
+		{opb.Device_ARISTA, "show interfaces status detail", -1},
+		{opb.Device_CISCO, "show interfaces", -1},
+	}
+	for _, tt := range tests {
+		p := parserFor(tt.vendor, tt.cmd)
+		got := -1
+		if p != nil {
+			rows, _ := p("")
+			got = len(rows)
+		}
+		if got != tt.want {
+			t.Errorf("parserFor(%v, %q) got parser returning %d rows, want %d", tt.vendor, tt.cmd, got, tt.want)
+		}
+	}
+}
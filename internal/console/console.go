@@ -17,6 +17,7 @@ package console
 
 import (
 	"golang.org/x/net/context"
+	"io"
 
 	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
@@ -27,3 +28,8 @@ import (
 func NewConsole(ctx context.Context, dut *binding.DUT) (binding.StreamClient, error) {
 	return testbed.Bind().DialConsole(ctx, dut, grpc.WithBlock())
 }
+
+// StreamLogs streams the console output of the specified DUT.
+func StreamLogs(ctx context.Context, dut *binding.DUT) (io.ReadCloser, error) {
+	return testbed.Bind().StreamConsoleLogs(ctx, dut)
+}
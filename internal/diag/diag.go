@@ -0,0 +1,170 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diag collects diagnostic bundles from DUTs.
+package diag
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/artifacts"
+	"github.com/openconfig/ondatra/internal/console"
+	"github.com/openconfig/ondatra/internal/operations"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	hpb "github.com/openconfig/gnoi/healthz"
+	tpb "github.com/openconfig/gnoi/types"
+)
+
+const (
+	// consoleTailTime is how long the console logs are read for.
+	consoleTailTime = 5 * time.Second
+	// consoleTailSize is the maximum number of bytes of console logs kept.
+	consoleTailSize = 64 * 1024
+)
+
+// Bundle files written by Collect.
+const (
+	GNMIFile    = "gnmi.txt"
+	HealthzFile = "healthz.txt"
+	ConsoleFile = "console.log"
+	ErrorsFile  = "errors.txt"
+)
+
+// ParsePaths parses the string forms of gNMI paths.
+func ParsePaths(paths []string) ([]*gpb.Path, error) {
+	var gpaths []*gpb.Path
+	for _, p := range paths {
+		gp, err := ygot.StringToStructuredPath(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing gNMI path %q", p)
+		}
+		gpaths = append(gpaths, gp)
+	}
+	return gpaths, nil
+}
+
+// Collect writes a diagnostic bundle of the DUT to the specified directory,
+// through the artifact sink.
+// The bundle contains a snapshot of the state of the gNMI paths, if any, the
+// gNOI healthz status of the device, and the tail of the console logs. A part
+// of the bundle that cannot be collected does not prevent the collection of
+// the other parts; its error is written to the errors file of the bundle.
+// Collect only returns an error if the bundle cannot be written.
+func Collect(ctx context.Context, dir string, dut *binding.DUT, gnmiFn func(context.Context) (gpb.GNMIClient, error), paths []*gpb.Path) error {
+	var errs []string
+	parts := []struct {
+		file    string
+		collect func() ([]byte, error)
+	}{
+		{GNMIFile, func() ([]byte, error) { return gnmiState(ctx, gnmiFn, paths) }},
+		{HealthzFile, func() ([]byte, error) { return healthz(ctx, dut) }},
+		{ConsoleFile, func() ([]byte, error) { return consoleTail(ctx, dut) }},
+	}
+	for _, part := range parts {
+		data, err := part.collect()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", part.file, err))
+		}
+		if data == nil {
+			continue
+		}
+		if err := artifacts.Write(ctx, filepath.Join(dir, part.file), data); err != nil {
+			return errors.Wrapf(err, "error writing diagnostics file %s", part.file)
+		}
+	}
+	if len(errs) > 0 {
+		data := []byte(strings.Join(errs, "\n") + "\n")
+		if err := artifacts.Write(ctx, filepath.Join(dir, ErrorsFile), data); err != nil {
+			return errors.Wrapf(err, "error writing diagnostics file %s", ErrorsFile)
+		}
+	}
+	return nil
+}
+
+func gnmiState(ctx context.Context, gnmiFn func(context.Context) (gpb.GNMIClient, error), paths []*gpb.Path) ([]byte, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	gnmi, err := gnmiFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gnmi.Get(ctx, &gpb.GetRequest{
+		Path:     paths,
+		Type:     gpb.GetRequest_STATE,
+		Encoding: gpb.Encoding_JSON_IETF,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting gNMI state")
+	}
+	return []byte(prototext.Format(resp)), nil
+}
+
+func healthz(ctx context.Context, dut *binding.DUT) ([]byte, error) {
+	gnoi, err := operations.FetchGNOI(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gnoi.Healthz().Get(ctx, &hpb.GetRequest{Path: &tpb.Path{}})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting gNOI healthz status")
+	}
+	return []byte(prototext.Format(resp)), nil
+}
+
+func consoleTail(ctx context.Context, dut *binding.DUT) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, consoleTailTime)
+	defer cancel()
+	logs, err := console.StreamLogs(ctx, dut)
+	if err != nil {
+		// The console logs are not part of the bundle if the binding cannot
+		// stream them.
+		if status.Code(err) == codes.Unimplemented {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error streaming console logs")
+	}
+	// Closing the logs unblocks the read when the tail time has elapsed.
+	go func() {
+		<-ctx.Done()
+		logs.Close()
+	}()
+	var tail []byte
+	buf := make([]byte, 4096)
+	for {
+		n, err := logs.Read(buf)
+		tail = append(tail, buf[:n]...)
+		if len(tail) > consoleTailSize {
+			tail = tail[len(tail)-consoleTailSize:]
+		}
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return tail, nil
+			}
+			return tail, errors.Wrap(err, "error reading console logs")
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag
+
+import (
+	"errors"
+	"golang.org/x/net/context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/fakebind"
+	"github.com/openconfig/ondatra/internal/testbed"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	hpb "github.com/openconfig/gnoi/healthz"
+)
+
+type fakeGNMIClient struct {
+	gpb.GNMIClient
+	gotReq *gpb.GetRequest
+}
+
+func (c *fakeGNMIClient) Get(_ context.Context, req *gpb.GetRequest, _ ...grpc.CallOption) (*gpb.GetResponse, error) {
+	c.gotReq = req
+	return &gpb.GetResponse{Notification: []*gpb.Notification{{Timestamp: 42}}}, nil
+}
+
+type fakeGNOIClients struct {
+	binding.GNOIClients
+	hpb.HealthzClient
+}
+
+func (c *fakeGNOIClients) Healthz() hpb.HealthzClient {
+	return c
+}
+
+func (c *fakeGNOIClients) Get(context.Context, *hpb.GetRequest, ...grpc.CallOption) (*hpb.GetResponse, error) {
+	return &hpb.GetResponse{Component: &hpb.ComponentStatus{Status: hpb.Status_STATUS_UNHEALTHY}}, nil
+}
+
+func readFile(t *testing.T, dir, file string) string {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		t.Fatalf("Failed to read bundle file: %v", err)
+	}
+	return string(data)
+}
+
+func TestCollect(t *testing.T) {
+	fb := &fakebind.Binding{
+		GNOIDialer: func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.GNOIClients, error) {
+			return &fakeGNOIClients{}, nil
+		},
+		ConsoleLogStreamer: func(context.Context, *binding.DUT) (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("kernel panic")), nil
+		},
+	}
+	testbed.InitBind(fb)
+	dut := &binding.DUT{&binding.Dims{Name: "dut1"}}
+	paths, err := ParsePaths([]string{"/interfaces"})
+	if err != nil {
+		t.Fatalf("ParsePaths() got error: %v", err)
+	}
+
+	t.Run("all parts", func(t *testing.T) {
+		gnmi := &fakeGNMIClient{}
+		gnmiFn := func(context.Context) (gpb.GNMIClient, error) { return gnmi, nil }
+		dir := t.TempDir()
+		if err := Collect(context.Background(), dir, dut, gnmiFn, paths); err != nil {
+			t.Fatalf("Collect() got error: %v", err)
+		}
+		if got := gnmi.gotReq.GetType(); got != gpb.GetRequest_STATE {
+			t.Errorf("Collect() got gNMI get type %v, want %v", got, gpb.GetRequest_STATE)
+		}
+		if got, want := readFile(t, dir, GNMIFile), "42"; !strings.Contains(got, want) {
+			t.Errorf("Collect() got gNMI state %q, want it to contain %q", got, want)
+		}
+		if got, want := readFile(t, dir, HealthzFile), "STATUS_UNHEALTHY"; !strings.Contains(got, want) {
+			t.Errorf("Collect() got healthz %q, want it to contain %q", got, want)
+		}
+		if got, want := readFile(t, dir, ConsoleFile), "kernel panic"; got != want {
+			t.Errorf("Collect() got console logs %q, want %q", got, want)
+		}
+	})
+
+	t.Run("failed part", func(t *testing.T) {
+		gnmiFn := func(context.Context) (gpb.GNMIClient, error) { return nil, errors.New("gnmi unreachable") }
+		dir := t.TempDir()
+		if err := Collect(context.Background(), dir, dut, gnmiFn, paths); err != nil {
+			t.Fatalf("Collect() got error: %v", err)
+		}
+		if got, want := readFile(t, dir, ErrorsFile), "gnmi unreachable"; !strings.Contains(got, want) {
+			t.Errorf("Collect() got errors %q, want it to contain %q", got, want)
+		}
+		if got, want := readFile(t, dir, ConsoleFile), "kernel panic"; got != want {
+			t.Errorf("Collect() got console logs %q, want %q", got, want)
+		}
+	})
+
+	t.Run("unsupported console logs", func(t *testing.T) {
+		defer func(streamer func(context.Context, *binding.DUT) (io.ReadCloser, error)) {
+			fb.ConsoleLogStreamer = streamer
+		}(fb.ConsoleLogStreamer)
+		fb.ConsoleLogStreamer = func(context.Context, *binding.DUT) (io.ReadCloser, error) {
+			return nil, status.Error(codes.Unimplemented, "no console")
+		}
+		gnmiFn := func(context.Context) (gpb.GNMIClient, error) { return &fakeGNMIClient{}, nil }
+		dir := t.TempDir()
+		if err := Collect(context.Background(), dir, dut, gnmiFn, paths); err != nil {
+			t.Fatalf("Collect() got error: %v", err)
+		}
+		for _, file := range []string{ConsoleFile, ErrorsFile} {
+			if _, err := os.Stat(filepath.Join(dir, file)); !os.IsNotExist(err) {
+				t.Errorf("Collect() wrote %s, want none", file)
+			}
+		}
+	})
+}
+
+func TestParsePathsError(t *testing.T) {
+	if _, err := ParsePaths([]string{"/interfaces/interface]"}); err == nil {
+		t.Errorf("ParsePaths() got no error, want error")
+	}
+}
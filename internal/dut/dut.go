@@ -17,16 +17,19 @@ package dut
 
 import (
 	"golang.org/x/net/context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"strings"
 	"text/template"
 
 	"github.com/pkg/errors"
+	"github.com/openconfig/ygot/ygot"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/binding/usererr"
 	"github.com/openconfig/ondatra/internal/testbed"
 
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	opb "github.com/openconfig/ondatra/proto"
 )
 
@@ -75,8 +78,19 @@ func (f ConfigFile) Get() (string, error) {
 
 // PushConfig pushes config to a DUT.
 func PushConfig(ctx context.Context, dut *binding.DUT, cfg *Config, append bool) error {
+	config, err := RenderConfig(dut, cfg)
+	if err != nil {
+		return err
+	}
+	opts := &binding.ConfigOptions{Append: append}
+	return testbed.Bind().PushConfig(ctx, dut, config, opts)
+}
+
+// RenderConfig returns the config text to push to a DUT, with its templated
+// variables substituted.
+func RenderConfig(dut *binding.DUT, cfg *Config) (string, error) {
 	if cfg.AllVendor != nil && len(cfg.PerVendor) > 0 {
-		return errors.New("cannot specify both all-vendor and per-vendor config")
+		return "", errors.New("cannot specify both all-vendor and per-vendor config")
 	}
 	var prov ConfigProvider
 	if cfg.AllVendor != nil {
@@ -84,18 +98,42 @@ func PushConfig(ctx context.Context, dut *binding.DUT, cfg *Config, append bool)
 	} else if c, ok := cfg.PerVendor[dut.Vendor]; ok {
 		prov = c
 	} else {
-		return errors.Errorf("no config specified for device %v", dut)
+		return "", errors.Errorf("no config specified for device %v", dut)
 	}
 	text, err := prov.Get()
 	if err != nil {
-		return errors.Wrapf(err, "error getting config from provider %v", prov)
+		return "", errors.Wrapf(err, "error getting config from provider %v", prov)
 	}
-	config, err := interpolateConfig(dut, text, cfg.Vars)
-	if err != nil {
-		return err
+	return interpolateConfig(dut, text, cfg.Vars)
+}
+
+// TemplateData is the data of the DUT available to config templates, such as
+// {{ .Name }} or {{ .Tags.rack }}.
+type TemplateData struct {
+	Name    string
+	Vendor  string
+	Model   string
+	Version string
+	// Ports maps the IDs of the ports of the DUT to their physical names.
+	Ports map[string]string
+	Tags  map[string]string
+	Vars  map[string]string
+}
+
+func templateData(dut *binding.DUT, vars map[string]string) *TemplateData {
+	data := &TemplateData{
+		Name:    dut.Name,
+		Vendor:  dut.Vendor.String(),
+		Model:   dut.HardwareModel,
+		Version: dut.SoftwareVersion,
+		Ports:   make(map[string]string),
+		Tags:    dut.Tags,
+		Vars:    vars,
 	}
-	opts := &binding.ConfigOptions{Append: append}
-	return testbed.Bind().PushConfig(ctx, dut, config, opts)
+	for id, p := range dut.Ports {
+		data.Ports[id] = p.Name
+	}
+	return data
 }
 
 // interpolateConfig substitutes templated variables in device config text.
@@ -103,6 +141,8 @@ func PushConfig(ctx context.Context, dut *binding.DUT, cfg *Config, append bool)
 // - {{ port "<portID>" }}: replaced with the physical port name
 // - {{ secrets "<arg1>" "<arg2>" }}: left untouched, returned as-is
 // - {{ var "<key>" }}: returns the value for the key in the vars map
+// The fields of TemplateData are also available, and referencing a missing
+// map key is an error.
 func interpolateConfig(dut *binding.DUT, config string, vars map[string]string) (string, error) {
 	funcMap := map[string]interface{}{
 		"port": func(portID string) (string, error) {
@@ -128,13 +168,237 @@ func interpolateConfig(dut *binding.DUT, config string, vars map[string]string)
 			return v, nil
 		},
 	}
-	template, err := template.New(dut.Name).Funcs(funcMap).Parse(config)
+	template, err := template.New(dut.Name).Funcs(funcMap).Option("missingkey=error").Parse(config)
 	if err != nil {
 		return "", usererr.Wrapf(err, "Invalid template in config: %q", config)
 	}
 	var b strings.Builder
-	if err = template.Execute(&b, nil); err != nil {
+	if err = template.Execute(&b, templateData(dut, vars)); err != nil {
 		return "", usererr.Wrapf(err, "Invalid template in config: %q", config)
 	}
 	return b.String(), nil
 }
+
+// Origins of the vendor-native config blocks of a mixed config.
+const (
+	OpenConfigOrigin = "openconfig"
+	CLIOrigin        = "cli"
+)
+
+// MixedConfig stores OpenConfig and vendor-native config blocks to push to the
+// device in a single gNMI SetRequest.
+type MixedConfig struct {
+	OpenConfig ygot.ValidatedGoStruct
+	Native     map[opb.Device_Vendor][]*NativeBlock
+	Vars       map[string]string
+}
+
+// NativeBlock is a vendor-native config block.
+type NativeBlock struct {
+	// Origin is the gNMI origin of the block, which is CLIOrigin for CLI text
+	// and a vendor-defined origin for vendor-native JSON.
+	Origin string
+	Text   string
+}
+
+// PushMixedConfig pushes the OpenConfig and the native config blocks for the
+// vendor of the DUT to the DUT. If append is false, the OpenConfig replaces the
+// existing OpenConfig of the DUT; otherwise it is merged into it. Native blocks
+// are always merged into the existing config.
+func PushMixedConfig(ctx context.Context, dut *binding.DUT, client gpb.GNMIClient, cfg *MixedConfig, append bool) error {
+	req, err := RenderMixedConfig(dut, cfg, append)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Set(ctx, req); err != nil {
+		return errors.Wrap(err, "SetRequest unsuccessful")
+	}
+	return nil
+}
+
+// RenderMixedConfig returns the SetRequest that pushes the mixed config to a
+// DUT, after validating the OpenConfig and substituting the templated
+// variables of the native config blocks.
+func RenderMixedConfig(dut *binding.DUT, cfg *MixedConfig, merge bool) (*gpb.SetRequest, error) {
+	req := new(gpb.SetRequest)
+	if cfg.OpenConfig != nil {
+		if err := cfg.OpenConfig.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid OpenConfig")
+		}
+		js, err := ygot.Marshal7951(cfg.OpenConfig, &ygot.RFC7951JSONConfig{AppendModuleName: true, PreferShadowPath: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "error marshalling OpenConfig")
+		}
+		upd := &gpb.Update{
+			Path: &gpb.Path{Origin: OpenConfigOrigin},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: js}},
+		}
+		if merge {
+			req.Update = []*gpb.Update{upd}
+		} else {
+			req.Replace = []*gpb.Update{upd}
+		}
+	}
+	for _, block := range cfg.Native[dut.Vendor] {
+		text, err := interpolateConfig(dut, block.Text, cfg.Vars)
+		if err != nil {
+			return nil, err
+		}
+		val := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(text)}}
+		if block.Origin == CLIOrigin {
+			val = &gpb.TypedValue{Value: &gpb.TypedValue_AsciiVal{AsciiVal: text}}
+		}
+		req.Update = append(req.Update, &gpb.Update{Path: &gpb.Path{Origin: block.Origin}, Val: val})
+	}
+	if len(req.GetReplace()) == 0 && len(req.GetUpdate()) == 0 {
+		return nil, errors.Errorf("no config specified for device %v", dut)
+	}
+	return req, nil
+}
+
+// Checkpoint is a saved config of a DUT.
+type Checkpoint struct {
+	// ID is the ID of the checkpoint of the binding, if it saved the config.
+	ID string
+	// Config is the whole OpenConfig tree of the DUT fetched with gNMI, as
+	// JSON_IETF, if the binding did not save the config.
+	Config *gpb.TypedValue
+}
+
+// CheckpointConfig saves the config of the DUT, with the binding if it
+// supports checkpoints and otherwise with a gNMI Get of the OpenConfig tree.
+func CheckpointConfig(ctx context.Context, dut *binding.DUT, gnmiFn func(context.Context) (gpb.GNMIClient, error)) (*Checkpoint, error) {
+	id, err := testbed.Bind().CheckpointConfig(ctx, dut)
+	if err != nil {
+		return nil, errors.Wrap(err, "error checkpointing config")
+	}
+	if id != "" {
+		return &Checkpoint{ID: id}, nil
+	}
+	client, err := gnmiFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, &gpb.GetRequest{
+		Path:     []*gpb.Path{{Origin: OpenConfigOrigin}},
+		Type:     gpb.GetRequest_CONFIG,
+		Encoding: gpb.Encoding_JSON_IETF,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting config")
+	}
+	// The device may return the tree as multiple subtrees, which are merged
+	// into the whole tree, so that restoring it replaces the root.
+	root := make(map[string]interface{})
+	var numUpdates int
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			path := joinPaths(n.GetPrefix(), u.GetPath())
+			val := u.GetVal().GetJsonIetfVal()
+			if val == nil {
+				val = u.GetVal().GetJsonVal()
+			}
+			var v interface{}
+			if err := json.Unmarshal(val, &v); err != nil {
+				return nil, errors.Wrapf(err, "error unmarshalling config at %v", path)
+			}
+			if err := setJSON(root, path.GetElem(), v); err != nil {
+				return nil, errors.Wrapf(err, "error merging config at %v", path)
+			}
+			numUpdates++
+		}
+	}
+	if numUpdates == 0 {
+		return nil, errors.Errorf("no config returned by device %v", dut)
+	}
+	b, err := json.Marshal(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling config")
+	}
+	return &Checkpoint{Config: &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: b}}}, nil
+}
+
+// setJSON sets the JSON value at the path elements under a JSON object,
+// creating the containers and list entries along the path as needed.
+func setJSON(obj map[string]interface{}, elems []*gpb.PathElem, v interface{}) error {
+	if len(elems) == 0 {
+		vObj, ok := v.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("config is %T, want an object", v)
+		}
+		for k, kv := range vObj {
+			obj[k] = kv
+		}
+		return nil
+	}
+	elem := elems[0]
+	if len(elem.GetKey()) == 0 {
+		if _, isObj := v.(map[string]interface{}); len(elems) == 1 && !isObj {
+			obj[elem.GetName()] = v
+			return nil
+		}
+		child, ok := obj[elem.GetName()].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			obj[elem.GetName()] = child
+		}
+		return setJSON(child, elems[1:], v)
+	}
+	list, _ := obj[elem.GetName()].([]interface{})
+	for _, e := range list {
+		if entry, ok := e.(map[string]interface{}); ok && entryHasKeys(entry, elem.GetKey()) {
+			return setJSON(entry, elems[1:], v)
+		}
+	}
+	entry := make(map[string]interface{})
+	for k, kv := range elem.GetKey() {
+		entry[k] = kv
+	}
+	obj[elem.GetName()] = append(list, entry)
+	return setJSON(entry, elems[1:], v)
+}
+
+// entryHasKeys returns whether a JSON list entry has the given key values.
+func entryHasKeys(entry map[string]interface{}, keys map[string]string) bool {
+	for k, kv := range keys {
+		if fmt.Sprint(entry[k]) != kv {
+			return false
+		}
+	}
+	return true
+}
+
+// RestoreConfig restores the saved config of the DUT. A config saved with
+// gNMI is restored by replacing the root of the OpenConfig tree, so config
+// added since the checkpoint is removed.
+func RestoreConfig(ctx context.Context, dut *binding.DUT, gnmiFn func(context.Context) (gpb.GNMIClient, error), cp *Checkpoint) error {
+	if cp.ID != "" {
+		if err := testbed.Bind().RestoreConfig(ctx, dut, cp.ID); err != nil {
+			return errors.Wrapf(err, "error restoring config checkpoint %q", cp.ID)
+		}
+		return nil
+	}
+	client, err := gnmiFn(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Set(ctx, &gpb.SetRequest{Replace: []*gpb.Update{{
+		Path: &gpb.Path{Origin: OpenConfigOrigin},
+		Val:  cp.Config,
+	}}}); err != nil {
+		return errors.Wrap(err, "SetRequest unsuccessful")
+	}
+	return nil
+}
+
+func joinPaths(prefix, path *gpb.Path) *gpb.Path {
+	origin := path.GetOrigin()
+	if origin == "" {
+		origin = prefix.GetOrigin()
+	}
+	if origin == "" {
+		origin = OpenConfigOrigin
+	}
+	elems := append(append([]*gpb.PathElem{}, prefix.GetElem()...), path.GetElem()...)
+	return &gpb.Path{Origin: origin, Elem: elems}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events dispatches test events to the listeners registered with the
+// public events package.
+package events
+
+import (
+	"sync"
+	"testing"
+)
+
+// Device is a reserved device, either an *ondatra.DUTDevice or an
+// *ondatra.ATEDevice.
+type Device interface {
+	ID() string
+	Name() string
+}
+
+var (
+	mu           sync.Mutex
+	testStartFns []func(testing.TB)
+	testEndFns   []func(testing.TB)
+	failureFns   []func(testing.TB, []Device)
+	configFns    []func(testing.TB, Device, error)
+)
+
+// AddTestStartListener registers a listener for the start of every test.
+func AddTestStartListener(fn func(testing.TB)) {
+	mu.Lock()
+	defer mu.Unlock()
+	testStartFns = append(testStartFns, fn)
+}
+
+// AddTestEndListener registers a listener for the end of every test.
+func AddTestEndListener(fn func(testing.TB)) {
+	mu.Lock()
+	defer mu.Unlock()
+	testEndFns = append(testEndFns, fn)
+}
+
+// AddFailureListener registers a listener for the failure of every test.
+func AddFailureListener(fn func(testing.TB, []Device)) {
+	mu.Lock()
+	defer mu.Unlock()
+	failureFns = append(failureFns, fn)
+}
+
+// AddConfigPushListener registers a listener for every config push.
+func AddConfigPushListener(fn func(testing.TB, Device, error)) {
+	mu.Lock()
+	defer mu.Unlock()
+	configFns = append(configFns, fn)
+}
+
+// TestStarted calls the test start listeners.
+func TestStarted(t testing.TB) {
+	mu.Lock()
+	fns := append([]func(testing.TB){}, testStartFns...)
+	mu.Unlock()
+	for _, fn := range fns {
+		fn(t)
+	}
+}
+
+// TestEnded calls the test end listeners.
+func TestEnded(t testing.TB) {
+	mu.Lock()
+	fns := append([]func(testing.TB){}, testEndFns...)
+	mu.Unlock()
+	for _, fn := range fns {
+		fn(t)
+	}
+}
+
+// TestFailed calls the failure listeners with the reserved devices.
+func TestFailed(t testing.TB, devs []Device) {
+	mu.Lock()
+	fns := append([]func(testing.TB, []Device){}, failureFns...)
+	mu.Unlock()
+	for _, fn := range fns {
+		fn(t, devs)
+	}
+}
+
+// ConfigPushed calls the config push listeners with the device the config was
+// pushed to and the error of the push, if any.
+func ConfigPushed(t testing.TB, dev Device, err error) {
+	mu.Lock()
+	fns := append([]func(testing.TB, Device, error){}, configFns...)
+	mu.Unlock()
+	for _, fn := range fns {
+		fn(t, dev, err)
+	}
+}
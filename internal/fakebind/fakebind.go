@@ -17,6 +17,7 @@ package fakebind
 
 import (
 	"golang.org/x/net/context"
+	"io"
 	"time"
 
 	log "github.com/golang/glog"
@@ -33,31 +34,59 @@ var _ binding.Binding = &Binding{}
 
 // Binding is a fake testbed binding comprised of stub implementations.
 type Binding struct {
-	Reservation   *binding.Reservation
-	ResvFetcher   func(context.Context, string) (*binding.Reservation, error)
-	ConfigPusher  func(context.Context, *binding.DUT, string, *binding.ConfigOptions) error
-	CLIDialer     func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.StreamClient, error)
-	ConsoleDialer func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.StreamClient, error)
-	GNMIDialer    func(context.Context, *binding.DUT, ...grpc.DialOption) (gpb.GNMIClient, error)
-	GNOIDialer    func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.GNOIClients, error)
-	GRIBIDialer     func(context.Context, *binding.DUT, ...grpc.DialOption) (grpb.GRIBIClient, error)
-	P4RTDialer      func(context.Context, *binding.DUT, ...grpc.DialOption) (p4pb.P4RuntimeClient, error)
-	IxNetworkDialer func(context.Context, *binding.ATE) (*binding.IxNetwork, error)
+	TestbedSetup       func(context.Context, *opb.Testbed) error
+	TestbedTeardown    func(context.Context) error
+	Reservation        *binding.Reservation
+	ResvFetcher        func(context.Context, string) (*binding.Reservation, error)
+	ConfigPusher       func(context.Context, *binding.DUT, string, *binding.ConfigOptions) error
+	ConfigCheckpointer func(context.Context, *binding.DUT) (string, error)
+	ConfigRestorer     func(context.Context, *binding.DUT, string) error
+	CLIDialer          func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.StreamClient, error)
+	ConsoleDialer      func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.StreamClient, error)
+	ConsoleLogStreamer func(context.Context, *binding.DUT) (io.ReadCloser, error)
+	PacketCapturer     func(context.Context, *binding.DUT, []*binding.Port, string) (binding.PacketCapture, error)
+	GNMIDialer         func(context.Context, *binding.DUT, ...grpc.DialOption) (gpb.GNMIClient, error)
+	GNOIDialer         func(context.Context, *binding.DUT, ...grpc.DialOption) (binding.GNOIClients, error)
+	GRIBIDialer        func(context.Context, *binding.DUT, ...grpc.DialOption) (grpb.GRIBIClient, error)
+	P4RTDialer         func(context.Context, *binding.DUT, ...grpc.DialOption) (p4pb.P4RuntimeClient, error)
+	IxNetworkDialer    func(context.Context, *binding.ATE) (*binding.IxNetwork, error)
 }
 
 // Reset zeros out all the stub implementations.
 func (b *Binding) Reset() {
+	b.TestbedSetup = nil
+	b.TestbedTeardown = nil
 	b.Reservation = nil
 	b.ResvFetcher = nil
 	b.ConfigPusher = nil
+	b.ConfigCheckpointer = nil
+	b.ConfigRestorer = nil
 	b.CLIDialer = nil
 	b.ConsoleDialer = nil
+	b.ConsoleLogStreamer = nil
+	b.PacketCapturer = nil
 	b.GNMIDialer = nil
 	b.GNOIDialer = nil
 	b.P4RTDialer = nil
 	b.IxNetworkDialer = nil
 }
 
+// SetupTestbed delegates to b.TestbedSetup, if it is set.
+func (b *Binding) SetupTestbed(ctx context.Context, tb *opb.Testbed) error {
+	if b.TestbedSetup == nil {
+		return nil
+	}
+	return b.TestbedSetup(ctx, tb)
+}
+
+// TeardownTestbed delegates to b.TestbedTeardown, if it is set.
+func (b *Binding) TeardownTestbed(ctx context.Context) error {
+	if b.TestbedTeardown == nil {
+		return nil
+	}
+	return b.TestbedTeardown(ctx)
+}
+
 // Reserve returns b.Reservation.
 func (b *Binding) Reserve(context.Context, *opb.Testbed, time.Duration, time.Duration, map[string]string) (*binding.Reservation, error) {
 	return b.Reservation, nil
@@ -83,6 +112,19 @@ func (b *Binding) PushConfig(ctx context.Context, dut *binding.DUT, config strin
 	return b.ConfigPusher(ctx, dut, config, opts)
 }
 
+// CheckpointConfig delegates to b.ConfigCheckpointer, if it is set.
+func (b *Binding) CheckpointConfig(ctx context.Context, dut *binding.DUT) (string, error) {
+	if b.ConfigCheckpointer == nil {
+		return "", nil
+	}
+	return b.ConfigCheckpointer(ctx, dut)
+}
+
+// RestoreConfig delegates to b.ConfigRestorer.
+func (b *Binding) RestoreConfig(ctx context.Context, dut *binding.DUT, id string) error {
+	return b.ConfigRestorer(ctx, dut, id)
+}
+
 // DialGNMI creates a client connection to the fake GNMI server.
 func (b *Binding) DialGNMI(ctx context.Context, dut *binding.DUT, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
 	return b.GNMIDialer(ctx, dut, opts...)
@@ -113,6 +155,16 @@ func (b *Binding) DialConsole(ctx context.Context, dut *binding.DUT, opts ...grp
 	return b.ConsoleDialer(ctx, dut, opts...)
 }
 
+// StreamConsoleLogs delegates to b.ConsoleLogStreamer.
+func (b *Binding) StreamConsoleLogs(ctx context.Context, dut *binding.DUT) (io.ReadCloser, error) {
+	return b.ConsoleLogStreamer(ctx, dut)
+}
+
+// StartPacketCapture delegates to b.PacketCapturer.
+func (b *Binding) StartPacketCapture(ctx context.Context, dut *binding.DUT, ports []*binding.Port, filter string) (binding.PacketCapture, error) {
+	return b.PacketCapturer(ctx, dut, ports, filter)
+}
+
 // DialIxNetwork delegates to b.IxNetworkDialer.
 func (b *Binding) DialIxNetwork(ctx context.Context, ate *binding.ATE) (*binding.IxNetwork, error) {
 	return b.IxNetworkDialer(ctx, ate)
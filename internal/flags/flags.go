@@ -24,23 +24,67 @@ import (
 )
 
 var (
-	testbed = flag.String("testbed", "", "Path to the Ondatra testbed file")
+	testbed = flag.String("testbed", "", "Path to the Ondatra testbed file. "+
+		"Required unless the test specifies the testbed programmatically.")
 	runTime = flag.Duration("run_time", 0, "Timeout of the test run, excluding the wait time for the testbed to be ready. "+
 		"A zero value means here is no time limit. Must be a non-negative value.")
+	testTime = flag.Duration("test_time", 0, "Timeout of each test. A test that exceeds it fails, the traffic on the ATEs "+
+		"is stopped and the gNMI subscriptions are closed. A zero value means there is no time limit. Must be a non-negative value.")
+	cleanupTime = flag.Duration("cleanup_time", time.Minute, "Time before the -test.timeout deadline at which the run "+
+		"winds down: the traffic on the ATEs is stopped, the gNMI subscriptions are closed, the running test fails and "+
+		"the remaining tests are skipped, so the testbed is released before the deadline. If the run has not ended a "+
+		"quarter of this time before the deadline, the testbed is released anyway. At most half of the time until the "+
+		"deadline is used. A zero value disables the cleanup. Must be a non-negative value.")
 	waitTime = flag.Duration("wait_time", 0, "Maximum amount of time the test should wait until the testbed is ready. "+
 		"A zero value lets the binding implementation choose an appropriate wait time. Must be a non-negative value.")
 	reserve = flag.String("reserve", "", "reservation id or a mapping of device and port IDs to names of the form "+
 		"'dut=mydevice,dut:port1=Ethernet1/1,ate=myixia,ate:port2=2/3'")
-
+	diagDir = flag.String("diag_dir", "", "Directory in which to write a diagnostic bundle of every DUT when a test fails, "+
+		"the healthz status of a DUT when a test finds an unhealthy component, and the packets captured on DUTs. "+
+		"If empty, diagnostics are not collected.")
+	diagPaths = flag.String("diag_paths", "", "Comma-separated gNMI paths whose state is included in the diagnostic bundles, "+
+		"such as '/interfaces,/network-instances'.")
+	ateConfigDir = flag.String("ate_config_dir", "", "Directory in which to write the IxNetwork configs pushed to the ATEs. "+
+		"If empty, the configs are not written.")
+	reportDir = flag.String("report_dir", "", "Directory in which to write a JSON and a JUnit XML report of the tests "+
+		"and their device operations at the end of the run. If empty, no report is written.")
+	complianceReport = flag.Bool("compliance_report", false, "Whether to record the telemetry received by each test "+
+		"that does not comply with the schema, such as values of the wrong type, in the report. Requires -report_dir.")
+	gnmiLogDir = flag.String("gnmi_log_dir", "", "Directory in which to log the gNMI requests and responses of every test, "+
+		"with credentials redacted. If empty, gNMI RPCs are not logged.")
+	gnmiLogMaxBytes = flag.Int64("gnmi_log_max_bytes", 0, "Maximum size of a gNMI log file of a device in a test, "+
+		"after which the log is truncated. A zero value means there is no limit. Must be a non-negative value.")
+	gnmiRetries = flag.Int("gnmi_retries", 0, "Maximum number of times a gNMI call that fails with an UNAVAILABLE or "+
+		"RESOURCE_EXHAUSTED error is retried, unless the device root specifies otherwise. Must be a non-negative value.")
+	gnmiRetryBackoff = flag.Duration("gnmi_retry_backoff", time.Second, "Time to wait before the first retry of a gNMI call, "+
+		"which doubles before each subsequent retry. Must be a non-negative value.")
+	discoverCaps = flag.Bool("discover_capabilities", false, "Whether to probe the DUTs for all their capabilities "+
+		"when the testbed is reserved. Otherwise, a DUT is probed for a capability when it is first queried.")
+	gnmiKeepalive = flag.Duration("gnmi_keepalive", 0, "Interval at which idle gNMI connections are pinged to detect stalls; "+
+		"a connection is closed if a ping is not acknowledged within the same interval. A zero value disables pings. "+
+		"Note that gRPC servers reject pings more frequent than every 5 minutes by default. Must be a non-negative value.")
 )
 
 // Values is the set of parsed and validated flag values.
 type Values struct {
-	TestbedPath string
-	RunTime     time.Duration
-	WaitTime    time.Duration
-	ResvID      string
-	ResvPartial map[string]string
+	TestbedPath   string
+	RunTime       time.Duration
+	TestTime      time.Duration
+	CleanupTime   time.Duration
+	WaitTime      time.Duration
+	ResvID        string
+	ResvPartial   map[string]string
+	DiagDir       string
+	DiagPaths     []string
+	ATEConfigDir  string
+	ReportDir     string
+	Compliance    bool
+	GNMILogDir    string
+	GNMILogMax    int64
+	GNMIRetries   int
+	GNMIBackoff   time.Duration
+	GNMIKeepalive time.Duration
+	DiscoverCaps  bool
 }
 
 // Parse parse and validates the flag values.
@@ -48,28 +92,69 @@ func Parse() (*Values, error) {
 	if !flag.Parsed() {
 		flag.Parse()
 	}
-	if *testbed == "" {
-		return nil, usererr.New("testbed path not specified")
-	}
 	if *runTime < 0 {
 		return nil, usererr.New("run timeout is negative: %d", *runTime)
 	}
+	if *testTime < 0 {
+		return nil, usererr.New("test timeout is negative: %v", *testTime)
+	}
+	if *cleanupTime < 0 {
+		return nil, usererr.New("cleanup time is negative: %v", *cleanupTime)
+	}
 	if *waitTime < 0 {
 		return nil, usererr.New("wait timeout is negative: %d", *waitTime)
 	}
+	if *gnmiLogMaxBytes < 0 {
+		return nil, usererr.New("gNMI log size limit is negative: %d", *gnmiLogMaxBytes)
+	}
+	if *complianceReport && *reportDir == "" {
+		return nil, usererr.New("compliance report requested without a report directory")
+	}
+	if *gnmiRetries < 0 {
+		return nil, usererr.New("gNMI retry count is negative: %d", *gnmiRetries)
+	}
+	if *gnmiRetryBackoff < 0 {
+		return nil, usererr.New("gNMI retry backoff is negative: %v", *gnmiRetryBackoff)
+	}
+	if *gnmiKeepalive < 0 {
+		return nil, usererr.New("gNMI keepalive interval is negative: %v", *gnmiKeepalive)
+	}
 	resvID, resvPartial, err := parseReserve(*reserve)
 	if err != nil {
 		return nil, err
 	}
 	return &Values{
-		TestbedPath: *testbed,
-		RunTime:     *runTime,
-		WaitTime:    *waitTime,
-		ResvID:      resvID,
-		ResvPartial: resvPartial,
+		TestbedPath:   *testbed,
+		RunTime:       *runTime,
+		TestTime:      *testTime,
+		CleanupTime:   *cleanupTime,
+		WaitTime:      *waitTime,
+		ResvID:        resvID,
+		ResvPartial:   resvPartial,
+		DiagDir:       *diagDir,
+		DiagPaths:     parseList(*diagPaths),
+		ATEConfigDir:  *ateConfigDir,
+		ReportDir:     *reportDir,
+		Compliance:    *complianceReport,
+		GNMILogDir:    *gnmiLogDir,
+		GNMILogMax:    *gnmiLogMaxBytes,
+		GNMIRetries:   *gnmiRetries,
+		GNMIBackoff:   *gnmiRetryBackoff,
+		GNMIKeepalive: *gnmiKeepalive,
+		DiscoverCaps:  *discoverCaps,
 	}, nil
 }
 
+func parseList(list string) []string {
+	var elems []string
+	for _, elem := range strings.Split(list, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
+}
+
 func parseReserve(res string) (string, map[string]string, error) {
 	if res == "" {
 		return "", nil, nil
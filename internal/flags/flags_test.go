@@ -90,3 +90,14 @@ func TestParseReserve(t *testing.T) {
 		})
 	}
 }
+
+func TestParseList(t *testing.T) {
+	got := parseList(" /interfaces, ,/network-instances ")
+	want := []string{"/interfaces", "/network-instances"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseList() got unexpected diff (-want,+got): %s", diff)
+	}
+	if got := parseList(""); got != nil {
+		t.Errorf("parseList(\"\") got %v, want nil", got)
+	}
+}
@@ -15,11 +15,34 @@
 # limitations under the License.
 
 # This script is used to generate the Ondatra Telemetry and Config Go APIs.
+#
+# By default, the APIs are generated from the latest OpenConfig models into
+# the config and telemetry directories. To generate the APIs of another
+# OpenConfig release alongside the default ones, such as for devices with older
+# firmware, set OC_RELEASE to a tag of github.com/openconfig/public and
+# OUTPUT_ROOT to a directory for that release, e.g.
+#
+#   OC_RELEASE=v1.0.0 OUTPUT_ROOT=versions/v1_0_0 ./internal/gnmigen/generate.sh
+#
+# Paths of the generated APIs are bound to a device with Device.AttachRoot.
 
 set -e
 
+OC_RELEASE="${OC_RELEASE:-}"
+OUTPUT_ROOT="${OUTPUT_ROOT:-.}"
+if [ "${OUTPUT_ROOT}" = "." ]; then
+  IMPORT_ROOT=github.com/openconfig/ondatra
+else
+  IMPORT_ROOT="github.com/openconfig/ondatra/${OUTPUT_ROOT}"
+fi
+CONFIG_DIR="${OUTPUT_ROOT}/config"
+TELEMETRY_DIR="${OUTPUT_ROOT}/telemetry"
+
 go install github.com/openconfig/ygot/generator@latest
 git clone https://github.com/openconfig/public.git
+if [ -n "${OC_RELEASE}" ]; then
+  git -C public checkout "${OC_RELEASE}"
+fi
 wget https://raw.githubusercontent.com/openconfig/gnmi/master/metadata/yang/gnmi-collector-metadata.yang
 
 EXCLUDE_MODULES=ietf-interfaces,openconfig-bfd,openconfig-messages
@@ -103,14 +126,14 @@ generator \
   -generate_path_structs=false \
   -prefer_operational_state \
   -list_builder_key_threshold=4 \
-  -output_dir=telemetry \
+  -output_dir="${TELEMETRY_DIR}" \
   -package_name=telemetry \
   -path_structs_split_files_count=10 \
   "${COMMON_ARGS[@]}" \
   "${YANG_FILES[@]}"
 
 go run internal/gnmigen/main/main.go \
-  -output_dir=telemetry \
+  -output_dir="${TELEMETRY_DIR}" \
   -package_name=telemetry \
   -path=public/release/models,public/third_party/ietf \
   -exclude_modules="${EXCLUDE_MODULES}" \
@@ -120,62 +143,62 @@ go run internal/gnmigen/main/main.go \
   "${YANG_FILES[@]}"
 
 # Generate Config API.
-mkdir -p config/device
+mkdir -p "${CONFIG_DIR}/device"
 generator \
   -generate_structs=false \
   -exclude_state \
-  -schema_struct_path=github.com/openconfig/ondatra/telemetry \
-  -output_dir=config \
+  -schema_struct_path="${IMPORT_ROOT}/telemetry" \
+  -output_dir="${CONFIG_DIR}" \
   -package_name=device \
   -path_structs_split_files_count=5 \
   -split_pathstructs_by_module=true \
-  -path_structs_output_file=config/device/device.go \
-  -base_import_path=github.com/openconfig/ondatra/config \
+  -path_structs_output_file="${CONFIG_DIR}/device/device.go" \
+  -base_import_path="${IMPORT_ROOT}/config" \
   -trim_path_package_oc_prefix=true \
   -path_struct_package_suffix="" \
   "${COMMON_ARGS[@]}" \
   "${YANG_FILES[@]}"
 
 go run internal/gnmigen/main/main.go \
-  -output_dir=config \
+  -output_dir="${CONFIG_DIR}" \
   -package_name=device \
   -path=public/release/models,public/third_party/ietf \
   -exclude_modules="${EXCLUDE_MODULES}" \
   -generate_config_func \
   -prefer_shadow_path \
-  -schema_struct_path=github.com/openconfig/ondatra/telemetry \
+  -schema_struct_path="${IMPORT_ROOT}/telemetry" \
   -split_pathstructs_by_module=true \
   -fake_root_helper_filename=device/root_helper.go \
   -fake_root_gnmi_filename=device/device_telem.go \
-  -config_import_path=github.com/openconfig/ondatra/config \
+  -config_import_path="${IMPORT_ROOT}/config" \
   -telemetry_funcs_file_split=5 \
   -telemetry_types_file_split=0 \
   "${YANG_FILES[@]}"
 
 # Generate Telemetry API.
-mkdir -p telemetry/device
+mkdir -p "${TELEMETRY_DIR}/device"
 generator \
   -generate_structs=false \
   -prefer_operational_state \
   -list_builder_key_threshold=4 \
-  -output_dir=telemetry \
+  -output_dir="${TELEMETRY_DIR}" \
   -package_name=device \
-  -path_structs_output_file=telemetry/device/device.go \
+  -path_structs_output_file="${TELEMETRY_DIR}/device/device.go" \
   -split_pathstructs_by_module=true \
-  -schema_struct_path=github.com/openconfig/ondatra/telemetry \
+  -schema_struct_path="${IMPORT_ROOT}/telemetry" \
   -trim_path_package_oc_prefix=true \
   -path_struct_package_suffix="" \
-  -base_import_path=github.com/openconfig/ondatra/telemetry \
+  -base_import_path="${IMPORT_ROOT}/telemetry" \
   -path_structs_split_files_count=10 \
   "${COMMON_ARGS[@]}" \
   "${YANG_FILES[@]}"
 
 go run internal/gnmigen/main/main.go \
-  -output_dir=telemetry \
+  -output_dir="${TELEMETRY_DIR}" \
   -package_name=device \
   -path=public/release/models,public/third_party/ietf \
   -exclude_modules="${EXCLUDE_MODULES}" \
-  -schema_struct_path=github.com/openconfig/ondatra/telemetry \
+  -schema_struct_path="${IMPORT_ROOT}/telemetry" \
   -split_pathstructs_by_module=true \
   -fake_root_helper_filename=device/root_helper.go \
   -fake_root_gnmi_filename=device/device_telem.go \
@@ -183,6 +206,17 @@ go run internal/gnmigen/main/main.go \
   -telemetry_types_file_split=10 \
   "${YANG_FILES[@]}"
 
-find config telemetry -name "*.go" -exec goimports -w {} +
-find config telemetry -name "*.go" -exec gofmt -w -s {} +
+# The APIs of another release share the hand-written helpers of the default.
+if [ "${OUTPUT_ROOT}" != "." ]; then
+  cp config/batch.go "${CONFIG_DIR}/"
+  cp telemetry/schema_helpers.go "${TELEMETRY_DIR}/"
+fi
+
+# Unzip the telemetry schema on first use, via schema_helpers.go, instead of on
+# package initialization.
+sed -i -e '/^func init() {$/,/^}$/d' "${TELEMETRY_DIR}/structs-0.go"
+sed -i -e 's/\bSchemaTree\[/schemaTree()[/g' "${TELEMETRY_DIR}"/structs-*.go
+
+find "${CONFIG_DIR}" "${TELEMETRY_DIR}" -name "*.go" -exec goimports -w {} +
+find "${CONFIG_DIR}" "${TELEMETRY_DIR}" -name "*.go" -exec gofmt -w -s {} +
 rm -rf public gnmi-collector-metadata.yang
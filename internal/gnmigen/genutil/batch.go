@@ -18,8 +18,12 @@ import (
 	"golang.org/x/net/context"
 	"fmt"
 	"testing"
+	"time"
 
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ondatra/internal/report"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
@@ -66,7 +70,9 @@ func (b *SetRequestBatch) WithSubscriptionMode(mode gpb.SubscriptionMode) *SetRe
 // Set creates and makes a single gNMI SetRequest call for the batched set requests.
 func (b *SetRequestBatch) Set(t testing.TB) *gpb.SetResponse {
 	t.Helper()
+	start := time.Now()
 	resp, err := batchSet(context.Background(), "openconfig", b.deviceRoot.Id(), b.deviceRoot.CustomData(), b.req)
+	report.Record(t, deviceName(b.deviceRoot.Id()), "gNMI batch Set", start, err)
 	if err != nil {
 		t.Fatalf("SetRequestBatch.Set: %v", err)
 	}
@@ -94,6 +100,16 @@ func (b *SetRequestBatch) BatchReplace(t testing.TB, n ygot.PathStruct, val inte
 	}
 }
 
+// BatchUnionReplace buffers a union_replace operation in the SetRequestBatch.
+// Union replace requires a gNMI 0.10+ server; a server that does not support
+// it ignores the operation.
+func (b *SetRequestBatch) BatchUnionReplace(t testing.TB, n ygot.PathStruct, val interface{}) {
+	t.Helper()
+	if err := b.batchSet(n, val, unionReplacePath); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // BatchUpdate buffers an update operation in the SetRequestBatch.
 func (b *SetRequestBatch) BatchUpdate(t testing.TB, n ygot.PathStruct, val interface{}) {
 	t.Helper()
@@ -132,8 +148,58 @@ const (
 	replacePath
 	// updatePath represents a SetRequest update.
 	updatePath
+	// unionReplacePath represents a SetRequest union_replace.
+	unionReplacePath
 )
 
+// unionReplaceField is the field number of union_replace in a SetRequest.
+// The vendored gNMI proto predates union_replace, so it is encoded as an
+// unknown field.
+const unionReplaceField = 6
+
+// appendUnionReplace appends a union_replace update to the SetRequest.
+func appendUnionReplace(req *gpb.SetRequest, update *gpb.Update) error {
+	b, err := proto.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("could not marshal union_replace update: %w", err)
+	}
+	raw := req.ProtoReflect().GetUnknown()
+	raw = protowire.AppendTag(raw, unionReplaceField, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, b)
+	req.ProtoReflect().SetUnknown(raw)
+	return nil
+}
+
+// unionReplaces returns the union_replace updates of the SetRequest.
+func unionReplaces(req *gpb.SetRequest) ([]*gpb.Update, error) {
+	var updates []*gpb.Update
+	for raw := req.ProtoReflect().GetUnknown(); len(raw) > 0; {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		raw = raw[n:]
+		if num != unionReplaceField || typ != protowire.BytesType {
+			if n = protowire.ConsumeFieldValue(num, typ, raw); n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			raw = raw[n:]
+			continue
+		}
+		b, n := protowire.ConsumeBytes(raw)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		raw = raw[n:]
+		update := new(gpb.Update)
+		if err := proto.Unmarshal(b, update); err != nil {
+			return nil, fmt.Errorf("could not unmarshal union_replace update: %w", err)
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
 func populateSetRequest(req *gpb.SetRequest, path *gpb.Path, val interface{}, op setOperation) error {
 	if req == nil {
 		return fmt.Errorf("cannot populate a nil SetRequest")
@@ -144,7 +210,7 @@ func populateSetRequest(req *gpb.SetRequest, path *gpb.Path, val interface{}, op
 	switch op {
 	case deletePath:
 		req.Delete = append(req.Delete, path)
-	case replacePath, updatePath:
+	case replacePath, updatePath, unionReplacePath:
 		// Since the GoStructs are generated using preferOperationalState, we
 		// need to turn on preferShadowPath to prefer marshalling config paths.
 		js, err := ygot.Marshal7951(val, ygot.JSONIndent("  "), &ygot.RFC7951JSONConfig{AppendModuleName: true, PreferShadowPath: true})
@@ -160,6 +226,10 @@ func populateSetRequest(req *gpb.SetRequest, path *gpb.Path, val interface{}, op
 			req.Replace = append(req.Replace, update)
 		case updatePath:
 			req.Update = append(req.Update, update)
+		case unionReplacePath:
+			if err := appendUnionReplace(req, update); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"github.com/openconfig/ygot/ygot"
 
@@ -205,3 +206,59 @@ func TestBatchReplaceAndReset_BadPath(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchUnionReplace(t *testing.T) {
+	batch := NewSetRequestBatch(DeviceRoot("42"))
+	ops := []batchSetInput{{
+		DeviceRoot("42").Interface("eth1").Description(),
+		ygot.String("foo"),
+		unionReplacePath,
+	}, {
+		DeviceRoot("42").Interface("eth2").Description(),
+		ygot.String("bar"),
+		replacePath,
+	}, {
+		DeviceRoot("42").Interface("eth3").Description(),
+		ygot.String("baz"),
+		unionReplacePath,
+	}}
+	for _, op := range ops {
+		if err := batch.batchSet(op.path, op.val, op.op); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Round trip the request to check union_replace survives the wire.
+	b, err := proto.Marshal(batch.req)
+	if err != nil {
+		t.Fatalf("cannot marshal SetRequest: %v", err)
+	}
+	req := new(gpb.SetRequest)
+	if err := proto.Unmarshal(b, req); err != nil {
+		t.Fatalf("cannot unmarshal SetRequest: %v", err)
+	}
+	wantReplace := []*gpb.Update{{
+		Path: mustPath(t, "/interfaces/interface[name=eth2]/config/description"),
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{[]byte(`"bar"`)}},
+	}}
+	if diff := cmp.Diff(req.GetReplace(), wantReplace, protocmp.Transform()); diff != "" {
+		t.Errorf("replace (-got, +want):\n%s", diff)
+	}
+	gotUnion, err := unionReplaces(req)
+	if err != nil {
+		t.Fatalf("unionReplaces: unexpected error: %v", err)
+	}
+	wantUnion := []*gpb.Update{{
+		Path: mustPath(t, "/interfaces/interface[name=eth1]/config/description"),
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{[]byte(`"foo"`)}},
+	}, {
+		Path: mustPath(t, "/interfaces/interface[name=eth3]/config/description"),
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{[]byte(`"baz"`)}},
+	}}
+	if diff := cmp.Diff(gotUnion, wantUnion, protocmp.Transform()); diff != "" {
+		t.Errorf("union_replace (-got, +want):\n%s", diff)
+	}
+	batch.Reset()
+	if diff := cmp.Diff(batch.req, &gpb.SetRequest{}, protocmp.Transform()); diff != "" {
+		t.Errorf("after Reset (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// sampleValue is a timestamped leaf value of a collected sample.
+type sampleValue struct {
+	timestamp     time.Time
+	recvTimestamp time.Time
+	path          string
+	val           interface{}
+}
+
+// sampleValues flattens the present samples into their leaf values.
+func sampleValues[T any](data []*Qualified[T]) ([]*sampleValue, error) {
+	var vals []*sampleValue
+	for _, q := range data {
+		if !q.IsPresent() {
+			continue
+		}
+		gs, ok := any(q.val).(ygot.GoStruct)
+		if !ok {
+			val := interface{}(q.val)
+			if e, ok := val.(ygot.GoEnum); ok {
+				name, err := ygot.EnumName(e)
+				if err != nil {
+					return nil, err
+				}
+				val = name
+			}
+			vals = append(vals, &sampleValue{q.Timestamp, q.RecvTimestamp, pathToString(q.Path), val})
+			continue
+		}
+		notifs, err := ygot.TogNMINotifications(gs, q.Timestamp.UnixNano(), ygot.GNMINotificationsConfig{
+			UsePathElem:    true,
+			PathElemPrefix: q.Path.GetElem(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range notifs {
+			for _, u := range n.GetUpdate() {
+				path, err := util.JoinPaths(n.GetPrefix(), u.GetPath())
+				if err != nil {
+					return nil, err
+				}
+				val, err := value.ToScalar(u.GetVal())
+				if err != nil {
+					return nil, err
+				}
+				vals = append(vals, &sampleValue{q.Timestamp, q.RecvTimestamp, pathToString(path), val})
+			}
+		}
+	}
+	return vals, nil
+}
+
+// WriteCSV writes the samples as CSV, with a row for each leaf value of each
+// sample, with the columns timestamp, recv_timestamp, path, and value.
+func WriteCSV[T any](w io.Writer, data []*Qualified[T]) error {
+	vals, err := sampleValues(data)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"timestamp", "recv_timestamp", "path", "value"}); err != nil {
+		return err
+	}
+	for _, v := range vals {
+		if err := cw.Write([]string{
+			v.timestamp.Format(time.RFC3339Nano),
+			v.recvTimestamp.Format(time.RFC3339Nano),
+			v.path,
+			fmt.Sprint(v.val),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteOpenMetrics writes the numeric and boolean leaf values of the samples
+// in the OpenMetrics text format, as a gauge metric family named
+// ondatra_telemetry with a path label. Other values are omitted.
+func WriteOpenMetrics[T any](w io.Writer, data []*Qualified[T]) error {
+	vals, err := sampleValues(data)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	b.WriteString("# TYPE ondatra_telemetry gauge\n")
+	b.WriteString("# HELP ondatra_telemetry Collected telemetry values.\n")
+	for _, v := range vals {
+		num, ok := numericValue(v.val)
+		if !ok {
+			continue
+		}
+		ts := float64(v.timestamp.UnixNano()) / float64(time.Second)
+		fmt.Fprintf(&b, "ondatra_telemetry{path=%s} %s %s\n", strconv.Quote(v.path), num, strconv.FormatFloat(ts, 'f', -1, 64))
+	}
+	b.WriteString("# EOF\n")
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// numericValue returns the value formatted as an OpenMetrics number, if it is
+// numeric or boolean.
+func numericValue(val interface{}) (string, bool) {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64), true
+	case reflect.Bool:
+		if rv.Bool() {
+			return "1", true
+		}
+		return "0", true
+	}
+	return "", false
+}
+
+// ExportCSV writes the samples as CSV to the file at the path, as WriteCSV
+// does, and fails the test fatally on error.
+func ExportCSV[T any](t testing.TB, path string, data []*Qualified[T]) {
+	t.Helper()
+	if err := exportFile(path, func(w io.Writer) error { return WriteCSV(w, data) }); err != nil {
+		t.Fatalf("ExportCSV(t, %q) failed: %v", path, err)
+	}
+}
+
+// ExportOpenMetrics writes the samples in the OpenMetrics text format to the
+// file at the path, as WriteOpenMetrics does, and fails the test fatally on
+// error.
+func ExportOpenMetrics[T any](t testing.TB, path string, data []*Qualified[T]) {
+	t.Helper()
+	if err := exportFile(path, func(w io.Writer) error { return WriteOpenMetrics(w, data) }); err != nil {
+		t.Fatalf("ExportOpenMetrics(t, %q) failed: %v", path, err)
+	}
+}
+
+func exportFile(path string, write func(io.Writer) error) (rerr error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && rerr == nil {
+			rerr = err
+		}
+	}()
+	return write(f)
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestWriteCSV(t *testing.T) {
+	ts, recv := time.Unix(1, 500), time.Unix(2, 0)
+	data := []*Qualified[*Model_SingleKey]{
+		(&Qualified[*Model_SingleKey]{Metadata: &Metadata{
+			Path:          mustPath(t, "/super-container/model/a/single-key[key=1]"),
+			Timestamp:     ts,
+			RecvTimestamp: recv,
+		}}).SetVal(&Model_SingleKey{Value: ygot.Int64(43)}),
+		{Metadata: &Metadata{Path: mustPath(t, "/super-container/model/a/single-key[key=1]")}},
+	}
+	var b strings.Builder
+	if err := WriteCSV(&b, data); err != nil {
+		t.Fatalf("WriteCSV() got unexpected error: %v", err)
+	}
+	want := "timestamp,recv_timestamp,path,value\n" +
+		ts.Format(time.RFC3339Nano) + "," + recv.Format(time.RFC3339Nano) + ",/super-container/model/a/single-key[key=1]/config/value,43\n"
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("WriteCSV() got unexpected diff (-want,+got): %s", diff)
+	}
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	sample := func(path string, sec int64, val interface{}) *Qualified[interface{}] {
+		return (&Qualified[interface{}]{Metadata: &Metadata{
+			Path:      mustPath(t, path),
+			Timestamp: time.Unix(sec, 0),
+		}}).SetVal(val)
+	}
+	data := []*Qualified[interface{}]{
+		sample("/a/counter", 1, uint64(7)),
+		sample("/a/enabled", 2, true),
+		sample("/a/rate", 3, 1.5),
+		sample("/a/description", 4, "skipped"),
+	}
+	var b strings.Builder
+	if err := WriteOpenMetrics(&b, data); err != nil {
+		t.Fatalf("WriteOpenMetrics() got unexpected error: %v", err)
+	}
+	want := `# TYPE ondatra_telemetry gauge
+# HELP ondatra_telemetry Collected telemetry values.
+ondatra_telemetry{path="/a/counter"} 7 1
+ondatra_telemetry{path="/a/enabled"} 1 2
+ondatra_telemetry{path="/a/rate"} 1.5 3
+# EOF
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("WriteOpenMetrics() got unexpected diff (-want,+got): %s", diff)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "samples.csv")
+	ExportCSV(t, path, []*Qualified[int]{(&Qualified[int]{Metadata: &Metadata{
+		Path: mustPath(t, "/a/b"),
+	}}).SetVal(1)})
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) got unexpected error: %v", path, err)
+	}
+	if want := "/a/b,1\n"; !strings.HasSuffix(string(got), want) {
+		t.Errorf("ExportCSV() wrote %q, want suffix %q", got, want)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/openconfig/ygot/ytypes"
 	"github.com/openconfig/gnmi/errlist"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/report"
 	"github.com/openconfig/ondatra/internal/testbed"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
@@ -208,6 +210,15 @@ func prettySetRequest(setRequest *gpb.SetRequest) string {
 		writePath(update.Path)
 		writeVal(update.Val)
 	}
+	unionReplace, err := unionReplaces(setRequest)
+	if err != nil {
+		fmt.Fprintf(&buf, "-------invalid union_replace: %v------\n", err)
+	}
+	for i, update := range unionReplace {
+		fmt.Fprintf(&buf, "-------union_replace path/value pair #%d------\n", i)
+		writePath(update.Path)
+		writeVal(update.Val)
+	}
 	return buf.String()
 }
 
@@ -217,6 +228,7 @@ func MustUnmarshal(t testing.TB, data []*DataPoint, schema *ytypes.Schema, goStr
 
 	t.Helper()
 	md, ok, err := Unmarshal(data, schema, goStructName, structPtr, queryPath, isLeaf, reverseShadowPaths)
+	RecordComplianceErrors(t, md.ComplianceErrors)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -238,6 +250,39 @@ func MustUnmarshal(t testing.TB, data []*DataPoint, schema *ytypes.Schema, goStr
 func Unmarshal(data []*DataPoint, schema *ytypes.Schema, goStructName string, structPtr ygot.GoStruct,
 	queryPath *gpb.Path, isLeaf bool, reverseShadowPaths bool) (*Metadata, bool, error) {
 
+	return unmarshalMetadata(data, schema, goStructName, structPtr, queryPath, isLeaf, reverseShadowPaths, false)
+}
+
+// MustUnmarshalUpdates calls UnmarshalUpdates and fails the calling test fatally on error.
+func MustUnmarshalUpdates(t testing.TB, data []*DataPoint, schema *ytypes.Schema, goStructName string, structPtr ygot.GoStruct,
+	queryPath *gpb.Path, isLeaf bool, reverseShadowPaths bool) (*Metadata, bool) {
+
+	t.Helper()
+	md, ok, err := UnmarshalUpdates(data, schema, goStructName, structPtr, queryPath, isLeaf, reverseShadowPaths)
+	RecordComplianceErrors(t, md.ComplianceErrors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return md, ok
+}
+
+// UnmarshalUpdates is like Unmarshal, but only validates the nodes set by
+// the given datapoints, rather than the whole GoStruct. It is intended for
+// applying a stream of updates to the same GoStruct, where validating the
+// whole GoStruct on every update is too slow for large containers.
+// NOTE: Constraints that span several nodes, such as the uniqueness of list
+// keys, are not checked.
+func UnmarshalUpdates(data []*DataPoint, schema *ytypes.Schema, goStructName string, structPtr ygot.GoStruct,
+	queryPath *gpb.Path, isLeaf bool, reverseShadowPaths bool) (*Metadata, bool, error) {
+
+	return unmarshalMetadata(data, schema, goStructName, structPtr, queryPath, isLeaf, reverseShadowPaths, true)
+}
+
+// unmarshalMetadata unmarshals the data and returns its metadata, validating
+// only the updated nodes if incremental is true.
+func unmarshalMetadata(data []*DataPoint, schema *ytypes.Schema, goStructName string, structPtr ygot.GoStruct,
+	queryPath *gpb.Path, isLeaf, reverseShadowPaths, incremental bool) (*Metadata, bool, error) {
+
 	ret := &Metadata{
 		Path: queryPath,
 	}
@@ -245,7 +290,7 @@ func Unmarshal(data []*DataPoint, schema *ytypes.Schema, goStructName string, st
 		return ret, false, nil
 	}
 
-	unmarshalledData, complianceErrs, err := unmarshal(data, schema.SchemaTree[goStructName], structPtr, queryPath, schema, isLeaf, reverseShadowPaths)
+	unmarshalledData, complianceErrs, err := unmarshalData(data, schema.SchemaTree[goStructName], structPtr, queryPath, schema, isLeaf, reverseShadowPaths, incremental)
 	ret.ComplianceErrors = complianceErrs
 	if err != nil {
 		return ret, false, err
@@ -270,6 +315,50 @@ func Unmarshal(data []*DataPoint, schema *ytypes.Schema, goStructName string, st
 	return ret, true, nil
 }
 
+// RecordComplianceErrors records the compliance errors as violations in the
+// test report, if recording violations is enabled.
+func RecordComplianceErrors(t testing.TB, errs *ComplianceErrors) {
+	if errs == nil {
+		return
+	}
+	recordTelemetryErrors := func(kind string, terrs []*TelemetryError) {
+		for _, terr := range terrs {
+			var path, value string
+			if terr.Path != nil {
+				path = pathToString(terr.Path)
+			}
+			if terr.Value != nil {
+				value = prototext.Format(terr.Value)
+			}
+			report.RecordViolation(t, kind, path, value, terr.Err)
+		}
+	}
+	recordTelemetryErrors("path", errs.PathErrors)
+	recordTelemetryErrors("type", errs.TypeErrors)
+	for _, err := range errs.ValidateErrors {
+		report.RecordViolation(t, "value", "", "", err)
+	}
+}
+
+// record records a gNMI operation at the path in the test report.
+func record(t testing.TB, desc string, path *gpb.Path, start time.Time, err error) {
+	report.Record(t, deviceName(path.GetTarget()), fmt.Sprintf("%s %s", desc, pathToString(path)), start, err)
+}
+
+// deviceName returns the name of the reserved device with the specified ID,
+// or the ID if there is no such device.
+func deviceName(id string) string {
+	res, err := testbed.Reservation()
+	if err != nil {
+		return id
+	}
+	dev, err := testbed.Device(res, id)
+	if err != nil {
+		return id
+	}
+	return dev.Dimensions().Name
+}
+
 // pathToString returns a string version of the input path for display during
 // debugging.
 func pathToString(path *gpb.Path) string {
@@ -299,6 +388,12 @@ func PathStructToString(ps ygot.PathStruct) string {
 // The second error slice are internal errors, while the returned
 // *ComplianceError stores the compliance errors.
 func unmarshal(data []*DataPoint, structSchema *yang.Entry, structPtr ygot.GoStruct, queryPath *gpb.Path, schema *ytypes.Schema, isLeaf, reverseShadowPaths bool) ([]*DataPoint, *ComplianceErrors, error) {
+	return unmarshalData(data, structSchema, structPtr, queryPath, schema, isLeaf, reverseShadowPaths, false)
+}
+
+// unmarshalData is like unmarshal, but if incremental is true, it only
+// validates the nodes set by the datapoints rather than the whole GoStruct.
+func unmarshalData(data []*DataPoint, structSchema *yang.Entry, structPtr ygot.GoStruct, queryPath *gpb.Path, schema *ytypes.Schema, isLeaf, reverseShadowPaths, incremental bool) ([]*DataPoint, *ComplianceErrors, error) {
 	queryPathStr := pathToString(queryPath)
 	if isLeaf {
 		switch {
@@ -314,6 +409,7 @@ func unmarshal(data []*DataPoint, structSchema *yang.Entry, structPtr ygot.GoStr
 	}
 
 	var unmarshalledDatapoints []*DataPoint
+	var setPaths []*gpb.Path
 	var pathUnmarshalErrs []*TelemetryError
 	var typeUnmarshalErrs []*TelemetryError
 
@@ -382,23 +478,51 @@ func unmarshal(data []*DataPoint, structSchema *yang.Entry, structPtr ygot.GoStr
 			// 2. Check for type compliance (since path should already be compliant).
 			if err := ytypes.SetNode(structSchema, structPtr, relPath, dp.Value, sopts...); err == nil {
 				unmarshalledDatapoints = append(unmarshalledDatapoints, dp)
+				setPaths = append(setPaths, relPath)
 			} else {
 				typeUnmarshalErrs = append(typeUnmarshalErrs, &TelemetryError{Path: dp.Path, Value: dp.Value, Err: err})
 			}
 		}
 	}
 	// 3. Check for value (restriction) compliance.
-	validateErrs := ytypes.Validate(structSchema, structPtr)
+	var validateErrs []error
+	if incremental {
+		validateErrs = validateNodes(structSchema, structPtr, setPaths, reverseShadowPaths)
+	} else {
+		validateErrs = ytypes.Validate(structSchema, structPtr)
+	}
 	if pathUnmarshalErrs != nil || typeUnmarshalErrs != nil || validateErrs != nil {
 		return unmarshalledDatapoints, &ComplianceErrors{PathErrors: pathUnmarshalErrs, TypeErrors: typeUnmarshalErrs, ValidateErrors: validateErrs}, errs.Err()
 	}
 	return unmarshalledDatapoints, nil, errs.Err()
 }
 
+// validateNodes validates the nodes at the given paths relative to structPtr.
+func validateNodes(structSchema *yang.Entry, structPtr ygot.GoStruct, paths []*gpb.Path, reverseShadowPaths bool) []error {
+	var gopts []ytypes.GetNodeOpt
+	if reverseShadowPaths {
+		gopts = append(gopts, &ytypes.PreferShadowPath{})
+	}
+	var errs []error
+	for _, path := range paths {
+		nodes, err := ytypes.GetNode(structSchema, structPtr, path, gopts...)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, node := range nodes {
+			errs = append(errs, ytypes.Validate(node.Schema, node.Data)...)
+		}
+	}
+	return errs
+}
+
 // MustGet calls Get and fails the calling test fatally on error.
 func MustGet(t testing.TB, n ygot.PathStruct, subPaths ...*gpb.Path) ([]*DataPoint, *gpb.Path) {
 	t.Helper()
+	start := time.Now()
 	data, path, err := Get(context.Background(), n, subPaths...)
+	record(t, "gNMI Get", path, start, err)
 	if err != nil {
 		t.Fatalf("Get(t) at path %s: %v", path, err)
 	}
@@ -407,11 +531,21 @@ func MustGet(t testing.TB, n ygot.PathStruct, subPaths ...*gpb.Path) ([]*DataPoi
 
 // Get does gNMI ONCE subscription for the device under n. SubPaths, if set, override the subscription paths.
 func Get(ctx context.Context, n ygot.PathStruct, subPaths ...*gpb.Path) ([]*DataPoint, *gpb.Path, error) {
-	sub, path, err := subscribe(ctx, n, subPaths, gpb.SubscriptionList_ONCE)
+	path, dev, opts, err := resolve(ctx, n)
 	if err != nil {
 		return nil, path, errors.Wrap(err, "cannot subscribe to gNMI client")
 	}
-	data, err := receiveAll(sub, false, gpb.SubscriptionList_ONCE)
+	var data []*DataPoint
+	err = opts.retryPolicy().retry(ctx, func() error {
+		sub, err := subscribe(ctx, path, dev, opts, subPaths, gpb.SubscriptionList_ONCE)
+		if err != nil {
+			return errors.Wrap(err, "cannot subscribe to gNMI client")
+		}
+		data, err = receiveAll(sub, false, opts.strict, gpb.SubscriptionList_ONCE)
+		return err
+	})
+	// Use the target only for the subscription but exclude from the datapoint construction.
+	path.Target = ""
 	if err != nil {
 		return nil, path, err
 	}
@@ -424,6 +558,7 @@ type Metadata struct {
 	Timestamp        time.Time         // Timestamp is the sample time.
 	RecvTimestamp    time.Time         // Timestamp is the time the test received the sample.
 	ComplianceErrors *ComplianceErrors // ComplianceErrors contains the compliance errors encountered from an Unmarshal operation.
+	Reconnected      bool              // Reconnected is whether the subscription reconnected before the sample, so earlier samples may be missing.
 }
 
 // GetPath returns the YANG query path for this value.
@@ -446,6 +581,16 @@ func (q *Metadata) GetComplianceErrors() *ComplianceErrors {
 	return q.ComplianceErrors
 }
 
+// GetReconnected returns whether the subscription reconnected before this
+// value was received, so that earlier values may be missing.
+func (q *Metadata) GetReconnected() bool {
+	return q.Reconnected
+}
+
+func (q *Metadata) setReconnected() {
+	q.Reconnected = true
+}
+
 // QualifiedValue is an interface for generated telemetry types.
 type QualifiedValue interface {
 	GetPath() *gpb.Path
@@ -464,7 +609,9 @@ type ConvertFunc func([]*DataPoint, *gpb.Path) (QualifiedValue, error)
 // MustWatch retrieves a Collection sample for a PathStruct and evaluates data against the predicate.
 func MustWatch(t testing.TB, n ygot.PathStruct, paths []*gpb.Path, duration time.Duration, isLeaf bool, converter ConvertFunc, pred Predicate) *Watcher {
 	t.Helper()
+	start := time.Now()
 	w, path, err := watch(context.Background(), n, paths, duration, isLeaf, converter, pred)
+	record(t, "gNMI Watch", path, start, err)
 	if err != nil {
 		t.Fatalf("Watch(t) at path %s: %v", path, err)
 	}
@@ -480,25 +627,39 @@ func watch(ctx context.Context, n ygot.PathStruct, paths []*gpb.Path, duration t
 	collectEnd := time.Now().Add(duration)
 	if time.Now().Before(collectEnd) {
 		ctx, cancel = context.WithDeadline(ctx, collectEnd)
+		cancel = trackStream(cancel)
 		// Only cancel the context in this function if there is an error;
 		// otherwise it is up to the asynchronous go routine to cancel.
 		defer closer.CloseVoidOnErr(&rerr, cancel)
 		mode = gpb.SubscriptionList_STREAM
 	}
-	sub, path, err := subscribe(ctx, n, paths, mode)
+	path, dev, opts, err := resolve(ctx, n)
+	if err != nil {
+		return nil, path, errors.Wrap(err, "cannot subscribe to gNMI client")
+	}
+	var sub gpb.GNMI_SubscribeClient
+	err = opts.retryPolicy().retry(ctx, func() error {
+		sub, err = subscribe(ctx, path, dev, opts, paths, mode)
+		return err
+	})
+	// Use the target only for the subscription but exclude from the datapoint construction.
+	path.Target = ""
 	if err != nil {
 		return nil, path, errors.Wrap(err, "cannot subscribe to gNMI client")
 	}
 
 	c := &Watcher{
-		err:  make(chan error, 1),
+		done: make(chan struct{}),
 		path: path,
 	}
 
+	resubscribe := func() (gpb.GNMI_SubscribeClient, error) {
+		return subscribe(ctx, path, dev, opts, paths, mode)
+	}
 	go func() {
 		defer cancel()
-		err := receiveUntil(sub, mode, path, isLeaf, converter, pred)
-		c.err <- err
+		c.err = receiveUntil(ctx, sub, resubscribe, opts.retryPolicy(), mode, opts.strict, path, isLeaf, converter, pred)
+		close(c.done)
 	}()
 
 	return c, path, nil
@@ -507,16 +668,34 @@ func watch(ctx context.Context, n ygot.PathStruct, paths []*gpb.Path, duration t
 // receiveUntil receives gNMI notifications until predicate is true (if set) or subscription times out.
 // Note: For leaves the converter and predicate are evaluated once per DataPoint. For non-leaves,
 // they are evaluated once per notification, after the first sync is received.
-func receiveUntil(sub gpb.GNMI_SubscribeClient, mode gpb.SubscriptionList_Mode, path *gpb.Path, isLeaf bool, converter ConvertFunc, pred Predicate) error {
+// If a STREAM subscription fails with a transient error, it is resubscribed as
+// many times as the retry policy allows, and the first value received after
+// each resubscription is marked as reconnected.
+func receiveUntil(ctx context.Context, sub gpb.GNMI_SubscribeClient, resubscribe func() (gpb.GNMI_SubscribeClient, error), policy *RetryPolicy,
+	mode gpb.SubscriptionList_Mode, strict bool, path *gpb.Path, isLeaf bool, converter ConvertFunc, pred Predicate) error {
 	var recvData []*DataPoint
 	var hasSynced bool
 	var sync bool
+	var reconnects int
+	var reconnected bool
 	var err error
 
 	for {
-		recvData, sync, err = receive(sub, recvData, true)
+		recvData, sync, err = receive(sub, recvData, true, strict)
 		if err != nil {
-			return errors.Wrap(err, "error receiving gNMI response")
+			if mode != gpb.SubscriptionList_STREAM || reconnects >= policy.MaxRetries || !isTransient(err) {
+				return errors.Wrap(err, "error receiving gNMI response")
+			}
+			log.Warningf("Resubscribing to %s after transient error: %v", pathToString(path), err)
+			if !policy.wait(ctx, reconnects) {
+				return errors.Wrap(err, "error receiving gNMI response")
+			}
+			reconnects++
+			if sub, err = resubscribe(); err != nil {
+				return errors.Wrap(err, "error resubscribing to gNMI")
+			}
+			recvData, hasSynced, reconnected = nil, false, true
+			continue
 		}
 		if mode == gpb.SubscriptionList_ONCE && sync {
 			return nil
@@ -547,6 +726,10 @@ func receiveUntil(sub gpb.GNMI_SubscribeClient, mode gpb.SubscriptionList_Mode,
 				log.V(0).Infof("noncompliant data encountered during receiveUntil, ignoring value: %v", complianceErrs)
 				continue
 			}
+			if md, ok := val.(interface{ setReconnected() }); ok && reconnected {
+				md.setReconnected()
+				reconnected = false
+			}
 			if pred(val) {
 				return nil
 			}
@@ -557,14 +740,16 @@ func receiveUntil(sub gpb.GNMI_SubscribeClient, mode gpb.SubscriptionList_Mode,
 
 // Watcher represents an ongoing watch of telemetry values.
 type Watcher struct {
-	err  chan error
+	done chan struct{} // Closed when the watch finishes.
+	err  error
 	path *gpb.Path
 }
 
 // Await waits for the watch to finish and returns a boolean indicating whether the predicate evaluated to true.
 func (c *Watcher) Await(t testing.TB) bool {
 	t.Helper()
-	err := <-c.err
+	<-c.done
+	err := c.err
 	isTimeout := false
 	if err != nil {
 		// if the err is gRPC timeout, then the predicate was never true
@@ -578,6 +763,46 @@ func (c *Watcher) Await(t testing.TB) bool {
 	return !isTimeout
 }
 
+// AwaitAll waits for all the watchers, which run concurrently, and returns
+// whether all their predicates evaluated to true. It returns false as soon as
+// any watch times out without its predicate becoming true.
+func AwaitAll(t testing.TB, watchers ...*Watcher) bool {
+	t.Helper()
+	finished := awaitEach(watchers)
+	for range watchers {
+		if !(<-finished).Await(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// AwaitAny waits for any of the watchers, which run concurrently, and returns
+// whether any of their predicates evaluated to true. It returns true as soon as
+// any predicate becomes true.
+func AwaitAny(t testing.TB, watchers ...*Watcher) bool {
+	t.Helper()
+	finished := awaitEach(watchers)
+	for range watchers {
+		if (<-finished).Await(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// awaitEach returns a channel that receives each watcher when it finishes.
+func awaitEach(watchers []*Watcher) <-chan *Watcher {
+	finished := make(chan *Watcher, len(watchers))
+	for _, w := range watchers {
+		go func(w *Watcher) {
+			<-w.done
+			finished <- w
+		}(w)
+	}
+	return finished
+}
+
 func batchSet(ctx context.Context, origin string, target string, customData map[string]interface{}, req *gpb.SetRequest) (*gpb.SetResponse, error) {
 	dev, opts, err := resolveBatch(ctx, target, customData)
 	if err != nil {
@@ -622,7 +847,9 @@ func resolveBatch(ctx context.Context, target string, customData map[string]inte
 // for the path specified by the path struct.
 func Delete(t testing.TB, n ygot.PathStruct) *gpb.SetResponse {
 	t.Helper()
+	start := time.Now()
 	resp, path, err := set(context.Background(), n, nil, deletePath)
+	record(t, "gNMI Delete", path, start, err)
 	if err != nil {
 		t.Fatalf("Delete(t) at path %s: %v", path, err)
 	}
@@ -633,7 +860,9 @@ func Delete(t testing.TB, n ygot.PathStruct) *gpb.SetResponse {
 // for the path specified by the path struct.
 func Replace(t testing.TB, n ygot.PathStruct, val interface{}) *gpb.SetResponse {
 	t.Helper()
+	start := time.Now()
 	resp, path, err := set(context.Background(), n, val, replacePath)
+	record(t, "gNMI Replace", path, start, err)
 	if err != nil {
 		t.Fatalf("Replace(t, %v) at path %s: %v", val, path, err)
 	}
@@ -644,7 +873,9 @@ func Replace(t testing.TB, n ygot.PathStruct, val interface{}) *gpb.SetResponse
 // for the path specified by the path struct.
 func Update(t testing.TB, n ygot.PathStruct, val interface{}) *gpb.SetResponse {
 	t.Helper()
+	start := time.Now()
 	resp, path, err := set(context.Background(), n, val, updatePath)
+	record(t, "gNMI Update", path, start, err)
 	if err != nil {
 		t.Fatalf("Update(t, %v) at path %s: %v", val, path, err)
 	}
@@ -673,7 +904,12 @@ func setVals(ctx context.Context, dev binding.Device, opts *requestOpts, origin,
 	ctx = metadata.NewOutgoingContext(ctx, opts.md)
 
 	log.V(1).Info(prettySetRequest(req))
-	resp, err := opts.client.Set(ctx, req)
+	var resp *gpb.SetResponse
+	err := opts.retryPolicy().retry(ctx, func() error {
+		var err error
+		resp, err = opts.client.Set(ctx, req)
+		return err
+	})
 	log.V(1).Infof("SetResponse:\n%s", prototext.Format(resp))
 	if err != nil {
 		return nil, fmt.Errorf("SetRequest unsuccessful: %w", err)
@@ -734,7 +970,9 @@ func LatestRecvTimestamp(data []*DataPoint) time.Time {
 // Each bundle is identified by a common prefix path of length prefixLen. A
 // slice of sorted prefixes is returned so users can examine each group
 // deterministically. If any path is longer than prefixLen, then it is stored
-// in a special "/" bundle.
+// in a special "/" bundle. Datapoints with the same path are deduplicated,
+// keeping only the one with the latest timestamp, or the last one of those
+// with the same timestamp.
 func BundleDatapoints(t testing.TB, datapoints []*DataPoint, prefixLen uint) (map[string][]*DataPoint, []string) {
 	t.Helper()
 	groups, prefixes, err := bundleDatapoints(datapoints, prefixLen)
@@ -747,7 +985,7 @@ func BundleDatapoints(t testing.TB, datapoints []*DataPoint, prefixLen uint) (ma
 func bundleDatapoints(datapoints []*DataPoint, prefixLen uint) (map[string][]*DataPoint, []string, error) {
 	groups := map[string][]*DataPoint{}
 
-	for _, dp := range datapoints {
+	for _, dp := range latestDatapoints(datapoints) {
 		elems := dp.Path.GetElem()
 		if uint(len(elems)) < prefixLen {
 			groups["/"] = append(groups["/"], dp)
@@ -769,19 +1007,73 @@ func bundleDatapoints(datapoints []*DataPoint, prefixLen uint) (map[string][]*Da
 	return groups, prefixes, nil
 }
 
-// subscribe create a gNMI SubscribeClient. Specifying subPaths is optional, if unset will subscribe to the path at n.
-func subscribe(ctx context.Context, n ygot.PathStruct, subPaths []*gpb.Path, mode gpb.SubscriptionList_Mode) (_ gpb.GNMI_SubscribeClient, _ *gpb.Path, rerr error) {
-	path, dev, opts, err := resolve(ctx, n)
-	if err != nil {
-		return nil, path, err
+// latestDatapoints returns the datapoints without those that have the same
+// path as a later or more recent datapoint, retaining their order.
+func latestDatapoints(datapoints []*DataPoint) []*DataPoint {
+	latest := make(map[string]int)
+	for i, dp := range datapoints {
+		if dp.Path == nil {
+			continue
+		}
+		pathStr := pathToString(dp.Path)
+		if j, ok := latest[pathStr]; !ok || !datapoints[j].Timestamp.After(dp.Timestamp) {
+			latest[pathStr] = i
+		}
+	}
+	var deduped []*DataPoint
+	for i, dp := range datapoints {
+		if dp.Path == nil || latest[pathToString(dp.Path)] == i {
+			deduped = append(deduped, dp)
+		}
+	}
+	return deduped
+}
+
+var (
+	streamsMu sync.Mutex
+	// streams are the cancel functions of the streaming subscriptions in
+	// progress, keyed by a unique ID.
+	streams    = make(map[uint64]context.CancelFunc)
+	nextStream uint64
+)
+
+// trackStream records the cancel function of a streaming subscription, so it
+// can be closed by CloseSubscriptions, and returns a function that cancels the
+// subscription and stops tracking it.
+func trackStream(cancel context.CancelFunc) context.CancelFunc {
+	streamsMu.Lock()
+	defer streamsMu.Unlock()
+	id := nextStream
+	nextStream++
+	streams[id] = cancel
+	return func() {
+		streamsMu.Lock()
+		delete(streams, id)
+		streamsMu.Unlock()
+		cancel()
+	}
+}
+
+// CloseSubscriptions cancels all the streaming subscriptions in progress, such
+// as those of watchers and collectors, which then end with a canceled error.
+func CloseSubscriptions() {
+	streamsMu.Lock()
+	defer streamsMu.Unlock()
+	for id, cancel := range streams {
+		cancel()
+		delete(streams, id)
 	}
+}
+
+// subscribe create a gNMI SubscribeClient. Specifying subPaths is optional, if unset will subscribe to path.
+func subscribe(ctx context.Context, path *gpb.Path, dev binding.Device, opts *requestOpts, subPaths []*gpb.Path, mode gpb.SubscriptionList_Mode) (_ gpb.GNMI_SubscribeClient, rerr error) {
 	if len(subPaths) == 0 {
 		subPaths = []*gpb.Path{path}
 	}
 	ctx = metadata.NewOutgoingContext(ctx, opts.md)
 	sub, err := opts.client.Subscribe(ctx)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "gNMI failed to Subscribe")
+		return nil, errors.Wrap(err, "gNMI failed to Subscribe")
 	}
 	defer closer.Close(&rerr, sub.CloseSend, "error closing gNMI send stream")
 
@@ -792,7 +1084,8 @@ func subscribe(ctx context.Context, n ygot.PathStruct, subPaths []*gpb.Path, mod
 				Elem:   path.GetElem(),
 				Origin: path.GetOrigin(),
 			},
-			Mode: opts.subMode,
+			Mode:              opts.subMode,
+			HeartbeatInterval: uint64(opts.heartbeat.Nanoseconds()),
 		})
 	}
 
@@ -805,16 +1098,15 @@ func subscribe(ctx context.Context, n ygot.PathStruct, subPaths []*gpb.Path, mod
 				Subscription: subs,
 				Mode:         mode,
 				Encoding:     gpb.Encoding_PROTO,
+				UpdatesOnly:  opts.updatesOnly,
 			},
 		},
 	}
 	log.V(1).Info(prototext.Format(sr))
 	if err := sub.Send(sr); err != nil {
-		return nil, nil, errors.Wrapf(err, "gNMI failed to Send(%+v)", sr)
+		return nil, errors.Wrapf(err, "gNMI failed to Send(%+v)", sr)
 	}
-	// Use the target only for the subscription but exclude from the datapoint construction.
-	path.Target = ""
-	return sub, path, nil
+	return sub, nil
 }
 
 // pathElemSlicesEqual compares whether two PathElem slices are equal.
@@ -830,12 +1122,29 @@ func pathElemSlicesEqual(a, b []*gpb.PathElem) bool {
 	return true
 }
 
+// normalizePath returns the path of an update relative to the prefix of its
+// notification. Some servers send the full path of each update along with the
+// prefix; since update paths are relative to the prefix, any such path is
+// trimmed, or causes an error if strict is true.
+func normalizePath(prefix, p *gpb.Path, strict bool) (*gpb.Path, error) {
+	prefixElems := prefix.GetElem()
+	if len(prefixElems) == 0 || len(p.GetElem()) < len(prefixElems) || !pathElemSlicesEqual(p.GetElem()[:len(prefixElems)], prefixElems) {
+		return p, nil
+	}
+	if strict {
+		return nil, errors.Errorf("path %s repeats the notification prefix %s", pathToString(p), pathToString(prefix))
+	}
+	trimmed := proto.Clone(p).(*gpb.Path)
+	trimmed.Elem = trimmed.Elem[len(prefixElems):]
+	return trimmed, nil
+}
+
 // receiveAll receives data until the context deadline is reached, or when in
 // ONCE mode, a sync response is received.
-func receiveAll(sub gpb.GNMI_SubscribeClient, deletesExpected bool, mode gpb.SubscriptionList_Mode) (data []*DataPoint, err error) {
+func receiveAll(sub gpb.GNMI_SubscribeClient, deletesExpected, strict bool, mode gpb.SubscriptionList_Mode) (data []*DataPoint, err error) {
 	for {
 		var sync bool
-		data, sync, err = receive(sub, data, deletesExpected)
+		data, sync, err = receive(sub, data, deletesExpected, strict)
 		if err != nil {
 			// DeadlineExceeded is expected when collections are complete.
 			if st, ok := status.FromError(err); ok && st.Code() == codes.DeadlineExceeded {
@@ -856,7 +1165,10 @@ func receiveAll(sub gpb.GNMI_SubscribeClient, deletesExpected bool, mode gpb.Sub
 // the data is returned as-is and the second return value is true. If Delete paths are present in
 // the update, they are appended to the given data before the Update values. If deletesExpected
 // is false, however, any deletes received will cause an error.
-func receive(sub gpb.GNMI_SubscribeClient, data []*DataPoint, deletesExpected bool) ([]*DataPoint, bool, error) {
+// Paths that wrongly repeat the prefix of their notification are normalized,
+// and paths updated twice within a notification are kept, unless strict is true,
+// in which case either causes an error.
+func receive(sub gpb.GNMI_SubscribeClient, data []*DataPoint, deletesExpected, strict bool) ([]*DataPoint, bool, error) {
 	res, err := sub.Recv()
 	if err != nil {
 		return data, false, err
@@ -870,7 +1182,17 @@ func receive(sub gpb.GNMI_SubscribeClient, data []*DataPoint, deletesExpected bo
 			return data, false, errors.Errorf("unexpected delete updates: %v", n.Delete)
 		}
 		ts := time.Unix(0, n.GetTimestamp())
+		seen := make(map[string]bool)
 		newDataPoint := func(p *gpb.Path, val *gpb.TypedValue) (*DataPoint, error) {
+			p, err := normalizePath(n.GetPrefix(), p, strict)
+			if err != nil {
+				return nil, err
+			}
+			if pathStr := pathToString(p); val != nil && seen[pathStr] && strict {
+				return nil, errors.Errorf("path %s is updated more than once in notification", pathStr)
+			} else if val != nil {
+				seen[pathStr] = true
+			}
 			j, err := util.JoinPaths(n.GetPrefix(), p)
 			if err != nil {
 				return nil, err
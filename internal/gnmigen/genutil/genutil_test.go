@@ -15,18 +15,25 @@
 package genutil
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/openconfig/goyang/pkg/yang"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/openconfig/ygot/ytypes"
 	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ondatra/internal/report"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
@@ -1370,6 +1377,111 @@ func TestUnmarshal(t *testing.T) {
 			}
 		})
 	}
+
+	incrementalTests := []struct {
+		name            string
+		inData          []*DataPoint
+		inIncremental   bool
+		wantValidateErr bool
+	}{{
+		name: "full validation of other invalid leaf",
+		inData: []*DataPoint{{
+			Path:      gnmiPath(t, "super-container/leaf-container-struct/uint64-leaf"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 43}},
+			Timestamp: time.Unix(1, 1),
+		}},
+		wantValidateErr: true,
+	}, {
+		name: "incremental validation of other invalid leaf",
+		inData: []*DataPoint{{
+			Path:      gnmiPath(t, "super-container/leaf-container-struct/uint64-leaf"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 43}},
+			Timestamp: time.Unix(1, 1),
+		}},
+		inIncremental: true,
+	}, {
+		name: "incremental validation of updated invalid leaf",
+		inData: []*DataPoint{{
+			Path:      gnmiPath(t, "super-container/leaf-container-struct/union-leaf"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "forty three"}},
+			Timestamp: time.Unix(1, 1),
+		}},
+		inIncremental:   true,
+		wantValidateErr: true,
+	}}
+
+	for _, tt := range incrementalTests {
+		t.Run(tt.name, func(t *testing.T) {
+			inStruct := &LeafContainerStruct{UnionLeaf: &UnionLeafType_String{String: "forty two"}}
+			queryPath := gnmiPath(t, "super-container/leaf-container-struct")
+			unmarshalledData, complianceErrs, err := unmarshalData(tt.inData, superContainerSchema.Dir["leaf-container-struct"], inStruct, queryPath, schemaStruct(), false, false, tt.inIncremental)
+			if err != nil {
+				t.Fatalf("unmarshalData: got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.inData, unmarshalledData, protocmp.Transform()); diff != "" {
+				t.Errorf("unmarshalData: unmarshalled datapoints do not match (-want +got):\n%s", diff)
+			}
+			if gotValidateErr := complianceErrs != nil && len(complianceErrs.ValidateErrors) > 0; gotValidateErr != tt.wantValidateErr {
+				t.Errorf("unmarshalData: got validate errors %v, want validate errors %t", complianceErrs, tt.wantValidateErr)
+			}
+		})
+	}
+}
+
+func TestRecordComplianceErrors(t *testing.T) {
+	report.Enable("resv1")
+	report.EnableViolations()
+	report.TestStarted(t)
+	RecordComplianceErrors(t, &ComplianceErrors{
+		PathErrors: []*TelemetryError{{
+			Path:  gnmiPath(t, "super-container/dne"),
+			Value: &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 43}},
+			Err:   errors.New("no schema"),
+		}},
+		TypeErrors: []*TelemetryError{{
+			Path: gnmiPath(t, "super-container/leaf-container-struct/uint64-leaf"),
+			Err:  errors.New("bad type"),
+		}},
+		ValidateErrors: []error{errors.New("bad value")},
+	})
+	report.TestEnded(t)
+	dir := t.TempDir()
+	if err := report.Write(dir); err != nil {
+		t.Fatalf("report.Write() got error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, report.JSONFile))
+	if err != nil {
+		t.Fatalf("Failed to read JSON report: %v", err)
+	}
+	got := new(report.Report)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Failed to unmarshal JSON report: %v", err)
+	}
+	want := []*report.Violation{{
+		Test:  t.Name(),
+		Kind:  "path",
+		Path:  "/super-container/dne",
+		Error: "no schema",
+	}, {
+		Test:  t.Name(),
+		Kind:  "type",
+		Path:  "/super-container/leaf-container-struct/uint64-leaf",
+		Error: "bad type",
+	}, {
+		Test:  t.Name(),
+		Kind:  "value",
+		Error: "bad value",
+	}}
+	if diff := cmp.Diff(want, got.Tests[0].Violations, cmp.FilterPath(func(p cmp.Path) bool {
+		return p.Last().String() == ".Time" || p.Last().String() == ".Value"
+	}, cmp.Ignore())); diff != "" {
+		t.Errorf("RecordComplianceErrors() got unexpected violations diff (-want,+got): %s", diff)
+	}
+	// Ignore the whitespace of the prototext format of the value.
+	if got := got.Tests[0].Violations[0].Value; !strings.Contains(got, "43") {
+		t.Errorf("RecordComplianceErrors() got violation value %q, want it to contain 43", got)
+	}
 }
 
 func TestLatestTimestamp(t *testing.T) {
@@ -1501,9 +1613,6 @@ func TestBundleDatapoints(t *testing.T) {
 				Value: &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 600}},
 			}},
 			"/alpha/bravo[key=trois]": {{
-				Path:  gnmiPath(t, "alpha/bravo[key=trois]/leaf3"),
-				Value: &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 400}},
-			}, {
 				Path:  gnmiPath(t, "alpha/bravo[key=trois]/leaf4"),
 				Value: &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 500}},
 			}, {
@@ -1516,6 +1625,40 @@ func TestBundleDatapoints(t *testing.T) {
 			"/alpha/bravo[key=trois]",
 			"/alpha/bravo[key=un]",
 		},
+	}, {
+		desc: "duplicate-paths-with-timestamps",
+		inDatapoints: []*DataPoint{{
+			Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 100}},
+			Timestamp: time.Unix(2, 0),
+		}, {
+			Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 200}},
+			Timestamp: time.Unix(1, 0),
+		}, {
+			Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf1"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 300}},
+			Timestamp: time.Unix(1, 0),
+		}, {
+			Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf1"),
+			Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 400}},
+			Timestamp: time.Unix(3, 0),
+		}},
+		inPrefixLen: 2,
+		want: map[string][]*DataPoint{
+			"/alpha/bravo[key=un]": {{
+				Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+				Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 100}},
+				Timestamp: time.Unix(2, 0),
+			}, {
+				Path:      gnmiPath(t, "alpha/bravo[key=un]/leaf1"),
+				Value:     &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: 400}},
+				Timestamp: time.Unix(3, 0),
+			}},
+		},
+		wantPrefixes: []string{
+			"/alpha/bravo[key=un]",
+		},
 	}, {
 		desc: "path-shorter-than-prefixLen",
 		inDatapoints: []*DataPoint{{
@@ -1592,6 +1735,54 @@ func TestBundleDatapoints(t *testing.T) {
 	}
 }
 
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		desc     string
+		inPrefix *gpb.Path
+		inPath   *gpb.Path
+		inStrict bool
+		want     *gpb.Path
+		wantErr  bool
+	}{{
+		desc:     "relative path",
+		inPrefix: gnmiPath(t, "alpha/bravo[key=un]"),
+		inPath:   gnmiPath(t, "leaf0"),
+		want:     gnmiPath(t, "leaf0"),
+	}, {
+		desc:   "no prefix",
+		inPath: gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+		want:   gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+	}, {
+		desc:     "path repeats prefix",
+		inPrefix: gnmiPath(t, "alpha/bravo[key=un]"),
+		inPath:   gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+		want:     gnmiPath(t, "leaf0"),
+	}, {
+		desc:     "path repeats prefix with other key",
+		inPrefix: gnmiPath(t, "alpha/bravo[key=un]"),
+		inPath:   gnmiPath(t, "alpha/bravo[key=deux]/leaf0"),
+		want:     gnmiPath(t, "alpha/bravo[key=deux]/leaf0"),
+	}, {
+		desc:     "path repeats prefix strict",
+		inPrefix: gnmiPath(t, "alpha/bravo[key=un]"),
+		inPath:   gnmiPath(t, "alpha/bravo[key=un]/leaf0"),
+		inStrict: true,
+		wantErr:  true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := normalizePath(tt.inPrefix, tt.inPath, tt.inStrict)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("normalizePath: got error %v, want error %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("normalizePath: (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestQualifiedTypeString(t *testing.T) {
 	tests := []struct {
 		desc  string
@@ -1657,3 +1848,81 @@ func TestQualifiedTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestAwaitAllAny(t *testing.T) {
+	finishedWatcher := func(err error) *Watcher {
+		w := &Watcher{done: make(chan struct{}), err: err}
+		close(w.done)
+		return w
+	}
+	timeoutErr := status.Error(codes.DeadlineExceeded, "timeout")
+	// The pending watcher never finishes, so a combinator that waits for it
+	// makes the test time out.
+	pending := &Watcher{done: make(chan struct{})}
+
+	tests := []struct {
+		desc     string
+		awaitFn  func(testing.TB, ...*Watcher) bool
+		watchers []*Watcher
+		want     bool
+	}{{
+		desc:     "all true",
+		awaitFn:  AwaitAll,
+		watchers: []*Watcher{finishedWatcher(nil), finishedWatcher(nil)},
+		want:     true,
+	}, {
+		desc:     "all with timeout",
+		awaitFn:  AwaitAll,
+		watchers: []*Watcher{finishedWatcher(nil), finishedWatcher(timeoutErr), pending},
+		want:     false,
+	}, {
+		desc:    "all without watchers",
+		awaitFn: AwaitAll,
+		want:    true,
+	}, {
+		desc:     "any true",
+		awaitFn:  AwaitAny,
+		watchers: []*Watcher{finishedWatcher(timeoutErr), pending, finishedWatcher(nil)},
+		want:     true,
+	}, {
+		desc:     "any all timeouts",
+		awaitFn:  AwaitAny,
+		watchers: []*Watcher{finishedWatcher(timeoutErr), finishedWatcher(timeoutErr)},
+		want:     false,
+	}, {
+		desc:    "any without watchers",
+		awaitFn: AwaitAny,
+		want:    false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := tt.awaitFn(t, tt.watchers...); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAwaitTwice(t *testing.T) {
+	w := &Watcher{done: make(chan struct{})}
+	close(w.done)
+	if !w.Await(t) || !w.Await(t) {
+		t.Errorf("Await(t) got false, want true on each call")
+	}
+}
+
+func TestCloseSubscriptions(t *testing.T) {
+	var closed, done int
+	trackStream(func() { closed++ })
+	trackStream(func() { done++ })()
+	CloseSubscriptions()
+	if closed != 1 {
+		t.Errorf("CloseSubscriptions() canceled the open stream %d times, want 1", closed)
+	}
+	if done != 1 {
+		t.Errorf("CloseSubscriptions() canceled the ended stream again, got %d cancels, want 1", done)
+	}
+	if len(streams) != 0 {
+		t.Errorf("CloseSubscriptions() left %d streams tracked, want 0", len(streams))
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Qualified is a telemetry value of type T with a corresponding timestamp.
+// Each generated QualifiedX type is an alias of Qualified for a value type.
+type Qualified[T any] struct {
+	*Metadata
+	val     T // val is the sample value.
+	present bool
+}
+
+func (q *Qualified[T]) String() string {
+	return QualifiedTypeString(q.val, q.Metadata)
+}
+
+// Val returns the value of the sample, erroring out if not present.
+func (q *Qualified[T]) Val(t testing.TB) T {
+	t.Helper()
+	if q == nil {
+		t.Fatal("No value present")
+	}
+	if !q.present {
+		pathStr, err := ygot.PathToString(q.Path)
+		if err != nil {
+			pathStr = fmt.Sprintf("%v", q.Path.GetElem())
+		}
+		t.Fatalf("No value present at path %s", pathStr)
+	}
+	return q.val
+}
+
+// SetVal sets the value of the sample.
+func (q *Qualified[T]) SetVal(v T) *Qualified[T] {
+	q.val = v
+	q.present = true
+	return q
+}
+
+// IsPresent returns true if the qualified struct contains a value.
+func (q *Qualified[T]) IsPresent() bool {
+	return q != nil && q.present
+}
+
+// Collection is a telemetry Collection whose Await method returns a slice of
+// samples of type T.
+type Collection[T any] struct {
+	W    *TypedWatcher[T]
+	Data []*Qualified[T]
+}
+
+// Await blocks until the telemetry collection is complete and returns the slice of values collected.
+func (c *Collection[T]) Await(t testing.TB) []*Qualified[T] {
+	t.Helper()
+	c.W.Await(t)
+	return c.Data
+}
+
+// ExportCSV blocks until the telemetry collection is complete and writes the
+// collected samples as CSV to the file at the path.
+func (c *Collection[T]) ExportCSV(t testing.TB, path string) {
+	t.Helper()
+	ExportCSV(t, path, c.Await(t))
+}
+
+// ExportOpenMetrics blocks until the telemetry collection is complete and
+// writes the collected samples in the OpenMetrics text format to the file at
+// the path.
+func (c *Collection[T]) ExportOpenMetrics(t testing.TB, path string) {
+	t.Helper()
+	ExportOpenMetrics(t, path, c.Await(t))
+}
+
+// TypedWatcher observes a stream of samples of type T.
+type TypedWatcher[T any] struct {
+	W       *Watcher
+	LastVal *Qualified[T]
+}
+
+// Await blocks until the Watch predicate is true or the duration elapses.
+// It returns the last value received and a boolean indicating whether it satisfies the predicate.
+func (w *TypedWatcher[T]) Await(t testing.TB) (*Qualified[T], bool) {
+	t.Helper()
+	return w.LastVal, w.W.Await(t)
+}
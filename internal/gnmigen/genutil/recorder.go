@@ -0,0 +1,166 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// Recorder periodically looks up values of type T in the background and
+// records them as a time series.
+type Recorder[T any] struct {
+	lookups  []func(testing.TB) *Qualified[T]
+	interval time.Duration
+	ct       *concurrentT
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu     sync.Mutex
+	data   []*Qualified[T]
+	alarms []*alarm[T]
+}
+
+// alarm fires when a recorded value crosses a threshold.
+type alarm[T any] struct {
+	name    string
+	crossed func(T) bool
+	fire    func(*Qualified[T])
+	// active is the set of paths whose last value crossed the threshold.
+	active map[string]bool
+}
+
+// StartRecorder starts looking up the values every interval in the background,
+// beginning immediately, until the recorder is stopped or the test ends.
+func StartRecorder[T any](t testing.TB, interval time.Duration, lookups ...func(testing.TB) *Qualified[T]) *Recorder[T] {
+	t.Helper()
+	if interval <= 0 {
+		t.Fatalf("StartRecorder(t) failed: interval must be positive, got %v", interval)
+	}
+	r := &Recorder[T]{
+		lookups:  lookups,
+		interval: interval,
+		ct:       &concurrentT{TB: t},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go r.run()
+	t.Cleanup(r.halt)
+	return r
+}
+
+func (r *Recorder[T]) run() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		for _, lookup := range r.lookups {
+			v := lookup(r.ct)
+			r.mu.Lock()
+			r.data = append(r.data, v)
+			fired := r.checkAlarms(v)
+			r.mu.Unlock()
+			for _, a := range fired {
+				r.fire(a, v)
+			}
+		}
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAlarms returns the alarms for which the value newly crosses the
+// threshold. It must be called with the mutex held.
+func (r *Recorder[T]) checkAlarms(v *Qualified[T]) []*alarm[T] {
+	if !v.IsPresent() {
+		return nil
+	}
+	path := pathToString(v.GetPath())
+	var fired []*alarm[T]
+	for _, a := range r.alarms {
+		crossed := a.crossed(v.val)
+		if crossed && !a.active[path] {
+			fired = append(fired, a)
+		}
+		a.active[path] = crossed
+	}
+	return fired
+}
+
+func (r *Recorder[T]) fire(a *alarm[T], v *Qualified[T]) {
+	if a.fire != nil {
+		a.fire(v)
+		return
+	}
+	r.ct.Errorf("Alarm %q fired: %v", a.name, v)
+}
+
+// Alarm registers an alarm that fires the moment a recorded value crosses a
+// threshold, that is when crossed returns true for a value whose path had no
+// previous value or a previous value for which crossed returned false. The
+// alarm calls fire with the value or, if fire is nil, marks the test failed.
+// Only values recorded after the alarm is registered are checked.
+func (r *Recorder[T]) Alarm(name string, crossed func(T) bool, fire func(*Qualified[T])) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alarms = append(r.alarms, &alarm[T]{
+		name:    name,
+		crossed: crossed,
+		fire:    fire,
+		active:  make(map[string]bool),
+	})
+}
+
+func (r *Recorder[T]) halt() {
+	r.stopOnce.Do(func() { close(r.stop) })
+	<-r.done
+}
+
+// Series returns the values recorded so far, in the order they were looked up.
+func (r *Recorder[T]) Series() []*Qualified[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Qualified[T](nil), r.data...)
+}
+
+// Stop stops the recording and returns the recorded values. The test fails
+// fatally if a lookup did, which ends the recording early.
+func (r *Recorder[T]) Stop(t testing.TB) []*Qualified[T] {
+	t.Helper()
+	r.halt()
+	if r.ct.failed {
+		t.Fatalf("Recorder lookup failed: %s", r.ct.msg)
+	}
+	return r.Series()
+}
+
+// ExportCSV stops the recording and writes the recorded values as CSV to the
+// file at the path.
+func (r *Recorder[T]) ExportCSV(t testing.TB, path string) {
+	t.Helper()
+	ExportCSV(t, path, r.Stop(t))
+}
+
+// ExportOpenMetrics stops the recording and writes the recorded values in the
+// OpenMetrics text format to the file at the path.
+func (r *Recorder[T]) ExportOpenMetrics(t testing.TB, path string) {
+	t.Helper()
+	ExportOpenMetrics(t, path, r.Stop(t))
+}
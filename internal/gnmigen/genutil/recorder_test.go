@@ -0,0 +1,118 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/negtest"
+)
+
+func TestRecorder(t *testing.T) {
+	var count int64
+	lookup := func(testing.TB) *Qualified[int64] {
+		return (&Qualified[int64]{Metadata: &Metadata{}}).SetVal(atomic.AddInt64(&count, 1))
+	}
+	r := StartRecorder(t, time.Millisecond, lookup)
+	for len(r.Series()) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	got := r.Stop(t)
+	if len(got) < 3 {
+		t.Fatalf("Stop(t) got %d values, want at least 3", len(got))
+	}
+	for i, v := range got {
+		if want := int64(i + 1); v.Val(t) != want {
+			t.Errorf("Stop(t) value %d got %d, want %d", i, v.Val(t), want)
+		}
+	}
+	if n := len(r.Series()); n != len(got) {
+		t.Errorf("Series() after Stop(t) got %d values, want %d", n, len(got))
+	}
+}
+
+func TestRecorderAlarm(t *testing.T) {
+	vals := []int{1, 5, 6, 2, 7}
+	var i int64 = -1
+	block := make(chan struct{})
+	lookup := func(testing.TB) *Qualified[int] {
+		n := atomic.AddInt64(&i, 1)
+		if n == 0 {
+			<-block
+		}
+		if int(n) >= len(vals) {
+			return &Qualified[int]{Metadata: &Metadata{Path: mustPath(t, "/a/b")}}
+		}
+		return (&Qualified[int]{Metadata: &Metadata{Path: mustPath(t, "/a/b")}}).SetVal(vals[n])
+	}
+	r := StartRecorder(t, time.Millisecond, lookup)
+	var fired []int
+	r.Alarm("above 4", func(v int) bool { return v > 4 }, func(q *Qualified[int]) {
+		fired = append(fired, q.Val(t))
+	})
+	close(block)
+	for len(r.Series()) < len(vals) {
+		time.Sleep(time.Millisecond)
+	}
+	r.Stop(t)
+	if diff := cmp.Diff([]int{5, 7}, fired); diff != "" {
+		t.Errorf("Alarm() fired with unexpected values diff (-want,+got): %s", diff)
+	}
+}
+
+func TestRecorderAlarmFails(t *testing.T) {
+	lookup := func(testing.TB) *Qualified[int] {
+		return (&Qualified[int]{Metadata: &Metadata{Path: mustPath(t, "/a/b")}}).SetVal(100)
+	}
+	et := &errorT{TB: t}
+	r := StartRecorder(et, time.Millisecond, lookup)
+	r.Alarm("too hot", func(v int) bool { return v > 90 }, nil)
+	for len(r.Series()) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	r.Stop(t)
+	if len(et.errs) != 1 || !strings.Contains(et.errs[0], `"too hot"`) {
+		t.Errorf("Alarm() got errors %v, want one error for alarm \"too hot\"", et.errs)
+	}
+}
+
+// errorT is a testing.TB that records errors rather than reporting them.
+type errorT struct {
+	testing.TB
+	errs []string
+}
+
+func (t *errorT) Errorf(format string, args ...interface{}) {
+	t.errs = append(t.errs, fmt.Sprintf(format, args...))
+}
+
+func TestRecorderFatal(t *testing.T) {
+	fatal := func(t testing.TB) *Qualified[int] {
+		t.Fatalf("no such path")
+		return nil
+	}
+	r := StartRecorder(t, time.Millisecond, fatal)
+	got := negtest.ExpectFatal(t, func(t testing.TB) {
+		r.Stop(t)
+	})
+	if want := "no such path"; !strings.Contains(got, want) {
+		t.Errorf("Stop(t) got fatal %q, want it to contain %q", got, want)
+	}
+}
@@ -17,6 +17,7 @@ package genutil
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/metadata"
@@ -27,6 +28,10 @@ import (
 const (
 	metadataKeyPrefix   = "metadata-"
 	subscriptionModeKey = "subscriptionMode"
+	heartbeatKey        = "heartbeatInterval"
+	updatesOnlyKey      = "updatesOnly"
+	strictKey           = "strictCompliance"
+	retryKey            = "retryPolicy"
 	clientKey           = "client"
 )
 
@@ -45,10 +50,39 @@ func PutSubscriptionMode(n FakeRootPathStruct, subMode gpb.SubscriptionMode) {
 	n.PutCustomData(subscriptionModeKey, subMode)
 }
 
+// PutHeartbeatInterval sets the subscription heartbeat interval as a request
+// option.
+func PutHeartbeatInterval(n FakeRootPathStruct, interval time.Duration) {
+	n.PutCustomData(heartbeatKey, interval)
+}
+
+// PutUpdatesOnly sets whether the subscription is updates only as a request
+// option.
+func PutUpdatesOnly(n FakeRootPathStruct, updatesOnly bool) {
+	n.PutCustomData(updatesOnlyKey, updatesOnly)
+}
+
+// PutStrictCompliance sets whether responses that violate the gNMI spec, such
+// as by updating the same path twice in a notification, fail the request rather
+// than being normalized, as a request option.
+func PutStrictCompliance(n FakeRootPathStruct, strict bool) {
+	n.PutCustomData(strictKey, strict)
+}
+
+// PutRetryPolicy sets the policy for retrying calls that fail with a transient
+// error as a request option.
+func PutRetryPolicy(n FakeRootPathStruct, p *RetryPolicy) {
+	n.PutCustomData(retryKey, p)
+}
+
 type requestOpts struct {
-	subMode gpb.SubscriptionMode
-	client  gpb.GNMIClient
-	md      metadata.MD
+	subMode     gpb.SubscriptionMode
+	heartbeat   time.Duration
+	updatesOnly bool
+	strict      bool
+	retry       *RetryPolicy
+	client      gpb.GNMIClient
+	md          metadata.MD
 }
 
 // extractRequestOpts translates the root path's custom data to request options.
@@ -61,6 +95,34 @@ func extractRequestOpts(customData map[string]interface{}) (*requestOpts, error)
 		}
 		opts.subMode = m
 	}
+	if v, ok := customData[heartbeatKey]; ok {
+		h, ok := v.(time.Duration)
+		if !ok {
+			return nil, errors.Errorf("customData key %q but value is not Duration type (%T, %v)", heartbeatKey, v, v)
+		}
+		opts.heartbeat = h
+	}
+	if v, ok := customData[updatesOnlyKey]; ok {
+		u, ok := v.(bool)
+		if !ok {
+			return nil, errors.Errorf("customData key %q but value is not bool type (%T, %v)", updatesOnlyKey, v, v)
+		}
+		opts.updatesOnly = u
+	}
+	if v, ok := customData[strictKey]; ok {
+		s, ok := v.(bool)
+		if !ok {
+			return nil, errors.Errorf("customData key %q but value is not bool type (%T, %v)", strictKey, v, v)
+		}
+		opts.strict = s
+	}
+	if v, ok := customData[retryKey]; ok {
+		p, ok := v.(*RetryPolicy)
+		if !ok {
+			return nil, errors.Errorf("customData key %q but value is not *RetryPolicy type (%T, %v)", retryKey, v, v)
+		}
+		opts.retry = p
+	}
 	if v, ok := customData[clientKey]; ok {
 		if v == nil {
 			return nil, errors.Errorf("customData key %q but value is nil", clientKey)
@@ -16,6 +16,7 @@ package genutil
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc/metadata"
@@ -55,6 +56,54 @@ func TestExtractRequestOptions(t *testing.T) {
 			subscriptionModeKey: gpb.SubscriptionMode_ON_CHANGE,
 		},
 		want: &requestOpts{subMode: gpb.SubscriptionMode_ON_CHANGE, md: metadata.MD{}},
+	}, {
+		name: "get heartbeat interval",
+		inCustomData: map[string]interface{}{
+			heartbeatKey: time.Minute,
+		},
+		want: &requestOpts{heartbeat: time.Minute, md: metadata.MD{}},
+	}, {
+		name: "invalid heartbeat interval",
+		inCustomData: map[string]interface{}{
+			heartbeatKey: 60,
+		},
+		wantErrSubstr: "value is not Duration type",
+	}, {
+		name: "get updates only",
+		inCustomData: map[string]interface{}{
+			updatesOnlyKey: true,
+		},
+		want: &requestOpts{updatesOnly: true, md: metadata.MD{}},
+	}, {
+		name: "invalid updates only",
+		inCustomData: map[string]interface{}{
+			updatesOnlyKey: "true",
+		},
+		wantErrSubstr: "value is not bool type",
+	}, {
+		name: "get strict compliance",
+		inCustomData: map[string]interface{}{
+			strictKey: true,
+		},
+		want: &requestOpts{strict: true, md: metadata.MD{}},
+	}, {
+		name: "invalid strict compliance",
+		inCustomData: map[string]interface{}{
+			strictKey: 1,
+		},
+		wantErrSubstr: "value is not bool type",
+	}, {
+		name: "get retry policy",
+		inCustomData: map[string]interface{}{
+			retryKey: &RetryPolicy{MaxRetries: 3},
+		},
+		want: &requestOpts{retry: &RetryPolicy{MaxRetries: 3}, md: metadata.MD{}},
+	}, {
+		name: "invalid retry policy",
+		inCustomData: map[string]interface{}{
+			retryKey: 3,
+		},
+		wantErrSubstr: "value is not *RetryPolicy type",
 	}, {
 		name: "single metadata field",
 		inCustomData: map[string]interface{}{
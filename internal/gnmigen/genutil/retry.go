@@ -0,0 +1,95 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"golang.org/x/net/context"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy is a policy for retrying gNMI calls that fail with a transient
+// error, which is an UNAVAILABLE or RESOURCE_EXHAUSTED status.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a call is retried.
+	MaxRetries int
+	// Backoff is the time to wait before the first retry, which doubles
+	// before each subsequent retry.
+	Backoff time.Duration
+}
+
+var (
+	retryMu            sync.Mutex
+	defaultRetryPolicy = &RetryPolicy{}
+)
+
+// SetDefaultRetryPolicy sets the retry policy of the gNMI calls to devices
+// whose root does not specify one. By default, calls are not retried.
+func SetDefaultRetryPolicy(p *RetryPolicy) {
+	retryMu.Lock()
+	defer retryMu.Unlock()
+	defaultRetryPolicy = p
+}
+
+// retryPolicy returns the retry policy of the request.
+func (o *requestOpts) retryPolicy() *RetryPolicy {
+	if o.retry != nil {
+		return o.retry
+	}
+	retryMu.Lock()
+	defer retryMu.Unlock()
+	return defaultRetryPolicy
+}
+
+// retry calls fn until it does not return a transient error, the retries of
+// the policy are exhausted, or the context is done.
+func (p *RetryPolicy) retry(ctx context.Context, fn func() error) error {
+	for retries := 0; ; retries++ {
+		err := fn()
+		if err == nil || retries >= p.MaxRetries || !isTransient(err) {
+			return err
+		}
+		log.Warningf("Retrying gNMI call after transient error: %v", err)
+		if !p.wait(ctx, retries) {
+			return err
+		}
+	}
+}
+
+// wait waits for the backoff before the retry after the specified number of
+// previous retries and returns whether the context is still active.
+func (p *RetryPolicy) wait(ctx context.Context, retries int) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(p.Backoff << retries):
+		return true
+	}
+}
+
+// isTransient returns whether the error is a transient gNMI error.
+func isTransient(err error) bool {
+	var se interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &se) {
+		return false
+	}
+	code := se.GRPCStatus().Code()
+	return code == codes.Unavailable || code == codes.ResourceExhausted
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"golang.org/x/net/context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestRetry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	tests := []struct {
+		desc      string
+		policy    *RetryPolicy
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{{
+		desc:      "no retries",
+		policy:    &RetryPolicy{},
+		errs:      []error{unavailable, nil},
+		wantCalls: 1,
+		wantErr:   unavailable,
+	}, {
+		desc:      "success after retry",
+		policy:    &RetryPolicy{MaxRetries: 2},
+		errs:      []error{unavailable, nil},
+		wantCalls: 2,
+	}, {
+		desc:      "retries exhausted",
+		policy:    &RetryPolicy{MaxRetries: 2},
+		errs:      []error{unavailable, unavailable, unavailable, nil},
+		wantCalls: 3,
+		wantErr:   unavailable,
+	}, {
+		desc:      "wrapped resource exhausted",
+		policy:    &RetryPolicy{MaxRetries: 2},
+		errs:      []error{fmt.Errorf("set: %w", errors.Wrap(status.Error(codes.ResourceExhausted, "busy"), "wrapped")), nil},
+		wantCalls: 2,
+	}, {
+		desc:      "permanent error",
+		policy:    &RetryPolicy{MaxRetries: 2},
+		errs:      []error{status.Error(codes.InvalidArgument, "bad"), nil},
+		wantCalls: 1,
+		wantErr:   status.Error(codes.InvalidArgument, "bad"),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var calls int
+			err := tt.policy.retry(context.Background(), func() error {
+				calls++
+				return tt.errs[calls-1]
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("retry() got %d calls, want %d", calls, tt.wantCalls)
+			}
+			if fmt.Sprint(err) != fmt.Sprint(tt.wantErr) {
+				t.Errorf("retry() got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	defer SetDefaultRetryPolicy(&RetryPolicy{})
+	defaultPolicy := &RetryPolicy{MaxRetries: 1}
+	SetDefaultRetryPolicy(defaultPolicy)
+	if got := (&requestOpts{}).retryPolicy(); got != defaultPolicy {
+		t.Errorf("retryPolicy() got %v, want default %v", got, defaultPolicy)
+	}
+	rootPolicy := &RetryPolicy{MaxRetries: 2}
+	if got := (&requestOpts{retry: rootPolicy}).retryPolicy(); got != rootPolicy {
+		t.Errorf("retryPolicy() got %v, want %v", got, rootPolicy)
+	}
+}
+
+type fakeSubscribeClient struct {
+	gpb.GNMI_SubscribeClient
+	resps []*gpb.SubscribeResponse
+	err   error
+}
+
+func (c *fakeSubscribeClient) Recv() (*gpb.SubscribeResponse, error) {
+	if len(c.resps) == 0 {
+		return nil, c.err
+	}
+	resp := c.resps[0]
+	c.resps = c.resps[1:]
+	return resp, nil
+}
+
+func TestReceiveUntilResubscribes(t *testing.T) {
+	path := &gpb.Path{Elem: []*gpb.PathElem{{Name: "leaf"}}}
+	update := func(v uint64) *gpb.SubscribeResponse {
+		return &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Update: []*gpb.Update{{Path: path, Val: &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: v}}}},
+		}}}
+	}
+	subs := []*fakeSubscribeClient{{
+		resps: []*gpb.SubscribeResponse{update(1)},
+		err:   status.Error(codes.Unavailable, "stalled"),
+	}, {
+		resps: []*gpb.SubscribeResponse{update(2), update(3)},
+		err:   status.Error(codes.DeadlineExceeded, "done"),
+	}}
+	var resubscribes int
+	resubscribe := func() (gpb.GNMI_SubscribeClient, error) {
+		resubscribes++
+		return subs[resubscribes], nil
+	}
+	var got []*Qualified[uint64]
+	converter := func(data []*DataPoint, _ *gpb.Path) (QualifiedValue, error) {
+		return (&Qualified[uint64]{Metadata: &Metadata{}}).SetVal(data[0].Value.GetUintVal()), nil
+	}
+	pred := func(val QualifiedValue) bool {
+		got = append(got, val.(*Qualified[uint64]))
+		return false
+	}
+
+	err := receiveUntil(context.Background(), subs[0], resubscribe, &RetryPolicy{MaxRetries: 1}, gpb.SubscriptionList_STREAM, false, path, true, converter, pred)
+	if st, _ := status.FromError(errors.Cause(err)); st.Code() != codes.DeadlineExceeded {
+		t.Errorf("receiveUntil() got error %v, want DeadlineExceeded", err)
+	}
+	if resubscribes != 1 {
+		t.Errorf("receiveUntil() resubscribed %d times, want 1", resubscribes)
+	}
+	var gotVals []uint64
+	var gotReconnected []bool
+	for _, q := range got {
+		gotVals = append(gotVals, q.val)
+		gotReconnected = append(gotReconnected, q.GetReconnected())
+	}
+	if want := []uint64{1, 2, 3}; fmt.Sprint(gotVals) != fmt.Sprint(want) {
+		t.Errorf("receiveUntil() got values %v, want %v", gotVals, want)
+	}
+	if want := []bool{false, true, false}; fmt.Sprint(gotReconnected) != fmt.Sprint(want) {
+		t.Errorf("receiveUntil() got reconnected %v, want %v", gotReconnected, want)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"sync"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// SchemaLoader unzips a generated schema tree on its first use, rather than
+// when the generated package is initialized, and shares it between all uses.
+type SchemaLoader struct {
+	unzip func() (map[string]*yang.Entry, error)
+	dst   *map[string]*yang.Entry
+	once  sync.Once
+}
+
+// NewSchemaLoader returns a loader of the schema tree returned by unzip, which
+// also stores the tree in dst when it is loaded.
+func NewSchemaLoader(unzip func() (map[string]*yang.Entry, error), dst *map[string]*yang.Entry) *SchemaLoader {
+	return &SchemaLoader{unzip: unzip, dst: dst}
+}
+
+// Tree returns the schema tree, unzipping it on the first call. Like the
+// generated code that unzips the tree on initialization, it panics if the
+// tree cannot be unzipped.
+func (l *SchemaLoader) Tree() map[string]*yang.Entry {
+	l.once.Do(func() {
+		tree, err := l.unzip()
+		if err != nil {
+			panic("schema error: " + err.Error())
+		}
+		*l.dst = tree
+	})
+	return *l.dst
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+func TestSchemaLoader(t *testing.T) {
+	var (
+		tree   map[string]*yang.Entry
+		unzips int
+	)
+	want := map[string]*yang.Entry{"Device": {Name: "device"}}
+	l := NewSchemaLoader(func() (map[string]*yang.Entry, error) {
+		unzips++
+		return want, nil
+	}, &tree)
+	if tree != nil {
+		t.Fatalf("NewSchemaLoader: tree loaded before first use")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Tree(); got["Device"] != want["Device"] {
+				t.Errorf("Tree() got %v, want %v", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+	if unzips != 1 {
+		t.Errorf("Tree() unzipped the schema %d times, want 1", unzips)
+	}
+	if tree["Device"] != want["Device"] {
+		t.Errorf("Tree() stored %v, want %v", tree, want)
+	}
+}
+
+func TestSchemaLoaderError(t *testing.T) {
+	var tree map[string]*yang.Entry
+	l := NewSchemaLoader(func() (map[string]*yang.Entry, error) {
+		return nil, errors.New("bad schema")
+	}, &tree)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Tree() did not panic on unzip error")
+		}
+	}()
+	l.Tree()
+}
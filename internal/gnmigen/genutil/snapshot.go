@@ -0,0 +1,103 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Snapshot is a set of values of type T looked up at about the same time,
+// typically on different devices.
+type Snapshot[T any] struct {
+	// Values are the looked up values, in the order of the lookups.
+	Values []*Qualified[T]
+	// Skew is the time between receiving the first and the last present value.
+	Skew time.Duration
+}
+
+// LookupConcurrently calls the lookup funcs concurrently, releasing them all
+// at the same time, and returns their values. The test fails fatally if any
+// lookup does.
+func LookupConcurrently[T any](t testing.TB, lookups ...func(testing.TB) *Qualified[T]) *Snapshot[T] {
+	t.Helper()
+	start := make(chan struct{})
+	values := make([]*Qualified[T], len(lookups))
+	ts := make([]*concurrentT, len(lookups))
+	var wg sync.WaitGroup
+	for i, lookup := range lookups {
+		i, lookup := i, lookup
+		ts[i] = &concurrentT{TB: t}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			values[i] = lookup(ts[i])
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	var fatals []string
+	for i, ct := range ts {
+		if ct.failed {
+			fatals = append(fatals, fmt.Sprintf("lookup %d: %s", i, ct.msg))
+		}
+	}
+	if len(fatals) > 0 {
+		t.Fatalf("LookupConcurrently(t) failed: %s", strings.Join(fatals, "; "))
+	}
+
+	var first, last time.Time
+	for _, v := range values {
+		if !v.IsPresent() {
+			continue
+		}
+		if recv := v.GetRecvTimestamp(); first.IsZero() || recv.Before(first) {
+			first = recv
+		}
+		if recv := v.GetRecvTimestamp(); recv.After(last) {
+			last = recv
+		}
+	}
+	return &Snapshot[T]{Values: values, Skew: last.Sub(first)}
+}
+
+// concurrentT is a testing.TB for a goroutine other than the test's, whose
+// fatal failures are recorded for the test to report rather than reported.
+type concurrentT struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (t *concurrentT) FailNow() {
+	t.failed = true
+	runtime.Goexit()
+}
+
+func (t *concurrentT) Fatal(args ...interface{}) {
+	t.msg = fmt.Sprint(args...)
+	t.FailNow()
+}
+
+func (t *concurrentT) Fatalf(format string, args ...interface{}) {
+	t.msg = fmt.Sprintf(format, args...)
+	t.FailNow()
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/negtest"
+)
+
+func TestLookupConcurrently(t *testing.T) {
+	recv := time.Unix(100, 0)
+	lookup := func(val int, delay time.Duration) func(testing.TB) *Qualified[int] {
+		return func(testing.TB) *Qualified[int] {
+			return (&Qualified[int]{Metadata: &Metadata{RecvTimestamp: recv.Add(delay)}}).SetVal(val)
+		}
+	}
+	missing := func(testing.TB) *Qualified[int] {
+		return &Qualified[int]{Metadata: &Metadata{}}
+	}
+
+	got := LookupConcurrently(t, lookup(1, 0), missing, lookup(2, 30*time.Millisecond), lookup(3, 10*time.Millisecond))
+	var gotVals []int
+	for _, v := range got.Values {
+		if v.IsPresent() {
+			gotVals = append(gotVals, v.Val(t))
+		}
+	}
+	if diff := cmp.Diff([]int{1, 2, 3}, gotVals); diff != "" {
+		t.Errorf("LookupConcurrently(t) got unexpected values diff (-want,+got): %s", diff)
+	}
+	if want := 30 * time.Millisecond; got.Skew != want {
+		t.Errorf("LookupConcurrently(t) got skew %v, want %v", got.Skew, want)
+	}
+}
+
+func TestLookupConcurrentlyFatal(t *testing.T) {
+	fatal := func(t testing.TB) *Qualified[int] {
+		t.Fatalf("no such path")
+		return nil
+	}
+	ok := func(testing.TB) *Qualified[int] {
+		return (&Qualified[int]{Metadata: &Metadata{}}).SetVal(1)
+	}
+	got := negtest.ExpectFatal(t, func(t testing.TB) {
+		LookupConcurrently(t, ok, fatal)
+	})
+	if want := "lookup 1: no such path"; !strings.Contains(got, want) {
+		t.Errorf("LookupConcurrently(t) got fatal %q, want it to contain %q", got, want)
+	}
+}
@@ -411,6 +411,36 @@ func (n *{{ .FakeRootTypePathName }}) WithSubscriptionMode(mode gpb.Subscription
 	return n
 }
 
+// WithHeartbeatInterval specifies the heartbeat interval in the underlying
+// gNMI subscribe.
+func (n *{{ .FakeRootTypePathName }}) WithHeartbeatInterval(interval time.Duration) *{{ .FakeRootTypePathName }} {
+	genutil.PutHeartbeatInterval(n, interval)
+	return n
+}
+
+// WithUpdatesOnly specifies whether the underlying gNMI subscribe is updates
+// only, which suppresses the initial updates before the sync response.
+func (n *{{ .FakeRootTypePathName }}) WithUpdatesOnly(updatesOnly bool) *{{ .FakeRootTypePathName }} {
+	genutil.PutUpdatesOnly(n, updatesOnly)
+	return n
+}
+
+// WithStrictCompliance specifies whether responses that violate the gNMI spec,
+// such as by updating the same path twice in a notification, fail the call
+// rather than being normalized.
+func (n *{{ .FakeRootTypePathName }}) WithStrictCompliance(strict bool) *{{ .FakeRootTypePathName }} {
+	genutil.PutStrictCompliance(n, strict)
+	return n
+}
+
+// WithRetries specifies the maximum number of times that gNMI calls that fail
+// with a transient error are retried, and the time to wait before the first
+// retry, which doubles before each subsequent retry.
+func (n *{{ .FakeRootTypePathName }}) WithRetries(maxRetries int, backoff time.Duration) *{{ .FakeRootTypePathName }} {
+	genutil.PutRetryPolicy(n, &genutil.RetryPolicy{MaxRetries: maxRetries, Backoff: backoff})
+	return n
+}
+
 // WithClient allows the user to provide a gNMI client. This allows for creation
 // of tests for multiple gNMI clients to a single DUT.
 func (n *{{ .FakeRootTypePathName }}) WithClient(c gpb.GNMIClient) *{{ .FakeRootTypePathName }} {
@@ -565,7 +595,7 @@ func watch_{{ .PathStructName }}(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &{{ .SchemaStructPkgAccessor }}{{ .GoStructTypeName }}{}
 	w.W = genutil.MustWatch(t, n, nil, duration, {{ .GoType.IsLeaf }}, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, {{ .SchemaStructPkgAccessor }}GetSchema(), "{{ .GoStructTypeName }}", gs, queryPath, {{ .GoType.IsLeaf }}, {{ .PreferShadowPath }})
+		md, _ := genutil.{{ if .GoType.IsLeaf }}MustUnmarshal{{ else }}MustUnmarshalUpdates{{ end }}(t, upd, {{ .SchemaStructPkgAccessor }}GetSchema(), "{{ .GoStructTypeName }}", gs, queryPath, {{ .GoType.IsLeaf }}, {{ .PreferShadowPath }})
 		{{- if .GoType.IsLeaf }}
 		return convert{{ .PathStructName }}(t, md, gs), nil
 		{{- else }}
@@ -686,46 +716,12 @@ func (n *{{ .PathStructName }}) BatchUpdate(t testing.TB, b *{{ .ConfigPkgAccess
 
 	// goQualifiedTypeTemplate contains the per-leaf return type used to store the
 	// telemetry results. A pointer to a struct is intended as the return type to
-	// allow a non-existent node to be expressed as a nil pointer.
+	// allow a non-existent node to be expressed as a nil pointer. The type is an
+	// alias of the generic genutil.Qualified, so that the generated code does
+	// not repeat its methods for every type.
 	goQualifiedTypeTemplate = mustTemplate("goQualifiedType", `{{ $QualifiedGoTypeName := printf "Qualified%s" .TransformedGoTypeName }}
 // {{ $QualifiedGoTypeName }} is a {{ .GoTypeName }} with a corresponding timestamp.
-type {{ $QualifiedGoTypeName }} struct {
-	*genutil.Metadata
-	val {{ .GoTypeName }} // val is the sample value.
-	present bool
-}
-
-func (q *{{ $QualifiedGoTypeName }}) String() string {
-	return genutil.QualifiedTypeString(q.val, q.Metadata)
-}
-
-// Val returns the value of the {{ .GoTypeName }} sample, erroring out if not present.
-func (q *{{ $QualifiedGoTypeName }}) Val(t testing.TB) {{ .GoTypeName }} {
-	t.Helper()
-	if q == nil {
-		t.Fatal("No value present")
-	}
-	if !q.present {
-		pathStr, err := ygot.PathToString(q.Path)
-		if err != nil {
-			pathStr = fmt.Sprintf("%v", q.Path.GetElem())
-		}
-		t.Fatalf("No value present at path %s", pathStr)
-	}
-	return q.val
-}
-
-// SetVal sets the value of the {{ .GoTypeName }} sample.
-func (q *{{ $QualifiedGoTypeName }}) SetVal(v {{ .GoTypeName }}) *{{ $QualifiedGoTypeName }} {
-	q.val = v
-	q.present = true
-	return q
-}
-
-// IsPresent returns true if the qualified struct contains a value.
-func (q *{{ $QualifiedGoTypeName }}) IsPresent() bool {
-	return q != nil && q.present
-}
+type {{ $QualifiedGoTypeName }} = genutil.Qualified[{{ .GoTypeName }}]
 
 `)
 
@@ -737,33 +733,14 @@ func (q *{{ $QualifiedGoTypeName }}) IsPresent() bool {
 	// disambiguate this node from other nodes having the same type, in
 	// order to correctly carry out the unmarshal operation once the
 	// user triggers the Await() call that blocks for the data retrieval to
-	// complete.
-	goCollectionTypeTemplate = mustTemplate("goCollectionType", `{{ $QualifiedGoTypeName := printf "Qualified%s" .TransformedGoTypeName }}
+	// complete. The collection and watcher types are aliases of the generic
+	// genutil.Collection and genutil.TypedWatcher.
+	goCollectionTypeTemplate = mustTemplate("goCollectionType", `
 // Collection{{ .TransformedGoTypeName }} is a telemetry Collection whose Await method returns a slice of {{ .GoTypeName }} samples.
-type Collection{{ .TransformedGoTypeName }} struct {
-	W *{{ .TransformedGoTypeName }}Watcher
-	Data []*{{ $QualifiedGoTypeName }}
-}
-
-// Await blocks until the telemetry collection is complete and returns the slice of values collected.
-func (c *Collection{{ .TransformedGoTypeName }}) Await(t testing.TB) []*{{ $QualifiedGoTypeName }} {
-	t.Helper()
-	c.W.Await(t)
-	return c.Data
-}
+type Collection{{ .TransformedGoTypeName }} = genutil.Collection[{{ .GoTypeName }}]
 
 // {{ .TransformedGoTypeName }}Watcher observes a stream of {{ .GoTypeName }} samples.
-type {{ .TransformedGoTypeName }}Watcher struct {
-	W *genutil.Watcher
-	LastVal *{{ $QualifiedGoTypeName }}
-}
-
-// Await blocks until the Watch predicate is true or the duration elapses.
-// It returns the last value received and a boolean indicating whether it satisfies the predicate.
-func (w *{{ .TransformedGoTypeName }}Watcher) Await(t testing.TB) (*{{ $QualifiedGoTypeName }}, bool) {
-	t.Helper()
-	return w.LastVal, w.W.Await(t)
-}
+type {{ .TransformedGoTypeName }}Watcher = genutil.TypedWatcher[{{ .GoTypeName }}]
 `)
 )
 
@@ -1737,7 +1737,7 @@ func watch_SuperContainer_Container(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &SuperContainer_Container{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, GetSchema(), "SuperContainer_Container", gs, queryPath, false, true)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, GetSchema(), "SuperContainer_Container", gs, queryPath, false, true)
 		return (&QualifiedSuperContainer_Container{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1859,71 +1859,15 @@ func TestGeneratePerTypeSnippet(t *testing.T) {
 			TypeName: "int32",
 			QualifiedType: `
 // QualifiedInt32 is a int32 with a corresponding timestamp.
-type QualifiedInt32 struct {
-	*genutil.Metadata
-	val int32 // val is the sample value.
-	present bool
-}
-
-func (q *QualifiedInt32) String() string {
-	return genutil.QualifiedTypeString(q.val, q.Metadata)
-}
-
-// Val returns the value of the int32 sample, erroring out if not present.
-func (q *QualifiedInt32) Val(t testing.TB) int32 {
-	t.Helper()
-	if q == nil {
-		t.Fatal("No value present")
-	}
-	if !q.present {
-		pathStr, err := ygot.PathToString(q.Path)
-		if err != nil {
-			pathStr = fmt.Sprintf("%v", q.Path.GetElem())
-		}
-		t.Fatalf("No value present at path %s", pathStr)
-	}
-	return q.val
-}
-
-// SetVal sets the value of the int32 sample.
-func (q *QualifiedInt32) SetVal(v int32) *QualifiedInt32 {
-	q.val = v
-	q.present = true
-	return q
-}
-
-// IsPresent returns true if the qualified struct contains a value.
-func (q *QualifiedInt32) IsPresent() bool {
-	return q != nil && q.present
-}
+type QualifiedInt32 = genutil.Qualified[int32]
 
 `,
 			CollectionType: `
 // CollectionInt32 is a telemetry Collection whose Await method returns a slice of int32 samples.
-type CollectionInt32 struct {
-	W *Int32Watcher
-	Data []*QualifiedInt32
-}
-
-// Await blocks until the telemetry collection is complete and returns the slice of values collected.
-func (c *CollectionInt32) Await(t testing.TB) []*QualifiedInt32 {
-	t.Helper()
-	c.W.Await(t)
-	return c.Data
-}
+type CollectionInt32 = genutil.Collection[int32]
 
 // Int32Watcher observes a stream of int32 samples.
-type Int32Watcher struct {
-	W *genutil.Watcher
-	LastVal *QualifiedInt32
-}
-
-// Await blocks until the Watch predicate is true or the duration elapses.
-// It returns the last value received and a boolean indicating whether it satisfies the predicate.
-func (w *Int32Watcher) Await(t testing.TB) (*QualifiedInt32, bool) {
-	t.Helper()
-	return w.LastVal, w.W.Await(t)
-}
+type Int32Watcher = genutil.TypedWatcher[int32]
 `,
 		},
 	}, {
@@ -1938,71 +1882,15 @@ func (w *Int32Watcher) Await(t testing.TB) (*QualifiedInt32, bool) {
 			TypeName: "[]Binary",
 			QualifiedType: `
 // QualifiedBinarySlice is a []Binary with a corresponding timestamp.
-type QualifiedBinarySlice struct {
-	*genutil.Metadata
-	val []Binary // val is the sample value.
-	present bool
-}
-
-func (q *QualifiedBinarySlice) String() string {
-	return genutil.QualifiedTypeString(q.val, q.Metadata)
-}
-
-// Val returns the value of the []Binary sample, erroring out if not present.
-func (q *QualifiedBinarySlice) Val(t testing.TB) []Binary {
-	t.Helper()
-	if q == nil {
-		t.Fatal("No value present")
-	}
-	if !q.present {
-		pathStr, err := ygot.PathToString(q.Path)
-		if err != nil {
-			pathStr = fmt.Sprintf("%v", q.Path.GetElem())
-		}
-		t.Fatalf("No value present at path %s", pathStr)
-	}
-	return q.val
-}
-
-// SetVal sets the value of the []Binary sample.
-func (q *QualifiedBinarySlice) SetVal(v []Binary) *QualifiedBinarySlice {
-	q.val = v
-	q.present = true
-	return q
-}
-
-// IsPresent returns true if the qualified struct contains a value.
-func (q *QualifiedBinarySlice) IsPresent() bool {
-	return q != nil && q.present
-}
+type QualifiedBinarySlice = genutil.Qualified[[]Binary]
 
 `,
 			CollectionType: `
 // CollectionBinarySlice is a telemetry Collection whose Await method returns a slice of []Binary samples.
-type CollectionBinarySlice struct {
-	W *BinarySliceWatcher
-	Data []*QualifiedBinarySlice
-}
-
-// Await blocks until the telemetry collection is complete and returns the slice of values collected.
-func (c *CollectionBinarySlice) Await(t testing.TB) []*QualifiedBinarySlice {
-	t.Helper()
-	c.W.Await(t)
-	return c.Data
-}
+type CollectionBinarySlice = genutil.Collection[[]Binary]
 
 // BinarySliceWatcher observes a stream of []Binary samples.
-type BinarySliceWatcher struct {
-	W *genutil.Watcher
-	LastVal *QualifiedBinarySlice
-}
-
-// Await blocks until the Watch predicate is true or the duration elapses.
-// It returns the last value received and a boolean indicating whether it satisfies the predicate.
-func (w *BinarySliceWatcher) Await(t testing.TB) (*QualifiedBinarySlice, bool) {
-	t.Helper()
-	return w.LastVal, w.W.Await(t)
-}
+type BinarySliceWatcher = genutil.TypedWatcher[[]Binary]
 `,
 		},
 	}}
@@ -0,0 +1,345 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gnmilog logs the gNMI requests sent to devices and the responses
+// received from them to per-test files, with credentials redacted.
+package gnmilog
+
+import (
+	"golang.org/x/net/context"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Redacted replaces the values of credentials in the log.
+const Redacted = "<redacted>"
+
+// noTest is the name of the log directory of RPCs made outside of a test.
+const noTest = "_no_test"
+
+// sensitiveRE matches the names of path elements, JSON keys, and metadata keys
+// whose values are credentials.
+var sensitiveRE = regexp.MustCompile(`(?i)(password|passwd|secret|token|private-key|credential)`)
+
+var (
+	mu       sync.Mutex
+	enabled  bool
+	dir      string
+	maxBytes int64
+	test     string
+	files    = make(map[string]*logFile)
+	nowFn    = time.Now
+)
+
+type logFile struct {
+	f         *os.File
+	size      int64
+	truncated bool
+}
+
+// Enable starts logging the gNMI RPCs of subsequently dialed clients to the
+// specified directory. If max is positive, every log file is truncated once it
+// reaches max bytes.
+func Enable(logDir string, max int64) {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = true
+	dir = logDir
+	maxBytes = max
+}
+
+// Disable stops logging and closes all log files.
+func Disable() error {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = false
+	return closeFiles()
+}
+
+// TestStarted directs subsequent log entries to the files of the named test.
+func TestStarted(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := closeFiles(); err != nil {
+		log.Errorf("Error closing gNMI logs: %v", err)
+	}
+	test = name
+}
+
+// TestEnded closes the log files of the current test.
+func TestEnded() {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := closeFiles(); err != nil {
+		log.Errorf("Error closing gNMI logs: %v", err)
+	}
+	test = ""
+}
+
+func closeFiles() error {
+	var errs []string
+	for name, lf := range files {
+		if err := lf.f.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		delete(files, name)
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("error closing log files: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// DialOptions returns the options that log the RPCs of a gNMI client of the
+// named device, or nil if logging is not enabled.
+func DialOptions(device string) []grpc.DialOption {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unaryInterceptor(device)),
+		grpc.WithChainStreamInterceptor(streamInterceptor(device)),
+	}
+}
+
+func unaryInterceptor(device string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		logMetadata(ctx, device, method)
+		logMessage(device, method, ">>", req)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			logError(device, method, err)
+		} else {
+			logMessage(device, method, "<<", reply)
+		}
+		return err
+	}
+}
+
+func streamInterceptor(device string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		logMetadata(ctx, device, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			logError(device, method, err)
+			return nil, err
+		}
+		return &loggedStream{ClientStream: cs, device: device, method: method}, nil
+	}
+}
+
+type loggedStream struct {
+	grpc.ClientStream
+	device, method string
+}
+
+func (s *loggedStream) SendMsg(m interface{}) error {
+	logMessage(s.device, s.method, ">>", m)
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		logMessage(s.device, s.method, "<<", m)
+	case err != io.EOF:
+		logError(s.device, s.method, err)
+	}
+	return err
+}
+
+func logMetadata(ctx context.Context, device, method string) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok || len(md) == 0 {
+		return
+	}
+	var keys []string
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		v := strings.Join(md[k], ",")
+		if sensitiveRE.MatchString(k) {
+			v = Redacted
+		}
+		pairs = append(pairs, k+"="+v)
+	}
+	write(device, method, "metadata", strings.Join(pairs, " "))
+}
+
+func logMessage(device, method, kind string, m interface{}) {
+	pm, ok := m.(proto.Message)
+	if !ok {
+		write(device, method, kind, fmt.Sprintf("%v", m))
+		return
+	}
+	write(device, method, kind, prototext.Format(Redact(pm)))
+}
+
+func logError(device, method string, err error) {
+	write(device, method, "error", err.Error())
+}
+
+func write(device, method, kind, text string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	lf, err := file(device)
+	if err != nil {
+		log.Errorf("Error opening gNMI log of %s: %v", device, err)
+		return
+	}
+	if lf.truncated {
+		return
+	}
+	entry := fmt.Sprintf("%s %s %s\n%s\n", nowFn().Format(time.RFC3339Nano), kind, method, text)
+	if maxBytes > 0 && lf.size+int64(len(entry)) > maxBytes {
+		lf.truncated = true
+		entry = fmt.Sprintf("%s log truncated at %d bytes\n", nowFn().Format(time.RFC3339Nano), maxBytes)
+	}
+	n, err := io.WriteString(lf.f, entry)
+	lf.size += int64(n)
+	if err != nil {
+		log.Errorf("Error writing gNMI log of %s: %v", device, err)
+	}
+}
+
+// file returns the log file of the device in the current test.
+func file(device string) (*logFile, error) {
+	if lf, ok := files[device]; ok {
+		return lf, nil
+	}
+	name := test
+	if name == "" {
+		name = noTest
+	}
+	testDir := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(testDir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "error creating log directory %s", testDir)
+	}
+	f, err := os.OpenFile(filepath.Join(testDir, LogFile(device)), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening log file")
+	}
+	lf := &logFile{f: f}
+	files[device] = lf
+	return lf, nil
+}
+
+// LogFile returns the base name of the log file of the named device.
+func LogFile(device string) string {
+	return "gnmi-" + device + ".log"
+}
+
+// Redact returns a copy of the message with the values of credentials
+// replaced. Values are credentials if the last element of their path, or
+// their key in a JSON value, names a credential.
+func Redact(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	switch v := m.(type) {
+	case *gpb.SetRequest:
+		for _, u := range append(v.GetReplace(), v.GetUpdate()...) {
+			redactUpdate(v.GetPrefix(), u)
+		}
+	case *gpb.SubscribeResponse:
+		n := v.GetUpdate()
+		for _, u := range n.GetUpdate() {
+			redactUpdate(n.GetPrefix(), u)
+		}
+	case *gpb.GetResponse:
+		for _, n := range v.GetNotification() {
+			for _, u := range n.GetUpdate() {
+				redactUpdate(n.GetPrefix(), u)
+			}
+		}
+	}
+	return m
+}
+
+func redactUpdate(prefix *gpb.Path, u *gpb.Update) {
+	if u.GetVal() == nil {
+		return
+	}
+	elems := u.GetPath().GetElem()
+	if len(elems) == 0 {
+		elems = prefix.GetElem()
+	}
+	if len(elems) > 0 && sensitiveRE.MatchString(elems[len(elems)-1].GetName()) {
+		u.Val = &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: Redacted}}
+		return
+	}
+	switch v := u.GetVal().GetValue().(type) {
+	case *gpb.TypedValue_JsonVal:
+		v.JsonVal = redactJSON(v.JsonVal)
+	case *gpb.TypedValue_JsonIetfVal:
+		v.JsonIetfVal = redactJSON(v.JsonIetfVal)
+	}
+}
+
+func redactJSON(data []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return data
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactJSONValue(v)); err != nil {
+		return data
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+func redactJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if sensitiveRE.MatchString(k) {
+				v[k] = Redacted
+			} else {
+				v[k] = redactJSONValue(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJSONValue(val)
+		}
+	}
+	return v
+}
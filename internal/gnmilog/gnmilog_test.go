@@ -0,0 +1,172 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnmilog
+
+import (
+	"golang.org/x/net/context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestRedact(t *testing.T) {
+	path := func(elems ...string) *gpb.Path {
+		p := new(gpb.Path)
+		for _, e := range elems {
+			p.Elem = append(p.Elem, &gpb.PathElem{Name: e})
+		}
+		return p
+	}
+	strVal := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: s}}
+	}
+	jsonVal := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(s)}}
+	}
+
+	tests := []struct {
+		desc string
+		in   proto.Message
+		want proto.Message
+	}{{
+		desc: "set path",
+		in: &gpb.SetRequest{
+			Prefix: path("system", "aaa"),
+			Update: []*gpb.Update{{Path: path("user", "config", "password"), Val: strVal("hunter2")}},
+		},
+		want: &gpb.SetRequest{
+			Prefix: path("system", "aaa"),
+			Update: []*gpb.Update{{Path: path("user", "config", "password"), Val: strVal(Redacted)}},
+		},
+	}, {
+		desc: "set json",
+		in: &gpb.SetRequest{
+			Replace: []*gpb.Update{{Path: path("system"), Val: jsonVal(`{"user":[{"name":"admin","openconfig-system:password":"hunter2"}]}`)}},
+		},
+		want: &gpb.SetRequest{
+			Replace: []*gpb.Update{{Path: path("system"), Val: jsonVal(`{"user":[{"name":"admin","openconfig-system:password":"<redacted>"}]}`)}},
+		},
+	}, {
+		desc: "subscribe response",
+		in: &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Prefix: path("system", "aaa", "secret-key"),
+			Update: []*gpb.Update{{Path: path(), Val: strVal("s3cret")}},
+		}}},
+		want: &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Prefix: path("system", "aaa", "secret-key"),
+			Update: []*gpb.Update{{Path: path(), Val: strVal(Redacted)}},
+		}}},
+	}, {
+		desc: "not sensitive",
+		in: &gpb.SetRequest{
+			Update: []*gpb.Update{{Path: path("system", "config", "hostname"), Val: strVal("dut")}},
+		},
+		want: &gpb.SetRequest{
+			Update: []*gpb.Update{{Path: path("system", "config", "hostname"), Val: strVal("dut")}},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			orig := proto.Clone(test.in)
+			got := Redact(test.in)
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("Redact() got unexpected diff (-want,+got): %s", diff)
+			}
+			if !proto.Equal(orig, test.in) {
+				t.Errorf("Redact() modified its input")
+			}
+		})
+	}
+}
+
+func TestLog(t *testing.T) {
+	nowFn = func() time.Time { return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) }
+	dir := t.TempDir()
+	Enable(dir, 0)
+	defer Disable()
+
+	interceptor := unaryInterceptor("mydut")
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "username", "admin", "password", "hunter2")
+	req := &gpb.GetRequest{Path: []*gpb.Path{{Elem: []*gpb.PathElem{{Name: "system"}}}}}
+	resp := new(gpb.GetResponse)
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		resp.Notification = []*gpb.Notification{{Timestamp: 42}}
+		return nil
+	}
+
+	TestStarted("TestFoo/sub")
+	if err := interceptor(ctx, "/gnmi.gNMI/Get", req, resp, nil, invoker); err != nil {
+		t.Fatalf("interceptor() got error: %v", err)
+	}
+	TestEnded()
+
+	got, err := ioutil.ReadFile(filepath.Join(dir, "TestFoo", "sub", LogFile("mydut")))
+	if err != nil {
+		t.Fatalf("error reading log: %v", err)
+	}
+	for _, want := range []string{
+		"2022-01-01T00:00:00Z metadata /gnmi.gNMI/Get\npassword=<redacted> username=admin\n",
+		"2022-01-01T00:00:00Z >> /gnmi.gNMI/Get\n",
+		"name:",
+		"2022-01-01T00:00:00Z << /gnmi.gNMI/Get\n",
+		"timestamp:",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("log does not contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(string(got), "hunter2") {
+		t.Errorf("log contains unredacted password, got:\n%s", got)
+	}
+}
+
+func TestLogTruncated(t *testing.T) {
+	dir := t.TempDir()
+	Enable(dir, 100)
+	defer Disable()
+
+	TestStarted("TestFoo")
+	for i := 0; i < 10; i++ {
+		logMessage("mydut", "/gnmi.gNMI/Set", ">>", &gpb.SetRequest{})
+	}
+	TestEnded()
+
+	got, err := ioutil.ReadFile(filepath.Join(dir, "TestFoo", LogFile("mydut")))
+	if err != nil {
+		t.Fatalf("error reading log: %v", err)
+	}
+	if !strings.HasSuffix(string(got), "log truncated at 100 bytes\n") {
+		t.Errorf("log is not truncated, got:\n%s", got)
+	}
+	if c := strings.Count(string(got), "/gnmi.gNMI/Set"); c < 1 || c > 2 {
+		t.Errorf("log has %d entries, want 1 or 2, got:\n%s", c, got)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	if opts := DialOptions("mydut"); opts != nil {
+		t.Errorf("DialOptions() got %v, want nil", opts)
+	}
+}
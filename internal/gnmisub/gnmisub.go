@@ -0,0 +1,192 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gnmisub subscribes to arbitrary gNMI paths and streams the received
+// notifications without unmarshalling them into a schema.
+package gnmisub
+
+import (
+	"golang.org/x/net/context"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/prototext"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// reconnectWait is the time to wait before resubscribing after a stream error.
+var reconnectWait = 5 * time.Second
+
+// ClientFn returns the gNMI client with which to subscribe.
+type ClientFn func(context.Context) (gpb.GNMIClient, error)
+
+// Request returns a SubscriptionList for the given string paths.
+func Request(target, origin string, paths []string, mode gpb.SubscriptionList_Mode, subMode gpb.SubscriptionMode, encoding gpb.Encoding) (*gpb.SubscriptionList, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no paths to subscribe to")
+	}
+	var subs []*gpb.Subscription
+	for _, p := range paths {
+		path, err := ygot.StringToStructuredPath(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid path %q", p)
+		}
+		path.Origin = origin
+		subs = append(subs, &gpb.Subscription{Path: path, Mode: subMode})
+	}
+	return &gpb.SubscriptionList{
+		Prefix:       &gpb.Path{Target: target},
+		Subscription: subs,
+		Mode:         mode,
+		Encoding:     encoding,
+	}, nil
+}
+
+// Stream is a subscription that streams notifications until it is closed. A
+// STREAM mode subscription resubscribes when its stream fails, up to a
+// maximum number of times.
+type Stream struct {
+	clientFn      ClientFn
+	req           *gpb.SubscribeRequest
+	maxReconnects int
+	cancel        context.CancelFunc
+	notifs        chan *gpb.Notification
+
+	mu  sync.Mutex
+	err error
+}
+
+// Subscribe subscribes with the SubscriptionList and returns the stream of
+// received notifications.
+func Subscribe(ctx context.Context, clientFn ClientFn, subList *gpb.SubscriptionList, maxReconnects int) (*Stream, error) {
+	// The stream must outlive the context of the call.
+	sctx, cancel := context.WithCancel(context.Background())
+	s := &Stream{
+		clientFn:      clientFn,
+		req:           &gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: subList}},
+		maxReconnects: maxReconnects,
+		cancel:        cancel,
+		notifs:        make(chan *gpb.Notification),
+	}
+	sub, err := s.subscribe(ctx, sctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go s.receive(sctx, sub)
+	return s, nil
+}
+
+func (s *Stream) subscribe(dialCtx, ctx context.Context) (gpb.GNMI_SubscribeClient, error) {
+	client, err := s.clientFn(dialCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get gNMI client")
+	}
+	sub, err := client.Subscribe(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "gNMI failed to Subscribe")
+	}
+	log.V(1).Info(prototext.Format(s.req))
+	if err := sub.Send(s.req); err != nil {
+		return nil, errors.Wrapf(err, "gNMI failed to Send(%+v)", s.req)
+	}
+	return sub, nil
+}
+
+func (s *Stream) receive(ctx context.Context, sub gpb.GNMI_SubscribeClient) {
+	defer close(s.notifs)
+	mode := s.req.GetSubscribe().GetMode()
+	for reconnects := 0; ; {
+		err := s.receiveUntilErr(ctx, sub, mode)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		if mode != gpb.SubscriptionList_STREAM || reconnects >= s.maxReconnects {
+			s.setErr(err)
+			return
+		}
+		for sub = nil; sub == nil; {
+			reconnects++
+			log.Warningf("gNMI subscription failed, resubscribing (attempt %d of %d): %v", reconnects, s.maxReconnects, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(reconnectWait):
+			}
+			if sub, err = s.subscribe(ctx, ctx); err != nil && reconnects >= s.maxReconnects {
+				s.setErr(err)
+				return
+			}
+		}
+	}
+}
+
+// receiveUntilErr receives notifications until the subscription ends, when it
+// returns nil, or until the stream fails, when it returns the error.
+func (s *Stream) receiveUntilErr(ctx context.Context, sub gpb.GNMI_SubscribeClient, mode gpb.SubscriptionList_Mode) error {
+	for {
+		resp, err := sub.Recv()
+		if err == io.EOF && mode != gpb.SubscriptionList_STREAM {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving gNMI response")
+		}
+		switch r := resp.GetResponse().(type) {
+		case *gpb.SubscribeResponse_Update:
+			select {
+			case s.notifs <- r.Update:
+			case <-ctx.Done():
+				return nil
+			}
+		case *gpb.SubscribeResponse_SyncResponse:
+			if mode == gpb.SubscriptionList_ONCE {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *Stream) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Next returns the next received notification. It returns a nil notification
+// if the context is done or the subscription has ended, and an error if the
+// subscription failed.
+func (s *Stream) Next(ctx context.Context) (*gpb.Notification, error) {
+	select {
+	case n, ok := <-s.notifs:
+		if ok {
+			return n, nil
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return nil, s.err
+	case <-ctx.Done():
+		return nil, nil
+	}
+}
+
+// Close ends the subscription.
+func (s *Stream) Close() {
+	s.cancel()
+}
@@ -17,8 +17,11 @@ package gribi
 
 import (
 	"golang.org/x/net/context"
+	"fmt"
+	"io"
 	"sync"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/testbed"
@@ -31,9 +34,14 @@ var (
 	gribis = make(map[binding.Device]grpb.GRIBIClient)
 )
 
-// NewGRIBI creates a new gRIBI client for the specified Device.
-func NewGRIBI(ctx context.Context, dev *binding.DUT) (grpb.GRIBIClient, error) {
-	return testbed.Bind().DialGRIBI(ctx, dev, grpc.WithBlock())
+// fibFailed is the FIB_FAILED status of later gRIBI versions, with which a
+// device reports that an entry was programmed in the RIB but not in the FIB.
+const fibFailed = grpb.AFTResult_Status(5)
+
+// NewGRIBI creates a new gRIBI client for the specified Device, dialed with the
+// specified options in addition to the default ones.
+func NewGRIBI(ctx context.Context, dev *binding.DUT, opts ...grpc.DialOption) (grpb.GRIBIClient, error) {
+	return testbed.Bind().DialGRIBI(ctx, dev, append([]grpc.DialOption{grpc.WithBlock()}, opts...)...)
 }
 
 // FetchGRIBI fetches the gRIBI client for the specified Device.
@@ -52,3 +60,306 @@ func FetchGRIBI(ctx context.Context, dev *binding.DUT) (grpb.GRIBIClient, error)
 	}
 	return c, nil
 }
+
+// Session is a gRIBI client session with a Modify stream open to a device.
+type Session struct {
+	client     grpb.GRIBIClient
+	stream     grpb.GRIBI_ModifyClient
+	cancel     context.CancelFunc
+	params     *grpb.SessionParameters
+	electionID *grpb.Uint128
+
+	mu     sync.Mutex
+	nextID uint64
+	// installed holds the operations of the entries installed by the session,
+	// in the order they were installed, so they can be replayed.
+	installed []*grpb.AFTOperation
+}
+
+// NewSession creates a gRIBI client for the specified DUT, opens a Modify
+// stream and negotiates the session parameters. If the parameters specify a
+// single primary client, the session also sends the election ID and returns an
+// error if the client does not become the primary client.
+func NewSession(ctx context.Context, dut *binding.DUT, params *grpb.SessionParameters, electionID *grpb.Uint128, opts ...grpc.DialOption) (*Session, error) {
+	client, err := NewGRIBI(ctx, dut, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newSession(client, params, electionID)
+}
+
+func newSession(client grpb.GRIBIClient, params *grpb.SessionParameters, electionID *grpb.Uint128) (*Session, error) {
+	s := &Session{
+		client: client,
+		params: params,
+		nextID: 1,
+	}
+	if err := s.open(electionID); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// open opens a Modify stream and negotiates the session parameters and, for a
+// single primary client, the election ID on it.
+func (s *Session) open(electionID *grpb.Uint128) error {
+	// The stream must outlive the context of the call, so that the entries of
+	// the session are not removed until it is closed.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := s.client.Modify(ctx)
+	if err != nil {
+		cancel()
+		return errors.Wrap(err, "error opening gRIBI modify stream")
+	}
+	s.stream = stream
+	s.cancel = cancel
+	if err := s.negotiate(electionID); err != nil {
+		cancel()
+		return err
+	}
+	return nil
+}
+
+func (s *Session) negotiate(electionID *grpb.Uint128) error {
+	if err := s.stream.Send(&grpb.ModifyRequest{Params: s.params}); err != nil {
+		return errors.Wrap(err, "error sending gRIBI session parameters")
+	}
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "error receiving gRIBI session parameters result")
+	}
+	if got := resp.GetSessionParamsResult().GetStatus(); got != grpb.SessionParametersResult_OK {
+		return errors.Errorf("gRIBI session parameters %v not accepted: %v", s.params, got)
+	}
+	if s.params.GetRedundancy() != grpb.SessionParameters_SINGLE_PRIMARY {
+		return nil
+	}
+	if err := s.stream.Send(&grpb.ModifyRequest{ElectionId: electionID}); err != nil {
+		return errors.Wrap(err, "error sending gRIBI election ID")
+	}
+	resp, err = s.stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "error receiving gRIBI election ID")
+	}
+	if got := resp.GetElectionId(); got.GetHigh() != electionID.GetHigh() || got.GetLow() != electionID.GetLow() {
+		return errors.Errorf("client with election ID %v is not the primary client, election ID %v is", electionID, got)
+	}
+	s.electionID = electionID
+	return nil
+}
+
+// Client returns the underlying gRIBI client.
+func (s *Session) Client() grpb.GRIBIClient {
+	return s.client
+}
+
+// Modify sends the AFT operations on the Modify stream of the session and waits
+// until each of them is acknowledged as programmed in the RIB, or in both the
+// RIB and FIB if the session requested FIB acknowledgements. The session
+// assigns the IDs and election ID of the operations. If the context is done
+// before all operations are acknowledged, the Modify stream is closed; it can
+// be reopened with Replay.
+func (s *Session) Modify(ctx context.Context, ops ...*grpb.AFTOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.modify(ctx, ops)
+}
+
+func (s *Session) modify(ctx context.Context, ops []*grpb.AFTOperation) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	// Recv does not take a context, so closing the stream is the only way to
+	// stop waiting for the results when the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func(cancel context.CancelFunc) {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-done:
+		}
+	}(s.cancel)
+	pending := make(map[uint64]*grpb.AFTOperation)
+	for _, op := range ops {
+		op.Id = s.nextID
+		op.ElectionId = s.electionID
+		pending[op.GetId()] = op
+		s.nextID++
+	}
+	if err := s.stream.Send(&grpb.ModifyRequest{Operation: ops, ElectionId: s.electionID}); err != nil {
+		return errors.Wrap(err, "error sending gRIBI AFT operations")
+	}
+	want := grpb.AFTResult_RIB_PROGRAMMED
+	if s.params.GetAckType() == grpb.SessionParameters_RIB_AND_FIB_ACK {
+		want = grpb.AFTResult_FIB_PROGRAMMED
+	}
+	for len(pending) > 0 {
+		resp, err := s.stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			return errors.Wrap(err, "error receiving gRIBI AFT operation results")
+		}
+		for _, res := range resp.GetResult() {
+			op, ok := pending[res.GetId()]
+			if !ok {
+				continue
+			}
+			switch res.GetStatus() {
+			case grpb.AFTResult_FAILED:
+				return errors.Errorf("gRIBI AFT operation %v failed", op)
+			case fibFailed:
+				return errors.Errorf("gRIBI AFT operation %v failed to be programmed in the FIB", op)
+			case want:
+				delete(pending, res.GetId())
+				s.record(op)
+			}
+		}
+	}
+	return nil
+}
+
+// record updates the installed entries of the session with the operation. A
+// replaced entry keeps its position, so entries are still replayed after the
+// entries they reference.
+func (s *Session) record(op *grpb.AFTOperation) {
+	key := entryKey(op)
+	for i, inst := range s.installed {
+		if entryKey(inst) != key {
+			continue
+		}
+		if op.GetOp() == grpb.AFTOperation_DELETE {
+			s.installed = append(s.installed[:i], s.installed[i+1:]...)
+		} else {
+			s.installed[i] = op
+		}
+		return
+	}
+	if op.GetOp() != grpb.AFTOperation_DELETE {
+		s.installed = append(s.installed, op)
+	}
+}
+
+// entryKey returns a key that uniquely identifies the AFT entry of the operation.
+func entryKey(op *grpb.AFTOperation) string {
+	var key string
+	switch e := op.GetEntry().(type) {
+	case *grpb.AFTOperation_Ipv4:
+		key = "ipv4/" + e.Ipv4.GetPrefix()
+	case *grpb.AFTOperation_Ipv6:
+		key = "ipv6/" + e.Ipv6.GetPrefix()
+	case *grpb.AFTOperation_Mpls:
+		key = fmt.Sprintf("mpls/%v", e.Mpls.GetLabel())
+	case *grpb.AFTOperation_NextHopGroup:
+		key = fmt.Sprintf("nhg/%d", e.NextHopGroup.GetId())
+	case *grpb.AFTOperation_NextHop:
+		key = fmt.Sprintf("nh/%d", e.NextHop.GetIndex())
+	case *grpb.AFTOperation_MacEntry:
+		key = "mac/" + e.MacEntry.GetMacAddress()
+	case *grpb.AFTOperation_PolicyForwardingEntry:
+		key = fmt.Sprintf("pfe/%d", e.PolicyForwardingEntry.GetIndex())
+	}
+	return op.GetNetworkInstance() + "/" + key
+}
+
+// Get returns the AFT entries installed on the device in the specified network
+// instance, or in all network instances if the name is empty.
+func (s *Session) Get(ctx context.Context, networkInstance string) ([]*grpb.AFTEntry, error) {
+	req := &grpb.GetRequest{Aft: grpb.AFTType_ALL}
+	if networkInstance == "" {
+		req.NetworkInstance = &grpb.GetRequest_All{All: &grpb.Empty{}}
+	} else {
+		req.NetworkInstance = &grpb.GetRequest_Name{Name: networkInstance}
+	}
+	gc, err := s.client.Get(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting gRIBI AFT entries")
+	}
+	var got []*grpb.AFTEntry
+	for {
+		resp, err := gc.Recv()
+		if err == io.EOF {
+			return got, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error receiving gRIBI AFT entries")
+		}
+		got = append(got, resp.GetEntry()...)
+	}
+}
+
+// Flush deletes all AFT entries installed on the device in the specified
+// network instance, or in all network instances if the name is empty. The
+// entries that reference next-hop groups are deleted before the groups, and the
+// groups before the next-hops they reference.
+func (s *Session) Flush(ctx context.Context, networkInstance string) error {
+	entries, err := s.Get(ctx, networkInstance)
+	if err != nil {
+		return err
+	}
+	var routes, nhgs, nhs []*grpb.AFTOperation
+	for _, e := range entries {
+		op := &grpb.AFTOperation{NetworkInstance: e.GetNetworkInstance(), Op: grpb.AFTOperation_DELETE}
+		switch ee := e.GetEntry().(type) {
+		case *grpb.AFTEntry_Ipv4:
+			op.Entry = &grpb.AFTOperation_Ipv4{Ipv4: ee.Ipv4}
+		case *grpb.AFTEntry_Ipv6:
+			op.Entry = &grpb.AFTOperation_Ipv6{Ipv6: ee.Ipv6}
+		case *grpb.AFTEntry_Mpls:
+			op.Entry = &grpb.AFTOperation_Mpls{Mpls: ee.Mpls}
+		case *grpb.AFTEntry_MacEntry:
+			op.Entry = &grpb.AFTOperation_MacEntry{MacEntry: ee.MacEntry}
+		case *grpb.AFTEntry_PolicyForwardingEntry:
+			op.Entry = &grpb.AFTOperation_PolicyForwardingEntry{PolicyForwardingEntry: ee.PolicyForwardingEntry}
+		case *grpb.AFTEntry_NextHopGroup:
+			op.Entry = &grpb.AFTOperation_NextHopGroup{NextHopGroup: ee.NextHopGroup}
+			nhgs = append(nhgs, op)
+			continue
+		case *grpb.AFTEntry_NextHop:
+			op.Entry = &grpb.AFTOperation_NextHop{NextHop: ee.NextHop}
+			nhs = append(nhs, op)
+			continue
+		default:
+			return errors.Errorf("unsupported gRIBI AFT entry %v", e)
+		}
+		routes = append(routes, op)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, ops := range [][]*grpb.AFTOperation{routes, nhgs, nhs} {
+		if err := s.modify(ctx, ops); err != nil {
+			return errors.Wrap(err, "error flushing gRIBI AFT entries")
+		}
+	}
+	return nil
+}
+
+// Replay reopens the Modify stream of the session, renegotiates the session
+// parameters and election ID, and re-adds all the AFT entries installed by the
+// session in the order they were installed, such as to restore them after the
+// device has restarted and closed the stream.
+func (s *Session) Replay(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancel()
+	if err := s.open(s.electionID); err != nil {
+		return errors.Wrap(err, "error reopening gRIBI session")
+	}
+	var ops []*grpb.AFTOperation
+	for _, inst := range s.installed {
+		ops = append(ops, &grpb.AFTOperation{
+			NetworkInstance: inst.GetNetworkInstance(),
+			Op:              grpb.AFTOperation_ADD,
+			Entry:           inst.GetEntry(),
+		})
+	}
+	return errors.Wrap(s.modify(ctx, ops), "error replaying gRIBI AFT entries")
+}
+
+// Close closes the Modify stream of the session. Unless the session requested
+// the entries to be preserved, the device removes the entries of the session.
+func (s *Session) Close() {
+	s.cancel()
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ixconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Change is a difference between two IxNetwork configs.
+type Change struct {
+	// XPath is the XPath of the config node that changed.
+	XPath string
+	// Field is the JSON name of the changed field of the config node, or empty
+	// if the whole config node was added or removed.
+	Field string
+	// Old and New are the JSON values of the field (or the fields of the node,
+	// if the whole node was added or removed) in the first and second config.
+	// They are nil if the field or node is not present in that config.
+	Old, New interface{}
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s: %v -> %v", c.XPath, c.Field, c.Old, c.New)
+}
+
+// Diff returns the changes from config a to config b, ordered by XPath and then
+// by field. Config nodes are matched by XPath, so a node removed from the
+// middle of a list changes all nodes after it in the list. The configs are not
+// modified; a nil config is treated as empty.
+func Diff(a, b *Ixnetwork) ([]*Change, error) {
+	aNodes, err := configNodes(a)
+	if err != nil {
+		return nil, err
+	}
+	bNodes, err := configNodes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*Change
+	for xp, aFields := range aNodes {
+		bFields, ok := bNodes[xp]
+		if !ok {
+			changes = append(changes, &Change{XPath: xp, Old: aFields})
+			continue
+		}
+		for f, av := range aFields {
+			if bv, ok := bFields[f]; !ok || !reflect.DeepEqual(av, bv) {
+				changes = append(changes, &Change{XPath: xp, Field: f, Old: av, New: bv})
+			}
+		}
+		for f, bv := range bFields {
+			if _, ok := aFields[f]; !ok {
+				changes = append(changes, &Change{XPath: xp, Field: f, New: bv})
+			}
+		}
+	}
+	for xp, bFields := range bNodes {
+		if _, ok := aNodes[xp]; !ok {
+			changes = append(changes, &Change{XPath: xp, New: bFields})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].XPath != changes[j].XPath {
+			return changes[i].XPath < changes[j].XPath
+		}
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// configNodes returns the value fields of every node in the config, keyed by
+// XPath. Fields whose values are child config nodes are excluded.
+func configNodes(cfg *Ixnetwork) (map[string]map[string]interface{}, error) {
+	if cfg == nil {
+		cfg = &Ixnetwork{}
+	}
+	cfg = cfg.Copy()
+	cfg.updateAllXPaths()
+	jsonCfg, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal Ixnetwork config to JSON: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(jsonCfg))
+	dec.UseNumber()
+	var root map[string]interface{}
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("could not decode Ixnetwork config JSON: %w", err)
+	}
+
+	nodes := make(map[string]map[string]interface{})
+	var addNode func(map[string]interface{})
+	addNode = func(node map[string]interface{}) {
+		fields := make(map[string]interface{})
+		for k, v := range node {
+			if k == "xpath" {
+				continue
+			}
+			if child, ok := v.(map[string]interface{}); ok && child["xpath"] != nil {
+				addNode(child)
+				continue
+			}
+			if children, ok := configNodeList(v); ok {
+				for _, child := range children {
+					addNode(child)
+				}
+				continue
+			}
+			fields[k] = v
+		}
+		nodes[fmt.Sprint(node["xpath"])] = fields
+	}
+	addNode(root)
+	return nodes, nil
+}
+
+// configNodeList returns the config nodes of the value, if it is a non-empty
+// list of config nodes.
+func configNodeList(v interface{}) ([]map[string]interface{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+	var nodes []map[string]interface{}
+	for _, e := range list {
+		node, ok := e.(map[string]interface{})
+		if !ok || node["xpath"] == nil {
+			return nil, false
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, true
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ixconfig
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	base := func() *Ixnetwork {
+		return &Ixnetwork{
+			Vport: []*Vport{{Name: String("port1")}},
+			Topology: []*Topology{{
+				Name:        String("topo1"),
+				DeviceGroup: []*TopologyDeviceGroup{{Name: String("dg1")}},
+			}},
+		}
+	}
+	tests := []struct {
+		desc string
+		a, b func() *Ixnetwork
+		want []*Change
+	}{{
+		desc: "no changes",
+		a:    base,
+		b:    base,
+	}, {
+		desc: "nil configs",
+		a:    func() *Ixnetwork { return nil },
+		b:    func() *Ixnetwork { return nil },
+	}, {
+		desc: "changed, added and removed fields",
+		a:    base,
+		b: func() *Ixnetwork {
+			cfg := base()
+			cfg.Vport[0].Name = String("port2")
+			cfg.Topology[0].Name = nil
+			cfg.Topology[0].DeviceGroup[0].Multiplier = NumberInt(2)
+			return cfg
+		},
+		want: []*Change{{
+			XPath: "/topology[1]",
+			Field: "name",
+			Old:   "topo1",
+		}, {
+			XPath: "/topology[1]/deviceGroup[1]",
+			Field: "multiplier",
+			New:   json.Number("2"),
+		}, {
+			XPath: "/vport[1]",
+			Field: "name",
+			Old:   "port1",
+			New:   "port2",
+		}},
+	}, {
+		desc: "added and removed nodes",
+		a:    base,
+		b: func() *Ixnetwork {
+			cfg := base()
+			cfg.Topology[0].DeviceGroup = nil
+			cfg.Vport = append(cfg.Vport, &Vport{Name: String("port2")})
+			return cfg
+		},
+		want: []*Change{{
+			XPath: "/topology[1]/deviceGroup[1]",
+			Old:   map[string]interface{}{"name": "dg1"},
+		}, {
+			XPath: "/vport[2]",
+			New:   map[string]interface{}{"name": "port2"},
+		}},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			a, b := test.a(), test.b()
+			got, err := Diff(a, b)
+			if err != nil {
+				t.Fatalf("Diff: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Diff: unexpected changes (-want,+got): %s", diff)
+			}
+			if a != nil && a.Vport[0].XPath() != nil {
+				t.Errorf("Diff: XPaths of config a were updated, want config unmodified")
+			}
+		})
+	}
+}
@@ -26,14 +26,22 @@ package ixconfig
 
 import (
 	"golang.org/x/net/context"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
 
+	log "github.com/golang/glog"
 	"github.com/openconfig/ondatra/binding/ixweb"
 )
 
 type ixSession interface {
 	Config() config
+	Delete(context.Context, string) error
+	IsActive(context.Context) bool
 }
 
 type config interface {
@@ -50,9 +58,34 @@ func (sw *sessionWrapper) Config() config {
 	return sw.Session.Config()
 }
 
+var sleepFn = time.Sleep
+
+// RecoveryPolicy configures how a Client recovers from the loss of its
+// IxNetwork session, such as when the IxNetwork API server restarts.
+type RecoveryPolicy struct {
+	// NewSession establishes a replacement IxNetwork session.
+	NewSession func(context.Context) (*ixweb.Session, error)
+	// Attempts is the maximum number of attempts to establish a replacement
+	// session. At least one attempt is always made.
+	Attempts int
+	// Delay is the time to wait between attempts.
+	Delay time.Duration
+}
+
+type recovery struct {
+	newSession func(context.Context) (ixSession, error)
+	attempts   int
+	delay      time.Duration
+}
+
 // Client implements an API for interacting with an Ixia session using a JSON-based config representation.
+// A Client is safe for concurrent use. Calls that import config, delete nodes or
+// query IDs are serialized, so they are applied to the session one at a time in
+// the order that they acquire the Client.
 type Client struct {
+	mu           sync.Mutex // Guards the fields below and serializes session config changes.
 	sess         ixSession
+	recovery     *recovery
 	lastImported *Ixnetwork
 	xPathToID    map[string]string
 }
@@ -63,13 +96,105 @@ func New(sess *ixweb.Session) *Client {
 }
 
 // Session returns the IxNetwork session used by the config client.
+// The session may change if recovery is enabled and the session is lost.
 func (c *Client) Session() *ixweb.Session {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.sess.(*sessionWrapper).Session
 }
 
+// EnableRecovery enables automatic recovery from the loss of the IxNetwork
+// session. When a config export, import, deletion or ID query fails and the
+// session is no longer active, the client establishes a replacement session
+// according to the policy, re-imports the last imported config, re-resolves
+// the IDs of all previously resolved nodes, and then retries the failed call
+// once. Any other session state, such as running protocols or traffic, is not
+// restored.
+func (c *Client) EnableRecovery(policy *RecoveryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recovery = &recovery{
+		newSession: func(ctx context.Context) (ixSession, error) {
+			sess, err := policy.NewSession(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &sessionWrapper{sess}, nil
+		},
+		attempts: policy.Attempts,
+		delay:    policy.Delay,
+	}
+}
+
+// withRecovery calls the function and, if it fails because the session was
+// lost, recovers the session and calls the function again.
+// The client mutex must be held.
+func (c *Client) withRecovery(ctx context.Context, fn func() error) error {
+	err := fn()
+	if err == nil || c.recovery == nil || c.sess.IsActive(ctx) {
+		return err
+	}
+	log.Warningf("IxNetwork session lost after error %v, recovering session", err)
+	if rerr := c.recoverSession(ctx); rerr != nil {
+		return fmt.Errorf("could not recover lost session after error %v: %w", err, rerr)
+	}
+	return fn()
+}
+
+func (c *Client) recoverSession(ctx context.Context) error {
+	var sess ixSession
+	var err error
+	for i := 0; i == 0 || i < c.recovery.attempts; i++ {
+		if i > 0 {
+			sleepFn(c.recovery.delay)
+		}
+		if sess, err = c.recovery.newSession(ctx); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("could not establish replacement session: %w", err)
+	}
+	c.sess = sess
+
+	if c.lastImported != nil {
+		cfg := c.lastImported.Copy()
+		cfg.updateAllXPaths()
+		jsonCfg, err := json.Marshal(cfg)
+		if err != nil {
+			return fmt.Errorf("could not marshal last imported config to JSON: %w", err)
+		}
+		if err := sess.Config().Import(ctx, string(jsonCfg), true); err != nil {
+			return fmt.Errorf("could not re-import last imported config: %w", err)
+		}
+	}
+	var xps []string
+	for xp := range c.xPathToID {
+		xps = append(xps, xp)
+	}
+	c.xPathToID = map[string]string{}
+	if len(xps) == 0 {
+		return nil
+	}
+	ids, err := sess.Config().QueryIDs(ctx, xps...)
+	if err != nil {
+		return fmt.Errorf("could not re-resolve node IDs: %w", err)
+	}
+	for xp, id := range ids {
+		c.xPathToID[xp] = id
+	}
+	return nil
+}
+
 // NodeID returns the updated ID for the specified node. Returns an error if the
 // node is not part of an imported config or the node ID has not been updated.
 func (c *Client) NodeID(node IxiaCfgNode) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nodeID(node)
+}
+
+func (c *Client) nodeID(node IxiaCfgNode) (string, error) {
 	xp := node.XPath()
 	if xp == nil {
 		return "", fmt.Errorf("node of type %T not yet imported", node)
@@ -83,8 +208,14 @@ func (c *Client) NodeID(node IxiaCfgNode) (string, error) {
 
 // ExportConfig exports the current full configuration of the IxNetwork session.
 func (c *Client) ExportConfig(ctx context.Context) (*Ixnetwork, error) {
-	cfgStr, err := c.sess.Config().Export(ctx)
-	if err != nil {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var cfgStr string
+	if err := c.withRecovery(ctx, func() error {
+		var err error
+		cfgStr, err = c.sess.Config().Export(ctx)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 	cfg := &Ixnetwork{}
@@ -101,7 +232,11 @@ func (c *Client) ExportConfig(ctx context.Context) (*Ixnetwork, error) {
 // For values that are a list of config nodes, only the nodes that are specified are updated. (Eg.
 // you cannot remove a config node from a list using this function with overwrite set to 'false'.)
 // All XPaths in the config are updated before this function returns.
+// Concurrent imports are applied one at a time, and the config must not be
+// modified by other goroutines during the import.
 func (c *Client) ImportConfig(ctx context.Context, cfg *Ixnetwork, node IxiaCfgNode, overwrite bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.xPathToID = map[string]string{}
 	cfg.updateAllXPaths()
 
@@ -109,7 +244,9 @@ func (c *Client) ImportConfig(ctx context.Context, cfg *Ixnetwork, node IxiaCfgN
 	if err != nil {
 		return fmt.Errorf("could not marshal Ixnetwork config to JSON: %w", err)
 	}
-	if err := c.sess.Config().Import(ctx, string(jsonCfg), overwrite); err != nil {
+	if err := c.withRecovery(ctx, func() error {
+		return c.sess.Config().Import(ctx, string(jsonCfg), overwrite)
+	}); err != nil {
 		return err
 	}
 
@@ -118,17 +255,220 @@ func (c *Client) ImportConfig(ctx context.Context, cfg *Ixnetwork, node IxiaCfgN
 	return nil
 }
 
+// ImportConfigChunked imports the specified config like ImportConfig, but
+// splits it across multiple imports so that no single request body grows too
+// large, such as for configs with hundreds of thousands of route ranges. Lists
+// of more than chunkSize config nodes are removed from their parents, and
+// their nodes are imported after the parents, at most chunkSize nodes per
+// import. Only the first import overwrites the existing config if overwrite is
+// 'true'. If progress is non-nil, it is called after each import with the
+// number of imports done so far and the total number of imports.
+func (c *Client) ImportConfigChunked(ctx context.Context, cfg *Ixnetwork, node IxiaCfgNode, overwrite bool, chunkSize int, progress func(done, total int)) error {
+	if chunkSize <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.xPathToID = map[string]string{}
+	cfg.updateAllXPaths()
+
+	jsonCfg, err := json.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("could not marshal Ixnetwork config to JSON: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(jsonCfg))
+	// Preserve numbers as they are, rather than converting them to floats.
+	dec.UseNumber()
+	var root map[string]interface{}
+	if err := dec.Decode(&root); err != nil {
+		return fmt.Errorf("could not decode Ixnetwork config JSON: %w", err)
+	}
+
+	imports := []interface{}{root}
+	nodes := splitLists(root, chunkSize)
+	for len(nodes) > 0 {
+		n := chunkSize
+		if len(nodes) < n {
+			n = len(nodes)
+		}
+		imports = append(imports, nodes[:n])
+		nodes = nodes[n:]
+	}
+	for i, imp := range imports {
+		jsonImp, err := json.Marshal(imp)
+		if err != nil {
+			return fmt.Errorf("could not marshal Ixnetwork config chunk to JSON: %w", err)
+		}
+		if err := c.withRecovery(ctx, func() error {
+			return c.sess.Config().Import(ctx, string(jsonImp), overwrite && i == 0)
+		}); err != nil {
+			return fmt.Errorf("could not import config chunk %d of %d: %w", i+1, len(imports), err)
+		}
+		if progress != nil {
+			progress(i+1, len(imports))
+		}
+	}
+
+	// Record the config that was pushed.
+	c.lastImported = cfg.Copy()
+	return nil
+}
+
+// splitLists removes the lists of more than chunkSize config nodes from the
+// specified JSON config node and its descendants, and returns the removed nodes
+// in the order they must be imported, with every node after its parent.
+func splitLists(node map[string]interface{}, chunkSize int) []map[string]interface{} {
+	var keys []string
+	for k := range node {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var split []map[string]interface{}
+	for _, k := range keys {
+		children, ok := configNodeList(node[k])
+		if !ok {
+			continue
+		}
+		var removed []map[string]interface{}
+		if len(children) > chunkSize {
+			delete(node, k)
+			removed = children
+		}
+		for _, child := range children {
+			descendants := splitLists(child, chunkSize)
+			if removed == nil {
+				split = append(split, descendants...)
+			} else {
+				removed = append(removed, descendants...)
+			}
+		}
+		split = append(split, removed...)
+	}
+	return split
+}
+
+// DeleteNodes deletes the specified nodes, and all nodes below them, from the
+// IxNetwork session. The IDs of the nodes must already be updated, such as with
+// UpdateIDs. Since deleting nodes from a list changes the XPaths of the nodes
+// after them in the list, all recorded IDs are cleared; callers should remove
+// the deleted nodes from their config and use UpdateIDs to query the IDs of the
+// remaining nodes as needed. The deleted nodes are also removed from the last
+// imported config, so they are not restored if the session is recovered.
+func (c *Client) DeleteNodes(ctx context.Context, nodes ...IxiaCfgNode) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.nodeIDs(nodes); err != nil {
+		return err
+	}
+	defer func() { c.xPathToID = map[string]string{} }()
+	deleted := map[string]bool{}
+	err := c.withRecovery(ctx, func() error {
+		// Resolve the IDs again, since they may change if the session is recovered.
+		ids, err := c.nodeIDs(nodes)
+		if err != nil {
+			return err
+		}
+		// Recovery re-imports the last config, so only the deletions of the final
+		// attempt are reflected in the session.
+		deleted = map[string]bool{}
+		for i, id := range ids {
+			if err := c.sess.Delete(ctx, id); err != nil {
+				return fmt.Errorf("could not delete node at %q: %w", id, err)
+			}
+			deleted[nodes[i].XPath().String()] = true
+		}
+		return nil
+	})
+	if len(deleted) > 0 && c.lastImported != nil {
+		cfg := c.lastImported.Copy()
+		cfg.updateAllXPaths()
+		removeNodes(reflect.ValueOf(cfg), deleted)
+		c.lastImported = cfg
+	}
+	return err
+}
+
+// removeNodes removes the config nodes with the specified XPaths from the
+// config node held by the value, which must be a pointer to a config struct.
+func removeNodes(v reflect.Value, xps map[string]bool) {
+	if v.IsNil() {
+		return
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Ptr && isConfigNode(f.Type()):
+			if f.IsNil() {
+				continue
+			}
+			if isRemoved(f, xps) {
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+			removeNodes(f, xps)
+		case f.Kind() == reflect.Slice && isConfigNode(f.Type().Elem()):
+			if f.IsNil() {
+				continue
+			}
+			kept := reflect.MakeSlice(f.Type(), 0, f.Len())
+			for j := 0; j < f.Len(); j++ {
+				e := f.Index(j)
+				if isRemoved(e, xps) {
+					continue
+				}
+				removeNodes(e, xps)
+				kept = reflect.Append(kept, e)
+			}
+			f.Set(kept)
+		}
+	}
+}
+
+func isRemoved(v reflect.Value, xps map[string]bool) bool {
+	if v.IsNil() {
+		return false
+	}
+	xp := v.Interface().(IxiaCfgNode).XPath()
+	return xp != nil && xps[xp.String()]
+}
+
+var cfgNodeType = reflect.TypeOf((*IxiaCfgNode)(nil)).Elem()
+
+// isConfigNode returns whether the type is a pointer to a config struct.
+func isConfigNode(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Implements(cfgNodeType)
+}
+
+func (c *Client) nodeIDs(nodes []IxiaCfgNode) ([]string, error) {
+	var ids []string
+	for _, n := range nodes {
+		id, err := c.nodeID(n)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 // LastImportedConfig returns a copy of the last config push attempt using this
 // client. Returns 'nil' if there has not been a config push. A new copy of the
 // last config is returned on every invocation and does not have its XPaths set.
 func (c *Client) LastImportedConfig() *Ixnetwork {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.lastImported.Copy()
 }
 
 // UpdateIDs updates recorded REST IDs for the target nodes in the config.
 // If the ID for the node is already updated, this is a noop for that node.
 // This query can be expensive if used with many different types of objects.
+// The config must not be modified by other goroutines during the update.
 func (c *Client) UpdateIDs(ctx context.Context, cfg *Ixnetwork, nodes ...IxiaCfgNode) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	// Update XPaths because they may be lost as *Ixnetwork config objects
 	// are copied around (such as a config returned from
 	// 'LastImportedConfig' or a user may have constructed a read-only
@@ -142,8 +482,12 @@ func (c *Client) UpdateIDs(ctx context.Context, cfg *Ixnetwork, nodes ...IxiaCfg
 			xPathsMissing = append(xPathsMissing, xp)
 		}
 	}
-	newIDs, err := c.sess.Config().QueryIDs(ctx, xPathsMissing...)
-	if err != nil {
+	var newIDs map[string]string
+	if err := c.withRecovery(ctx, func() error {
+		var err error
+		newIDs, err = c.sess.Config().QueryIDs(ctx, xPathsMissing...)
+		return err
+	}); err != nil {
 		return err
 	}
 	for xp, id := range newIDs {
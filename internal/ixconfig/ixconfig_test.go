@@ -21,25 +21,44 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 type fakeSession struct {
 	ixSession
-	config config
+	config     config
+	deleteErrs map[string]error
+	deleted    []string
+	inactive   bool
 }
 
 func (s *fakeSession) Config() config {
 	return s.config
 }
 
+func (s *fakeSession) Delete(_ context.Context, p string) error {
+	if err := s.deleteErrs[p]; err != nil {
+		return err
+	}
+	s.deleted = append(s.deleted, p)
+	return nil
+}
+
+func (s *fakeSession) IsActive(context.Context) bool {
+	return !s.inactive
+}
+
 type fakeConfig struct {
 	config
-	exportRes interface{}
-	importErr error
-	queryRes  interface{}
+	exportRes  interface{}
+	importErr  error
+	queryRes   interface{}
+	imports    []string
+	overwrites []bool
 }
 
 func (c *fakeConfig) Export(context.Context) (string, error) {
@@ -53,7 +72,9 @@ func (c *fakeConfig) Export(context.Context) (string, error) {
 	}
 }
 
-func (c *fakeConfig) Import(context.Context, string, bool) error {
+func (c *fakeConfig) Import(_ context.Context, cfg string, overwrite bool) error {
+	c.imports = append(c.imports, cfg)
+	c.overwrites = append(c.overwrites, overwrite)
 	return c.importErr
 }
 
@@ -150,6 +171,198 @@ func TestImportConfig(t *testing.T) {
 	}
 }
 
+func TestImportConfigChunked(t *testing.T) {
+	newCfg := func() *Ixnetwork {
+		cfg := &Ixnetwork{}
+		for i := 0; i < 3; i++ {
+			cfg.Topology = append(cfg.Topology, &Topology{
+				Name:        String(fmt.Sprintf("topo%d", i+1)),
+				DeviceGroup: []*TopologyDeviceGroup{{}, {}},
+			})
+		}
+		return cfg
+	}
+	// importXPaths returns the XPaths of the top-level nodes of each import.
+	importXPaths := func(t *testing.T, imports []string) [][]string {
+		t.Helper()
+		var got [][]string
+		for _, imp := range imports {
+			var nodes []map[string]interface{}
+			if strings.HasPrefix(imp, "{") {
+				nodes = []map[string]interface{}{{}}
+				if err := json.Unmarshal([]byte(imp), &nodes[0]); err != nil {
+					t.Fatalf("could not unmarshal import %q: %v", imp, err)
+				}
+			} else if err := json.Unmarshal([]byte(imp), &nodes); err != nil {
+				t.Fatalf("could not unmarshal import %q: %v", imp, err)
+			}
+			var xps []string
+			for _, n := range nodes {
+				xps = append(xps, n["xpath"].(string))
+			}
+			got = append(got, xps)
+		}
+		return got
+	}
+
+	tests := []struct {
+		desc           string
+		chunkSize      int
+		importErr      error
+		wantXPaths     [][]string
+		wantOverwrites []bool
+		wantErr        string
+	}{{
+		desc:      "invalid chunk size",
+		chunkSize: 0,
+		wantErr:   "chunk size must be positive",
+	}, {
+		desc:      "import error",
+		chunkSize: 2,
+		importErr: errors.New("import error"),
+		wantXPaths: [][]string{
+			{"/"},
+		},
+		wantOverwrites: []bool{true},
+		wantErr:        "chunk 1 of 3",
+	}, {
+		desc:      "no splitting",
+		chunkSize: 3,
+		wantXPaths: [][]string{
+			{"/"},
+		},
+		wantOverwrites: []bool{true},
+	}, {
+		desc:      "split topologies",
+		chunkSize: 2,
+		wantXPaths: [][]string{
+			{"/"},
+			{"/topology[1]", "/topology[2]"},
+			{"/topology[3]"},
+		},
+		wantOverwrites: []bool{true, false, false},
+	}, {
+		desc:      "split topologies and device groups",
+		chunkSize: 1,
+		wantXPaths: [][]string{
+			{"/"},
+			{"/topology[1]"},
+			{"/topology[2]"},
+			{"/topology[3]"},
+			{"/topology[1]/deviceGroup[1]"},
+			{"/topology[1]/deviceGroup[2]"},
+			{"/topology[2]/deviceGroup[1]"},
+			{"/topology[2]/deviceGroup[2]"},
+			{"/topology[3]/deviceGroup[1]"},
+			{"/topology[3]/deviceGroup[2]"},
+		},
+		wantOverwrites: []bool{true, false, false, false, false, false, false, false, false, false},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			fc := &fakeConfig{importErr: test.importErr}
+			c := &Client{sess: &fakeSession{config: fc}}
+			cfg := newCfg()
+			var gotProgress []int
+			gotErr := c.ImportConfigChunked(context.Background(), cfg, cfg, true, test.chunkSize, func(done, total int) {
+				if total != len(test.wantXPaths) {
+					t.Errorf("ImportConfigChunked: got progress total %d, want %d", total, len(test.wantXPaths))
+				}
+				gotProgress = append(gotProgress, done)
+			})
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("ImportConfigChunked: unexpected error, got err %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.wantXPaths, importXPaths(t, fc.imports)); diff != "" {
+				t.Errorf("ImportConfigChunked: unexpected imported XPaths (-want,+got): %s", diff)
+			}
+			if diff := cmp.Diff(test.wantOverwrites, fc.overwrites); diff != "" {
+				t.Errorf("ImportConfigChunked: unexpected overwrites (-want,+got): %s", diff)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if len(gotProgress) != len(test.wantXPaths) {
+				t.Errorf("ImportConfigChunked: got %d progress calls, want %d", len(gotProgress), len(test.wantXPaths))
+			}
+			if c.LastImportedConfig() == nil {
+				t.Errorf("ImportConfigChunked: last imported config not recorded")
+			}
+		})
+	}
+}
+
+func TestDeleteNodes(t *testing.T) {
+	const (
+		topologyID    = "/fake/abs/path/to/topology/2"
+		deviceGroupID = "/fake/abs/path/to/topology/2/deviceGroup/3"
+		trafficItemID = "/fake/abs/path/to/traffic/trafficItem/1"
+	)
+	cfg := &Ixnetwork{
+		Topology: []*Topology{{DeviceGroup: []*TopologyDeviceGroup{{}}}},
+		Traffic:  &Traffic{TrafficItem: []*TrafficTrafficItem{{}}},
+	}
+	cfg.updateAllXPaths()
+	topology := cfg.Topology[0]
+	trafficItem := cfg.Traffic.TrafficItem[0]
+	ids := map[string]string{
+		topology.XPath().String():                topologyID,
+		topology.DeviceGroup[0].XPath().String(): deviceGroupID,
+		trafficItem.XPath().String():             trafficItemID,
+	}
+
+	tests := []struct {
+		desc        string
+		nodes       []IxiaCfgNode
+		deleteErrs  map[string]error
+		wantDeleted []string
+		wantLast    *Ixnetwork
+		wantErr     string
+	}{{
+		desc:     "node without ID",
+		nodes:    []IxiaCfgNode{&Topology{}},
+		wantLast: cfg,
+		wantErr:  "not yet imported",
+	}, {
+		desc:        "delete error",
+		nodes:       []IxiaCfgNode{topology, trafficItem},
+		deleteErrs:  map[string]error{trafficItemID: errors.New("DELETE error")},
+		wantDeleted: []string{topologyID},
+		wantLast:    &Ixnetwork{Topology: []*Topology{}, Traffic: &Traffic{TrafficItem: []*TrafficTrafficItem{{}}}},
+		wantErr:     "DELETE error",
+	}, {
+		desc:        "success",
+		nodes:       []IxiaCfgNode{topology.DeviceGroup[0], trafficItem},
+		wantDeleted: []string{deviceGroupID, trafficItemID},
+		wantLast:    &Ixnetwork{Topology: []*Topology{{DeviceGroup: []*TopologyDeviceGroup{}}}, Traffic: &Traffic{TrafficItem: []*TrafficTrafficItem{}}},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sess := &fakeSession{deleteErrs: test.deleteErrs}
+			idsCopy := map[string]string{}
+			for k, v := range ids {
+				idsCopy[k] = v
+			}
+			c := &Client{sess: sess, xPathToID: idsCopy, lastImported: cfg.Copy()}
+			gotErr := c.DeleteNodes(context.Background(), test.nodes...)
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Errorf("DeleteNodes: unexpected error, got err %v, want err %q", gotErr, test.wantErr)
+			}
+			if diff := cmp.Diff(test.wantDeleted, sess.deleted); diff != "" {
+				t.Errorf("DeleteNodes: unexpected deleted IDs (-want,+got): %s", diff)
+			}
+			if test.wantDeleted != nil && len(c.xPathToID) != 0 {
+				t.Errorf("DeleteNodes: got ID cache %v after deletion, want empty", c.xPathToID)
+			}
+			wantLast := test.wantLast.Copy()
+			wantLast.updateAllXPaths()
+			if diff := cmp.Diff(wantLast, c.lastImported, cmp.AllowUnexported(XPath{})); diff != "" {
+				t.Errorf("DeleteNodes: unexpected last imported config (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdateIDs(t *testing.T) {
 	const (
 		topologyID    = "/fake/abs/path/to/topology/2"
@@ -273,3 +486,122 @@ func TestUpdateIDsOfLastImported(t *testing.T) {
 		t.Errorf("UpdateIDs: unexpected diff in ID cache (-want,+got): %s\n", diff)
 	}
 }
+
+// This case tests that the client can be used from multiple goroutines, and
+// should be run with the race detector enabled.
+func TestConcurrentUse(t *testing.T) {
+	c := &Client{
+		sess: &fakeSession{config: &fakeConfig{
+			queryRes: map[string]string{"/topology[1]": "/fake/abs/path/to/topology/1"},
+		}},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cfg := &Ixnetwork{Topology: []*Topology{{}}}
+			if err := c.ImportConfig(context.Background(), cfg, cfg, false); err != nil {
+				t.Errorf("ImportConfig: unexpected error: %v", err)
+				return
+			}
+			if err := c.UpdateIDs(context.Background(), cfg, cfg.Topology[0]); err != nil {
+				t.Errorf("UpdateIDs: unexpected error: %v", err)
+				return
+			}
+			c.NodeID(cfg.Topology[0])
+			c.LastImportedConfig()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRecovery(t *testing.T) {
+	sleepFn = func(time.Duration) {}
+	defer func() { sleepFn = time.Sleep }()
+
+	cfg := &Ixnetwork{Topology: []*Topology{{}, {}}}
+	cfg.updateAllXPaths()
+	topo1XPath := cfg.Topology[0].XPath().String()
+	topo2XPath := cfg.Topology[1].XPath().String()
+	newIDs := map[string]string{
+		topo1XPath: "/new/abs/path/to/topology/1",
+		topo2XPath: "/new/abs/path/to/topology/2",
+	}
+
+	tests := []struct {
+		desc         string
+		noRecovery   bool
+		active       bool
+		newSessErrs  int
+		wantErr      string
+		wantSessions int
+		wantIDs      map[string]string
+	}{{
+		desc:         "recovered",
+		wantSessions: 1,
+		wantIDs:      newIDs,
+	}, {
+		desc:         "recovered after retry",
+		newSessErrs:  1,
+		wantSessions: 2,
+		wantIDs:      newIDs,
+	}, {
+		desc:       "recovery disabled",
+		noRecovery: true,
+		wantErr:    "query error",
+	}, {
+		desc:    "session still active",
+		active:  true,
+		wantErr: "query error",
+	}, {
+		desc:         "new session error",
+		newSessErrs:  3,
+		wantErr:      "new session error",
+		wantSessions: 3,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			newCfg := &fakeConfig{queryRes: newIDs}
+			c := &Client{
+				sess: &fakeSession{
+					config:   &fakeConfig{queryRes: errors.New("query error")},
+					inactive: !test.active,
+				},
+				lastImported: cfg.Copy(),
+				xPathToID:    map[string]string{topo1XPath: "/old/abs/path/to/topology/1"},
+			}
+			var gotSessions int
+			if !test.noRecovery {
+				c.recovery = &recovery{
+					newSession: func(context.Context) (ixSession, error) {
+						gotSessions++
+						if gotSessions <= test.newSessErrs {
+							return nil, errors.New("new session error")
+						}
+						return &fakeSession{config: newCfg}, nil
+					},
+					attempts: 3,
+				}
+			}
+
+			gotErr := c.UpdateIDs(context.Background(), cfg, cfg.Topology[1])
+			if (gotErr == nil) != (test.wantErr == "") || (gotErr != nil && !strings.Contains(gotErr.Error(), test.wantErr)) {
+				t.Fatalf("UpdateIDs: unexpected error, got err %v, want err %q", gotErr, test.wantErr)
+			}
+			if gotSessions != test.wantSessions {
+				t.Errorf("UpdateIDs: got %d new session attempts, want %d", gotSessions, test.wantSessions)
+			}
+			if test.wantErr != "" {
+				return
+			}
+			if len(newCfg.imports) != 1 || !newCfg.overwrites[0] {
+				t.Errorf("UpdateIDs: got imports %v with overwrites %v, want one overwriting import of the last config", newCfg.imports, newCfg.overwrites)
+			}
+			if diff := cmp.Diff(test.wantIDs, c.xPathToID); diff != "" {
+				t.Errorf("UpdateIDs: unexpected diff in ID cache (-want,+got): %s\n", diff)
+			}
+		})
+	}
+}
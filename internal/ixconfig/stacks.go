@@ -4508,3 +4508,223 @@ func NewVlanStack(idx int) *VlanStack {
 	stack := VlanStack(newStack(idx, "vlan", vlanAliasToFieldIdx))
 	return &stack
 }
+
+type VxlanStack TrafficStack
+
+var vxlanAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.flags-1",
+	"header.reserved-2",
+	"header.vni-3",
+	"header.reserved8-4",
+})
+
+func (s *VxlanStack) Flags() *TrafficField {
+	return s.Field[vxlanAliasToFieldIdx["header.flags-1"]]
+}
+func (s *VxlanStack) Reserved() *TrafficField {
+	return s.Field[vxlanAliasToFieldIdx["header.reserved-2"]]
+}
+func (s *VxlanStack) Vni() *TrafficField {
+	return s.Field[vxlanAliasToFieldIdx["header.vni-3"]]
+}
+func (s *VxlanStack) Reserved8() *TrafficField {
+	return s.Field[vxlanAliasToFieldIdx["header.reserved8-4"]]
+}
+
+func (s *VxlanStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewVxlanStack(idx int) *VxlanStack {
+	stack := VxlanStack(newStack(idx, "vxlan", vxlanAliasToFieldIdx))
+	return &stack
+}
+
+type GeneveStack TrafficStack
+
+var geneveAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.version-1",
+	"header.optionsLength-2",
+	"header.controlPacket-3",
+	"header.criticalOptionsPresent-4",
+	"header.reserved-5",
+	"header.protocolType-6",
+	"header.vni-7",
+	"header.reserved8-8",
+})
+
+func (s *GeneveStack) Version() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.version-1"]]
+}
+func (s *GeneveStack) OptionsLength() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.optionsLength-2"]]
+}
+func (s *GeneveStack) ControlPacket() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.controlPacket-3"]]
+}
+func (s *GeneveStack) CriticalOptionsPresent() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.criticalOptionsPresent-4"]]
+}
+func (s *GeneveStack) Reserved() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.reserved-5"]]
+}
+func (s *GeneveStack) ProtocolType() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.protocolType-6"]]
+}
+func (s *GeneveStack) Vni() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.vni-7"]]
+}
+func (s *GeneveStack) Reserved8() *TrafficField {
+	return s.Field[geneveAliasToFieldIdx["header.reserved8-8"]]
+}
+
+func (s *GeneveStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewGeneveStack(idx int) *GeneveStack {
+	stack := GeneveStack(newStack(idx, "geneve", geneveAliasToFieldIdx))
+	return &stack
+}
+
+type GtpuStack TrafficStack
+
+var gtpuAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.version-1",
+	"header.pt-2",
+	"header.reserved-3",
+	"header.e-4",
+	"header.s-5",
+	"header.n-6",
+	"header.type-7",
+	"header.totalLength-8",
+	"header.teid-9",
+})
+
+func (s *GtpuStack) Version() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.version-1"]]
+}
+func (s *GtpuStack) Pt() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.pt-2"]]
+}
+func (s *GtpuStack) Reserved() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.reserved-3"]]
+}
+func (s *GtpuStack) E() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.e-4"]]
+}
+func (s *GtpuStack) S() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.s-5"]]
+}
+func (s *GtpuStack) N() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.n-6"]]
+}
+func (s *GtpuStack) Type() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.type-7"]]
+}
+func (s *GtpuStack) TotalLength() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.totalLength-8"]]
+}
+func (s *GtpuStack) Teid() *TrafficField {
+	return s.Field[gtpuAliasToFieldIdx["header.teid-9"]]
+}
+
+func (s *GtpuStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewGtpuStack(idx int) *GtpuStack {
+	stack := GtpuStack(newStack(idx, "gtpu", gtpuAliasToFieldIdx))
+	return &stack
+}
+
+type GtpuOptionalFieldsStack TrafficStack
+
+var gtpuOptionalFieldsAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.sequenceNumber-1",
+	"header.npduNumber-2",
+	"header.nextExtHdrField-3",
+})
+
+func (s *GtpuOptionalFieldsStack) SequenceNumber() *TrafficField {
+	return s.Field[gtpuOptionalFieldsAliasToFieldIdx["header.sequenceNumber-1"]]
+}
+func (s *GtpuOptionalFieldsStack) NpduNumber() *TrafficField {
+	return s.Field[gtpuOptionalFieldsAliasToFieldIdx["header.npduNumber-2"]]
+}
+func (s *GtpuOptionalFieldsStack) NextExtHdrField() *TrafficField {
+	return s.Field[gtpuOptionalFieldsAliasToFieldIdx["header.nextExtHdrField-3"]]
+}
+
+func (s *GtpuOptionalFieldsStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewGtpuOptionalFieldsStack(idx int) *GtpuOptionalFieldsStack {
+	stack := GtpuOptionalFieldsStack(newStack(idx, "gtpuOptionalFields", gtpuOptionalFieldsAliasToFieldIdx))
+	return &stack
+}
+
+type GtpuExtensionPduSessionContainerStack TrafficStack
+
+var gtpuExtensionPduSessionContainerAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.length-1",
+	"header.pduType-2",
+	"header.spare-3",
+	"header.qfi-4",
+	"header.nextExtHdrField-5",
+})
+
+func (s *GtpuExtensionPduSessionContainerStack) Length() *TrafficField {
+	return s.Field[gtpuExtensionPduSessionContainerAliasToFieldIdx["header.length-1"]]
+}
+func (s *GtpuExtensionPduSessionContainerStack) PduType() *TrafficField {
+	return s.Field[gtpuExtensionPduSessionContainerAliasToFieldIdx["header.pduType-2"]]
+}
+func (s *GtpuExtensionPduSessionContainerStack) Spare() *TrafficField {
+	return s.Field[gtpuExtensionPduSessionContainerAliasToFieldIdx["header.spare-3"]]
+}
+func (s *GtpuExtensionPduSessionContainerStack) Qfi() *TrafficField {
+	return s.Field[gtpuExtensionPduSessionContainerAliasToFieldIdx["header.qfi-4"]]
+}
+func (s *GtpuExtensionPduSessionContainerStack) NextExtHdrField() *TrafficField {
+	return s.Field[gtpuExtensionPduSessionContainerAliasToFieldIdx["header.nextExtHdrField-5"]]
+}
+
+func (s *GtpuExtensionPduSessionContainerStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewGtpuExtensionPduSessionContainerStack(idx int) *GtpuExtensionPduSessionContainerStack {
+	stack := GtpuExtensionPduSessionContainerStack(newStack(idx, "gtpuExtensionPduSessionContainer", gtpuExtensionPduSessionContainerAliasToFieldIdx))
+	return &stack
+}
+
+type CustomStack TrafficStack
+
+var customAliasToFieldIdx = aliasesToFieldIdx([]string{
+	"header.length-1",
+	"header.data-2",
+})
+
+func (s *CustomStack) Length() *TrafficField {
+	return s.Field[customAliasToFieldIdx["header.length-1"]]
+}
+func (s *CustomStack) Data() *TrafficField {
+	return s.Field[customAliasToFieldIdx["header.data-2"]]
+}
+
+func (s *CustomStack) TrafficStack() *TrafficStack {
+	ts := TrafficStack(*s)
+	return &ts
+}
+
+func NewCustomStack(idx int) *CustomStack {
+	stack := CustomStack(newStack(idx, "custom", customAliasToFieldIdx))
+	return &stack
+}
@@ -193,3 +193,67 @@ func TestUdpStack(t *testing.T) {
 		}
 	}
 }
+
+func TestVxlanStack(t *testing.T) {
+	stack := NewVxlanStack(2)
+	wantStackAlias := "vxlan-3"
+	wantFieldAliases := map[string]*TrafficField{
+		"vxlan.header.vni-3": stack.Vni(),
+	}
+	if !strings.Contains(stack.Xpath.String(), wantStackAlias) {
+		t.Errorf("Unexpected xpath %q for VxlanStack (wanted alias text to contain %q)", stack.Xpath.String(), wantStackAlias)
+	}
+	for alias, field := range wantFieldAliases {
+		xp := field.XPath().String()
+		if !strings.Contains(xp, wantStackAlias) {
+			t.Errorf("Unexpected xpath %q for VxlanStack field (wanted alias text to contain stack alias portion %q)", xp, wantStackAlias)
+		}
+		if !strings.Contains(xp, alias) {
+			t.Errorf("Unexpected xpath %q for VxlanStack field (wanted alias text to contain %q)", xp, alias)
+		}
+	}
+}
+
+func TestGeneveStack(t *testing.T) {
+	stack := NewGeneveStack(2)
+	wantStackAlias := "geneve-3"
+	wantFieldAliases := map[string]*TrafficField{
+		"geneve.header.controlPacket-3": stack.ControlPacket(),
+		"geneve.header.protocolType-6":  stack.ProtocolType(),
+		"geneve.header.vni-7":           stack.Vni(),
+	}
+	if !strings.Contains(stack.Xpath.String(), wantStackAlias) {
+		t.Errorf("Unexpected xpath %q for GeneveStack (wanted alias text to contain %q)", stack.Xpath.String(), wantStackAlias)
+	}
+	for alias, field := range wantFieldAliases {
+		xp := field.XPath().String()
+		if !strings.Contains(xp, wantStackAlias) {
+			t.Errorf("Unexpected xpath %q for GeneveStack field (wanted alias text to contain stack alias portion %q)", xp, wantStackAlias)
+		}
+		if !strings.Contains(xp, alias) {
+			t.Errorf("Unexpected xpath %q for GeneveStack field (wanted alias text to contain %q)", xp, alias)
+		}
+	}
+}
+
+func TestGtpuStack(t *testing.T) {
+	stack := NewGtpuStack(2)
+	wantStackAlias := "gtpu-3"
+	wantFieldAliases := map[string]*TrafficField{
+		"gtpu.header.e-4":    stack.E(),
+		"gtpu.header.type-7": stack.Type(),
+		"gtpu.header.teid-9": stack.Teid(),
+	}
+	if !strings.Contains(stack.Xpath.String(), wantStackAlias) {
+		t.Errorf("Unexpected xpath %q for GtpuStack (wanted alias text to contain %q)", stack.Xpath.String(), wantStackAlias)
+	}
+	for alias, field := range wantFieldAliases {
+		xp := field.XPath().String()
+		if !strings.Contains(xp, wantStackAlias) {
+			t.Errorf("Unexpected xpath %q for GtpuStack field (wanted alias text to contain stack alias portion %q)", xp, wantStackAlias)
+		}
+		if !strings.Contains(xp, alias) {
+			t.Errorf("Unexpected xpath %q for GtpuStack field (wanted alias text to contain %q)", xp, alias)
+		}
+	}
+}
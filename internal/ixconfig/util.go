@@ -24,6 +24,12 @@ func (cfg *Ixnetwork) updateAllXPaths() {
 	cfg.updateXPaths(&XPath{parentXPath: "/"})
 }
 
+// UpdateXPaths updates all XPaths in the config, as importing it would,
+// without contacting an IxNetwork session.
+func (cfg *Ixnetwork) UpdateXPaths() {
+	cfg.updateAllXPaths()
+}
+
 // Copy returns a new deep copy of the IxNetwork config.
 func (cfg *Ixnetwork) Copy() *Ixnetwork {
 	return cfg.copyCfg()
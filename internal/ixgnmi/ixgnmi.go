@@ -42,7 +42,8 @@ import (
 )
 
 const (
-	ribOCPath = "/network-instances/network-instance/protocols/protocol/bgp/rib"
+	ribOCPath          = "/network-instances/network-instance/protocols/protocol/bgp/rib"
+	bgpNeighborsOCPath = "/network-instances/network-instance/protocols/protocol/bgp/neighbors"
 
 	portStatsCaption    = "Port Statistics"
 	portCPUStatsCaption = "Port CPU Statistics"
@@ -61,13 +62,17 @@ var (
 			}
 			return nil, err
 		}},
+		bgpNeighborsOCPath: &prefixReader{read: func(ctx context.Context, c *Client, p *gpb.Path) ([]*gpb.Notification, error) {
+			return c.pathToOCBGPNeighbors(ctx, p)
+		}},
 	}
 
 	// To be stubbed out by tests.
 	readStatsFn = func(ctx context.Context, c *Client, cacheKey string, captions []string) (ygot.GoStruct, error) {
 		return c.readStats(ctx, cacheKey, captions)
 	}
-	ribFromIxiaFn = (*Client).ribFromIxia
+	ribFromIxiaFn   = (*Client).ribFromIxia
+	sessionStatusFn = (*Client).sessionStatus
 )
 
 type prefixReader struct {
@@ -419,6 +424,62 @@ func (c *Client) pathToOCRIB(ctx context.Context, p *gpb.Path) (*gpb.Notificatio
 	return notif, nil
 }
 
+// sessionStatus returns the IxNetwork session status of a BGP peer, such as
+// "up" or "down".
+func (c *Client) sessionStatus(ctx context.Context, node ixconfig.IxiaCfgNode) (string, error) {
+	nodeID, err := c.client.NodeID(node)
+	if err != nil {
+		return "", err
+	}
+	rsp := struct{ SessionStatus []string }{}
+	if err := c.client.Session().Get(ctx, nodeID, &rsp); err != nil {
+		return "", errors.Wrapf(err, "failed to get session status at %q", nodeID)
+	}
+	if len(rsp.SessionStatus) == 0 {
+		return "", nil
+	}
+	return rsp.SessionStatus[0], nil
+}
+
+// pathToOCBGPNeighbors returns the session states of the BGP peers emulated on
+// the interface of the network instance in the path.
+func (c *Client) pathToOCBGPNeighbors(ctx context.Context, p *gpb.Path) ([]*gpb.Notification, error) {
+	intf := p.GetElem()[1].GetKey()["name"]
+	protocolName := p.GetElem()[3].GetKey()["name"]
+	cacheKey := fmt.Sprintf("%s[%s]", bgpNeighborsOCPath, intf)
+	if _, ok := c.fresh.Get(cacheKey); ok {
+		return nil, nil
+	}
+	peerCache, err := c.fetchPeerCache(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update cache")
+	}
+	peers := peerCache[intf]
+	if len(peers) == 0 {
+		return nil, nil
+	}
+
+	dev := &telemetry.Device{}
+	bgp := dev.GetOrCreateNetworkInstance(intf).GetOrCreateProtocol(telemetry.PolicyTypes_INSTALL_PROTOCOL_TYPE_BGP, protocolName).GetOrCreateBgp()
+	for addr, node := range peers {
+		status, err := sessionStatusFn(c, ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		state := telemetry.Bgp_Neighbor_SessionState_IDLE
+		if status == "up" {
+			state = telemetry.Bgp_Neighbor_SessionState_ESTABLISHED
+		}
+		bgp.GetOrCreateNeighbor(addr).SessionState = state
+	}
+	ns, err := ygot.TogNMINotifications(dev, time.Now().UnixNano(), ygot.GNMINotificationsConfig{UsePathElem: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot render telemetry Notifications")
+	}
+	c.fresh.SetDefault(cacheKey, true)
+	return ns, nil
+}
+
 type subClient struct {
 	gpb.GNMI_SubscribeClient
 	parent *Client
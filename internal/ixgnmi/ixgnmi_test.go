@@ -868,3 +868,116 @@ func TestPathToOCRIB(t *testing.T) {
 		})
 	}
 }
+
+func TestPathToOCBGPNeighbors(t *testing.T) {
+	bgp4XP := parseXPath(t, "/xpath/to/bgpv4")
+	bgp6XP := parseXPath(t, "/xpath/to/bgpv6")
+	const (
+		bgp4ID = "/api/v1/sessions/0/topology/1/deviceGroup/1/ethernet/1/ipv4/1/bgpIpv4Peer/1"
+		bgp6ID = "/api/v1/sessions/0/topology/1/deviceGroup/1/ethernet/1/ipv6/1/bgpIpv6Peer/1"
+	)
+	cfg := &ixconfig.Ixnetwork{
+		Topology: []*ixconfig.Topology{{
+			DeviceGroup: []*ixconfig.TopologyDeviceGroup{{
+				Name: ixconfig.String("Device Group on eth0"),
+				Ethernet: []*ixconfig.TopologyEthernet{{
+					Ipv4: []*ixconfig.TopologyIpv4{{
+						BgpIpv4Peer: []*ixconfig.TopologyBgpIpv4Peer{{
+							DutIp: ixconfig.MultivalueStr("192.0.2.1"),
+							Xpath: bgp4XP,
+						}},
+					}},
+					Ipv6: []*ixconfig.TopologyIpv6{{
+						BgpIpv6Peer: []*ixconfig.TopologyBgpIpv6Peer{{
+							DutIp: ixconfig.MultivalueStr("2001:db8::1"),
+							Xpath: bgp6XP,
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+	neighborsPath := func(intf string) *gpb.Path {
+		return &gpb.Path{
+			Elem: []*gpb.PathElem{
+				{Name: "network-instances"},
+				{Name: "network-instance", Key: map[string]string{"name": intf}},
+				{Name: "protocols"},
+				{Name: "protocol", Key: map[string]string{"identifier": "BGP", "name": "0"}},
+				{Name: "bgp"},
+				{Name: "neighbors"},
+			},
+		}
+	}
+
+	tests := []struct {
+		desc    string
+		intf    string
+		getRsps map[string]string
+		getErrs map[string]error
+		want    map[string]telemetry.E_Bgp_Neighbor_SessionState
+		wantErr string
+	}{{
+		desc: "states",
+		intf: "eth0",
+		getRsps: map[string]string{
+			bgp4ID: `{"sessionStatus": ["up"]}`,
+			bgp6ID: `{"sessionStatus": ["down"]}`,
+		},
+		want: map[string]telemetry.E_Bgp_Neighbor_SessionState{
+			"192.0.2.1":   telemetry.Bgp_Neighbor_SessionState_ESTABLISHED,
+			"2001:db8::1": telemetry.Bgp_Neighbor_SessionState_IDLE,
+		},
+	}, {
+		desc: "no peers",
+		intf: "eth1",
+	}, {
+		desc:    "get error",
+		intf:    "eth0",
+		getErrs: map[string]error{bgp4ID: errors.New("fake")},
+		wantErr: "failed to get session status",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := &Client{
+				client: &fakeCfgClient{
+					sess: &fakeSession{getRsps: tt.getRsps, getErrs: tt.getErrs},
+					cfg:  cfg,
+					xpathToID: map[string]string{
+						bgp4XP.String(): bgp4ID,
+						bgp6XP.String(): bgp6ID,
+					},
+				},
+				fresh: cache.New(cache.NoExpiration, cache.NoExpiration),
+			}
+			ns, err := c.pathToOCBGPNeighbors(context.Background(), neighborsPath(tt.intf))
+			if d := errdiff.Substring(err, tt.wantErr); d != "" {
+				t.Fatalf("pathToOCBGPNeighbors() unexpected error diff\n%s", d)
+			}
+			if err != nil {
+				return
+			}
+			got := make(map[string]telemetry.E_Bgp_Neighbor_SessionState)
+			for _, n := range ns {
+				for _, u := range n.GetUpdate() {
+					elems := u.GetPath().GetElem()
+					if elems[len(elems)-1].GetName() != "session-state" {
+						continue
+					}
+					addr := elems[len(elems)-3].GetKey()["neighbor-address"]
+					for v, e := range telemetry.ΛEnum["E_Bgp_Neighbor_SessionState"] {
+						if e.Name == u.GetVal().GetStringVal() {
+							got[addr] = telemetry.E_Bgp_Neighbor_SessionState(v)
+						}
+					}
+				}
+			}
+			if len(tt.want) == 0 && len(got) == 0 {
+				return
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("pathToOCBGPNeighbors() unexpected states diff (-want +got)\n%s", d)
+			}
+		})
+	}
+}
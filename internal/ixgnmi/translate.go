@@ -195,6 +195,8 @@ func translateFlowStats(in ixweb.StatTable, itFlows, _ []string) (*telemetry.Dev
 		return nil, err
 	}
 
+	// The openconfig-ate-flow model has no latency leaves, so the latency and
+	// jitter of the flows are fetched by the ate package instead.
 	d := &telemetry.Device{}
 	for _, row := range flowRows {
 		f := d.GetOrCreateFlow(row.trafficItem)
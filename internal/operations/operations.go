@@ -16,9 +16,15 @@
 package operations
 
 import (
+	"bytes"
 	"golang.org/x/net/context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"fmt"
+	"hash"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,13 +33,20 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/binding/usererr"
 	"github.com/openconfig/ondatra/internal/ate"
+	"github.com/openconfig/ondatra/internal/closer"
 	"github.com/openconfig/ondatra/internal/testbed"
 
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	cpb "github.com/openconfig/gnoi/cert"
+	fpb "github.com/openconfig/gnoi/file"
+	hpb "github.com/openconfig/gnoi/healthz"
 	ospb "github.com/openconfig/gnoi/os"
 	spb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
 	opb "github.com/openconfig/ondatra/proto"
 )
 
@@ -47,9 +60,10 @@ var (
 	gnois = make(map[*binding.DUT]binding.GNOIClients)
 )
 
-// NewGNOI creates a gNOI client for the specified DUT.
-func NewGNOI(ctx context.Context, dut *binding.DUT) (binding.GNOIClients, error) {
-	return testbed.Bind().DialGNOI(ctx, dut, grpc.WithBlock())
+// NewGNOI creates a gNOI client for the specified DUT, dialed with the
+// specified options in addition to the default ones.
+func NewGNOI(ctx context.Context, dut *binding.DUT, opts ...grpc.DialOption) (binding.GNOIClients, error) {
+	return testbed.Bind().DialGNOI(ctx, dut, append([]grpc.DialOption{grpc.WithBlock()}, opts...)...)
 }
 
 // FetchGNOI fetches a cached gNOI client for the given DUT.
@@ -71,7 +85,9 @@ func FetchGNOI(ctx context.Context, dut *binding.DUT) (binding.GNOIClients, erro
 // Install executes an install operation.
 // The gNOI install scenarios are documented on the Install function here:
 // https://github.com/openconfig/gnoi/blob/master/os/os.proto
-func Install(ctx context.Context, dev binding.Device, version string, standby bool, reader io.Reader) error {
+// If progress is non-nil, it is called with the number of bytes received by the
+// device whenever the device reports transfer progress.
+func Install(ctx context.Context, dev binding.Device, version string, standby bool, reader io.Reader, progress func(uint64)) error {
 	dut, err := checkDUT(dev, "ping")
 	if err != nil {
 		return err
@@ -94,7 +110,7 @@ func Install(ctx context.Context, dev binding.Device, version string, standby bo
 	if err := ic.Send(sreq); err != nil {
 		return errors.Wrap(err, "error sending gnoi install request")
 	}
-	validated, err := awaitPackageInstall(ic)
+	validated, err := awaitPackageInstall(ic, progress)
 	if err != nil {
 		return err
 	}
@@ -104,7 +120,7 @@ func Install(ctx context.Context, dev binding.Device, version string, standby bo
 		}
 		awaitChan := make(chan error)
 		go func() {
-			validated, err = awaitPackageInstall(ic)
+			validated, err = awaitPackageInstall(ic, progress)
 			awaitChan <- err
 		}()
 		if err := transferContent(ic, reader); err != nil {
@@ -145,7 +161,7 @@ func transferContent(ic ospb.OS_InstallClient, reader io.Reader) error {
 // (a) the package is installed and validated, in which case it returns the validated message
 // (b) the device does not have the package, in which case it returns a nil validated message
 // (c) an error occurs, in which case it returns the error
-func awaitPackageInstall(ic ospb.OS_InstallClient) (*ospb.Validated, error) {
+func awaitPackageInstall(ic ospb.OS_InstallClient, progress func(uint64)) (*ospb.Validated, error) {
 	for {
 		cresp, err := ic.Recv()
 		if err != nil {
@@ -161,6 +177,9 @@ func awaitPackageInstall(ic ospb.OS_InstallClient) (*ospb.Validated, error) {
 			return nil, usererr.New("installation error %q: %s", errName, v.InstallError.GetDetail())
 		case *ospb.InstallResponse_TransferProgress:
 			log.Infof("installation progress: %v bytes received from client", v.TransferProgress.GetBytesReceived())
+			if progress != nil {
+				progress(v.TransferProgress.GetBytesReceived())
+			}
 		case *ospb.InstallResponse_SyncProgress:
 			log.Infof("installation progress: %v%% synced from supervisor", v.SyncProgress.GetPercentageTransferred())
 		default:
@@ -169,44 +188,161 @@ func awaitPackageInstall(ic ospb.OS_InstallClient) (*ospb.Validated, error) {
 	}
 }
 
-// Ping executes the ping command from target device to a specified destination.
-func Ping(ctx context.Context, dev binding.Device, dest string, count int32) error {
-	dut, err := checkDUT(dev, "ping")
+// Activate sets the version of the OS to boot on a device, and reboots the
+// device unless noReboot is true.
+func Activate(ctx context.Context, dev binding.Device, version string, standby, noReboot bool) error {
+	dut, err := checkDUT(dev, "activate")
 	if err != nil {
 		return err
 	}
-	if dest == "" {
-		return errors.Errorf("no destination for ping operation: %v", dest)
+	if version == "" {
+		return usererr.New("version not set in activate operation on device: %v", dev)
 	}
 	gnoi, err := FetchGNOI(ctx, dut)
 	if err != nil {
 		return err
 	}
-	ping, err := gnoi.System().Ping(ctx, &spb.PingRequest{
+	resp, err := gnoi.OS().Activate(ctx, &ospb.ActivateRequest{
+		Version:           version,
+		StandbySupervisor: standby,
+		NoReboot:          noReboot,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error on gnoi activate")
+	}
+	if actErr := resp.GetActivateError(); actErr != nil {
+		return errors.Errorf("activation error %q: %s", actErr.GetType(), actErr.GetDetail())
+	}
+	return nil
+}
+
+// Verify checks that a device is running the specified version of the OS, and
+// that the last activation of an OS did not fail.
+func Verify(ctx context.Context, dev binding.Device, version string, standby bool) error {
+	dut, err := checkDUT(dev, "verify")
+	if err != nil {
+		return err
+	}
+	if version == "" {
+		return usererr.New("version not set in verify operation on device: %v", dev)
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	resp, err := gnoi.OS().Verify(ctx, &ospb.VerifyRequest{})
+	if err != nil {
+		return errors.Wrap(err, "error on gnoi verify")
+	}
+	if standby {
+		switch v := resp.GetVerifyStandby().GetState().(type) {
+		case *ospb.VerifyStandby_VerifyResponse:
+			return verifyVersion(v.VerifyResponse.GetId(), v.VerifyResponse.GetVersion(), v.VerifyResponse.GetActivationFailMessage(), version)
+		case *ospb.VerifyStandby_StandbyState:
+			return errors.Errorf("standby supervisor cannot be verified in state %v", v.StandbyState.GetState())
+		default:
+			return errors.Errorf("device %v did not report the state of a standby supervisor", dev)
+		}
+	}
+	return verifyVersion("", resp.GetVersion(), resp.GetActivationFailMessage(), version)
+}
+
+func verifyVersion(supervisor, gotVersion, failMsg, wantVersion string) error {
+	if supervisor != "" {
+		supervisor = " on supervisor " + supervisor
+	}
+	if failMsg != "" {
+		return errors.Errorf("activation of OS failed%s: %s", supervisor, failMsg)
+	}
+	if gotVersion != wantVersion {
+		return errors.Errorf("running version %q%s does not match expected version %q", gotVersion, supervisor, wantVersion)
+	}
+	return nil
+}
+
+// Ping executes the ping command from target device to a specified destination.
+func Ping(ctx context.Context, dev binding.Device, dest string, count int32) error {
+	resps, err := PingResponses(ctx, dev, &spb.PingRequest{
 		Destination: dest,
 		Count:       count,
 	})
 	if err != nil {
-		return errors.Wrapf(err, "error on gnoi ping of %s from %v", dest, dev)
+		return err
 	}
 
 	// Ping result is included in the last message of the stream.
 	lastPingResp := &spb.PingResponse{}
+	if len(resps) > 0 {
+		lastPingResp = resps[len(resps)-1]
+	}
+	if sent, recv := lastPingResp.GetSent(), lastPingResp.GetReceived(); sent != recv {
+		return fmt.Errorf("ping sent %d packets, received %d", sent, recv)
+	}
+	return nil
+}
+
+// PingResponses executes the ping request on the device and returns all the
+// responses in the stream, the last of which summarizes the ping.
+func PingResponses(ctx context.Context, dev binding.Device, req *spb.PingRequest) ([]*spb.PingResponse, error) {
+	dut, err := checkDUT(dev, "ping")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDestination() == "" {
+		return nil, errors.Errorf("no destination for ping operation: %v", req.GetDestination())
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	ping, err := gnoi.System().Ping(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error on gnoi ping of %s from %v", req.GetDestination(), dev)
+	}
+	var resps []*spb.PingResponse
 	for {
 		resp, err := ping.Recv()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return errors.Wrap(err, "error receiving ping response")
+			return nil, errors.Wrap(err, "error receiving ping response")
 		}
-		lastPingResp = resp
+		resps = append(resps, resp)
 	}
+	return resps, nil
+}
 
-	if sent, recv := lastPingResp.GetSent(), lastPingResp.GetReceived(); sent != recv {
-		return fmt.Errorf("ping sent %d packets, received %d", sent, recv)
+// Traceroute executes the traceroute request on the device and returns all
+// the responses in the stream.
+func Traceroute(ctx context.Context, dev binding.Device, req *spb.TracerouteRequest) ([]*spb.TracerouteResponse, error) {
+	dut, err := checkDUT(dev, "traceroute")
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if req.GetDestination() == "" {
+		return nil, errors.Errorf("no destination for traceroute operation: %v", req.GetDestination())
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := gnoi.System().Traceroute(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error on gnoi traceroute of %s from %v", req.GetDestination(), dev)
+	}
+	var resps []*spb.TracerouteResponse
+	for {
+		resp, err := trace.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error receiving traceroute response")
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
 }
 
 // SetInterfaceState sets the state of a specified interface on a device.
@@ -275,8 +411,10 @@ func setDUTInterfaceState(ctx context.Context, dut *binding.DUT, intf string, en
 	return nil
 }
 
-// Reboot reboots a device.
-func Reboot(ctx context.Context, dev binding.Device, timeout time.Duration) error {
+// Reboot reboots a device. If a gNMI client is specified, Reboot waits for the
+// gNMI server of the device to become unreachable and then reachable again, and
+// then for all the health checks to pass, instead of polling the reboot status.
+func Reboot(ctx context.Context, dev binding.Device, timeout time.Duration, gnmi gpb.GNMIClient, healthChecks []func() error) error {
 	dut, err := checkDUT(dev, "restart routing")
 	if err != nil {
 		return err
@@ -296,6 +434,9 @@ func Reboot(ctx context.Context, dev binding.Device, timeout time.Duration) erro
 		return errors.New("reboot timeout must be a positive duration")
 	}
 	rebootDeadline := time.Now().Add(rebootTimeout)
+	if gnmi != nil {
+		return awaitHealthy(ctx, dev, gnmi, healthChecks, rebootTimeout, rebootDeadline)
+	}
 	retry := true
 	for retry {
 		if time.Now().After(rebootDeadline) {
@@ -324,6 +465,143 @@ func Reboot(ctx context.Context, dev binding.Device, timeout time.Duration) erro
 	return errors.Errorf("reboot of %s timed out after %s", dev, rebootTimeout)
 }
 
+// awaitHealthy waits for a rebooting device to become unreachable over gNMI,
+// then reachable again, and then for all the health checks to pass. If the
+// deadline passes first, the last error of the awaited stage is returned.
+func awaitHealthy(ctx context.Context, dev binding.Device, gnmi gpb.GNMIClient, healthChecks []func() error, timeout time.Duration, deadline time.Time) error {
+	reachable := func() error {
+		ctx, cancel := context.WithTimeout(ctx, defaultStatusWait)
+		defer cancel()
+		_, err := gnmi.Capabilities(ctx, &gpb.CapabilityRequest{})
+		return err
+	}
+	stages := []struct {
+		desc  string
+		check func() error
+	}{
+		{desc: "gNMI to become unreachable", check: func() error {
+			if reachable() == nil {
+				return errors.New("gNMI is still reachable")
+			}
+			return nil
+		}},
+		{desc: "gNMI to become reachable", check: reachable},
+		{desc: "health checks to pass", check: func() error { return checkHealth(healthChecks) }},
+	}
+	for _, stage := range stages {
+		for err := stage.check(); err != nil; err = stage.check() {
+			if time.Now().After(deadline) {
+				return errors.Wrapf(err, "reboot of %s timed out after %s waiting for %s", dev, timeout, stage.desc)
+			}
+			time.Sleep(defaultStatusWait)
+		}
+	}
+	return nil
+}
+
+// checkHealth runs all the health checks and returns the first error, if any.
+func checkHealth(healthChecks []func() error) error {
+	for _, check := range healthChecks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SwitchoverSupervisor switches the active control processor of a device to
+// the supervisor component with the specified name. It then waits for the
+// oper-status of the supervisor to be reported as ACTIVE over gNMI, which
+// shows that the supervisor is ready and telemetry has resumed, and then for
+// all the health checks to pass.
+func SwitchoverSupervisor(ctx context.Context, dev binding.Device, supervisor string, timeout time.Duration, gnmi gpb.GNMIClient, healthChecks []func() error) error {
+	dut, err := checkDUT(dev, "switchover supervisor")
+	if err != nil {
+		return err
+	}
+	if supervisor == "" {
+		return usererr.New("supervisor not set in switchover operation on device: %v", dev)
+	}
+	switch {
+	case timeout == 0:
+		timeout = defaultRebootTimeout
+	case timeout < 0:
+		return errors.New("switchover timeout must be a positive duration")
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	cpPath := &tpb.Path{Elem: []*tpb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": supervisor}},
+	}}
+	resp, err := gnoi.System().SwitchControlProcessor(ctx, &spb.SwitchControlProcessorRequest{ControlProcessor: cpPath})
+	if err != nil {
+		return errors.Wrap(err, "error on gnoi switch control processor")
+	}
+	if got := resp.GetControlProcessor(); got != nil && !proto.Equal(got, cpPath) {
+		return errors.Errorf("switchover of %s made %v active, want %v", dev, got, cpPath)
+	}
+	deadline := time.Now().Add(timeout)
+	stages := []struct {
+		desc  string
+		check func() error
+	}{
+		{desc: fmt.Sprintf("supervisor %s to become active", supervisor), check: func() error {
+			if !supervisorActive(ctx, gnmi, supervisor) {
+				return errors.Errorf("supervisor %s is not active", supervisor)
+			}
+			return nil
+		}},
+		{desc: "health checks to pass", check: func() error { return checkHealth(healthChecks) }},
+	}
+	for _, stage := range stages {
+		for err := stage.check(); err != nil; err = stage.check() {
+			if time.Now().After(deadline) {
+				return errors.Wrapf(err, "switchover of %s timed out after %s waiting for %s", dev, timeout, stage.desc)
+			}
+			time.Sleep(defaultStatusWait)
+		}
+	}
+	return nil
+}
+
+// supervisorActive returns whether the oper-status of the supervisor is ACTIVE.
+func supervisorActive(ctx context.Context, gnmi gpb.GNMIClient, supervisor string) bool {
+	ctx, cancel := context.WithTimeout(ctx, defaultStatusWait)
+	defer cancel()
+	resp, err := gnmi.Get(ctx, &gpb.GetRequest{
+		Path: []*gpb.Path{{Elem: []*gpb.PathElem{
+			{Name: "components"},
+			{Name: "component", Key: map[string]string{"name": supervisor}},
+			{Name: "state"},
+			{Name: "oper-status"},
+		}}},
+		Type:     gpb.GetRequest_STATE,
+		Encoding: gpb.Encoding_JSON_IETF,
+	})
+	if err != nil {
+		return false
+	}
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			val := u.GetVal().GetStringVal()
+			if jv := u.GetVal().GetJsonIetfVal(); jv != nil {
+				val = strings.Trim(string(jv), `"`)
+			}
+			// Strip the module prefix of the identity, if any.
+			if i := strings.LastIndex(val, ":"); i >= 0 {
+				val = val[i+1:]
+			}
+			if val == "ACTIVE" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // KillProcess kills a process on a device, and optionally restarts it.
 func KillProcess(ctx context.Context, dev binding.Device, req *spb.KillProcessRequest) error {
 	dut, err := checkDUT(dev, "restart routing")
@@ -338,6 +616,362 @@ func KillProcess(ctx context.Context, dev binding.Device, req *spb.KillProcessRe
 	return err
 }
 
+// PutFile streams the content of the reader to the file at the absolute remote
+// path on a device, with the permissions in the octal format of gNOI, followed
+// by its SHA256 hash for the device to verify.
+func PutFile(ctx context.Context, dev binding.Device, remotePath string, perms uint32, reader io.Reader) error {
+	dut, err := checkDUT(dev, "put file")
+	if err != nil {
+		return err
+	}
+	if remotePath == "" {
+		return usererr.New("remote path not set in put file operation on device: %v", dev)
+	}
+	if reader == nil {
+		return usererr.New("no content specified in put file operation on device: %v", dev)
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	pc, err := gnoi.File().Put(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error creating gnoi file put client")
+	}
+	if err := pc.Send(&fpb.PutRequest{Request: &fpb.PutRequest_Open{&fpb.PutRequest_Details{
+		RemoteFile:  remotePath,
+		Permissions: perms,
+	}}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi file put open request")
+	}
+	// The gNOI File Put operation sets the maximum chunk size at 64K.
+	buf := make([]byte, 64*1024)
+	h := sha256.New()
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			h.Write(buf[:n])
+			if err := pc.Send(&fpb.PutRequest{Request: &fpb.PutRequest_Contents{buf[:n]}}); err != nil {
+				return errors.Wrap(err, "error sending gnoi file put contents")
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading file contents")
+		}
+	}
+	if err := pc.Send(&fpb.PutRequest{Request: &fpb.PutRequest_Hash{&tpb.HashType{
+		Method: tpb.HashType_SHA256,
+		Hash:   h.Sum(nil),
+	}}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi file put hash")
+	}
+	if _, err := pc.CloseAndRecv(); err != nil {
+		return errors.Wrapf(err, "error putting file %s on %v", remotePath, dev)
+	}
+	return nil
+}
+
+// GetFile streams the content of the file at the absolute remote path on a
+// device to the writer, and verifies it against the hash sent by the device.
+func GetFile(ctx context.Context, dev binding.Device, remotePath string, writer io.Writer) error {
+	dut, err := checkDUT(dev, "get file")
+	if err != nil {
+		return err
+	}
+	if remotePath == "" {
+		return usererr.New("remote path not set in get file operation on device: %v", dev)
+	}
+	if writer == nil {
+		return usererr.New("no destination specified in get file operation on device: %v", dev)
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	gc, err := gnoi.File().Get(ctx, &fpb.GetRequest{RemoteFile: remotePath})
+	if err != nil {
+		return errors.Wrapf(err, "error getting file %s from %v", remotePath, dev)
+	}
+	var content []byte
+	var fileHash *tpb.HashType
+	for {
+		resp, err := gc.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "error receiving gnoi file get response")
+		}
+		switch r := resp.GetResponse().(type) {
+		case *fpb.GetResponse_Contents:
+			if fileHash != nil {
+				return errors.Errorf("received contents of file %s after its hash", remotePath)
+			}
+			content = append(content, r.Contents...)
+		case *fpb.GetResponse_Hash:
+			fileHash = r.Hash
+		}
+	}
+	if err := verifyHash(content, fileHash); err != nil {
+		return errors.Wrapf(err, "error verifying file %s from %v", remotePath, dev)
+	}
+	_, err = writer.Write(content)
+	return err
+}
+
+// verifyHash returns an error if the wanted hash is missing or does not match
+// the content.
+func verifyHash(content []byte, want *tpb.HashType) error {
+	if want == nil {
+		return errors.New("no hash received")
+	}
+	var h hash.Hash
+	switch want.GetMethod() {
+	case tpb.HashType_SHA256:
+		h = sha256.New()
+	case tpb.HashType_SHA512:
+		h = sha512.New()
+	case tpb.HashType_MD5:
+		h = md5.New()
+	default:
+		return errors.Errorf("unsupported hash method %v", want.GetMethod())
+	}
+	h.Write(content)
+	if got := h.Sum(nil); !bytes.Equal(got, want.GetHash()) {
+		return errors.Errorf("%v hash of content is %x, want %x", want.GetMethod(), got, want.GetHash())
+	}
+	return nil
+}
+
+// StatFile returns information about the file at the absolute remote path on
+// a device or, if it is a directory, about the files in it.
+func StatFile(ctx context.Context, dev binding.Device, remotePath string) ([]*fpb.StatInfo, error) {
+	dut, err := checkDUT(dev, "stat file")
+	if err != nil {
+		return nil, err
+	}
+	if remotePath == "" {
+		return nil, usererr.New("remote path not set in stat file operation on device: %v", dev)
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gnoi.File().Stat(ctx, &fpb.StatRequest{Path: remotePath})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting stats of %s from %v", remotePath, dev)
+	}
+	return resp.GetStats(), nil
+}
+
+// Healthz returns the gNOI healthz status of the component at the path on a
+// device, including the status of its subcomponents.
+func Healthz(ctx context.Context, dev binding.Device, path *tpb.Path) (*hpb.ComponentStatus, error) {
+	dut, err := checkDUT(dev, "healthz")
+	if err != nil {
+		return nil, err
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gnoi.Healthz().Get(ctx, &hpb.GetRequest{Path: path})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting healthz status of %v from %v", path, dev)
+	}
+	return resp.GetComponent(), nil
+}
+
+// StartPacketCapture starts capturing the packets on the ports of a device
+// that match the filter, using the packet capture mechanism of the binding.
+func StartPacketCapture(ctx context.Context, dev binding.Device, ports []*binding.Port, filter string) (binding.PacketCapture, error) {
+	dut, err := checkDUT(dev, "packet capture")
+	if err != nil {
+		return nil, err
+	}
+	pc, err := testbed.Bind().StartPacketCapture(ctx, dut, ports, filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error starting packet capture on %v", dev)
+	}
+	return pc, nil
+}
+
+// InstallCertificate installs a new certificate on a device. The device
+// generates a key pair and a CSR for the common name, which is signed by the
+// sign function, and the signed certificate is loaded along with the CA
+// certificates.
+func InstallCertificate(ctx context.Context, dev binding.Device, certID, commonName string, sign func(csr []byte) ([]byte, error), caCerts [][]byte) error {
+	dut, err := checkCertOp(dev, "install certificate", certID, sign)
+	if err != nil {
+		return err
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	ic, err := gnoi.CertificateManagement().Install(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error creating gnoi certificate install client")
+	}
+	defer closer.CloseAndLog(ic.CloseSend, "error closing gnoi certificate install client")
+	if err := ic.Send(&cpb.InstallCertificateRequest{InstallRequest: &cpb.InstallCertificateRequest_GenerateCsr{
+		GenerateCsr: generateCSRRequest(certID, commonName),
+	}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi generate CSR request")
+	}
+	resp, err := ic.Recv()
+	if err != nil {
+		return errors.Wrap(err, "error receiving gnoi generate CSR response")
+	}
+	load, err := signedLoadRequest(certID, resp.GetGeneratedCsr().GetCsr(), sign, caCerts)
+	if err != nil {
+		return err
+	}
+	if err := ic.Send(&cpb.InstallCertificateRequest{InstallRequest: &cpb.InstallCertificateRequest_LoadCertificate{
+		LoadCertificate: load,
+	}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi load certificate request")
+	}
+	if _, err := ic.Recv(); err != nil {
+		return errors.Wrap(err, "error receiving gnoi load certificate response")
+	}
+	return nil
+}
+
+// RotateCertificate replaces an existing certificate on a device, like
+// InstallCertificate. After the new certificate is loaded, the validate
+// function is called to check that the certificate works, such as by
+// connecting to the device with it. The rotation is finalized if it returns
+// true, and otherwise the device rolls back to the existing certificate.
+func RotateCertificate(ctx context.Context, dev binding.Device, certID, commonName string, sign func(csr []byte) ([]byte, error), caCerts [][]byte, validate func() bool) error {
+	dut, err := checkCertOp(dev, "rotate certificate", certID, sign)
+	if err != nil {
+		return err
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	// Canceling the stream before the rotation is finalized rolls it back.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rc, err := gnoi.CertificateManagement().Rotate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error creating gnoi certificate rotate client")
+	}
+	if err := rc.Send(&cpb.RotateCertificateRequest{RotateRequest: &cpb.RotateCertificateRequest_GenerateCsr{
+		GenerateCsr: generateCSRRequest(certID, commonName),
+	}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi generate CSR request")
+	}
+	resp, err := rc.Recv()
+	if err != nil {
+		return errors.Wrap(err, "error receiving gnoi generate CSR response")
+	}
+	load, err := signedLoadRequest(certID, resp.GetGeneratedCsr().GetCsr(), sign, caCerts)
+	if err != nil {
+		return err
+	}
+	if err := rc.Send(&cpb.RotateCertificateRequest{RotateRequest: &cpb.RotateCertificateRequest_LoadCertificate{
+		LoadCertificate: load,
+	}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi load certificate request")
+	}
+	if _, err := rc.Recv(); err != nil {
+		return errors.Wrap(err, "error receiving gnoi load certificate response")
+	}
+	if validate != nil && !validate() {
+		return errors.Errorf("validation of rotated certificate %q failed, rolled back rotation", certID)
+	}
+	if err := rc.Send(&cpb.RotateCertificateRequest{RotateRequest: &cpb.RotateCertificateRequest_FinalizeRotation{
+		FinalizeRotation: &cpb.FinalizeRequest{},
+	}}); err != nil {
+		return errors.Wrap(err, "error sending gnoi finalize rotation request")
+	}
+	if err := rc.CloseSend(); err != nil {
+		return errors.Wrap(err, "error closing gnoi certificate rotate client")
+	}
+	// Wait for the device to end the stream, so the rotation is not rolled back.
+	if _, err := rc.Recv(); err != nil && err != io.EOF {
+		return errors.Wrap(err, "error finalizing certificate rotation")
+	}
+	return nil
+}
+
+// RevokeCertificates revokes certificates on a device.
+func RevokeCertificates(ctx context.Context, dev binding.Device, certIDs []string) error {
+	dut, err := checkDUT(dev, "revoke certificates")
+	if err != nil {
+		return err
+	}
+	if len(certIDs) == 0 {
+		return usererr.New("no certificate IDs set in revoke certificates operation on device: %v", dev)
+	}
+	gnoi, err := FetchGNOI(ctx, dut)
+	if err != nil {
+		return err
+	}
+	resp, err := gnoi.CertificateManagement().RevokeCertificates(ctx, &cpb.RevokeCertificatesRequest{CertificateId: certIDs})
+	if err != nil {
+		return errors.Wrap(err, "error on gnoi revoke certificates")
+	}
+	if revErrs := resp.GetCertificateRevocationError(); len(revErrs) > 0 {
+		var msgs []string
+		for _, e := range revErrs {
+			msgs = append(msgs, fmt.Sprintf("%q: %s", e.GetCertificateId(), e.GetErrorMessage()))
+		}
+		return errors.Errorf("error revoking certificates: %s", strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+func checkCertOp(dev binding.Device, op, certID string, sign func([]byte) ([]byte, error)) (*binding.DUT, error) {
+	dut, err := checkDUT(dev, op)
+	if err != nil {
+		return nil, err
+	}
+	if certID == "" {
+		return nil, usererr.New("certificate ID not set in %s operation on device: %v", op, dev)
+	}
+	if sign == nil {
+		return nil, usererr.New("certificate authority not set in %s operation on device: %v", op, dev)
+	}
+	return dut, nil
+}
+
+func generateCSRRequest(certID, commonName string) *cpb.GenerateCSRRequest {
+	return &cpb.GenerateCSRRequest{
+		CertificateId: certID,
+		CsrParams: &cpb.CSRParams{
+			Type:       cpb.CertificateType_CT_X509,
+			MinKeySize: 2048,
+			KeyType:    cpb.KeyType_KT_RSA,
+			CommonName: commonName,
+		},
+	}
+}
+
+func signedLoadRequest(certID string, csr *cpb.CSR, sign func([]byte) ([]byte, error), caCerts [][]byte) (*cpb.LoadCertificateRequest, error) {
+	if len(csr.GetCsr()) == 0 {
+		return nil, errors.New("device did not generate a CSR")
+	}
+	cert, err := sign(csr.GetCsr())
+	if err != nil {
+		return nil, errors.Wrap(err, "error signing CSR")
+	}
+	load := &cpb.LoadCertificateRequest{
+		CertificateId: certID,
+		Certificate:   &cpb.Certificate{Type: cpb.CertificateType_CT_X509, Certificate: cert},
+	}
+	for _, ca := range caCerts {
+		load.CaCertificates = append(load.CaCertificates, &cpb.Certificate{Type: cpb.CertificateType_CT_X509, Certificate: ca})
+	}
+	return load, nil
+}
+
 func checkDUT(dev binding.Device, op string) (*binding.DUT, error) {
 	if _, ok := dev.(*binding.ATE); ok {
 		return nil, errors.Errorf("%s operation not supported on ATEs: %v", op, dev)
@@ -17,7 +17,11 @@ package p4rt
 
 import (
 	"golang.org/x/net/context"
+	"io"
+	"time"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/testbed"
@@ -25,7 +29,145 @@ import (
 	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
 )
 
-// NewP4RT creates a P4RT client for the specified DUT.
-func NewP4RT(ctx context.Context, dut *binding.DUT) (p4pb.P4RuntimeClient, error) {
-	return testbed.Bind().DialP4RT(ctx, dut, grpc.WithBlock())
+// NewP4RT creates a P4RT client for the specified DUT, dialed with the
+// specified options in addition to the default ones.
+func NewP4RT(ctx context.Context, dut *binding.DUT, opts ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+	return testbed.Bind().DialP4RT(ctx, dut, append([]grpc.DialOption{grpc.WithBlock()}, opts...)...)
+}
+
+// arbitrationTimeout bounds the time to wait for the arbitration response when
+// a session is created.
+var arbitrationTimeout = time.Minute
+
+// Session is a P4RT client session that is the primary client of a device.
+type Session struct {
+	client     p4pb.P4RuntimeClient
+	cancel     context.CancelFunc
+	deviceID   uint64
+	electionID *p4pb.Uint128
+}
+
+// NewSession creates a P4RT client for the specified DUT and opens a stream
+// channel that arbitrates for the specified P4RT device with the election ID.
+// It returns an error if the client does not become the primary client, or if
+// no arbitration response is received before the context is done.
+func NewSession(ctx context.Context, dut *binding.DUT, deviceID uint64, electionID *p4pb.Uint128, opts ...grpc.DialOption) (*Session, error) {
+	client, err := NewP4RT(ctx, dut, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newSession(ctx, client, deviceID, electionID)
+}
+
+func newSession(ctx context.Context, client p4pb.P4RuntimeClient, deviceID uint64, electionID *p4pb.Uint128) (*Session, error) {
+	// The stream must outlive the context of the call, so that the client
+	// remains the primary client until the session is closed.
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream, err := client.StreamChannel(streamCtx)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "error opening P4RT stream channel")
+	}
+	if err := stream.Send(&p4pb.StreamMessageRequest{Update: &p4pb.StreamMessageRequest_Arbitration{
+		Arbitration: &p4pb.MasterArbitrationUpdate{DeviceId: deviceID, ElectionId: electionID},
+	}}); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "error sending P4RT arbitration request")
+	}
+	// Only the wait for the arbitration response is bounded by the context of
+	// the call; the stream is cancelled if it expires.
+	ctx, cancelWait := context.WithTimeout(ctx, arbitrationTimeout)
+	defer cancelWait()
+	arbc := make(chan error, 1)
+	go func() {
+		arbc <- awaitArbitration(stream, deviceID, electionID)
+	}()
+	select {
+	case err := <-arbc:
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	case <-ctx.Done():
+		cancel()
+		return nil, errors.Wrap(ctx.Err(), "error receiving P4RT arbitration response")
+	}
+	return &Session{
+		client:     client,
+		cancel:     cancel,
+		deviceID:   deviceID,
+		electionID: electionID,
+	}, nil
+}
+
+// awaitArbitration receives from the stream until the arbitration response,
+// and returns an error if the client is not the primary client.
+func awaitArbitration(stream p4pb.P4Runtime_StreamChannelClient, deviceID uint64, electionID *p4pb.Uint128) error {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "error receiving P4RT arbitration response")
+		}
+		arb := resp.GetArbitration()
+		if arb == nil {
+			continue
+		}
+		if code := codes.Code(arb.GetStatus().GetCode()); code != codes.OK {
+			return errors.Errorf("client with election ID %v is not the primary client of device %d: %v: %s",
+				electionID, deviceID, code, arb.GetStatus().GetMessage())
+		}
+		return nil
+	}
+}
+
+// Client returns the underlying P4RT client.
+func (s *Session) Client() p4pb.P4RuntimeClient {
+	return s.client
+}
+
+// SetForwardingPipelineConfig verifies and commits a forwarding pipeline
+// config on the device.
+func (s *Session) SetForwardingPipelineConfig(ctx context.Context, cfg *p4pb.ForwardingPipelineConfig) error {
+	_, err := s.client.SetForwardingPipelineConfig(ctx, &p4pb.SetForwardingPipelineConfigRequest{
+		DeviceId:   s.deviceID,
+		ElectionId: s.electionID,
+		Action:     p4pb.SetForwardingPipelineConfigRequest_VERIFY_AND_COMMIT,
+		Config:     cfg,
+	})
+	return errors.Wrap(err, "error setting P4RT forwarding pipeline config")
+}
+
+// Write applies the updates to the entities of the device.
+func (s *Session) Write(ctx context.Context, updates ...*p4pb.Update) error {
+	_, err := s.client.Write(ctx, &p4pb.WriteRequest{
+		DeviceId:   s.deviceID,
+		ElectionId: s.electionID,
+		Updates:    updates,
+	})
+	return errors.Wrap(err, "error writing P4RT entities")
+}
+
+// Read reads the entities of the device that match the specified entities.
+func (s *Session) Read(ctx context.Context, entities ...*p4pb.Entity) ([]*p4pb.Entity, error) {
+	rc, err := s.client.Read(ctx, &p4pb.ReadRequest{DeviceId: s.deviceID, Entities: entities})
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading P4RT entities")
+	}
+	var got []*p4pb.Entity
+	for {
+		resp, err := rc.Recv()
+		if err == io.EOF {
+			return got, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error receiving P4RT entities")
+		}
+		got = append(got, resp.GetEntities()...)
+	}
+}
+
+// Close closes the stream channel of the session, so that the client is no
+// longer the primary client.
+func (s *Session) Close() {
+	s.cancel()
 }
@@ -0,0 +1,78 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p4rt
+
+import (
+	"golang.org/x/net/context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
+)
+
+type fakeP4RTClient struct {
+	p4pb.P4RuntimeClient
+	streamCtx context.Context
+}
+
+func (c *fakeP4RTClient) StreamChannel(ctx context.Context, _ ...grpc.CallOption) (p4pb.P4Runtime_StreamChannelClient, error) {
+	c.streamCtx = ctx
+	return &silentStreamChannel{ctx: ctx}, nil
+}
+
+// silentStreamChannel is a stream channel that never receives a response.
+type silentStreamChannel struct {
+	p4pb.P4Runtime_StreamChannelClient
+	ctx context.Context
+}
+
+func (s *silentStreamChannel) Send(*p4pb.StreamMessageRequest) error {
+	return nil
+}
+
+func (s *silentStreamChannel) Recv() (*p4pb.StreamMessageResponse, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func TestNewSessionNoArbitrationResponse(t *testing.T) {
+	origTimeout := arbitrationTimeout
+	defer func() { arbitrationTimeout = origTimeout }()
+	arbitrationTimeout = time.Millisecond
+
+	fc := &fakeP4RTClient{}
+	_, err := newSession(context.Background(), fc, 1, &p4pb.Uint128{Low: 2})
+	if err == nil || !strings.Contains(err.Error(), "arbitration response") {
+		t.Errorf("newSession() got err %v, want arbitration response error", err)
+	}
+	if fc.streamCtx.Err() == nil {
+		t.Errorf("newSession() did not close the stream channel")
+	}
+}
+
+func TestNewSessionCallerContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	fc := &fakeP4RTClient{}
+	if _, err := newSession(ctx, fc, 1, &p4pb.Uint128{Low: 2}); err == nil {
+		t.Errorf("newSession() with a cancelled context got nil err, want error")
+	}
+	if fc.streamCtx.Err() == nil {
+		t.Errorf("newSession() did not close the stream channel")
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report records the tests and device operations of an Ondatra test
+// run and writes them as a machine-readable report.
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"golang.org/x/net/context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openconfig/ondatra/internal/artifacts"
+	"github.com/pkg/errors"
+)
+
+// Report files written by Write.
+const (
+	JSONFile  = "report.json"
+	JUnitFile = "report.xml"
+)
+
+// Report is the record of a test run.
+type Report struct {
+	ReservationID string    `json:"reservation_id,omitempty"`
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	Tests         []*Test   `json:"tests"`
+}
+
+// Test is the record of a top-level test.
+type Test struct {
+	Name       string       `json:"name"`
+	Start      time.Time    `json:"start"`
+	End        time.Time    `json:"end"`
+	Failed     bool         `json:"failed"`
+	Skipped    bool         `json:"skipped"`
+	Operations []*Operation `json:"operations"`
+	Violations []*Violation `json:"violations,omitempty"`
+}
+
+// Operation is the record of an operation of a test, or one of its subtests,
+// on a device.
+type Operation struct {
+	Test        string    `json:"test"`
+	Device      string    `json:"device,omitempty"`
+	Description string    `json:"description"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// Violation is the record of telemetry received by a test, or one of its
+// subtests, that does not comply with the schema.
+type Violation struct {
+	Test  string    `json:"test"`
+	Kind  string    `json:"kind"`
+	Path  string    `json:"path,omitempty"`
+	Value string    `json:"value,omitempty"`
+	Error string    `json:"error"`
+	Time  time.Time `json:"time"`
+}
+
+// pending is an operation whose end is not known when it starts, which ends
+// when the next operation of the same top-level test starts or the test ends.
+type pending struct {
+	op          *Operation
+	t           testing.TB
+	startFailed bool
+}
+
+var (
+	mu         sync.Mutex
+	enabled    bool
+	violations bool
+	report     *Report
+	tests      map[string]*Test
+	pendOps    map[string]*pending
+	nowFn      = time.Now
+)
+
+// Enable starts recording a new report of the reservation.
+func Enable(resvID string) {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = true
+	report = &Report{ReservationID: resvID, Start: nowFn()}
+	tests = make(map[string]*Test)
+	pendOps = make(map[string]*pending)
+}
+
+// EnableViolations starts recording compliance violations in the report.
+func EnableViolations() {
+	mu.Lock()
+	defer mu.Unlock()
+	violations = true
+}
+
+// TestStarted records the start of a top-level test.
+func TestStarted(t testing.TB) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	test := &Test{Name: t.Name(), Start: nowFn()}
+	tests[t.Name()] = test
+	report.Tests = append(report.Tests, test)
+}
+
+// TestEnded records the end of a top-level test and of its pending operations.
+func TestEnded(t testing.TB) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	endPending(t.Name())
+	if test, ok := tests[t.Name()]; ok {
+		test.End = nowFn()
+		test.Failed = t.Failed()
+		test.Skipped = t.Skipped()
+	}
+}
+
+// Begin records the start of an operation whose outcome is not known to the
+// caller. The operation ends when the next operation of the test or any of its
+// subtests starts or the test ends, and it is considered failed if the test
+// failed meanwhile.
+func Begin(t testing.TB, device, desc string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	endPending(topLevel(t.Name()))
+	op := &Operation{Test: t.Name(), Device: device, Description: desc, Start: nowFn()}
+	if add(op) {
+		pendOps[topLevel(t.Name())] = &pending{op: op, t: t, startFailed: t.Failed()}
+	}
+}
+
+// Record records an operation that started at the specified time and ended
+// now with the specified error, if any.
+func Record(t testing.TB, device, desc string, start time.Time, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	endPending(topLevel(t.Name()))
+	op := &Operation{Test: t.Name(), Device: device, Description: desc, Start: start, End: nowFn()}
+	if err != nil {
+		op.Error = err.Error()
+	}
+	add(op)
+}
+
+// RecordViolation records received telemetry that does not comply with the
+// schema, if recording violations is enabled.
+func RecordViolation(t testing.TB, kind, path, value string, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled || !violations {
+		return
+	}
+	test, ok := tests[topLevel(t.Name())]
+	if !ok {
+		return
+	}
+	test.Violations = append(test.Violations, &Violation{
+		Test:  t.Name(),
+		Kind:  kind,
+		Path:  path,
+		Value: value,
+		Error: err.Error(),
+		Time:  nowFn(),
+	})
+}
+
+// add adds the operation to its top-level test and returns whether it exists.
+func add(op *Operation) bool {
+	test, ok := tests[topLevel(op.Test)]
+	if !ok {
+		return false
+	}
+	test.Operations = append(test.Operations, op)
+	return true
+}
+
+func endPending(name string) {
+	p, ok := pendOps[name]
+	if !ok {
+		return
+	}
+	delete(pendOps, name)
+	p.op.End = nowFn()
+	if p.t.Failed() && !p.startFailed {
+		p.op.Error = "test failed during operation"
+	}
+}
+
+func topLevel(name string) string {
+	return strings.SplitN(name, "/", 2)[0]
+}
+
+// Write stops recording and writes the report as JSON and as JUnit XML to the
+// specified directory, through the artifact sink.
+func Write(dir string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return nil
+	}
+	enabled = false
+	violations = false
+	report.End = nowFn()
+	ctx := context.Background()
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "error marshalling JSON report")
+	}
+	if err := artifacts.Write(ctx, filepath.Join(dir, JSONFile), jsonData); err != nil {
+		return errors.Wrapf(err, "error writing report file %s", JSONFile)
+	}
+	xmlData, err := xml.MarshalIndent(junit(report), "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "error marshalling JUnit report")
+	}
+	xmlData = append([]byte(xml.Header), xmlData...)
+	if err := artifacts.Write(ctx, filepath.Join(dir, JUnitFile), xmlData); err != nil {
+		return errors.Wrapf(err, "error writing report file %s", JUnitFile)
+	}
+	return nil
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+func junit(r *Report) *junitSuites {
+	suite := junitSuite{
+		Name:  r.ReservationID,
+		Tests: len(r.Tests),
+		Time:  seconds(r.End.Sub(r.Start)),
+	}
+	for _, test := range r.Tests {
+		c := junitCase{Name: test.Name, Time: seconds(test.End.Sub(test.Start))}
+		var failedOps []string
+		var timeline strings.Builder
+		for _, op := range test.Operations {
+			fmt.Fprintf(&timeline, "%s %s %s %s", op.Start.Format(time.RFC3339Nano), op.Test, op.Device, op.Description)
+			if op.Error != "" {
+				fmt.Fprintf(&timeline, ": %s", op.Error)
+				failedOps = append(failedOps, op.Description)
+			}
+			timeline.WriteString("\n")
+		}
+		for _, v := range test.Violations {
+			fmt.Fprintf(&timeline, "%s %s %s compliance violation at %s: %s\n", v.Time.Format(time.RFC3339Nano), v.Test, v.Kind, v.Path, v.Error)
+		}
+		c.SystemOut = timeline.String()
+		switch {
+		case test.Failed:
+			suite.Failures++
+			msg := "test failed"
+			if len(failedOps) > 0 {
+				msg = "failed operations: " + strings.Join(failedOps, "; ")
+			}
+			c.Failure = &junitMessage{Message: msg}
+		case test.Skipped:
+			suite.Skipped++
+			c.Skipped = &junitMessage{Message: "test skipped"}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+	return &junitSuites{Suites: []junitSuite{suite}}
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
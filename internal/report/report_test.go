@@ -0,0 +1,202 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeTB struct {
+	testing.TB
+	name            string
+	failed, skipped bool
+}
+
+func (t *fakeTB) Name() string {
+	return t.name
+}
+
+func (t *fakeTB) Failed() bool {
+	return t.failed
+}
+
+func (t *fakeTB) Skipped() bool {
+	return t.skipped
+}
+
+func TestWrite(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	var now time.Time
+	nowFn = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	defer func() { nowFn = time.Now }()
+	now = epoch
+
+	Enable("resv1")
+	test1 := &fakeTB{name: "TestOne"}
+	sub1 := &fakeTB{name: "TestOne/sub"}
+	TestStarted(test1)
+	Begin(test1, "dut1", "Pushing config to dut1")
+	Record(sub1, "dut1", "gNMI Get /interfaces", epoch.Add(2*time.Second), errors.New("timeout"))
+	Begin(sub1, "ate1", "Starting traffic on ate1")
+	sub1.failed = true
+	test1.failed = true
+	TestEnded(test1)
+	test2 := &fakeTB{name: "TestTwo", skipped: true}
+	TestStarted(test2)
+	TestEnded(test2)
+	// Operations outside of a test are not recorded.
+	Begin(&fakeTB{name: "TestOther"}, "dut1", "Pushing config to dut1")
+
+	dir := t.TempDir()
+	if err := Write(dir); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, JSONFile))
+	if err != nil {
+		t.Fatalf("Failed to read JSON report: %v", err)
+	}
+	got := new(Report)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Failed to unmarshal JSON report: %v", err)
+	}
+	sec := func(n int) time.Time { return epoch.Add(time.Duration(n) * time.Second) }
+	want := &Report{
+		ReservationID: "resv1",
+		Start:         sec(1),
+		End:           sec(12),
+		Tests: []*Test{{
+			Name:   "TestOne",
+			Start:  sec(2),
+			End:    sec(8),
+			Failed: true,
+			Operations: []*Operation{{
+				Test:        "TestOne",
+				Device:      "dut1",
+				Description: "Pushing config to dut1",
+				Start:       sec(3),
+				End:         sec(4),
+			}, {
+				Test:        "TestOne/sub",
+				Device:      "dut1",
+				Description: "gNMI Get /interfaces",
+				Start:       sec(2),
+				End:         sec(5),
+				Error:       "timeout",
+			}, {
+				Test:        "TestOne/sub",
+				Device:      "ate1",
+				Description: "Starting traffic on ate1",
+				Start:       sec(6),
+				End:         sec(7),
+				Error:       "test failed during operation",
+			}},
+		}, {
+			Name:    "TestTwo",
+			Start:   sec(9),
+			End:     sec(10),
+			Skipped: true,
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Write() got unexpected JSON report diff (-want,+got): %s", diff)
+	}
+
+	data, err = ioutil.ReadFile(filepath.Join(dir, JUnitFile))
+	if err != nil {
+		t.Fatalf("Failed to read JUnit report: %v", err)
+	}
+	gotSuites := new(junitSuites)
+	if err := xml.Unmarshal(data, gotSuites); err != nil {
+		t.Fatalf("Failed to unmarshal JUnit report: %v", err)
+	}
+	suite := gotSuites.Suites[0]
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Skipped != 1 {
+		t.Errorf("Write() got JUnit suite with %d tests, %d failures, %d skipped, want 2, 1, 1", suite.Tests, suite.Failures, suite.Skipped)
+	}
+	wantMsg := "failed operations: gNMI Get /interfaces; Starting traffic on ate1"
+	if f := suite.Cases[0].Failure; f == nil || f.Message != wantMsg {
+		t.Errorf("Write() got JUnit failure %v, want message %q", f, wantMsg)
+	}
+}
+
+func TestWriteViolations(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	var now time.Time
+	nowFn = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	defer func() { nowFn = time.Now }()
+	now = epoch
+
+	Enable("resv1")
+	test1 := &fakeTB{name: "TestOne"}
+	TestStarted(test1)
+	// Violations are not recorded until enabled.
+	RecordViolation(test1, "type", "/interfaces/interface[name=eth0]/state/mtu", "string_val:\"big\"", errors.New("bad type"))
+	EnableViolations()
+	RecordViolation(&fakeTB{name: "TestOne/sub"}, "path", "/interfaces/dne", "uint_val:1", errors.New("no schema"))
+	TestEnded(test1)
+
+	dir := t.TempDir()
+	if err := Write(dir); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, JSONFile))
+	if err != nil {
+		t.Fatalf("Failed to read JSON report: %v", err)
+	}
+	got := new(Report)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Failed to unmarshal JSON report: %v", err)
+	}
+	want := []*Violation{{
+		Test:  "TestOne/sub",
+		Kind:  "path",
+		Path:  "/interfaces/dne",
+		Value: "uint_val:1",
+		Error: "no schema",
+		Time:  epoch.Add(3 * time.Second),
+	}}
+	if diff := cmp.Diff(want, got.Tests[0].Violations); diff != "" {
+		t.Errorf("Write() got unexpected violations diff (-want,+got): %s", diff)
+	}
+
+	data, err = ioutil.ReadFile(filepath.Join(dir, JUnitFile))
+	if err != nil {
+		t.Fatalf("Failed to read JUnit report: %v", err)
+	}
+	gotSuites := new(junitSuites)
+	if err := xml.Unmarshal(data, gotSuites); err != nil {
+		t.Fatalf("Failed to unmarshal JUnit report: %v", err)
+	}
+	if c := gotSuites.Suites[0].Cases[0]; c.Failure != nil || !strings.Contains(c.SystemOut, "path compliance violation at /interfaces/dne: no schema") {
+		t.Errorf("Write() got JUnit case %+v, want no failure and the violation in its output", c)
+	}
+}
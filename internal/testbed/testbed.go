@@ -25,8 +25,10 @@ import (
 	log "github.com/golang/glog"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/binding/usererr"
+	"github.com/openconfig/ondatra/internal/closer"
 	"github.com/openconfig/ondatra/internal/flags"
 
 	opb "github.com/openconfig/ondatra/proto"
@@ -35,6 +37,7 @@ import (
 var (
 	resMu   sync.RWMutex
 	res     *binding.Reservation
+	resTB   *opb.Testbed
 	fetched bool
 
 	bind binding.Binding
@@ -66,12 +69,10 @@ func Reservation() (*binding.Reservation, error) {
 	return res, nil
 }
 
-// Reserve reserves the testbed.
+// Reserve reserves the testbed in the testbed file.
 func Reserve(ctx context.Context, fv *flags.Values) error {
-	resMu.Lock()
-	defer resMu.Unlock()
-	if res != nil {
-		return errors.New("testbed is already reserved; RunTests was already called")
+	if fv.TestbedPath == "" {
+		return usererr.New("testbed path not specified")
 	}
 	tb := &opb.Testbed{}
 	s, err := ioutil.ReadFile(fv.TestbedPath)
@@ -81,12 +82,30 @@ func Reserve(ctx context.Context, fv *flags.Values) error {
 	if err := prototext.Unmarshal(s, tb); err != nil {
 		return usererr.Wrapf(err, "failed to parse testbed proto %s", fv.TestbedPath)
 	}
+	return ReserveTestbed(ctx, tb, fv)
+}
+
+// ReserveTestbed reserves the specified testbed. The testbed path of the flag
+// values is ignored.
+func ReserveTestbed(ctx context.Context, tb *opb.Testbed, fv *flags.Values) (rerr error) {
+	resMu.Lock()
+	defer resMu.Unlock()
+	if res != nil {
+		return errors.New("testbed is already reserved; RunTests was already called")
+	}
 	if err := validateTB(tb); err != nil {
 		return err
 	}
 
 	var r *binding.Reservation
+	var err error
 	if fv.ResvID == "" {
+		if err := Bind().SetupTestbed(ctx, tb); err != nil {
+			return err
+		}
+		defer closer.CloseOnErr(&rerr, func() error {
+			return Bind().TeardownTestbed(ctx)
+		}, "error tearing down testbed")
 		r, err = Bind().Reserve(ctx, tb, fv.RunTime, fv.WaitTime, fv.ResvPartial)
 	} else {
 		r, err = Bind().FetchReservation(ctx, fv.ResvID)
@@ -99,9 +118,21 @@ func Reserve(ctx context.Context, fv *flags.Values) error {
 		return err
 	}
 	res = r
+	resTB = tb
 	return nil
 }
 
+// Testbed returns the reserved testbed, including the devices, ports and
+// links that are optional and were not reserved.
+func Testbed() (*opb.Testbed, error) {
+	resMu.RLock()
+	defer resMu.RUnlock()
+	if res == nil {
+		return nil, errors.New("testbed is not reserved; RunTests was not called")
+	}
+	return resTB, nil
+}
+
 // portMap registers which ports are connected to which other ports, in the format "<device-id>:<port-id>".
 // Non-connected ports map to "", which allows to check for validity of port IDs in links.
 // Each pair of connected ports A and B must be in the map twice: port A's ID mapping to port B's ID and port B's ID mapping to port A's ID.
@@ -121,8 +152,14 @@ func validateTB(tb *opb.Testbed) error {
 			if _, ok := pm[pid]; ok {
 				return usererr.New("duplicate port %q", pid)
 			}
+			if b := p.GetBreakout(); b != nil && (b.GetNumChannels() < 2 || b.GetChannelSpeedGbps() == 0) {
+				return usererr.New("invalid breakout %v of port %q: must have at least 2 channels and a channel speed", b, pid)
+			}
 			pm[pid] = ""
 		}
+		if err := checkPortBounds(d); err != nil {
+			return err
+		}
 	}
 	for _, ln := range tb.GetLinks() {
 		dupB, ok := pm[ln.GetA()]
@@ -145,6 +182,37 @@ func validateTB(tb *opb.Testbed) error {
 	return nil
 }
 
+// checkPortBounds checks the bounds on the number of reserved ports of a device.
+func checkPortBounds(d *opb.Device) error {
+	minPorts, maxPorts := d.GetMinPorts(), d.GetMaxPorts()
+	if minPorts < 0 || maxPorts < 0 {
+		return usererr.New("negative port bounds [%d, %d] on device %q", minPorts, maxPorts, d.GetId())
+	}
+	if min, max := PortBounds(d); min > max {
+		return usererr.New("device %q requires at least %d ports, but at most %d of its %d ports may be reserved", d.GetId(), min, max, len(d.GetPorts()))
+	}
+	return nil
+}
+
+// PortBounds returns the minimum and maximum number of reserved ports of a
+// device. The minimum is at least the number of ports that are not optional.
+func PortBounds(d *opb.Device) (int, int) {
+	var min int
+	for _, p := range d.GetPorts() {
+		if !p.GetIsOptional() {
+			min++
+		}
+	}
+	if int(d.GetMinPorts()) > min {
+		min = int(d.GetMinPorts())
+	}
+	max := len(d.GetPorts())
+	if mp := int(d.GetMaxPorts()); mp > 0 && mp < max {
+		max = mp
+	}
+	return min, max
+}
+
 var idRE = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
 
 // checkID enforces testbed IDs that "look like" variable names.
@@ -160,6 +228,9 @@ func validateRes(tb *opb.Testbed, res *binding.Reservation) error {
 	for _, dut := range tb.GetDuts() {
 		rd, err := DUT(res, dut.GetId())
 		if err != nil {
+			if dut.GetIsOptional() {
+				continue
+			}
 			return err
 		}
 		if err := validateDevice(dut, rd); err != nil {
@@ -169,6 +240,9 @@ func validateRes(tb *opb.Testbed, res *binding.Reservation) error {
 	for _, ate := range tb.GetAtes() {
 		ra, err := ATE(res, ate.GetId())
 		if err != nil {
+			if ate.GetIsOptional() {
+				continue
+			}
 			return err
 		}
 		if err := validateDevice(ate, ra); err != nil {
@@ -183,27 +257,51 @@ func validateDevice(dev *opb.Device, rd binding.Device) error {
 	if dims.Name == "" {
 		return errors.Errorf("no name for reserved device: %v", rd)
 	}
+	var numPorts int
 	for _, p := range dev.GetPorts() {
 		rp, err := Port(dims, p.GetId())
 		if err != nil {
+			if p.GetIsOptional() {
+				continue
+			}
 			return err
 		}
 		if rp.Name == "" {
 			return errors.Errorf("no name for reserved port: %v", rp)
 		}
+		if b := p.GetBreakout(); b != nil && !proto.Equal(b, rp.Breakout) {
+			return errors.Errorf("reserved port %s has breakout %v, want %v", rp.Name, rp.Breakout, b)
+		}
+		numPorts++
+	}
+	if min, max := PortBounds(dev); numPorts < min || numPorts > max {
+		return errors.Errorf("%d ports reserved on device %s, want between %d and %d", numPorts, dims.Name, min, max)
 	}
 	return nil
 }
 
-// Release releases the testbed. This is a noop if the reservation is not
-// currently reserved or if the reservation was fetched and not created.
-func Release(ctx context.Context) error {
+// Owned returns whether the testbed is reserved by this test, rather than
+// fetched by the ID of an existing reservation, so it is released by Release.
+func Owned() bool {
+	resMu.RLock()
+	defer resMu.RUnlock()
+	return res != nil && !fetched
+}
+
+// Release releases the testbed and then tears it down. This is a noop if the
+// reservation is not currently reserved or if the reservation was fetched and
+// not created.
+func Release(ctx context.Context) (rerr error) {
 	resMu.Lock()
 	defer resMu.Unlock()
 	if res == nil || fetched {
 		return nil
 	}
 	res = nil
+	resTB = nil
+	defer closer.Close(&rerr, func() error {
+		return Bind().TeardownTestbed(ctx)
+	}, "error tearing down testbed")
 	return Bind().Release(ctx)
 }
 
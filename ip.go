@@ -34,3 +34,57 @@ func (i *IP) WithDefaultGateway(gateway string) *IP {
 	i.pb.DefaultGateway = gateway
 	return i
 }
+
+// NeighborDiscovery creates an IPv6 neighbor discovery config for the
+// interface or returns the existing config. It is only valid for IPv6.
+// By default, the interface does not send router advertisements, and the ATE
+// default neighbor solicitation settings are used.
+func (i *IP) NeighborDiscovery() *NeighborDiscovery {
+	if i.pb.NeighborDiscovery == nil {
+		i.pb.NeighborDiscovery = &opb.NeighborDiscoveryConfig{}
+	}
+	return &NeighborDiscovery{pb: i.pb.NeighborDiscovery}
+}
+
+// NeighborDiscovery is a representation of IPv6 neighbor discovery config on
+// the ATE.
+type NeighborDiscovery struct {
+	pb *opb.NeighborDiscoveryConfig
+}
+
+// WithRouterAdvertisements sets whether the interface sends router
+// advertisements.
+func (nd *NeighborDiscovery) WithRouterAdvertisements(send bool) *NeighborDiscovery {
+	nd.pb.SendRouterAdvertisements = send
+	return nd
+}
+
+// WithRAPrefixIncluded sets whether the router advertisements include the
+// prefix of the interface address.
+func (nd *NeighborDiscovery) WithRAPrefixIncluded(included bool) *NeighborDiscovery {
+	nd.pb.IncludeRaPrefix = included
+	return nd
+}
+
+// WithNSCount sets the number of neighbor solicitations sent to resolve an
+// address before giving up. It applies to all the interfaces on the port, so
+// interfaces on the same port or LAG must set the same count.
+func (nd *NeighborDiscovery) WithNSCount(count uint32) *NeighborDiscovery {
+	nd.pb.NsCount = count
+	return nd
+}
+
+// WithNSRetransmitInterval sets the interval in milliseconds between
+// neighbor solicitations. It applies to all the interfaces on the port, so
+// interfaces on the same port or LAG must set the same interval.
+func (nd *NeighborDiscovery) WithNSRetransmitInterval(intervalMs uint32) *NeighborDiscovery {
+	nd.pb.NsRetransmitMs = intervalMs
+	return nd
+}
+
+// WithGatewayResolution sets whether the interface resolves the link-layer
+// address of its default gateway, which it does by default.
+func (nd *NeighborDiscovery) WithGatewayResolution(resolve bool) *NeighborDiscovery {
+	nd.pb.DisableGatewayResolution = !resolve
+	return nd
+}
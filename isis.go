@@ -67,6 +67,12 @@ func (i *ISIS) WithLevelL2() *ISIS {
 	return i
 }
 
+// WithLevelL1L2 sets the IS-IS level to both L1 and L2.
+func (i *ISIS) WithLevelL1L2() *ISIS {
+	i.pb.Level = opb.ISISConfig_L1L2
+	return i
+}
+
 // WithNetworkTypeBroadcast sets the IS-IS network type to broadcast.
 func (i *ISIS) WithNetworkTypeBroadcast() *ISIS {
 	i.pb.NetworkType = opb.ISISConfig_BROADCAST
@@ -117,10 +123,51 @@ func (i *ISIS) WithAuthPassword(key string) *ISIS {
 	return i
 }
 
-// WithAuthDisabled disables authentication.
+// WithAreaAuthMD5 sets md5 authentication of level 1 LSPs and SNPs.
+func (i *ISIS) WithAreaAuthMD5(key string) *ISIS {
+	i.pb.AreaAuthType = opb.ISISConfig_MD5
+	i.pb.AreaAuthKey = key
+	return i
+}
+
+// WithAreaAuthPassword sets password authentication of level 1 LSPs and SNPs.
+func (i *ISIS) WithAreaAuthPassword(key string) *ISIS {
+	i.pb.AreaAuthType = opb.ISISConfig_PASSWORD
+	i.pb.AreaAuthKey = key
+	return i
+}
+
+// WithDomainAuthMD5 sets md5 authentication of level 2 LSPs and SNPs.
+func (i *ISIS) WithDomainAuthMD5(key string) *ISIS {
+	i.pb.DomainAuthType = opb.ISISConfig_MD5
+	i.pb.DomainAuthKey = key
+	return i
+}
+
+// WithDomainAuthPassword sets password authentication of level 2 LSPs and SNPs.
+func (i *ISIS) WithDomainAuthPassword(key string) *ISIS {
+	i.pb.DomainAuthType = opb.ISISConfig_PASSWORD
+	i.pb.DomainAuthKey = key
+	return i
+}
+
+// WithAuthDisabled disables authentication of hellos, and of level 1 and
+// level 2 LSPs and SNPs.
 func (i *ISIS) WithAuthDisabled() *ISIS {
 	i.pb.AuthType = opb.ISISConfig_AUTH_TYPE_UNSPECIFIED
 	i.pb.AuthKey = ""
+	i.pb.AreaAuthType = opb.ISISConfig_AUTH_TYPE_UNSPECIFIED
+	i.pb.AreaAuthKey = ""
+	i.pb.DomainAuthType = opb.ISISConfig_AUTH_TYPE_UNSPECIFIED
+	i.pb.DomainAuthKey = ""
+	return i
+}
+
+// WithOverloaded sets whether the overload bit is set in the LSPs of the
+// emulated router. To change it while protocols are running, call
+// ATETopology.UpdateISIS after setting it.
+func (i *ISIS) WithOverloaded(overloaded bool) *ISIS {
+	i.pb.Overloaded = overloaded
 	return i
 }
 
@@ -166,6 +213,13 @@ func (i *ISIS) WithDeadInterval(intervalSec uint32) *ISIS {
 	return i
 }
 
+// WithBFDEnabled sets whether IS-IS registers with the BFD sessions configured
+// on the interface.
+func (i *ISIS) WithBFDEnabled(enabled bool) *ISIS {
+	i.pb.EnableBfd = enabled
+	return i
+}
+
 // SegmentRouting creates or returns the ISIS Segment Routing configuration.
 func (i *ISIS) SegmentRouting() *ISISSegmentRouting {
 	if i.pb.SegmentRouting == nil {
@@ -174,6 +228,14 @@ func (i *ISIS) SegmentRouting() *ISISSegmentRouting {
 	return &ISISSegmentRouting{pb: i.pb.SegmentRouting}
 }
 
+// TEAttributes creates or returns the TE attributes advertised for the link.
+func (i *ISIS) TEAttributes() *ISISTrafficEngineering {
+	if i.pb.TeAttributes == nil {
+		i.pb.TeAttributes = &opb.ISISTrafficEngineering{}
+	}
+	return &ISISTrafficEngineering{pb: i.pb.TeAttributes}
+}
+
 // AddISReachability adds an ISReachability config to the ISIS config.
 func (i *ISIS) AddISReachability() *ISReachabilityConfig {
 	isR := &ISReachabilityConfig{pb: &opb.ISReachability{}}
@@ -502,6 +564,14 @@ func (node *ISISNode) ClearLinks() *ISISNode {
 	return node
 }
 
+// TEAttributes creates or returns the TE attributes advertised for the link.
+func (link *ISISNodeLink) TEAttributes() *ISISTrafficEngineering {
+	if link.pb.TeAttributes == nil {
+		link.pb.TeAttributes = &opb.ISISTrafficEngineering{}
+	}
+	return &ISISTrafficEngineering{pb: link.pb.TeAttributes}
+}
+
 // WithFromIPv4 sets the IPv4 'from' address for the link.
 func (link *ISISNodeLink) WithFromIPv4(ip string) *ISISNodeLink {
 	link.pb.FromIpv4 = ip
@@ -556,3 +626,47 @@ func (routes *ISISRoutes) IPReachability() *IPReachabilityConfig {
 	}
 	return &IPReachabilityConfig{pb: routes.pb.Reachability}
 }
+
+// ISISTrafficEngineering is a representation of the TE attributes of an IS-IS
+// link.
+type ISISTrafficEngineering struct {
+	pb *opb.ISISTrafficEngineering
+}
+
+// WithMetric sets the TE default metric of the link.
+func (te *ISISTrafficEngineering) WithMetric(metric uint32) *ISISTrafficEngineering {
+	te.pb.Metric = metric
+	return te
+}
+
+// WithAdminGroup sets the administrative group bit mask of the link.
+func (te *ISISTrafficEngineering) WithAdminGroup(adminGroup uint32) *ISISTrafficEngineering {
+	te.pb.AdminGroup = adminGroup
+	return te
+}
+
+// WithMaxBandwidth sets the maximum bandwidth of the link in bytes per second.
+func (te *ISISTrafficEngineering) WithMaxBandwidth(bw float32) *ISISTrafficEngineering {
+	te.pb.MaxBandwidth = bw
+	return te
+}
+
+// WithMaxReservableBandwidth sets the maximum reservable bandwidth of the link
+// in bytes per second.
+func (te *ISISTrafficEngineering) WithMaxReservableBandwidth(bw float32) *ISISTrafficEngineering {
+	te.pb.MaxReservableBandwidth = bw
+	return te
+}
+
+// WithUnreservedBandwidth sets the unreserved bandwidth of the link in bytes
+// per second, indexed by priority. At most eight values may be specified.
+func (te *ISISTrafficEngineering) WithUnreservedBandwidth(bws ...float32) *ISISTrafficEngineering {
+	te.pb.UnreservedBandwidth = bws
+	return te
+}
+
+// WithSRLGs sets the shared risk link groups of the link.
+func (te *ISISTrafficEngineering) WithSRLGs(srlgs ...uint32) *ISISTrafficEngineering {
+	te.pb.Srlgs = srlgs
+	return te
+}
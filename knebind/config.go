@@ -29,12 +29,32 @@ type Config struct {
 	TopoPath           string `yaml:"topology"`
 	CLIPath            string `yaml:"cli"`
 	KubecfgPath        string `yaml:"kubecfg"`
+	// CreateTopology specifies whether the binding creates the topology when
+	// the testbed is set up and deletes it when the testbed is torn down.
+	CreateTopology bool `yaml:"create_topology"`
+	// Credentials overrides the username and password used to dial specific
+	// services of specific nodes, keyed by node name and then by service name,
+	// such as "gnmi" or "p4rt".
+	Credentials map[string]map[string]*Credentials `yaml:"credentials"`
+}
+
+// Credentials are a username and password to log into a KNE node.
+type Credentials struct {
+	Username, Password string
 }
 
 func (c *Config) String() string {
 	return fmt.Sprintf("%+v", *c)
 }
 
+// credentials returns the credentials to dial the service of the node.
+func (c *Config) credentials(node, service string) *Credentials {
+	if creds := c.Credentials[node][service]; creds != nil {
+		return creds
+	}
+	return &Credentials{Username: c.Username, Password: c.Password}
+}
+
 // ParseConfigFile parses a yaml file containing a serialized Config.
 func ParseConfigFile(configFile string) (*Config, error) {
 	data, err := ioutil.ReadFile(configFile)
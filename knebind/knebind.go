@@ -29,8 +29,10 @@ import (
 	"github.com/openconfig/ondatra/internal/closer"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/prototext"
 	grpb "github.com/openconfig/gribi/v1/proto/service"
 	"github.com/openconfig/ondatra/binding"
@@ -54,6 +56,7 @@ type Bind struct {
 	services solver.ServiceMap
 	mu       sync.Mutex
 	cfg      *Config
+	created  bool
 }
 
 // New returns a new KNE bind instance.
@@ -66,6 +69,34 @@ func New(cfg *Config) (*Bind, error) {
 	}, nil
 }
 
+// SetupTestbed implements the binding SetupTestbed method by creating the
+// topology specified in the config file, if the config enables it.
+func (b *Bind) SetupTestbed(ctx context.Context, _ *opb.Testbed) error {
+	if !b.cfg.CreateTopology {
+		return nil
+	}
+	log.Infof("Creating KNE topology %s", b.cfg.TopoPath)
+	if _, err := kneCmdFn(b.cfg, "create", b.cfg.TopoPath); err != nil {
+		return errors.Wrap(err, "error creating KNE topology")
+	}
+	b.created = true
+	return nil
+}
+
+// TeardownTestbed implements the binding TeardownTestbed method by deleting
+// the topology, if it was created by SetupTestbed.
+func (b *Bind) TeardownTestbed(ctx context.Context) error {
+	if !b.created {
+		return nil
+	}
+	log.Infof("Deleting KNE topology %s", b.cfg.TopoPath)
+	if _, err := kneCmdFn(b.cfg, "delete", b.cfg.TopoPath); err != nil {
+		return errors.Wrap(err, "error deleting KNE topology")
+	}
+	b.created = false
+	return nil
+}
+
 // Reserve implements the binding Reserve method by finding nodes and links in
 // the topology specified in the config file that match the requested testbed.
 func (b *Bind) Reserve(ctx context.Context, tb *opb.Testbed, runTime time.Duration, waitTime time.Duration, partial map[string]string) (*binding.Reservation, error) {
@@ -129,12 +160,16 @@ func (b *Bind) dialGRPC(ctx context.Context, dut *binding.DUT, serviceName strin
 	}
 	addr := serviceAddr(s)
 	log.Infof("Dialing service %q on dut %s@%s", serviceName, dut.Name, addr)
-	opts = append(opts,
+	creds := b.cfg.credentials(dut.Name, serviceName)
+	// The specified options come last, so they override the default ones,
+	// except for per-RPC credentials, which gRPC sends in addition.
+	opts = append([]grpc.DialOption{
 		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})),
 		grpc.WithPerRPCCredentials(&passCred{
-			username: b.cfg.Username,
-			password: b.cfg.Password,
-		}))
+			username: creds.Username,
+			password: creds.Password,
+		}),
+	}, opts...)
 	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "DialContext(ctx, %s, %v)", addr, opts)
@@ -179,6 +214,30 @@ func (b *Bind) PushConfig(ctx context.Context, dut *binding.DUT, config string,
 	return err
 }
 
+// CheckpointConfig implements the binding CheckpointConfig method by returning
+// an empty ID, so that the framework checkpoints the config with gNMI.
+func (b *Bind) CheckpointConfig(context.Context, *binding.DUT) (string, error) {
+	return "", nil
+}
+
+// RestoreConfig implements the binding RestoreConfig method. It always returns
+// an error, because CheckpointConfig never returns a checkpoint ID.
+func (b *Bind) RestoreConfig(_ context.Context, _ *binding.DUT, id string) error {
+	return errors.Errorf("KNEBind has no config checkpoint %q", id)
+}
+
+// StreamConsoleLogs implements the binding StreamConsoleLogs method. It always
+// returns an Unimplemented error, because KNE does not stream console logs.
+func (b *Bind) StreamConsoleLogs(context.Context, *binding.DUT) (io.ReadCloser, error) {
+	return nil, status.Error(codes.Unimplemented, "KNEBind does not support streaming console logs")
+}
+
+// StartPacketCapture implements the binding StartPacketCapture method. It
+// always returns an error, because KNE does not support packet capture.
+func (b *Bind) StartPacketCapture(context.Context, *binding.DUT, []*binding.Port, string) (binding.PacketCapture, error) {
+	return nil, errors.New("KNEBind does not support packet capture")
+}
+
 func (b *Bind) dutExec(dut *binding.DUT, cmd string) (_ string, rerr error) {
 	s, err := b.services.Lookup(dut.Name, "ssh")
 	if err != nil {
@@ -16,6 +16,13 @@ package knebind
 
 import (
 	"golang.org/x/net/context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -23,11 +30,17 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"github.com/openconfig/gnmi/errdiff"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/knebind/solver"
 
 	tpb "github.com/google/kne/proto/topo"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	opb "github.com/openconfig/ondatra/proto"
 )
 
@@ -143,6 +156,23 @@ func TestReserve(t *testing.T) {
 		A: "dut1:port2",
 		B: "ate:port1",
 	}
+	optDUT := &opb.Device{
+		Id:         "opt",
+		Vendor:     opb.Device_NOKIA,
+		IsOptional: true,
+		Ports:      []*opb.Port{{Id: "port1"}},
+	}
+	optPortDUT := &opb.Device{
+		Id:     "dut3",
+		Vendor: opb.Device_JUNIPER,
+		Ports:  []*opb.Port{{Id: "port1"}, {Id: "port2", IsOptional: true}},
+	}
+	subsetDUT := &opb.Device{
+		Id:       "dut1",
+		Vendor:   opb.Device_ARISTA,
+		MaxPorts: 1,
+		Ports:    []*opb.Port{{Id: "port1", IsOptional: true}, {Id: "port2"}},
+	}
 	kneCmdFn = func(cfg *Config, args ...string) ([]byte, error) {
 		return []byte(topo), nil
 	}
@@ -175,6 +205,15 @@ func TestReserve(t *testing.T) {
 			"port1": {Name: "eth1"},
 		},
 	}}
+	wantSubsetDUT := &binding.DUT{&binding.Dims{
+		Name:            "node1",
+		Vendor:          opb.Device_ARISTA,
+		HardwareModel:   "ARISTA_CEOS",
+		SoftwareVersion: "ARISTA_CEOS",
+		Ports: map[string]*binding.Port{
+			"port2": {Name: "Ethernet2"},
+		},
+	}}
 	wantATE := &binding.ATE{&binding.Dims{
 		Name:            "node4",
 		Vendor:          opb.Device_IXIA,
@@ -254,6 +293,59 @@ func TestReserve(t *testing.T) {
 			},
 			ATEs: map[string]*binding.ATE{},
 		},
+	}, {
+		desc: "absent optional dut",
+		tb: &opb.Testbed{
+			Duts:  []*opb.Device{dut3, optDUT},
+			Links: []*opb.Link{{A: "dut3:port1", B: "opt:port1"}},
+		},
+		wantRes: &binding.Reservation{
+			DUTs: map[string]*binding.DUT{
+				"dut3": wantDUT3,
+			},
+			ATEs: map[string]*binding.ATE{},
+		},
+	}, {
+		desc: "absent optional port",
+		tb: &opb.Testbed{
+			Duts: []*opb.Device{optPortDUT},
+		},
+		wantRes: &binding.Reservation{
+			DUTs: map[string]*binding.DUT{
+				"dut3": wantDUT3,
+			},
+			ATEs: map[string]*binding.ATE{},
+		},
+	}, {
+		desc: "connected link",
+		tb: &opb.Testbed{
+			Duts:  []*opb.Device{dut1},
+			Ates:  []*opb.Device{ate},
+			Links: []*opb.Link{{A: "dut1:port2", B: "ate:port1"}},
+		},
+		wantRes: &binding.Reservation{
+			DUTs: map[string]*binding.DUT{
+				"dut1": wantDUT1,
+			},
+			ATEs: map[string]*binding.ATE{
+				"ate": wantATE,
+			},
+		},
+	}, {
+		desc: "port subset",
+		tb: &opb.Testbed{
+			Duts:  []*opb.Device{subsetDUT},
+			Ates:  []*opb.Device{ate},
+			Links: []*opb.Link{link14},
+		},
+		wantRes: &binding.Reservation{
+			DUTs: map[string]*binding.DUT{
+				"dut1": wantSubsetDUT,
+			},
+			ATEs: map[string]*binding.ATE{
+				"ate": wantATE,
+			},
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -316,6 +408,22 @@ func TestReserveErrors(t *testing.T) {
 		  }
 		`,
 		wantErr: "No node in KNE topology to match testbed",
+	}, {
+		desc: "too few ports for min ports",
+		tb: &opb.Testbed{
+			Duts: []*opb.Device{{
+				Id:       "dut1",
+				MinPorts: 2,
+				Ports:    []*opb.Port{{Id: "port1", IsOptional: true}, {Id: "port2", IsOptional: true}},
+			}},
+		},
+		topo: `
+		  nodes: {
+		    name: "node1"
+        type: ARISTA_CEOS
+		  }
+		`,
+		wantErr: "No node in KNE topology to match testbed",
 	}, {
 		desc: "no match for ATE",
 		tb: &opb.Testbed{
@@ -408,6 +516,63 @@ func TestReserveErrors(t *testing.T) {
 		  }
 		`,
 		wantErr: "No KNE topology",
+	}, {
+		desc: "unconnected link",
+		tb: &opb.Testbed{
+			Duts: []*opb.Device{{
+				Id:     "dut1",
+				Vendor: opb.Device_ARISTA,
+				Ports:  []*opb.Port{{Id: "port1"}},
+			}, {
+				Id:     "dut2",
+				Vendor: opb.Device_JUNIPER,
+				Ports:  []*opb.Port{{Id: "port1"}},
+			}},
+			Links: []*opb.Link{{A: "dut1:port1", B: "dut2:port1"}},
+		},
+		topo: `
+		  nodes: {
+		    name: "node1"
+        type: ARISTA_CEOS
+		  }
+		  nodes: {
+		    name: "node2"
+        type: JUNIPER_VMX
+		  }
+		  nodes: {
+		    name: "node3"
+        type: CISCO_CXR
+		  }
+			links: {
+		    a_node: "node1"
+		    a_int: "eth1"
+		    z_node: "node3"
+		    z_int: "eth1"
+		  }
+			links: {
+		    a_node: "node2"
+		    a_int: "eth1"
+		    z_node: "node3"
+		    z_int: "eth2"
+		  }
+		`,
+		wantErr: "No KNE topology",
+	}, {
+		desc: "link to nonexistent port",
+		tb: &opb.Testbed{
+			Duts: []*opb.Device{{
+				Id:    "dut1",
+				Ports: []*opb.Port{{Id: "port1", IsOptional: true}},
+			}},
+			Links: []*opb.Link{{A: "dut1:port1", B: "dut1:port2"}},
+		},
+		topo: `
+		  nodes: {
+		    name: "node1"
+        type: ARISTA_CEOS
+		  }
+		`,
+		wantErr: "nonexistent port",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -570,6 +735,94 @@ func TestServices(t *testing.T) {
 	}
 }
 
+func TestSetupTeardownTestbed(t *testing.T) {
+	var gotCmds []string
+	kneCmdFn = func(cfg *Config, args ...string) ([]byte, error) {
+		gotCmds = append(gotCmds, strings.Join(args, " "))
+		return nil, nil
+	}
+	tests := []struct {
+		desc     string
+		create   bool
+		wantCmds []string
+	}{{
+		desc:     "create topology",
+		create:   true,
+		wantCmds: []string{"create topo.textproto", "delete topo.textproto"},
+	}, {
+		desc: "existing topology",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			gotCmds = nil
+			bind := &Bind{cfg: &Config{TopoPath: "topo.textproto", CreateTopology: tt.create}}
+			if err := bind.SetupTestbed(context.Background(), nil); err != nil {
+				t.Fatalf("SetupTestbed() got error: %v", err)
+			}
+			if err := bind.TeardownTestbed(context.Background()); err != nil {
+				t.Fatalf("TeardownTestbed() got error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantCmds, gotCmds); diff != "" {
+				t.Errorf("SetupTestbed() and TeardownTestbed() got unexpected commands diff (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetupTestbedError(t *testing.T) {
+	kneCmdFn = func(cfg *Config, args ...string) ([]byte, error) {
+		return nil, errors.New("create failed")
+	}
+	bind := &Bind{cfg: &Config{TopoPath: "topo.textproto", CreateTopology: true}}
+	if err := bind.SetupTestbed(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "create failed") {
+		t.Errorf("SetupTestbed() got error %v, want create failed", err)
+	}
+	// A topology that failed to be created must not be deleted.
+	kneCmdFn = func(cfg *Config, args ...string) ([]byte, error) {
+		t.Errorf("TeardownTestbed() got unexpected command %v", args)
+		return nil, nil
+	}
+	if err := bind.TeardownTestbed(context.Background()); err != nil {
+		t.Errorf("TeardownTestbed() got error: %v", err)
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	cfg := &Config{
+		Username: "user",
+		Password: "pass",
+		Credentials: map[string]map[string]*Credentials{
+			"node1": {"p4rt": {Username: "p4user", Password: "p4pass"}},
+		},
+	}
+	tests := []struct {
+		desc, node, service string
+		want                *Credentials
+	}{{
+		desc:    "overridden service",
+		node:    "node1",
+		service: "p4rt",
+		want:    &Credentials{Username: "p4user", Password: "p4pass"},
+	}, {
+		desc:    "other service",
+		node:    "node1",
+		service: "gnmi",
+		want:    &Credentials{Username: "user", Password: "pass"},
+	}, {
+		desc:    "other node",
+		node:    "node2",
+		service: "p4rt",
+		want:    &Credentials{Username: "user", Password: "pass"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, cfg.credentials(tt.node, tt.service)); diff != "" {
+				t.Errorf("credentials(%q, %q) got unexpected diff (-want,+got): %s", tt.node, tt.service, diff)
+			}
+		})
+	}
+}
+
 func TestPushConfig(t *testing.T) {
 	const dutName = "dut"
 	bind := &Bind{
@@ -639,3 +892,82 @@ func TestPushConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamConsoleLogs(t *testing.T) {
+	bind := &Bind{cfg: &Config{}}
+	_, err := bind.StreamConsoleLogs(context.Background(), &binding.DUT{&binding.Dims{Name: "dut"}})
+	if got, want := status.Code(err), codes.Unimplemented; got != want {
+		t.Errorf("StreamConsoleLogs() got error code %v, want %v", got, want)
+	}
+}
+
+// selfSignedCert returns a self-signed TLS certificate for localhost.
+func selfSignedCert(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() got error: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() got error: %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+type tokenCred string
+
+func (c tokenCred) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(c)}, nil
+}
+
+func (tokenCred) RequireTransportSecurity() bool {
+	return true
+}
+
+func TestDialCredentials(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() got error: %v", err)
+	}
+	cert := selfSignedCert(t)
+	mdCh := make(chan metadata.MD, 1)
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
+		grpc.UnaryInterceptor(func(ctx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			mdCh <- md
+			return nil, status.Error(codes.Unimplemented, "no capabilities")
+		}))
+	gpb.RegisterGNMIServer(srv, &gpb.UnimplementedGNMIServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	bind := &Bind{
+		cfg: &Config{Username: "user", Password: "pass"},
+		services: solver.ServiceMap{"node1": {"gnmi": &tpb.Service{
+			OutsideIp: "127.0.0.1",
+			Outside:   uint32(lis.Addr().(*net.TCPAddr).Port),
+		}}},
+	}
+	dut := &binding.DUT{&binding.Dims{Name: "node1"}}
+	c, err := bind.DialGNMI(context.Background(), dut, grpc.WithPerRPCCredentials(tokenCred("secret")))
+	if err != nil {
+		t.Fatalf("DialGNMI() got error: %v", err)
+	}
+	c.Capabilities(context.Background(), &gpb.CapabilityRequest{})
+	md := <-mdCh
+	// Per-RPC credentials of the caller are sent along with the default ones.
+	if got, want := md.Get("authorization"), []string{"Bearer secret"}; !cmp.Equal(got, want) {
+		t.Errorf("DialGNMI() sent authorization %v, want %v", got, want)
+	}
+	if got, want := md.Get("username"), []string{"user"}; !cmp.Equal(got, want) {
+		t.Errorf("DialGNMI() sent username %v, want %v", got, want)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/pborman/uuid"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/testbed"
 
 	tpb "github.com/google/kne/proto/topo"
 	opb "github.com/openconfig/ondatra/proto"
@@ -50,14 +51,16 @@ var (
 // Solve creates a new Reservation from a desired testbed and an available topology.
 func Solve(tb *opb.Testbed, topo *tpb.Topology) (*Solution, error) {
 	devs := append(append([]*opb.Device{}, tb.GetDuts()...), tb.GetAtes()...)
-	if numDevs, numNodes := len(devs), len(topo.GetNodes()); numDevs > numNodes {
+	var numDevs int
+	for _, dev := range devs {
+		if !dev.GetIsOptional() {
+			numDevs++
+		}
+	}
+	if numNodes := len(topo.GetNodes()); numDevs > numNodes {
 		return nil, errors.Errorf("Not enough nodes in KNE topology for specified testbed: "+
 			" testbed has %d devices and topology only has %d nodes", numDevs, numNodes)
 	}
-	if numTBLinks, numTopoLinks := len(tb.GetLinks()), len(topo.GetLinks()); numTBLinks > numTopoLinks {
-		return nil, errors.Errorf("Not enough links in KNE topology for specified testbed "+
-			" testbed has %d links and topology only has %d links", numTBLinks, numTopoLinks)
-	}
 	s := &solver{
 		testbed:    tb,
 		topology:   topo,
@@ -76,6 +79,25 @@ func Solve(tb *opb.Testbed, topo *tpb.Topology) (*Solution, error) {
 		}
 		s.dev2Ports[dev] = ports
 	}
+	// Links to optional devices or ports may be absent from the reservation.
+	var numTBLinks int
+	for _, link := range tb.GetLinks() {
+		if !s.isOptional(link.GetA()) && !s.isOptional(link.GetB()) {
+			numTBLinks++
+		}
+	}
+	if numTopoLinks := len(topo.GetLinks()); numTBLinks > numTopoLinks {
+		return nil, errors.Errorf("Not enough links in KNE topology for specified testbed "+
+			" testbed has %d links and topology only has %d links", numTBLinks, numTopoLinks)
+	}
+	for _, link := range tb.GetLinks() {
+		for _, end := range []string{link.GetA(), link.GetB()} {
+			parts := strings.SplitN(end, ":", 2)
+			if len(parts) != 2 || s.dev2Ports[s.id2Dev[parts[0]]][parts[1]] == nil {
+				return nil, errors.Errorf("testbed link %s<->%s is to nonexistent port %q", link.GetA(), link.GetB(), end)
+			}
+		}
+	}
 	name2Node := make(map[string]*tpb.Node)
 	for _, node := range s.topology.GetNodes() {
 		name2Node[node.GetName()] = node
@@ -110,10 +132,14 @@ func Solve(tb *opb.Testbed, topo *tpb.Topology) (*Solution, error) {
 		ID:   uuid.New(),
 		DUTs: make(map[string]*binding.DUT),
 		ATEs: make(map[string]*binding.ATE),
+		Lab:  topo.GetName(),
 	}
 	sm := ServiceMap{}
 
 	for _, dut := range tb.GetDuts() {
+		if _, ok := a.dev2Node[dut]; !ok && dut.GetIsOptional() {
+			continue
+		}
 		resDUT, err := a.resolveDUT(dut)
 		if err != nil {
 			return nil, err
@@ -126,6 +152,9 @@ func Solve(tb *opb.Testbed, topo *tpb.Topology) (*Solution, error) {
 		sm.Update(resDUT.Name, services)
 	}
 	for _, ate := range tb.GetAtes() {
+		if _, ok := a.dev2Node[ate]; !ok && ate.GetIsOptional() {
+			continue
+		}
 		resATE, err := a.resolveATE(ate)
 		if err != nil {
 			return nil, err
@@ -173,6 +202,12 @@ type assign struct {
 	port2Intf map[*opb.Port]*intf
 }
 
+// absent is the assignment of an optional device or port that is not reserved.
+// It wraps the device or port, so that it is unique among the assigned values.
+type absent struct {
+	key interface{}
+}
+
 type intf struct {
 	name       string
 	vendorName string
@@ -224,7 +259,9 @@ func (a *assign) resolveDims(dev *opb.Device) (*binding.Dims, error) {
 		Ports:           make(map[string]*binding.Port),
 	}
 	for _, p := range dev.GetPorts() {
-		dims.Ports[p.GetId()] = &binding.Port{Name: a.port2Intf[p].vendorName}
+		if i, ok := a.port2Intf[p]; ok {
+			dims.Ports[p.GetId()] = &binding.Port{Name: i.vendorName}
+		}
 	}
 	return dims, nil
 }
@@ -257,6 +294,9 @@ func (s *solver) solve() (*assign, error) {
 	for _, dut := range s.testbed.GetDuts() {
 		node2Port2Intfs, err := s.nodeMatches(dut, false)
 		if err != nil {
+			if dut.GetIsOptional() {
+				continue
+			}
 			return nil, err
 		}
 		dev2Node2Port2Intfs[dut] = node2Port2Intfs
@@ -264,17 +304,25 @@ func (s *solver) solve() (*assign, error) {
 	for _, ate := range s.testbed.GetAtes() {
 		node2Port2Intfs, err := s.nodeMatches(ate, true)
 		if err != nil {
+			if ate.GetIsOptional() {
+				continue
+			}
 			return nil, err
 		}
 		dev2Node2Port2Intfs[ate] = node2Port2Intfs
 	}
 
 	// Iterate over each of the possible testbed->topology combinations.
+	// Optional devices and ports may be absent, which is tried last so that
+	// the solution includes as many of them as possible.
 	dev2Nodes := make(map[interface{}][]interface{})
 	for dev, node2Port2Intfs := range dev2Node2Port2Intfs {
 		for node := range node2Port2Intfs {
 			dev2Nodes[dev] = append(dev2Nodes[dev], node)
 		}
+		if dev.GetIsOptional() {
+			dev2Nodes[dev] = append(dev2Nodes[dev], absent{dev})
+		}
 	}
 	dev2NodeChan := genCombos(dev2Nodes)
 	var hasNodeCombo bool
@@ -282,15 +330,22 @@ func (s *solver) solve() (*assign, error) {
 		hasNodeCombo = true
 		port2Intfs := make(map[interface{}][]interface{})
 		for dut, node := range dev2Node {
-			for port, intfs := range dev2Node2Port2Intfs[dut.(*opb.Device)][node.(*tpb.Node)] {
+			node, ok := node.(*tpb.Node)
+			if !ok {
+				continue
+			}
+			for port, intfs := range dev2Node2Port2Intfs[dut.(*opb.Device)][node] {
 				for _, i := range intfs {
 					port2Intfs[port] = append(port2Intfs[port], i)
 				}
+				if port.GetIsOptional() {
+					port2Intfs[port] = append(port2Intfs[port], absent{port})
+				}
 			}
 		}
 		port2IntfChan := genCombos(port2Intfs)
 		for port2Intf := range port2IntfChan {
-			if a := newAssign(dev2Node, port2Intf); s.linksMatch(a) {
+			if a := newAssign(dev2Node, port2Intf); s.portCountsMatch(a) && s.linksMatch(a) {
 				// TODO: When solver is rewritten, signal the gorouting
 				// channel to exit early here and avoid leaving the goroutine hanging.
 				// Not disastrous but ideally the goroutines would terminate here.
@@ -338,7 +393,7 @@ func (s *solver) devMatch(dev *opb.Device, node *tpb.Node) (bool, map[*opb.Port]
 	intfs := s.node2Intfs[node]
 	log.V(1).Infof("Interfaces: %v", intfs)
 	// If the device needs more ports than the node, this node cannot match.
-	if len(dev.GetPorts()) > len(intfs) {
+	if minPorts, _ := testbed.PortBounds(dev); minPorts > len(intfs) {
 		return false, nil
 	}
 	port2Infs := make(map[*opb.Port][]*intf)
@@ -350,7 +405,7 @@ func (s *solver) devMatch(dev *opb.Device, node *tpb.Node) (bool, map[*opb.Port]
 				infs = append(infs, intf)
 			}
 		}
-		if len(intfs) == 0 {
+		if len(infs) == 0 && !port.GetIsOptional() {
 			return false, nil
 		}
 		port2Infs[port] = infs
@@ -366,6 +421,14 @@ func (s *solver) portMatch(port *opb.Port, intf *intf) bool {
 	return true
 }
 
+// isOptional returns whether the device or the port at an end of a testbed
+// link, in the format "<device-id>:<port-id>", is optional.
+func (s *solver) isOptional(end string) bool {
+	parts := strings.SplitN(end, ":", 2)
+	dev := s.id2Dev[parts[0]]
+	return dev.GetIsOptional() || (len(parts) == 2 && s.dev2Ports[dev][parts[1]].GetIsOptional())
+}
+
 func (s *solver) linksMatch(a *assign) bool {
 	getIntf := func(tbLink string) *intf {
 		parts := strings.Split(tbLink, ":")
@@ -376,6 +439,11 @@ func (s *solver) linksMatch(a *assign) bool {
 	for _, link := range s.testbed.GetLinks() {
 		intfA := getIntf(link.GetA())
 		intfB := getIntf(link.GetB())
+		// Links to absent optional devices or ports are not reserved, but any
+		// other link must be connected.
+		if intfA == nil || intfB == nil {
+			continue
+		}
 		if s.intf2Intf[intfA] != intfB {
 			return false
 		}
@@ -383,6 +451,21 @@ func (s *solver) linksMatch(a *assign) bool {
 	return true
 }
 
+func (s *solver) portCountsMatch(a *assign) bool {
+	for dev := range a.dev2Node {
+		var numPorts int
+		for _, port := range dev.GetPorts() {
+			if _, ok := a.port2Intf[port]; ok {
+				numPorts++
+			}
+		}
+		if minPorts, maxPorts := testbed.PortBounds(dev); numPorts < minPorts || numPorts > maxPorts {
+			return false
+		}
+	}
+	return true
+}
+
 func hardwareModel(node *tpb.Node) string {
 	return tpb.Node_Type_name[int32(node.GetType())]
 }
@@ -437,10 +520,14 @@ func newAssign(dev2Node, port2Intf map[interface{}]interface{}) *assign {
 		port2Intf: make(map[*opb.Port]*intf),
 	}
 	for d, n := range dev2Node {
-		a.dev2Node[d.(*opb.Device)] = n.(*tpb.Node)
+		if n, ok := n.(*tpb.Node); ok {
+			a.dev2Node[d.(*opb.Device)] = n
+		}
 	}
 	for p, i := range port2Intf {
-		a.port2Intf[p.(*opb.Port)] = i.(*intf)
+		if i, ok := i.(*intf); ok {
+			a.port2Intf[p.(*opb.Port)] = i
+		}
 	}
 	return a
 }
@@ -47,3 +47,17 @@ func (l *LACP) WithEnabled(enabled bool) *LACP {
 	l.pb.Enabled = enabled
 	return l
 }
+
+// WithPassive sets whether the LAG members only respond to LACPDUs, rather than
+// initiating them.
+func (l *LACP) WithPassive(passive bool) *LACP {
+	l.pb.Passive = passive
+	return l
+}
+
+// WithSlowRate sets whether LACPDUs are sent at the slow rate of one every 30
+// seconds, rather than at the default rate of the ATE.
+func (l *LACP) WithSlowRate(slow bool) *LACP {
+	l.pb.SlowRate = slow
+	return l
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// LDP is a representation of an LDP config on the ATE.
+// LDP runs over the IPv4 address configured on the interface.
+type LDP struct {
+	pb *opb.LdpConfig
+}
+
+// WithLSRID sets the LSR ID of the emulated router, which must be an IPv4
+// address. The LSR ID is also used as the transport address of LDP sessions.
+func (l *LDP) WithLSRID(id string) *LDP {
+	l.pb.LsrId = id
+	return l
+}
+
+// WithLabelSpaceID sets the label space ID.
+func (l *LDP) WithLabelSpaceID(id uint32) *LDP {
+	l.pb.LabelSpaceId = id
+	return l
+}
+
+// WithHelloInterval sets the interval in seconds between link hello messages.
+func (l *LDP) WithHelloInterval(intervalSec uint32) *LDP {
+	l.pb.HelloIntervalSec = intervalSec
+	return l
+}
+
+// WithHelloHoldTime sets the hold time in seconds advertised in link hello
+// messages.
+func (l *LDP) WithHelloHoldTime(holdTimeSec uint32) *LDP {
+	l.pb.HelloHoldTimeSec = holdTimeSec
+	return l
+}
+
+// WithKeepaliveInterval sets the interval in seconds between keepalive
+// messages.
+func (l *LDP) WithKeepaliveInterval(intervalSec uint32) *LDP {
+	l.pb.KeepaliveIntervalSec = intervalSec
+	return l
+}
+
+// WithKeepaliveHoldTime sets the keepalive hold time in seconds of sessions.
+func (l *LDP) WithKeepaliveHoldTime(holdTimeSec uint32) *LDP {
+	l.pb.KeepaliveHoldTimeSec = holdTimeSec
+	return l
+}
+
+// WithBFDEnabled sets whether LDP registers with the BFD sessions configured on
+// the interface.
+func (l *LDP) WithBFDEnabled(enabled bool) *LDP {
+	l.pb.EnableBfd = enabled
+	return l
+}
+
+// LDPFEC is the LDP FEC config of a network, which binds labels to the IPv4
+// prefixes of the network.
+type LDPFEC struct {
+	pb *opb.LdpFec
+}
+
+// WithLabelStart sets the label bound to the first prefix of the network.
+func (f *LDPFEC) WithLabelStart(label uint32) *LDPFEC {
+	f.pb.LabelStart = label
+	return f
+}
+
+// WithLabelIncrement sets whether the label is incremented for each prefix of
+// the network. Otherwise, every prefix is bound to the same label.
+func (f *LDPFEC) WithLabelIncrement(increment bool) *LDPFEC {
+	f.pb.LabelIncrement = increment
+	return f
+}
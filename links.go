@@ -0,0 +1,194 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/openconfig/ondatra/internal/testbed"
+	"github.com/openconfig/ondatra/telemetry"
+	"github.com/openconfig/ygot/ygot"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+const (
+	// LinkIPv4PrefixLen is the prefix length of the IPv4 subnet of a DUT-ATE link.
+	LinkIPv4PrefixLen = 31
+	// LinkIPv6PrefixLen is the prefix length of the IPv6 subnet of a DUT-ATE link.
+	LinkIPv6PrefixLen = 127
+
+	// maxLinks is the number of /31 subnets in the IPv4 base range.
+	maxLinks = 1 << 16
+)
+
+// The subnets of the links are taken from the IPv4 benchmarking range of
+// RFC 2544, 198.18.0.0/15, and the IPv6 documentation range of RFC 3849.
+var (
+	linkIPv4Base = net.IPv4(198, 18, 0, 0).To4()
+	linkIPv6Base = net.ParseIP("2001:db8::")
+)
+
+// DUTATELink is a link between a port of a DUT and a port of an ATE, with an
+// IPv4 /31 and an IPv6 /127 subnet assigned to it.
+type DUTATELink struct {
+	DUTPort *Port
+	ATEPort *Port
+	// DUTIPv4 and ATEIPv4 are the IPv4 addresses of the ports, without the
+	// prefix length.
+	DUTIPv4, ATEIPv4 string
+	// DUTIPv6 and ATEIPv6 are the IPv6 addresses of the ports, without the
+	// prefix length.
+	DUTIPv6, ATEIPv6 string
+}
+
+func (l *DUTATELink) String() string {
+	return fmt.Sprintf("{dut: %s, ate: %s}", l.DUTPort, l.ATEPort)
+}
+
+// DUTInterface returns the OpenConfig config of the interface of the DUT port,
+// with the addresses of the link on its subinterface 0, for use with the
+// config APIs.
+func (l *DUTATELink) DUTInterface() *telemetry.Interface {
+	i := &telemetry.Interface{
+		Name:    ygot.String(l.DUTPort.Name()),
+		Type:    telemetry.IETFInterfaces_InterfaceType_ethernetCsmacd,
+		Enabled: ygot.Bool(true),
+	}
+	s := i.GetOrCreateSubinterface(0)
+	s.GetOrCreateIpv4().GetOrCreateAddress(l.DUTIPv4).PrefixLength = ygot.Uint8(LinkIPv4PrefixLen)
+	s.GetOrCreateIpv6().GetOrCreateAddress(l.DUTIPv6).PrefixLength = ygot.Uint8(LinkIPv6PrefixLen)
+	return i
+}
+
+// AddATEInterface adds an interface with the given name on the ATE port to
+// the topology, with the addresses of the link and the DUT addresses as its
+// default gateways.
+func (l *DUTATELink) AddATEInterface(top *ATETopology, name string) *Interface {
+	i := top.AddInterface(name).WithPort(l.ATEPort)
+	i.IPv4().
+		WithAddress(fmt.Sprintf("%s/%d", l.ATEIPv4, LinkIPv4PrefixLen)).
+		WithDefaultGateway(l.DUTIPv4)
+	i.IPv6().
+		WithAddress(fmt.Sprintf("%s/%d", l.ATEIPv6, LinkIPv6PrefixLen)).
+		WithDefaultGateway(l.DUTIPv6)
+	return i
+}
+
+// DUTATELinks returns the reserved links between the ports of the DUT and the
+// ports of the ATE, in the order of the DUT port IDs, with subnets assigned.
+// The subnets are assigned to all the links between DUTs and ATEs in the
+// testbed, in the order of their DUT and port IDs, so a link is assigned the
+// same subnet in every test that uses the same testbed.
+func DUTATELinks(t testing.TB, dut *DUTDevice, ate *ATEDevice) []*DUTATELink {
+	t.Helper()
+	tb, err := testbed.Testbed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	links, err := dutATELinks(tb, dut, ate)
+	if err != nil {
+		t.Fatalf("DUTATELinks(t, %s, %s): %v", dut.ID(), ate.ID(), err)
+	}
+	return links
+}
+
+func dutATELinks(tb *opb.Testbed, dut *DUTDevice, ate *ATEDevice) ([]*DUTATELink, error) {
+	dutIDs, ateIDs := make(map[string]bool), make(map[string]bool)
+	for _, d := range tb.GetDuts() {
+		dutIDs[d.GetId()] = true
+	}
+	for _, a := range tb.GetAtes() {
+		ateIDs[a.GetId()] = true
+	}
+	// Map each DUT port to the ATE port it is linked to, both in the format
+	// "<device-id>:<port-id>".
+	dutToATE := make(map[string]string)
+	for _, l := range tb.GetLinks() {
+		a, b := l.GetA(), l.GetB()
+		if ateIDs[deviceID(a)] {
+			a, b = b, a
+		}
+		if dutIDs[deviceID(a)] && ateIDs[deviceID(b)] {
+			dutToATE[a] = b
+		}
+	}
+	if len(dutToATE) > maxLinks {
+		return nil, errors.Errorf("testbed has %d DUT-ATE links, more than the %d that can be addressed", len(dutToATE), maxLinks)
+	}
+	dutPorts := make([]string, 0, len(dutToATE))
+	for p := range dutToATE {
+		dutPorts = append(dutPorts, p)
+	}
+	sort.Strings(dutPorts)
+
+	var links []*DUTATELink
+	for i, dp := range dutPorts {
+		ap := dutToATE[dp]
+		if deviceID(dp) != dut.ID() || deviceID(ap) != ate.ID() {
+			continue
+		}
+		dutPort, ok := dut.LookupPort(portID(dp))
+		if !ok {
+			continue
+		}
+		atePort, ok := ate.LookupPort(portID(ap))
+		if !ok {
+			continue
+		}
+		links = append(links, &DUTATELink{
+			DUTPort: dutPort,
+			ATEPort: atePort,
+			DUTIPv4: linkAddr(linkIPv4Base, 2*i),
+			ATEIPv4: linkAddr(linkIPv4Base, 2*i+1),
+			DUTIPv6: linkAddr(linkIPv6Base, 2*i),
+			ATEIPv6: linkAddr(linkIPv6Base, 2*i+1),
+		})
+	}
+	if len(links) == 0 {
+		return nil, errors.Errorf("no reserved links between DUT %s and ATE %s", dut.ID(), ate.ID())
+	}
+	return links, nil
+}
+
+// deviceID returns the device ID of a port in the format "<device-id>:<port-id>".
+func deviceID(port string) string {
+	return strings.SplitN(port, ":", 2)[0]
+}
+
+// portID returns the port ID of a port in the format "<device-id>:<port-id>".
+func portID(port string) string {
+	parts := strings.SplitN(port, ":", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// linkAddr returns the address at the given offset from the base address,
+// which must be a 4-byte IPv4 or 16-byte IPv6 address.
+func linkAddr(base net.IP, offset int) string {
+	ip := make(net.IP, len(base))
+	copy(ip, base)
+	low := ip[len(ip)-4:]
+	binary.BigEndian.PutUint32(low, binary.BigEndian.Uint32(low)+uint32(offset))
+	return ip.String()
+}
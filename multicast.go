@@ -0,0 +1,117 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// IGMPHost is a representation of an emulated IGMP host on an ATE interface.
+// As a flow destination endpoint, it represents the groups joined by the host.
+type IGMPHost struct {
+	pb       *opb.IgmpHostConfig
+	intfName string
+}
+
+// Implement the Endpoint marker interface.
+func (*IGMPHost) isEndpoint() {}
+
+// WithVersion2 sets the IGMP version to 2.
+func (h *IGMPHost) WithVersion2() *IGMPHost {
+	h.pb.Version = opb.IgmpHostConfig_V2
+	return h
+}
+
+// WithVersion3 sets the IGMP version to 3.
+func (h *IGMPHost) WithVersion3() *IGMPHost {
+	h.pb.Version = opb.IgmpHostConfig_V3
+	return h
+}
+
+// AddGroup adds a range of groups joined by the host, starting at the
+// specified multicast address.
+func (h *IGMPHost) AddGroup(address string) *MulticastGroup {
+	g := &opb.MulticastGroup{Address: address, Count: 1}
+	h.pb.Groups = append(h.pb.Groups, g)
+	return &MulticastGroup{pb: g}
+}
+
+// ClearGroups clears the groups of the host.
+func (h *IGMPHost) ClearGroups() *IGMPHost {
+	h.pb.Groups = nil
+	return h
+}
+
+// MLDHost is a representation of an emulated MLD host on an ATE interface.
+// As a flow destination endpoint, it represents the groups joined by the host.
+type MLDHost struct {
+	pb       *opb.MldHostConfig
+	intfName string
+}
+
+// Implement the Endpoint marker interface.
+func (*MLDHost) isEndpoint() {}
+
+// WithVersion1 sets the MLD version to 1.
+func (h *MLDHost) WithVersion1() *MLDHost {
+	h.pb.Version = opb.MldHostConfig_V1
+	return h
+}
+
+// WithVersion2 sets the MLD version to 2.
+func (h *MLDHost) WithVersion2() *MLDHost {
+	h.pb.Version = opb.MldHostConfig_V2
+	return h
+}
+
+// AddGroup adds a range of groups joined by the host, starting at the
+// specified multicast address.
+func (h *MLDHost) AddGroup(address string) *MulticastGroup {
+	g := &opb.MulticastGroup{Address: address, Count: 1}
+	h.pb.Groups = append(h.pb.Groups, g)
+	return &MulticastGroup{pb: g}
+}
+
+// ClearGroups clears the groups of the host.
+func (h *MLDHost) ClearGroups() *MLDHost {
+	h.pb.Groups = nil
+	return h
+}
+
+// MulticastGroup is a range of multicast groups joined by an IGMP or MLD host.
+type MulticastGroup struct {
+	pb *opb.MulticastGroup
+}
+
+// WithCount sets the number of groups in the range.
+func (g *MulticastGroup) WithCount(count uint32) *MulticastGroup {
+	g.pb.Count = count
+	return g
+}
+
+// WithSourceAddress sets the source to include for source-specific multicast,
+// which requires IGMPv3 or MLDv2.
+func (g *MulticastGroup) WithSourceAddress(address string) *MulticastGroup {
+	g.pb.SourceAddress = address
+	return g
+}
+
+// WithJoined sets whether the host has joined the groups.
+// Groups are joined by default. Use ATETopology.UpdateMulticastGroups to join
+// or leave groups while protocols are running.
+func (g *MulticastGroup) WithJoined(joined bool) *MulticastGroup {
+	g.pb.Left = !joined
+	return g
+}
@@ -137,3 +137,14 @@ func (ft *fakeT) Error(args ...interface{}) {
 
 // Helper implements the testing.TB Helper method as a noop.
 func (*fakeT) Helper() {}
+
+// Name implements the testing.TB Name method by delegating to the real *testing.T.
+func (ft *fakeT) Name() string {
+	return ft.realT.Name()
+}
+
+// Failed implements the testing.TB Failed method, reporting whether the
+// function under test raised an error.
+func (ft *fakeT) Failed() bool {
+	return ft.errs != nil
+}
@@ -179,11 +179,20 @@ func TestPanic(t *testing.T) {
 }
 
 func TestBenignMethods(t *testing.T) {
+	var gotName string
+	var gotFailed bool
 	ExpectFatal(t, func(t testing.TB) {
 		t.Helper()
 		t.Log("hello")
 		t.Logf("hello %v", "there")
+		gotName, gotFailed = t.Name(), t.Failed()
 		// Must fail to so that the test passes
 		t.FailNow()
 	})
+	if want := "TestBenignMethods"; gotName != want {
+		t.Errorf("Name() got %q, want %q", gotName, want)
+	}
+	if gotFailed {
+		t.Errorf("Failed() got true before any error, want false")
+	}
 }
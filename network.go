@@ -26,6 +26,14 @@ type Network struct {
 // Implement the Endpoint marker interface.
 func (*Network) isEndpoint() {}
 
+// WithAdvertised sets whether the routes in the network are advertised.
+// A network is advertised by default. Use ATETopology.UpdateNetworks to
+// withdraw or re-advertise the routes of a network while protocols are running.
+func (n *Network) WithAdvertised(advertised bool) *Network {
+	n.pb.Withdrawn = !advertised
+	return n
+}
+
 // Ethernet creates an Ethernet config for the network or returns the existing config.
 // The default count of MAC addresses in the network is 1.
 func (n *Network) Ethernet() *NetworkEthernet {
@@ -128,6 +136,16 @@ func (n *Network) BGP() *BGPAttributes {
 	return &BGPAttributes{pb: n.pb.BgpAttributes}
 }
 
+// LDP creates an LDP FEC config for the IPv4 prefixes of the network or returns
+// the existing config. The interface of the network must be configured with LDP.
+// By default, every prefix is bound to label 16.
+func (n *Network) LDP() *LDPFEC {
+	if n.pb.Ldp == nil {
+		n.pb.Ldp = &opb.LdpFec{LabelStart: 16}
+	}
+	return &LDPFEC{pb: n.pb.Ldp}
+}
+
 // ImportedBGPRoutes creates a BGP route import configuration for the network or
 // returns the existing config.
 func (n *Network) ImportedBGPRoutes() *ImportedBGPRoutes {
@@ -170,3 +188,109 @@ func (i *ImportedBGPRoutes) WithIPv6RoutesPath(path string) *ImportedBGPRoutes {
 	i.pb.Ipv6RoutesPath = path
 	return i
 }
+
+// GeneratedBGPRoutes creates a config for a synthetic BGP route table that is
+// generated for the network, such as a full internet table, or returns the
+// existing config. The generated routes are imported like the routes of
+// ImportedBGPRoutes, without the need for route table files. By default, the
+// AS path of each route consists of 2 to 6 ASNs chosen at random between 1 and
+// 64495.
+func (n *Network) GeneratedBGPRoutes() *GeneratedBGPRoutes {
+	if n.pb.GeneratedBgpRoutes == nil {
+		n.pb.GeneratedBgpRoutes = &opb.GeneratedBgpRoutes{
+			AsPathMinLength: 2,
+			AsPathMaxLength: 6,
+			AsnMin:          1,
+			AsnMax:          64495,
+		}
+	}
+	return &GeneratedBGPRoutes{pb: n.pb.GeneratedBgpRoutes}
+}
+
+// GeneratedBGPRoutes is the config for generating a synthetic route table for a
+// network.
+type GeneratedBGPRoutes struct {
+	pb *opb.GeneratedBgpRoutes
+}
+
+// IPv4 creates a config for the generated IPv4 prefixes or returns the existing
+// config. By default, prefixes are allocated from 1.0.0.0, with a distribution
+// of prefix lengths from /16 to /24 similar to that of the internet table.
+func (g *GeneratedBGPRoutes) IPv4() *GeneratedBGPPrefixes {
+	if g.pb.Ipv4 == nil {
+		g.pb.Ipv4 = &opb.GeneratedBgpRoutes_Prefixes{
+			StartAddress: "1.0.0.0",
+			PrefixLengthWeights: map[uint32]uint32{
+				16: 1, 17: 1, 18: 1, 19: 2, 20: 4, 21: 4, 22: 11, 23: 10, 24: 66,
+			},
+		}
+	}
+	return &GeneratedBGPPrefixes{pb: g.pb.Ipv4}
+}
+
+// IPv6 creates a config for the generated IPv6 prefixes or returns the existing
+// config. By default, prefixes are allocated from 2400::, with a distribution
+// of prefix lengths from /29 to /48 similar to that of the internet table.
+func (g *GeneratedBGPRoutes) IPv6() *GeneratedBGPPrefixes {
+	if g.pb.Ipv6 == nil {
+		g.pb.Ipv6 = &opb.GeneratedBgpRoutes_Prefixes{
+			StartAddress: "2400::",
+			PrefixLengthWeights: map[uint32]uint32{
+				29: 3, 32: 12, 36: 4, 40: 6, 44: 9, 46: 3, 47: 2, 48: 61,
+			},
+		}
+	}
+	return &GeneratedBGPPrefixes{pb: g.pb.Ipv6}
+}
+
+// WithASPathLength sets the range of the number of ASNs in the AS path of each
+// generated route.
+func (g *GeneratedBGPRoutes) WithASPathLength(min, max uint32) *GeneratedBGPRoutes {
+	g.pb.AsPathMinLength = min
+	g.pb.AsPathMaxLength = max
+	return g
+}
+
+// WithASNRange sets the range of the randomly chosen ASNs in the AS paths.
+func (g *GeneratedBGPRoutes) WithASNRange(min, max uint32) *GeneratedBGPRoutes {
+	g.pb.AsnMin = min
+	g.pb.AsnMax = max
+	return g
+}
+
+// WithSeed sets the seed of the random AS paths. The same config with the same
+// seed always generates the same route table.
+func (g *GeneratedBGPRoutes) WithSeed(seed int64) *GeneratedBGPRoutes {
+	g.pb.Seed = seed
+	return g
+}
+
+// GeneratedBGPPrefixes is the config for the prefixes of a generated route
+// table. Prefixes are allocated consecutively from the start address, from the
+// shortest prefix length to the longest, so they never overlap.
+type GeneratedBGPPrefixes struct {
+	pb *opb.GeneratedBgpRoutes_Prefixes
+}
+
+// WithStartAddress sets the address from which prefixes are allocated.
+func (p *GeneratedBGPPrefixes) WithStartAddress(address string) *GeneratedBGPPrefixes {
+	p.pb.StartAddress = address
+	return p
+}
+
+// WithCount sets the total number of generated prefixes.
+func (p *GeneratedBGPPrefixes) WithCount(count uint32) *GeneratedBGPPrefixes {
+	p.pb.Count = count
+	return p
+}
+
+// WithPrefixLengthWeights replaces the distribution of prefix lengths with the
+// specified relative weights, keyed by prefix length. For example, {22: 1,
+// 24: 3} generates a quarter of the prefixes as /22s and the rest as /24s.
+func (p *GeneratedBGPPrefixes) WithPrefixLengthWeights(weights map[uint32]uint32) *GeneratedBGPPrefixes {
+	p.pb.PrefixLengthWeights = make(map[uint32]uint32)
+	for l, w := range weights {
+		p.pb.PrefixLengthWeights[l] = w
+	}
+	return p
+}
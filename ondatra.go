@@ -16,12 +16,15 @@
 package ondatra
 
 import (
+	"golang.org/x/net/context"
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -31,17 +34,29 @@ import (
 	"github.com/openconfig/ondatra/internal/closer"
 	"golang.org/x/sys/unix"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/ate"
+	"github.com/openconfig/ondatra/internal/diag"
+	"github.com/openconfig/ondatra/internal/events"
 	"github.com/openconfig/ondatra/internal/flags"
+	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
+	"github.com/openconfig/ondatra/internal/gnmilog"
+	"github.com/openconfig/ondatra/internal/report"
 	"github.com/openconfig/ondatra/internal/testbed"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	opb "github.com/openconfig/ondatra/proto"
 )
 
 var (
-	sigc        = make(chan os.Signal, 1)
-	reserveFn   = reserve
-	releaseFn   = release
-	runTestsFn  = (*fixture).runTests
-	flagParseFn = flags.Parse
-	initBindFn  = testbed.InitBind
+	sigc           = make(chan os.Signal, 1)
+	reserveFn      = reserve
+	reserveTBFn    = reserveTestbed
+	releaseFn      = release
+	discoverFn     = discover
+	runTestsFn     = (*fixture).runTests
+	flagParseFn    = flags.Parse
+	initBindFn     = testbed.InitBind
+	stopActivityFn = stopActivity
 )
 
 // Binder creates the binding for the test.
@@ -51,23 +66,57 @@ type Binder func() (binding.Binding, error)
 // initialized with a baseline configuration that allows it to be managed.
 func RunTests(m *testing.M, binder Binder) {
 	// Careful to only exit at the very end, because exiting skips all pending defers.
-	if err := doRun(m, binder); err != nil {
+	if err := doRun(m, binder, nil); err != nil {
 		log.Exit(err)
 	}
 }
 
-func doRun(m *testing.M, binder Binder) (rerr error) {
+// RunTestsWithTestbed is like RunTests, but acquires the specified testbed
+// instead of the testbed in the file of the -testbed flag, which must not be
+// set. This allows harnesses that generate topologies on the fly to build the
+// testbed programmatically.
+func RunTestsWithTestbed(m *testing.M, binder Binder, tb *opb.Testbed) {
+	// Careful to only exit at the very end, because exiting skips all pending defers.
+	if err := doRun(m, binder, tb); err != nil {
+		log.Exit(err)
+	}
+}
+
+func doRun(m *testing.M, binder Binder, tb *opb.Testbed) (rerr error) {
 	fv, err := flagParseFn()
 	if err != nil {
 		return err
 	}
+	if tb != nil && fv.TestbedPath != "" {
+		return fmt.Errorf("testbed path %s specified for a programmatic testbed", fv.TestbedPath)
+	}
+	diagDir = fv.DiagDir
+	if fv.DiagDir != "" {
+		paths, err := diag.ParsePaths(fv.DiagPaths)
+		if err != nil {
+			return err
+		}
+		events.AddFailureListener(diagListener(fv.DiagDir, paths))
+	}
+	ate.SetConfigDir(fv.ATEConfigDir)
+	if fv.GNMILogDir != "" {
+		gnmilog.Enable(fv.GNMILogDir, fv.GNMILogMax)
+		defer closer.Close(&rerr, gnmilog.Disable, "error closing gNMI logs")
+	}
+	genutil.SetDefaultRetryPolicy(&genutil.RetryPolicy{MaxRetries: fv.GNMIRetries, Backoff: fv.GNMIBackoff})
+	gnmiKeepalive = fv.GNMIKeepalive
 	b, err := binder()
 	if err != nil {
 		return fmt.Errorf("failed to create binding: %w", err)
 	}
 	initBindFn(b)
 	fmt.Println(actionMsg("Reserving the testbed"))
-	if err := reserveFn(fv); err != nil {
+	if tb == nil {
+		err = reserveFn(fv)
+	} else {
+		err = reserveTBFn(tb, fv)
+	}
+	if err != nil {
 		return err
 	}
 	go fnAfterSignal(releaseFn, unix.SIGINT, unix.SIGTERM)
@@ -75,7 +124,25 @@ func doRun(m *testing.M, binder Binder) (rerr error) {
 		fmt.Println(actionMsg("Releasing the testbed"))
 		return releaseFn()
 	}, "error releasing testbed")
-	runTestsFn(new(fixture), m, fv.RunTime)
+	if fv.DiscoverCaps {
+		fmt.Println(actionMsg("Discovering the DUT capabilities"))
+		discoverFn()
+	}
+	if fv.ReportDir != "" {
+		res, err := testbed.Reservation()
+		if err != nil {
+			return err
+		}
+		report.Enable(res.ID)
+		if fv.Compliance {
+			report.EnableViolations()
+		}
+		defer closer.Close(&rerr, func() error {
+			fmt.Println(actionMsg("Writing the test report to " + fv.ReportDir))
+			return report.Write(fv.ReportDir)
+		}, "error writing test report")
+	}
+	runTestsFn(&fixture{testTime: fv.TestTime, cleanupTime: fv.CleanupTime}, m, fv.RunTime)
 	return nil
 }
 
@@ -94,6 +161,18 @@ type fixture struct {
 	mu        sync.Mutex
 	earlyFail bool
 	fatalFn   func(args ...interface{})
+	// ended is whether the tests have ended, so fatalFn must not be called.
+	ended bool
+	// current is the running test, if any.
+	current testing.TB
+
+	// testTime is the timeout of each test, or zero if there is none.
+	testTime time.Duration
+	// cleanupTime is the time before the deadline of the run at which the run
+	// ends gracefully, or zero if it does not.
+	cleanupTime time.Duration
+	cleanupTmr  *time.Timer
+	releaseTmr  *time.Timer
 }
 
 func (f *fixture) runTests(m *testing.M, timeout time.Duration) {
@@ -104,7 +183,20 @@ func (f *fixture) runTests(m *testing.M, timeout time.Duration) {
 		fn := *fnPtr
 		*fnPtr = func(t *testing.T) {
 			f.testStarted(t, timeout)
+			defer f.testEnded()
+			defer f.watchTest(t)()
 			testbed.Bind().SetTestMetadata(&binding.TestMetadata{TestName: t.Name()})
+			report.TestStarted(t)
+			gnmilog.TestStarted(t.Name())
+			events.TestStarted(t)
+			defer func() {
+				if t.Failed() {
+					events.TestFailed(t, reservedDevices())
+				}
+				events.TestEnded(t)
+				gnmilog.TestEnded()
+				report.TestEnded(t)
+			}()
 			defer func() {
 				if r := recover(); r != nil {
 					f.failEarly(fmt.Sprintf("Ondatra test panicked: %v, stack :%s", r, debug.Stack()))
@@ -114,6 +206,58 @@ func (f *fixture) runTests(m *testing.M, timeout time.Duration) {
 		}
 	}
 	m.Run()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ended = true
+	if f.cleanupTmr != nil {
+		f.cleanupTmr.Stop()
+		f.releaseTmr.Stop()
+	}
+}
+
+// diagDir is the directory of the diagnostics of the tests, if any.
+var diagDir string
+
+// diagTimeout is the maximum time to collect the diagnostic bundle of a DUT.
+const diagTimeout = 2 * time.Minute
+
+// diagListener returns a failure listener that writes a diagnostic bundle of
+// every reserved DUT to a subdirectory of dir named after the test and the DUT.
+func diagListener(dir string, paths []*gpb.Path) func(testing.TB, []events.Device) {
+	return func(t testing.TB, devs []events.Device) {
+		t.Helper()
+		for _, d := range devs {
+			dut, ok := d.(*DUTDevice)
+			if !ok {
+				continue
+			}
+			dutDir := filepath.Join(dir, t.Name(), dut.ID())
+			t.Log(actionMsg(fmt.Sprintf("Collecting diagnostics of %s in %s", dut.Name(), dutDir)))
+			ctx, cancel := context.WithTimeout(context.Background(), diagTimeout)
+			err := diag.Collect(ctx, dutDir, dut.res.(*binding.DUT), dut.clientFn, paths)
+			cancel()
+			if err != nil {
+				t.Logf("Failed to collect diagnostics of %s: %v", dut, err)
+			}
+		}
+	}
+}
+
+// reservedDevices returns the handles of all reserved devices, sorted by ID.
+func reservedDevices() []events.Device {
+	res, err := testbed.Reservation()
+	if err != nil {
+		return nil
+	}
+	var devs []events.Device
+	for id, d := range res.DUTs {
+		devs = append(devs, newDUT(id, d))
+	}
+	for id, a := range res.ATEs {
+		devs = append(devs, newATE(id, a))
+	}
+	sort.Slice(devs, func(i, j int) bool { return devs[i].ID() < devs[j].ID() })
+	return devs
 }
 
 func (f *fixture) testStarted(t *testing.T, timeout time.Duration) {
@@ -122,26 +266,126 @@ func (f *fixture) testStarted(t *testing.T, timeout time.Duration) {
 	if f.earlyFail {
 		t.SkipNow()
 	}
-	// Wait until the first testcase to start the timer, so fatalFn is definitely set.
-	if timeout > 0 && f.fatalFn == nil {
-		go func() {
-			time.Sleep(timeout)
-			f.failEarly(fmt.Sprintf("Ondatra test timed out after %v", timeout))
-		}()
+	// Wait until the first testcase to start the timers, so fatalFn is definitely set.
+	if f.fatalFn == nil {
+		if timeout > 0 {
+			go func() {
+				time.Sleep(timeout)
+				stopActivityFn()
+				f.failEarly(fmt.Sprintf("Ondatra test timed out after %v", timeout))
+			}()
+		}
+		// The deadline of the -test.timeout flag only starts with the tests.
+		if deadline, ok := t.Deadline(); ok && f.cleanupTime > 0 {
+			f.scheduleCleanup(deadline)
+		}
 	}
 	f.fatalFn = t.Fatal
+	f.current = t
+}
+
+func (f *fixture) testEnded() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.current = nil
+}
+
+// watchTest fails the test and stops the activity on the testbed if the test
+// exceeds the timeout of each test. It returns a function to call when the
+// test ends.
+func (f *fixture) watchTest(t testing.TB) func() {
+	if f.testTime <= 0 {
+		return func() {}
+	}
+	var mu sync.Mutex
+	var ended bool
+	timer := time.AfterFunc(f.testTime, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if ended {
+			return
+		}
+		t.Errorf("Ondatra test timed out after %v", f.testTime)
+		stopActivityFn()
+	})
+	return func() {
+		timer.Stop()
+		mu.Lock()
+		defer mu.Unlock()
+		ended = true
+	}
+}
+
+// scheduleCleanup schedules the cleanup of the run before the deadline of the
+// -test.timeout flag and, as a last resort, the release of the testbed just
+// before the deadline. The cleanup leaves the tests at least half of the time
+// until the deadline.
+func (f *fixture) scheduleCleanup(deadline time.Time) {
+	if half := time.Until(deadline) / 2; f.cleanupTime > half {
+		f.cleanupTime = half
+	}
+	f.cleanupTmr = time.AfterFunc(time.Until(deadline.Add(-f.cleanupTime)), f.cleanup)
+	f.releaseTmr = time.AfterFunc(time.Until(deadline.Add(-f.cleanupTime/4)), f.release)
+}
+
+// cleanup winds the run down before the deadline of the -test.timeout flag:
+// it stops the activity on the testbed, fails the running test and skips the
+// remaining tests, so the run ends and releases the testbed before the test
+// binary panics. The activity is stopped without holding the lock of the
+// fixture, so that the running test can end meanwhile.
+func (f *fixture) cleanup() {
+	f.mu.Lock()
+	f.earlyFail = true
+	f.mu.Unlock()
+	log.Warningf("Ondatra test run within %v of its deadline, cleaning up", f.cleanupTime)
+	stopActivityFn()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.current != nil {
+		f.current.Errorf("Ondatra test run stopped %v before its -test.timeout deadline", f.cleanupTime)
+	}
+}
+
+// release releases the testbed just before the deadline of the -test.timeout
+// flag, if the run has not ended despite the cleanup, because the test binary
+// panics at the deadline without releasing it.
+func (f *fixture) release() {
+	f.mu.Lock()
+	ended := f.ended
+	f.mu.Unlock()
+	if ended {
+		return
+	}
+	log.Warningf("Ondatra test run did not end before its deadline, releasing the testbed")
+	if err := releaseFn(); err != nil {
+		log.Errorf("error releasing testbed: %v", err)
+	}
+}
+
+// stopActivity stops the traffic on the ATEs and closes the gNMI subscriptions,
+// so that a test that is timing out stops using the testbed.
+func stopActivity() {
+	if err := ate.StopAllTraffic(context.Background()); err != nil {
+		log.Errorf("error stopping traffic: %v", err)
+	}
+	genutil.CloseSubscriptions()
 }
 
 func (f *fixture) failEarly(msg string) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	f.earlyFail = true
-	f.fatalFn(msg)
+	if !f.ended {
+		f.fatalFn(msg)
+	}
 }
 
 func logAction(t testing.TB, format string, dev binding.Device) {
 	t.Helper()
-	t.Log(actionMsg(fmt.Sprintf(format, dev.Dimensions().Name)))
+	name := dev.Dimensions().Name
+	msg := fmt.Sprintf(format, name)
+	report.Begin(t, name, msg)
+	t.Log(actionMsg(msg))
 }
 
 func actionMsg(msg string) string {
@@ -15,30 +15,44 @@
 package ondatra
 
 import (
+	"golang.org/x/net/context"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/diag"
 	"github.com/openconfig/ondatra/internal/flags"
+	"github.com/openconfig/ondatra/internal/report"
+	"github.com/openconfig/ondatra/internal/testbed"
+	"github.com/openconfig/ondatra/negtest"
+
+	hpb "github.com/openconfig/gnoi/healthz"
+	opb "github.com/openconfig/ondatra/proto"
 )
 
 func TestReserveOnRun(t *testing.T) {
-	flagParseFn = func() (*flags.Values, error) {
-		return &flags.Values{}, nil
-	}
 	origRunTests := runTestsFn
 	defer func() {
 		reserveFn = reserve
 		releaseFn = release
+		discoverFn = discover
 		runTestsFn = origRunTests
+		flagParseFn = flags.Parse
 	}()
 	tests := []struct {
 		desc                  string
 		sig                   os.Signal
 		reservErr, releaseErr error
+		discoverCaps          bool
 		wantErr               string
 	}{{
 		desc:      "error on reserve",
@@ -53,9 +67,20 @@ func TestReserveOnRun(t *testing.T) {
 		sig:  os.Interrupt,
 	}, {
 		desc: "release on test completion",
+	}, {
+		desc:         "discover capabilities",
+		discoverCaps: true,
+	}, {
+		desc:         "no discovery on reserve error",
+		reservErr:    errors.New("error reserving testbed"),
+		discoverCaps: true,
+		wantErr:      "error reserving testbed",
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
+			flagParseFn = func() (*flags.Values, error) {
+				return &flags.Values{DiscoverCaps: test.discoverCaps}, nil
+			}
 			reserveFn = func(*flags.Values) error {
 				return test.reservErr
 			}
@@ -73,6 +98,10 @@ func TestReserveOnRun(t *testing.T) {
 				}
 				return releaseErr
 			}
+			var discovered bool
+			discoverFn = func() {
+				discovered = true
+			}
 			runTestsFn = func(*fixture, *testing.M, time.Duration) {
 				if test.sig != nil {
 					sigc <- test.sig
@@ -83,13 +112,16 @@ func TestReserveOnRun(t *testing.T) {
 				initBindCalled = true
 			}
 			fakeBinder := func() (binding.Binding, error) { return nil, nil }
-			if gotErr := doRun(nil, fakeBinder); (gotErr != nil) != (test.wantErr != "") {
+			if gotErr := doRun(nil, fakeBinder, nil); (gotErr != nil) != (test.wantErr != "") {
 				t.Fatalf("doRun: got err %v, wanted err? %t", gotErr, test.wantErr != "")
 			}
 			if !initBindCalled {
 				t.Errorf("doRun did not initialize the binding")
 			}
 			wantReleased := test.reservErr == nil
+			if wantDiscovered := test.discoverCaps && wantReleased; discovered != wantDiscovered {
+				t.Errorf("doRun: capabilities discovered? %t, want %t", discovered, wantDiscovered)
+			}
 			if wantReleased {
 				<-releaseCh
 			}
@@ -101,3 +133,281 @@ func TestReserveOnRun(t *testing.T) {
 		})
 	}
 }
+
+func TestReserveTestbedOnRun(t *testing.T) {
+	origRunTests := runTestsFn
+	defer func() {
+		flagParseFn = flags.Parse
+		reserveFn = reserve
+		reserveTBFn = reserveTestbed
+		releaseFn = release
+		discoverFn = discover
+		runTestsFn = origRunTests
+	}()
+	reserveFn = func(*flags.Values) error {
+		return errors.New("reserved the testbed file")
+	}
+	var gotTB *opb.Testbed
+	reserveTBFn = func(tb *opb.Testbed, _ *flags.Values) error {
+		gotTB = tb
+		return nil
+	}
+	releaseFn = func() error { return nil }
+	discoverFn = func() {}
+	runTestsFn = func(*fixture, *testing.M, time.Duration) {}
+	initBindFn = func(binding.Binding) {}
+	fakeBinder := func() (binding.Binding, error) { return nil, nil }
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+
+	flagParseFn = func() (*flags.Values, error) {
+		return &flags.Values{}, nil
+	}
+	if err := doRun(nil, fakeBinder, tb); err != nil {
+		t.Fatalf("doRun: got err %v", err)
+	}
+	if gotTB != tb {
+		t.Errorf("doRun: reserved testbed %v, want %v", gotTB, tb)
+	}
+
+	flagParseFn = func() (*flags.Values, error) {
+		return &flags.Values{TestbedPath: "testbed.textproto"}, nil
+	}
+	if err := doRun(nil, fakeBinder, tb); err == nil {
+		t.Errorf("doRun with testbed path and programmatic testbed: got no error")
+	}
+}
+
+func TestReservedDevices(t *testing.T) {
+	initFakeBinding(t)
+	reserveFakeTestbed(t)
+	var got []string
+	for _, d := range reservedDevices() {
+		got = append(got, d.ID())
+	}
+	want := []string{"ate", "dut", "dut_cisco", "dut_juniper"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("reservedDevices() got unexpected diff (-want,+got): %s", diff)
+	}
+	if _, ok := reservedDevices()[0].(*ATEDevice); !ok {
+		t.Errorf("reservedDevices() got %T, want *ATEDevice", reservedDevices()[0])
+	}
+}
+
+type fakeHealthzClient struct {
+	binding.GNOIClients
+	hpb.HealthzClient
+	req    *hpb.GetRequest
+	status *hpb.ComponentStatus
+}
+
+func (c *fakeHealthzClient) Healthz() hpb.HealthzClient {
+	return c
+}
+
+func (c *fakeHealthzClient) Get(_ context.Context, req *hpb.GetRequest, _ ...grpc.CallOption) (*hpb.GetResponse, error) {
+	c.req = req
+	if c.status == nil {
+		return nil, errors.New("no healthz")
+	}
+	return &hpb.GetResponse{Component: c.status}, nil
+}
+
+func TestDiagListener(t *testing.T) {
+	initOperationFakes(t)
+	fakeGNOI.GNOIClients = &fakeHealthzClient{}
+	defer func() { fakeGNOI.GNOIClients = nil }()
+	fakeBind.ConsoleLogStreamer = func(context.Context, *binding.DUT) (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader("console logs")), nil
+	}
+	dir := t.TempDir()
+	diagListener(dir, nil)(t, reservedDevices())
+	for _, id := range []string{"dut", "dut_cisco", "dut_juniper"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, t.Name(), id, diag.ConsoleFile))
+		if err != nil {
+			t.Fatalf("diagListener() did not write the bundle of %s: %v", id, err)
+		}
+		if got, want := string(data), "console logs"; got != want {
+			t.Errorf("diagListener() got console logs %q for %s, want %q", got, id, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, t.Name(), "ate")); !os.IsNotExist(err) {
+		t.Errorf("diagListener() got bundle of ATE, want none")
+	}
+}
+
+func TestReportOnRun(t *testing.T) {
+	initFakeBinding(t)
+	origRunTests := runTestsFn
+	defer func() {
+		flagParseFn = flags.Parse
+		reserveFn = reserve
+		releaseFn = release
+		discoverFn = discover
+		runTestsFn = origRunTests
+		initBindFn = testbed.InitBind
+	}()
+	dir := t.TempDir()
+	flagParseFn = func() (*flags.Values, error) {
+		return &flags.Values{TestbedPath: fakeTBPath, ReportDir: dir}, nil
+	}
+	initBindFn = func(binding.Binding) {}
+	discoverFn = func() {}
+	runTestsFn = func(*fixture, *testing.M, time.Duration) {}
+	fakeBinder := func() (binding.Binding, error) { return nil, nil }
+	if err := doRun(nil, fakeBinder, nil); err != nil {
+		t.Fatalf("doRun: got err %v", err)
+	}
+	for _, file := range []string{report.JSONFile, report.JUnitFile} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("doRun: report file %s not written: %v", file, err)
+		}
+	}
+}
+
+func TestFixtureOnRun(t *testing.T) {
+	origRunTests := runTestsFn
+	defer func() {
+		flagParseFn = flags.Parse
+		reserveFn = reserve
+		releaseFn = release
+		discoverFn = discover
+		runTestsFn = origRunTests
+		initBindFn = testbed.InitBind
+	}()
+	flagParseFn = func() (*flags.Values, error) {
+		return &flags.Values{TestTime: time.Minute, CleanupTime: time.Second}, nil
+	}
+	reserveFn = func(*flags.Values) error { return nil }
+	releaseFn = func() error { return nil }
+	discoverFn = func() {}
+	initBindFn = func(binding.Binding) {}
+	var got *fixture
+	runTestsFn = func(f *fixture, _ *testing.M, _ time.Duration) {
+		got = f
+	}
+	fakeBinder := func() (binding.Binding, error) { return nil, nil }
+	if err := doRun(nil, fakeBinder, nil); err != nil {
+		t.Fatalf("doRun: got err %v", err)
+	}
+	if got.testTime != time.Minute || got.cleanupTime != time.Second {
+		t.Errorf("doRun: got fixture times %v and %v, want %v and %v", got.testTime, got.cleanupTime, time.Minute, time.Second)
+	}
+}
+
+func TestFixtureCleanup(t *testing.T) {
+	defer func() { stopActivityFn = stopActivity }()
+	f := &fixture{cleanupTime: time.Minute}
+	var lockedOnStop bool
+	stopActivityFn = func() {
+		// The running test must be able to end while the activity is stopped.
+		if lockedOnStop = !f.mu.TryLock(); !lockedOnStop {
+			f.mu.Unlock()
+		}
+	}
+	errs := negtest.ExpectError(t, func(t testing.TB) {
+		f.current = t
+		f.cleanup()
+	})
+	if lockedOnStop {
+		t.Errorf("cleanup() stopped the activity with the fixture locked")
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0], "-test.timeout deadline") {
+		t.Errorf("cleanup() got errors %q, want one about the deadline", errs)
+	}
+	if !f.earlyFail {
+		t.Errorf("cleanup() did not skip the remaining tests")
+	}
+
+	// Without a running test, there is no test to fail.
+	f = &fixture{cleanupTime: time.Minute}
+	f.cleanup()
+	if !f.earlyFail {
+		t.Errorf("cleanup() without a running test did not skip the remaining tests")
+	}
+}
+
+func TestFixtureScheduleCleanup(t *testing.T) {
+	defer func() { stopActivityFn = stopActivity }()
+	stopActivityFn = func() {}
+	tests := []struct {
+		desc            string
+		cleanupTime     time.Duration
+		untilDeadline   time.Duration
+		wantCleanupTime time.Duration
+	}{{
+		desc:            "cleanup time",
+		cleanupTime:     time.Minute,
+		untilDeadline:   time.Hour,
+		wantCleanupTime: time.Minute,
+	}, {
+		desc:            "short deadline",
+		cleanupTime:     time.Minute,
+		untilDeadline:   time.Minute,
+		wantCleanupTime: 30 * time.Second,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := &fixture{cleanupTime: tt.cleanupTime}
+			f.scheduleCleanup(time.Now().Add(tt.untilDeadline))
+			defer f.cleanupTmr.Stop()
+			defer f.releaseTmr.Stop()
+			// Allow for the time elapsed since the deadline was computed.
+			if got := f.cleanupTime; got > tt.wantCleanupTime || got < tt.wantCleanupTime-time.Second {
+				t.Errorf("scheduleCleanup() got cleanup time %v, want %v", got, tt.wantCleanupTime)
+			}
+		})
+	}
+}
+
+func TestFixtureRelease(t *testing.T) {
+	defer func() { releaseFn = release }()
+	var released bool
+	releaseFn = func() error {
+		released = true
+		return nil
+	}
+	f := &fixture{}
+	f.release()
+	if !released {
+		t.Errorf("release() did not release the testbed of a run that has not ended")
+	}
+
+	released = false
+	f = &fixture{ended: true}
+	f.release()
+	if released {
+		t.Errorf("release() released the testbed of a run that has ended")
+	}
+}
+
+func TestFailEarlyAfterTests(t *testing.T) {
+	var fatals []string
+	f := &fixture{
+		ended: true,
+		fatalFn: func(args ...interface{}) {
+			fatals = append(fatals, args[0].(string))
+		},
+	}
+	f.failEarly("after the tests")
+	if len(fatals) != 0 {
+		t.Errorf("failEarly() after the tests ended got fatal messages %q, want none", fatals)
+	}
+}
+
+func TestWatchTest(t *testing.T) {
+	f := &fixture{testTime: time.Millisecond}
+	errs := negtest.ExpectError(t, func(t testing.TB) {
+		end := f.watchTest(t)
+		time.Sleep(100 * time.Millisecond)
+		end()
+	})
+	if len(errs) != 1 || !strings.Contains(errs[0], "timed out") {
+		t.Errorf("watchTest() got errors %q, want one timeout", errs)
+	}
+
+	// A test that ends in time must not fail.
+	f = &fixture{testTime: time.Hour}
+	f.watchTest(t)()
+	f = &fixture{}
+	f.watchTest(t)()
+}
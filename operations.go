@@ -15,23 +15,40 @@
 package ondatra
 
 import (
+	"bytes"
 	"golang.org/x/net/context"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/openconfig/ondatra/internal/closer"
+	"github.com/pkg/errors"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/artifacts"
+	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
 	"github.com/openconfig/ondatra/internal/operations"
+	"github.com/openconfig/ondatra/telemetry/device"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	hpb "github.com/openconfig/gnoi/healthz"
 	spb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 )
 
 // Operations is the device operations API.
 type Operations struct {
-	dev binding.Device
+	id        string
+	dev       binding.Device
+	telemetry func() *device.DevicePath
 }
 
 // NewInstall creates a new install operation.
@@ -41,10 +58,11 @@ func (o *Operations) NewInstall() *InstallOp {
 
 // InstallOp is an OS install operation.
 type InstallOp struct {
-	dev     binding.Device
-	version string
-	standby bool
-	reader  io.Reader
+	dev      binding.Device
+	version  string
+	standby  bool
+	reader   io.Reader
+	progress func(uint64)
 }
 
 func (i *InstallOp) String() string {
@@ -80,6 +98,14 @@ func (i *InstallOp) WithPackageFile(path string) *InstallOp {
 	return i.WithPackageReader(&fileReader{path: path})
 }
 
+// WithProgressFunc specifies a function that is called with the number of
+// bytes of the package received by the device whenever the device reports the
+// progress of the transfer.
+func (i *InstallOp) WithProgressFunc(progress func(bytesReceived uint64)) *InstallOp {
+	i.progress = progress
+	return i
+}
+
 type fileReader struct {
 	path string
 	file *os.File
@@ -104,11 +130,112 @@ func (fr *fileReader) Read(p []byte) (int, error) {
 func (i *InstallOp) Operate(t testing.TB) {
 	t.Helper()
 	logAction(t, "Installing package on %s", i.dev)
-	if err := operations.Install(context.Background(), i.dev, i.version, i.standby, i.reader); err != nil {
+	if err := operations.Install(context.Background(), i.dev, i.version, i.standby, i.reader, i.progress); err != nil {
 		t.Fatalf("Operate(t) on %s: %v", i, err)
 	}
 }
 
+// NewActivate creates a new OS activate operation.
+// By default the device reboots into the activated version.
+func (o *Operations) NewActivate() *ActivateOp {
+	return &ActivateOp{dev: o.dev}
+}
+
+// ActivateOp is an OS activate operation.
+type ActivateOp struct {
+	dev      binding.Device
+	version  string
+	standby  bool
+	noReboot bool
+}
+
+func (a *ActivateOp) String() string {
+	return fmt.Sprintf("ActivateOp%+v", *a)
+}
+
+// WithVersion specifies the version of the activate operation.
+func (a *ActivateOp) WithVersion(version string) *ActivateOp {
+	a.version = version
+	return a
+}
+
+// WithStandbySupervisor specifies whether the activation applies to the
+// Standby Supervisor instead of the Active Supervisor.
+func (a *ActivateOp) WithStandbySupervisor(standby bool) *ActivateOp {
+	a.standby = standby
+	return a
+}
+
+// WithNoReboot specifies whether to skip the reboot into the activated version,
+// in which case the version is booted on the next reboot of the device.
+func (a *ActivateOp) WithNoReboot(noReboot bool) *ActivateOp {
+	a.noReboot = noReboot
+	return a
+}
+
+// Operate performs the Activate operation.
+func (a *ActivateOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Activating OS on %s", a.dev)
+	if err := operations.Activate(context.Background(), a.dev, a.version, a.standby, a.noReboot); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", a, err)
+	}
+}
+
+// NewVerify creates a new OS verify operation, which checks that the device
+// runs an expected version, such as after an install and activation.
+func (o *Operations) NewVerify() *VerifyOp {
+	return &VerifyOp{dev: o.dev, telemetry: o.telemetry}
+}
+
+// VerifyOp is an OS verify operation.
+type VerifyOp struct {
+	dev        binding.Device
+	telemetry  func() *device.DevicePath
+	version    string
+	standby    bool
+	components []string
+}
+
+func (v *VerifyOp) String() string {
+	return fmt.Sprintf("VerifyOp%+v", *v)
+}
+
+// WithVersion specifies the expected version of the verify operation.
+func (v *VerifyOp) WithVersion(version string) *VerifyOp {
+	v.version = version
+	return v
+}
+
+// WithStandbySupervisor specifies whether to verify the version of the Standby
+// Supervisor instead of the Active Supervisor.
+func (v *VerifyOp) WithStandbySupervisor(standby bool) *VerifyOp {
+	v.standby = standby
+	return v
+}
+
+// WithTelemetryComponents specifies components, such as the OPERATING_SYSTEM
+// components of the supervisors, whose software-version telemetry must also
+// match the expected version.
+func (v *VerifyOp) WithTelemetryComponents(components ...string) *VerifyOp {
+	v.components = components
+	return v
+}
+
+// Operate performs the Verify operation.
+func (v *VerifyOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Verifying OS on %s", v.dev)
+	if err := operations.Verify(context.Background(), v.dev, v.version, v.standby); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", v, err)
+	}
+	for _, c := range v.components {
+		if got := v.telemetry().Component(c).SoftwareVersion().Get(t); got != v.version {
+			t.Fatalf("Operate(t) on %s: component %q has software version %q, want %q", v, c, got, v.version)
+		}
+	}
+}
+
 // NewPing creates a new ping operation.
 func (o *Operations) NewPing() *PingOp {
 	return &PingOp{dev: o.dev}
@@ -146,6 +273,291 @@ func (p *PingOp) Operate(t testing.TB) {
 	}
 }
 
+// NewPingOptions returns new options for a Ping with the device defaults.
+func NewPingOptions() *PingOptions {
+	return &PingOptions{req: &spb.PingRequest{}}
+}
+
+// PingOptions are the options of a Ping.
+type PingOptions struct {
+	req *spb.PingRequest
+}
+
+// WithCount sets the number of packets to send.
+func (o *PingOptions) WithCount(count int32) *PingOptions {
+	o.req.Count = count
+	return o
+}
+
+// WithSource sets the source address to ping from.
+func (o *PingOptions) WithSource(src string) *PingOptions {
+	o.req.Source = src
+	return o
+}
+
+// WithInterval sets the time between requests.
+func (o *PingOptions) WithInterval(interval time.Duration) *PingOptions {
+	o.req.Interval = interval.Nanoseconds()
+	return o
+}
+
+// WithWait sets the time to wait for a response.
+func (o *PingOptions) WithWait(wait time.Duration) *PingOptions {
+	o.req.Wait = wait.Nanoseconds()
+	return o
+}
+
+// WithSize sets the size of the request packets, excluding the ICMP header.
+func (o *PingOptions) WithSize(size int32) *PingOptions {
+	o.req.Size = size
+	return o
+}
+
+// WithDoNotFragment sets whether the do not fragment bit is set on IPv4
+// request packets.
+func (o *PingOptions) WithDoNotFragment(dnf bool) *PingOptions {
+	o.req.DoNotFragment = dnf
+	return o
+}
+
+// PingResult is the result of a Ping.
+type PingResult struct {
+	Sent, Received                    int32
+	MinRTT, AvgRTT, MaxRTT, StdDevRTT time.Duration
+	// Replies are the received replies, in the order they were received.
+	Replies []*PingReply
+}
+
+// PingReply is a single reply received by a Ping.
+type PingReply struct {
+	Source               string
+	RTT                  time.Duration
+	Bytes, Sequence, TTL int32
+}
+
+// Loss returns the percentage of sent packets that were not received.
+func (r *PingResult) Loss() float64 {
+	if r.Sent == 0 {
+		return 0
+	}
+	return 100 * float64(r.Sent-r.Received) / float64(r.Sent)
+}
+
+// Ping pings the destination from the device with the options, which may be
+// nil, and returns the result. Unlike the Ping operation, it does not fail
+// the test when packets are lost, so the result can be checked instead.
+func (o *Operations) Ping(t testing.TB, dest string, opts *PingOptions) *PingResult {
+	t.Helper()
+	logAction(t, "Pinging from %s", o.dev)
+	req := &spb.PingRequest{}
+	if opts != nil {
+		req = proto.Clone(opts.req).(*spb.PingRequest)
+	}
+	req.Destination = dest
+	resps, err := operations.PingResponses(context.Background(), o.dev, req)
+	if err != nil {
+		t.Fatalf("Ping(t, %q) on %s: %v", dest, o.dev, err)
+	}
+	return pingResult(resps)
+}
+
+func pingResult(resps []*spb.PingResponse) *PingResult {
+	res := &PingResult{}
+	for _, resp := range resps {
+		// Only the final message summarizes the ping.
+		if resp.GetSent() != 0 || resp.GetReceived() != 0 {
+			res.Sent = resp.GetSent()
+			res.Received = resp.GetReceived()
+			res.MinRTT = time.Duration(resp.GetMinTime())
+			res.AvgRTT = time.Duration(resp.GetAvgTime())
+			res.MaxRTT = time.Duration(resp.GetMaxTime())
+			res.StdDevRTT = time.Duration(resp.GetStdDev())
+			continue
+		}
+		res.Replies = append(res.Replies, &PingReply{
+			Source:   resp.GetSource(),
+			RTT:      time.Duration(resp.GetTime()),
+			Bytes:    resp.GetBytes(),
+			Sequence: resp.GetSequence(),
+			TTL:      resp.GetTtl(),
+		})
+	}
+	return res
+}
+
+// NewTracerouteOptions returns new options for a Traceroute with the device
+// defaults, which probe with ICMP.
+func NewTracerouteOptions() *TracerouteOptions {
+	return &TracerouteOptions{req: &spb.TracerouteRequest{}}
+}
+
+// TracerouteOptions are the options of a Traceroute.
+type TracerouteOptions struct {
+	req *spb.TracerouteRequest
+}
+
+// WithSource sets the source address of the probes.
+func (o *TracerouteOptions) WithSource(src string) *TracerouteOptions {
+	o.req.Source = src
+	return o
+}
+
+// WithInitialTTL sets the TTL of the first probes.
+func (o *TracerouteOptions) WithInitialTTL(ttl uint32) *TracerouteOptions {
+	o.req.InitialTtl = ttl
+	return o
+}
+
+// WithMaxTTL sets the maximum number of hops.
+func (o *TracerouteOptions) WithMaxTTL(ttl int32) *TracerouteOptions {
+	o.req.MaxTtl = ttl
+	return o
+}
+
+// WithWait sets the time to wait for a response to a probe.
+func (o *TracerouteOptions) WithWait(wait time.Duration) *TracerouteOptions {
+	o.req.Wait = wait.Nanoseconds()
+	return o
+}
+
+// WithDoNotFragment sets whether the do not fragment bit is set on IPv4
+// probes.
+func (o *TracerouteOptions) WithDoNotFragment(dnf bool) *TracerouteOptions {
+	o.req.DoNotFragment = dnf
+	return o
+}
+
+// WithICMPProbes sets the probes to ICMP echo requests.
+func (o *TracerouteOptions) WithICMPProbes() *TracerouteOptions {
+	o.req.L4Protocol = spb.TracerouteRequest_ICMP
+	return o
+}
+
+// WithTCPProbes sets the probes to TCP SYN packets.
+func (o *TracerouteOptions) WithTCPProbes() *TracerouteOptions {
+	o.req.L4Protocol = spb.TracerouteRequest_TCP
+	return o
+}
+
+// WithUDPProbes sets the probes to UDP packets.
+func (o *TracerouteOptions) WithUDPProbes() *TracerouteOptions {
+	o.req.L4Protocol = spb.TracerouteRequest_UDP
+	return o
+}
+
+// TracerouteResult is the result of a Traceroute.
+type TracerouteResult struct {
+	DestinationName    string
+	DestinationAddress string
+	// Hops are the hops of the trace, in increasing order of hop number.
+	Hops []*TracerouteHop
+}
+
+// TracerouteHop is a hop of a Traceroute.
+type TracerouteHop struct {
+	Hop    int32
+	Probes []*TracerouteProbe
+}
+
+// TracerouteProbe is the disposition of a single probe of a Traceroute hop.
+type TracerouteProbe struct {
+	Address, Name string
+	RTT           time.Duration
+	State         spb.TracerouteResponse_State
+	ICMPCode      int32
+	MPLS          map[string]string
+	ASPath        []int32
+}
+
+// Reached returns whether a probe of the last hop was answered by the
+// destination.
+func (r *TracerouteResult) Reached() bool {
+	if len(r.Hops) == 0 {
+		return false
+	}
+	for _, p := range r.Hops[len(r.Hops)-1].Probes {
+		if p.Address == r.DestinationAddress && p.State == spb.TracerouteResponse_DEFAULT {
+			return true
+		}
+	}
+	return false
+}
+
+// Addresses returns the addresses of the hops, using the address of the first
+// answered probe of each hop or the empty string if no probe was answered.
+func (r *TracerouteResult) Addresses() []string {
+	addrs := make([]string, len(r.Hops))
+	for i, h := range r.Hops {
+		for _, p := range h.Probes {
+			if p.State == spb.TracerouteResponse_DEFAULT {
+				addrs[i] = p.Address
+				break
+			}
+		}
+	}
+	return addrs
+}
+
+// RTTs returns the round trip times of the answered probes of the hop.
+func (h *TracerouteHop) RTTs() []time.Duration {
+	var rtts []time.Duration
+	for _, p := range h.Probes {
+		if p.State == spb.TracerouteResponse_DEFAULT {
+			rtts = append(rtts, p.RTT)
+		}
+	}
+	return rtts
+}
+
+// Traceroute traces the route to the destination from the device with the
+// options, which may be nil, and returns the result.
+func (o *Operations) Traceroute(t testing.TB, dest string, opts *TracerouteOptions) *TracerouteResult {
+	t.Helper()
+	logAction(t, "Tracing route from %s", o.dev)
+	req := &spb.TracerouteRequest{}
+	if opts != nil {
+		req = proto.Clone(opts.req).(*spb.TracerouteRequest)
+	}
+	req.Destination = dest
+	resps, err := operations.Traceroute(context.Background(), o.dev, req)
+	if err != nil {
+		t.Fatalf("Traceroute(t, %q) on %s: %v", dest, o.dev, err)
+	}
+	return tracerouteResult(resps)
+}
+
+func tracerouteResult(resps []*spb.TracerouteResponse) *TracerouteResult {
+	res := &TracerouteResult{}
+	hops := make(map[int32]*TracerouteHop)
+	for _, resp := range resps {
+		// Only the first message describes the destination, without a hop.
+		if resp.GetHop() == 0 {
+			res.DestinationName = resp.GetDestinationName()
+			res.DestinationAddress = resp.GetDestinationAddress()
+			continue
+		}
+		h, ok := hops[resp.GetHop()]
+		if !ok {
+			h = &TracerouteHop{Hop: resp.GetHop()}
+			hops[h.Hop] = h
+			res.Hops = append(res.Hops, h)
+		}
+		h.Probes = append(h.Probes, &TracerouteProbe{
+			Address:  resp.GetAddress(),
+			Name:     resp.GetName(),
+			RTT:      time.Duration(resp.GetRtt()),
+			State:    resp.GetState(),
+			ICMPCode: resp.GetIcmpCode(),
+			MPLS:     resp.GetMpls(),
+			ASPath:   resp.GetAsPath(),
+		})
+	}
+	sort.Slice(res.Hops, func(i, j int) bool {
+		return res.Hops[i].Hop < res.Hops[j].Hop
+	})
+	return res
+}
+
 // NewSetInterfaceState creates a new set interface state operation.
 func (o *Operations) NewSetInterfaceState() *SetInterfaceStateOp {
 	return &SetInterfaceStateOp{dev: o.dev}
@@ -198,8 +610,10 @@ func (o *Operations) NewReboot() *RebootOp {
 
 // RebootOp is a reboot operation.
 type RebootOp struct {
-	dev     binding.Device
-	timeout time.Duration
+	dev          binding.Device
+	timeout      time.Duration
+	awaitHealthy bool
+	healthChecks []func() error
 }
 
 func (r *RebootOp) String() string {
@@ -212,28 +626,110 @@ func (r *RebootOp) WithTimeout(timeout time.Duration) *RebootOp {
 	return r
 }
 
+// WithAwaitHealthy specifies that the operation waits for the device to become
+// healthy after the reboot: for gNMI to become unreachable, then reachable
+// again, and then for all the specified checks to return nil, such as a check
+// that looks up the telemetry of the line cards and returns an error if any of
+// them is down. The checks are polled until they all pass or the timeout
+// expires, in which case the operation fails with the last error returned.
+func (r *RebootOp) WithAwaitHealthy(checks ...func() error) *RebootOp {
+	r.awaitHealthy = true
+	r.healthChecks = checks
+	return r
+}
+
 // Operate performs the Reboot operation.
 func (r *RebootOp) Operate(t testing.TB) {
 	t.Helper()
 	logAction(t, "Rebooting %s", r.dev)
-	if err := operations.Reboot(context.Background(), r.dev, r.timeout); err != nil {
+	ctx := context.Background()
+	var gnmi gpb.GNMIClient
+	if r.awaitHealthy {
+		var err error
+		if gnmi, err = fetchGNMI(ctx, r.dev, nil); err != nil {
+			t.Fatalf("Operate(t) on %s: %v", r, err)
+		}
+	}
+	if err := operations.Reboot(ctx, r.dev, r.timeout, gnmi, r.healthChecks); err != nil {
 		t.Fatalf("Operate(t) on %s: %v", r, err)
 	}
 }
 
+// NewSwitchoverSupervisor creates a new supervisor switchover operation.
+func (o *Operations) NewSwitchoverSupervisor() *SwitchoverSupervisorOp {
+	return &SwitchoverSupervisorOp{dev: o.dev}
+}
+
+// SwitchoverSupervisorOp is an operation that switches the active supervisor
+// of a device, using the gNOI SwitchControlProcessor RPC.
+type SwitchoverSupervisorOp struct {
+	dev          binding.Device
+	supervisor   string
+	timeout      time.Duration
+	healthChecks []func() error
+}
+
+func (s *SwitchoverSupervisorOp) String() string {
+	return fmt.Sprintf("SwitchoverSupervisorOp%+v", *s)
+}
+
+// WithSupervisor specifies the name of the component of the standby
+// supervisor to make active.
+func (s *SwitchoverSupervisorOp) WithSupervisor(name string) *SwitchoverSupervisorOp {
+	s.supervisor = name
+	return s
+}
+
+// WithTimeout specifies the timeout on the switchover operation, including
+// the wait for the new active supervisor to be ready.
+func (s *SwitchoverSupervisorOp) WithTimeout(timeout time.Duration) *SwitchoverSupervisorOp {
+	s.timeout = timeout
+	return s
+}
+
+// WithAwaitHealthy specifies checks that must all return nil, after the new
+// active supervisor is ready, for the switchover to complete, such as a check
+// that looks up the telemetry of the line cards and returns an error if any of
+// them is down. The checks are polled until they all pass or the timeout
+// expires, in which case the operation fails with the last error returned.
+func (s *SwitchoverSupervisorOp) WithAwaitHealthy(checks ...func() error) *SwitchoverSupervisorOp {
+	s.healthChecks = checks
+	return s
+}
+
+// Operate performs the switchover operation. It waits for the new active
+// supervisor to report its oper-status as ACTIVE over gNMI, which shows that
+// it is ready and that telemetry has resumed, and then for the health checks
+// to pass.
+func (s *SwitchoverSupervisorOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Switching over supervisor of %s", s.dev)
+	ctx := context.Background()
+	gnmi, err := fetchGNMI(ctx, s.dev, nil)
+	if err != nil {
+		t.Fatalf("Operate(t) on %s: %v", s, err)
+	}
+	if err := operations.SwitchoverSupervisor(ctx, s.dev, s.supervisor, s.timeout, gnmi, s.healthChecks); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", s, err)
+	}
+}
+
 // NewKillProcess creates a new kill process operation.
 // By default the process is killed with a SIGTERM signal.
 func (o *Operations) NewKillProcess() *KillProcessOp {
 	return &KillProcessOp{
-		dev: o.dev,
-		req: &spb.KillProcessRequest{Signal: spb.KillProcessRequest_SIGNAL_TERM},
+		dev:       o.dev,
+		telemetry: o.telemetry,
+		req:       &spb.KillProcessRequest{Signal: spb.KillProcessRequest_SIGNAL_TERM},
 	}
 }
 
 // KillProcessOp is an operation that kills a process on a device.
 type KillProcessOp struct {
-	dev binding.Device
-	req *spb.KillProcessRequest
+	dev            binding.Device
+	telemetry      func() *device.DevicePath
+	req            *spb.KillProcessRequest
+	restartTimeout time.Duration
 }
 
 // WithPID sets the PID to be killed.
@@ -268,7 +764,16 @@ func (r *KillProcessOp) WithSIGHUP() *KillProcessOp {
 
 // WithRestart sets whether the process should restart after being killed.
 func (r *KillProcessOp) WithRestart(restart bool) *KillProcessOp {
-	r.req.Restart = true
+	r.req.Restart = restart
+	return r
+}
+
+// WithAwaitRestart specifies that the operation waits, up to the timeout, for
+// the process to restart after it is killed: for the system process telemetry
+// to report a process with the same name and a new PID or start time. The
+// process must be specified by name.
+func (r *KillProcessOp) WithAwaitRestart(timeout time.Duration) *KillProcessOp {
+	r.restartTimeout = timeout
 	return r
 }
 
@@ -280,7 +785,518 @@ func (r *KillProcessOp) String() string {
 func (r *KillProcessOp) Operate(t testing.TB) {
 	t.Helper()
 	logAction(t, "Killing process on %s", r.dev)
-	if err := operations.KillProcess(context.Background(), r.dev, r.req); err != nil {
+	ctx := context.Background()
+	var before map[uint64]uint64
+	if r.restartTimeout > 0 {
+		if r.req.GetName() == "" {
+			t.Fatalf("Operate(t) on %s: awaiting the restart requires the process name", r)
+		}
+		var err error
+		if before, err = r.processes(ctx); err != nil {
+			t.Fatalf("Operate(t) on %s: %v", r, err)
+		}
+		if len(before) == 0 {
+			t.Fatalf("Operate(t) on %s: no process named %q on the device", r, r.req.GetName())
+		}
+	}
+	if err := operations.KillProcess(ctx, r.dev, r.req); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", r, err)
+	}
+	if r.restartTimeout > 0 {
+		logAction(t, "Awaiting process restart on %s", r.dev)
+		if err := r.awaitRestart(ctx, before); err != nil {
+			t.Fatalf("Operate(t) on %s: %v", r, err)
+		}
+	}
+}
+
+// processPollInterval is the interval at which the processes of a device are
+// polled while awaiting the restart of a process.
+const processPollInterval = time.Second
+
+// awaitRestart polls the processes of the device until one with the name of
+// the killed process has a PID or start time that is not in the processes
+// from before the kill.
+func (r *KillProcessOp) awaitRestart(ctx context.Context, before map[uint64]uint64) error {
+	deadline := time.Now().Add(r.restartTimeout)
+	for {
+		// Errors are expected while the process restarts, e.g. if it serves gNMI.
+		if after, err := r.processes(ctx); err == nil {
+			for pid, start := range after {
+				if prevStart, ok := before[pid]; !ok || start != prevStart {
+					return nil
+				}
+			}
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("process %q did not restart within %s", r.req.GetName(), r.restartTimeout)
+		}
+		time.Sleep(processPollInterval)
+	}
+}
+
+// processes returns the start times of the processes with the name of the
+// operation, keyed by PID, from the system process telemetry.
+func (r *KillProcessOp) processes(ctx context.Context) (map[uint64]uint64, error) {
+	data, _, err := genutil.Get(ctx, r.telemetry().System().ProcessAny())
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the processes")
+	}
+	names := make(map[uint64]string)
+	starts := make(map[uint64]uint64)
+	for _, dp := range data {
+		elems := dp.Path.GetElem()
+		if len(elems) == 0 {
+			continue
+		}
+		var pid uint64
+		for _, e := range elems {
+			if e.GetName() == "process" {
+				if pid, err = strconv.ParseUint(e.GetKey()["pid"], 10, 64); err != nil {
+					return nil, errors.Wrapf(err, "invalid PID in path %v", dp.Path)
+				}
+			}
+		}
+		switch elems[len(elems)-1].GetName() {
+		case "name":
+			names[pid] = dp.Value.GetStringVal()
+		case "start-time":
+			starts[pid] = dp.Value.GetUintVal()
+		}
+	}
+	procs := make(map[uint64]uint64)
+	for pid, name := range names {
+		if name == r.req.GetName() {
+			procs[pid] = starts[pid]
+		}
+	}
+	return procs, nil
+}
+
+// NewPutFile creates a new operation that puts a file on a device.
+// By default the file has permissions 644.
+func (o *Operations) NewPutFile() *PutFileOp {
+	return &PutFileOp{dev: o.dev, perms: 644}
+}
+
+// PutFileOp is an operation that puts a file on a device.
+type PutFileOp struct {
+	dev        binding.Device
+	remotePath string
+	perms      uint32
+	reader     io.Reader
+}
+
+func (p *PutFileOp) String() string {
+	return fmt.Sprintf("PutFileOp%+v", *p)
+}
+
+// WithRemotePath specifies the absolute path of the file on the device.
+func (p *PutFileOp) WithRemotePath(path string) *PutFileOp {
+	p.remotePath = path
+	return p
+}
+
+// WithPermissions specifies the permissions of the file, in the octal format
+// of standard UNIX permissions, e.g. 755.
+func (p *PutFileOp) WithPermissions(perms uint32) *PutFileOp {
+	p.perms = perms
+	return p
+}
+
+// WithContents specifies the content of the file.
+func (p *PutFileOp) WithContents(content []byte) *PutFileOp {
+	return p.WithReader(bytes.NewReader(content))
+}
+
+// WithReader specifies the content of the file, in the form of a reader.
+func (p *PutFileOp) WithReader(reader io.Reader) *PutFileOp {
+	p.reader = reader
+	return p
+}
+
+// WithLocalFile specifies the content of the file, in the form of a path to a
+// local file.
+func (p *PutFileOp) WithLocalFile(path string) *PutFileOp {
+	return p.WithReader(&fileReader{path: path})
+}
+
+// Operate performs the put file operation.
+func (p *PutFileOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Putting file on %s", p.dev)
+	if err := operations.PutFile(context.Background(), p.dev, p.remotePath, p.perms, p.reader); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", p, err)
+	}
+}
+
+// GetFile returns the content of the file at the absolute path on the device,
+// after verifying it against the hash sent by the device.
+func (o *Operations) GetFile(t testing.TB, path string) []byte {
+	t.Helper()
+	logAction(t, "Getting file from %s", o.dev)
+	var buf bytes.Buffer
+	if err := operations.GetFile(context.Background(), o.dev, path, &buf); err != nil {
+		t.Fatalf("GetFile(t, %q) on %s: %v", path, o.dev, err)
+	}
+	return buf.Bytes()
+}
+
+// CopyFile copies the file at the absolute path on the device to the local
+// path, after verifying it against the hash sent by the device. It is suited
+// to large files, such as core files, that are analyzed outside the test.
+func (o *Operations) CopyFile(t testing.TB, path, localPath string) {
+	t.Helper()
+	logAction(t, "Copying file from %s", o.dev)
+	f, err := os.Create(localPath)
+	if err != nil {
+		t.Fatalf("CopyFile(t, %q, %q) on %s: %v", path, localPath, o.dev, err)
+	}
+	defer closer.CloseAndLog(f.Close, "error closing local file")
+	if err := operations.GetFile(context.Background(), o.dev, path, f); err != nil {
+		t.Fatalf("CopyFile(t, %q, %q) on %s: %v", path, localPath, o.dev, err)
+	}
+}
+
+// FileStat is information about a file on a device.
+type FileStat struct {
+	Path         string
+	LastModified time.Time
+	// Permissions are in the octal format of standard UNIX permissions.
+	Permissions uint32
+	Size        uint64
+	Umask       uint32
+}
+
+// StatFile returns information about the file at the absolute path on the
+// device or, if it is a directory, about the files in it.
+func (o *Operations) StatFile(t testing.TB, path string) []*FileStat {
+	t.Helper()
+	logAction(t, "Getting file stats from %s", o.dev)
+	infos, err := operations.StatFile(context.Background(), o.dev, path)
+	if err != nil {
+		t.Fatalf("StatFile(t, %q) on %s: %v", path, o.dev, err)
+	}
+	var stats []*FileStat
+	for _, info := range infos {
+		stats = append(stats, &FileStat{
+			Path:         info.GetPath(),
+			LastModified: time.Unix(0, int64(info.GetLastModified())),
+			Permissions:  info.GetPermissions(),
+			Size:         info.GetSize(),
+			Umask:        info.GetUmask(),
+		})
+	}
+	return stats
+}
+
+// ComponentHealth is the gNOI healthz status of a component of a device.
+type ComponentHealth struct {
+	// Path is the gNMI path of the component.
+	Path   string
+	Status hpb.Status
+	// Details are the implementation-specific health details, if any.
+	Details       *anypb.Any
+	Subcomponents []*ComponentHealth
+}
+
+// Unhealthy returns the component and its subcomponents, at any depth, whose
+// status is unhealthy.
+func (c *ComponentHealth) Unhealthy() []*ComponentHealth {
+	var unhealthy []*ComponentHealth
+	if c.Status == hpb.Status_STATUS_UNHEALTHY {
+		unhealthy = append(unhealthy, c)
+	}
+	for _, sub := range c.Subcomponents {
+		unhealthy = append(unhealthy, sub.Unhealthy()...)
+	}
+	return unhealthy
+}
+
+// Healthz returns the gNOI healthz status of the component at the gNMI path
+// on the device, such as "/components/component[name=FPC0]", or of the whole
+// device if the path is empty. When a component is unhealthy and the
+// -diag_dir flag is set, the status is also written to the diagnostics
+// directory of the test, so the evidence is kept with the test outputs.
+func (o *Operations) Healthz(t testing.TB, path string) *ComponentHealth {
+	t.Helper()
+	logAction(t, "Getting healthz status of %s", o.dev)
+	tpath, err := healthzPath(path)
+	if err != nil {
+		t.Fatalf("Healthz(t, %q) on %s: %v", path, o.dev, err)
+	}
+	status, err := operations.Healthz(context.Background(), o.dev, tpath)
+	if err != nil {
+		t.Fatalf("Healthz(t, %q) on %s: %v", path, o.dev, err)
+	}
+	health, err := componentHealth(status)
+	if err != nil {
+		t.Fatalf("Healthz(t, %q) on %s: %v", path, o.dev, err)
+	}
+	if diagDir != "" && len(health.Unhealthy()) > 0 {
+		file := filepath.Join(diagDir, t.Name(), o.id, fmt.Sprintf("healthz-%s.txt", time.Now().Format("20060102-150405.000")))
+		t.Log(actionMsg(fmt.Sprintf("Writing unhealthy healthz status of %s to %s", o.dev, file)))
+		if err := artifacts.Write(context.Background(), file, []byte(prototext.Format(status))); err != nil {
+			t.Logf("Failed to write healthz status of %s: %v", o.dev, err)
+		}
+	}
+	return health
+}
+
+// healthzPath converts the string form of a gNMI path to a gNOI path.
+func healthzPath(path string) (*tpb.Path, error) {
+	gpath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing gNMI path %q", path)
+	}
+	tpath := &tpb.Path{Origin: gpath.GetOrigin()}
+	for _, e := range gpath.GetElem() {
+		tpath.Elem = append(tpath.Elem, &tpb.PathElem{Name: e.GetName(), Key: e.GetKey()})
+	}
+	return tpath, nil
+}
+
+func componentHealth(status *hpb.ComponentStatus) (*ComponentHealth, error) {
+	gpath := &gpb.Path{Origin: status.GetPath().GetOrigin()}
+	for _, e := range status.GetPath().GetElem() {
+		gpath.Elem = append(gpath.Elem, &gpb.PathElem{Name: e.GetName(), Key: e.GetKey()})
+	}
+	path, err := ygot.PathToString(gpath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error formatting healthz path %v", status.GetPath())
+	}
+	health := &ComponentHealth{
+		Path:    path,
+		Status:  status.GetStatus(),
+		Details: status.GetHealthz(),
+	}
+	for _, sub := range status.GetSubcomponents() {
+		subHealth, err := componentHealth(sub)
+		if err != nil {
+			return nil, err
+		}
+		health.Subcomponents = append(health.Subcomponents, subHealth)
+	}
+	return health, nil
+}
+
+// NewPacketCapture creates a new operation that captures packets on a device.
+// By default all the packets on all the ports of the device are captured.
+func (o *Operations) NewPacketCapture() *PacketCaptureOp {
+	return &PacketCaptureOp{id: o.id, dev: o.dev}
+}
+
+// PacketCaptureOp is an operation that captures packets on a device.
+type PacketCaptureOp struct {
+	id     string
+	dev    binding.Device
+	ports  []*Port
+	filter string
+}
+
+func (p *PacketCaptureOp) String() string {
+	return fmt.Sprintf("PacketCaptureOp%+v", *p)
+}
+
+// WithPorts specifies the ports on which packets are captured.
+func (p *PacketCaptureOp) WithPorts(ports ...*Port) *PacketCaptureOp {
+	p.ports = ports
+	return p
+}
+
+// WithFilter specifies a filter of the captured packets, in tcpdump syntax,
+// such as "tcp port 179".
+func (p *PacketCaptureOp) WithFilter(filter string) *PacketCaptureOp {
+	p.filter = filter
+	return p
+}
+
+// Start starts the packet capture, which runs until it is stopped.
+func (p *PacketCaptureOp) Start(t testing.TB) *PacketCapture {
+	t.Helper()
+	logAction(t, "Starting packet capture on %s", p.dev)
+	var ports []*binding.Port
+	for _, port := range p.ports {
+		ports = append(ports, port.res)
+	}
+	pc, err := operations.StartPacketCapture(context.Background(), p.dev, ports, p.filter)
+	if err != nil {
+		t.Fatalf("Start(t) on %s: %v", p, err)
+	}
+	return &PacketCapture{op: p, pc: pc}
+}
+
+// PacketCapture is a packet capture running on a device.
+type PacketCapture struct {
+	op *PacketCaptureOp
+	pc binding.PacketCapture
+}
+
+// Stop stops the packet capture and returns the captured packets in pcap
+// format. If the -diag_dir flag is set, the packets are also written to the
+// diagnostics directory of the test, so they are kept with the test outputs.
+func (c *PacketCapture) Stop(t testing.TB) []byte {
+	t.Helper()
+	logAction(t, "Stopping packet capture on %s", c.op.dev)
+	pcap, err := c.pc.Stop(context.Background())
+	if err != nil {
+		t.Fatalf("Stop(t) on %s: %v", c.op, err)
+	}
+	if diagDir != "" {
+		file := filepath.Join(diagDir, t.Name(), c.op.id, fmt.Sprintf("capture-%s.pcap", time.Now().Format("20060102-150405.000")))
+		t.Log(actionMsg(fmt.Sprintf("Writing packet capture of %s to %s", c.op.dev, file)))
+		if err := artifacts.Write(context.Background(), file, pcap); err != nil {
+			t.Logf("Failed to write packet capture of %s: %v", c.op.dev, err)
+		}
+	}
+	return pcap
+}
+
+// NewInstallCertificate creates a new certificate install operation. The
+// device generates a key pair and CSR, which is signed by the CA of the
+// operation, and the signed certificate is installed on the device.
+func (o *Operations) NewInstallCertificate() *InstallCertificateOp {
+	return &InstallCertificateOp{dev: o.dev}
+}
+
+// InstallCertificateOp is a certificate install operation.
+type InstallCertificateOp struct {
+	dev        binding.Device
+	certID     string
+	commonName string
+	ca         *TestCA
+}
+
+func (i *InstallCertificateOp) String() string {
+	return fmt.Sprintf("InstallCertificateOp%+v", *i)
+}
+
+// WithCertificateID specifies the ID of the installed certificate.
+func (i *InstallCertificateOp) WithCertificateID(id string) *InstallCertificateOp {
+	i.certID = id
+	return i
+}
+
+// WithCommonName specifies the common name of the installed certificate.
+func (i *InstallCertificateOp) WithCommonName(name string) *InstallCertificateOp {
+	i.commonName = name
+	return i
+}
+
+// WithCA specifies the CA that signs the installed certificate. The
+// certificate of the CA is also installed as a trusted CA certificate.
+func (i *InstallCertificateOp) WithCA(ca *TestCA) *InstallCertificateOp {
+	i.ca = ca
+	return i
+}
+
+// Operate performs the InstallCertificate operation.
+func (i *InstallCertificateOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Installing certificate on %s", i.dev)
+	sign, caCerts := caSigner(i.ca)
+	if err := operations.InstallCertificate(context.Background(), i.dev, i.certID, i.commonName, sign, caCerts); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", i, err)
+	}
+}
+
+// NewRotateCertificate creates a new certificate rotate operation. The
+// device generates a new key pair and CSR for an existing certificate, which
+// is signed by the CA of the operation, and the signed certificate replaces
+// the existing certificate.
+func (o *Operations) NewRotateCertificate() *RotateCertificateOp {
+	return &RotateCertificateOp{dev: o.dev}
+}
+
+// RotateCertificateOp is a certificate rotate operation.
+type RotateCertificateOp struct {
+	dev        binding.Device
+	certID     string
+	commonName string
+	ca         *TestCA
+	validate   func(testing.TB) bool
+}
+
+func (r *RotateCertificateOp) String() string {
+	return fmt.Sprintf("RotateCertificateOp%+v", *r)
+}
+
+// WithCertificateID specifies the ID of the rotated certificate.
+func (r *RotateCertificateOp) WithCertificateID(id string) *RotateCertificateOp {
+	r.certID = id
+	return r
+}
+
+// WithCommonName specifies the common name of the new certificate.
+func (r *RotateCertificateOp) WithCommonName(name string) *RotateCertificateOp {
+	r.commonName = name
+	return r
+}
+
+// WithCA specifies the CA that signs the new certificate. The certificate of
+// the CA is also installed as a trusted CA certificate.
+func (r *RotateCertificateOp) WithCA(ca *TestCA) *RotateCertificateOp {
+	r.ca = ca
+	return r
+}
+
+// WithValidation specifies a function that checks whether the new certificate
+// works after it is loaded, such as by connecting to the device with a client
+// that trusts only the CA. The rotation is finalized if the function returns
+// true, and otherwise the device rolls back to the existing certificate and
+// the operation fails. By default the rotation is finalized without a check.
+func (r *RotateCertificateOp) WithValidation(validate func(t testing.TB) bool) *RotateCertificateOp {
+	r.validate = validate
+	return r
+}
+
+// Operate performs the RotateCertificate operation.
+func (r *RotateCertificateOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Rotating certificate on %s", r.dev)
+	sign, caCerts := caSigner(r.ca)
+	var validate func() bool
+	if r.validate != nil {
+		validate = func() bool { return r.validate(t) }
+	}
+	if err := operations.RotateCertificate(context.Background(), r.dev, r.certID, r.commonName, sign, caCerts, validate); err != nil {
+		t.Fatalf("Operate(t) on %s: %v", r, err)
+	}
+}
+
+func caSigner(ca *TestCA) (func([]byte) ([]byte, error), [][]byte) {
+	if ca == nil {
+		return nil, nil
+	}
+	return ca.signCSR, [][]byte{ca.CertificatePEM()}
+}
+
+// NewRevokeCertificates creates a new certificate revoke operation.
+func (o *Operations) NewRevokeCertificates() *RevokeCertificatesOp {
+	return &RevokeCertificatesOp{dev: o.dev}
+}
+
+// RevokeCertificatesOp is an operation that revokes certificates on a device.
+type RevokeCertificatesOp struct {
+	dev     binding.Device
+	certIDs []string
+}
+
+func (r *RevokeCertificatesOp) String() string {
+	return fmt.Sprintf("RevokeCertificatesOp%+v", *r)
+}
+
+// WithCertificateIDs specifies the IDs of the revoked certificates.
+func (r *RevokeCertificatesOp) WithCertificateIDs(ids ...string) *RevokeCertificatesOp {
+	r.certIDs = ids
+	return r
+}
+
+// Operate performs the RevokeCertificates operation.
+func (r *RevokeCertificatesOp) Operate(t testing.TB) {
+	t.Helper()
+	logAction(t, "Revoking certificates on %s", r.dev)
+	if err := operations.RevokeCertificates(context.Background(), r.dev, r.certIDs); err != nil {
 		t.Fatalf("Operate(t) on %s: %v", r, err)
 	}
 }
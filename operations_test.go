@@ -17,9 +17,13 @@ package ondatra
 import (
 	"bytes"
 	"golang.org/x/net/context"
+	"crypto/md5"
+	"crypto/sha256"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -31,8 +35,13 @@ import (
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/negtest"
 
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	cpb "github.com/openconfig/gnoi/cert"
+	fpb "github.com/openconfig/gnoi/file"
+	hpb "github.com/openconfig/gnoi/healthz"
 	ospb "github.com/openconfig/gnoi/os"
 	spb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
 	opb "github.com/openconfig/ondatra/proto"
 )
 
@@ -57,10 +66,16 @@ type fakeGNOIClient struct {
 	spb.SystemClient
 	ospb.OSClient
 	Pinger         func(context.Context, *spb.PingRequest, ...grpc.CallOption) (spb.System_PingClient, error)
+	Tracerouter    func(context.Context, *spb.TracerouteRequest, ...grpc.CallOption) (spb.System_TracerouteClient, error)
 	Rebooter       func(context.Context, *spb.RebootRequest, ...grpc.CallOption) (*spb.RebootResponse, error)
 	RebootStatuser func(context.Context, *spb.RebootStatusRequest, ...grpc.CallOption) (*spb.RebootStatusResponse, error)
 	KillProcessor  func(context.Context, *spb.KillProcessRequest, ...grpc.CallOption) (*spb.KillProcessResponse, error)
+	Switcher       func(context.Context, *spb.SwitchControlProcessorRequest, ...grpc.CallOption) (*spb.SwitchControlProcessorResponse, error)
 	Installer      func(context.Context, ...grpc.CallOption) (ospb.OS_InstallClient, error)
+	Activator      func(context.Context, *ospb.ActivateRequest, ...grpc.CallOption) (*ospb.ActivateResponse, error)
+	Verifier       func(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error)
+	certMgmt       *fakeCertClient
+	file           *fakeFileClient
 }
 
 func (fg *fakeGNOIClient) System() spb.SystemClient {
@@ -71,10 +86,22 @@ func (fg *fakeGNOIClient) OS() ospb.OSClient {
 	return fg
 }
 
+func (fg *fakeGNOIClient) CertificateManagement() cpb.CertificateManagementClient {
+	return fg.certMgmt
+}
+
+func (fg *fakeGNOIClient) File() fpb.FileClient {
+	return fg.file
+}
+
 func (fg *fakeGNOIClient) Ping(ctx context.Context, in *spb.PingRequest, opts ...grpc.CallOption) (spb.System_PingClient, error) {
 	return fg.Pinger(ctx, in, opts...)
 }
 
+func (fg *fakeGNOIClient) Traceroute(ctx context.Context, in *spb.TracerouteRequest, opts ...grpc.CallOption) (spb.System_TracerouteClient, error) {
+	return fg.Tracerouter(ctx, in, opts...)
+}
+
 func (fg *fakeGNOIClient) Reboot(ctx context.Context, req *spb.RebootRequest, opts ...grpc.CallOption) (*spb.RebootResponse, error) {
 	return fg.Rebooter(ctx, req, opts...)
 }
@@ -87,10 +114,22 @@ func (fg *fakeGNOIClient) KillProcess(ctx context.Context, req *spb.KillProcessR
 	return fg.KillProcessor(ctx, req, opts...)
 }
 
+func (fg *fakeGNOIClient) SwitchControlProcessor(ctx context.Context, req *spb.SwitchControlProcessorRequest, opts ...grpc.CallOption) (*spb.SwitchControlProcessorResponse, error) {
+	return fg.Switcher(ctx, req, opts...)
+}
+
 func (fg *fakeGNOIClient) Install(ctx context.Context, opts ...grpc.CallOption) (ospb.OS_InstallClient, error) {
 	return fg.Installer(ctx, opts...)
 }
 
+func (fg *fakeGNOIClient) Activate(ctx context.Context, req *ospb.ActivateRequest, opts ...grpc.CallOption) (*ospb.ActivateResponse, error) {
+	return fg.Activator(ctx, req, opts...)
+}
+
+func (fg *fakeGNOIClient) Verify(ctx context.Context, req *ospb.VerifyRequest, opts ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+	return fg.Verifier(ctx, req, opts...)
+}
+
 type fakeInstallClient struct {
 	ospb.OS_InstallClient
 	gotSent  []*ospb.InstallRequest
@@ -213,6 +252,29 @@ func TestInstall(t *testing.T) {
 	}
 }
 
+func TestInstallProgress(t *testing.T) {
+	initOperationFakes(t)
+	const version = "1.2.3"
+	ic := &fakeInstallClient{stubRecv: []*ospb.InstallResponse{
+		{Response: &ospb.InstallResponse_TransferReady{&ospb.TransferReady{}}},
+		{Response: &ospb.InstallResponse_TransferProgress{&ospb.TransferProgress{BytesReceived: 1}}},
+		{Response: &ospb.InstallResponse_TransferProgress{&ospb.TransferProgress{BytesReceived: 2}}},
+		{Response: &ospb.InstallResponse_Validated{&ospb.Validated{Version: version}}},
+	}}
+	fakeGNOI.Installer = func(context.Context, ...grpc.CallOption) (ospb.OS_InstallClient, error) {
+		return ic, nil
+	}
+	var got []uint64
+	DUT(t, "dut").Operations().NewInstall().
+		WithVersion(version).
+		WithPackageReader(bytes.NewReader([]byte{0, 1})).
+		WithProgressFunc(func(n uint64) { got = append(got, n) }).
+		Operate(t)
+	if want := []uint64{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Operate(t) reported progress %v, want %v", got, want)
+	}
+}
+
 func TestInstallErrors(t *testing.T) {
 	initOperationFakes(t)
 	const version = "1.2.3"
@@ -273,23 +335,186 @@ func TestInstallErrors(t *testing.T) {
 
 type fakePingClient struct {
 	spb.System_PingClient
-	resp *spb.PingResponse
-	err  error
+	resps []*spb.PingResponse
+	err   error
 }
 
 func (pc *fakePingClient) Recv() (*spb.PingResponse, error) {
-	if pc.resp == nil && pc.err == nil {
+	if pc.err != nil {
+		return nil, pc.err
+	}
+	if len(pc.resps) == 0 {
 		return nil, io.EOF
 	}
-	resp := pc.resp
-	pc.resp = nil
-	return resp, pc.err
+	resp := pc.resps[0]
+	pc.resps = pc.resps[1:]
+	return resp, nil
 }
 
 func (*fakePingClient) CloseSend() error {
 	return nil
 }
 
+type fakeTracerouteClient struct {
+	spb.System_TracerouteClient
+	resps []*spb.TracerouteResponse
+	err   error
+}
+
+func (tc *fakeTracerouteClient) Recv() (*spb.TracerouteResponse, error) {
+	if tc.err != nil {
+		return nil, tc.err
+	}
+	if len(tc.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := tc.resps[0]
+	tc.resps = tc.resps[1:]
+	return resp, nil
+}
+
+func (*fakeTracerouteClient) CloseSend() error {
+	return nil
+}
+
+func TestActivate(t *testing.T) {
+	initOperationFakes(t)
+	const version = "1.2.3"
+	var gotReq *ospb.ActivateRequest
+	fakeGNOI.Activator = func(_ context.Context, req *ospb.ActivateRequest, _ ...grpc.CallOption) (*ospb.ActivateResponse, error) {
+		gotReq = req
+		return &ospb.ActivateResponse{Response: &ospb.ActivateResponse_ActivateOk{&ospb.ActivateOK{}}}, nil
+	}
+	DUT(t, "dut").Operations().NewActivate().WithVersion(version).WithStandbySupervisor(true).WithNoReboot(true).Operate(t)
+	wantReq := &ospb.ActivateRequest{Version: version, StandbySupervisor: true, NoReboot: true}
+	if diff := cmp.Diff(wantReq, gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Operate(t) unexpected activate request diff (-want,+got): %s", diff)
+	}
+}
+
+func TestActivateErrors(t *testing.T) {
+	initOperationFakes(t)
+	const version = "1.2.3"
+	dut := DUT(t, "dut")
+
+	tests := []struct {
+		desc    string
+		op      *ActivateOp
+		resp    *ospb.ActivateResponse
+		err     error
+		wantErr string
+	}{{
+		desc:    "no version",
+		op:      dut.Operations().NewActivate(),
+		wantErr: "version not set",
+	}, {
+		desc:    "rpc error",
+		op:      dut.Operations().NewActivate().WithVersion(version),
+		err:     errors.New("rpc error"),
+		wantErr: "rpc error",
+	}, {
+		desc: "activate error",
+		op:   dut.Operations().NewActivate().WithVersion(version),
+		resp: &ospb.ActivateResponse{Response: &ospb.ActivateResponse_ActivateError{&ospb.ActivateError{
+			Type:   ospb.ActivateError_NON_EXISTENT_VERSION,
+			Detail: "no such version",
+		}}},
+		wantErr: "no such version",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.Activator = func(context.Context, *ospb.ActivateRequest, ...grpc.CallOption) (*ospb.ActivateResponse, error) {
+				return tt.resp, tt.err
+			}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				tt.op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on activate got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	initOperationFakes(t)
+	const version = "1.2.3"
+	dut := DUT(t, "dut")
+
+	tests := []struct {
+		desc    string
+		op      *VerifyOp
+		resp    *ospb.VerifyResponse
+		err     error
+		wantErr string
+	}{{
+		desc: "success",
+		op:   dut.Operations().NewVerify().WithVersion(version),
+		resp: &ospb.VerifyResponse{Version: version},
+	}, {
+		desc: "standby success",
+		op:   dut.Operations().NewVerify().WithVersion(version).WithStandbySupervisor(true),
+		resp: &ospb.VerifyResponse{
+			Version: "1.0.0",
+			VerifyStandby: &ospb.VerifyStandby{State: &ospb.VerifyStandby_VerifyResponse{&ospb.StandbyResponse{
+				Id:      "2",
+				Version: version,
+			}}},
+		},
+	}, {
+		desc:    "no version",
+		op:      dut.Operations().NewVerify(),
+		wantErr: "version not set",
+	}, {
+		desc:    "rpc error",
+		op:      dut.Operations().NewVerify().WithVersion(version),
+		err:     errors.New("rpc error"),
+		wantErr: "rpc error",
+	}, {
+		desc:    "version mismatch",
+		op:      dut.Operations().NewVerify().WithVersion(version),
+		resp:    &ospb.VerifyResponse{Version: "1.0.0"},
+		wantErr: "does not match",
+	}, {
+		desc:    "activation failed",
+		op:      dut.Operations().NewVerify().WithVersion(version),
+		resp:    &ospb.VerifyResponse{Version: "1.0.0", ActivationFailMessage: "bad image"},
+		wantErr: "bad image",
+	}, {
+		desc: "standby unavailable",
+		op:   dut.Operations().NewVerify().WithVersion(version).WithStandbySupervisor(true),
+		resp: &ospb.VerifyResponse{
+			Version: version,
+			VerifyStandby: &ospb.VerifyStandby{State: &ospb.VerifyStandby_StandbyState{&ospb.StandbyState{
+				State: ospb.StandbyState_UNAVAILABLE,
+			}}},
+		},
+		wantErr: "UNAVAILABLE",
+	}, {
+		desc:    "no standby",
+		op:      dut.Operations().NewVerify().WithVersion(version).WithStandbySupervisor(true),
+		resp:    &ospb.VerifyResponse{Version: version},
+		wantErr: "standby",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.Verifier = func(context.Context, *ospb.VerifyRequest, ...grpc.CallOption) (*ospb.VerifyResponse, error) {
+				return tt.resp, tt.err
+			}
+			if tt.wantErr == "" {
+				tt.op.Operate(t)
+				return
+			}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				tt.op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on verify got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestPing(t *testing.T) {
 	initOperationFakes(t)
 	tests := []struct {
@@ -304,7 +529,7 @@ func TestPing(t *testing.T) {
 			var got string
 			fakeGNOI.Pinger = func(_ context.Context, req *spb.PingRequest, _ ...grpc.CallOption) (spb.System_PingClient, error) {
 				got = req.GetDestination()
-				return &fakePingClient{resp: &spb.PingResponse{Sent: tt.count, Received: tt.count}}, nil
+				return &fakePingClient{resps: []*spb.PingResponse{{Sent: tt.count, Received: tt.count}}}, nil
 			}
 			want := tt.dest
 			DUT(t, "dut").Operations().NewPing().WithDestination(tt.dest).WithCount(tt.count).Operate(t)
@@ -343,7 +568,7 @@ func TestPingErrors(t *testing.T) {
 		dest:    "1.2.3.4",
 		pinger: func(_ context.Context, req *spb.PingRequest, _ ...grpc.CallOption) (spb.System_PingClient, error) {
 			return &fakePingClient{
-				resp: &spb.PingResponse{Sent: 5, Received: 3},
+				resps: []*spb.PingResponse{{Sent: 5, Received: 3}},
 			}, nil
 		},
 	},
@@ -363,6 +588,171 @@ func TestPingErrors(t *testing.T) {
 	}
 }
 
+func TestPingResult(t *testing.T) {
+	initOperationFakes(t)
+	var gotReq *spb.PingRequest
+	fakeGNOI.Pinger = func(_ context.Context, req *spb.PingRequest, _ ...grpc.CallOption) (spb.System_PingClient, error) {
+		gotReq = req
+		return &fakePingClient{resps: []*spb.PingResponse{
+			{Source: "1.2.3.4", Time: int64(2 * time.Millisecond), Bytes: 64, Sequence: 1, Ttl: 63},
+			{Source: "1.2.3.4", Time: int64(4 * time.Millisecond), Bytes: 64, Sequence: 3, Ttl: 63},
+			{
+				Sent:     4,
+				Received: 2,
+				MinTime:  int64(2 * time.Millisecond),
+				AvgTime:  int64(3 * time.Millisecond),
+				MaxTime:  int64(4 * time.Millisecond),
+				StdDev:   int64(time.Millisecond),
+			},
+		}}, nil
+	}
+	opts := NewPingOptions().WithCount(4).WithSource("5.6.7.8").WithInterval(time.Second)
+	got := DUT(t, "dut").Operations().Ping(t, "1.2.3.4", opts)
+
+	wantReq := &spb.PingRequest{
+		Destination: "1.2.3.4",
+		Source:      "5.6.7.8",
+		Count:       4,
+		Interval:    int64(time.Second),
+	}
+	if diff := cmp.Diff(wantReq, gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Ping(t) sent unexpected request (-want,+got):\n%s", diff)
+	}
+	want := &PingResult{
+		Sent:      4,
+		Received:  2,
+		MinRTT:    2 * time.Millisecond,
+		AvgRTT:    3 * time.Millisecond,
+		MaxRTT:    4 * time.Millisecond,
+		StdDevRTT: time.Millisecond,
+		Replies: []*PingReply{
+			{Source: "1.2.3.4", RTT: 2 * time.Millisecond, Bytes: 64, Sequence: 1, TTL: 63},
+			{Source: "1.2.3.4", RTT: 4 * time.Millisecond, Bytes: 64, Sequence: 3, TTL: 63},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Ping(t) got unexpected result (-want,+got):\n%s", diff)
+	}
+	if got, want := got.Loss(), 50.0; got != want {
+		t.Errorf("Loss() got %v, want %v", got, want)
+	}
+}
+
+func TestPingResultErrors(t *testing.T) {
+	initOperationFakes(t)
+	tests := []struct {
+		wantErr, dest string
+		pinger        func(context.Context, *spb.PingRequest, ...grpc.CallOption) (spb.System_PingClient, error)
+	}{{
+		wantErr: "no destination",
+	}, {
+		wantErr: "recv error",
+		dest:    "1.2.3.4",
+		pinger: func(_ context.Context, req *spb.PingRequest, _ ...grpc.CallOption) (spb.System_PingClient, error) {
+			return &fakePingClient{err: errors.New("recv error")}, nil
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.wantErr, func(t *testing.T) {
+			fakeGNOI.Pinger = tt.pinger
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				DUT(t, "dut").Operations().Ping(t, tt.dest, nil)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Ping(t) got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTraceroute(t *testing.T) {
+	initOperationFakes(t)
+	var gotReq *spb.TracerouteRequest
+	fakeGNOI.Tracerouter = func(_ context.Context, req *spb.TracerouteRequest, _ ...grpc.CallOption) (spb.System_TracerouteClient, error) {
+		gotReq = req
+		return &fakeTracerouteClient{resps: []*spb.TracerouteResponse{
+			{DestinationName: "dest", DestinationAddress: "10.0.0.2", Hops: 30, PacketSize: 60},
+			{Hop: 1, Address: "10.0.1.1", Rtt: int64(time.Millisecond)},
+			{Hop: 1, State: spb.TracerouteResponse_NONE},
+			{Hop: 2, Address: "10.0.0.2", Rtt: int64(3 * time.Millisecond)},
+			{Hop: 2, Address: "10.0.0.2", Rtt: int64(5 * time.Millisecond)},
+		}}, nil
+	}
+	opts := NewTracerouteOptions().WithMaxTTL(8).WithUDPProbes()
+	got := DUT(t, "dut").Operations().Traceroute(t, "10.0.0.2", opts)
+
+	wantReq := &spb.TracerouteRequest{
+		Destination: "10.0.0.2",
+		MaxTtl:      8,
+		L4Protocol:  spb.TracerouteRequest_UDP,
+	}
+	if diff := cmp.Diff(wantReq, gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Traceroute(t) sent unexpected request (-want,+got):\n%s", diff)
+	}
+	want := &TracerouteResult{
+		DestinationName:    "dest",
+		DestinationAddress: "10.0.0.2",
+		Hops: []*TracerouteHop{{
+			Hop: 1,
+			Probes: []*TracerouteProbe{
+				{Address: "10.0.1.1", RTT: time.Millisecond},
+				{State: spb.TracerouteResponse_NONE},
+			},
+		}, {
+			Hop: 2,
+			Probes: []*TracerouteProbe{
+				{Address: "10.0.0.2", RTT: 3 * time.Millisecond},
+				{Address: "10.0.0.2", RTT: 5 * time.Millisecond},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Traceroute(t) got unexpected result (-want,+got):\n%s", diff)
+	}
+	if !got.Reached() {
+		t.Errorf("Reached() got false, want true")
+	}
+	if diff := cmp.Diff([]string{"10.0.1.1", "10.0.0.2"}, got.Addresses()); diff != "" {
+		t.Errorf("Addresses() got unexpected addresses (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]time.Duration{time.Millisecond}, got.Hops[0].RTTs()); diff != "" {
+		t.Errorf("RTTs() got unexpected RTTs (-want,+got):\n%s", diff)
+	}
+}
+
+func TestTracerouteErrors(t *testing.T) {
+	initOperationFakes(t)
+	tests := []struct {
+		wantErr, dest string
+		tracerouter   func(context.Context, *spb.TracerouteRequest, ...grpc.CallOption) (spb.System_TracerouteClient, error)
+	}{{
+		wantErr: "no destination",
+	}, {
+		wantErr: "traceroute error",
+		dest:    "1.2.3.4",
+		tracerouter: func(_ context.Context, req *spb.TracerouteRequest, _ ...grpc.CallOption) (spb.System_TracerouteClient, error) {
+			return nil, errors.New("traceroute error")
+		},
+	}, {
+		wantErr: "recv error",
+		dest:    "1.2.3.4",
+		tracerouter: func(_ context.Context, req *spb.TracerouteRequest, _ ...grpc.CallOption) (spb.System_TracerouteClient, error) {
+			return &fakeTracerouteClient{err: errors.New("recv error")}, nil
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.wantErr, func(t *testing.T) {
+			fakeGNOI.Tracerouter = tt.tracerouter
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				DUT(t, "dut").Operations().Traceroute(t, tt.dest, nil)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Traceroute(t) got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestSetInterfaceState(t *testing.T) {
 	initOperationFakes(t)
 	var gotConfig string
@@ -587,6 +977,449 @@ func TestRebootErrors(t *testing.T) {
 	}
 }
 
+type fakeGNMIClient struct {
+	gpb.GNMIClient
+	capErrs []error
+	getResp *gpb.GetResponse
+}
+
+func (fg *fakeGNMIClient) Capabilities(context.Context, *gpb.CapabilityRequest, ...grpc.CallOption) (*gpb.CapabilityResponse, error) {
+	if len(fg.capErrs) == 0 {
+		return &gpb.CapabilityResponse{}, nil
+	}
+	err := fg.capErrs[0]
+	fg.capErrs = fg.capErrs[1:]
+	return &gpb.CapabilityResponse{}, err
+}
+
+func (fg *fakeGNMIClient) Get(context.Context, *gpb.GetRequest, ...grpc.CallOption) (*gpb.GetResponse, error) {
+	if fg.getResp == nil {
+		return nil, errors.New("no get response")
+	}
+	return fg.getResp, nil
+}
+
+func TestRebootAwaitHealthy(t *testing.T) {
+	initOperationFakes(t)
+	dut := DUT(t, "dut")
+	clearGNMICache := func() {
+		gnmisMu.Lock()
+		defer gnmisMu.Unlock()
+		delete(gnmis, dut.res)
+	}
+	clearGNMICache()
+	t.Cleanup(clearGNMICache)
+	fakeGNOI.Rebooter = func(context.Context, *spb.RebootRequest, ...grpc.CallOption) (*spb.RebootResponse, error) {
+		return &spb.RebootResponse{}, nil
+	}
+	unavailable := errors.New("device unavailable")
+
+	tests := []struct {
+		desc      string
+		capErrs   []error
+		healthErr error
+		wantErr   string
+	}{{
+		desc:    "success",
+		capErrs: []error{unavailable},
+	}, {
+		desc:    "never unreachable",
+		wantErr: "unreachable",
+	}, {
+		desc:    "never reachable",
+		capErrs: []error{unavailable, unavailable},
+		wantErr: "become reachable",
+	}, {
+		desc:      "never healthy",
+		capErrs:   []error{unavailable},
+		healthErr: errors.New("line card down"),
+		wantErr:   "health checks to pass: line card down",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fg := &fakeGNMIClient{capErrs: tt.capErrs}
+			fakeBind.GNMIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (gpb.GNMIClient, error) {
+				return fg, nil
+			}
+			clearGNMICache()
+			var checked bool
+			reboot := dut.Operations().NewReboot().WithTimeout(1).WithAwaitHealthy(func() error {
+				checked = true
+				return tt.healthErr
+			})
+			if tt.wantErr == "" {
+				reboot.Operate(t)
+				if !checked {
+					t.Errorf("Operate(t) on reboot did not run the health check")
+				}
+				return
+			}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				reboot.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on reboot got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSwitchoverSupervisor(t *testing.T) {
+	initOperationFakes(t)
+	dut := DUT(t, "dut")
+	clearGNMICache := func() {
+		gnmisMu.Lock()
+		defer gnmisMu.Unlock()
+		delete(gnmis, dut.res)
+	}
+	t.Cleanup(clearGNMICache)
+	sup1Path := &tpb.Path{Elem: []*tpb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": "Supervisor1"}},
+	}}
+	sup2Path := &tpb.Path{Elem: []*tpb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": "Supervisor2"}},
+	}}
+	operStatus := func(status string) *gpb.GetResponse {
+		return &gpb.GetResponse{Notification: []*gpb.Notification{{
+			Update: []*gpb.Update{{Val: &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{
+				JsonIetfVal: []byte(`"openconfig-platform-types:` + status + `"`),
+			}}}},
+		}}}
+	}
+
+	tests := []struct {
+		desc       string
+		supervisor string
+		switchedTo *tpb.Path
+		getResp    *gpb.GetResponse
+		healthErr  error
+		wantErr    string
+	}{{
+		desc:       "success",
+		supervisor: "Supervisor2",
+		switchedTo: sup2Path,
+		getResp:    operStatus("ACTIVE"),
+	}, {
+		desc:    "no supervisor",
+		wantErr: "supervisor not set",
+	}, {
+		desc:       "wrong supervisor",
+		supervisor: "Supervisor2",
+		switchedTo: sup1Path,
+		getResp:    operStatus("ACTIVE"),
+		wantErr:    "Supervisor1",
+	}, {
+		desc:       "never active",
+		supervisor: "Supervisor2",
+		switchedTo: sup2Path,
+		getResp:    operStatus("INACTIVE"),
+		wantErr:    "to become active",
+	}, {
+		desc:       "never healthy",
+		supervisor: "Supervisor2",
+		switchedTo: sup2Path,
+		getResp:    operStatus("ACTIVE"),
+		healthErr:  errors.New("line card down"),
+		wantErr:    "health checks to pass: line card down",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var gotReq *spb.SwitchControlProcessorRequest
+			fakeGNOI.Switcher = func(_ context.Context, req *spb.SwitchControlProcessorRequest, _ ...grpc.CallOption) (*spb.SwitchControlProcessorResponse, error) {
+				gotReq = req
+				return &spb.SwitchControlProcessorResponse{ControlProcessor: tt.switchedTo}, nil
+			}
+			fg := &fakeGNMIClient{getResp: tt.getResp}
+			fakeBind.GNMIDialer = func(context.Context, *binding.DUT, ...grpc.DialOption) (gpb.GNMIClient, error) {
+				return fg, nil
+			}
+			clearGNMICache()
+			op := dut.Operations().NewSwitchoverSupervisor().
+				WithSupervisor(tt.supervisor).
+				WithTimeout(1).
+				WithAwaitHealthy(func() error { return tt.healthErr })
+			if tt.wantErr == "" {
+				op.Operate(t)
+				if diff := cmp.Diff(sup2Path, gotReq.GetControlProcessor(), protocmp.Transform()); diff != "" {
+					t.Errorf("Operate(t) sent unexpected control processor (-want,+got):\n%s", diff)
+				}
+				return
+			}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on switchover got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+type fakeCertClient struct {
+	cpb.CertificateManagementClient
+	stream     *fakeCertStream
+	revokeReq  *cpb.RevokeCertificatesRequest
+	revokeResp *cpb.RevokeCertificatesResponse
+}
+
+func (fc *fakeCertClient) Install(context.Context, ...grpc.CallOption) (cpb.CertificateManagement_InstallClient, error) {
+	return &fakeCertInstallClient{fc.stream}, nil
+}
+
+func (fc *fakeCertClient) Rotate(context.Context, ...grpc.CallOption) (cpb.CertificateManagement_RotateClient, error) {
+	return &fakeCertRotateClient{fc.stream}, nil
+}
+
+func (fc *fakeCertClient) RevokeCertificates(_ context.Context, req *cpb.RevokeCertificatesRequest, _ ...grpc.CallOption) (*cpb.RevokeCertificatesResponse, error) {
+	fc.revokeReq = req
+	return fc.revokeResp, nil
+}
+
+// fakeCertStream responds to the first request with a CSR generated by the
+// device and to the second with a load certificate response.
+type fakeCertStream struct {
+	grpc.ClientStream
+	csrPEM []byte
+	recvs  int
+	loaded *cpb.LoadCertificateRequest
+	final  bool
+	closed bool
+}
+
+func (s *fakeCertStream) CloseSend() error {
+	s.closed = true
+	return nil
+}
+
+type fakeCertInstallClient struct {
+	*fakeCertStream
+}
+
+func (c *fakeCertInstallClient) Send(req *cpb.InstallCertificateRequest) error {
+	if load := req.GetLoadCertificate(); load != nil {
+		c.loaded = load
+	}
+	return nil
+}
+
+func (c *fakeCertInstallClient) Recv() (*cpb.InstallCertificateResponse, error) {
+	c.recvs++
+	switch c.recvs {
+	case 1:
+		return &cpb.InstallCertificateResponse{InstallResponse: &cpb.InstallCertificateResponse_GeneratedCsr{
+			&cpb.GenerateCSRResponse{Csr: &cpb.CSR{Type: cpb.CertificateType_CT_X509, Csr: c.csrPEM}},
+		}}, nil
+	case 2:
+		return &cpb.InstallCertificateResponse{InstallResponse: &cpb.InstallCertificateResponse_LoadCertificate{
+			&cpb.LoadCertificateResponse{},
+		}}, nil
+	default:
+		return nil, io.EOF
+	}
+}
+
+type fakeCertRotateClient struct {
+	*fakeCertStream
+}
+
+func (c *fakeCertRotateClient) Send(req *cpb.RotateCertificateRequest) error {
+	if load := req.GetLoadCertificate(); load != nil {
+		c.loaded = load
+	}
+	if req.GetFinalizeRotation() != nil {
+		c.final = true
+	}
+	return nil
+}
+
+func (c *fakeCertRotateClient) Recv() (*cpb.RotateCertificateResponse, error) {
+	c.recvs++
+	switch c.recvs {
+	case 1:
+		return &cpb.RotateCertificateResponse{RotateResponse: &cpb.RotateCertificateResponse_GeneratedCsr{
+			&cpb.GenerateCSRResponse{Csr: &cpb.CSR{Type: cpb.CertificateType_CT_X509, Csr: c.csrPEM}},
+		}}, nil
+	case 2:
+		return &cpb.RotateCertificateResponse{RotateResponse: &cpb.RotateCertificateResponse_LoadCertificate{
+			&cpb.LoadCertificateResponse{},
+		}}, nil
+	default:
+		return nil, io.EOF
+	}
+}
+
+// checkLoaded checks that a loaded certificate is signed by the CA.
+func checkLoaded(t *testing.T, ca *TestCA, certID string, load *cpb.LoadCertificateRequest) {
+	t.Helper()
+	if load == nil {
+		t.Fatalf("Operate(t) did not load a certificate")
+	}
+	if got := load.GetCertificateId(); got != certID {
+		t.Errorf("Operate(t) loaded certificate ID %q, want %q", got, certID)
+	}
+	if len(load.GetCaCertificates()) != 1 || !bytes.Equal(load.GetCaCertificates()[0].GetCertificate(), ca.CertificatePEM()) {
+		t.Errorf("Operate(t) loaded CA certificates %v, want the certificate of the CA", load.GetCaCertificates())
+	}
+	verifyCert(t, ca, load.GetCertificate().GetCertificate())
+}
+
+func TestInstallCertificate(t *testing.T) {
+	initOperationFakes(t)
+	ca := NewTestCA(t, "ca")
+	csrPEM, _ := NewTestCSR(t, "dut")
+	stream := &fakeCertStream{csrPEM: csrPEM}
+	fakeGNOI.certMgmt = &fakeCertClient{stream: stream}
+
+	DUT(t, "dut").Operations().NewInstallCertificate().
+		WithCertificateID("cert1").
+		WithCommonName("dut").
+		WithCA(ca).
+		Operate(t)
+	checkLoaded(t, ca, "cert1", stream.loaded)
+	if !stream.closed {
+		t.Errorf("Operate(t) did not close the install stream")
+	}
+}
+
+func TestInstallCertificateErrors(t *testing.T) {
+	initOperationFakes(t)
+	ca := NewTestCA(t, "ca")
+	dut := DUT(t, "dut")
+
+	tests := []struct {
+		desc    string
+		op      *InstallCertificateOp
+		csrPEM  []byte
+		wantErr string
+	}{{
+		desc:    "no certificate ID",
+		op:      dut.Operations().NewInstallCertificate().WithCA(ca),
+		wantErr: "certificate ID not set",
+	}, {
+		desc:    "no CA",
+		op:      dut.Operations().NewInstallCertificate().WithCertificateID("cert1"),
+		wantErr: "certificate authority not set",
+	}, {
+		desc:    "no CSR",
+		op:      dut.Operations().NewInstallCertificate().WithCertificateID("cert1").WithCA(ca),
+		wantErr: "did not generate a CSR",
+	}, {
+		desc:    "bad CSR",
+		op:      dut.Operations().NewInstallCertificate().WithCertificateID("cert1").WithCA(ca),
+		csrPEM:  []byte("not a CSR"),
+		wantErr: "error signing CSR",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.certMgmt = &fakeCertClient{stream: &fakeCertStream{csrPEM: tt.csrPEM}}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				tt.op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on install certificate got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRotateCertificate(t *testing.T) {
+	initOperationFakes(t)
+	ca := NewTestCA(t, "ca")
+	csrPEM, _ := NewTestCSR(t, "dut")
+	dut := DUT(t, "dut")
+
+	tests := []struct {
+		desc      string
+		validate  func(testing.TB) bool
+		wantFinal bool
+		wantErr   string
+	}{{
+		desc:      "no validation",
+		wantFinal: true,
+	}, {
+		desc:      "validation passed",
+		validate:  func(testing.TB) bool { return true },
+		wantFinal: true,
+	}, {
+		desc:     "validation failed",
+		validate: func(testing.TB) bool { return false },
+		wantErr:  "rolled back",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			stream := &fakeCertStream{csrPEM: csrPEM}
+			fakeGNOI.certMgmt = &fakeCertClient{stream: stream}
+			op := dut.Operations().NewRotateCertificate().WithCertificateID("cert1").WithCA(ca).WithValidation(tt.validate)
+			if tt.wantErr == "" {
+				op.Operate(t)
+			} else {
+				gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+					op.Operate(t)
+				})
+				if !strings.Contains(gotErr, tt.wantErr) {
+					t.Errorf("Operate(t) on rotate certificate got %q, want %q", gotErr, tt.wantErr)
+				}
+			}
+			checkLoaded(t, ca, "cert1", stream.loaded)
+			if stream.final != tt.wantFinal {
+				t.Errorf("Operate(t) on rotate certificate finalized rotation: %t, want %t", stream.final, tt.wantFinal)
+			}
+		})
+	}
+}
+
+func TestRevokeCertificates(t *testing.T) {
+	initOperationFakes(t)
+	dut := DUT(t, "dut")
+
+	tests := []struct {
+		desc    string
+		ids     []string
+		resp    *cpb.RevokeCertificatesResponse
+		wantErr string
+	}{{
+		desc: "success",
+		ids:  []string{"cert1", "cert2"},
+		resp: &cpb.RevokeCertificatesResponse{RevokedCertificateId: []string{"cert1", "cert2"}},
+	}, {
+		desc:    "no IDs",
+		wantErr: "no certificate IDs",
+	}, {
+		desc: "revocation error",
+		ids:  []string{"cert1", "cert2"},
+		resp: &cpb.RevokeCertificatesResponse{
+			RevokedCertificateId: []string{"cert1"},
+			CertificateRevocationError: []*cpb.CertificateRevocationError{{
+				CertificateId: "cert2",
+				ErrorMessage:  "no such certificate",
+			}},
+		},
+		wantErr: "no such certificate",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fc := &fakeCertClient{revokeResp: tt.resp}
+			fakeGNOI.certMgmt = fc
+			op := dut.Operations().NewRevokeCertificates().WithCertificateIDs(tt.ids...)
+			if tt.wantErr != "" {
+				gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+					op.Operate(t)
+				})
+				if !strings.Contains(gotErr, tt.wantErr) {
+					t.Errorf("Operate(t) on revoke certificates got %q, want %q", gotErr, tt.wantErr)
+				}
+				return
+			}
+			op.Operate(t)
+			if diff := cmp.Diff(tt.ids, fc.revokeReq.GetCertificateId()); diff != "" {
+				t.Errorf("Operate(t) unexpected revoked IDs diff (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestKillProcess(t *testing.T) {
 	initOperationFakes(t)
 	var killed bool
@@ -603,6 +1436,90 @@ func TestKillProcess(t *testing.T) {
 	}
 }
 
+func TestKillProcessAwaitRestart(t *testing.T) {
+	initOperationFakes(t)
+	dut := DUT(t, "dut")
+	fakeBind.GNMIDialer = func(ctx context.Context, _ *binding.DUT, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
+		return fakeGNMI.Dial(ctx, opts...)
+	}
+	clearGNMICache := func() {
+		gnmisMu.Lock()
+		defer gnmisMu.Unlock()
+		delete(gnmis, dut.res)
+	}
+	clearGNMICache()
+	t.Cleanup(clearGNMICache)
+	stubProcess := func(pid, start uint64) {
+		prefix := fmt.Sprintf("/system/processes/process[pid=%d]/state/", pid)
+		fakeGNMI.Stub().Notification(&gpb.Notification{
+			Update: []*gpb.Update{{
+				Path: gnmiPath(t, prefix+"name"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "bgpd"}},
+			}, {
+				Path: gnmiPath(t, prefix+"start-time"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: start}},
+			}},
+			Timestamp: 100,
+		}).Sync()
+	}
+
+	tests := []struct {
+		desc         string
+		name         string
+		restartPID   uint64
+		restartStart uint64
+		wantErr      string
+	}{{
+		desc:         "new pid",
+		name:         "bgpd",
+		restartPID:   2,
+		restartStart: 1000,
+	}, {
+		desc:         "same pid, new start time",
+		name:         "bgpd",
+		restartPID:   1,
+		restartStart: 2000,
+	}, {
+		desc:    "no name",
+		wantErr: "requires the process name",
+	}, {
+		desc:    "no such process",
+		name:    "ospfd",
+		wantErr: "no process named",
+	}, {
+		desc:         "not restarted",
+		name:         "bgpd",
+		restartPID:   1,
+		restartStart: 1000,
+		wantErr:      "did not restart",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			stubProcess(1, 1000)
+			var killed bool
+			fakeGNOI.KillProcessor = func(context.Context, *spb.KillProcessRequest, ...grpc.CallOption) (*spb.KillProcessResponse, error) {
+				killed = true
+				stubProcess(tt.restartPID, tt.restartStart)
+				return &spb.KillProcessResponse{}, nil
+			}
+			op := dut.Operations().NewKillProcess().WithName(tt.name).WithRestart(true).WithAwaitRestart(1)
+			if tt.wantErr == "" {
+				op.Operate(t)
+				if !killed {
+					t.Errorf("Operate(t) on %v did not kill the process", op)
+				}
+				return
+			}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) on %v got %q, want %q", op, gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestKillProcessErrors(t *testing.T) {
 	initOperationFakes(t)
 	fakeGNOI.KillProcessor = func(context.Context, *spb.KillProcessRequest, ...grpc.CallOption) (*spb.KillProcessResponse, error) {
@@ -636,3 +1553,340 @@ func TestKillProcessErrors(t *testing.T) {
 		})
 	}
 }
+
+// fakeFileClient serves the content of a single file on Get and records the
+// requests of a Put.
+type fakeFileClient struct {
+	fpb.FileClient
+	content []byte
+	getHash *tpb.HashType
+	putReqs []*fpb.PutRequest
+	putErr  error
+	stats   []*fpb.StatInfo
+}
+
+func (fc *fakeFileClient) Put(context.Context, ...grpc.CallOption) (fpb.File_PutClient, error) {
+	return &fakeFilePutClient{fc: fc}, nil
+}
+
+func (fc *fakeFileClient) Get(context.Context, *fpb.GetRequest, ...grpc.CallOption) (fpb.File_GetClient, error) {
+	resps := []*fpb.GetResponse{
+		{Response: &fpb.GetResponse_Contents{fc.content[:len(fc.content)/2]}},
+		{Response: &fpb.GetResponse_Contents{fc.content[len(fc.content)/2:]}},
+	}
+	if fc.getHash != nil {
+		resps = append(resps, &fpb.GetResponse{Response: &fpb.GetResponse_Hash{fc.getHash}})
+	}
+	return &fakeFileGetClient{resps: resps}, nil
+}
+
+func (fc *fakeFileClient) Stat(_ context.Context, req *fpb.StatRequest, _ ...grpc.CallOption) (*fpb.StatResponse, error) {
+	return &fpb.StatResponse{Stats: fc.stats}, nil
+}
+
+type fakeFilePutClient struct {
+	fpb.File_PutClient
+	fc *fakeFileClient
+}
+
+func (pc *fakeFilePutClient) Send(req *fpb.PutRequest) error {
+	pc.fc.putReqs = append(pc.fc.putReqs, req)
+	return nil
+}
+
+func (pc *fakeFilePutClient) CloseAndRecv() (*fpb.PutResponse, error) {
+	return &fpb.PutResponse{}, pc.fc.putErr
+}
+
+type fakeFileGetClient struct {
+	fpb.File_GetClient
+	resps []*fpb.GetResponse
+}
+
+func (gc *fakeFileGetClient) Recv() (*fpb.GetResponse, error) {
+	if len(gc.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := gc.resps[0]
+	gc.resps = gc.resps[1:]
+	return resp, nil
+}
+
+func TestPutFile(t *testing.T) {
+	initOperationFakes(t)
+	fc := &fakeFileClient{}
+	fakeGNOI.file = fc
+	content := []byte("route table")
+	DUT(t, "dut").Operations().NewPutFile().
+		WithRemotePath("/tmp/routes").
+		WithPermissions(600).
+		WithContents(content).
+		Operate(t)
+
+	hash := sha256.Sum256(content)
+	want := []*fpb.PutRequest{
+		{Request: &fpb.PutRequest_Open{&fpb.PutRequest_Details{RemoteFile: "/tmp/routes", Permissions: 600}}},
+		{Request: &fpb.PutRequest_Contents{content}},
+		{Request: &fpb.PutRequest_Hash{&tpb.HashType{Method: tpb.HashType_SHA256, Hash: hash[:]}}},
+	}
+	if diff := cmp.Diff(want, fc.putReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Operate(t) sent unexpected requests (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPutFileErrors(t *testing.T) {
+	initOperationFakes(t)
+	tests := []struct {
+		desc, wantErr string
+		op            *PutFileOp
+		putErr        error
+	}{{
+		desc:    "no remote path",
+		wantErr: "remote path",
+		op:      DUT(t, "dut").Operations().NewPutFile().WithContents([]byte("x")),
+	}, {
+		desc:    "no content",
+		wantErr: "no content",
+		op:      DUT(t, "dut").Operations().NewPutFile().WithRemotePath("/tmp/x"),
+	}, {
+		desc:    "put fails",
+		wantErr: "hash mismatch",
+		op:      DUT(t, "dut").Operations().NewPutFile().WithRemotePath("/tmp/x").WithContents([]byte("x")),
+		putErr:  errors.New("hash mismatch"),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.file = &fakeFileClient{putErr: tt.putErr}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				tt.op.Operate(t)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("Operate(t) got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetFile(t *testing.T) {
+	initOperationFakes(t)
+	content := []byte("core dump")
+	sha := sha256.Sum256(content)
+	md := md5.Sum(content)
+	tests := []struct {
+		desc string
+		hash *tpb.HashType
+	}{{
+		desc: "sha256",
+		hash: &tpb.HashType{Method: tpb.HashType_SHA256, Hash: sha[:]},
+	}, {
+		desc: "md5",
+		hash: &tpb.HashType{Method: tpb.HashType_MD5, Hash: md[:]},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.file = &fakeFileClient{content: content, getHash: tt.hash}
+			got := DUT(t, "dut").Operations().GetFile(t, "/var/core/core.1")
+			if !bytes.Equal(got, content) {
+				t.Errorf("GetFile(t) got %q, want %q", got, content)
+			}
+		})
+	}
+}
+
+func TestGetFileErrors(t *testing.T) {
+	initOperationFakes(t)
+	content := []byte("core dump")
+	tests := []struct {
+		desc, path, wantErr string
+		hash                *tpb.HashType
+	}{{
+		desc:    "no remote path",
+		wantErr: "remote path",
+	}, {
+		desc:    "no hash",
+		path:    "/var/core/core.1",
+		wantErr: "no hash",
+	}, {
+		desc:    "hash mismatch",
+		path:    "/var/core/core.1",
+		hash:    &tpb.HashType{Method: tpb.HashType_SHA256, Hash: []byte("bad")},
+		wantErr: "SHA256 hash of content",
+	}, {
+		desc:    "unsupported method",
+		path:    "/var/core/core.1",
+		hash:    &tpb.HashType{Hash: []byte("bad")},
+		wantErr: "unsupported hash method",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fakeGNOI.file = &fakeFileClient{content: content, getHash: tt.hash}
+			gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+				DUT(t, "dut").Operations().GetFile(t, tt.path)
+			})
+			if !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("GetFile(t) got %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStatFile(t *testing.T) {
+	initOperationFakes(t)
+	fakeGNOI.file = &fakeFileClient{stats: []*fpb.StatInfo{{
+		Path:         "/var/core/core.1",
+		LastModified: 1e9,
+		Permissions:  644,
+		Size:         1024,
+		Umask:        22,
+	}}}
+	got := DUT(t, "dut").Operations().StatFile(t, "/var/core")
+	want := []*FileStat{{
+		Path:         "/var/core/core.1",
+		LastModified: time.Unix(1, 0),
+		Permissions:  644,
+		Size:         1024,
+		Umask:        22,
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("StatFile(t) got unexpected stats (-want,+got):\n%s", diff)
+	}
+}
+
+func TestHealthz(t *testing.T) {
+	initOperationFakes(t)
+	fpcPath := &tpb.Path{Elem: []*tpb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": "FPC0"}},
+	}}
+	pfePath := &tpb.Path{Elem: []*tpb.PathElem{
+		{Name: "components"},
+		{Name: "component", Key: map[string]string{"name": "PFE0"}},
+	}}
+	tests := []struct {
+		desc          string
+		status        *hpb.ComponentStatus
+		want          *ComponentHealth
+		wantUnhealthy []string
+	}{{
+		desc:   "healthy",
+		status: &hpb.ComponentStatus{Path: fpcPath, Status: hpb.Status_STATUS_HEALTHLY},
+		want: &ComponentHealth{
+			Path:   "/components/component[name=FPC0]",
+			Status: hpb.Status_STATUS_HEALTHLY,
+		},
+	}, {
+		desc: "unhealthy subcomponent",
+		status: &hpb.ComponentStatus{
+			Path:   fpcPath,
+			Status: hpb.Status_STATUS_HEALTHLY,
+			Subcomponents: []*hpb.ComponentStatus{
+				{Path: pfePath, Status: hpb.Status_STATUS_UNHEALTHY},
+			},
+		},
+		want: &ComponentHealth{
+			Path:   "/components/component[name=FPC0]",
+			Status: hpb.Status_STATUS_HEALTHLY,
+			Subcomponents: []*ComponentHealth{{
+				Path:   "/components/component[name=PFE0]",
+				Status: hpb.Status_STATUS_UNHEALTHY,
+			}},
+		},
+		wantUnhealthy: []string{"/components/component[name=PFE0]"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			hc := &fakeHealthzClient{status: tt.status}
+			fakeGNOI.GNOIClients = hc
+			defer func() { fakeGNOI.GNOIClients = nil }()
+			dir := t.TempDir()
+			diagDir = dir
+			defer func() { diagDir = "" }()
+
+			got := DUT(t, "dut").Operations().Healthz(t, "/components/component[name=FPC0]")
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("Healthz(t) got unexpected status (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(fpcPath, hc.req.GetPath(), protocmp.Transform()); diff != "" {
+				t.Errorf("Healthz(t) sent unexpected path (-want,+got):\n%s", diff)
+			}
+			var gotUnhealthy []string
+			for _, c := range got.Unhealthy() {
+				gotUnhealthy = append(gotUnhealthy, c.Path)
+			}
+			if diff := cmp.Diff(tt.wantUnhealthy, gotUnhealthy); diff != "" {
+				t.Errorf("Unhealthy() got unexpected components (-want,+got):\n%s", diff)
+			}
+			artifacts, err := filepath.Glob(filepath.Join(dir, t.Name(), "dut", "healthz-*.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := len(artifacts), len(tt.wantUnhealthy); (got > 0) != (want > 0) {
+				t.Errorf("Healthz(t) wrote %d artifacts, want them only for unhealthy components", got)
+			}
+		})
+	}
+}
+
+type fakePacketCapture struct {
+	pcap    []byte
+	stopped bool
+}
+
+func (c *fakePacketCapture) Stop(context.Context) ([]byte, error) {
+	c.stopped = true
+	return c.pcap, nil
+}
+
+func TestPacketCapture(t *testing.T) {
+	initOperationFakes(t)
+	dut := DUT(t, "dut")
+	port := dut.Port(t, "port1")
+	pc := &fakePacketCapture{pcap: []byte("pcap")}
+	var gotPorts []*binding.Port
+	var gotFilter string
+	fakeBind.PacketCapturer = func(_ context.Context, _ *binding.DUT, ports []*binding.Port, filter string) (binding.PacketCapture, error) {
+		gotPorts = ports
+		gotFilter = filter
+		return pc, nil
+	}
+	dir := t.TempDir()
+	diagDir = dir
+	defer func() { diagDir = "" }()
+
+	capture := dut.Operations().NewPacketCapture().WithPorts(port).WithFilter("tcp port 179").Start(t)
+	if diff := cmp.Diff([]*binding.Port{port.res}, gotPorts); diff != "" {
+		t.Errorf("Start(t) captured on unexpected ports (-want,+got):\n%s", diff)
+	}
+	if want := "tcp port 179"; gotFilter != want {
+		t.Errorf("Start(t) got filter %q, want %q", gotFilter, want)
+	}
+	if got, want := capture.Stop(t), []byte("pcap"); !bytes.Equal(got, want) {
+		t.Errorf("Stop(t) got %q, want %q", got, want)
+	}
+	if !pc.stopped {
+		t.Errorf("Stop(t) did not stop the capture")
+	}
+	artifacts, err := filepath.Glob(filepath.Join(dir, t.Name(), "dut", "capture-*.pcap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(artifacts) != 1 {
+		t.Fatalf("Stop(t) wrote captures %v, want one", artifacts)
+	}
+	if got, err := ioutil.ReadFile(artifacts[0]); err != nil || string(got) != "pcap" {
+		t.Errorf("Stop(t) wrote capture %q, %v, want %q", got, err, "pcap")
+	}
+}
+
+func TestPacketCaptureErrors(t *testing.T) {
+	initOperationFakes(t)
+	fakeBind.PacketCapturer = func(context.Context, *binding.DUT, []*binding.Port, string) (binding.PacketCapture, error) {
+		return nil, errors.New("capture not supported")
+	}
+	gotErr := negtest.ExpectFatal(t, func(t testing.TB) {
+		DUT(t, "dut").Operations().NewPacketCapture().Start(t)
+	})
+	if want := "capture not supported"; !strings.Contains(gotErr, want) {
+		t.Errorf("Start(t) got %q, want %q", gotErr, want)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// PIM is a representation of a PIM-SM config on the ATE.
+// PIM runs over each IP version configured on the interface.
+type PIM struct {
+	pb *opb.PimConfig
+}
+
+// WithHelloInterval sets the interval in seconds between hello messages.
+func (p *PIM) WithHelloInterval(intervalSec uint32) *PIM {
+	p.pb.HelloIntervalSec = intervalSec
+	return p
+}
+
+// WithHelloHoldTime sets the hold time in seconds advertised in hello messages.
+func (p *PIM) WithHelloHoldTime(holdTimeSec uint32) *PIM {
+	p.pb.HelloHoldTimeSec = holdTimeSec
+	return p
+}
+
+// WithBFDEnabled sets whether PIM registers with the BFD sessions configured on
+// the interface.
+func (p *PIM) WithBFDEnabled(enabled bool) *PIM {
+	p.pb.EnableBfd = enabled
+	return p
+}
+
+// AddJoinPrune adds a range of groups for which the ATE originates
+// join/prunes. By default, the range is a single (*,G) group.
+func (p *PIM) AddJoinPrune() *PIMJoinPrune {
+	jp := &opb.PimConfig_JoinPrune{
+		RangeType:  opb.PimConfig_JoinPrune_STAR_G,
+		GroupCount: 1,
+	}
+	p.pb.JoinPrunes = append(p.pb.JoinPrunes, jp)
+	return &PIMJoinPrune{pb: jp}
+}
+
+// ClearJoinPrunes clears the join/prunes of the PIM config.
+func (p *PIM) ClearJoinPrunes() *PIM {
+	p.pb.JoinPrunes = nil
+	return p
+}
+
+// AddCandidateRP adds a candidate RP advertised by the ATE.
+func (p *PIM) AddCandidateRP() *PIMCandidateRP {
+	rp := &opb.PimConfig_CandidateRp{}
+	p.pb.CandidateRps = append(p.pb.CandidateRps, rp)
+	return &PIMCandidateRP{pb: rp}
+}
+
+// ClearCandidateRPs clears the candidate RPs of the PIM config.
+func (p *PIM) ClearCandidateRPs() *PIM {
+	p.pb.CandidateRps = nil
+	return p
+}
+
+// PIMJoinPrune is a range of groups for which the ATE originates join/prunes.
+type PIMJoinPrune struct {
+	pb *opb.PimConfig_JoinPrune
+}
+
+// WithRangeTypeStarG sets the join/prunes to be (*,G) towards the RP.
+func (jp *PIMJoinPrune) WithRangeTypeStarG() *PIMJoinPrune {
+	jp.pb.RangeType = opb.PimConfig_JoinPrune_STAR_G
+	return jp
+}
+
+// WithRangeTypeSG sets the join/prunes to be (S,G) towards the source.
+func (jp *PIMJoinPrune) WithRangeTypeSG() *PIMJoinPrune {
+	jp.pb.RangeType = opb.PimConfig_JoinPrune_S_G
+	return jp
+}
+
+// WithRPAddress sets the address of the RP.
+func (jp *PIMJoinPrune) WithRPAddress(address string) *PIMJoinPrune {
+	jp.pb.RpAddress = address
+	return jp
+}
+
+// WithGroupAddress sets the first group address of the range.
+func (jp *PIMJoinPrune) WithGroupAddress(address string) *PIMJoinPrune {
+	jp.pb.GroupAddress = address
+	return jp
+}
+
+// WithGroupCount sets the number of groups in the range.
+func (jp *PIMJoinPrune) WithGroupCount(count uint32) *PIMJoinPrune {
+	jp.pb.GroupCount = count
+	return jp
+}
+
+// WithSourceAddress sets the source address of (S,G) join/prunes.
+func (jp *PIMJoinPrune) WithSourceAddress(address string) *PIMJoinPrune {
+	jp.pb.SourceAddress = address
+	return jp
+}
+
+// WithPruned sets whether the groups are pruned rather than joined.
+// Use ATETopology.UpdatePIM to change this while protocols are running.
+func (jp *PIMJoinPrune) WithPruned(pruned bool) *PIMJoinPrune {
+	jp.pb.Pruned = pruned
+	return jp
+}
+
+// PIMCandidateRP is a candidate RP advertised by the ATE.
+type PIMCandidateRP struct {
+	pb *opb.PimConfig_CandidateRp
+}
+
+// WithAddress sets the address of the candidate RP.
+func (rp *PIMCandidateRP) WithAddress(address string) *PIMCandidateRP {
+	rp.pb.Address = address
+	return rp
+}
+
+// WithGroupPrefix sets the range of groups, in CIDR notation, for which the RP
+// advertises candidacy.
+func (rp *PIMCandidateRP) WithGroupPrefix(prefix string) *PIMCandidateRP {
+	rp.pb.GroupPrefix = prefix
+	return rp
+}
+
+// WithPriority sets the priority of the candidate RP.
+func (rp *PIMCandidateRP) WithPriority(priority uint32) *PIMCandidateRP {
+	rp.pb.Priority = priority
+	return rp
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	"golang.org/x/net/context"
+	"fmt"
+	"testing"
+
+	"github.com/openconfig/ondatra/internal/ate"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// PortL1Config is the layer 1 config of an ATE port, such as its speed, FEC
+// mode, and auto-negotiation.
+type PortL1Config struct {
+	pb *opb.PortL1Config
+}
+
+// NewPortL1Config returns a new port L1 config. By default, the config
+// specifies no settings, so applying it leaves the port unchanged.
+func (at *ATETopology) NewPortL1Config() *PortL1Config {
+	return &PortL1Config{pb: &opb.PortL1Config{}}
+}
+
+func (c *PortL1Config) String() string {
+	return fmt.Sprintf("PortL1Config%+v", c.pb)
+}
+
+// WithSpeedGbps sets the speed of the port in Gbps, which must be one of the
+// speeds supported by the port, such as 10, 25, 40, 50, 100, 200 or 400.
+func (c *PortL1Config) WithSpeedGbps(gbps uint32) *PortL1Config {
+	c.pb.SpeedGbps = gbps
+	return c
+}
+
+// WithFECDisabled disables FEC on the port.
+func (c *PortL1Config) WithFECDisabled() *PortL1Config {
+	c.pb.FecMode = opb.PortL1Config_FEC_MODE_NONE
+	return c
+}
+
+// WithRSFEC sets the FEC mode of the port to Reed-Solomon (clause 91) FEC.
+func (c *PortL1Config) WithRSFEC() *PortL1Config {
+	c.pb.FecMode = opb.PortL1Config_FEC_MODE_RS
+	return c
+}
+
+// WithFirecodeFEC sets the FEC mode of the port to Firecode, or BASE-R
+// (clause 74), FEC.
+func (c *PortL1Config) WithFirecodeFEC() *PortL1Config {
+	c.pb.FecMode = opb.PortL1Config_FEC_MODE_FIRECODE
+	return c
+}
+
+// WithAutoNegotiation sets whether the port auto-negotiates with its link
+// partner.
+func (c *PortL1Config) WithAutoNegotiation(enabled bool) *PortL1Config {
+	c.pb.AutoNegotiation = opb.PortL1Config_AUTO_NEGOTIATION_DISABLED
+	if enabled {
+		c.pb.AutoNegotiation = opb.PortL1Config_AUTO_NEGOTIATION_ENABLED
+	}
+	return c
+}
+
+// SetPortL1Config applies the L1 config to a port on the ATE, changing only
+// the settings specified by the config.
+func (at *ATETopology) SetPortL1Config(t testing.TB, port *Port, l1 *PortL1Config) {
+	t.Helper()
+	logAction(t, "Setting port L1 config on %s", at.ate)
+	if err := ate.SetPortL1Config(context.Background(), at.ate, port.Name(), l1.pb); err != nil {
+		t.Fatalf("SetPortL1Config(t, %s, %s) on %s: %v", port, l1, at, err)
+	}
+}
@@ -35,1111 +35,2415 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type BgpAsnSetMode int32
+// The loopback mode of an ATE port.
+type PortLoopbackMode int32
 
 const (
-	BgpAsnSetMode_ASN_SET_MODE_UNSPECIFIED          BgpAsnSetMode = 0
-	BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE       BgpAsnSetMode = 1
-	BgpAsnSetMode_ASN_SET_MODE_AS_SEQ               BgpAsnSetMode = 2
-	BgpAsnSetMode_ASN_SET_MODE_AS_SET               BgpAsnSetMode = 3
-	BgpAsnSetMode_ASN_SET_MODE_AS_SEQ_CONFEDERATION BgpAsnSetMode = 4
-	BgpAsnSetMode_ASN_SET_MODE_AS_SET_CONFEDERATION BgpAsnSetMode = 5
-	BgpAsnSetMode_ASN_SET_MODE_PREPEND              BgpAsnSetMode = 6
+	PortLoopbackMode_PORT_LOOPBACK_MODE_NONE PortLoopbackMode = 0
+	// Transmitted frames are looped back to the receiver of the port itself.
+	PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL PortLoopbackMode = 1
+	// Received frames are looped back to the link partner of the port.
+	PortLoopbackMode_PORT_LOOPBACK_MODE_LINE PortLoopbackMode = 2
 )
 
-// Enum value maps for BgpAsnSetMode.
+// Enum value maps for PortLoopbackMode.
 var (
-	BgpAsnSetMode_name = map[int32]string{
-		0: "ASN_SET_MODE_UNSPECIFIED",
-		1: "ASN_SET_MODE_DO_NOT_INCLUDE",
-		2: "ASN_SET_MODE_AS_SEQ",
-		3: "ASN_SET_MODE_AS_SET",
-		4: "ASN_SET_MODE_AS_SEQ_CONFEDERATION",
-		5: "ASN_SET_MODE_AS_SET_CONFEDERATION",
-		6: "ASN_SET_MODE_PREPEND",
+	PortLoopbackMode_name = map[int32]string{
+		0: "PORT_LOOPBACK_MODE_NONE",
+		1: "PORT_LOOPBACK_MODE_INTERNAL",
+		2: "PORT_LOOPBACK_MODE_LINE",
 	}
-	BgpAsnSetMode_value = map[string]int32{
-		"ASN_SET_MODE_UNSPECIFIED":          0,
-		"ASN_SET_MODE_DO_NOT_INCLUDE":       1,
-		"ASN_SET_MODE_AS_SEQ":               2,
-		"ASN_SET_MODE_AS_SET":               3,
-		"ASN_SET_MODE_AS_SEQ_CONFEDERATION": 4,
-		"ASN_SET_MODE_AS_SET_CONFEDERATION": 5,
-		"ASN_SET_MODE_PREPEND":              6,
+	PortLoopbackMode_value = map[string]int32{
+		"PORT_LOOPBACK_MODE_NONE":     0,
+		"PORT_LOOPBACK_MODE_INTERNAL": 1,
+		"PORT_LOOPBACK_MODE_LINE":     2,
 	}
 )
 
-func (x BgpAsnSetMode) Enum() *BgpAsnSetMode {
-	p := new(BgpAsnSetMode)
+func (x PortLoopbackMode) Enum() *PortLoopbackMode {
+	p := new(PortLoopbackMode)
 	*p = x
 	return p
 }
 
-func (x BgpAsnSetMode) String() string {
+func (x PortLoopbackMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BgpAsnSetMode) Descriptor() protoreflect.EnumDescriptor {
+func (PortLoopbackMode) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[0].Descriptor()
 }
 
-func (BgpAsnSetMode) Type() protoreflect.EnumType {
+func (PortLoopbackMode) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[0]
 }
 
-func (x BgpAsnSetMode) Number() protoreflect.EnumNumber {
+func (x PortLoopbackMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BgpAsnSetMode.Descriptor instead.
-func (BgpAsnSetMode) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use PortLoopbackMode.Descriptor instead.
+func (PortLoopbackMode) EnumDescriptor() ([]byte, []int) {
 	return file_ate_proto_rawDescGZIP(), []int{0}
 }
 
-type MacSec_CipherSuite int32
+// A link fault signaled by an ATE port to its link partner.
+type PortLinkFault int32
 
 const (
-	MacSec_CIPHER_SUITE_UNSPECIFIED MacSec_CipherSuite = 0
-	MacSec_AES_128                  MacSec_CipherSuite = 1
-	MacSec_AES_256                  MacSec_CipherSuite = 2
-	MacSec_AES_XPN_128              MacSec_CipherSuite = 3
-	MacSec_AES_XPN_256              MacSec_CipherSuite = 4
+	PortLinkFault_PORT_LINK_FAULT_NONE   PortLinkFault = 0
+	PortLinkFault_PORT_LINK_FAULT_LOCAL  PortLinkFault = 1
+	PortLinkFault_PORT_LINK_FAULT_REMOTE PortLinkFault = 2
 )
 
-// Enum value maps for MacSec_CipherSuite.
+// Enum value maps for PortLinkFault.
 var (
-	MacSec_CipherSuite_name = map[int32]string{
-		0: "CIPHER_SUITE_UNSPECIFIED",
-		1: "AES_128",
-		2: "AES_256",
-		3: "AES_XPN_128",
-		4: "AES_XPN_256",
+	PortLinkFault_name = map[int32]string{
+		0: "PORT_LINK_FAULT_NONE",
+		1: "PORT_LINK_FAULT_LOCAL",
+		2: "PORT_LINK_FAULT_REMOTE",
 	}
-	MacSec_CipherSuite_value = map[string]int32{
-		"CIPHER_SUITE_UNSPECIFIED": 0,
-		"AES_128":                  1,
-		"AES_256":                  2,
-		"AES_XPN_128":              3,
-		"AES_XPN_256":              4,
+	PortLinkFault_value = map[string]int32{
+		"PORT_LINK_FAULT_NONE":   0,
+		"PORT_LINK_FAULT_LOCAL":  1,
+		"PORT_LINK_FAULT_REMOTE": 2,
 	}
 )
 
-func (x MacSec_CipherSuite) Enum() *MacSec_CipherSuite {
-	p := new(MacSec_CipherSuite)
+func (x PortLinkFault) Enum() *PortLinkFault {
+	p := new(PortLinkFault)
 	*p = x
 	return p
 }
 
-func (x MacSec_CipherSuite) String() string {
+func (x PortLinkFault) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (MacSec_CipherSuite) Descriptor() protoreflect.EnumDescriptor {
+func (PortLinkFault) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[1].Descriptor()
 }
 
-func (MacSec_CipherSuite) Type() protoreflect.EnumType {
+func (PortLinkFault) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[1]
 }
 
-func (x MacSec_CipherSuite) Number() protoreflect.EnumNumber {
+func (x PortLinkFault) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use MacSec_CipherSuite.Descriptor instead.
-func (MacSec_CipherSuite) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use PortLinkFault.Descriptor instead.
+func (PortLinkFault) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{1}
 }
 
-type MacSec_MKA_Capability int32
+type BgpAsnSetMode int32
 
 const (
-	MacSec_MKA_CAPABILITY_UNSPECIFIED                   MacSec_MKA_Capability = 0
-	MacSec_MKA_NOT_IMPLEMENTED                          MacSec_MKA_Capability = 1
-	MacSec_MKA_INTEGRITY_WITHOUT_CONFIDENTIALITY        MacSec_MKA_Capability = 2
-	MacSec_MKA_INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET MacSec_MKA_Capability = 3
-	MacSec_MKA_INTEGRITY_WITH_CONFIDENTIALITY_OFFSET    MacSec_MKA_Capability = 4
+	BgpAsnSetMode_ASN_SET_MODE_UNSPECIFIED          BgpAsnSetMode = 0
+	BgpAsnSetMode_ASN_SET_MODE_DO_NOT_INCLUDE       BgpAsnSetMode = 1
+	BgpAsnSetMode_ASN_SET_MODE_AS_SEQ               BgpAsnSetMode = 2
+	BgpAsnSetMode_ASN_SET_MODE_AS_SET               BgpAsnSetMode = 3
+	BgpAsnSetMode_ASN_SET_MODE_AS_SEQ_CONFEDERATION BgpAsnSetMode = 4
+	BgpAsnSetMode_ASN_SET_MODE_AS_SET_CONFEDERATION BgpAsnSetMode = 5
+	BgpAsnSetMode_ASN_SET_MODE_PREPEND              BgpAsnSetMode = 6
 )
 
-// Enum value maps for MacSec_MKA_Capability.
+// Enum value maps for BgpAsnSetMode.
 var (
-	MacSec_MKA_Capability_name = map[int32]string{
-		0: "CAPABILITY_UNSPECIFIED",
-		1: "NOT_IMPLEMENTED",
-		2: "INTEGRITY_WITHOUT_CONFIDENTIALITY",
-		3: "INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET",
-		4: "INTEGRITY_WITH_CONFIDENTIALITY_OFFSET",
+	BgpAsnSetMode_name = map[int32]string{
+		0: "ASN_SET_MODE_UNSPECIFIED",
+		1: "ASN_SET_MODE_DO_NOT_INCLUDE",
+		2: "ASN_SET_MODE_AS_SEQ",
+		3: "ASN_SET_MODE_AS_SET",
+		4: "ASN_SET_MODE_AS_SEQ_CONFEDERATION",
+		5: "ASN_SET_MODE_AS_SET_CONFEDERATION",
+		6: "ASN_SET_MODE_PREPEND",
 	}
-	MacSec_MKA_Capability_value = map[string]int32{
-		"CAPABILITY_UNSPECIFIED":                   0,
-		"NOT_IMPLEMENTED":                          1,
-		"INTEGRITY_WITHOUT_CONFIDENTIALITY":        2,
-		"INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET": 3,
-		"INTEGRITY_WITH_CONFIDENTIALITY_OFFSET":    4,
+	BgpAsnSetMode_value = map[string]int32{
+		"ASN_SET_MODE_UNSPECIFIED":          0,
+		"ASN_SET_MODE_DO_NOT_INCLUDE":       1,
+		"ASN_SET_MODE_AS_SEQ":               2,
+		"ASN_SET_MODE_AS_SET":               3,
+		"ASN_SET_MODE_AS_SEQ_CONFEDERATION": 4,
+		"ASN_SET_MODE_AS_SET_CONFEDERATION": 5,
+		"ASN_SET_MODE_PREPEND":              6,
 	}
 )
 
-func (x MacSec_MKA_Capability) Enum() *MacSec_MKA_Capability {
-	p := new(MacSec_MKA_Capability)
+func (x BgpAsnSetMode) Enum() *BgpAsnSetMode {
+	p := new(BgpAsnSetMode)
 	*p = x
 	return p
 }
 
-func (x MacSec_MKA_Capability) String() string {
+func (x BgpAsnSetMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (MacSec_MKA_Capability) Descriptor() protoreflect.EnumDescriptor {
+func (BgpAsnSetMode) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[2].Descriptor()
 }
 
-func (MacSec_MKA_Capability) Type() protoreflect.EnumType {
+func (BgpAsnSetMode) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[2]
 }
 
-func (x MacSec_MKA_Capability) Number() protoreflect.EnumNumber {
+func (x BgpAsnSetMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use MacSec_MKA_Capability.Descriptor instead.
-func (MacSec_MKA_Capability) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6, 0, 0}
+// Deprecated: Use BgpAsnSetMode.Descriptor instead.
+func (BgpAsnSetMode) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{2}
 }
 
-type MacSec_MKA_ConfidentialityOffset int32
+type IgmpHostConfig_Version int32
 
 const (
-	MacSec_MKA_OFFSET_UNSPECIFIED        MacSec_MKA_ConfidentialityOffset = 0
-	MacSec_MKA_OFFSET_NO_CONFIDENTIALITY MacSec_MKA_ConfidentialityOffset = 4
-	MacSec_MKA_OFFSET_0                  MacSec_MKA_ConfidentialityOffset = 1
-	MacSec_MKA_OFFSET_30                 MacSec_MKA_ConfidentialityOffset = 2
-	MacSec_MKA_OFFSET_50                 MacSec_MKA_ConfidentialityOffset = 3
+	IgmpHostConfig_VERSION_UNSPECIFIED IgmpHostConfig_Version = 0
+	IgmpHostConfig_V2                  IgmpHostConfig_Version = 1
+	IgmpHostConfig_V3                  IgmpHostConfig_Version = 2
 )
 
-// Enum value maps for MacSec_MKA_ConfidentialityOffset.
+// Enum value maps for IgmpHostConfig_Version.
 var (
-	MacSec_MKA_ConfidentialityOffset_name = map[int32]string{
-		0: "OFFSET_UNSPECIFIED",
-		4: "OFFSET_NO_CONFIDENTIALITY",
-		1: "OFFSET_0",
-		2: "OFFSET_30",
-		3: "OFFSET_50",
+	IgmpHostConfig_Version_name = map[int32]string{
+		0: "VERSION_UNSPECIFIED",
+		1: "V2",
+		2: "V3",
 	}
-	MacSec_MKA_ConfidentialityOffset_value = map[string]int32{
-		"OFFSET_UNSPECIFIED":        0,
-		"OFFSET_NO_CONFIDENTIALITY": 4,
-		"OFFSET_0":                  1,
-		"OFFSET_30":                 2,
-		"OFFSET_50":                 3,
+	IgmpHostConfig_Version_value = map[string]int32{
+		"VERSION_UNSPECIFIED": 0,
+		"V2":                  1,
+		"V3":                  2,
 	}
 )
 
-func (x MacSec_MKA_ConfidentialityOffset) Enum() *MacSec_MKA_ConfidentialityOffset {
-	p := new(MacSec_MKA_ConfidentialityOffset)
+func (x IgmpHostConfig_Version) Enum() *IgmpHostConfig_Version {
+	p := new(IgmpHostConfig_Version)
 	*p = x
 	return p
 }
 
-func (x MacSec_MKA_ConfidentialityOffset) String() string {
+func (x IgmpHostConfig_Version) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (MacSec_MKA_ConfidentialityOffset) Descriptor() protoreflect.EnumDescriptor {
+func (IgmpHostConfig_Version) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[3].Descriptor()
 }
 
-func (MacSec_MKA_ConfidentialityOffset) Type() protoreflect.EnumType {
+func (IgmpHostConfig_Version) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[3]
 }
 
-func (x MacSec_MKA_ConfidentialityOffset) Number() protoreflect.EnumNumber {
+func (x IgmpHostConfig_Version) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use MacSec_MKA_ConfidentialityOffset.Descriptor instead.
-func (MacSec_MKA_ConfidentialityOffset) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6, 0, 1}
+// Deprecated: Use IgmpHostConfig_Version.Descriptor instead.
+func (IgmpHostConfig_Version) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{6, 0}
 }
 
-type ISISConfig_Level int32
+type MldHostConfig_Version int32
 
 const (
-	ISISConfig_LEVEL_UNSPECIFIED ISISConfig_Level = 0
-	ISISConfig_L1                ISISConfig_Level = 1
-	ISISConfig_L2                ISISConfig_Level = 2
+	MldHostConfig_VERSION_UNSPECIFIED MldHostConfig_Version = 0
+	MldHostConfig_V1                  MldHostConfig_Version = 1
+	MldHostConfig_V2                  MldHostConfig_Version = 2
 )
 
-// Enum value maps for ISISConfig_Level.
+// Enum value maps for MldHostConfig_Version.
 var (
-	ISISConfig_Level_name = map[int32]string{
-		0: "LEVEL_UNSPECIFIED",
-		1: "L1",
-		2: "L2",
+	MldHostConfig_Version_name = map[int32]string{
+		0: "VERSION_UNSPECIFIED",
+		1: "V1",
+		2: "V2",
 	}
-	ISISConfig_Level_value = map[string]int32{
-		"LEVEL_UNSPECIFIED": 0,
-		"L1":                1,
-		"L2":                2,
+	MldHostConfig_Version_value = map[string]int32{
+		"VERSION_UNSPECIFIED": 0,
+		"V1":                  1,
+		"V2":                  2,
 	}
 )
 
-func (x ISISConfig_Level) Enum() *ISISConfig_Level {
-	p := new(ISISConfig_Level)
+func (x MldHostConfig_Version) Enum() *MldHostConfig_Version {
+	p := new(MldHostConfig_Version)
 	*p = x
 	return p
 }
 
-func (x ISISConfig_Level) String() string {
+func (x MldHostConfig_Version) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ISISConfig_Level) Descriptor() protoreflect.EnumDescriptor {
+func (MldHostConfig_Version) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[4].Descriptor()
 }
 
-func (ISISConfig_Level) Type() protoreflect.EnumType {
+func (MldHostConfig_Version) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[4]
 }
 
-func (x ISISConfig_Level) Number() protoreflect.EnumNumber {
+func (x MldHostConfig_Version) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ISISConfig_Level.Descriptor instead.
-func (ISISConfig_Level) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use MldHostConfig_Version.Descriptor instead.
+func (MldHostConfig_Version) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{7, 0}
 }
 
-type ISISConfig_NetworkType int32
+type PimConfig_JoinPrune_RangeType int32
 
 const (
-	ISISConfig_NETWORK_TYPE_UNSPECIFIED ISISConfig_NetworkType = 0
-	ISISConfig_BROADCAST                ISISConfig_NetworkType = 1
-	ISISConfig_POINT_TO_POINT           ISISConfig_NetworkType = 2
+	PimConfig_JoinPrune_RANGE_TYPE_UNSPECIFIED PimConfig_JoinPrune_RangeType = 0
+	// (*,G) join/prunes towards the RP.
+	PimConfig_JoinPrune_STAR_G PimConfig_JoinPrune_RangeType = 1
+	// (S,G) join/prunes towards the source.
+	PimConfig_JoinPrune_S_G PimConfig_JoinPrune_RangeType = 2
 )
 
-// Enum value maps for ISISConfig_NetworkType.
+// Enum value maps for PimConfig_JoinPrune_RangeType.
 var (
-	ISISConfig_NetworkType_name = map[int32]string{
-		0: "NETWORK_TYPE_UNSPECIFIED",
-		1: "BROADCAST",
-		2: "POINT_TO_POINT",
+	PimConfig_JoinPrune_RangeType_name = map[int32]string{
+		0: "RANGE_TYPE_UNSPECIFIED",
+		1: "STAR_G",
+		2: "S_G",
 	}
-	ISISConfig_NetworkType_value = map[string]int32{
-		"NETWORK_TYPE_UNSPECIFIED": 0,
-		"BROADCAST":                1,
-		"POINT_TO_POINT":           2,
+	PimConfig_JoinPrune_RangeType_value = map[string]int32{
+		"RANGE_TYPE_UNSPECIFIED": 0,
+		"STAR_G":                 1,
+		"S_G":                    2,
 	}
 )
 
-func (x ISISConfig_NetworkType) Enum() *ISISConfig_NetworkType {
-	p := new(ISISConfig_NetworkType)
+func (x PimConfig_JoinPrune_RangeType) Enum() *PimConfig_JoinPrune_RangeType {
+	p := new(PimConfig_JoinPrune_RangeType)
 	*p = x
 	return p
 }
 
-func (x ISISConfig_NetworkType) String() string {
+func (x PimConfig_JoinPrune_RangeType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ISISConfig_NetworkType) Descriptor() protoreflect.EnumDescriptor {
+func (PimConfig_JoinPrune_RangeType) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[5].Descriptor()
 }
 
-func (ISISConfig_NetworkType) Type() protoreflect.EnumType {
+func (PimConfig_JoinPrune_RangeType) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[5]
 }
 
-func (x ISISConfig_NetworkType) Number() protoreflect.EnumNumber {
+func (x PimConfig_JoinPrune_RangeType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ISISConfig_NetworkType.Descriptor instead.
-func (ISISConfig_NetworkType) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use PimConfig_JoinPrune_RangeType.Descriptor instead.
+func (PimConfig_JoinPrune_RangeType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{9, 0, 0}
 }
 
-type ISISConfig_AuthType int32
+type PtpConfig_Role int32
 
 const (
-	ISISConfig_AUTH_TYPE_UNSPECIFIED ISISConfig_AuthType = 0
-	ISISConfig_MD5                   ISISConfig_AuthType = 1
-	ISISConfig_PASSWORD              ISISConfig_AuthType = 2
+	PtpConfig_ROLE_UNSPECIFIED PtpConfig_Role = 0
+	PtpConfig_MASTER           PtpConfig_Role = 1
+	PtpConfig_SLAVE            PtpConfig_Role = 2
 )
 
-// Enum value maps for ISISConfig_AuthType.
+// Enum value maps for PtpConfig_Role.
 var (
-	ISISConfig_AuthType_name = map[int32]string{
-		0: "AUTH_TYPE_UNSPECIFIED",
-		1: "MD5",
-		2: "PASSWORD",
+	PtpConfig_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "MASTER",
+		2: "SLAVE",
 	}
-	ISISConfig_AuthType_value = map[string]int32{
-		"AUTH_TYPE_UNSPECIFIED": 0,
-		"MD5":                   1,
-		"PASSWORD":              2,
+	PtpConfig_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"MASTER":           1,
+		"SLAVE":            2,
 	}
 )
 
-func (x ISISConfig_AuthType) Enum() *ISISConfig_AuthType {
-	p := new(ISISConfig_AuthType)
+func (x PtpConfig_Role) Enum() *PtpConfig_Role {
+	p := new(PtpConfig_Role)
 	*p = x
 	return p
 }
 
-func (x ISISConfig_AuthType) String() string {
+func (x PtpConfig_Role) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ISISConfig_AuthType) Descriptor() protoreflect.EnumDescriptor {
+func (PtpConfig_Role) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[6].Descriptor()
 }
 
-func (ISISConfig_AuthType) Type() protoreflect.EnumType {
+func (PtpConfig_Role) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[6]
 }
 
-func (x ISISConfig_AuthType) Number() protoreflect.EnumNumber {
+func (x PtpConfig_Role) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ISISConfig_AuthType.Descriptor instead.
-func (ISISConfig_AuthType) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{9, 2}
+// Deprecated: Use PtpConfig_Role.Descriptor instead.
+func (PtpConfig_Role) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{11, 0}
 }
 
-type IPReachability_RouteOrigin int32
+type PtpConfig_Transport int32
 
 const (
-	IPReachability_ROUTE_ORIGIN_UNSPECIFIED IPReachability_RouteOrigin = 0
-	IPReachability_INTERNAL                 IPReachability_RouteOrigin = 1
-	IPReachability_EXTERNAL                 IPReachability_RouteOrigin = 2
+	PtpConfig_ETHERNET PtpConfig_Transport = 0
+	PtpConfig_IPV4     PtpConfig_Transport = 1
+	PtpConfig_IPV6     PtpConfig_Transport = 2
 )
 
-// Enum value maps for IPReachability_RouteOrigin.
+// Enum value maps for PtpConfig_Transport.
 var (
-	IPReachability_RouteOrigin_name = map[int32]string{
-		0: "ROUTE_ORIGIN_UNSPECIFIED",
-		1: "INTERNAL",
-		2: "EXTERNAL",
+	PtpConfig_Transport_name = map[int32]string{
+		0: "ETHERNET",
+		1: "IPV4",
+		2: "IPV6",
 	}
-	IPReachability_RouteOrigin_value = map[string]int32{
-		"ROUTE_ORIGIN_UNSPECIFIED": 0,
-		"INTERNAL":                 1,
-		"EXTERNAL":                 2,
+	PtpConfig_Transport_value = map[string]int32{
+		"ETHERNET": 0,
+		"IPV4":     1,
+		"IPV6":     2,
 	}
 )
 
-func (x IPReachability_RouteOrigin) Enum() *IPReachability_RouteOrigin {
-	p := new(IPReachability_RouteOrigin)
+func (x PtpConfig_Transport) Enum() *PtpConfig_Transport {
+	p := new(PtpConfig_Transport)
 	*p = x
 	return p
 }
 
-func (x IPReachability_RouteOrigin) String() string {
+func (x PtpConfig_Transport) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (IPReachability_RouteOrigin) Descriptor() protoreflect.EnumDescriptor {
+func (PtpConfig_Transport) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[7].Descriptor()
 }
 
-func (IPReachability_RouteOrigin) Type() protoreflect.EnumType {
+func (PtpConfig_Transport) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[7]
 }
 
-func (x IPReachability_RouteOrigin) Number() protoreflect.EnumNumber {
+func (x PtpConfig_Transport) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use IPReachability_RouteOrigin.Descriptor instead.
-func (IPReachability_RouteOrigin) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use PtpConfig_Transport.Descriptor instead.
+func (PtpConfig_Transport) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{11, 1}
 }
 
-type BgpPeer_Type int32
+type PtpConfig_DelayMechanism int32
 
 const (
-	BgpPeer_TYPE_UNSPECIFIED BgpPeer_Type = 0
-	BgpPeer_TYPE_EXTERNAL    BgpPeer_Type = 1
-	BgpPeer_TYPE_INTERNAL    BgpPeer_Type = 2
+	PtpConfig_END_TO_END   PtpConfig_DelayMechanism = 0
+	PtpConfig_PEER_TO_PEER PtpConfig_DelayMechanism = 1
 )
 
-// Enum value maps for BgpPeer_Type.
+// Enum value maps for PtpConfig_DelayMechanism.
 var (
-	BgpPeer_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_EXTERNAL",
-		2: "TYPE_INTERNAL",
+	PtpConfig_DelayMechanism_name = map[int32]string{
+		0: "END_TO_END",
+		1: "PEER_TO_PEER",
 	}
-	BgpPeer_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"TYPE_EXTERNAL":    1,
-		"TYPE_INTERNAL":    2,
+	PtpConfig_DelayMechanism_value = map[string]int32{
+		"END_TO_END":   0,
+		"PEER_TO_PEER": 1,
 	}
 )
 
-func (x BgpPeer_Type) Enum() *BgpPeer_Type {
-	p := new(BgpPeer_Type)
+func (x PtpConfig_DelayMechanism) Enum() *PtpConfig_DelayMechanism {
+	p := new(PtpConfig_DelayMechanism)
 	*p = x
 	return p
 }
 
-func (x BgpPeer_Type) String() string {
+func (x PtpConfig_DelayMechanism) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BgpPeer_Type) Descriptor() protoreflect.EnumDescriptor {
+func (PtpConfig_DelayMechanism) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[8].Descriptor()
 }
 
-func (BgpPeer_Type) Type() protoreflect.EnumType {
+func (PtpConfig_DelayMechanism) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[8]
 }
 
-func (x BgpPeer_Type) Number() protoreflect.EnumNumber {
+func (x PtpConfig_DelayMechanism) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BgpPeer_Type.Descriptor instead.
-func (BgpPeer_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use PtpConfig_DelayMechanism.Descriptor instead.
+func (PtpConfig_DelayMechanism) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{11, 2}
 }
 
-type BgpAttributes_Origin int32
+type Dot1XConfig_EapMethod int32
 
 const (
-	BgpAttributes_ORIGIN_UNSPECIFIED BgpAttributes_Origin = 0
-	BgpAttributes_ORIGIN_IGP         BgpAttributes_Origin = 1
-	BgpAttributes_ORIGIN_EGP         BgpAttributes_Origin = 2
-	BgpAttributes_ORIGIN_INCOMPLETE  BgpAttributes_Origin = 3
+	Dot1XConfig_EAP_METHOD_UNSPECIFIED Dot1XConfig_EapMethod = 0
+	Dot1XConfig_MD5                    Dot1XConfig_EapMethod = 1
+	Dot1XConfig_PEAP_V0                Dot1XConfig_EapMethod = 2
+	Dot1XConfig_PEAP_V1                Dot1XConfig_EapMethod = 3
+	Dot1XConfig_TTLS                   Dot1XConfig_EapMethod = 4
 )
 
-// Enum value maps for BgpAttributes_Origin.
+// Enum value maps for Dot1XConfig_EapMethod.
 var (
-	BgpAttributes_Origin_name = map[int32]string{
-		0: "ORIGIN_UNSPECIFIED",
-		1: "ORIGIN_IGP",
-		2: "ORIGIN_EGP",
-		3: "ORIGIN_INCOMPLETE",
+	Dot1XConfig_EapMethod_name = map[int32]string{
+		0: "EAP_METHOD_UNSPECIFIED",
+		1: "MD5",
+		2: "PEAP_V0",
+		3: "PEAP_V1",
+		4: "TTLS",
 	}
-	BgpAttributes_Origin_value = map[string]int32{
-		"ORIGIN_UNSPECIFIED": 0,
-		"ORIGIN_IGP":         1,
-		"ORIGIN_EGP":         2,
-		"ORIGIN_INCOMPLETE":  3,
+	Dot1XConfig_EapMethod_value = map[string]int32{
+		"EAP_METHOD_UNSPECIFIED": 0,
+		"MD5":                    1,
+		"PEAP_V0":                2,
+		"PEAP_V1":                3,
+		"TTLS":                   4,
 	}
 )
 
-func (x BgpAttributes_Origin) Enum() *BgpAttributes_Origin {
-	p := new(BgpAttributes_Origin)
+func (x Dot1XConfig_EapMethod) Enum() *Dot1XConfig_EapMethod {
+	p := new(Dot1XConfig_EapMethod)
 	*p = x
 	return p
 }
 
-func (x BgpAttributes_Origin) String() string {
+func (x Dot1XConfig_EapMethod) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BgpAttributes_Origin) Descriptor() protoreflect.EnumDescriptor {
+func (Dot1XConfig_EapMethod) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[9].Descriptor()
 }
 
-func (BgpAttributes_Origin) Type() protoreflect.EnumType {
+func (Dot1XConfig_EapMethod) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[9]
 }
 
-func (x BgpAttributes_Origin) Number() protoreflect.EnumNumber {
+func (x Dot1XConfig_EapMethod) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BgpAttributes_Origin.Descriptor instead.
-func (BgpAttributes_Origin) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use Dot1XConfig_EapMethod.Descriptor instead.
+func (Dot1XConfig_EapMethod) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{12, 0}
 }
 
-type BgpAttributes_ExtendedCommunity_Color_CoBits int32
+type MacSec_CipherSuite int32
 
 const (
-	BgpAttributes_ExtendedCommunity_Color_CO_BITS_UNSPECIFIED BgpAttributes_ExtendedCommunity_Color_CoBits = 0
-	BgpAttributes_ExtendedCommunity_Color_CO_BITS_00          BgpAttributes_ExtendedCommunity_Color_CoBits = 1
-	BgpAttributes_ExtendedCommunity_Color_CO_BITS_01          BgpAttributes_ExtendedCommunity_Color_CoBits = 2
-	BgpAttributes_ExtendedCommunity_Color_CO_BITS_10          BgpAttributes_ExtendedCommunity_Color_CoBits = 3
-	BgpAttributes_ExtendedCommunity_Color_CO_BITS_11          BgpAttributes_ExtendedCommunity_Color_CoBits = 4
+	MacSec_CIPHER_SUITE_UNSPECIFIED MacSec_CipherSuite = 0
+	MacSec_AES_128                  MacSec_CipherSuite = 1
+	MacSec_AES_256                  MacSec_CipherSuite = 2
+	MacSec_AES_XPN_128              MacSec_CipherSuite = 3
+	MacSec_AES_XPN_256              MacSec_CipherSuite = 4
 )
 
-// Enum value maps for BgpAttributes_ExtendedCommunity_Color_CoBits.
+// Enum value maps for MacSec_CipherSuite.
 var (
-	BgpAttributes_ExtendedCommunity_Color_CoBits_name = map[int32]string{
-		0: "CO_BITS_UNSPECIFIED",
-		1: "CO_BITS_00",
-		2: "CO_BITS_01",
-		3: "CO_BITS_10",
-		4: "CO_BITS_11",
+	MacSec_CipherSuite_name = map[int32]string{
+		0: "CIPHER_SUITE_UNSPECIFIED",
+		1: "AES_128",
+		2: "AES_256",
+		3: "AES_XPN_128",
+		4: "AES_XPN_256",
 	}
-	BgpAttributes_ExtendedCommunity_Color_CoBits_value = map[string]int32{
-		"CO_BITS_UNSPECIFIED": 0,
-		"CO_BITS_00":          1,
-		"CO_BITS_01":          2,
-		"CO_BITS_10":          3,
-		"CO_BITS_11":          4,
+	MacSec_CipherSuite_value = map[string]int32{
+		"CIPHER_SUITE_UNSPECIFIED": 0,
+		"AES_128":                  1,
+		"AES_256":                  2,
+		"AES_XPN_128":              3,
+		"AES_XPN_256":              4,
 	}
 )
 
-func (x BgpAttributes_ExtendedCommunity_Color_CoBits) Enum() *BgpAttributes_ExtendedCommunity_Color_CoBits {
-	p := new(BgpAttributes_ExtendedCommunity_Color_CoBits)
+func (x MacSec_CipherSuite) Enum() *MacSec_CipherSuite {
+	p := new(MacSec_CipherSuite)
 	*p = x
 	return p
 }
 
-func (x BgpAttributes_ExtendedCommunity_Color_CoBits) String() string {
+func (x MacSec_CipherSuite) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BgpAttributes_ExtendedCommunity_Color_CoBits) Descriptor() protoreflect.EnumDescriptor {
+func (MacSec_CipherSuite) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[10].Descriptor()
 }
 
-func (BgpAttributes_ExtendedCommunity_Color_CoBits) Type() protoreflect.EnumType {
+func (MacSec_CipherSuite) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[10]
 }
 
-func (x BgpAttributes_ExtendedCommunity_Color_CoBits) Number() protoreflect.EnumNumber {
+func (x MacSec_CipherSuite) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BgpAttributes_ExtendedCommunity_Color_CoBits.Descriptor instead.
-func (BgpAttributes_ExtendedCommunity_Color_CoBits) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 0, 0, 0}
+// Deprecated: Use MacSec_CipherSuite.Descriptor instead.
+func (MacSec_CipherSuite) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{15, 0}
 }
 
-type BgpAttributes_AsPathSegment_Type int32
+type MacSec_MKA_Capability int32
 
 const (
-	BgpAttributes_AsPathSegment_TYPE_UNSPECIFIED          BgpAttributes_AsPathSegment_Type = 0
-	BgpAttributes_AsPathSegment_TYPE_AS_SEQ               BgpAttributes_AsPathSegment_Type = 1
-	BgpAttributes_AsPathSegment_TYPE_AS_SET               BgpAttributes_AsPathSegment_Type = 2
-	BgpAttributes_AsPathSegment_TYPE_AS_SEQ_CONFEDERATION BgpAttributes_AsPathSegment_Type = 3
-	BgpAttributes_AsPathSegment_TYPE_AS_SET_CONFEDERATION BgpAttributes_AsPathSegment_Type = 4
+	MacSec_MKA_CAPABILITY_UNSPECIFIED                   MacSec_MKA_Capability = 0
+	MacSec_MKA_NOT_IMPLEMENTED                          MacSec_MKA_Capability = 1
+	MacSec_MKA_INTEGRITY_WITHOUT_CONFIDENTIALITY        MacSec_MKA_Capability = 2
+	MacSec_MKA_INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET MacSec_MKA_Capability = 3
+	MacSec_MKA_INTEGRITY_WITH_CONFIDENTIALITY_OFFSET    MacSec_MKA_Capability = 4
 )
 
-// Enum value maps for BgpAttributes_AsPathSegment_Type.
+// Enum value maps for MacSec_MKA_Capability.
 var (
-	BgpAttributes_AsPathSegment_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_AS_SEQ",
-		2: "TYPE_AS_SET",
-		3: "TYPE_AS_SEQ_CONFEDERATION",
-		4: "TYPE_AS_SET_CONFEDERATION",
+	MacSec_MKA_Capability_name = map[int32]string{
+		0: "CAPABILITY_UNSPECIFIED",
+		1: "NOT_IMPLEMENTED",
+		2: "INTEGRITY_WITHOUT_CONFIDENTIALITY",
+		3: "INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET",
+		4: "INTEGRITY_WITH_CONFIDENTIALITY_OFFSET",
 	}
-	BgpAttributes_AsPathSegment_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED":          0,
-		"TYPE_AS_SEQ":               1,
-		"TYPE_AS_SET":               2,
-		"TYPE_AS_SEQ_CONFEDERATION": 3,
-		"TYPE_AS_SET_CONFEDERATION": 4,
+	MacSec_MKA_Capability_value = map[string]int32{
+		"CAPABILITY_UNSPECIFIED":                   0,
+		"NOT_IMPLEMENTED":                          1,
+		"INTEGRITY_WITHOUT_CONFIDENTIALITY":        2,
+		"INTEGRITY_WITH_NO_CONFIDENTIALITY_OFFSET": 3,
+		"INTEGRITY_WITH_CONFIDENTIALITY_OFFSET":    4,
 	}
 )
 
-func (x BgpAttributes_AsPathSegment_Type) Enum() *BgpAttributes_AsPathSegment_Type {
-	p := new(BgpAttributes_AsPathSegment_Type)
+func (x MacSec_MKA_Capability) Enum() *MacSec_MKA_Capability {
+	p := new(MacSec_MKA_Capability)
 	*p = x
 	return p
 }
 
-func (x BgpAttributes_AsPathSegment_Type) String() string {
+func (x MacSec_MKA_Capability) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BgpAttributes_AsPathSegment_Type) Descriptor() protoreflect.EnumDescriptor {
+func (MacSec_MKA_Capability) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[11].Descriptor()
 }
 
-func (BgpAttributes_AsPathSegment_Type) Type() protoreflect.EnumType {
+func (MacSec_MKA_Capability) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[11]
 }
 
-func (x BgpAttributes_AsPathSegment_Type) Number() protoreflect.EnumNumber {
+func (x MacSec_MKA_Capability) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BgpAttributes_AsPathSegment_Type.Descriptor instead.
-func (BgpAttributes_AsPathSegment_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 1, 0}
+// Deprecated: Use MacSec_MKA_Capability.Descriptor instead.
+func (MacSec_MKA_Capability) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{15, 0, 0}
 }
 
-type Network_ImportedBgpRoutes_RouteTableFormat int32
+type MacSec_MKA_ConfidentialityOffset int32
 
 const (
-	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_UNSPECIFIED Network_ImportedBgpRoutes_RouteTableFormat = 0
-	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_CISCO       Network_ImportedBgpRoutes_RouteTableFormat = 1
-	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_JUNIPER     Network_ImportedBgpRoutes_RouteTableFormat = 2
+	MacSec_MKA_OFFSET_UNSPECIFIED        MacSec_MKA_ConfidentialityOffset = 0
+	MacSec_MKA_OFFSET_NO_CONFIDENTIALITY MacSec_MKA_ConfidentialityOffset = 4
+	MacSec_MKA_OFFSET_0                  MacSec_MKA_ConfidentialityOffset = 1
+	MacSec_MKA_OFFSET_30                 MacSec_MKA_ConfidentialityOffset = 2
+	MacSec_MKA_OFFSET_50                 MacSec_MKA_ConfidentialityOffset = 3
 )
 
-// Enum value maps for Network_ImportedBgpRoutes_RouteTableFormat.
+// Enum value maps for MacSec_MKA_ConfidentialityOffset.
 var (
-	Network_ImportedBgpRoutes_RouteTableFormat_name = map[int32]string{
-		0: "ROUTE_TABLE_FORMAT_UNSPECIFIED",
-		1: "ROUTE_TABLE_FORMAT_CISCO",
-		2: "ROUTE_TABLE_FORMAT_JUNIPER",
+	MacSec_MKA_ConfidentialityOffset_name = map[int32]string{
+		0: "OFFSET_UNSPECIFIED",
+		4: "OFFSET_NO_CONFIDENTIALITY",
+		1: "OFFSET_0",
+		2: "OFFSET_30",
+		3: "OFFSET_50",
 	}
-	Network_ImportedBgpRoutes_RouteTableFormat_value = map[string]int32{
-		"ROUTE_TABLE_FORMAT_UNSPECIFIED": 0,
-		"ROUTE_TABLE_FORMAT_CISCO":       1,
-		"ROUTE_TABLE_FORMAT_JUNIPER":     2,
+	MacSec_MKA_ConfidentialityOffset_value = map[string]int32{
+		"OFFSET_UNSPECIFIED":        0,
+		"OFFSET_NO_CONFIDENTIALITY": 4,
+		"OFFSET_0":                  1,
+		"OFFSET_30":                 2,
+		"OFFSET_50":                 3,
 	}
 )
 
-func (x Network_ImportedBgpRoutes_RouteTableFormat) Enum() *Network_ImportedBgpRoutes_RouteTableFormat {
-	p := new(Network_ImportedBgpRoutes_RouteTableFormat)
+func (x MacSec_MKA_ConfidentialityOffset) Enum() *MacSec_MKA_ConfidentialityOffset {
+	p := new(MacSec_MKA_ConfidentialityOffset)
 	*p = x
 	return p
 }
 
-func (x Network_ImportedBgpRoutes_RouteTableFormat) String() string {
+func (x MacSec_MKA_ConfidentialityOffset) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Network_ImportedBgpRoutes_RouteTableFormat) Descriptor() protoreflect.EnumDescriptor {
+func (MacSec_MKA_ConfidentialityOffset) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[12].Descriptor()
 }
 
-func (Network_ImportedBgpRoutes_RouteTableFormat) Type() protoreflect.EnumType {
+func (MacSec_MKA_ConfidentialityOffset) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[12]
 }
 
-func (x Network_ImportedBgpRoutes_RouteTableFormat) Number() protoreflect.EnumNumber {
+func (x MacSec_MKA_ConfidentialityOffset) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Network_ImportedBgpRoutes_RouteTableFormat.Descriptor instead.
-func (Network_ImportedBgpRoutes_RouteTableFormat) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{18, 0, 0}
+// Deprecated: Use MacSec_MKA_ConfidentialityOffset.Descriptor instead.
+func (MacSec_MKA_ConfidentialityOffset) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{15, 0, 1}
 }
 
-type FrameSize_ImixPreset int32
+type ISISConfig_Level int32
 
 const (
-	FrameSize_IMIX_UNKNOWN       FrameSize_ImixPreset = 0
-	FrameSize_IMIX_CISCO         FrameSize_ImixPreset = 1
-	FrameSize_IMIX_DEFAULT       FrameSize_ImixPreset = 2
-	FrameSize_IMIX_IPSEC         FrameSize_ImixPreset = 3
-	FrameSize_IMIX_IPV6          FrameSize_ImixPreset = 4
-	FrameSize_IMIX_RPR_QUADMODAL FrameSize_ImixPreset = 5
-	FrameSize_IMIX_RPR_TRIMODAL  FrameSize_ImixPreset = 6
-	FrameSize_IMIX_STANDARD      FrameSize_ImixPreset = 7
-	FrameSize_IMIX_TCP           FrameSize_ImixPreset = 8
-	FrameSize_IMIX_TOLLY         FrameSize_ImixPreset = 9
+	ISISConfig_LEVEL_UNSPECIFIED ISISConfig_Level = 0
+	ISISConfig_L1                ISISConfig_Level = 1
+	ISISConfig_L2                ISISConfig_Level = 2
+	// Both level 1 and level 2.
+	ISISConfig_L1L2 ISISConfig_Level = 3
 )
 
-// Enum value maps for FrameSize_ImixPreset.
+// Enum value maps for ISISConfig_Level.
 var (
-	FrameSize_ImixPreset_name = map[int32]string{
-		0: "IMIX_UNKNOWN",
-		1: "IMIX_CISCO",
-		2: "IMIX_DEFAULT",
-		3: "IMIX_IPSEC",
-		4: "IMIX_IPV6",
-		5: "IMIX_RPR_QUADMODAL",
-		6: "IMIX_RPR_TRIMODAL",
-		7: "IMIX_STANDARD",
-		8: "IMIX_TCP",
-		9: "IMIX_TOLLY",
+	ISISConfig_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "L1",
+		2: "L2",
+		3: "L1L2",
 	}
-	FrameSize_ImixPreset_value = map[string]int32{
-		"IMIX_UNKNOWN":       0,
-		"IMIX_CISCO":         1,
-		"IMIX_DEFAULT":       2,
-		"IMIX_IPSEC":         3,
-		"IMIX_IPV6":          4,
-		"IMIX_RPR_QUADMODAL": 5,
-		"IMIX_RPR_TRIMODAL":  6,
-		"IMIX_STANDARD":      7,
-		"IMIX_TCP":           8,
-		"IMIX_TOLLY":         9,
+	ISISConfig_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"L1":                1,
+		"L2":                2,
+		"L1L2":              3,
 	}
 )
 
-func (x FrameSize_ImixPreset) Enum() *FrameSize_ImixPreset {
-	p := new(FrameSize_ImixPreset)
+func (x ISISConfig_Level) Enum() *ISISConfig_Level {
+	p := new(ISISConfig_Level)
 	*p = x
 	return p
 }
 
-func (x FrameSize_ImixPreset) String() string {
+func (x ISISConfig_Level) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (FrameSize_ImixPreset) Descriptor() protoreflect.EnumDescriptor {
+func (ISISConfig_Level) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[13].Descriptor()
 }
 
-func (FrameSize_ImixPreset) Type() protoreflect.EnumType {
+func (ISISConfig_Level) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[13]
 }
 
-func (x FrameSize_ImixPreset) Number() protoreflect.EnumNumber {
+func (x ISISConfig_Level) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use FrameSize_ImixPreset.Descriptor instead.
-func (FrameSize_ImixPreset) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use ISISConfig_Level.Descriptor instead.
+func (ISISConfig_Level) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{19, 0}
 }
 
-type Transmission_Pattern int32
+type ISISConfig_NetworkType int32
 
 const (
-	Transmission_PATTERN_UNSPECIFIED Transmission_Pattern = 0
-	Transmission_CONTINUOUS          Transmission_Pattern = 1
-	Transmission_BURST               Transmission_Pattern = 2
-	Transmission_FIXED_FRAME_COUNT   Transmission_Pattern = 3
-	Transmission_FIXED_DURATION      Transmission_Pattern = 4
+	ISISConfig_NETWORK_TYPE_UNSPECIFIED ISISConfig_NetworkType = 0
+	ISISConfig_BROADCAST                ISISConfig_NetworkType = 1
+	ISISConfig_POINT_TO_POINT           ISISConfig_NetworkType = 2
 )
 
-// Enum value maps for Transmission_Pattern.
+// Enum value maps for ISISConfig_NetworkType.
 var (
-	Transmission_Pattern_name = map[int32]string{
-		0: "PATTERN_UNSPECIFIED",
-		1: "CONTINUOUS",
-		2: "BURST",
-		3: "FIXED_FRAME_COUNT",
-		4: "FIXED_DURATION",
+	ISISConfig_NetworkType_name = map[int32]string{
+		0: "NETWORK_TYPE_UNSPECIFIED",
+		1: "BROADCAST",
+		2: "POINT_TO_POINT",
 	}
-	Transmission_Pattern_value = map[string]int32{
-		"PATTERN_UNSPECIFIED": 0,
-		"CONTINUOUS":          1,
-		"BURST":               2,
-		"FIXED_FRAME_COUNT":   3,
-		"FIXED_DURATION":      4,
+	ISISConfig_NetworkType_value = map[string]int32{
+		"NETWORK_TYPE_UNSPECIFIED": 0,
+		"BROADCAST":                1,
+		"POINT_TO_POINT":           2,
 	}
 )
 
-func (x Transmission_Pattern) Enum() *Transmission_Pattern {
-	p := new(Transmission_Pattern)
+func (x ISISConfig_NetworkType) Enum() *ISISConfig_NetworkType {
+	p := new(ISISConfig_NetworkType)
 	*p = x
 	return p
 }
 
-func (x Transmission_Pattern) String() string {
+func (x ISISConfig_NetworkType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Transmission_Pattern) Descriptor() protoreflect.EnumDescriptor {
+func (ISISConfig_NetworkType) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[14].Descriptor()
 }
 
-func (Transmission_Pattern) Type() protoreflect.EnumType {
+func (ISISConfig_NetworkType) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[14]
 }
 
-func (x Transmission_Pattern) Number() protoreflect.EnumNumber {
+func (x ISISConfig_NetworkType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Transmission_Pattern.Descriptor instead.
-func (Transmission_Pattern) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{24, 0}
+// Deprecated: Use ISISConfig_NetworkType.Descriptor instead.
+func (ISISConfig_NetworkType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{19, 1}
 }
 
-type IcmpHeader_DestinationUnreachable_Code int32
+type ISISConfig_AuthType int32
 
 const (
-	IcmpHeader_DestinationUnreachable_CODE_UNSPECIFIED       IcmpHeader_DestinationUnreachable_Code = 0
-	IcmpHeader_DestinationUnreachable_NETWORK_UNREACHABLE    IcmpHeader_DestinationUnreachable_Code = 1
-	IcmpHeader_DestinationUnreachable_HOST_UNREACHABLE       IcmpHeader_DestinationUnreachable_Code = 2
-	IcmpHeader_DestinationUnreachable_PROTOCOL_UNREACHABLE   IcmpHeader_DestinationUnreachable_Code = 3
-	IcmpHeader_DestinationUnreachable_PORT_UNREACHABLE       IcmpHeader_DestinationUnreachable_Code = 4
-	IcmpHeader_DestinationUnreachable_FRAGMENTATION_REQUIRED IcmpHeader_DestinationUnreachable_Code = 5
-	IcmpHeader_DestinationUnreachable_SOURCE_ROUTE_FAILED    IcmpHeader_DestinationUnreachable_Code = 6
+	ISISConfig_AUTH_TYPE_UNSPECIFIED ISISConfig_AuthType = 0
+	ISISConfig_MD5                   ISISConfig_AuthType = 1
+	ISISConfig_PASSWORD              ISISConfig_AuthType = 2
 )
 
-// Enum value maps for IcmpHeader_DestinationUnreachable_Code.
+// Enum value maps for ISISConfig_AuthType.
 var (
-	IcmpHeader_DestinationUnreachable_Code_name = map[int32]string{
-		0: "CODE_UNSPECIFIED",
-		1: "NETWORK_UNREACHABLE",
-		2: "HOST_UNREACHABLE",
-		3: "PROTOCOL_UNREACHABLE",
-		4: "PORT_UNREACHABLE",
-		5: "FRAGMENTATION_REQUIRED",
-		6: "SOURCE_ROUTE_FAILED",
+	ISISConfig_AuthType_name = map[int32]string{
+		0: "AUTH_TYPE_UNSPECIFIED",
+		1: "MD5",
+		2: "PASSWORD",
 	}
-	IcmpHeader_DestinationUnreachable_Code_value = map[string]int32{
-		"CODE_UNSPECIFIED":       0,
-		"NETWORK_UNREACHABLE":    1,
-		"HOST_UNREACHABLE":       2,
-		"PROTOCOL_UNREACHABLE":   3,
-		"PORT_UNREACHABLE":       4,
-		"FRAGMENTATION_REQUIRED": 5,
-		"SOURCE_ROUTE_FAILED":    6,
+	ISISConfig_AuthType_value = map[string]int32{
+		"AUTH_TYPE_UNSPECIFIED": 0,
+		"MD5":                   1,
+		"PASSWORD":              2,
 	}
 )
 
-func (x IcmpHeader_DestinationUnreachable_Code) Enum() *IcmpHeader_DestinationUnreachable_Code {
-	p := new(IcmpHeader_DestinationUnreachable_Code)
+func (x ISISConfig_AuthType) Enum() *ISISConfig_AuthType {
+	p := new(ISISConfig_AuthType)
 	*p = x
 	return p
 }
 
-func (x IcmpHeader_DestinationUnreachable_Code) String() string {
+func (x ISISConfig_AuthType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (IcmpHeader_DestinationUnreachable_Code) Descriptor() protoreflect.EnumDescriptor {
+func (ISISConfig_AuthType) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[15].Descriptor()
 }
 
-func (IcmpHeader_DestinationUnreachable_Code) Type() protoreflect.EnumType {
+func (ISISConfig_AuthType) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[15]
 }
 
-func (x IcmpHeader_DestinationUnreachable_Code) Number() protoreflect.EnumNumber {
+func (x ISISConfig_AuthType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use IcmpHeader_DestinationUnreachable_Code.Descriptor instead.
-func (IcmpHeader_DestinationUnreachable_Code) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 1, 0}
+// Deprecated: Use ISISConfig_AuthType.Descriptor instead.
+func (ISISConfig_AuthType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{19, 2}
 }
 
-type IcmpHeader_RedirectMessage_Code int32
+type IPReachability_RouteOrigin int32
 
 const (
-	IcmpHeader_RedirectMessage_CODE_UNSPECIFIED IcmpHeader_RedirectMessage_Code = 0
-	IcmpHeader_RedirectMessage_NETWORK          IcmpHeader_RedirectMessage_Code = 1
-	IcmpHeader_RedirectMessage_HOST             IcmpHeader_RedirectMessage_Code = 2
-	IcmpHeader_RedirectMessage_TOS_AND_NETWORK  IcmpHeader_RedirectMessage_Code = 3
-	IcmpHeader_RedirectMessage_TOS_AND_HOST     IcmpHeader_RedirectMessage_Code = 4
+	IPReachability_ROUTE_ORIGIN_UNSPECIFIED IPReachability_RouteOrigin = 0
+	IPReachability_INTERNAL                 IPReachability_RouteOrigin = 1
+	IPReachability_EXTERNAL                 IPReachability_RouteOrigin = 2
 )
 
-// Enum value maps for IcmpHeader_RedirectMessage_Code.
+// Enum value maps for IPReachability_RouteOrigin.
 var (
-	IcmpHeader_RedirectMessage_Code_name = map[int32]string{
-		0: "CODE_UNSPECIFIED",
-		1: "NETWORK",
-		2: "HOST",
-		3: "TOS_AND_NETWORK",
-		4: "TOS_AND_HOST",
+	IPReachability_RouteOrigin_name = map[int32]string{
+		0: "ROUTE_ORIGIN_UNSPECIFIED",
+		1: "INTERNAL",
+		2: "EXTERNAL",
 	}
-	IcmpHeader_RedirectMessage_Code_value = map[string]int32{
-		"CODE_UNSPECIFIED": 0,
-		"NETWORK":          1,
-		"HOST":             2,
-		"TOS_AND_NETWORK":  3,
-		"TOS_AND_HOST":     4,
+	IPReachability_RouteOrigin_value = map[string]int32{
+		"ROUTE_ORIGIN_UNSPECIFIED": 0,
+		"INTERNAL":                 1,
+		"EXTERNAL":                 2,
 	}
 )
 
-func (x IcmpHeader_RedirectMessage_Code) Enum() *IcmpHeader_RedirectMessage_Code {
-	p := new(IcmpHeader_RedirectMessage_Code)
+func (x IPReachability_RouteOrigin) Enum() *IPReachability_RouteOrigin {
+	p := new(IPReachability_RouteOrigin)
 	*p = x
 	return p
 }
 
-func (x IcmpHeader_RedirectMessage_Code) String() string {
+func (x IPReachability_RouteOrigin) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (IcmpHeader_RedirectMessage_Code) Descriptor() protoreflect.EnumDescriptor {
+func (IPReachability_RouteOrigin) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[16].Descriptor()
 }
 
-func (IcmpHeader_RedirectMessage_Code) Type() protoreflect.EnumType {
+func (IPReachability_RouteOrigin) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[16]
 }
 
-func (x IcmpHeader_RedirectMessage_Code) Number() protoreflect.EnumNumber {
+func (x IPReachability_RouteOrigin) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use IcmpHeader_RedirectMessage_Code.Descriptor instead.
-func (IcmpHeader_RedirectMessage_Code) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 2, 0}
+// Deprecated: Use IPReachability_RouteOrigin.Descriptor instead.
+func (IPReachability_RouteOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{22, 0}
 }
 
-type IcmpHeader_TimeExceeded_Code int32
+type PortL1Config_FecMode int32
 
 const (
-	IcmpHeader_TimeExceeded_CODE_UNSPECIFIED    IcmpHeader_TimeExceeded_Code = 0
-	IcmpHeader_TimeExceeded_TRANSIT             IcmpHeader_TimeExceeded_Code = 1
-	IcmpHeader_TimeExceeded_FRAGMENT_REASSEMBLY IcmpHeader_TimeExceeded_Code = 2
+	PortL1Config_FEC_MODE_UNSPECIFIED PortL1Config_FecMode = 0
+	PortL1Config_FEC_MODE_NONE        PortL1Config_FecMode = 1
+	// Reed-Solomon FEC (clause 91).
+	PortL1Config_FEC_MODE_RS PortL1Config_FecMode = 2
+	// Firecode, or BASE-R, FEC (clause 74).
+	PortL1Config_FEC_MODE_FIRECODE PortL1Config_FecMode = 3
 )
 
-// Enum value maps for IcmpHeader_TimeExceeded_Code.
+// Enum value maps for PortL1Config_FecMode.
 var (
-	IcmpHeader_TimeExceeded_Code_name = map[int32]string{
-		0: "CODE_UNSPECIFIED",
-		1: "TRANSIT",
-		2: "FRAGMENT_REASSEMBLY",
-	}
-	IcmpHeader_TimeExceeded_Code_value = map[string]int32{
-		"CODE_UNSPECIFIED":    0,
-		"TRANSIT":             1,
-		"FRAGMENT_REASSEMBLY": 2,
+	PortL1Config_FecMode_name = map[int32]string{
+		0: "FEC_MODE_UNSPECIFIED",
+		1: "FEC_MODE_NONE",
+		2: "FEC_MODE_RS",
+		3: "FEC_MODE_FIRECODE",
+	}
+	PortL1Config_FecMode_value = map[string]int32{
+		"FEC_MODE_UNSPECIFIED": 0,
+		"FEC_MODE_NONE":        1,
+		"FEC_MODE_RS":          2,
+		"FEC_MODE_FIRECODE":    3,
 	}
 )
 
-func (x IcmpHeader_TimeExceeded_Code) Enum() *IcmpHeader_TimeExceeded_Code {
-	p := new(IcmpHeader_TimeExceeded_Code)
+func (x PortL1Config_FecMode) Enum() *PortL1Config_FecMode {
+	p := new(PortL1Config_FecMode)
 	*p = x
 	return p
 }
 
-func (x IcmpHeader_TimeExceeded_Code) String() string {
+func (x PortL1Config_FecMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (IcmpHeader_TimeExceeded_Code) Descriptor() protoreflect.EnumDescriptor {
+func (PortL1Config_FecMode) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[17].Descriptor()
 }
 
-func (IcmpHeader_TimeExceeded_Code) Type() protoreflect.EnumType {
+func (PortL1Config_FecMode) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[17]
 }
 
-func (x IcmpHeader_TimeExceeded_Code) Number() protoreflect.EnumNumber {
+func (x PortL1Config_FecMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use IcmpHeader_TimeExceeded_Code.Descriptor instead.
-func (IcmpHeader_TimeExceeded_Code) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 4, 0}
+// Deprecated: Use PortL1Config_FecMode.Descriptor instead.
+func (PortL1Config_FecMode) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{24, 0}
 }
 
-type OspfHeader_LinkStateType int32
+type PortL1Config_AutoNegotiation int32
 
 const (
-	OspfHeader_LINK_STATE_TYPE_UNSPECIFIED     OspfHeader_LinkStateType = 0
-	OspfHeader_LINK_STATE_TYPE_ROUTER          OspfHeader_LinkStateType = 1
-	OspfHeader_LINK_STATE_TYPE_NETWORK         OspfHeader_LinkStateType = 2
-	OspfHeader_LINK_STATE_TYPE_SUMMARY_NETWORK OspfHeader_LinkStateType = 3
-	OspfHeader_LINK_STATE_TYPE_SUMMARY_ASBR    OspfHeader_LinkStateType = 4
-	OspfHeader_LINK_STATE_TYPE_AS_EXTERNAL     OspfHeader_LinkStateType = 5
+	PortL1Config_AUTO_NEGOTIATION_UNSPECIFIED PortL1Config_AutoNegotiation = 0
+	PortL1Config_AUTO_NEGOTIATION_ENABLED     PortL1Config_AutoNegotiation = 1
+	PortL1Config_AUTO_NEGOTIATION_DISABLED    PortL1Config_AutoNegotiation = 2
 )
 
-// Enum value maps for OspfHeader_LinkStateType.
+// Enum value maps for PortL1Config_AutoNegotiation.
 var (
-	OspfHeader_LinkStateType_name = map[int32]string{
-		0: "LINK_STATE_TYPE_UNSPECIFIED",
-		1: "LINK_STATE_TYPE_ROUTER",
-		2: "LINK_STATE_TYPE_NETWORK",
-		3: "LINK_STATE_TYPE_SUMMARY_NETWORK",
-		4: "LINK_STATE_TYPE_SUMMARY_ASBR",
-		5: "LINK_STATE_TYPE_AS_EXTERNAL",
+	PortL1Config_AutoNegotiation_name = map[int32]string{
+		0: "AUTO_NEGOTIATION_UNSPECIFIED",
+		1: "AUTO_NEGOTIATION_ENABLED",
+		2: "AUTO_NEGOTIATION_DISABLED",
 	}
-	OspfHeader_LinkStateType_value = map[string]int32{
-		"LINK_STATE_TYPE_UNSPECIFIED":     0,
-		"LINK_STATE_TYPE_ROUTER":          1,
-		"LINK_STATE_TYPE_NETWORK":         2,
-		"LINK_STATE_TYPE_SUMMARY_NETWORK": 3,
-		"LINK_STATE_TYPE_SUMMARY_ASBR":    4,
-		"LINK_STATE_TYPE_AS_EXTERNAL":     5,
+	PortL1Config_AutoNegotiation_value = map[string]int32{
+		"AUTO_NEGOTIATION_UNSPECIFIED": 0,
+		"AUTO_NEGOTIATION_ENABLED":     1,
+		"AUTO_NEGOTIATION_DISABLED":    2,
 	}
 )
 
-func (x OspfHeader_LinkStateType) Enum() *OspfHeader_LinkStateType {
-	p := new(OspfHeader_LinkStateType)
+func (x PortL1Config_AutoNegotiation) Enum() *PortL1Config_AutoNegotiation {
+	p := new(PortL1Config_AutoNegotiation)
 	*p = x
 	return p
 }
 
-func (x OspfHeader_LinkStateType) String() string {
+func (x PortL1Config_AutoNegotiation) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (OspfHeader_LinkStateType) Descriptor() protoreflect.EnumDescriptor {
+func (PortL1Config_AutoNegotiation) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[18].Descriptor()
 }
 
-func (OspfHeader_LinkStateType) Type() protoreflect.EnumType {
+func (PortL1Config_AutoNegotiation) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[18]
 }
 
-func (x OspfHeader_LinkStateType) Number() protoreflect.EnumNumber {
+func (x PortL1Config_AutoNegotiation) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use OspfHeader_LinkStateType.Descriptor instead.
-func (OspfHeader_LinkStateType) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 0}
+// Deprecated: Use PortL1Config_AutoNegotiation.Descriptor instead.
+func (PortL1Config_AutoNegotiation) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{24, 1}
 }
 
-type RsvpHeader_MessageType int32
+type BgpPeer_Type int32
 
 const (
-	RsvpHeader_MESSAGE_TYPE_UNSPECIFIED RsvpHeader_MessageType = 0
-	RsvpHeader_PATH                     RsvpHeader_MessageType = 1
-	RsvpHeader_RESV                     RsvpHeader_MessageType = 2
+	BgpPeer_TYPE_UNSPECIFIED BgpPeer_Type = 0
+	BgpPeer_TYPE_EXTERNAL    BgpPeer_Type = 1
+	BgpPeer_TYPE_INTERNAL    BgpPeer_Type = 2
 )
 
-// Enum value maps for RsvpHeader_MessageType.
+// Enum value maps for BgpPeer_Type.
 var (
-	RsvpHeader_MessageType_name = map[int32]string{
-		0: "MESSAGE_TYPE_UNSPECIFIED",
-		1: "PATH",
-		2: "RESV",
+	BgpPeer_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_EXTERNAL",
+		2: "TYPE_INTERNAL",
 	}
-	RsvpHeader_MessageType_value = map[string]int32{
-		"MESSAGE_TYPE_UNSPECIFIED": 0,
-		"PATH":                     1,
-		"RESV":                     2,
+	BgpPeer_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_EXTERNAL":    1,
+		"TYPE_INTERNAL":    2,
 	}
 )
 
-func (x RsvpHeader_MessageType) Enum() *RsvpHeader_MessageType {
-	p := new(RsvpHeader_MessageType)
+func (x BgpPeer_Type) Enum() *BgpPeer_Type {
+	p := new(BgpPeer_Type)
 	*p = x
 	return p
 }
 
-func (x RsvpHeader_MessageType) String() string {
+func (x BgpPeer_Type) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (RsvpHeader_MessageType) Descriptor() protoreflect.EnumDescriptor {
+func (BgpPeer_Type) Descriptor() protoreflect.EnumDescriptor {
 	return file_ate_proto_enumTypes[19].Descriptor()
 }
 
-func (RsvpHeader_MessageType) Type() protoreflect.EnumType {
+func (BgpPeer_Type) Type() protoreflect.EnumType {
 	return &file_ate_proto_enumTypes[19]
 }
 
-func (x RsvpHeader_MessageType) Number() protoreflect.EnumNumber {
+func (x BgpPeer_Type) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use RsvpHeader_MessageType.Descriptor instead.
-func (RsvpHeader_MessageType) EnumDescriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use BgpPeer_Type.Descriptor instead.
+func (BgpPeer_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{28, 0}
 }
 
-type Topology struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// The direction in which additional paths are negotiated for the add-path
+// capabilities enabled on the peer.
+type BgpPeer_AddPathMode int32
 
-	Lags       []*Lag             `protobuf:"bytes,1,rep,name=lags,proto3" json:"lags,omitempty"`
-	Interfaces []*InterfaceConfig `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-}
+const (
+	BgpPeer_ADD_PATH_MODE_UNSPECIFIED  BgpPeer_AddPathMode = 0
+	BgpPeer_ADD_PATH_MODE_RECEIVE      BgpPeer_AddPathMode = 1
+	BgpPeer_ADD_PATH_MODE_SEND         BgpPeer_AddPathMode = 2
+	BgpPeer_ADD_PATH_MODE_SEND_RECEIVE BgpPeer_AddPathMode = 3
+)
 
-func (x *Topology) Reset() {
-	*x = Topology{}
-	if protoimpl.UnsafeEnabled {
+// Enum value maps for BgpPeer_AddPathMode.
+var (
+	BgpPeer_AddPathMode_name = map[int32]string{
+		0: "ADD_PATH_MODE_UNSPECIFIED",
+		1: "ADD_PATH_MODE_RECEIVE",
+		2: "ADD_PATH_MODE_SEND",
+		3: "ADD_PATH_MODE_SEND_RECEIVE",
+	}
+	BgpPeer_AddPathMode_value = map[string]int32{
+		"ADD_PATH_MODE_UNSPECIFIED":  0,
+		"ADD_PATH_MODE_RECEIVE":      1,
+		"ADD_PATH_MODE_SEND":         2,
+		"ADD_PATH_MODE_SEND_RECEIVE": 3,
+	}
+)
+
+func (x BgpPeer_AddPathMode) Enum() *BgpPeer_AddPathMode {
+	p := new(BgpPeer_AddPathMode)
+	*p = x
+	return p
+}
+
+func (x BgpPeer_AddPathMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BgpPeer_AddPathMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[20].Descriptor()
+}
+
+func (BgpPeer_AddPathMode) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[20]
+}
+
+func (x BgpPeer_AddPathMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BgpPeer_AddPathMode.Descriptor instead.
+func (BgpPeer_AddPathMode) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{28, 1}
+}
+
+type BgpAttributes_Origin int32
+
+const (
+	BgpAttributes_ORIGIN_UNSPECIFIED BgpAttributes_Origin = 0
+	BgpAttributes_ORIGIN_IGP         BgpAttributes_Origin = 1
+	BgpAttributes_ORIGIN_EGP         BgpAttributes_Origin = 2
+	BgpAttributes_ORIGIN_INCOMPLETE  BgpAttributes_Origin = 3
+)
+
+// Enum value maps for BgpAttributes_Origin.
+var (
+	BgpAttributes_Origin_name = map[int32]string{
+		0: "ORIGIN_UNSPECIFIED",
+		1: "ORIGIN_IGP",
+		2: "ORIGIN_EGP",
+		3: "ORIGIN_INCOMPLETE",
+	}
+	BgpAttributes_Origin_value = map[string]int32{
+		"ORIGIN_UNSPECIFIED": 0,
+		"ORIGIN_IGP":         1,
+		"ORIGIN_EGP":         2,
+		"ORIGIN_INCOMPLETE":  3,
+	}
+)
+
+func (x BgpAttributes_Origin) Enum() *BgpAttributes_Origin {
+	p := new(BgpAttributes_Origin)
+	*p = x
+	return p
+}
+
+func (x BgpAttributes_Origin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BgpAttributes_Origin) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[21].Descriptor()
+}
+
+func (BgpAttributes_Origin) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[21]
+}
+
+func (x BgpAttributes_Origin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BgpAttributes_Origin.Descriptor instead.
+func (BgpAttributes_Origin) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type BgpAttributes_ExtendedCommunity_Color_CoBits int32
+
+const (
+	BgpAttributes_ExtendedCommunity_Color_CO_BITS_UNSPECIFIED BgpAttributes_ExtendedCommunity_Color_CoBits = 0
+	BgpAttributes_ExtendedCommunity_Color_CO_BITS_00          BgpAttributes_ExtendedCommunity_Color_CoBits = 1
+	BgpAttributes_ExtendedCommunity_Color_CO_BITS_01          BgpAttributes_ExtendedCommunity_Color_CoBits = 2
+	BgpAttributes_ExtendedCommunity_Color_CO_BITS_10          BgpAttributes_ExtendedCommunity_Color_CoBits = 3
+	BgpAttributes_ExtendedCommunity_Color_CO_BITS_11          BgpAttributes_ExtendedCommunity_Color_CoBits = 4
+)
+
+// Enum value maps for BgpAttributes_ExtendedCommunity_Color_CoBits.
+var (
+	BgpAttributes_ExtendedCommunity_Color_CoBits_name = map[int32]string{
+		0: "CO_BITS_UNSPECIFIED",
+		1: "CO_BITS_00",
+		2: "CO_BITS_01",
+		3: "CO_BITS_10",
+		4: "CO_BITS_11",
+	}
+	BgpAttributes_ExtendedCommunity_Color_CoBits_value = map[string]int32{
+		"CO_BITS_UNSPECIFIED": 0,
+		"CO_BITS_00":          1,
+		"CO_BITS_01":          2,
+		"CO_BITS_10":          3,
+		"CO_BITS_11":          4,
+	}
+)
+
+func (x BgpAttributes_ExtendedCommunity_Color_CoBits) Enum() *BgpAttributes_ExtendedCommunity_Color_CoBits {
+	p := new(BgpAttributes_ExtendedCommunity_Color_CoBits)
+	*p = x
+	return p
+}
+
+func (x BgpAttributes_ExtendedCommunity_Color_CoBits) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BgpAttributes_ExtendedCommunity_Color_CoBits) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[22].Descriptor()
+}
+
+func (BgpAttributes_ExtendedCommunity_Color_CoBits) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[22]
+}
+
+func (x BgpAttributes_ExtendedCommunity_Color_CoBits) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BgpAttributes_ExtendedCommunity_Color_CoBits.Descriptor instead.
+func (BgpAttributes_ExtendedCommunity_Color_CoBits) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 0, 0, 0}
+}
+
+type BgpAttributes_AsPathSegment_Type int32
+
+const (
+	BgpAttributes_AsPathSegment_TYPE_UNSPECIFIED          BgpAttributes_AsPathSegment_Type = 0
+	BgpAttributes_AsPathSegment_TYPE_AS_SEQ               BgpAttributes_AsPathSegment_Type = 1
+	BgpAttributes_AsPathSegment_TYPE_AS_SET               BgpAttributes_AsPathSegment_Type = 2
+	BgpAttributes_AsPathSegment_TYPE_AS_SEQ_CONFEDERATION BgpAttributes_AsPathSegment_Type = 3
+	BgpAttributes_AsPathSegment_TYPE_AS_SET_CONFEDERATION BgpAttributes_AsPathSegment_Type = 4
+)
+
+// Enum value maps for BgpAttributes_AsPathSegment_Type.
+var (
+	BgpAttributes_AsPathSegment_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_AS_SEQ",
+		2: "TYPE_AS_SET",
+		3: "TYPE_AS_SEQ_CONFEDERATION",
+		4: "TYPE_AS_SET_CONFEDERATION",
+	}
+	BgpAttributes_AsPathSegment_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":          0,
+		"TYPE_AS_SEQ":               1,
+		"TYPE_AS_SET":               2,
+		"TYPE_AS_SEQ_CONFEDERATION": 3,
+		"TYPE_AS_SET_CONFEDERATION": 4,
+	}
+)
+
+func (x BgpAttributes_AsPathSegment_Type) Enum() *BgpAttributes_AsPathSegment_Type {
+	p := new(BgpAttributes_AsPathSegment_Type)
+	*p = x
+	return p
+}
+
+func (x BgpAttributes_AsPathSegment_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BgpAttributes_AsPathSegment_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[23].Descriptor()
+}
+
+func (BgpAttributes_AsPathSegment_Type) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[23]
+}
+
+func (x BgpAttributes_AsPathSegment_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BgpAttributes_AsPathSegment_Type.Descriptor instead.
+func (BgpAttributes_AsPathSegment_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 1, 0}
+}
+
+type Network_ImportedBgpRoutes_RouteTableFormat int32
+
+const (
+	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_UNSPECIFIED Network_ImportedBgpRoutes_RouteTableFormat = 0
+	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_CISCO       Network_ImportedBgpRoutes_RouteTableFormat = 1
+	Network_ImportedBgpRoutes_ROUTE_TABLE_FORMAT_JUNIPER     Network_ImportedBgpRoutes_RouteTableFormat = 2
+)
+
+// Enum value maps for Network_ImportedBgpRoutes_RouteTableFormat.
+var (
+	Network_ImportedBgpRoutes_RouteTableFormat_name = map[int32]string{
+		0: "ROUTE_TABLE_FORMAT_UNSPECIFIED",
+		1: "ROUTE_TABLE_FORMAT_CISCO",
+		2: "ROUTE_TABLE_FORMAT_JUNIPER",
+	}
+	Network_ImportedBgpRoutes_RouteTableFormat_value = map[string]int32{
+		"ROUTE_TABLE_FORMAT_UNSPECIFIED": 0,
+		"ROUTE_TABLE_FORMAT_CISCO":       1,
+		"ROUTE_TABLE_FORMAT_JUNIPER":     2,
+	}
+)
+
+func (x Network_ImportedBgpRoutes_RouteTableFormat) Enum() *Network_ImportedBgpRoutes_RouteTableFormat {
+	p := new(Network_ImportedBgpRoutes_RouteTableFormat)
+	*p = x
+	return p
+}
+
+func (x Network_ImportedBgpRoutes_RouteTableFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Network_ImportedBgpRoutes_RouteTableFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[24].Descriptor()
+}
+
+func (Network_ImportedBgpRoutes_RouteTableFormat) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[24]
+}
+
+func (x Network_ImportedBgpRoutes_RouteTableFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Network_ImportedBgpRoutes_RouteTableFormat.Descriptor instead.
+func (Network_ImportedBgpRoutes_RouteTableFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{31, 0, 0}
+}
+
+type Payload_Type int32
+
+const (
+	Payload_TYPE_UNSPECIFIED Payload_Type = 0
+	// The fixed pattern repeated to fill the payload.
+	Payload_FIXED Payload_Type = 1
+	// Bytes incrementing from zero.
+	Payload_INCREMENTING Payload_Type = 2
+	// A pseudo-random bit sequence.
+	Payload_PRBS Payload_Type = 3
+)
+
+// Enum value maps for Payload_Type.
+var (
+	Payload_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "FIXED",
+		2: "INCREMENTING",
+		3: "PRBS",
+	}
+	Payload_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"FIXED":            1,
+		"INCREMENTING":     2,
+		"PRBS":             3,
+	}
+)
+
+func (x Payload_Type) Enum() *Payload_Type {
+	p := new(Payload_Type)
+	*p = x
+	return p
+}
+
+func (x Payload_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Payload_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[25].Descriptor()
+}
+
+func (Payload_Type) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[25]
+}
+
+func (x Payload_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Payload_Type.Descriptor instead.
+func (Payload_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ApplicationTraffic_Protocol int32
+
+const (
+	ApplicationTraffic_PROTOCOL_UNSPECIFIED ApplicationTraffic_Protocol = 0
+	// HTTP GET requests over TCP connections.
+	ApplicationTraffic_HTTP_GET ApplicationTraffic_Protocol = 1
+	// Bulk data over TCP connections.
+	ApplicationTraffic_TCP ApplicationTraffic_Protocol = 2
+	// Request and response datagrams over UDP.
+	ApplicationTraffic_UDP ApplicationTraffic_Protocol = 3
+)
+
+// Enum value maps for ApplicationTraffic_Protocol.
+var (
+	ApplicationTraffic_Protocol_name = map[int32]string{
+		0: "PROTOCOL_UNSPECIFIED",
+		1: "HTTP_GET",
+		2: "TCP",
+		3: "UDP",
+	}
+	ApplicationTraffic_Protocol_value = map[string]int32{
+		"PROTOCOL_UNSPECIFIED": 0,
+		"HTTP_GET":             1,
+		"TCP":                  2,
+		"UDP":                  3,
+	}
+)
+
+func (x ApplicationTraffic_Protocol) Enum() *ApplicationTraffic_Protocol {
+	p := new(ApplicationTraffic_Protocol)
+	*p = x
+	return p
+}
+
+func (x ApplicationTraffic_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationTraffic_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[26].Descriptor()
+}
+
+func (ApplicationTraffic_Protocol) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[26]
+}
+
+func (x ApplicationTraffic_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationTraffic_Protocol.Descriptor instead.
+func (ApplicationTraffic_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type FrameSize_ImixPreset int32
+
+const (
+	FrameSize_IMIX_UNKNOWN       FrameSize_ImixPreset = 0
+	FrameSize_IMIX_CISCO         FrameSize_ImixPreset = 1
+	FrameSize_IMIX_DEFAULT       FrameSize_ImixPreset = 2
+	FrameSize_IMIX_IPSEC         FrameSize_ImixPreset = 3
+	FrameSize_IMIX_IPV6          FrameSize_ImixPreset = 4
+	FrameSize_IMIX_RPR_QUADMODAL FrameSize_ImixPreset = 5
+	FrameSize_IMIX_RPR_TRIMODAL  FrameSize_ImixPreset = 6
+	FrameSize_IMIX_STANDARD      FrameSize_ImixPreset = 7
+	FrameSize_IMIX_TCP           FrameSize_ImixPreset = 8
+	FrameSize_IMIX_TOLLY         FrameSize_ImixPreset = 9
+)
+
+// Enum value maps for FrameSize_ImixPreset.
+var (
+	FrameSize_ImixPreset_name = map[int32]string{
+		0: "IMIX_UNKNOWN",
+		1: "IMIX_CISCO",
+		2: "IMIX_DEFAULT",
+		3: "IMIX_IPSEC",
+		4: "IMIX_IPV6",
+		5: "IMIX_RPR_QUADMODAL",
+		6: "IMIX_RPR_TRIMODAL",
+		7: "IMIX_STANDARD",
+		8: "IMIX_TCP",
+		9: "IMIX_TOLLY",
+	}
+	FrameSize_ImixPreset_value = map[string]int32{
+		"IMIX_UNKNOWN":       0,
+		"IMIX_CISCO":         1,
+		"IMIX_DEFAULT":       2,
+		"IMIX_IPSEC":         3,
+		"IMIX_IPV6":          4,
+		"IMIX_RPR_QUADMODAL": 5,
+		"IMIX_RPR_TRIMODAL":  6,
+		"IMIX_STANDARD":      7,
+		"IMIX_TCP":           8,
+		"IMIX_TOLLY":         9,
+	}
+)
+
+func (x FrameSize_ImixPreset) Enum() *FrameSize_ImixPreset {
+	p := new(FrameSize_ImixPreset)
+	*p = x
+	return p
+}
+
+func (x FrameSize_ImixPreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameSize_ImixPreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[27].Descriptor()
+}
+
+func (FrameSize_ImixPreset) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[27]
+}
+
+func (x FrameSize_ImixPreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameSize_ImixPreset.Descriptor instead.
+func (FrameSize_ImixPreset) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type Transmission_Pattern int32
+
+const (
+	Transmission_PATTERN_UNSPECIFIED Transmission_Pattern = 0
+	Transmission_CONTINUOUS          Transmission_Pattern = 1
+	Transmission_BURST               Transmission_Pattern = 2
+	Transmission_FIXED_FRAME_COUNT   Transmission_Pattern = 3
+	Transmission_FIXED_DURATION      Transmission_Pattern = 4
+)
+
+// Enum value maps for Transmission_Pattern.
+var (
+	Transmission_Pattern_name = map[int32]string{
+		0: "PATTERN_UNSPECIFIED",
+		1: "CONTINUOUS",
+		2: "BURST",
+		3: "FIXED_FRAME_COUNT",
+		4: "FIXED_DURATION",
+	}
+	Transmission_Pattern_value = map[string]int32{
+		"PATTERN_UNSPECIFIED": 0,
+		"CONTINUOUS":          1,
+		"BURST":               2,
+		"FIXED_FRAME_COUNT":   3,
+		"FIXED_DURATION":      4,
+	}
+)
+
+func (x Transmission_Pattern) Enum() *Transmission_Pattern {
+	p := new(Transmission_Pattern)
+	*p = x
+	return p
+}
+
+func (x Transmission_Pattern) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Transmission_Pattern) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[28].Descriptor()
+}
+
+func (Transmission_Pattern) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[28]
+}
+
+func (x Transmission_Pattern) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Transmission_Pattern.Descriptor instead.
+func (Transmission_Pattern) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type EgressTracking_Filter int32
+
+const (
+	EgressTracking_FILTER_CUSTOM     EgressTracking_Filter = 0
+	EgressTracking_FILTER_MPLS_LABEL EgressTracking_Filter = 1
+	EgressTracking_FILTER_DSCP       EgressTracking_Filter = 2
+	EgressTracking_FILTER_VLAN_ID    EgressTracking_Filter = 3
+)
+
+// Enum value maps for EgressTracking_Filter.
+var (
+	EgressTracking_Filter_name = map[int32]string{
+		0: "FILTER_CUSTOM",
+		1: "FILTER_MPLS_LABEL",
+		2: "FILTER_DSCP",
+		3: "FILTER_VLAN_ID",
+	}
+	EgressTracking_Filter_value = map[string]int32{
+		"FILTER_CUSTOM":     0,
+		"FILTER_MPLS_LABEL": 1,
+		"FILTER_DSCP":       2,
+		"FILTER_VLAN_ID":    3,
+	}
+)
+
+func (x EgressTracking_Filter) Enum() *EgressTracking_Filter {
+	p := new(EgressTracking_Filter)
+	*p = x
+	return p
+}
+
+func (x EgressTracking_Filter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EgressTracking_Filter) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[29].Descriptor()
+}
+
+func (EgressTracking_Filter) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[29]
+}
+
+func (x EgressTracking_Filter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EgressTracking_Filter.Descriptor instead.
+func (EgressTracking_Filter) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type IcmpHeader_DestinationUnreachable_Code int32
+
+const (
+	IcmpHeader_DestinationUnreachable_CODE_UNSPECIFIED       IcmpHeader_DestinationUnreachable_Code = 0
+	IcmpHeader_DestinationUnreachable_NETWORK_UNREACHABLE    IcmpHeader_DestinationUnreachable_Code = 1
+	IcmpHeader_DestinationUnreachable_HOST_UNREACHABLE       IcmpHeader_DestinationUnreachable_Code = 2
+	IcmpHeader_DestinationUnreachable_PROTOCOL_UNREACHABLE   IcmpHeader_DestinationUnreachable_Code = 3
+	IcmpHeader_DestinationUnreachable_PORT_UNREACHABLE       IcmpHeader_DestinationUnreachable_Code = 4
+	IcmpHeader_DestinationUnreachable_FRAGMENTATION_REQUIRED IcmpHeader_DestinationUnreachable_Code = 5
+	IcmpHeader_DestinationUnreachable_SOURCE_ROUTE_FAILED    IcmpHeader_DestinationUnreachable_Code = 6
+)
+
+// Enum value maps for IcmpHeader_DestinationUnreachable_Code.
+var (
+	IcmpHeader_DestinationUnreachable_Code_name = map[int32]string{
+		0: "CODE_UNSPECIFIED",
+		1: "NETWORK_UNREACHABLE",
+		2: "HOST_UNREACHABLE",
+		3: "PROTOCOL_UNREACHABLE",
+		4: "PORT_UNREACHABLE",
+		5: "FRAGMENTATION_REQUIRED",
+		6: "SOURCE_ROUTE_FAILED",
+	}
+	IcmpHeader_DestinationUnreachable_Code_value = map[string]int32{
+		"CODE_UNSPECIFIED":       0,
+		"NETWORK_UNREACHABLE":    1,
+		"HOST_UNREACHABLE":       2,
+		"PROTOCOL_UNREACHABLE":   3,
+		"PORT_UNREACHABLE":       4,
+		"FRAGMENTATION_REQUIRED": 5,
+		"SOURCE_ROUTE_FAILED":    6,
+	}
+)
+
+func (x IcmpHeader_DestinationUnreachable_Code) Enum() *IcmpHeader_DestinationUnreachable_Code {
+	p := new(IcmpHeader_DestinationUnreachable_Code)
+	*p = x
+	return p
+}
+
+func (x IcmpHeader_DestinationUnreachable_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IcmpHeader_DestinationUnreachable_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[30].Descriptor()
+}
+
+func (IcmpHeader_DestinationUnreachable_Code) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[30]
+}
+
+func (x IcmpHeader_DestinationUnreachable_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IcmpHeader_DestinationUnreachable_Code.Descriptor instead.
+func (IcmpHeader_DestinationUnreachable_Code) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{52, 1, 0}
+}
+
+type IcmpHeader_RedirectMessage_Code int32
+
+const (
+	IcmpHeader_RedirectMessage_CODE_UNSPECIFIED IcmpHeader_RedirectMessage_Code = 0
+	IcmpHeader_RedirectMessage_NETWORK          IcmpHeader_RedirectMessage_Code = 1
+	IcmpHeader_RedirectMessage_HOST             IcmpHeader_RedirectMessage_Code = 2
+	IcmpHeader_RedirectMessage_TOS_AND_NETWORK  IcmpHeader_RedirectMessage_Code = 3
+	IcmpHeader_RedirectMessage_TOS_AND_HOST     IcmpHeader_RedirectMessage_Code = 4
+)
+
+// Enum value maps for IcmpHeader_RedirectMessage_Code.
+var (
+	IcmpHeader_RedirectMessage_Code_name = map[int32]string{
+		0: "CODE_UNSPECIFIED",
+		1: "NETWORK",
+		2: "HOST",
+		3: "TOS_AND_NETWORK",
+		4: "TOS_AND_HOST",
+	}
+	IcmpHeader_RedirectMessage_Code_value = map[string]int32{
+		"CODE_UNSPECIFIED": 0,
+		"NETWORK":          1,
+		"HOST":             2,
+		"TOS_AND_NETWORK":  3,
+		"TOS_AND_HOST":     4,
+	}
+)
+
+func (x IcmpHeader_RedirectMessage_Code) Enum() *IcmpHeader_RedirectMessage_Code {
+	p := new(IcmpHeader_RedirectMessage_Code)
+	*p = x
+	return p
+}
+
+func (x IcmpHeader_RedirectMessage_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IcmpHeader_RedirectMessage_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[31].Descriptor()
+}
+
+func (IcmpHeader_RedirectMessage_Code) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[31]
+}
+
+func (x IcmpHeader_RedirectMessage_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IcmpHeader_RedirectMessage_Code.Descriptor instead.
+func (IcmpHeader_RedirectMessage_Code) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{52, 2, 0}
+}
+
+type IcmpHeader_TimeExceeded_Code int32
+
+const (
+	IcmpHeader_TimeExceeded_CODE_UNSPECIFIED    IcmpHeader_TimeExceeded_Code = 0
+	IcmpHeader_TimeExceeded_TRANSIT             IcmpHeader_TimeExceeded_Code = 1
+	IcmpHeader_TimeExceeded_FRAGMENT_REASSEMBLY IcmpHeader_TimeExceeded_Code = 2
+)
+
+// Enum value maps for IcmpHeader_TimeExceeded_Code.
+var (
+	IcmpHeader_TimeExceeded_Code_name = map[int32]string{
+		0: "CODE_UNSPECIFIED",
+		1: "TRANSIT",
+		2: "FRAGMENT_REASSEMBLY",
+	}
+	IcmpHeader_TimeExceeded_Code_value = map[string]int32{
+		"CODE_UNSPECIFIED":    0,
+		"TRANSIT":             1,
+		"FRAGMENT_REASSEMBLY": 2,
+	}
+)
+
+func (x IcmpHeader_TimeExceeded_Code) Enum() *IcmpHeader_TimeExceeded_Code {
+	p := new(IcmpHeader_TimeExceeded_Code)
+	*p = x
+	return p
+}
+
+func (x IcmpHeader_TimeExceeded_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IcmpHeader_TimeExceeded_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[32].Descriptor()
+}
+
+func (IcmpHeader_TimeExceeded_Code) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[32]
+}
+
+func (x IcmpHeader_TimeExceeded_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IcmpHeader_TimeExceeded_Code.Descriptor instead.
+func (IcmpHeader_TimeExceeded_Code) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{52, 4, 0}
+}
+
+type OspfHeader_LinkStateType int32
+
+const (
+	OspfHeader_LINK_STATE_TYPE_UNSPECIFIED     OspfHeader_LinkStateType = 0
+	OspfHeader_LINK_STATE_TYPE_ROUTER          OspfHeader_LinkStateType = 1
+	OspfHeader_LINK_STATE_TYPE_NETWORK         OspfHeader_LinkStateType = 2
+	OspfHeader_LINK_STATE_TYPE_SUMMARY_NETWORK OspfHeader_LinkStateType = 3
+	OspfHeader_LINK_STATE_TYPE_SUMMARY_ASBR    OspfHeader_LinkStateType = 4
+	OspfHeader_LINK_STATE_TYPE_AS_EXTERNAL     OspfHeader_LinkStateType = 5
+)
+
+// Enum value maps for OspfHeader_LinkStateType.
+var (
+	OspfHeader_LinkStateType_name = map[int32]string{
+		0: "LINK_STATE_TYPE_UNSPECIFIED",
+		1: "LINK_STATE_TYPE_ROUTER",
+		2: "LINK_STATE_TYPE_NETWORK",
+		3: "LINK_STATE_TYPE_SUMMARY_NETWORK",
+		4: "LINK_STATE_TYPE_SUMMARY_ASBR",
+		5: "LINK_STATE_TYPE_AS_EXTERNAL",
+	}
+	OspfHeader_LinkStateType_value = map[string]int32{
+		"LINK_STATE_TYPE_UNSPECIFIED":     0,
+		"LINK_STATE_TYPE_ROUTER":          1,
+		"LINK_STATE_TYPE_NETWORK":         2,
+		"LINK_STATE_TYPE_SUMMARY_NETWORK": 3,
+		"LINK_STATE_TYPE_SUMMARY_ASBR":    4,
+		"LINK_STATE_TYPE_AS_EXTERNAL":     5,
+	}
+)
+
+func (x OspfHeader_LinkStateType) Enum() *OspfHeader_LinkStateType {
+	p := new(OspfHeader_LinkStateType)
+	*p = x
+	return p
+}
+
+func (x OspfHeader_LinkStateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OspfHeader_LinkStateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[33].Descriptor()
+}
+
+func (OspfHeader_LinkStateType) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[33]
+}
+
+func (x OspfHeader_LinkStateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OspfHeader_LinkStateType.Descriptor instead.
+func (OspfHeader_LinkStateType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type RsvpHeader_MessageType int32
+
+const (
+	RsvpHeader_MESSAGE_TYPE_UNSPECIFIED RsvpHeader_MessageType = 0
+	RsvpHeader_PATH                     RsvpHeader_MessageType = 1
+	RsvpHeader_RESV                     RsvpHeader_MessageType = 2
+)
+
+// Enum value maps for RsvpHeader_MessageType.
+var (
+	RsvpHeader_MessageType_name = map[int32]string{
+		0: "MESSAGE_TYPE_UNSPECIFIED",
+		1: "PATH",
+		2: "RESV",
+	}
+	RsvpHeader_MessageType_value = map[string]int32{
+		"MESSAGE_TYPE_UNSPECIFIED": 0,
+		"PATH":                     1,
+		"RESV":                     2,
+	}
+)
+
+func (x RsvpHeader_MessageType) Enum() *RsvpHeader_MessageType {
+	p := new(RsvpHeader_MessageType)
+	*p = x
+	return p
+}
+
+func (x RsvpHeader_MessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RsvpHeader_MessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[34].Descriptor()
+}
+
+func (RsvpHeader_MessageType) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[34]
+}
+
+func (x RsvpHeader_MessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RsvpHeader_MessageType.Descriptor instead.
+func (RsvpHeader_MessageType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type GtpuHeader_PduSessionContainer_PduType int32
+
+const (
+	GtpuHeader_PduSessionContainer_PDU_TYPE_UNSPECIFIED GtpuHeader_PduSessionContainer_PduType = 0
+	GtpuHeader_PduSessionContainer_DOWNLINK             GtpuHeader_PduSessionContainer_PduType = 1
+	GtpuHeader_PduSessionContainer_UPLINK               GtpuHeader_PduSessionContainer_PduType = 2
+)
+
+// Enum value maps for GtpuHeader_PduSessionContainer_PduType.
+var (
+	GtpuHeader_PduSessionContainer_PduType_name = map[int32]string{
+		0: "PDU_TYPE_UNSPECIFIED",
+		1: "DOWNLINK",
+		2: "UPLINK",
+	}
+	GtpuHeader_PduSessionContainer_PduType_value = map[string]int32{
+		"PDU_TYPE_UNSPECIFIED": 0,
+		"DOWNLINK":             1,
+		"UPLINK":               2,
+	}
+)
+
+func (x GtpuHeader_PduSessionContainer_PduType) Enum() *GtpuHeader_PduSessionContainer_PduType {
+	p := new(GtpuHeader_PduSessionContainer_PduType)
+	*p = x
+	return p
+}
+
+func (x GtpuHeader_PduSessionContainer_PduType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GtpuHeader_PduSessionContainer_PduType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ate_proto_enumTypes[35].Descriptor()
+}
+
+func (GtpuHeader_PduSessionContainer_PduType) Type() protoreflect.EnumType {
+	return &file_ate_proto_enumTypes[35]
+}
+
+func (x GtpuHeader_PduSessionContainer_PduType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GtpuHeader_PduSessionContainer_PduType.Descriptor instead.
+func (GtpuHeader_PduSessionContainer_PduType) EnumDescriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{59, 0, 0}
+}
+
+type Topology struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lags       []*Lag             `protobuf:"bytes,1,rep,name=lags,proto3" json:"lags,omitempty"`
+	Interfaces []*InterfaceConfig `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *Topology) Reset() {
+	*x = Topology{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_ate_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Topology) String() string {
+func (x *Topology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topology) ProtoMessage() {}
+
+func (x *Topology) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
+func (*Topology) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Topology) GetLags() []*Lag {
+	if x != nil {
+		return x.Lags
+	}
+	return nil
+}
+
+func (x *Topology) GetInterfaces() []*InterfaceConfig {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type Traffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flows []*Flow `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows,omitempty"`
+}
+
+func (x *Traffic) Reset() {
+	*x = Traffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Traffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Traffic) ProtoMessage() {}
+
+func (x *Traffic) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Traffic.ProtoReflect.Descriptor instead.
+func (*Traffic) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Traffic) GetFlows() []*Flow {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+type Lag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ports []string  `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	Lacp  *Lag_Lacp `protobuf:"bytes,3,opt,name=lacp,proto3" json:"lacp,omitempty"`
+}
+
+func (x *Lag) Reset() {
+	*x = Lag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lag) ProtoMessage() {}
+
+func (x *Lag) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lag.ProtoReflect.Descriptor instead.
+func (*Lag) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Lag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Lag) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Lag) GetLacp() *Lag_Lacp {
+	if x != nil {
+		return x.Lacp
+	}
+	return nil
+}
+
+type InterfaceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Link:
+	//	*InterfaceConfig_Port
+	//	*InterfaceConfig_Lag
+	Link             isInterfaceConfig_Link `protobuf_oneof:"link"`
+	Ethernet         *EthernetConfig        `protobuf:"bytes,4,opt,name=ethernet,proto3" json:"ethernet,omitempty"`
+	Ipv4             *IpConfig              `protobuf:"bytes,5,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6             *IpConfig              `protobuf:"bytes,6,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	Ipv4LoopbackCidr string                 `protobuf:"bytes,11,opt,name=ipv4_loopback_cidr,json=ipv4LoopbackCidr,proto3" json:"ipv4_loopback_cidr,omitempty"`
+	Ipv6LoopbackCidr string                 `protobuf:"bytes,13,opt,name=ipv6_loopback_cidr,json=ipv6LoopbackCidr,proto3" json:"ipv6_loopback_cidr,omitempty"`
+	Isis             *ISISConfig            `protobuf:"bytes,7,opt,name=isis,proto3" json:"isis,omitempty"`
+	Bgp              *BgpConfig             `protobuf:"bytes,8,opt,name=bgp,proto3" json:"bgp,omitempty"`
+	Rsvp             []*RsvpConfig          `protobuf:"bytes,12,rep,name=rsvp,proto3" json:"rsvp,omitempty"`
+	Networks         []*Network             `protobuf:"bytes,9,rep,name=networks,proto3" json:"networks,omitempty"`
+	EnableLacp       bool                   `protobuf:"varint,10,opt,name=enable_lacp,json=enableLacp,proto3" json:"enable_lacp,omitempty"`
+	Dhcp             *DhcpConfig            `protobuf:"bytes,14,opt,name=dhcp,proto3" json:"dhcp,omitempty"`
+	Bfd              *BfdConfig             `protobuf:"bytes,15,opt,name=bfd,proto3" json:"bfd,omitempty"`
+	IgmpHost         *IgmpHostConfig        `protobuf:"bytes,16,opt,name=igmp_host,json=igmpHost,proto3" json:"igmp_host,omitempty"`
+	MldHost          *MldHostConfig         `protobuf:"bytes,17,opt,name=mld_host,json=mldHost,proto3" json:"mld_host,omitempty"`
+	Pim              *PimConfig             `protobuf:"bytes,18,opt,name=pim,proto3" json:"pim,omitempty"`
+	Ldp              *LdpConfig             `protobuf:"bytes,19,opt,name=ldp,proto3" json:"ldp,omitempty"`
+	Dot1X            *Dot1XConfig           `protobuf:"bytes,20,opt,name=dot1x,proto3" json:"dot1x,omitempty"`
+	Ptp              *PtpConfig             `protobuf:"bytes,21,opt,name=ptp,proto3" json:"ptp,omitempty"` // NEXT ID: 22
+}
+
+func (x *InterfaceConfig) Reset() {
+	*x = InterfaceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceConfig) ProtoMessage() {}
+
+func (x *InterfaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceConfig.ProtoReflect.Descriptor instead.
+func (*InterfaceConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InterfaceConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *InterfaceConfig) GetLink() isInterfaceConfig_Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetPort() string {
+	if x, ok := x.GetLink().(*InterfaceConfig_Port); ok {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *InterfaceConfig) GetLag() string {
+	if x, ok := x.GetLink().(*InterfaceConfig_Lag); ok {
+		return x.Lag
+	}
+	return ""
+}
+
+func (x *InterfaceConfig) GetEthernet() *EthernetConfig {
+	if x != nil {
+		return x.Ethernet
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetIpv4() *IpConfig {
+	if x != nil {
+		return x.Ipv4
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetIpv6() *IpConfig {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetIpv4LoopbackCidr() string {
+	if x != nil {
+		return x.Ipv4LoopbackCidr
+	}
+	return ""
+}
+
+func (x *InterfaceConfig) GetIpv6LoopbackCidr() string {
+	if x != nil {
+		return x.Ipv6LoopbackCidr
+	}
+	return ""
+}
+
+func (x *InterfaceConfig) GetIsis() *ISISConfig {
+	if x != nil {
+		return x.Isis
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetBgp() *BgpConfig {
+	if x != nil {
+		return x.Bgp
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetRsvp() []*RsvpConfig {
+	if x != nil {
+		return x.Rsvp
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetEnableLacp() bool {
+	if x != nil {
+		return x.EnableLacp
+	}
+	return false
+}
+
+func (x *InterfaceConfig) GetDhcp() *DhcpConfig {
+	if x != nil {
+		return x.Dhcp
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetBfd() *BfdConfig {
+	if x != nil {
+		return x.Bfd
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetIgmpHost() *IgmpHostConfig {
+	if x != nil {
+		return x.IgmpHost
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetMldHost() *MldHostConfig {
+	if x != nil {
+		return x.MldHost
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetPim() *PimConfig {
+	if x != nil {
+		return x.Pim
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetLdp() *LdpConfig {
+	if x != nil {
+		return x.Ldp
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetDot1X() *Dot1XConfig {
+	if x != nil {
+		return x.Dot1X
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetPtp() *PtpConfig {
+	if x != nil {
+		return x.Ptp
+	}
+	return nil
+}
+
+type isInterfaceConfig_Link interface {
+	isInterfaceConfig_Link()
+}
+
+type InterfaceConfig_Port struct {
+	Port string `protobuf:"bytes,2,opt,name=port,proto3,oneof"`
+}
+
+type InterfaceConfig_Lag struct {
+	Lag string `protobuf:"bytes,3,opt,name=lag,proto3,oneof"`
+}
+
+func (*InterfaceConfig_Port) isInterfaceConfig_Link() {}
+
+func (*InterfaceConfig_Lag) isInterfaceConfig_Link() {}
+
+type DhcpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A client of a given IP version cannot be configured on an interface with a
+	// static address of that version, whereas a server requires one.
+	V4Client *DhcpConfig_Client `protobuf:"bytes,1,opt,name=v4_client,json=v4Client,proto3" json:"v4_client,omitempty"`
+	V6Client *DhcpConfig_Client `protobuf:"bytes,2,opt,name=v6_client,json=v6Client,proto3" json:"v6_client,omitempty"`
+	V4Server *DhcpConfig_Server `protobuf:"bytes,3,opt,name=v4_server,json=v4Server,proto3" json:"v4_server,omitempty"`
+	V6Server *DhcpConfig_Server `protobuf:"bytes,4,opt,name=v6_server,json=v6Server,proto3" json:"v6_server,omitempty"`
+}
+
+func (x *DhcpConfig) Reset() {
+	*x = DhcpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DhcpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DhcpConfig) ProtoMessage() {}
+
+func (x *DhcpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DhcpConfig.ProtoReflect.Descriptor instead.
+func (*DhcpConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DhcpConfig) GetV4Client() *DhcpConfig_Client {
+	if x != nil {
+		return x.V4Client
+	}
+	return nil
+}
+
+func (x *DhcpConfig) GetV6Client() *DhcpConfig_Client {
+	if x != nil {
+		return x.V6Client
+	}
+	return nil
+}
+
+func (x *DhcpConfig) GetV4Server() *DhcpConfig_Server {
+	if x != nil {
+		return x.V4Server
+	}
+	return nil
+}
+
+func (x *DhcpConfig) GetV6Server() *DhcpConfig_Server {
+	if x != nil {
+		return x.V6Server
+	}
+	return nil
+}
+
+type BfdConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum interval between transmitted BFD control packets.
+	TxIntervalMs uint32 `protobuf:"varint,1,opt,name=tx_interval_ms,json=txIntervalMs,proto3" json:"tx_interval_ms,omitempty"`
+	// The minimum interval between received BFD control packets.
+	RxIntervalMs uint32 `protobuf:"varint,2,opt,name=rx_interval_ms,json=rxIntervalMs,proto3" json:"rx_interval_ms,omitempty"`
+	// The number of missed packets after which a session is considered down.
+	Multiplier uint32 `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Whether the sessions are administratively down.
+	AdminDown bool `protobuf:"varint,4,opt,name=admin_down,json=adminDown,proto3" json:"admin_down,omitempty"`
+	// Whether the sessions are multi-hop rather than single-hop.
+	MultiHop bool `protobuf:"varint,5,opt,name=multi_hop,json=multiHop,proto3" json:"multi_hop,omitempty"`
+	// The remote addresses of the sessions. If unset, single-hop sessions are
+	// established with the default gateway of the interface.
+	Ipv4RemoteAddress string `protobuf:"bytes,6,opt,name=ipv4_remote_address,json=ipv4RemoteAddress,proto3" json:"ipv4_remote_address,omitempty"`
+	Ipv6RemoteAddress string `protobuf:"bytes,7,opt,name=ipv6_remote_address,json=ipv6RemoteAddress,proto3" json:"ipv6_remote_address,omitempty"`
+}
+
+func (x *BfdConfig) Reset() {
+	*x = BfdConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topology) ProtoMessage() {}
+func (*BfdConfig) ProtoMessage() {}
 
-func (x *Topology) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[0]
+func (x *BfdConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,50 +2454,143 @@ func (x *Topology) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
-func (*Topology) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BfdConfig.ProtoReflect.Descriptor instead.
+func (*BfdConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Topology) GetLags() []*Lag {
+func (x *BfdConfig) GetTxIntervalMs() uint32 {
 	if x != nil {
-		return x.Lags
+		return x.TxIntervalMs
 	}
-	return nil
+	return 0
 }
 
-func (x *Topology) GetInterfaces() []*InterfaceConfig {
+func (x *BfdConfig) GetRxIntervalMs() uint32 {
 	if x != nil {
-		return x.Interfaces
+		return x.RxIntervalMs
+	}
+	return 0
+}
+
+func (x *BfdConfig) GetMultiplier() uint32 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *BfdConfig) GetAdminDown() bool {
+	if x != nil {
+		return x.AdminDown
+	}
+	return false
+}
+
+func (x *BfdConfig) GetMultiHop() bool {
+	if x != nil {
+		return x.MultiHop
+	}
+	return false
+}
+
+func (x *BfdConfig) GetIpv4RemoteAddress() string {
+	if x != nil {
+		return x.Ipv4RemoteAddress
+	}
+	return ""
+}
+
+func (x *BfdConfig) GetIpv6RemoteAddress() string {
+	if x != nil {
+		return x.Ipv6RemoteAddress
+	}
+	return ""
+}
+
+// An IGMP host, which requires a static IPv4 address on the interface.
+type IgmpHostConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version IgmpHostConfig_Version `protobuf:"varint,1,opt,name=version,proto3,enum=ondatra.IgmpHostConfig_Version" json:"version,omitempty"`
+	Groups  []*MulticastGroup      `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *IgmpHostConfig) Reset() {
+	*x = IgmpHostConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IgmpHostConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgmpHostConfig) ProtoMessage() {}
+
+func (x *IgmpHostConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgmpHostConfig.ProtoReflect.Descriptor instead.
+func (*IgmpHostConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IgmpHostConfig) GetVersion() IgmpHostConfig_Version {
+	if x != nil {
+		return x.Version
+	}
+	return IgmpHostConfig_VERSION_UNSPECIFIED
+}
+
+func (x *IgmpHostConfig) GetGroups() []*MulticastGroup {
+	if x != nil {
+		return x.Groups
 	}
 	return nil
 }
 
-type Traffic struct {
+// An MLD host, which requires a static IPv6 address on the interface.
+type MldHostConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flows []*Flow `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows,omitempty"`
+	Version MldHostConfig_Version `protobuf:"varint,1,opt,name=version,proto3,enum=ondatra.MldHostConfig_Version" json:"version,omitempty"`
+	Groups  []*MulticastGroup     `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *Traffic) Reset() {
-	*x = Traffic{}
+func (x *MldHostConfig) Reset() {
+	*x = MldHostConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[1]
+		mi := &file_ate_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Traffic) String() string {
+func (x *MldHostConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Traffic) ProtoMessage() {}
+func (*MldHostConfig) ProtoMessage() {}
 
-func (x *Traffic) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[1]
+func (x *MldHostConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,45 +2601,223 @@ func (x *Traffic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Traffic.ProtoReflect.Descriptor instead.
-func (*Traffic) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MldHostConfig.ProtoReflect.Descriptor instead.
+func (*MldHostConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Traffic) GetFlows() []*Flow {
+func (x *MldHostConfig) GetVersion() MldHostConfig_Version {
 	if x != nil {
-		return x.Flows
+		return x.Version
+	}
+	return MldHostConfig_VERSION_UNSPECIFIED
+}
+
+func (x *MldHostConfig) GetGroups() []*MulticastGroup {
+	if x != nil {
+		return x.Groups
 	}
 	return nil
 }
 
-type Lag struct {
+// A range of multicast groups joined by an IGMP or MLD host.
+type MulticastGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ports []string  `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
-	Lacp  *Lag_Lacp `protobuf:"bytes,3,opt,name=lacp,proto3" json:"lacp,omitempty"`
+	// The first address of the range.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The number of groups in the range; one if unset.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The source to include for source-specific multicast, which is only
+	// supported by IGMPv3 and MLDv2. Any source is accepted if unset.
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// Whether the host has left the groups.
+	Left bool `protobuf:"varint,4,opt,name=left,proto3" json:"left,omitempty"`
+}
+
+func (x *MulticastGroup) Reset() {
+	*x = MulticastGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastGroup) ProtoMessage() {}
+
+func (x *MulticastGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastGroup.ProtoReflect.Descriptor instead.
+func (*MulticastGroup) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MulticastGroup) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MulticastGroup) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MulticastGroup) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *MulticastGroup) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+// PIM-SM, which runs over each static IP address version on the interface.
+type PimConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The interval between hello messages.
+	HelloIntervalSec uint32 `protobuf:"varint,1,opt,name=hello_interval_sec,json=helloIntervalSec,proto3" json:"hello_interval_sec,omitempty"`
+	// The hold time advertised in hello messages.
+	HelloHoldTimeSec uint32 `protobuf:"varint,2,opt,name=hello_hold_time_sec,json=helloHoldTimeSec,proto3" json:"hello_hold_time_sec,omitempty"`
+	// Whether PIM registers with BFD configured on the interface.
+	EnableBfd    bool                     `protobuf:"varint,3,opt,name=enable_bfd,json=enableBfd,proto3" json:"enable_bfd,omitempty"`
+	JoinPrunes   []*PimConfig_JoinPrune   `protobuf:"bytes,4,rep,name=join_prunes,json=joinPrunes,proto3" json:"join_prunes,omitempty"`
+	CandidateRps []*PimConfig_CandidateRp `protobuf:"bytes,5,rep,name=candidate_rps,json=candidateRps,proto3" json:"candidate_rps,omitempty"`
+}
+
+func (x *PimConfig) Reset() {
+	*x = PimConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PimConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PimConfig) ProtoMessage() {}
+
+func (x *PimConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PimConfig.ProtoReflect.Descriptor instead.
+func (*PimConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PimConfig) GetHelloIntervalSec() uint32 {
+	if x != nil {
+		return x.HelloIntervalSec
+	}
+	return 0
+}
+
+func (x *PimConfig) GetHelloHoldTimeSec() uint32 {
+	if x != nil {
+		return x.HelloHoldTimeSec
+	}
+	return 0
+}
+
+func (x *PimConfig) GetEnableBfd() bool {
+	if x != nil {
+		return x.EnableBfd
+	}
+	return false
+}
+
+func (x *PimConfig) GetJoinPrunes() []*PimConfig_JoinPrune {
+	if x != nil {
+		return x.JoinPrunes
+	}
+	return nil
+}
+
+func (x *PimConfig) GetCandidateRps() []*PimConfig_CandidateRp {
+	if x != nil {
+		return x.CandidateRps
+	}
+	return nil
 }
 
-func (x *Lag) Reset() {
-	*x = Lag{}
+// LDP, which runs over the static IPv4 address on the interface.
+type LdpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The LSR ID of the router, which is also used as its transport address.
+	LsrId        string `protobuf:"bytes,1,opt,name=lsr_id,json=lsrId,proto3" json:"lsr_id,omitempty"`
+	LabelSpaceId uint32 `protobuf:"varint,2,opt,name=label_space_id,json=labelSpaceId,proto3" json:"label_space_id,omitempty"`
+	// The interval between link hello messages.
+	HelloIntervalSec uint32 `protobuf:"varint,3,opt,name=hello_interval_sec,json=helloIntervalSec,proto3" json:"hello_interval_sec,omitempty"`
+	// The hold time advertised in link hello messages.
+	HelloHoldTimeSec uint32 `protobuf:"varint,4,opt,name=hello_hold_time_sec,json=helloHoldTimeSec,proto3" json:"hello_hold_time_sec,omitempty"`
+	// The interval between keepalive messages.
+	KeepaliveIntervalSec uint32 `protobuf:"varint,5,opt,name=keepalive_interval_sec,json=keepaliveIntervalSec,proto3" json:"keepalive_interval_sec,omitempty"`
+	// The keepalive hold time of sessions.
+	KeepaliveHoldTimeSec uint32 `protobuf:"varint,6,opt,name=keepalive_hold_time_sec,json=keepaliveHoldTimeSec,proto3" json:"keepalive_hold_time_sec,omitempty"`
+	// Whether LDP registers with BFD configured on the interface.
+	EnableBfd bool `protobuf:"varint,7,opt,name=enable_bfd,json=enableBfd,proto3" json:"enable_bfd,omitempty"`
+}
+
+func (x *LdpConfig) Reset() {
+	*x = LdpConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[2]
+		mi := &file_ate_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Lag) String() string {
+func (x *LdpConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lag) ProtoMessage() {}
+func (*LdpConfig) ProtoMessage() {}
 
-func (x *Lag) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[2]
+func (x *LdpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,71 +2828,97 @@ func (x *Lag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lag.ProtoReflect.Descriptor instead.
-func (*Lag) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LdpConfig.ProtoReflect.Descriptor instead.
+func (*LdpConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Lag) GetName() string {
+func (x *LdpConfig) GetLsrId() string {
 	if x != nil {
-		return x.Name
+		return x.LsrId
 	}
 	return ""
 }
 
-func (x *Lag) GetPorts() []string {
+func (x *LdpConfig) GetLabelSpaceId() uint32 {
 	if x != nil {
-		return x.Ports
+		return x.LabelSpaceId
 	}
-	return nil
+	return 0
 }
 
-func (x *Lag) GetLacp() *Lag_Lacp {
+func (x *LdpConfig) GetHelloIntervalSec() uint32 {
 	if x != nil {
-		return x.Lacp
+		return x.HelloIntervalSec
 	}
-	return nil
+	return 0
 }
 
-type InterfaceConfig struct {
+func (x *LdpConfig) GetHelloHoldTimeSec() uint32 {
+	if x != nil {
+		return x.HelloHoldTimeSec
+	}
+	return 0
+}
+
+func (x *LdpConfig) GetKeepaliveIntervalSec() uint32 {
+	if x != nil {
+		return x.KeepaliveIntervalSec
+	}
+	return 0
+}
+
+func (x *LdpConfig) GetKeepaliveHoldTimeSec() uint32 {
+	if x != nil {
+		return x.KeepaliveHoldTimeSec
+	}
+	return 0
+}
+
+func (x *LdpConfig) GetEnableBfd() bool {
+	if x != nil {
+		return x.EnableBfd
+	}
+	return false
+}
+
+// A PTP (IEEE 1588) clock, over Ethernet or over UDP on a static address of the
+// interface.
+type PtpConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Link:
-	//	*InterfaceConfig_Port
-	//	*InterfaceConfig_Lag
-	Link             isInterfaceConfig_Link `protobuf_oneof:"link"`
-	Ethernet         *EthernetConfig        `protobuf:"bytes,4,opt,name=ethernet,proto3" json:"ethernet,omitempty"`
-	Ipv4             *IpConfig              `protobuf:"bytes,5,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
-	Ipv6             *IpConfig              `protobuf:"bytes,6,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
-	Ipv4LoopbackCidr string                 `protobuf:"bytes,11,opt,name=ipv4_loopback_cidr,json=ipv4LoopbackCidr,proto3" json:"ipv4_loopback_cidr,omitempty"`
-	Ipv6LoopbackCidr string                 `protobuf:"bytes,13,opt,name=ipv6_loopback_cidr,json=ipv6LoopbackCidr,proto3" json:"ipv6_loopback_cidr,omitempty"`
-	Isis             *ISISConfig            `protobuf:"bytes,7,opt,name=isis,proto3" json:"isis,omitempty"`
-	Bgp              *BgpConfig             `protobuf:"bytes,8,opt,name=bgp,proto3" json:"bgp,omitempty"`
-	Rsvp             []*RsvpConfig          `protobuf:"bytes,12,rep,name=rsvp,proto3" json:"rsvp,omitempty"`
-	Networks         []*Network             `protobuf:"bytes,9,rep,name=networks,proto3" json:"networks,omitempty"`
-	EnableLacp       bool                   `protobuf:"varint,10,opt,name=enable_lacp,json=enableLacp,proto3" json:"enable_lacp,omitempty"` // NEXT ID: 14
-}
-
-func (x *InterfaceConfig) Reset() {
-	*x = InterfaceConfig{}
+	Role           PtpConfig_Role           `protobuf:"varint,1,opt,name=role,proto3,enum=ondatra.PtpConfig_Role" json:"role,omitempty"`
+	Transport      PtpConfig_Transport      `protobuf:"varint,2,opt,name=transport,proto3,enum=ondatra.PtpConfig_Transport" json:"transport,omitempty"`
+	DelayMechanism PtpConfig_DelayMechanism `protobuf:"varint,3,opt,name=delay_mechanism,json=delayMechanism,proto3,enum=ondatra.PtpConfig_DelayMechanism" json:"delay_mechanism,omitempty"`
+	Domain         uint32                   `protobuf:"varint,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// The priorities of the clock in the best master clock algorithm.
+	Priority1 uint32 `protobuf:"varint,5,opt,name=priority1,proto3" json:"priority1,omitempty"`
+	Priority2 uint32 `protobuf:"varint,6,opt,name=priority2,proto3" json:"priority2,omitempty"`
+	// The base 2 logarithms of the message intervals in seconds.
+	LogSyncInterval     int32 `protobuf:"varint,7,opt,name=log_sync_interval,json=logSyncInterval,proto3" json:"log_sync_interval,omitempty"`
+	LogAnnounceInterval int32 `protobuf:"varint,8,opt,name=log_announce_interval,json=logAnnounceInterval,proto3" json:"log_announce_interval,omitempty"`
+	LogDelayReqInterval int32 `protobuf:"varint,9,opt,name=log_delay_req_interval,json=logDelayReqInterval,proto3" json:"log_delay_req_interval,omitempty"`
+}
+
+func (x *PtpConfig) Reset() {
+	*x = PtpConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[3]
+		mi := &file_ate_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InterfaceConfig) String() string {
+func (x *PtpConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceConfig) ProtoMessage() {}
+func (*PtpConfig) ProtoMessage() {}
 
-func (x *InterfaceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[3]
+func (x *PtpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,125 +2929,149 @@ func (x *InterfaceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceConfig.ProtoReflect.Descriptor instead.
-func (*InterfaceConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PtpConfig.ProtoReflect.Descriptor instead.
+func (*PtpConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *InterfaceConfig) GetName() string {
+func (x *PtpConfig) GetRole() PtpConfig_Role {
 	if x != nil {
-		return x.Name
+		return x.Role
 	}
-	return ""
+	return PtpConfig_ROLE_UNSPECIFIED
 }
 
-func (m *InterfaceConfig) GetLink() isInterfaceConfig_Link {
-	if m != nil {
-		return m.Link
+func (x *PtpConfig) GetTransport() PtpConfig_Transport {
+	if x != nil {
+		return x.Transport
 	}
-	return nil
+	return PtpConfig_ETHERNET
 }
 
-func (x *InterfaceConfig) GetPort() string {
-	if x, ok := x.GetLink().(*InterfaceConfig_Port); ok {
-		return x.Port
+func (x *PtpConfig) GetDelayMechanism() PtpConfig_DelayMechanism {
+	if x != nil {
+		return x.DelayMechanism
 	}
-	return ""
+	return PtpConfig_END_TO_END
 }
 
-func (x *InterfaceConfig) GetLag() string {
-	if x, ok := x.GetLink().(*InterfaceConfig_Lag); ok {
-		return x.Lag
+func (x *PtpConfig) GetDomain() uint32 {
+	if x != nil {
+		return x.Domain
 	}
-	return ""
+	return 0
 }
 
-func (x *InterfaceConfig) GetEthernet() *EthernetConfig {
+func (x *PtpConfig) GetPriority1() uint32 {
 	if x != nil {
-		return x.Ethernet
+		return x.Priority1
 	}
-	return nil
+	return 0
 }
 
-func (x *InterfaceConfig) GetIpv4() *IpConfig {
+func (x *PtpConfig) GetPriority2() uint32 {
 	if x != nil {
-		return x.Ipv4
+		return x.Priority2
 	}
-	return nil
+	return 0
 }
 
-func (x *InterfaceConfig) GetIpv6() *IpConfig {
+func (x *PtpConfig) GetLogSyncInterval() int32 {
 	if x != nil {
-		return x.Ipv6
+		return x.LogSyncInterval
 	}
-	return nil
+	return 0
 }
 
-func (x *InterfaceConfig) GetIpv4LoopbackCidr() string {
+func (x *PtpConfig) GetLogAnnounceInterval() int32 {
 	if x != nil {
-		return x.Ipv4LoopbackCidr
+		return x.LogAnnounceInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *InterfaceConfig) GetIpv6LoopbackCidr() string {
+func (x *PtpConfig) GetLogDelayReqInterval() int32 {
 	if x != nil {
-		return x.Ipv6LoopbackCidr
+		return x.LogDelayReqInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *InterfaceConfig) GetIsis() *ISISConfig {
-	if x != nil {
-		return x.Isis
-	}
-	return nil
+// An 802.1X supplicant, which authenticates the interface's port to the
+// authenticator on the DUT.
+type Dot1XConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EapMethod Dot1XConfig_EapMethod `protobuf:"varint,1,opt,name=eap_method,json=eapMethod,proto3,enum=ondatra.Dot1XConfig_EapMethod" json:"eap_method,omitempty"`
+	Username  string                `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string                `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Whether to wait for the authenticator to request the identity, instead of
+	// starting the authentication with an EAPOL-Start message.
+	WaitForIdentityRequest bool `protobuf:"varint,4,opt,name=wait_for_identity_request,json=waitForIdentityRequest,proto3" json:"wait_for_identity_request,omitempty"`
 }
 
-func (x *InterfaceConfig) GetBgp() *BgpConfig {
-	if x != nil {
-		return x.Bgp
+func (x *Dot1XConfig) Reset() {
+	*x = Dot1XConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InterfaceConfig) GetRsvp() []*RsvpConfig {
-	if x != nil {
-		return x.Rsvp
-	}
-	return nil
+func (x *Dot1XConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InterfaceConfig) GetNetworks() []*Network {
-	if x != nil {
-		return x.Networks
+func (*Dot1XConfig) ProtoMessage() {}
+
+func (x *Dot1XConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InterfaceConfig) GetEnableLacp() bool {
+// Deprecated: Use Dot1XConfig.ProtoReflect.Descriptor instead.
+func (*Dot1XConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Dot1XConfig) GetEapMethod() Dot1XConfig_EapMethod {
 	if x != nil {
-		return x.EnableLacp
+		return x.EapMethod
 	}
-	return false
+	return Dot1XConfig_EAP_METHOD_UNSPECIFIED
 }
 
-type isInterfaceConfig_Link interface {
-	isInterfaceConfig_Link()
+func (x *Dot1XConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-type InterfaceConfig_Port struct {
-	Port string `protobuf:"bytes,2,opt,name=port,proto3,oneof"`
+func (x *Dot1XConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-type InterfaceConfig_Lag struct {
-	Lag string `protobuf:"bytes,3,opt,name=lag,proto3,oneof"`
+func (x *Dot1XConfig) GetWaitForIdentityRequest() bool {
+	if x != nil {
+		return x.WaitForIdentityRequest
+	}
+	return false
 }
 
-func (*InterfaceConfig_Port) isInterfaceConfig_Link() {}
-
-func (*InterfaceConfig_Lag) isInterfaceConfig_Link() {}
-
 type EthernetConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1462,7 +3087,7 @@ type EthernetConfig struct {
 func (x *EthernetConfig) Reset() {
 	*x = EthernetConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[4]
+		mi := &file_ate_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1475,7 +3100,7 @@ func (x *EthernetConfig) String() string {
 func (*EthernetConfig) ProtoMessage() {}
 
 func (x *EthernetConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[4]
+	mi := &file_ate_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,7 +3113,7 @@ func (x *EthernetConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetConfig.ProtoReflect.Descriptor instead.
 func (*EthernetConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{4}
+	return file_ate_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EthernetConfig) GetMtu() uint32 {
@@ -1530,7 +3155,7 @@ type Fec struct {
 func (x *Fec) Reset() {
 	*x = Fec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[5]
+		mi := &file_ate_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1543,7 +3168,7 @@ func (x *Fec) String() string {
 func (*Fec) ProtoMessage() {}
 
 func (x *Fec) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[5]
+	mi := &file_ate_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +3181,7 @@ func (x *Fec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fec.ProtoReflect.Descriptor instead.
 func (*Fec) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{5}
+	return file_ate_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Fec) GetEnabled() bool {
@@ -1580,7 +3205,7 @@ type MacSec struct {
 func (x *MacSec) Reset() {
 	*x = MacSec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[6]
+		mi := &file_ate_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1593,7 +3218,7 @@ func (x *MacSec) String() string {
 func (*MacSec) ProtoMessage() {}
 
 func (x *MacSec) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[6]
+	mi := &file_ate_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,7 +3231,7 @@ func (x *MacSec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacSec.ProtoReflect.Descriptor instead.
 func (*MacSec) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6}
+	return file_ate_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MacSec) GetCipherSuite() MacSec_CipherSuite {
@@ -1649,7 +3274,7 @@ type RxSakPool struct {
 func (x *RxSakPool) Reset() {
 	*x = RxSakPool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[7]
+		mi := &file_ate_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1662,7 +3287,7 @@ func (x *RxSakPool) String() string {
 func (*RxSakPool) ProtoMessage() {}
 
 func (x *RxSakPool) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[7]
+	mi := &file_ate_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +3300,7 @@ func (x *RxSakPool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxSakPool.ProtoReflect.Descriptor instead.
 func (*RxSakPool) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{7}
+	return file_ate_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RxSakPool) GetSak128() string {
@@ -1699,25 +3324,101 @@ type IpConfig struct {
 
 	AddressCidr    string `protobuf:"bytes,1,opt,name=address_cidr,json=addressCidr,proto3" json:"address_cidr,omitempty"`
 	DefaultGateway string `protobuf:"bytes,2,opt,name=default_gateway,json=defaultGateway,proto3" json:"default_gateway,omitempty"`
+	// Only valid for IPv6.
+	NeighborDiscovery *NeighborDiscoveryConfig `protobuf:"bytes,3,opt,name=neighbor_discovery,json=neighborDiscovery,proto3" json:"neighbor_discovery,omitempty"`
+}
+
+func (x *IpConfig) Reset() {
+	*x = IpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpConfig) ProtoMessage() {}
+
+func (x *IpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpConfig.ProtoReflect.Descriptor instead.
+func (*IpConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IpConfig) GetAddressCidr() string {
+	if x != nil {
+		return x.AddressCidr
+	}
+	return ""
+}
+
+func (x *IpConfig) GetDefaultGateway() string {
+	if x != nil {
+		return x.DefaultGateway
+	}
+	return ""
+}
+
+func (x *IpConfig) GetNeighborDiscovery() *NeighborDiscoveryConfig {
+	if x != nil {
+		return x.NeighborDiscovery
+	}
+	return nil
 }
 
-func (x *IpConfig) Reset() {
-	*x = IpConfig{}
+// IPv6 neighbor discovery configuration of an emulated interface.
+type NeighborDiscoveryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the interface sends router advertisements, and whether they
+	// include the prefix of the interface address.
+	SendRouterAdvertisements bool `protobuf:"varint,1,opt,name=send_router_advertisements,json=sendRouterAdvertisements,proto3" json:"send_router_advertisements,omitempty"`
+	IncludeRaPrefix          bool `protobuf:"varint,2,opt,name=include_ra_prefix,json=includeRaPrefix,proto3" json:"include_ra_prefix,omitempty"`
+	// The number of neighbor solicitations sent to resolve an address before
+	// giving up, and the interval in milliseconds between them. These apply to
+	// all the interfaces on the port, so interfaces on the same port or LAG must
+	// agree. The ATE defaults to 8 solicitations 7000 milliseconds apart.
+	NsCount        uint32 `protobuf:"varint,3,opt,name=ns_count,json=nsCount,proto3" json:"ns_count,omitempty"`
+	NsRetransmitMs uint32 `protobuf:"varint,4,opt,name=ns_retransmit_ms,json=nsRetransmitMs,proto3" json:"ns_retransmit_ms,omitempty"`
+	// Disables resolution of the default gateway, such as to test that the DUT
+	// resolves the interface address on its own.
+	DisableGatewayResolution bool `protobuf:"varint,5,opt,name=disable_gateway_resolution,json=disableGatewayResolution,proto3" json:"disable_gateway_resolution,omitempty"`
+}
+
+func (x *NeighborDiscoveryConfig) Reset() {
+	*x = NeighborDiscoveryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[8]
+		mi := &file_ate_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IpConfig) String() string {
+func (x *NeighborDiscoveryConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpConfig) ProtoMessage() {}
+func (*NeighborDiscoveryConfig) ProtoMessage() {}
 
-func (x *IpConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[8]
+func (x *NeighborDiscoveryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,23 +3429,44 @@ func (x *IpConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpConfig.ProtoReflect.Descriptor instead.
-func (*IpConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use NeighborDiscoveryConfig.ProtoReflect.Descriptor instead.
+func (*NeighborDiscoveryConfig) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *IpConfig) GetAddressCidr() string {
+func (x *NeighborDiscoveryConfig) GetSendRouterAdvertisements() bool {
 	if x != nil {
-		return x.AddressCidr
+		return x.SendRouterAdvertisements
 	}
-	return ""
+	return false
 }
 
-func (x *IpConfig) GetDefaultGateway() string {
+func (x *NeighborDiscoveryConfig) GetIncludeRaPrefix() bool {
 	if x != nil {
-		return x.DefaultGateway
+		return x.IncludeRaPrefix
 	}
-	return ""
+	return false
+}
+
+func (x *NeighborDiscoveryConfig) GetNsCount() uint32 {
+	if x != nil {
+		return x.NsCount
+	}
+	return 0
+}
+
+func (x *NeighborDiscoveryConfig) GetNsRetransmitMs() uint32 {
+	if x != nil {
+		return x.NsRetransmitMs
+	}
+	return 0
+}
+
+func (x *NeighborDiscoveryConfig) GetDisableGatewayResolution() bool {
+	if x != nil {
+		return x.DisableGatewayResolution
+	}
+	return false
 }
 
 // IS-IS configuration for the ATE.
@@ -1789,12 +3511,26 @@ type ISISConfig struct {
 	SegmentRouting *ISISSegmentRouting `protobuf:"bytes,17,opt,name=segment_routing,json=segmentRouting,proto3" json:"segment_routing,omitempty"`
 	// the capability router id.
 	CapabilityRouterId string `protobuf:"bytes,18,opt,name=capability_router_id,json=capabilityRouterId,proto3" json:"capability_router_id,omitempty"`
+	// whether IS-IS registers with BFD configured on the interface.
+	EnableBfd bool `protobuf:"varint,19,opt,name=enable_bfd,json=enableBfd,proto3" json:"enable_bfd,omitempty"`
+	// The authentication of level 1 LSPs and SNPs.
+	AreaAuthType ISISConfig_AuthType `protobuf:"varint,20,opt,name=area_auth_type,json=areaAuthType,proto3,enum=ondatra.ISISConfig_AuthType" json:"area_auth_type,omitempty"`
+	// The auth key to be used for area authentication.
+	AreaAuthKey string `protobuf:"bytes,21,opt,name=area_auth_key,json=areaAuthKey,proto3" json:"area_auth_key,omitempty"`
+	// The authentication of level 2 LSPs and SNPs.
+	DomainAuthType ISISConfig_AuthType `protobuf:"varint,22,opt,name=domain_auth_type,json=domainAuthType,proto3,enum=ondatra.ISISConfig_AuthType" json:"domain_auth_type,omitempty"`
+	// The auth key to be used for domain authentication.
+	DomainAuthKey string `protobuf:"bytes,23,opt,name=domain_auth_key,json=domainAuthKey,proto3" json:"domain_auth_key,omitempty"`
+	// whether the overload bit is set in the LSPs of the router.
+	Overloaded bool `protobuf:"varint,24,opt,name=overloaded,proto3" json:"overloaded,omitempty"`
+	// TE attributes of the IS-IS interface, advertised if TE is enabled.
+	TeAttributes *ISISTrafficEngineering `protobuf:"bytes,25,opt,name=te_attributes,json=teAttributes,proto3" json:"te_attributes,omitempty"`
 }
 
 func (x *ISISConfig) Reset() {
 	*x = ISISConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[9]
+		mi := &file_ate_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1807,7 +3543,7 @@ func (x *ISISConfig) String() string {
 func (*ISISConfig) ProtoMessage() {}
 
 func (x *ISISConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[9]
+	mi := &file_ate_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,7 +3556,7 @@ func (x *ISISConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISISConfig.ProtoReflect.Descriptor instead.
 func (*ISISConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{9}
+	return file_ate_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ISISConfig) GetLevel() ISISConfig_Level {
@@ -1949,6 +3685,150 @@ func (x *ISISConfig) GetCapabilityRouterId() string {
 	return ""
 }
 
+func (x *ISISConfig) GetEnableBfd() bool {
+	if x != nil {
+		return x.EnableBfd
+	}
+	return false
+}
+
+func (x *ISISConfig) GetAreaAuthType() ISISConfig_AuthType {
+	if x != nil {
+		return x.AreaAuthType
+	}
+	return ISISConfig_AUTH_TYPE_UNSPECIFIED
+}
+
+func (x *ISISConfig) GetAreaAuthKey() string {
+	if x != nil {
+		return x.AreaAuthKey
+	}
+	return ""
+}
+
+func (x *ISISConfig) GetDomainAuthType() ISISConfig_AuthType {
+	if x != nil {
+		return x.DomainAuthType
+	}
+	return ISISConfig_AUTH_TYPE_UNSPECIFIED
+}
+
+func (x *ISISConfig) GetDomainAuthKey() string {
+	if x != nil {
+		return x.DomainAuthKey
+	}
+	return ""
+}
+
+func (x *ISISConfig) GetOverloaded() bool {
+	if x != nil {
+		return x.Overloaded
+	}
+	return false
+}
+
+func (x *ISISConfig) GetTeAttributes() *ISISTrafficEngineering {
+	if x != nil {
+		return x.TeAttributes
+	}
+	return nil
+}
+
+// IS-IS traffic engineering attributes of a link.
+type ISISTrafficEngineering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The TE default metric of the link.
+	Metric uint32 `protobuf:"varint,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// The administrative group bit mask of the link.
+	AdminGroup uint32 `protobuf:"varint,2,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	// The maximum bandwidth of the link, in bytes per second.
+	MaxBandwidth float32 `protobuf:"fixed32,3,opt,name=max_bandwidth,json=maxBandwidth,proto3" json:"max_bandwidth,omitempty"`
+	// The maximum reservable bandwidth of the link, in bytes per second.
+	MaxReservableBandwidth float32 `protobuf:"fixed32,4,opt,name=max_reservable_bandwidth,json=maxReservableBandwidth,proto3" json:"max_reservable_bandwidth,omitempty"`
+	// The unreserved bandwidth of each priority, in bytes per second, starting
+	// with priority 0. At most 8 values may be specified.
+	UnreservedBandwidth []float32 `protobuf:"fixed32,5,rep,packed,name=unreserved_bandwidth,json=unreservedBandwidth,proto3" json:"unreserved_bandwidth,omitempty"`
+	// The shared risk link groups of the link.
+	Srlgs []uint32 `protobuf:"varint,6,rep,packed,name=srlgs,proto3" json:"srlgs,omitempty"`
+}
+
+func (x *ISISTrafficEngineering) Reset() {
+	*x = ISISTrafficEngineering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ISISTrafficEngineering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISISTrafficEngineering) ProtoMessage() {}
+
+func (x *ISISTrafficEngineering) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISISTrafficEngineering.ProtoReflect.Descriptor instead.
+func (*ISISTrafficEngineering) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ISISTrafficEngineering) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+func (x *ISISTrafficEngineering) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *ISISTrafficEngineering) GetMaxBandwidth() float32 {
+	if x != nil {
+		return x.MaxBandwidth
+	}
+	return 0
+}
+
+func (x *ISISTrafficEngineering) GetMaxReservableBandwidth() float32 {
+	if x != nil {
+		return x.MaxReservableBandwidth
+	}
+	return 0
+}
+
+func (x *ISISTrafficEngineering) GetUnreservedBandwidth() []float32 {
+	if x != nil {
+		return x.UnreservedBandwidth
+	}
+	return nil
+}
+
+func (x *ISISTrafficEngineering) GetSrlgs() []uint32 {
+	if x != nil {
+		return x.Srlgs
+	}
+	return nil
+}
+
 type ISISSegmentRouting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1972,7 +3852,7 @@ type ISISSegmentRouting struct {
 func (x *ISISSegmentRouting) Reset() {
 	*x = ISISSegmentRouting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[10]
+		mi := &file_ate_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1985,7 +3865,7 @@ func (x *ISISSegmentRouting) String() string {
 func (*ISISSegmentRouting) ProtoMessage() {}
 
 func (x *ISISSegmentRouting) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[10]
+	mi := &file_ate_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +3878,7 @@ func (x *ISISSegmentRouting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISISSegmentRouting.ProtoReflect.Descriptor instead.
 func (*ISISSegmentRouting) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{10}
+	return file_ate_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ISISSegmentRouting) GetEnable() bool {
@@ -2113,7 +3993,7 @@ type IPReachability struct {
 func (x *IPReachability) Reset() {
 	*x = IPReachability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[11]
+		mi := &file_ate_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2126,7 +4006,7 @@ func (x *IPReachability) String() string {
 func (*IPReachability) ProtoMessage() {}
 
 func (x *IPReachability) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[11]
+	mi := &file_ate_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +4019,7 @@ func (x *IPReachability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPReachability.ProtoReflect.Descriptor instead.
 func (*IPReachability) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{11}
+	return file_ate_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *IPReachability) GetMetric() uint32 {
@@ -2198,53 +4078,189 @@ func (x *IPReachability) GetFlagExplicitNull() bool {
 	return false
 }
 
-func (x *IPReachability) GetFlagValue() bool {
+func (x *IPReachability) GetFlagValue() bool {
+	if x != nil {
+		return x.FlagValue
+	}
+	return false
+}
+
+func (x *IPReachability) GetFlagLocal() bool {
+	if x != nil {
+		return x.FlagLocal
+	}
+	return false
+}
+
+func (x *IPReachability) GetEnableSidIndexLabel() bool {
+	if x != nil {
+		return x.EnableSidIndexLabel
+	}
+	return false
+}
+
+type ISReachability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*ISReachability_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ISReachability) Reset() {
+	*x = ISReachability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ISReachability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISReachability) ProtoMessage() {}
+
+func (x *ISReachability) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISReachability.ProtoReflect.Descriptor instead.
+func (*ISReachability) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ISReachability) GetNodes() []*ISReachability_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ISReachability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The layer 1 config of an ATE port. Unspecified settings are left unchanged.
+type PortL1Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpeedGbps       uint32                       `protobuf:"varint,1,opt,name=speed_gbps,json=speedGbps,proto3" json:"speed_gbps,omitempty"`
+	FecMode         PortL1Config_FecMode         `protobuf:"varint,2,opt,name=fec_mode,json=fecMode,proto3,enum=ondatra.PortL1Config_FecMode" json:"fec_mode,omitempty"`
+	AutoNegotiation PortL1Config_AutoNegotiation `protobuf:"varint,3,opt,name=auto_negotiation,json=autoNegotiation,proto3,enum=ondatra.PortL1Config_AutoNegotiation" json:"auto_negotiation,omitempty"`
+}
+
+func (x *PortL1Config) Reset() {
+	*x = PortL1Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortL1Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortL1Config) ProtoMessage() {}
+
+func (x *PortL1Config) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortL1Config.ProtoReflect.Descriptor instead.
+func (*PortL1Config) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PortL1Config) GetSpeedGbps() uint32 {
 	if x != nil {
-		return x.FlagValue
+		return x.SpeedGbps
 	}
-	return false
+	return 0
 }
 
-func (x *IPReachability) GetFlagLocal() bool {
+func (x *PortL1Config) GetFecMode() PortL1Config_FecMode {
 	if x != nil {
-		return x.FlagLocal
+		return x.FecMode
 	}
-	return false
+	return PortL1Config_FEC_MODE_UNSPECIFIED
 }
 
-func (x *IPReachability) GetEnableSidIndexLabel() bool {
+func (x *PortL1Config) GetAutoNegotiation() PortL1Config_AutoNegotiation {
 	if x != nil {
-		return x.EnableSidIndexLabel
+		return x.AutoNegotiation
 	}
-	return false
+	return PortL1Config_AUTO_NEGOTIATION_UNSPECIFIED
 }
 
-type ISReachability struct {
+// A network impairment applied to traffic by the impairment (network
+// emulation) capability of the ATE.
+type Impairment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []*ISReachability_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *ISReachability) Reset() {
-	*x = ISReachability{}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Ports on whose links traffic is impaired. If empty, traffic on all
+	// impairment links is impaired.
+	Ports []string `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Percentages of packets that are dropped, duplicated, and reordered.
+	DropPct      float32 `protobuf:"fixed32,3,opt,name=drop_pct,json=dropPct,proto3" json:"drop_pct,omitempty"`
+	DuplicatePct float32 `protobuf:"fixed32,4,opt,name=duplicate_pct,json=duplicatePct,proto3" json:"duplicate_pct,omitempty"`
+	ReorderPct   float32 `protobuf:"fixed32,5,opt,name=reorder_pct,json=reorderPct,proto3" json:"reorder_pct,omitempty"`
+	// Number of packets by which each reordered packet is delayed.
+	ReorderSkipCount uint32 `protobuf:"varint,6,opt,name=reorder_skip_count,json=reorderSkipCount,proto3" json:"reorder_skip_count,omitempty"`
+	// Fixed delay added to every packet, and the uniform spread of a random
+	// delay added on top of it.
+	DelayUs  uint32 `protobuf:"varint,7,opt,name=delay_us,json=delayUs,proto3" json:"delay_us,omitempty"`
+	JitterUs uint32 `protobuf:"varint,8,opt,name=jitter_us,json=jitterUs,proto3" json:"jitter_us,omitempty"`
+	// Flows whose packets are impaired, matched by the source and destination
+	// addresses of their first IP header. If empty, all packets are impaired.
+	Flows []*Flow `protobuf:"bytes,9,rep,name=flows,proto3" json:"flows,omitempty"`
+}
+
+func (x *Impairment) Reset() {
+	*x = Impairment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[12]
+		mi := &file_ate_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ISReachability) String() string {
+func (x *Impairment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ISReachability) ProtoMessage() {}
+func (*Impairment) ProtoMessage() {}
 
-func (x *ISReachability) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[12]
+func (x *Impairment) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,23 +4271,72 @@ func (x *ISReachability) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ISReachability.ProtoReflect.Descriptor instead.
-func (*ISReachability) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Impairment.ProtoReflect.Descriptor instead.
+func (*Impairment) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ISReachability) GetNodes() []*ISReachability_Node {
+func (x *Impairment) GetName() string {
 	if x != nil {
-		return x.Nodes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Impairment) GetPorts() []string {
+	if x != nil {
+		return x.Ports
 	}
 	return nil
 }
 
-func (x *ISReachability) GetName() string {
+func (x *Impairment) GetDropPct() float32 {
 	if x != nil {
-		return x.Name
+		return x.DropPct
 	}
-	return ""
+	return 0
+}
+
+func (x *Impairment) GetDuplicatePct() float32 {
+	if x != nil {
+		return x.DuplicatePct
+	}
+	return 0
+}
+
+func (x *Impairment) GetReorderPct() float32 {
+	if x != nil {
+		return x.ReorderPct
+	}
+	return 0
+}
+
+func (x *Impairment) GetReorderSkipCount() uint32 {
+	if x != nil {
+		return x.ReorderSkipCount
+	}
+	return 0
+}
+
+func (x *Impairment) GetDelayUs() uint32 {
+	if x != nil {
+		return x.DelayUs
+	}
+	return 0
+}
+
+func (x *Impairment) GetJitterUs() uint32 {
+	if x != nil {
+		return x.JitterUs
+	}
+	return 0
+}
+
+func (x *Impairment) GetFlows() []*Flow {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
 }
 
 type BgpCommunities struct {
@@ -2290,7 +4355,7 @@ type BgpCommunities struct {
 func (x *BgpCommunities) Reset() {
 	*x = BgpCommunities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[13]
+		mi := &file_ate_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2303,7 +4368,7 @@ func (x *BgpCommunities) String() string {
 func (*BgpCommunities) ProtoMessage() {}
 
 func (x *BgpCommunities) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[13]
+	mi := &file_ate_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,7 +4381,7 @@ func (x *BgpCommunities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpCommunities.ProtoReflect.Descriptor instead.
 func (*BgpCommunities) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{13}
+	return file_ate_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *BgpCommunities) GetNoExport() bool {
@@ -2373,7 +4438,7 @@ type BgpConfig struct {
 func (x *BgpConfig) Reset() {
 	*x = BgpConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[14]
+		mi := &file_ate_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2386,7 +4451,7 @@ func (x *BgpConfig) String() string {
 func (*BgpConfig) ProtoMessage() {}
 
 func (x *BgpConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[14]
+	mi := &file_ate_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2399,7 +4464,7 @@ func (x *BgpConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpConfig.ProtoReflect.Descriptor instead.
 func (*BgpConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{14}
+	return file_ate_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BgpConfig) GetBgpPeers() []*BgpPeer {
@@ -2425,13 +4490,16 @@ type BgpPeer struct {
 	KeepaliveTimeSec uint32                     `protobuf:"varint,6,opt,name=keepalive_time_sec,json=keepaliveTimeSec,proto3" json:"keepalive_time_sec,omitempty"`
 	Md5Key           string                     `protobuf:"bytes,7,opt,name=md5_key,json=md5Key,proto3" json:"md5_key,omitempty"`
 	Capabilities     *BgpPeer_Capabilities      `protobuf:"bytes,8,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	SrtePolicyGroups []*BgpPeer_SrtePolicyGroup `protobuf:"bytes,9,rep,name=srte_policy_groups,json=srtePolicyGroups,proto3" json:"srte_policy_groups,omitempty"` // NEXT ID: 11
+	SrtePolicyGroups []*BgpPeer_SrtePolicyGroup `protobuf:"bytes,9,rep,name=srte_policy_groups,json=srtePolicyGroups,proto3" json:"srte_policy_groups,omitempty"`
+	// Whether the peer registers with BFD configured on the interface.
+	EnableBfd   bool                `protobuf:"varint,11,opt,name=enable_bfd,json=enableBfd,proto3" json:"enable_bfd,omitempty"`
+	AddPathMode BgpPeer_AddPathMode `protobuf:"varint,12,opt,name=add_path_mode,json=addPathMode,proto3,enum=ondatra.BgpPeer_AddPathMode" json:"add_path_mode,omitempty"` // NEXT ID: 13
 }
 
 func (x *BgpPeer) Reset() {
 	*x = BgpPeer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[15]
+		mi := &file_ate_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2444,7 +4512,7 @@ func (x *BgpPeer) String() string {
 func (*BgpPeer) ProtoMessage() {}
 
 func (x *BgpPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[15]
+	mi := &file_ate_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2457,7 +4525,7 @@ func (x *BgpPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpPeer.ProtoReflect.Descriptor instead.
 func (*BgpPeer) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15}
+	return file_ate_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BgpPeer) GetActive() bool {
@@ -2530,6 +4598,20 @@ func (x *BgpPeer) GetSrtePolicyGroups() []*BgpPeer_SrtePolicyGroup {
 	return nil
 }
 
+func (x *BgpPeer) GetEnableBfd() bool {
+	if x != nil {
+		return x.EnableBfd
+	}
+	return false
+}
+
+func (x *BgpPeer) GetAddPathMode() BgpPeer_AddPathMode {
+	if x != nil {
+		return x.AddPathMode
+	}
+	return BgpPeer_ADD_PATH_MODE_UNSPECIFIED
+}
+
 // BGP attributes for advertised prefixes.
 type BgpAttributes struct {
 	state         protoimpl.MessageState
@@ -2545,13 +4627,19 @@ type BgpAttributes struct {
 	AsnSetMode          BgpAsnSetMode                      `protobuf:"varint,6,opt,name=asn_set_mode,json=asnSetMode,proto3,enum=ondatra.BgpAsnSetMode" json:"asn_set_mode,omitempty"`
 	AsPathSegments      []*BgpAttributes_AsPathSegment     `protobuf:"bytes,7,rep,name=as_path_segments,json=asPathSegments,proto3" json:"as_path_segments,omitempty"`
 	OriginatorId        *StringIncRange                    `protobuf:"bytes,9,opt,name=originator_id,json=originatorId,proto3" json:"originator_id,omitempty"`
-	ClusterIds          []string                           `protobuf:"bytes,10,rep,name=cluster_ids,json=clusterIds,proto3" json:"cluster_ids,omitempty"` // NEXT ID: 11
+	ClusterIds          []string                           `protobuf:"bytes,10,rep,name=cluster_ids,json=clusterIds,proto3" json:"cluster_ids,omitempty"`
+	AddPaths            *BgpAttributes_AddPaths            `protobuf:"bytes,11,opt,name=add_paths,json=addPaths,proto3" json:"add_paths,omitempty"`
+	Med                 *BgpAttributes_Med                 `protobuf:"bytes,12,opt,name=med,proto3" json:"med,omitempty"`
+	Aigp                *BgpAttributes_Aigp                `protobuf:"bytes,13,opt,name=aigp,proto3" json:"aigp,omitempty"`
+	// Large communities in the canonical "global:local1:local2" format of
+	// RFC 8092.
+	LargeCommunities []string `protobuf:"bytes,14,rep,name=large_communities,json=largeCommunities,proto3" json:"large_communities,omitempty"` // NEXT ID: 15
 }
 
 func (x *BgpAttributes) Reset() {
 	*x = BgpAttributes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[16]
+		mi := &file_ate_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2564,7 +4652,7 @@ func (x *BgpAttributes) String() string {
 func (*BgpAttributes) ProtoMessage() {}
 
 func (x *BgpAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[16]
+	mi := &file_ate_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,7 +4665,7 @@ func (x *BgpAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpAttributes.ProtoReflect.Descriptor instead.
 func (*BgpAttributes) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16}
+	return file_ate_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BgpAttributes) GetActive() bool {
@@ -2650,6 +4738,34 @@ func (x *BgpAttributes) GetClusterIds() []string {
 	return nil
 }
 
+func (x *BgpAttributes) GetAddPaths() *BgpAttributes_AddPaths {
+	if x != nil {
+		return x.AddPaths
+	}
+	return nil
+}
+
+func (x *BgpAttributes) GetMed() *BgpAttributes_Med {
+	if x != nil {
+		return x.Med
+	}
+	return nil
+}
+
+func (x *BgpAttributes) GetAigp() *BgpAttributes_Aigp {
+	if x != nil {
+		return x.Aigp
+	}
+	return nil
+}
+
+func (x *BgpAttributes) GetLargeCommunities() []string {
+	if x != nil {
+		return x.LargeCommunities
+	}
+	return nil
+}
+
 type RsvpConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2666,7 +4782,7 @@ type RsvpConfig struct {
 func (x *RsvpConfig) Reset() {
 	*x = RsvpConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[17]
+		mi := &file_ate_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2679,7 +4795,7 @@ func (x *RsvpConfig) String() string {
 func (*RsvpConfig) ProtoMessage() {}
 
 func (x *RsvpConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[17]
+	mi := &file_ate_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2692,7 +4808,7 @@ func (x *RsvpConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsvpConfig.ProtoReflect.Descriptor instead.
 func (*RsvpConfig) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{17}
+	return file_ate_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RsvpConfig) GetName() string {
@@ -2750,12 +4866,17 @@ type Network struct {
 	BgpAttributes     *BgpAttributes             `protobuf:"bytes,6,opt,name=bgp_attributes,json=bgpAttributes,proto3" json:"bgp_attributes,omitempty"`
 	Isis              *IPReachability            `protobuf:"bytes,7,opt,name=isis,proto3" json:"isis,omitempty"`
 	ImportedBgpRoutes *Network_ImportedBgpRoutes `protobuf:"bytes,8,opt,name=imported_bgp_routes,json=importedBgpRoutes,proto3" json:"imported_bgp_routes,omitempty"`
+	// Whether the routes in the network are withdrawn from all protocols.
+	Withdrawn bool    `protobuf:"varint,9,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Ldp       *LdpFec `protobuf:"bytes,10,opt,name=ldp,proto3" json:"ldp,omitempty"`
+	// BGP routes generated in place of imported route tables.
+	GeneratedBgpRoutes *GeneratedBgpRoutes `protobuf:"bytes,11,opt,name=generated_bgp_routes,json=generatedBgpRoutes,proto3" json:"generated_bgp_routes,omitempty"` // NEXT ID: 12
 }
 
 func (x *Network) Reset() {
 	*x = Network{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[18]
+		mi := &file_ate_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2768,7 +4889,136 @@ func (x *Network) String() string {
 func (*Network) ProtoMessage() {}
 
 func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[18]
+	mi := &file_ate_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Network) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Network) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *Network) GetEth() *NetworkEth {
+	if x != nil {
+		return x.Eth
+	}
+	return nil
+}
+
+func (x *Network) GetIpv4() *NetworkIp {
+	if x != nil {
+		return x.Ipv4
+	}
+	return nil
+}
+
+func (x *Network) GetIpv6() *NetworkIp {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+func (x *Network) GetBgpAttributes() *BgpAttributes {
+	if x != nil {
+		return x.BgpAttributes
+	}
+	return nil
+}
+
+func (x *Network) GetIsis() *IPReachability {
+	if x != nil {
+		return x.Isis
+	}
+	return nil
+}
+
+func (x *Network) GetImportedBgpRoutes() *Network_ImportedBgpRoutes {
+	if x != nil {
+		return x.ImportedBgpRoutes
+	}
+	return nil
+}
+
+func (x *Network) GetWithdrawn() bool {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return false
+}
+
+func (x *Network) GetLdp() *LdpFec {
+	if x != nil {
+		return x.Ldp
+	}
+	return nil
+}
+
+func (x *Network) GetGeneratedBgpRoutes() *GeneratedBgpRoutes {
+	if x != nil {
+		return x.GeneratedBgpRoutes
+	}
+	return nil
+}
+
+// A synthetic BGP route table, such as a full internet table, generated for a
+// network instead of being imported from route table files.
+type GeneratedBgpRoutes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv4 *GeneratedBgpRoutes_Prefixes `protobuf:"bytes,1,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6 *GeneratedBgpRoutes_Prefixes `protobuf:"bytes,2,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	// The range of the number of ASNs in the randomized AS path of each route.
+	AsPathMinLength uint32 `protobuf:"varint,3,opt,name=as_path_min_length,json=asPathMinLength,proto3" json:"as_path_min_length,omitempty"`
+	AsPathMaxLength uint32 `protobuf:"varint,4,opt,name=as_path_max_length,json=asPathMaxLength,proto3" json:"as_path_max_length,omitempty"`
+	// The range of the ASNs in the randomized AS paths.
+	AsnMin uint32 `protobuf:"varint,5,opt,name=asn_min,json=asnMin,proto3" json:"asn_min,omitempty"`
+	AsnMax uint32 `protobuf:"varint,6,opt,name=asn_max,json=asnMax,proto3" json:"asn_max,omitempty"`
+	// The seed of the AS path randomization, so that the same table is generated
+	// for the same config.
+	Seed int64 `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *GeneratedBgpRoutes) Reset() {
+	*x = GeneratedBgpRoutes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratedBgpRoutes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedBgpRoutes) ProtoMessage() {}
+
+func (x *GeneratedBgpRoutes) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,68 +5026,119 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedBgpRoutes.ProtoReflect.Descriptor instead.
+func (*GeneratedBgpRoutes) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GeneratedBgpRoutes) GetIpv4() *GeneratedBgpRoutes_Prefixes {
+	if x != nil {
+		return x.Ipv4
+	}
+	return nil
+}
+
+func (x *GeneratedBgpRoutes) GetIpv6() *GeneratedBgpRoutes_Prefixes {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
 }
 
-// Deprecated: Use Network.ProtoReflect.Descriptor instead.
-func (*Network) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{18}
+func (x *GeneratedBgpRoutes) GetAsPathMinLength() uint32 {
+	if x != nil {
+		return x.AsPathMinLength
+	}
+	return 0
 }
 
-func (x *Network) GetName() string {
+func (x *GeneratedBgpRoutes) GetAsPathMaxLength() uint32 {
 	if x != nil {
-		return x.Name
+		return x.AsPathMaxLength
 	}
-	return ""
+	return 0
 }
 
-func (x *Network) GetInterfaceName() string {
+func (x *GeneratedBgpRoutes) GetAsnMin() uint32 {
 	if x != nil {
-		return x.InterfaceName
+		return x.AsnMin
 	}
-	return ""
+	return 0
 }
 
-func (x *Network) GetEth() *NetworkEth {
+func (x *GeneratedBgpRoutes) GetAsnMax() uint32 {
 	if x != nil {
-		return x.Eth
+		return x.AsnMax
 	}
-	return nil
+	return 0
 }
 
-func (x *Network) GetIpv4() *NetworkIp {
+func (x *GeneratedBgpRoutes) GetSeed() int64 {
 	if x != nil {
-		return x.Ipv4
+		return x.Seed
 	}
-	return nil
+	return 0
 }
 
-func (x *Network) GetIpv6() *NetworkIp {
-	if x != nil {
-		return x.Ipv6
+// The LDP FEC labels bound to the IPv4 prefixes of a network.
+type LdpFec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first label in the range of labels bound to the prefixes.
+	LabelStart uint32 `protobuf:"varint,1,opt,name=label_start,json=labelStart,proto3" json:"label_start,omitempty"`
+	// Whether the labels are incremented for each prefix.
+	LabelIncrement bool `protobuf:"varint,2,opt,name=label_increment,json=labelIncrement,proto3" json:"label_increment,omitempty"`
+}
+
+func (x *LdpFec) Reset() {
+	*x = LdpFec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Network) GetBgpAttributes() *BgpAttributes {
-	if x != nil {
-		return x.BgpAttributes
+func (x *LdpFec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdpFec) ProtoMessage() {}
+
+func (x *LdpFec) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Network) GetIsis() *IPReachability {
+// Deprecated: Use LdpFec.ProtoReflect.Descriptor instead.
+func (*LdpFec) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LdpFec) GetLabelStart() uint32 {
 	if x != nil {
-		return x.Isis
+		return x.LabelStart
 	}
-	return nil
+	return 0
 }
 
-func (x *Network) GetImportedBgpRoutes() *Network_ImportedBgpRoutes {
+func (x *LdpFec) GetLabelIncrement() bool {
 	if x != nil {
-		return x.ImportedBgpRoutes
+		return x.LabelIncrement
 	}
-	return nil
+	return false
 }
 
 type NetworkEth struct {
@@ -2854,7 +5155,7 @@ type NetworkEth struct {
 func (x *NetworkEth) Reset() {
 	*x = NetworkEth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[19]
+		mi := &file_ate_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2867,7 +5168,7 @@ func (x *NetworkEth) String() string {
 func (*NetworkEth) ProtoMessage() {}
 
 func (x *NetworkEth) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[19]
+	mi := &file_ate_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2880,7 +5181,7 @@ func (x *NetworkEth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkEth.ProtoReflect.Descriptor instead.
 func (*NetworkEth) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{19}
+	return file_ate_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *NetworkEth) GetMacAddress() string {
@@ -2916,7 +5217,7 @@ type NetworkIp struct {
 func (x *NetworkIp) Reset() {
 	*x = NetworkIp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[20]
+		mi := &file_ate_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2929,7 +5230,7 @@ func (x *NetworkIp) String() string {
 func (*NetworkIp) ProtoMessage() {}
 
 func (x *NetworkIp) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[20]
+	mi := &file_ate_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2942,7 +5243,7 @@ func (x *NetworkIp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkIp.ProtoReflect.Descriptor instead.
 func (*NetworkIp) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{20}
+	return file_ate_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *NetworkIp) GetAddressCidr() string {
@@ -2975,12 +5276,24 @@ type Flow struct {
 	FrameSize              *FrameSize                   `protobuf:"bytes,51,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
 	// If transmission is not set, it's assumed to be a Continuous transmission.
 	Transmission *Transmission `protobuf:"bytes,52,opt,name=transmission,proto3" json:"transmission,omitempty"`
+	// Whether to measure the store-and-forward latency and jitter of the flow.
+	MeasureLatency bool `protobuf:"varint,53,opt,name=measure_latency,json=measureLatency,proto3" json:"measure_latency,omitempty"`
+	// Whether to measure the control plane/data plane convergence time of the
+	// flow.
+	MeasureConvergence bool `protobuf:"varint,54,opt,name=measure_convergence,json=measureConvergence,proto3" json:"measure_convergence,omitempty"`
+	// If set, the flow emulates stateful application sessions between its
+	// endpoints instead of sending packets built from its headers.
+	Application *ApplicationTraffic `protobuf:"bytes,55,opt,name=application,proto3" json:"application,omitempty"`
+	// If payload is not set, the ATE default payload is used.
+	Payload *Payload `protobuf:"bytes,56,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Whether to check the integrity of the payload of received packets.
+	CheckIntegrity bool `protobuf:"varint,57,opt,name=check_integrity,json=checkIntegrity,proto3" json:"check_integrity,omitempty"`
 }
 
 func (x *Flow) Reset() {
 	*x = Flow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[21]
+		mi := &file_ate_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2993,7 +5306,7 @@ func (x *Flow) String() string {
 func (*Flow) ProtoMessage() {}
 
 func (x *Flow) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[21]
+	mi := &file_ate_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,7 +5319,7 @@ func (x *Flow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Flow.ProtoReflect.Descriptor instead.
 func (*Flow) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{21}
+	return file_ate_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Flow) GetName() string {
@@ -3072,6 +5385,154 @@ func (x *Flow) GetTransmission() *Transmission {
 	return nil
 }
 
+func (x *Flow) GetMeasureLatency() bool {
+	if x != nil {
+		return x.MeasureLatency
+	}
+	return false
+}
+
+func (x *Flow) GetMeasureConvergence() bool {
+	if x != nil {
+		return x.MeasureConvergence
+	}
+	return false
+}
+
+func (x *Flow) GetApplication() *ApplicationTraffic {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *Flow) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Flow) GetCheckIntegrity() bool {
+	if x != nil {
+		return x.CheckIntegrity
+	}
+	return false
+}
+
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Payload_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ondatra.Payload_Type" json:"type,omitempty"`
+	// The pattern of a fixed payload, as hex digits.
+	FixedPattern string `protobuf:"bytes,2,opt,name=fixed_pattern,json=fixedPattern,proto3" json:"fixed_pattern,omitempty"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Payload) GetType() Payload_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Payload_TYPE_UNSPECIFIED
+}
+
+func (x *Payload) GetFixedPattern() string {
+	if x != nil {
+		return x.FixedPattern
+	}
+	return ""
+}
+
+type ApplicationTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocols []ApplicationTraffic_Protocol `protobuf:"varint,1,rep,packed,name=protocols,proto3,enum=ondatra.ApplicationTraffic_Protocol" json:"protocols,omitempty"`
+	// The number of concurrent sessions on each source port; zero for the ATE
+	// default.
+	ConcurrentSessions uint32 `protobuf:"varint,2,opt,name=concurrent_sessions,json=concurrentSessions,proto3" json:"concurrent_sessions,omitempty"`
+}
+
+func (x *ApplicationTraffic) Reset() {
+	*x = ApplicationTraffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationTraffic) ProtoMessage() {}
+
+func (x *ApplicationTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationTraffic.ProtoReflect.Descriptor instead.
+func (*ApplicationTraffic) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ApplicationTraffic) GetProtocols() []ApplicationTraffic_Protocol {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *ApplicationTraffic) GetConcurrentSessions() uint32 {
+	if x != nil {
+		return x.ConcurrentSessions
+	}
+	return 0
+}
+
 type FrameRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3087,7 +5548,7 @@ type FrameRate struct {
 func (x *FrameRate) Reset() {
 	*x = FrameRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[22]
+		mi := &file_ate_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3100,7 +5561,7 @@ func (x *FrameRate) String() string {
 func (*FrameRate) ProtoMessage() {}
 
 func (x *FrameRate) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[22]
+	mi := &file_ate_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,7 +5574,7 @@ func (x *FrameRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameRate.ProtoReflect.Descriptor instead.
 func (*FrameRate) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{22}
+	return file_ate_proto_rawDescGZIP(), []int{39}
 }
 
 func (m *FrameRate) GetType() isFrameRate_Type {
@@ -3176,13 +5637,14 @@ type FrameSize struct {
 	//	*FrameSize_Random_
 	//	*FrameSize_ImixPreset_
 	//	*FrameSize_ImixCustom_
+	//	*FrameSize_Increment_
 	Type isFrameSize_Type `protobuf_oneof:"type"`
 }
 
 func (x *FrameSize) Reset() {
 	*x = FrameSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[23]
+		mi := &file_ate_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3195,7 +5657,7 @@ func (x *FrameSize) String() string {
 func (*FrameSize) ProtoMessage() {}
 
 func (x *FrameSize) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[23]
+	mi := &file_ate_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3208,7 +5670,7 @@ func (x *FrameSize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameSize.ProtoReflect.Descriptor instead.
 func (*FrameSize) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{23}
+	return file_ate_proto_rawDescGZIP(), []int{40}
 }
 
 func (m *FrameSize) GetType() isFrameSize_Type {
@@ -3246,6 +5708,13 @@ func (x *FrameSize) GetImixCustom() *FrameSize_ImixCustom {
 	return nil
 }
 
+func (x *FrameSize) GetIncrement() *FrameSize_Increment {
+	if x, ok := x.GetType().(*FrameSize_Increment_); ok {
+		return x.Increment
+	}
+	return nil
+}
+
 type isFrameSize_Type interface {
 	isFrameSize_Type()
 }
@@ -3266,6 +5735,10 @@ type FrameSize_ImixCustom_ struct {
 	ImixCustom *FrameSize_ImixCustom `protobuf:"bytes,4,opt,name=imix_custom,json=imixCustom,proto3,oneof"`
 }
 
+type FrameSize_Increment_ struct {
+	Increment *FrameSize_Increment `protobuf:"bytes,5,opt,name=increment,proto3,oneof"`
+}
+
 func (*FrameSize_Fixed) isFrameSize_Type() {}
 
 func (*FrameSize_Random_) isFrameSize_Type() {}
@@ -3274,6 +5747,8 @@ func (*FrameSize_ImixPreset_) isFrameSize_Type() {}
 
 func (*FrameSize_ImixCustom_) isFrameSize_Type() {}
 
+func (*FrameSize_Increment_) isFrameSize_Type() {}
+
 type Transmission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3288,12 +5763,15 @@ type Transmission struct {
 	InterburstGap isTransmission_InterburstGap `protobuf_oneof:"interburst_gap"`
 	FrameCount    uint32                       `protobuf:"varint,6,opt,name=frame_count,json=frameCount,proto3" json:"frame_count,omitempty"`
 	DurationSecs  uint32                       `protobuf:"varint,7,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	// The number of bursts of a burst transmission; bursts are transmitted until
+	// traffic is stopped if unset.
+	BurstCount uint32 `protobuf:"varint,8,opt,name=burst_count,json=burstCount,proto3" json:"burst_count,omitempty"`
 }
 
 func (x *Transmission) Reset() {
 	*x = Transmission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[24]
+		mi := &file_ate_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3306,7 +5784,7 @@ func (x *Transmission) String() string {
 func (*Transmission) ProtoMessage() {}
 
 func (x *Transmission) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[24]
+	mi := &file_ate_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3319,7 +5797,7 @@ func (x *Transmission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transmission.ProtoReflect.Descriptor instead.
 func (*Transmission) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{24}
+	return file_ate_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Transmission) GetPattern() Transmission_Pattern {
@@ -3378,6 +5856,13 @@ func (x *Transmission) GetDurationSecs() uint32 {
 	return 0
 }
 
+func (x *Transmission) GetBurstCount() uint32 {
+	if x != nil {
+		return x.BurstCount
+	}
+	return 0
+}
+
 type isTransmission_InterburstGap interface {
 	isTransmission_InterburstGap()
 }
@@ -3399,14 +5884,16 @@ type EgressTracking struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomOffset uint32 `protobuf:"varint,1,opt,name=custom_offset,json=customOffset,proto3" json:"custom_offset,omitempty"`
-	CustomWidth  uint32 `protobuf:"varint,2,opt,name=custom_width,json=customWidth,proto3" json:"custom_width,omitempty"`
+	// The offset and width are only used by the custom filter.
+	CustomOffset uint32                `protobuf:"varint,1,opt,name=custom_offset,json=customOffset,proto3" json:"custom_offset,omitempty"`
+	CustomWidth  uint32                `protobuf:"varint,2,opt,name=custom_width,json=customWidth,proto3" json:"custom_width,omitempty"`
+	Filter       EgressTracking_Filter `protobuf:"varint,3,opt,name=filter,proto3,enum=ondatra.EgressTracking_Filter" json:"filter,omitempty"`
 }
 
 func (x *EgressTracking) Reset() {
 	*x = EgressTracking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[25]
+		mi := &file_ate_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3419,7 +5906,7 @@ func (x *EgressTracking) String() string {
 func (*EgressTracking) ProtoMessage() {}
 
 func (x *EgressTracking) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[25]
+	mi := &file_ate_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,7 +5919,7 @@ func (x *EgressTracking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EgressTracking.ProtoReflect.Descriptor instead.
 func (*EgressTracking) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{25}
+	return file_ate_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *EgressTracking) GetCustomOffset() uint32 {
@@ -3449,6 +5936,13 @@ func (x *EgressTracking) GetCustomWidth() uint32 {
 	return 0
 }
 
+func (x *EgressTracking) GetFilter() EgressTracking_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return EgressTracking_FILTER_CUSTOM
+}
+
 // A packet header.
 type Header struct {
 	state         protoimpl.MessageState
@@ -3469,13 +5963,17 @@ type Header struct {
 	//	*Header_Rsvp
 	//	*Header_Pim
 	//	*Header_Ldp
+	//	*Header_Vxlan
+	//	*Header_Geneve
+	//	*Header_Gtpu
+	//	*Header_Custom
 	Type isHeader_Type `protobuf_oneof:"type"`
 }
 
 func (x *Header) Reset() {
 	*x = Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[26]
+		mi := &file_ate_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3488,7 +5986,7 @@ func (x *Header) String() string {
 func (*Header) ProtoMessage() {}
 
 func (x *Header) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[26]
+	mi := &file_ate_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3501,7 +5999,7 @@ func (x *Header) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Header.ProtoReflect.Descriptor instead.
 func (*Header) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{26}
+	return file_ate_proto_rawDescGZIP(), []int{43}
 }
 
 func (m *Header) GetType() isHeader_Type {
@@ -3588,16 +6086,44 @@ func (x *Header) GetRsvp() *RsvpHeader {
 	return nil
 }
 
-func (x *Header) GetPim() *PimHeader {
-	if x, ok := x.GetType().(*Header_Pim); ok {
-		return x.Pim
+func (x *Header) GetPim() *PimHeader {
+	if x, ok := x.GetType().(*Header_Pim); ok {
+		return x.Pim
+	}
+	return nil
+}
+
+func (x *Header) GetLdp() *LdpHeader {
+	if x, ok := x.GetType().(*Header_Ldp); ok {
+		return x.Ldp
+	}
+	return nil
+}
+
+func (x *Header) GetVxlan() *VxlanHeader {
+	if x, ok := x.GetType().(*Header_Vxlan); ok {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *Header) GetGeneve() *GeneveHeader {
+	if x, ok := x.GetType().(*Header_Geneve); ok {
+		return x.Geneve
+	}
+	return nil
+}
+
+func (x *Header) GetGtpu() *GtpuHeader {
+	if x, ok := x.GetType().(*Header_Gtpu); ok {
+		return x.Gtpu
 	}
 	return nil
 }
 
-func (x *Header) GetLdp() *LdpHeader {
-	if x, ok := x.GetType().(*Header_Ldp); ok {
-		return x.Ldp
+func (x *Header) GetCustom() *CustomHeader {
+	if x, ok := x.GetType().(*Header_Custom); ok {
+		return x.Custom
 	}
 	return nil
 }
@@ -3658,6 +6184,22 @@ type Header_Ldp struct {
 	Ldp *LdpHeader `protobuf:"bytes,13,opt,name=ldp,proto3,oneof"`
 }
 
+type Header_Vxlan struct {
+	Vxlan *VxlanHeader `protobuf:"bytes,14,opt,name=vxlan,proto3,oneof"`
+}
+
+type Header_Geneve struct {
+	Geneve *GeneveHeader `protobuf:"bytes,15,opt,name=geneve,proto3,oneof"`
+}
+
+type Header_Gtpu struct {
+	Gtpu *GtpuHeader `protobuf:"bytes,16,opt,name=gtpu,proto3,oneof"`
+}
+
+type Header_Custom struct {
+	Custom *CustomHeader `protobuf:"bytes,17,opt,name=custom,proto3,oneof"`
+}
+
 func (*Header_Eth) isHeader_Type() {}
 
 func (*Header_Gre) isHeader_Type() {}
@@ -3684,6 +6226,14 @@ func (*Header_Pim) isHeader_Type() {}
 
 func (*Header_Ldp) isHeader_Type() {}
 
+func (*Header_Vxlan) isHeader_Type() {}
+
+func (*Header_Geneve) isHeader_Type() {}
+
+func (*Header_Gtpu) isHeader_Type() {}
+
+func (*Header_Custom) isHeader_Type() {}
+
 type EthernetHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3693,12 +6243,14 @@ type EthernetHeader struct {
 	DstAddr *AddressRange `protobuf:"bytes,2,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
 	VlanId  uint32        `protobuf:"varint,3,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
 	BadCrc  bool          `protobuf:"varint,4,opt,name=bad_crc,json=badCrc,proto3" json:"bad_crc,omitempty"`
+	// Set when the next header is not a known protocol, such as a custom header.
+	EtherType uint32 `protobuf:"varint,5,opt,name=ether_type,json=etherType,proto3" json:"ether_type,omitempty"`
 }
 
 func (x *EthernetHeader) Reset() {
 	*x = EthernetHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[27]
+		mi := &file_ate_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3711,7 +6263,7 @@ func (x *EthernetHeader) String() string {
 func (*EthernetHeader) ProtoMessage() {}
 
 func (x *EthernetHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[27]
+	mi := &file_ate_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3724,7 +6276,7 @@ func (x *EthernetHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetHeader.ProtoReflect.Descriptor instead.
 func (*EthernetHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{27}
+	return file_ate_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EthernetHeader) GetSrcAddr() *AddressRange {
@@ -3755,6 +6307,13 @@ func (x *EthernetHeader) GetBadCrc() bool {
 	return false
 }
 
+func (x *EthernetHeader) GetEtherType() uint32 {
+	if x != nil {
+		return x.EtherType
+	}
+	return 0
+}
+
 type GreHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3767,7 +6326,7 @@ type GreHeader struct {
 func (x *GreHeader) Reset() {
 	*x = GreHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[28]
+		mi := &file_ate_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3780,7 +6339,7 @@ func (x *GreHeader) String() string {
 func (*GreHeader) ProtoMessage() {}
 
 func (x *GreHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[28]
+	mi := &file_ate_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3793,7 +6352,7 @@ func (x *GreHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GreHeader.ProtoReflect.Descriptor instead.
 func (*GreHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{28}
+	return file_ate_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GreHeader) GetKey() uint32 {
@@ -3827,7 +6386,7 @@ type Ipv4Header struct {
 func (x *Ipv4Header) Reset() {
 	*x = Ipv4Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[29]
+		mi := &file_ate_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3840,7 +6399,7 @@ func (x *Ipv4Header) String() string {
 func (*Ipv4Header) ProtoMessage() {}
 
 func (x *Ipv4Header) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[29]
+	mi := &file_ate_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3853,7 +6412,7 @@ func (x *Ipv4Header) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ipv4Header.ProtoReflect.Descriptor instead.
 func (*Ipv4Header) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{29}
+	return file_ate_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Ipv4Header) GetSrcAddr() *AddressRange {
@@ -3921,7 +6480,7 @@ type Ipv6Header struct {
 func (x *Ipv6Header) Reset() {
 	*x = Ipv6Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[30]
+		mi := &file_ate_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3934,7 +6493,7 @@ func (x *Ipv6Header) String() string {
 func (*Ipv6Header) ProtoMessage() {}
 
 func (x *Ipv6Header) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[30]
+	mi := &file_ate_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3947,7 +6506,7 @@ func (x *Ipv6Header) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ipv6Header.ProtoReflect.Descriptor instead.
 func (*Ipv6Header) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{30}
+	return file_ate_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *Ipv6Header) GetSrcAddr() *AddressRange {
@@ -4005,7 +6564,7 @@ type MplsHeader struct {
 func (x *MplsHeader) Reset() {
 	*x = MplsHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[31]
+		mi := &file_ate_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4018,7 +6577,7 @@ func (x *MplsHeader) String() string {
 func (*MplsHeader) ProtoMessage() {}
 
 func (x *MplsHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[31]
+	mi := &file_ate_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4031,7 +6590,7 @@ func (x *MplsHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MplsHeader.ProtoReflect.Descriptor instead.
 func (*MplsHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{31}
+	return file_ate_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MplsHeader) GetLabel() *UIntRange {
@@ -4068,7 +6627,7 @@ type TcpHeader struct {
 func (x *TcpHeader) Reset() {
 	*x = TcpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[32]
+		mi := &file_ate_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4081,7 +6640,7 @@ func (x *TcpHeader) String() string {
 func (*TcpHeader) ProtoMessage() {}
 
 func (x *TcpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[32]
+	mi := &file_ate_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4094,7 +6653,7 @@ func (x *TcpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcpHeader.ProtoReflect.Descriptor instead.
 func (*TcpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{32}
+	return file_ate_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TcpHeader) GetSrcPort() *UIntRange {
@@ -4130,7 +6689,7 @@ type UdpHeader struct {
 func (x *UdpHeader) Reset() {
 	*x = UdpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[33]
+		mi := &file_ate_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4143,7 +6702,7 @@ func (x *UdpHeader) String() string {
 func (*UdpHeader) ProtoMessage() {}
 
 func (x *UdpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[33]
+	mi := &file_ate_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,7 +6715,7 @@ func (x *UdpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UdpHeader.ProtoReflect.Descriptor instead.
 func (*UdpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{33}
+	return file_ate_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *UdpHeader) GetSrcPort() *UIntRange {
@@ -4182,7 +6741,7 @@ type HttpHeader struct {
 func (x *HttpHeader) Reset() {
 	*x = HttpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[34]
+		mi := &file_ate_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4195,7 +6754,7 @@ func (x *HttpHeader) String() string {
 func (*HttpHeader) ProtoMessage() {}
 
 func (x *HttpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[34]
+	mi := &file_ate_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4208,7 +6767,7 @@ func (x *HttpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpHeader.ProtoReflect.Descriptor instead.
 func (*HttpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{34}
+	return file_ate_proto_rawDescGZIP(), []int{51}
 }
 
 type IcmpHeader struct {
@@ -4231,7 +6790,7 @@ type IcmpHeader struct {
 func (x *IcmpHeader) Reset() {
 	*x = IcmpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[35]
+		mi := &file_ate_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4244,7 +6803,7 @@ func (x *IcmpHeader) String() string {
 func (*IcmpHeader) ProtoMessage() {}
 
 func (x *IcmpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[35]
+	mi := &file_ate_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,7 +6816,7 @@ func (x *IcmpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader.ProtoReflect.Descriptor instead.
 func (*IcmpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35}
+	return file_ate_proto_rawDescGZIP(), []int{52}
 }
 
 func (m *IcmpHeader) GetType() isIcmpHeader_Type {
@@ -4394,7 +6953,7 @@ type OspfHeader struct {
 func (x *OspfHeader) Reset() {
 	*x = OspfHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[36]
+		mi := &file_ate_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4407,7 +6966,7 @@ func (x *OspfHeader) String() string {
 func (*OspfHeader) ProtoMessage() {}
 
 func (x *OspfHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[36]
+	mi := &file_ate_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4420,7 +6979,7 @@ func (x *OspfHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader.ProtoReflect.Descriptor instead.
 func (*OspfHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36}
+	return file_ate_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *OspfHeader) GetRouterId() string {
@@ -4527,7 +7086,7 @@ type RsvpHeader struct {
 func (x *RsvpHeader) Reset() {
 	*x = RsvpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[37]
+		mi := &file_ate_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4540,7 +7099,7 @@ func (x *RsvpHeader) String() string {
 func (*RsvpHeader) ProtoMessage() {}
 
 func (x *RsvpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[37]
+	mi := &file_ate_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4553,7 +7112,7 @@ func (x *RsvpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsvpHeader.ProtoReflect.Descriptor instead.
 func (*RsvpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{37}
+	return file_ate_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RsvpHeader) GetVersion() uint32 {
@@ -4597,7 +7156,7 @@ type PimHeader struct {
 func (x *PimHeader) Reset() {
 	*x = PimHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[38]
+		mi := &file_ate_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4610,7 +7169,7 @@ func (x *PimHeader) String() string {
 func (*PimHeader) ProtoMessage() {}
 
 func (x *PimHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[38]
+	mi := &file_ate_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4623,7 +7182,7 @@ func (x *PimHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PimHeader.ProtoReflect.Descriptor instead.
 func (*PimHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{38}
+	return file_ate_proto_rawDescGZIP(), []int{55}
 }
 
 func (m *PimHeader) GetType() isPimHeader_Type {
@@ -4666,7 +7225,7 @@ type LdpHeader struct {
 func (x *LdpHeader) Reset() {
 	*x = LdpHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[39]
+		mi := &file_ate_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4679,7 +7238,7 @@ func (x *LdpHeader) String() string {
 func (*LdpHeader) ProtoMessage() {}
 
 func (x *LdpHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[39]
+	mi := &file_ate_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,7 +7251,7 @@ func (x *LdpHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LdpHeader.ProtoReflect.Descriptor instead.
 func (*LdpHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{39}
+	return file_ate_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *LdpHeader) GetLsrId() string {
@@ -4740,6 +7299,237 @@ type LdpHeader_Hello_ struct {
 
 func (*LdpHeader_Hello_) isLdpHeader_Type() {}
 
+// A VXLAN header, which follows a UDP header and precedes the inner Ethernet
+// header of the encapsulated frame.
+type VxlanHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vni *UIntRange `protobuf:"bytes,1,opt,name=vni,proto3" json:"vni,omitempty"`
+}
+
+func (x *VxlanHeader) Reset() {
+	*x = VxlanHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VxlanHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VxlanHeader) ProtoMessage() {}
+
+func (x *VxlanHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VxlanHeader.ProtoReflect.Descriptor instead.
+func (*VxlanHeader) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *VxlanHeader) GetVni() *UIntRange {
+	if x != nil {
+		return x.Vni
+	}
+	return nil
+}
+
+// A GENEVE header, which follows a UDP header and precedes the encapsulated
+// payload.
+type GeneveHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vni *UIntRange `protobuf:"bytes,1,opt,name=vni,proto3" json:"vni,omitempty"`
+	// The EtherType of the encapsulated payload.
+	ProtocolType uint32 `protobuf:"varint,2,opt,name=protocol_type,json=protocolType,proto3" json:"protocol_type,omitempty"`
+	// Whether the O bit is set, marking the packet as a control packet.
+	ControlPacket bool `protobuf:"varint,3,opt,name=control_packet,json=controlPacket,proto3" json:"control_packet,omitempty"`
+}
+
+func (x *GeneveHeader) Reset() {
+	*x = GeneveHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneveHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneveHeader) ProtoMessage() {}
+
+func (x *GeneveHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneveHeader.ProtoReflect.Descriptor instead.
+func (*GeneveHeader) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GeneveHeader) GetVni() *UIntRange {
+	if x != nil {
+		return x.Vni
+	}
+	return nil
+}
+
+func (x *GeneveHeader) GetProtocolType() uint32 {
+	if x != nil {
+		return x.ProtocolType
+	}
+	return 0
+}
+
+func (x *GeneveHeader) GetControlPacket() bool {
+	if x != nil {
+		return x.ControlPacket
+	}
+	return false
+}
+
+// A GTPv1-U header, which follows a UDP header and precedes the encapsulated
+// IP packet.
+type GtpuHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teid *UIntRange `protobuf:"bytes,1,opt,name=teid,proto3" json:"teid,omitempty"`
+	// The GTP message type; 255 (G-PDU) for user plane traffic.
+	MessageType         uint32                          `protobuf:"varint,2,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	PduSessionContainer *GtpuHeader_PduSessionContainer `protobuf:"bytes,3,opt,name=pdu_session_container,json=pduSessionContainer,proto3" json:"pdu_session_container,omitempty"`
+}
+
+func (x *GtpuHeader) Reset() {
+	*x = GtpuHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GtpuHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GtpuHeader) ProtoMessage() {}
+
+func (x *GtpuHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GtpuHeader.ProtoReflect.Descriptor instead.
+func (*GtpuHeader) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GtpuHeader) GetTeid() *UIntRange {
+	if x != nil {
+		return x.Teid
+	}
+	return nil
+}
+
+func (x *GtpuHeader) GetMessageType() uint32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *GtpuHeader) GetPduSessionContainer() *GtpuHeader_PduSessionContainer {
+	if x != nil {
+		return x.PduSessionContainer
+	}
+	return nil
+}
+
+// A header of arbitrary bytes, such as to craft a malformed or unexpected
+// protocol packet that the other headers cannot express.
+type CustomHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CustomHeader) Reset() {
+	*x = CustomHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomHeader) ProtoMessage() {}
+
+func (x *CustomHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomHeader.ProtoReflect.Descriptor instead.
+func (*CustomHeader) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CustomHeader) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type IpAddressGenerator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4754,7 +7544,7 @@ type IpAddressGenerator struct {
 func (x *IpAddressGenerator) Reset() {
 	*x = IpAddressGenerator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[40]
+		mi := &file_ate_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4767,7 +7557,7 @@ func (x *IpAddressGenerator) String() string {
 func (*IpAddressGenerator) ProtoMessage() {}
 
 func (x *IpAddressGenerator) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[40]
+	mi := &file_ate_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,7 +7570,7 @@ func (x *IpAddressGenerator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IpAddressGenerator.ProtoReflect.Descriptor instead.
 func (*IpAddressGenerator) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{40}
+	return file_ate_proto_rawDescGZIP(), []int{61}
 }
 
 func (m *IpAddressGenerator) GetType() isIpAddressGenerator_Type {
@@ -4831,7 +7621,7 @@ type IpAddressList struct {
 func (x *IpAddressList) Reset() {
 	*x = IpAddressList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[41]
+		mi := &file_ate_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4844,7 +7634,7 @@ func (x *IpAddressList) String() string {
 func (*IpAddressList) ProtoMessage() {}
 
 func (x *IpAddressList) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[41]
+	mi := &file_ate_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4857,7 +7647,7 @@ func (x *IpAddressList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IpAddressList.ProtoReflect.Descriptor instead.
 func (*IpAddressList) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{41}
+	return file_ate_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *IpAddressList) GetAddrs() []string {
@@ -4879,7 +7669,7 @@ type IpAddressRandom struct {
 func (x *IpAddressRandom) Reset() {
 	*x = IpAddressRandom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[42]
+		mi := &file_ate_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4892,7 +7682,7 @@ func (x *IpAddressRandom) String() string {
 func (*IpAddressRandom) ProtoMessage() {}
 
 func (x *IpAddressRandom) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[42]
+	mi := &file_ate_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +7695,7 @@ func (x *IpAddressRandom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IpAddressRandom.ProtoReflect.Descriptor instead.
 func (*IpAddressRandom) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{42}
+	return file_ate_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *IpAddressRandom) GetPrefix() string {
@@ -4932,12 +7722,14 @@ type UIntRange struct {
 	Step   uint32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
 	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	Random bool   `protobuf:"varint,5,opt,name=random,proto3" json:"random,omitempty"`
+	// If set, the values are cycled through in order, instead of the range.
+	Values []uint32 `protobuf:"varint,6,rep,packed,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *UIntRange) Reset() {
 	*x = UIntRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[43]
+		mi := &file_ate_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4950,7 +7742,7 @@ func (x *UIntRange) String() string {
 func (*UIntRange) ProtoMessage() {}
 
 func (x *UIntRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[43]
+	mi := &file_ate_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4963,7 +7755,7 @@ func (x *UIntRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UIntRange.ProtoReflect.Descriptor instead.
 func (*UIntRange) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{43}
+	return file_ate_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UIntRange) GetMin() uint32 {
@@ -5001,6 +7793,13 @@ func (x *UIntRange) GetRandom() bool {
 	return false
 }
 
+func (x *UIntRange) GetValues() []uint32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type AddressRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5011,12 +7810,14 @@ type AddressRange struct {
 	Step   string `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
 	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	Random bool   `protobuf:"varint,5,opt,name=random,proto3" json:"random,omitempty"`
+	// If set, the values are cycled through in order, instead of the range.
+	Values []string `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *AddressRange) Reset() {
 	*x = AddressRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[44]
+		mi := &file_ate_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5029,7 +7830,7 @@ func (x *AddressRange) String() string {
 func (*AddressRange) ProtoMessage() {}
 
 func (x *AddressRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[44]
+	mi := &file_ate_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5042,7 +7843,7 @@ func (x *AddressRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressRange.ProtoReflect.Descriptor instead.
 func (*AddressRange) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{44}
+	return file_ate_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AddressRange) GetMin() string {
@@ -5080,6 +7881,13 @@ func (x *AddressRange) GetRandom() bool {
 	return false
 }
 
+func (x *AddressRange) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type StringIncRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5092,7 +7900,7 @@ type StringIncRange struct {
 func (x *StringIncRange) Reset() {
 	*x = StringIncRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[45]
+		mi := &file_ate_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5105,7 +7913,7 @@ func (x *StringIncRange) String() string {
 func (*StringIncRange) ProtoMessage() {}
 
 func (x *StringIncRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[45]
+	mi := &file_ate_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5118,7 +7926,7 @@ func (x *StringIncRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringIncRange.ProtoReflect.Descriptor instead.
 func (*StringIncRange) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{45}
+	return file_ate_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *StringIncRange) GetStart() string {
@@ -5147,7 +7955,7 @@ type UInt32IncRange struct {
 func (x *UInt32IncRange) Reset() {
 	*x = UInt32IncRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[46]
+		mi := &file_ate_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5160,7 +7968,7 @@ func (x *UInt32IncRange) String() string {
 func (*UInt32IncRange) ProtoMessage() {}
 
 func (x *UInt32IncRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[46]
+	mi := &file_ate_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5173,7 +7981,7 @@ func (x *UInt32IncRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UInt32IncRange.ProtoReflect.Descriptor instead.
 func (*UInt32IncRange) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{46}
+	return file_ate_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UInt32IncRange) GetStart() uint32 {
@@ -5196,12 +8004,17 @@ type Lag_Lacp struct {
 	unknownFields protoimpl.UnknownFields
 
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Whether the member ports only respond to LACPDUs, rather than
+	// initiating them.
+	Passive bool `protobuf:"varint,2,opt,name=passive,proto3" json:"passive,omitempty"`
+	// Whether LACPDUs are sent at the slow rate, rather than the fast rate.
+	SlowRate bool `protobuf:"varint,3,opt,name=slow_rate,json=slowRate,proto3" json:"slow_rate,omitempty"`
 }
 
 func (x *Lag_Lacp) Reset() {
 	*x = Lag_Lacp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[47]
+		mi := &file_ate_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5214,7 +8027,7 @@ func (x *Lag_Lacp) String() string {
 func (*Lag_Lacp) ProtoMessage() {}
 
 func (x *Lag_Lacp) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[47]
+	mi := &file_ate_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5230,11 +8043,308 @@ func (*Lag_Lacp) Descriptor() ([]byte, []int) {
 	return file_ate_proto_rawDescGZIP(), []int{2, 0}
 }
 
-func (x *Lag_Lacp) GetEnabled() bool {
+func (x *Lag_Lacp) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Lag_Lacp) GetPassive() bool {
+	if x != nil {
+		return x.Passive
+	}
+	return false
+}
+
+func (x *Lag_Lacp) GetSlowRate() bool {
+	if x != nil {
+		return x.SlowRate
+	}
+	return false
+}
+
+type DhcpConfig_Client struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of emulated clients; one if unset.
+	Count       uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	RapidCommit bool   `protobuf:"varint,2,opt,name=rapid_commit,json=rapidCommit,proto3" json:"rapid_commit,omitempty"`
+}
+
+func (x *DhcpConfig_Client) Reset() {
+	*x = DhcpConfig_Client{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DhcpConfig_Client) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DhcpConfig_Client) ProtoMessage() {}
+
+func (x *DhcpConfig_Client) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DhcpConfig_Client.ProtoReflect.Descriptor instead.
+func (*DhcpConfig_Client) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *DhcpConfig_Client) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DhcpConfig_Client) GetRapidCommit() bool {
+	if x != nil {
+		return x.RapidCommit
+	}
+	return false
+}
+
+type DhcpConfig_Server struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first address of the pool leased to clients.
+	PoolAddress   string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
+	PoolSize      uint32 `protobuf:"varint,2,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	PrefixLength  uint32 `protobuf:"varint,3,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	LeaseTimeSecs uint32 `protobuf:"varint,4,opt,name=lease_time_secs,json=leaseTimeSecs,proto3" json:"lease_time_secs,omitempty"`
+}
+
+func (x *DhcpConfig_Server) Reset() {
+	*x = DhcpConfig_Server{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DhcpConfig_Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DhcpConfig_Server) ProtoMessage() {}
+
+func (x *DhcpConfig_Server) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DhcpConfig_Server.ProtoReflect.Descriptor instead.
+func (*DhcpConfig_Server) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *DhcpConfig_Server) GetPoolAddress() string {
+	if x != nil {
+		return x.PoolAddress
+	}
+	return ""
+}
+
+func (x *DhcpConfig_Server) GetPoolSize() uint32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *DhcpConfig_Server) GetPrefixLength() uint32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *DhcpConfig_Server) GetLeaseTimeSecs() uint32 {
+	if x != nil {
+		return x.LeaseTimeSecs
+	}
+	return 0
+}
+
+type PimConfig_JoinPrune struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RangeType PimConfig_JoinPrune_RangeType `protobuf:"varint,1,opt,name=range_type,json=rangeType,proto3,enum=ondatra.PimConfig_JoinPrune_RangeType" json:"range_type,omitempty"`
+	RpAddress string                        `protobuf:"bytes,2,opt,name=rp_address,json=rpAddress,proto3" json:"rp_address,omitempty"`
+	// The first address of the range of groups.
+	GroupAddress string `protobuf:"bytes,3,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	// The number of groups in the range; one if unset.
+	GroupCount uint32 `protobuf:"varint,4,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
+	// The source address of (S,G) join/prunes.
+	SourceAddress string `protobuf:"bytes,5,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// Whether the groups are pruned rather than joined.
+	Pruned bool `protobuf:"varint,6,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (x *PimConfig_JoinPrune) Reset() {
+	*x = PimConfig_JoinPrune{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PimConfig_JoinPrune) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PimConfig_JoinPrune) ProtoMessage() {}
+
+func (x *PimConfig_JoinPrune) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PimConfig_JoinPrune.ProtoReflect.Descriptor instead.
+func (*PimConfig_JoinPrune) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *PimConfig_JoinPrune) GetRangeType() PimConfig_JoinPrune_RangeType {
+	if x != nil {
+		return x.RangeType
+	}
+	return PimConfig_JoinPrune_RANGE_TYPE_UNSPECIFIED
+}
+
+func (x *PimConfig_JoinPrune) GetRpAddress() string {
+	if x != nil {
+		return x.RpAddress
+	}
+	return ""
+}
+
+func (x *PimConfig_JoinPrune) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+func (x *PimConfig_JoinPrune) GetGroupCount() uint32 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *PimConfig_JoinPrune) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *PimConfig_JoinPrune) GetPruned() bool {
+	if x != nil {
+		return x.Pruned
+	}
+	return false
+}
+
+type PimConfig_CandidateRp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The range of groups, in CIDR notation, for which to advertise candidacy.
+	GroupPrefix string `protobuf:"bytes,2,opt,name=group_prefix,json=groupPrefix,proto3" json:"group_prefix,omitempty"`
+	Priority    uint32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *PimConfig_CandidateRp) Reset() {
+	*x = PimConfig_CandidateRp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PimConfig_CandidateRp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PimConfig_CandidateRp) ProtoMessage() {}
+
+func (x *PimConfig_CandidateRp) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PimConfig_CandidateRp.ProtoReflect.Descriptor instead.
+func (*PimConfig_CandidateRp) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *PimConfig_CandidateRp) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PimConfig_CandidateRp) GetGroupPrefix() string {
+	if x != nil {
+		return x.GroupPrefix
+	}
+	return ""
+}
+
+func (x *PimConfig_CandidateRp) GetPriority() uint32 {
 	if x != nil {
-		return x.Enabled
+		return x.Priority
 	}
-	return false
+	return 0
 }
 
 type MacSec_MKA struct {
@@ -5252,7 +8362,7 @@ type MacSec_MKA struct {
 func (x *MacSec_MKA) Reset() {
 	*x = MacSec_MKA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[48]
+		mi := &file_ate_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5265,7 +8375,7 @@ func (x *MacSec_MKA) String() string {
 func (*MacSec_MKA) ProtoMessage() {}
 
 func (x *MacSec_MKA) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[48]
+	mi := &file_ate_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5278,7 +8388,7 @@ func (x *MacSec_MKA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacSec_MKA.ProtoReflect.Descriptor instead.
 func (*MacSec_MKA) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6, 0}
+	return file_ate_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *MacSec_MKA) GetVersion() uint32 {
@@ -5328,7 +8438,7 @@ type MacSec_MKA_ConnectivityAssociation struct {
 func (x *MacSec_MKA_ConnectivityAssociation) Reset() {
 	*x = MacSec_MKA_ConnectivityAssociation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[49]
+		mi := &file_ate_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5341,7 +8451,7 @@ func (x *MacSec_MKA_ConnectivityAssociation) String() string {
 func (*MacSec_MKA_ConnectivityAssociation) ProtoMessage() {}
 
 func (x *MacSec_MKA_ConnectivityAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[49]
+	mi := &file_ate_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5354,7 +8464,7 @@ func (x *MacSec_MKA_ConnectivityAssociation) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MacSec_MKA_ConnectivityAssociation.ProtoReflect.Descriptor instead.
 func (*MacSec_MKA_ConnectivityAssociation) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{6, 0, 0}
+	return file_ate_proto_rawDescGZIP(), []int{15, 0, 0}
 }
 
 func (x *MacSec_MKA_ConnectivityAssociation) GetCkn() string {
@@ -5389,7 +8499,7 @@ type ISISSegmentRouting_AdjacencySID struct {
 func (x *ISISSegmentRouting_AdjacencySID) Reset() {
 	*x = ISISSegmentRouting_AdjacencySID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[50]
+		mi := &file_ate_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5402,7 +8512,7 @@ func (x *ISISSegmentRouting_AdjacencySID) String() string {
 func (*ISISSegmentRouting_AdjacencySID) ProtoMessage() {}
 
 func (x *ISISSegmentRouting_AdjacencySID) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[50]
+	mi := &file_ate_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5415,7 +8525,7 @@ func (x *ISISSegmentRouting_AdjacencySID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISISSegmentRouting_AdjacencySID.ProtoReflect.Descriptor instead.
 func (*ISISSegmentRouting_AdjacencySID) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{10, 0}
+	return file_ate_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *ISISSegmentRouting_AdjacencySID) GetSid() string {
@@ -5486,7 +8596,7 @@ type ISISSegmentRouting_SIDRange struct {
 func (x *ISISSegmentRouting_SIDRange) Reset() {
 	*x = ISISSegmentRouting_SIDRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[51]
+		mi := &file_ate_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5499,7 +8609,7 @@ func (x *ISISSegmentRouting_SIDRange) String() string {
 func (*ISISSegmentRouting_SIDRange) ProtoMessage() {}
 
 func (x *ISISSegmentRouting_SIDRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[51]
+	mi := &file_ate_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5512,7 +8622,7 @@ func (x *ISISSegmentRouting_SIDRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISISSegmentRouting_SIDRange.ProtoReflect.Descriptor instead.
 func (*ISISSegmentRouting_SIDRange) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{10, 1}
+	return file_ate_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *ISISSegmentRouting_SIDRange) GetSidStartLabel() uint32 {
@@ -5551,7 +8661,7 @@ type ISReachability_Node struct {
 func (x *ISReachability_Node) Reset() {
 	*x = ISReachability_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[52]
+		mi := &file_ate_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5564,7 +8674,7 @@ func (x *ISReachability_Node) String() string {
 func (*ISReachability_Node) ProtoMessage() {}
 
 func (x *ISReachability_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[52]
+	mi := &file_ate_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5577,7 +8687,7 @@ func (x *ISReachability_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISReachability_Node.ProtoReflect.Descriptor instead.
 func (*ISReachability_Node) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{12, 0}
+	return file_ate_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *ISReachability_Node) GetIngressMetric() uint32 {
@@ -5659,12 +8769,14 @@ type ISReachability_Node_Link struct {
 	ToIpv4   string `protobuf:"bytes,2,opt,name=to_ipv4,json=toIpv4,proto3" json:"to_ipv4,omitempty"`
 	FromIpv6 string `protobuf:"bytes,3,opt,name=from_ipv6,json=fromIpv6,proto3" json:"from_ipv6,omitempty"`
 	ToIpv6   string `protobuf:"bytes,4,opt,name=to_ipv6,json=toIpv6,proto3" json:"to_ipv6,omitempty"`
+	// TE attributes of the link, advertised if TE is enabled on the node.
+	TeAttributes *ISISTrafficEngineering `protobuf:"bytes,5,opt,name=te_attributes,json=teAttributes,proto3" json:"te_attributes,omitempty"`
 }
 
 func (x *ISReachability_Node_Link) Reset() {
 	*x = ISReachability_Node_Link{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[53]
+		mi := &file_ate_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5677,7 +8789,7 @@ func (x *ISReachability_Node_Link) String() string {
 func (*ISReachability_Node_Link) ProtoMessage() {}
 
 func (x *ISReachability_Node_Link) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[53]
+	mi := &file_ate_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5690,7 +8802,7 @@ func (x *ISReachability_Node_Link) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISReachability_Node_Link.ProtoReflect.Descriptor instead.
 func (*ISReachability_Node_Link) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_ate_proto_rawDescGZIP(), []int{23, 0, 0}
 }
 
 func (x *ISReachability_Node_Link) GetFromIpv4() string {
@@ -5721,6 +8833,13 @@ func (x *ISReachability_Node_Link) GetToIpv6() string {
 	return ""
 }
 
+func (x *ISReachability_Node_Link) GetTeAttributes() *ISISTrafficEngineering {
+	if x != nil {
+		return x.TeAttributes
+	}
+	return nil
+}
+
 type ISReachability_Node_Routes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5734,7 +8853,7 @@ type ISReachability_Node_Routes struct {
 func (x *ISReachability_Node_Routes) Reset() {
 	*x = ISReachability_Node_Routes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[54]
+		mi := &file_ate_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5747,7 +8866,7 @@ func (x *ISReachability_Node_Routes) String() string {
 func (*ISReachability_Node_Routes) ProtoMessage() {}
 
 func (x *ISReachability_Node_Routes) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[54]
+	mi := &file_ate_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5760,7 +8879,7 @@ func (x *ISReachability_Node_Routes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISReachability_Node_Routes.ProtoReflect.Descriptor instead.
 func (*ISReachability_Node_Routes) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{12, 0, 1}
+	return file_ate_proto_rawDescGZIP(), []int{23, 0, 1}
 }
 
 func (x *ISReachability_Node_Routes) GetPrefix() string {
@@ -5820,7 +8939,7 @@ type BgpPeer_Capabilities struct {
 func (x *BgpPeer_Capabilities) Reset() {
 	*x = BgpPeer_Capabilities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[55]
+		mi := &file_ate_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5833,7 +8952,7 @@ func (x *BgpPeer_Capabilities) String() string {
 func (*BgpPeer_Capabilities) ProtoMessage() {}
 
 func (x *BgpPeer_Capabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[55]
+	mi := &file_ate_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5846,7 +8965,7 @@ func (x *BgpPeer_Capabilities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpPeer_Capabilities.ProtoReflect.Descriptor instead.
 func (*BgpPeer_Capabilities) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 0}
+	return file_ate_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *BgpPeer_Capabilities) GetIpv4Unicast() bool {
@@ -6054,7 +9173,7 @@ type BgpPeer_SrtePolicyGroup struct {
 func (x *BgpPeer_SrtePolicyGroup) Reset() {
 	*x = BgpPeer_SrtePolicyGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[56]
+		mi := &file_ate_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6067,7 +9186,7 @@ func (x *BgpPeer_SrtePolicyGroup) String() string {
 func (*BgpPeer_SrtePolicyGroup) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[56]
+	mi := &file_ate_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6080,7 +9199,7 @@ func (x *BgpPeer_SrtePolicyGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1}
 }
 
 func (x *BgpPeer_SrtePolicyGroup) GetCount() uint32 {
@@ -6185,7 +9304,7 @@ type BgpPeer_SrtePolicyGroup_Preference struct {
 func (x *BgpPeer_SrtePolicyGroup_Preference) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_Preference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[57]
+		mi := &file_ate_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6198,7 +9317,7 @@ func (x *BgpPeer_SrtePolicyGroup_Preference) String() string {
 func (*BgpPeer_SrtePolicyGroup_Preference) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_Preference) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[57]
+	mi := &file_ate_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6211,7 +9330,7 @@ func (x *BgpPeer_SrtePolicyGroup_Preference) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_Preference.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_Preference) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 0}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 0}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_Preference) GetPreference() uint32 {
@@ -6237,7 +9356,7 @@ type BgpPeer_SrtePolicyGroup_Binding struct {
 func (x *BgpPeer_SrtePolicyGroup_Binding) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_Binding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[58]
+		mi := &file_ate_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6250,7 +9369,7 @@ func (x *BgpPeer_SrtePolicyGroup_Binding) String() string {
 func (*BgpPeer_SrtePolicyGroup_Binding) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_Binding) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[58]
+	mi := &file_ate_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6263,7 +9382,7 @@ func (x *BgpPeer_SrtePolicyGroup_Binding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_Binding.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_Binding) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 1}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 1}
 }
 
 func (m *BgpPeer_SrtePolicyGroup_Binding) GetType() isBgpPeer_SrtePolicyGroup_Binding_Type {
@@ -6343,7 +9462,7 @@ type BgpPeer_SrtePolicyGroup_SegmentList struct {
 func (x *BgpPeer_SrtePolicyGroup_SegmentList) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_SegmentList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[59]
+		mi := &file_ate_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6356,7 +9475,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList) String() string {
 func (*BgpPeer_SrtePolicyGroup_SegmentList) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[59]
+	mi := &file_ate_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6369,7 +9488,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_SegmentList.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_SegmentList) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 2}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 2}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList) GetActive() bool {
@@ -6404,7 +9523,7 @@ type BgpPeer_SrtePolicyGroup_Enlp struct {
 func (x *BgpPeer_SrtePolicyGroup_Enlp) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_Enlp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[60]
+		mi := &file_ate_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6417,7 +9536,7 @@ func (x *BgpPeer_SrtePolicyGroup_Enlp) String() string {
 func (*BgpPeer_SrtePolicyGroup_Enlp) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_Enlp) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[60]
+	mi := &file_ate_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6430,7 +9549,7 @@ func (x *BgpPeer_SrtePolicyGroup_Enlp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_Enlp.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_Enlp) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 3}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 3}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_Enlp) GetEnlp() uint32 {
@@ -6451,7 +9570,7 @@ type BgpPeer_SrtePolicyGroup_SegmentList_Weight struct {
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Weight) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_SegmentList_Weight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[61]
+		mi := &file_ate_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6464,7 +9583,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Weight) String() string {
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Weight) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Weight) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[61]
+	mi := &file_ate_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6477,7 +9596,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Weight) ProtoReflect() protoreflect
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_SegmentList_Weight.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Weight) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 2, 0}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 2, 0}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Weight) GetWeight() uint32 {
@@ -6502,7 +9621,7 @@ type BgpPeer_SrtePolicyGroup_SegmentList_Segment struct {
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_SegmentList_Segment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[62]
+		mi := &file_ate_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6515,7 +9634,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment) String() string {
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Segment) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[62]
+	mi := &file_ate_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6528,7 +9647,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment) ProtoReflect() protoreflec
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_SegmentList_Segment.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Segment) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 2, 1}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 2, 1}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment) GetActive() bool {
@@ -6594,7 +9713,7 @@ type BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid struct {
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) Reset() {
 	*x = BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[63]
+		mi := &file_ate_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6607,7 +9726,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) String() string {
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) ProtoMessage() {}
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[63]
+	mi := &file_ate_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6620,7 +9739,7 @@ func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) ProtoReflect() pro
 
 // Deprecated: Use BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid.ProtoReflect.Descriptor instead.
 func (*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{15, 1, 2, 1, 0}
+	return file_ate_proto_rawDescGZIP(), []int{28, 1, 2, 1, 0}
 }
 
 func (x *BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid) GetLabel() uint32 {
@@ -6664,7 +9783,7 @@ type BgpAttributes_ExtendedCommunity struct {
 func (x *BgpAttributes_ExtendedCommunity) Reset() {
 	*x = BgpAttributes_ExtendedCommunity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[64]
+		mi := &file_ate_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6677,7 +9796,7 @@ func (x *BgpAttributes_ExtendedCommunity) String() string {
 func (*BgpAttributes_ExtendedCommunity) ProtoMessage() {}
 
 func (x *BgpAttributes_ExtendedCommunity) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[64]
+	mi := &file_ate_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6690,7 +9809,7 @@ func (x *BgpAttributes_ExtendedCommunity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpAttributes_ExtendedCommunity.ProtoReflect.Descriptor instead.
 func (*BgpAttributes_ExtendedCommunity) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 0}
+	return file_ate_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (m *BgpAttributes_ExtendedCommunity) GetType() isBgpAttributes_ExtendedCommunity_Type {
@@ -6729,7 +9848,7 @@ type BgpAttributes_AsPathSegment struct {
 func (x *BgpAttributes_AsPathSegment) Reset() {
 	*x = BgpAttributes_AsPathSegment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[65]
+		mi := &file_ate_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6742,7 +9861,7 @@ func (x *BgpAttributes_AsPathSegment) String() string {
 func (*BgpAttributes_AsPathSegment) ProtoMessage() {}
 
 func (x *BgpAttributes_AsPathSegment) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[65]
+	mi := &file_ate_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6755,7 +9874,7 @@ func (x *BgpAttributes_AsPathSegment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BgpAttributes_AsPathSegment.ProtoReflect.Descriptor instead.
 func (*BgpAttributes_AsPathSegment) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 1}
+	return file_ate_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *BgpAttributes_AsPathSegment) GetType() BgpAttributes_AsPathSegment_Type {
@@ -6772,6 +9891,161 @@ func (x *BgpAttributes_AsPathSegment) GetAsns() []uint32 {
 	return nil
 }
 
+// Advertises each prefix multiple times with distinct add-path IDs. The peer
+// must have an add-path capability enabled in the send direction.
+type BgpAttributes_AddPaths struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of paths advertised per prefix.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Path ID of the first path; each subsequent path ID is incremented by one.
+	PathIdStart uint32 `protobuf:"varint,2,opt,name=path_id_start,json=pathIdStart,proto3" json:"path_id_start,omitempty"`
+}
+
+func (x *BgpAttributes_AddPaths) Reset() {
+	*x = BgpAttributes_AddPaths{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BgpAttributes_AddPaths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BgpAttributes_AddPaths) ProtoMessage() {}
+
+func (x *BgpAttributes_AddPaths) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BgpAttributes_AddPaths.ProtoReflect.Descriptor instead.
+func (*BgpAttributes_AddPaths) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 2}
+}
+
+func (x *BgpAttributes_AddPaths) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BgpAttributes_AddPaths) GetPathIdStart() uint32 {
+	if x != nil {
+		return x.PathIdStart
+	}
+	return 0
+}
+
+// The multi-exit discriminator; not advertised if unset.
+type BgpAttributes_Med struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Med uint32 `protobuf:"varint,1,opt,name=med,proto3" json:"med,omitempty"`
+}
+
+func (x *BgpAttributes_Med) Reset() {
+	*x = BgpAttributes_Med{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BgpAttributes_Med) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BgpAttributes_Med) ProtoMessage() {}
+
+func (x *BgpAttributes_Med) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BgpAttributes_Med.ProtoReflect.Descriptor instead.
+func (*BgpAttributes_Med) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 3}
+}
+
+func (x *BgpAttributes_Med) GetMed() uint32 {
+	if x != nil {
+		return x.Med
+	}
+	return 0
+}
+
+// The accumulated IGP metric (RFC 7311); not advertised if unset.
+type BgpAttributes_Aigp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric uint64 `protobuf:"varint,1,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *BgpAttributes_Aigp) Reset() {
+	*x = BgpAttributes_Aigp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BgpAttributes_Aigp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BgpAttributes_Aigp) ProtoMessage() {}
+
+func (x *BgpAttributes_Aigp) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BgpAttributes_Aigp.ProtoReflect.Descriptor instead.
+func (*BgpAttributes_Aigp) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{29, 4}
+}
+
+func (x *BgpAttributes_Aigp) GetMetric() uint64 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
 type BgpAttributes_ExtendedCommunity_Color struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -6785,7 +10059,7 @@ type BgpAttributes_ExtendedCommunity_Color struct {
 func (x *BgpAttributes_ExtendedCommunity_Color) Reset() {
 	*x = BgpAttributes_ExtendedCommunity_Color{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[66]
+		mi := &file_ate_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6798,7 +10072,7 @@ func (x *BgpAttributes_ExtendedCommunity_Color) String() string {
 func (*BgpAttributes_ExtendedCommunity_Color) ProtoMessage() {}
 
 func (x *BgpAttributes_ExtendedCommunity_Color) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[66]
+	mi := &file_ate_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6811,7 +10085,7 @@ func (x *BgpAttributes_ExtendedCommunity_Color) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use BgpAttributes_ExtendedCommunity_Color.ProtoReflect.Descriptor instead.
 func (*BgpAttributes_ExtendedCommunity_Color) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{16, 0, 0}
+	return file_ate_proto_rawDescGZIP(), []int{29, 0, 0}
 }
 
 func (x *BgpAttributes_ExtendedCommunity_Color) GetCoBits() BgpAttributes_ExtendedCommunity_Color_CoBits {
@@ -6847,7 +10121,7 @@ type RsvpConfig_Loopback struct {
 func (x *RsvpConfig_Loopback) Reset() {
 	*x = RsvpConfig_Loopback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[67]
+		mi := &file_ate_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6860,7 +10134,7 @@ func (x *RsvpConfig_Loopback) String() string {
 func (*RsvpConfig_Loopback) ProtoMessage() {}
 
 func (x *RsvpConfig_Loopback) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[67]
+	mi := &file_ate_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6873,7 +10147,7 @@ func (x *RsvpConfig_Loopback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsvpConfig_Loopback.ProtoReflect.Descriptor instead.
 func (*RsvpConfig_Loopback) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{17, 0}
+	return file_ate_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *RsvpConfig_Loopback) GetLocalIpCidr() string {
@@ -6909,7 +10183,7 @@ type RsvpConfig_Loopback_IngressLSP struct {
 func (x *RsvpConfig_Loopback_IngressLSP) Reset() {
 	*x = RsvpConfig_Loopback_IngressLSP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[68]
+		mi := &file_ate_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6922,7 +10196,7 @@ func (x *RsvpConfig_Loopback_IngressLSP) String() string {
 func (*RsvpConfig_Loopback_IngressLSP) ProtoMessage() {}
 
 func (x *RsvpConfig_Loopback_IngressLSP) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[68]
+	mi := &file_ate_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6935,7 +10209,7 @@ func (x *RsvpConfig_Loopback_IngressLSP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsvpConfig_Loopback_IngressLSP.ProtoReflect.Descriptor instead.
 func (*RsvpConfig_Loopback_IngressLSP) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{17, 0, 0}
+	return file_ate_proto_rawDescGZIP(), []int{30, 0, 0}
 }
 
 func (x *RsvpConfig_Loopback_IngressLSP) GetRemoteIpCidr() string {
@@ -7012,7 +10286,7 @@ type RsvpConfig_Loopback_IngressLSP_ERO struct {
 func (x *RsvpConfig_Loopback_IngressLSP_ERO) Reset() {
 	*x = RsvpConfig_Loopback_IngressLSP_ERO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[69]
+		mi := &file_ate_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7025,7 +10299,7 @@ func (x *RsvpConfig_Loopback_IngressLSP_ERO) String() string {
 func (*RsvpConfig_Loopback_IngressLSP_ERO) ProtoMessage() {}
 
 func (x *RsvpConfig_Loopback_IngressLSP_ERO) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[69]
+	mi := &file_ate_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7038,7 +10312,7 @@ func (x *RsvpConfig_Loopback_IngressLSP_ERO) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RsvpConfig_Loopback_IngressLSP_ERO.ProtoReflect.Descriptor instead.
 func (*RsvpConfig_Loopback_IngressLSP_ERO) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{17, 0, 0, 0}
+	return file_ate_proto_rawDescGZIP(), []int{30, 0, 0, 0}
 }
 
 func (x *RsvpConfig_Loopback_IngressLSP_ERO) GetIpv4Cidr() string {
@@ -7059,7 +10333,7 @@ type RsvpConfig_Loopback_IngressLSP_RRO struct {
 func (x *RsvpConfig_Loopback_IngressLSP_RRO) Reset() {
 	*x = RsvpConfig_Loopback_IngressLSP_RRO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[70]
+		mi := &file_ate_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7072,7 +10346,7 @@ func (x *RsvpConfig_Loopback_IngressLSP_RRO) String() string {
 func (*RsvpConfig_Loopback_IngressLSP_RRO) ProtoMessage() {}
 
 func (x *RsvpConfig_Loopback_IngressLSP_RRO) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[70]
+	mi := &file_ate_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7085,7 +10359,7 @@ func (x *RsvpConfig_Loopback_IngressLSP_RRO) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RsvpConfig_Loopback_IngressLSP_RRO.ProtoReflect.Descriptor instead.
 func (*RsvpConfig_Loopback_IngressLSP_RRO) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{17, 0, 0, 1}
+	return file_ate_proto_rawDescGZIP(), []int{30, 0, 0, 1}
 }
 
 func (x *RsvpConfig_Loopback_IngressLSP_RRO) GetIpv4() string {
@@ -7108,7 +10382,7 @@ type Network_ImportedBgpRoutes struct {
 func (x *Network_ImportedBgpRoutes) Reset() {
 	*x = Network_ImportedBgpRoutes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[71]
+		mi := &file_ate_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7121,7 +10395,7 @@ func (x *Network_ImportedBgpRoutes) String() string {
 func (*Network_ImportedBgpRoutes) ProtoMessage() {}
 
 func (x *Network_ImportedBgpRoutes) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[71]
+	mi := &file_ate_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7134,7 +10408,7 @@ func (x *Network_ImportedBgpRoutes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Network_ImportedBgpRoutes.ProtoReflect.Descriptor instead.
 func (*Network_ImportedBgpRoutes) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{18, 0}
+	return file_ate_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *Network_ImportedBgpRoutes) GetRouteTableFormat() Network_ImportedBgpRoutes_RouteTableFormat {
@@ -7158,6 +10432,73 @@ func (x *Network_ImportedBgpRoutes) GetIpv6RoutesPath() string {
 	return ""
 }
 
+type GeneratedBgpRoutes_Prefixes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address from which consecutive prefixes are allocated.
+	StartAddress string `protobuf:"bytes,1,opt,name=start_address,json=startAddress,proto3" json:"start_address,omitempty"`
+	// The total number of prefixes to generate.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The relative weights of the lengths of the generated prefixes, keyed by
+	// prefix length.
+	PrefixLengthWeights map[uint32]uint32 `protobuf:"bytes,3,rep,name=prefix_length_weights,json=prefixLengthWeights,proto3" json:"prefix_length_weights,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GeneratedBgpRoutes_Prefixes) Reset() {
+	*x = GeneratedBgpRoutes_Prefixes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratedBgpRoutes_Prefixes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedBgpRoutes_Prefixes) ProtoMessage() {}
+
+func (x *GeneratedBgpRoutes_Prefixes) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedBgpRoutes_Prefixes.ProtoReflect.Descriptor instead.
+func (*GeneratedBgpRoutes_Prefixes) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *GeneratedBgpRoutes_Prefixes) GetStartAddress() string {
+	if x != nil {
+		return x.StartAddress
+	}
+	return ""
+}
+
+func (x *GeneratedBgpRoutes_Prefixes) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GeneratedBgpRoutes_Prefixes) GetPrefixLengthWeights() map[uint32]uint32 {
+	if x != nil {
+		return x.PrefixLengthWeights
+	}
+	return nil
+}
+
 type Flow_Endpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7169,13 +10510,15 @@ type Flow_Endpoint struct {
 	// Types that are assignable to Generated:
 	//	*Flow_Endpoint_NetworkName
 	//	*Flow_Endpoint_RsvpName
+	//	*Flow_Endpoint_IgmpGroups
+	//	*Flow_Endpoint_MldGroups
 	Generated isFlow_Endpoint_Generated `protobuf_oneof:"generated"`
 }
 
 func (x *Flow_Endpoint) Reset() {
 	*x = Flow_Endpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[72]
+		mi := &file_ate_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7188,7 +10531,7 @@ func (x *Flow_Endpoint) String() string {
 func (*Flow_Endpoint) ProtoMessage() {}
 
 func (x *Flow_Endpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[72]
+	mi := &file_ate_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7201,7 +10544,7 @@ func (x *Flow_Endpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Flow_Endpoint.ProtoReflect.Descriptor instead.
 func (*Flow_Endpoint) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{21, 0}
+	return file_ate_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *Flow_Endpoint) GetInterfaceName() string {
@@ -7232,6 +10575,20 @@ func (x *Flow_Endpoint) GetRsvpName() string {
 	return ""
 }
 
+func (x *Flow_Endpoint) GetIgmpGroups() bool {
+	if x, ok := x.GetGenerated().(*Flow_Endpoint_IgmpGroups); ok {
+		return x.IgmpGroups
+	}
+	return false
+}
+
+func (x *Flow_Endpoint) GetMldGroups() bool {
+	if x, ok := x.GetGenerated().(*Flow_Endpoint_MldGroups); ok {
+		return x.MldGroups
+	}
+	return false
+}
+
 type isFlow_Endpoint_Generated interface {
 	isFlow_Endpoint_Generated()
 }
@@ -7244,10 +10601,24 @@ type Flow_Endpoint_RsvpName struct {
 	RsvpName string `protobuf:"bytes,3,opt,name=rsvp_name,json=rsvpName,proto3,oneof"`
 }
 
+type Flow_Endpoint_IgmpGroups struct {
+	// The groups of the IGMP host on the interface.
+	IgmpGroups bool `protobuf:"varint,4,opt,name=igmp_groups,json=igmpGroups,proto3,oneof"`
+}
+
+type Flow_Endpoint_MldGroups struct {
+	// The groups of the MLD host on the interface.
+	MldGroups bool `protobuf:"varint,5,opt,name=mld_groups,json=mldGroups,proto3,oneof"`
+}
+
 func (*Flow_Endpoint_NetworkName) isFlow_Endpoint_Generated() {}
 
 func (*Flow_Endpoint_RsvpName) isFlow_Endpoint_Generated() {}
 
+func (*Flow_Endpoint_IgmpGroups) isFlow_Endpoint_Generated() {}
+
+func (*Flow_Endpoint_MldGroups) isFlow_Endpoint_Generated() {}
+
 type Flow_IngressTrackingFilters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7266,7 +10637,7 @@ type Flow_IngressTrackingFilters struct {
 func (x *Flow_IngressTrackingFilters) Reset() {
 	*x = Flow_IngressTrackingFilters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[73]
+		mi := &file_ate_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7279,7 +10650,7 @@ func (x *Flow_IngressTrackingFilters) String() string {
 func (*Flow_IngressTrackingFilters) ProtoMessage() {}
 
 func (x *Flow_IngressTrackingFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[73]
+	mi := &file_ate_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7292,7 +10663,7 @@ func (x *Flow_IngressTrackingFilters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Flow_IngressTrackingFilters.ProtoReflect.Descriptor instead.
 func (*Flow_IngressTrackingFilters) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{21, 1}
+	return file_ate_proto_rawDescGZIP(), []int{36, 1}
 }
 
 func (x *Flow_IngressTrackingFilters) GetMplsLabel() bool {
@@ -7363,7 +10734,7 @@ type FrameSize_Random struct {
 func (x *FrameSize_Random) Reset() {
 	*x = FrameSize_Random{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[74]
+		mi := &file_ate_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7376,7 +10747,7 @@ func (x *FrameSize_Random) String() string {
 func (*FrameSize_Random) ProtoMessage() {}
 
 func (x *FrameSize_Random) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[74]
+	mi := &file_ate_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7389,7 +10760,7 @@ func (x *FrameSize_Random) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameSize_Random.ProtoReflect.Descriptor instead.
 func (*FrameSize_Random) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{23, 0}
+	return file_ate_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *FrameSize_Random) GetMin() uint32 {
@@ -7418,7 +10789,7 @@ type FrameSize_ImixCustomEntry struct {
 func (x *FrameSize_ImixCustomEntry) Reset() {
 	*x = FrameSize_ImixCustomEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[75]
+		mi := &file_ate_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7431,7 +10802,7 @@ func (x *FrameSize_ImixCustomEntry) String() string {
 func (*FrameSize_ImixCustomEntry) ProtoMessage() {}
 
 func (x *FrameSize_ImixCustomEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[75]
+	mi := &file_ate_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7444,7 +10815,7 @@ func (x *FrameSize_ImixCustomEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameSize_ImixCustomEntry.ProtoReflect.Descriptor instead.
 func (*FrameSize_ImixCustomEntry) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{23, 1}
+	return file_ate_proto_rawDescGZIP(), []int{40, 1}
 }
 
 func (x *FrameSize_ImixCustomEntry) GetSize() uint32 {
@@ -7472,7 +10843,7 @@ type FrameSize_ImixCustom struct {
 func (x *FrameSize_ImixCustom) Reset() {
 	*x = FrameSize_ImixCustom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[76]
+		mi := &file_ate_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7485,7 +10856,7 @@ func (x *FrameSize_ImixCustom) String() string {
 func (*FrameSize_ImixCustom) ProtoMessage() {}
 
 func (x *FrameSize_ImixCustom) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[76]
+	mi := &file_ate_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7498,7 +10869,7 @@ func (x *FrameSize_ImixCustom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrameSize_ImixCustom.ProtoReflect.Descriptor instead.
 func (*FrameSize_ImixCustom) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{23, 2}
+	return file_ate_proto_rawDescGZIP(), []int{40, 2}
 }
 
 func (x *FrameSize_ImixCustom) GetEntries() []*FrameSize_ImixCustomEntry {
@@ -7508,6 +10879,69 @@ func (x *FrameSize_ImixCustom) GetEntries() []*FrameSize_ImixCustomEntry {
 	return nil
 }
 
+type FrameSize_Increment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min  uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max  uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Step uint32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *FrameSize_Increment) Reset() {
+	*x = FrameSize_Increment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSize_Increment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSize_Increment) ProtoMessage() {}
+
+func (x *FrameSize_Increment) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSize_Increment.ProtoReflect.Descriptor instead.
+func (*FrameSize_Increment) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{40, 3}
+}
+
+func (x *FrameSize_Increment) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *FrameSize_Increment) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FrameSize_Increment) GetStep() uint32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
 type IcmpHeader_EchoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7517,7 +10951,7 @@ type IcmpHeader_EchoReply struct {
 func (x *IcmpHeader_EchoReply) Reset() {
 	*x = IcmpHeader_EchoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[77]
+		mi := &file_ate_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7530,7 +10964,7 @@ func (x *IcmpHeader_EchoReply) String() string {
 func (*IcmpHeader_EchoReply) ProtoMessage() {}
 
 func (x *IcmpHeader_EchoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[77]
+	mi := &file_ate_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7543,7 +10977,7 @@ func (x *IcmpHeader_EchoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_EchoReply.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_EchoReply) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 0}
+	return file_ate_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type IcmpHeader_DestinationUnreachable struct {
@@ -7557,7 +10991,7 @@ type IcmpHeader_DestinationUnreachable struct {
 func (x *IcmpHeader_DestinationUnreachable) Reset() {
 	*x = IcmpHeader_DestinationUnreachable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[78]
+		mi := &file_ate_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7570,7 +11004,7 @@ func (x *IcmpHeader_DestinationUnreachable) String() string {
 func (*IcmpHeader_DestinationUnreachable) ProtoMessage() {}
 
 func (x *IcmpHeader_DestinationUnreachable) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[78]
+	mi := &file_ate_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7583,7 +11017,7 @@ func (x *IcmpHeader_DestinationUnreachable) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use IcmpHeader_DestinationUnreachable.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_DestinationUnreachable) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 1}
+	return file_ate_proto_rawDescGZIP(), []int{52, 1}
 }
 
 func (x *IcmpHeader_DestinationUnreachable) GetCode() IcmpHeader_DestinationUnreachable_Code {
@@ -7605,7 +11039,7 @@ type IcmpHeader_RedirectMessage struct {
 func (x *IcmpHeader_RedirectMessage) Reset() {
 	*x = IcmpHeader_RedirectMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[79]
+		mi := &file_ate_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7618,7 +11052,7 @@ func (x *IcmpHeader_RedirectMessage) String() string {
 func (*IcmpHeader_RedirectMessage) ProtoMessage() {}
 
 func (x *IcmpHeader_RedirectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[79]
+	mi := &file_ate_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7631,7 +11065,7 @@ func (x *IcmpHeader_RedirectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_RedirectMessage.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_RedirectMessage) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 2}
+	return file_ate_proto_rawDescGZIP(), []int{52, 2}
 }
 
 func (x *IcmpHeader_RedirectMessage) GetCode() IcmpHeader_RedirectMessage_Code {
@@ -7657,7 +11091,7 @@ type IcmpHeader_EchoRequest struct {
 func (x *IcmpHeader_EchoRequest) Reset() {
 	*x = IcmpHeader_EchoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[80]
+		mi := &file_ate_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7670,7 +11104,7 @@ func (x *IcmpHeader_EchoRequest) String() string {
 func (*IcmpHeader_EchoRequest) ProtoMessage() {}
 
 func (x *IcmpHeader_EchoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[80]
+	mi := &file_ate_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7683,7 +11117,7 @@ func (x *IcmpHeader_EchoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_EchoRequest.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_EchoRequest) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 3}
+	return file_ate_proto_rawDescGZIP(), []int{52, 3}
 }
 
 type IcmpHeader_TimeExceeded struct {
@@ -7697,7 +11131,7 @@ type IcmpHeader_TimeExceeded struct {
 func (x *IcmpHeader_TimeExceeded) Reset() {
 	*x = IcmpHeader_TimeExceeded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[81]
+		mi := &file_ate_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7710,7 +11144,7 @@ func (x *IcmpHeader_TimeExceeded) String() string {
 func (*IcmpHeader_TimeExceeded) ProtoMessage() {}
 
 func (x *IcmpHeader_TimeExceeded) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[81]
+	mi := &file_ate_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7723,7 +11157,7 @@ func (x *IcmpHeader_TimeExceeded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_TimeExceeded.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_TimeExceeded) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 4}
+	return file_ate_proto_rawDescGZIP(), []int{52, 4}
 }
 
 func (x *IcmpHeader_TimeExceeded) GetCode() IcmpHeader_TimeExceeded_Code {
@@ -7744,7 +11178,7 @@ type IcmpHeader_ParameterProblem struct {
 func (x *IcmpHeader_ParameterProblem) Reset() {
 	*x = IcmpHeader_ParameterProblem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[82]
+		mi := &file_ate_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7757,7 +11191,7 @@ func (x *IcmpHeader_ParameterProblem) String() string {
 func (*IcmpHeader_ParameterProblem) ProtoMessage() {}
 
 func (x *IcmpHeader_ParameterProblem) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[82]
+	mi := &file_ate_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7770,7 +11204,7 @@ func (x *IcmpHeader_ParameterProblem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_ParameterProblem.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_ParameterProblem) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 5}
+	return file_ate_proto_rawDescGZIP(), []int{52, 5}
 }
 
 func (x *IcmpHeader_ParameterProblem) GetPointer() uint32 {
@@ -7793,7 +11227,7 @@ type IcmpHeader_Timestamp struct {
 func (x *IcmpHeader_Timestamp) Reset() {
 	*x = IcmpHeader_Timestamp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[83]
+		mi := &file_ate_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7806,7 +11240,7 @@ func (x *IcmpHeader_Timestamp) String() string {
 func (*IcmpHeader_Timestamp) ProtoMessage() {}
 
 func (x *IcmpHeader_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[83]
+	mi := &file_ate_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7819,7 +11253,7 @@ func (x *IcmpHeader_Timestamp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_Timestamp.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_Timestamp) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 6}
+	return file_ate_proto_rawDescGZIP(), []int{52, 6}
 }
 
 func (x *IcmpHeader_Timestamp) GetId() uint32 {
@@ -7858,7 +11292,7 @@ type IcmpHeader_TimestampReply struct {
 func (x *IcmpHeader_TimestampReply) Reset() {
 	*x = IcmpHeader_TimestampReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[84]
+		mi := &file_ate_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7871,7 +11305,7 @@ func (x *IcmpHeader_TimestampReply) String() string {
 func (*IcmpHeader_TimestampReply) ProtoMessage() {}
 
 func (x *IcmpHeader_TimestampReply) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[84]
+	mi := &file_ate_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7884,7 +11318,7 @@ func (x *IcmpHeader_TimestampReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpHeader_TimestampReply.ProtoReflect.Descriptor instead.
 func (*IcmpHeader_TimestampReply) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{35, 7}
+	return file_ate_proto_rawDescGZIP(), []int{52, 7}
 }
 
 func (x *IcmpHeader_TimestampReply) GetId() uint32 {
@@ -7939,7 +11373,7 @@ type OspfHeader_Hello struct {
 func (x *OspfHeader_Hello) Reset() {
 	*x = OspfHeader_Hello{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[85]
+		mi := &file_ate_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7952,7 +11386,7 @@ func (x *OspfHeader_Hello) String() string {
 func (*OspfHeader_Hello) ProtoMessage() {}
 
 func (x *OspfHeader_Hello) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[85]
+	mi := &file_ate_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7965,7 +11399,7 @@ func (x *OspfHeader_Hello) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader_Hello.ProtoReflect.Descriptor instead.
 func (*OspfHeader_Hello) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 0}
+	return file_ate_proto_rawDescGZIP(), []int{53, 0}
 }
 
 func (x *OspfHeader_Hello) GetNetworkMaskLength() uint32 {
@@ -8032,7 +11466,7 @@ type OspfHeader_DatabaseDescription struct {
 func (x *OspfHeader_DatabaseDescription) Reset() {
 	*x = OspfHeader_DatabaseDescription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[86]
+		mi := &file_ate_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8045,7 +11479,7 @@ func (x *OspfHeader_DatabaseDescription) String() string {
 func (*OspfHeader_DatabaseDescription) ProtoMessage() {}
 
 func (x *OspfHeader_DatabaseDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[86]
+	mi := &file_ate_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8058,7 +11492,7 @@ func (x *OspfHeader_DatabaseDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader_DatabaseDescription.ProtoReflect.Descriptor instead.
 func (*OspfHeader_DatabaseDescription) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 1}
+	return file_ate_proto_rawDescGZIP(), []int{53, 1}
 }
 
 func (x *OspfHeader_DatabaseDescription) GetMtu() uint32 {
@@ -8109,7 +11543,7 @@ type OspfHeader_LinkStateRequest struct {
 func (x *OspfHeader_LinkStateRequest) Reset() {
 	*x = OspfHeader_LinkStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[87]
+		mi := &file_ate_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8122,7 +11556,7 @@ func (x *OspfHeader_LinkStateRequest) String() string {
 func (*OspfHeader_LinkStateRequest) ProtoMessage() {}
 
 func (x *OspfHeader_LinkStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[87]
+	mi := &file_ate_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8135,7 +11569,7 @@ func (x *OspfHeader_LinkStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader_LinkStateRequest.ProtoReflect.Descriptor instead.
 func (*OspfHeader_LinkStateRequest) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 2}
+	return file_ate_proto_rawDescGZIP(), []int{53, 2}
 }
 
 func (x *OspfHeader_LinkStateRequest) GetType() OspfHeader_LinkStateType {
@@ -8174,7 +11608,7 @@ type OspfHeader_LinkStateAdvertisementHeader struct {
 func (x *OspfHeader_LinkStateAdvertisementHeader) Reset() {
 	*x = OspfHeader_LinkStateAdvertisementHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[88]
+		mi := &file_ate_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8187,7 +11621,7 @@ func (x *OspfHeader_LinkStateAdvertisementHeader) String() string {
 func (*OspfHeader_LinkStateAdvertisementHeader) ProtoMessage() {}
 
 func (x *OspfHeader_LinkStateAdvertisementHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[88]
+	mi := &file_ate_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8200,7 +11634,7 @@ func (x *OspfHeader_LinkStateAdvertisementHeader) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use OspfHeader_LinkStateAdvertisementHeader.ProtoReflect.Descriptor instead.
 func (*OspfHeader_LinkStateAdvertisementHeader) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 3}
+	return file_ate_proto_rawDescGZIP(), []int{53, 3}
 }
 
 func (x *OspfHeader_LinkStateAdvertisementHeader) GetAgeSeconds() uint32 {
@@ -8249,7 +11683,7 @@ type OspfHeader_LinkStateUpdate struct {
 func (x *OspfHeader_LinkStateUpdate) Reset() {
 	*x = OspfHeader_LinkStateUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[89]
+		mi := &file_ate_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8262,7 +11696,7 @@ func (x *OspfHeader_LinkStateUpdate) String() string {
 func (*OspfHeader_LinkStateUpdate) ProtoMessage() {}
 
 func (x *OspfHeader_LinkStateUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[89]
+	mi := &file_ate_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8275,7 +11709,7 @@ func (x *OspfHeader_LinkStateUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader_LinkStateUpdate.ProtoReflect.Descriptor instead.
 func (*OspfHeader_LinkStateUpdate) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 4}
+	return file_ate_proto_rawDescGZIP(), []int{53, 4}
 }
 
 func (x *OspfHeader_LinkStateUpdate) GetAdvertisements() []*OspfHeader_LinkStateUpdate_Advertisement {
@@ -8296,7 +11730,7 @@ type OspfHeader_LinkStateAck struct {
 func (x *OspfHeader_LinkStateAck) Reset() {
 	*x = OspfHeader_LinkStateAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[90]
+		mi := &file_ate_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8309,7 +11743,7 @@ func (x *OspfHeader_LinkStateAck) String() string {
 func (*OspfHeader_LinkStateAck) ProtoMessage() {}
 
 func (x *OspfHeader_LinkStateAck) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[90]
+	mi := &file_ate_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8322,7 +11756,7 @@ func (x *OspfHeader_LinkStateAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OspfHeader_LinkStateAck.ProtoReflect.Descriptor instead.
 func (*OspfHeader_LinkStateAck) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 5}
+	return file_ate_proto_rawDescGZIP(), []int{53, 5}
 }
 
 func (x *OspfHeader_LinkStateAck) GetHeaders() []*OspfHeader_LinkStateAdvertisementHeader {
@@ -8343,7 +11777,7 @@ type OspfHeader_LinkStateUpdate_Advertisement struct {
 func (x *OspfHeader_LinkStateUpdate_Advertisement) Reset() {
 	*x = OspfHeader_LinkStateUpdate_Advertisement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[91]
+		mi := &file_ate_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8356,7 +11790,7 @@ func (x *OspfHeader_LinkStateUpdate_Advertisement) String() string {
 func (*OspfHeader_LinkStateUpdate_Advertisement) ProtoMessage() {}
 
 func (x *OspfHeader_LinkStateUpdate_Advertisement) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[91]
+	mi := &file_ate_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8369,7 +11803,7 @@ func (x *OspfHeader_LinkStateUpdate_Advertisement) ProtoReflect() protoreflect.M
 
 // Deprecated: Use OspfHeader_LinkStateUpdate_Advertisement.ProtoReflect.Descriptor instead.
 func (*OspfHeader_LinkStateUpdate_Advertisement) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{36, 4, 0}
+	return file_ate_proto_rawDescGZIP(), []int{53, 4, 0}
 }
 
 func (x *OspfHeader_LinkStateUpdate_Advertisement) GetHeader() *OspfHeader_LinkStateAdvertisementHeader {
@@ -8388,7 +11822,7 @@ type PimHeader_Hello struct {
 func (x *PimHeader_Hello) Reset() {
 	*x = PimHeader_Hello{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[92]
+		mi := &file_ate_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8401,7 +11835,7 @@ func (x *PimHeader_Hello) String() string {
 func (*PimHeader_Hello) ProtoMessage() {}
 
 func (x *PimHeader_Hello) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[92]
+	mi := &file_ate_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8414,7 +11848,7 @@ func (x *PimHeader_Hello) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PimHeader_Hello.ProtoReflect.Descriptor instead.
 func (*PimHeader_Hello) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{38, 0}
+	return file_ate_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type LdpHeader_Hello struct {
@@ -8430,7 +11864,7 @@ type LdpHeader_Hello struct {
 func (x *LdpHeader_Hello) Reset() {
 	*x = LdpHeader_Hello{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ate_proto_msgTypes[93]
+		mi := &file_ate_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8443,7 +11877,7 @@ func (x *LdpHeader_Hello) String() string {
 func (*LdpHeader_Hello) ProtoMessage() {}
 
 func (x *LdpHeader_Hello) ProtoReflect() protoreflect.Message {
-	mi := &file_ate_proto_msgTypes[93]
+	mi := &file_ate_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8456,7 +11890,7 @@ func (x *LdpHeader_Hello) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LdpHeader_Hello.ProtoReflect.Descriptor instead.
 func (*LdpHeader_Hello) Descriptor() ([]byte, []int) {
-	return file_ate_proto_rawDescGZIP(), []int{39, 0}
+	return file_ate_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *LdpHeader_Hello) GetHoldTimeSec() uint32 {
@@ -8480,6 +11914,63 @@ func (x *LdpHeader_Hello) GetRequestTargeted() bool {
 	return false
 }
 
+// The PDU session container extension header of 5G user plane traffic.
+type GtpuHeader_PduSessionContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PduType GtpuHeader_PduSessionContainer_PduType `protobuf:"varint,1,opt,name=pdu_type,json=pduType,proto3,enum=ondatra.GtpuHeader_PduSessionContainer_PduType" json:"pdu_type,omitempty"`
+	// The QoS flow identifier.
+	Qfi *UIntRange `protobuf:"bytes,2,opt,name=qfi,proto3" json:"qfi,omitempty"`
+}
+
+func (x *GtpuHeader_PduSessionContainer) Reset() {
+	*x = GtpuHeader_PduSessionContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ate_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GtpuHeader_PduSessionContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GtpuHeader_PduSessionContainer) ProtoMessage() {}
+
+func (x *GtpuHeader_PduSessionContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_ate_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GtpuHeader_PduSessionContainer.ProtoReflect.Descriptor instead.
+func (*GtpuHeader_PduSessionContainer) Descriptor() ([]byte, []int) {
+	return file_ate_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *GtpuHeader_PduSessionContainer) GetPduType() GtpuHeader_PduSessionContainer_PduType {
+	if x != nil {
+		return x.PduType
+	}
+	return GtpuHeader_PduSessionContainer_PDU_TYPE_UNSPECIFIED
+}
+
+func (x *GtpuHeader_PduSessionContainer) GetQfi() *UIntRange {
+	if x != nil {
+		return x.Qfi
+	}
+	return nil
+}
+
 var File_ate_proto protoreflect.FileDescriptor
 
 var file_ate_proto_rawDesc = []byte{
@@ -8495,47 +11986,264 @@ var file_ate_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x07, 0x54, 0x72, 0x61,
 	0x66, 0x66, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x6c,
-	0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x78, 0x0a, 0x03, 0x4c, 0x61, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x61,
-	0x63, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x4c, 0x61, 0x67, 0x2e, 0x4c, 0x61, 0x63, 0x70, 0x52, 0x04, 0x6c, 0x61, 0x63,
-	0x70, 0x1a, 0x20, 0x0a, 0x04, 0x4c, 0x61, 0x63, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0xfd, 0x03, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x70,
-	0x76, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x49, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x69, 0x70, 0x76,
-	0x34, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34,
-	0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x34, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61,
-	0x63, 0x6b, 0x43, 0x69, 0x64, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6c,
-	0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b,
-	0x43, 0x69, 0x64, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49,
-	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x69, 0x73, 0x69, 0x73, 0x12, 0x24, 0x0a,
-	0x03, 0x62, 0x67, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64,
-	0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03,
-	0x62, 0x67, 0x70, 0x12, 0x27, 0x0a, 0x04, 0x72, 0x73, 0x76, 0x70, 0x18, 0x0c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x72, 0x73, 0x76, 0x70, 0x12, 0x2c, 0x0a, 0x08,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x63, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x61, 0x63, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x6c,
-	0x69, 0x6e, 0x6b, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x03, 0x4c, 0x61,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6c,
+	0x61, 0x63, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x4c, 0x61, 0x67, 0x2e, 0x4c, 0x61, 0x63, 0x70, 0x52, 0x04, 0x6c, 0x61,
+	0x63, 0x70, 0x1a, 0x57, 0x0a, 0x04, 0x4c, 0x61, 0x63, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x22, 0xd3, 0x06, 0x0a, 0x0f,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x61, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x33, 0x0a,
+	0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x70, 0x76,
+	0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x49, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70,
+	0x76, 0x34, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x69, 0x64, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x63, 0x69, 0x64, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36,
+	0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x69, 0x64, 0x72, 0x12, 0x27, 0x0a, 0x04,
+	0x69, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x04, 0x69, 0x73, 0x69, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x62, 0x67, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x62, 0x67, 0x70, 0x12, 0x27, 0x0a, 0x04, 0x72,
+	0x73, 0x76, 0x70, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04,
+	0x72, 0x73, 0x76, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x63,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x61, 0x63, 0x70, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x68, 0x63, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x68, 0x63, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x64, 0x68, 0x63, 0x70, 0x12, 0x24, 0x0a, 0x03,
+	0x62, 0x66, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x42, 0x66, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x62,
+	0x66, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x69, 0x67, 0x6d, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x49, 0x67, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x69, 0x67, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x6c, 0x64, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x4d, 0x6c, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x6d, 0x6c, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x70,
+	0x69, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x50, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x70, 0x69,
+	0x6d, 0x12, 0x24, 0x0a, 0x03, 0x6c, 0x64, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4c, 0x64, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x03, 0x6c, 0x64, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x64, 0x6f, 0x74, 0x31, 0x78,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x44, 0x6f, 0x74, 0x31, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x64, 0x6f,
+	0x74, 0x31, 0x78, 0x12, 0x24, 0x0a, 0x03, 0x70, 0x74, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x74, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x70, 0x74, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0xcb, 0x03, 0x0a, 0x0a, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x37, 0x0a, 0x09, 0x76, 0x34, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x68,
+	0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x76, 0x34, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x36, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x36, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x34, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x44, 0x68, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x08, 0x76, 0x34, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x76,
+	0x36, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x08, 0x76, 0x36, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x1a, 0x41, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x61, 0x70, 0x69,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x1a, 0x95, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x22,
+	0x93, 0x02, 0x0a, 0x09, 0x42, 0x66, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a,
+	0x0e, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x78, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x48, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x34, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x36, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x49, 0x67, 0x6d, 0x70, 0x48, 0x6f,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x49, 0x67, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x32, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x32, 0x10, 0x01,
+	0x12, 0x06, 0x0a, 0x02, 0x56, 0x33, 0x10, 0x02, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x4d, 0x6c, 0x64,
+	0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4d, 0x6c, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x32, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10,
+	0x01, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x32, 0x10, 0x02, 0x22, 0x7b, 0x0a, 0x0e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x22, 0xaa, 0x05, 0x0a, 0x09, 0x50, 0x69, 0x6d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
+	0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x66, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x66, 0x64,
+	0x12, 0x3d, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x50, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x12,
+	0x43, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x50, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x70, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x70, 0x73, 0x1a, 0xb4, 0x02, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x50, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x22, 0x3c, 0x0a,
+	0x09, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x52, 0x5f, 0x47,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x5f, 0x47, 0x10, 0x02, 0x1a, 0x66, 0x0a, 0x0b, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0xb1, 0x02, 0x0a, 0x09, 0x4c, 0x64, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x73, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x73, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c,
+	0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13,
+	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x16, 0x6b,
+	0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6b, 0x65, 0x65,
+	0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x12, 0x35, 0x0a, 0x17, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x14, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6c,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x66, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x42, 0x66, 0x64, 0x22, 0xc1, 0x04, 0x0a, 0x09, 0x50, 0x74, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x74,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x50, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4a,
+	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x50, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x31, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x31,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x32, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x32, 0x12, 0x2a,
+	0x0a, 0x11, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x67, 0x53, 0x79,
+	0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f,
+	0x67, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x6f, 0x67, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x33,
+	0x0a, 0x16, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x6c, 0x6f, 0x67, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x33, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x10, 0x02, 0x22, 0x2d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45,
+	0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x44,
+	0x5f, 0x54, 0x4f, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x45,
+	0x52, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x01, 0x22, 0x95, 0x02, 0x0a, 0x0b,
+	0x44, 0x6f, 0x74, 0x31, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0a, 0x65,
+	0x61, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x6f, 0x74, 0x31, 0x78, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x09, 0x65, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a,
+	0x09, 0x45, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x41,
+	0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x41, 0x50, 0x5f, 0x56, 0x30, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x45, 0x41, 0x50, 0x5f, 0x56, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x54, 0x4c,
+	0x53, 0x10, 0x04, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49,
@@ -8616,755 +12324,1008 @@ var file_ate_proto_rawDesc = []byte{
 	0x61, 0x6b, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6b, 0x31, 0x32, 0x38,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6b, 0x31, 0x32, 0x38, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x69,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x43, 0x69, 0x64, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0x8d,
-	0x08, 0x0a, 0x0a, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f,
+	0x73, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x49, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x43, 0x69, 0x64, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12,
+	0x4f, 0x0a, 0x12, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x6e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x22, 0x86, 0x02, 0x0a, 0x17, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x1a,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x61,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x73, 0x52,
+	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf4, 0x0a, 0x0a, 0x0a, 0x49, 0x53,
+	0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69,
+	0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x42, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x65, 0x6c,
+	0x6c, 0x6f, 0x5f, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x50, 0x61, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x73, 0x70,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x4c, 0x73, 0x70, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x69, 0x70, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x52, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x69, 0x73, 0x52, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65, 0x6c,
+	0x6c, 0x6f, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x66, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x66, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x72,
+	0x65, 0x61, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x65, 0x61, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x46, 0x0a, 0x10, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f,
 	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x65, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x57, 0x69, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x42, 0x0a,
-	0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53,
-	0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49,
-	0x53, 0x49, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x6c, 0x73, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x63, 0x61, 0x72, 0x64, 0x4c, 0x73, 0x70, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x69,
-	0x70, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49,
-	0x50, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x69,
-	0x70, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a,
-	0x0f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
-	0x2e, 0x49, 0x53, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x0e, 0x69, 0x73, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
-	0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2c,
-	0x0a, 0x12, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x5f, 0x73, 0x65, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c,
-	0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x2a, 0x0a, 0x11,
-	0x64, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
-	0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e,
-	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30,
-	0x0a, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x2e, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56,
-	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x06, 0x0a, 0x02, 0x4c, 0x31, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x02,
-	0x22, 0x4e, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x18, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02,
-	0x22, 0x3c, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
-	0x41, 0x55, 0x54, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x22, 0xcf,
-	0x07, 0x0a, 0x12, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4d, 0x0a,
-	0x0d, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49,
-	0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x49, 0x44, 0x52, 0x0c,
-	0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f,
-	0x73, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x72, 0x67, 0x62, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09,
-	0x73, 0x72, 0x67, 0x62, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x72, 0x6c,
-	0x62, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x49, 0x44, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x09, 0x73, 0x72, 0x6c, 0x62, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
-	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x52, 0x65,
-	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x61,
-	0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x66, 0x6c, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x12, 0x1e, 0x0a,
-	0x0b, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x68, 0x70, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x4e, 0x6f, 0x50, 0x68, 0x70, 0x12, 0x2c, 0x0a,
-	0x12, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x6e,
-	0x75, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x45,
-	0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
-	0x6c, 0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x31, 0x10, 0x01,
+	0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x31, 0x4c, 0x32,
+	0x10, 0x03, 0x22, 0x4e, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x10, 0x02, 0x22, 0x3c, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02,
+	0x22, 0xf9, 0x01, 0x0a, 0x16, 0x49, 0x53, 0x49, 0x53, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x42, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x72, 0x6c, 0x67, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x72, 0x6c, 0x67, 0x73, 0x22, 0xcf, 0x07, 0x0a,
+	0x12, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x61,
+	0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49,
+	0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x49, 0x44, 0x52, 0x0c, 0x61, 0x64,
+	0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69,
+	0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x72, 0x67, 0x62, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x73, 0x72,
+	0x67, 0x62, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x72, 0x6c, 0x62, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x09, 0x73, 0x72, 0x6c, 0x62, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x6c, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x61, 0x67, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x66, 0x6c, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x68, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x4e, 0x6f, 0x50, 0x68, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x6e, 0x75, 0x6c,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x45, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66,
+	0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c,
+	0x61, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x53, 0x69, 0x64, 0x1a, 0xb4, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x6a, 0x61, 0x63,
+	0x65, 0x6e, 0x63, 0x79, 0x53, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x19, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6c,
+	0x61, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x6c, 0x61, 0x67, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c,
+	0x61, 0x67, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x66, 0x6c, 0x61, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x09, 0x66, 0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c,
-	0x61, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x66, 0x6c, 0x61, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x61,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x69, 0x64, 0x1a, 0xb4, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x6a,
-	0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x19, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x13,
-	0x66, 0x6c, 0x61, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x6c, 0x61, 0x67, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x6c, 0x61, 0x67, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1d, 0x0a,
-	0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x66,
-	0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66,
-	0x6c, 0x61, 0x67, 0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x70,
-	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x66, 0x6c, 0x61, 0x67, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a,
-	0x4f, 0x0a, 0x08, 0x53, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73,
-	0x69, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x8f, 0x04, 0x0a, 0x0e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x46, 0x0a, 0x0c, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50, 0x52, 0x65,
-	0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6c, 0x61,
-	0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72,
-	0x74, 0x69, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x61,
-	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x67,
-	0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x68, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66,
-	0x6c, 0x61, 0x67, 0x4e, 0x6f, 0x50, 0x68, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x6c, 0x61, 0x67,
-	0x5f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63,
-	0x69, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
-	0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x47, 0x0a, 0x0b, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x55, 0x54,
-	0x45, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
-	0x10, 0x02, 0x22, 0xa0, 0x06, 0x0a, 0x0e, 0x49, 0x53, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49,
-	0x53, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0xc5, 0x05,
-	0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x23, 0x0a,
-	0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
-	0x37, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x61, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x66, 0x6c, 0x61, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x61,
+	0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c, 0x61,
+	0x67, 0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66,
+	0x6c, 0x61, 0x67, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x4f, 0x0a,
+	0x08, 0x53, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f,
+	0x04, 0x0a, 0x0e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x73, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e,
+	0x6f, 0x5f, 0x70, 0x68, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61,
+	0x67, 0x4e, 0x6f, 0x50, 0x68, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x65,
+	0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x47, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f,
+	0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x02,
+	0x22, 0xe7, 0x06, 0x0a, 0x0e, 0x49, 0x53, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x8c, 0x06, 0x0a, 0x04,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69,
+	0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
 	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x52, 0x65, 0x61, 0x63, 0x68,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x77, 0x69, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x64, 0x65, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0b, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x52, 0x65, 0x61,
-	0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x49, 0x70, 0x76,
-	0x34, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x1a, 0x72, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x72, 0x6f, 0x6d, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x69,
-	0x70, 0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x49, 0x70, 0x76,
-	0x34, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x70, 0x76, 0x36, 0x12, 0x17,
-	0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x6f, 0x49, 0x70, 0x76, 0x36, 0x1a, 0x7c, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d,
-	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e,
-	0x75, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x63,
-	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63, 0x68,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x5f, 0x61, 0x64, 0x76, 0x65,
-	0x72, 0x74, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x41,
-	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x5f, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
-	0x75, 0x62, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6c, 0x67, 0x72,
-	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6c,
-	0x67, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x6c, 0x6c,
-	0x67, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x4c, 0x6c, 0x67, 0x72,
-	0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x22, 0x3a, 0x0a, 0x09, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d,
-	0x0a, 0x09, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x08, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0xc3, 0x18,
-	0x0a, 0x07, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61,
-	0x63, 0x6b, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65,
-	0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x73, 0x6e, 0x12, 0x22, 0x0a,
-	0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x63, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b,
-	0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12,
-	0x17, 0x0a, 0x07, 0x6d, 0x64, 0x35, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x64, 0x35, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12,
+	0x30, 0x0a, 0x14, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x1a, 0xb8, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x69, 0x70,
+	0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x49, 0x70, 0x76, 0x34,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x70, 0x76, 0x36, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x6f, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x49, 0x70, 0x76, 0x36, 0x12, 0x44, 0x0a, 0x0d, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x53, 0x49, 0x53, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x06,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a,
+	0x0c, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50,
+	0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x8b, 0x03, 0x0a, 0x0c, 0x50,
+	0x6f, 0x72, 0x74, 0x4c, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x5f, 0x67, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x73, 0x70, 0x65, 0x65, 0x64, 0x47, 0x62, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x66, 0x65,
+	0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x31, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x46, 0x65, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x66, 0x65, 0x63,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67,
+	0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x31, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x07, 0x46, 0x65, 0x63, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46,
+	0x45, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x46, 0x45, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x53, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x46, 0x45, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x45,
+	0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x22, 0x70, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x4e, 0x65,
+	0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41,
+	0x55, 0x54, 0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xa2, 0x02, 0x0a, 0x0a, 0x49, 0x6d, 0x70,
+	0x61, 0x69, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x50, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x63,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x63, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x63, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x55, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xe9, 0x01,
+	0x0a, 0x0e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x5f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x75,
+	0x62, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e,
+	0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6c, 0x67, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6c, 0x67, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x6c, 0x6c, 0x67, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6e, 0x6f, 0x4c, 0x6c, 0x67, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x09, 0x42, 0x67, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x08, 0x62, 0x67, 0x70,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0xa5, 0x1a, 0x0a, 0x07, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x41, 0x73, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x6f,
+	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x65,
+	0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x64, 0x35, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x64, 0x35, 0x4b, 0x65, 0x79,
+	0x12, 0x41, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x73, 0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65,
+	0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x10, 0x73, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x66,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x66, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x1a, 0xe8, 0x08, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x75, 0x6e,
+	0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76,
+	0x34, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x34,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x70, 0x6c, 0x73,
+	0x56, 0x70, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x75, 0x6e, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x55,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x70, 0x76, 0x36, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x64, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x64, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x70, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x76, 0x70, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x70,
+	0x76, 0x34, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x56, 0x70, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x76, 0x70, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x56, 0x70, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x76,
+	0x70, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x56, 0x70, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x76, 0x70,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x76, 0x70, 0x6e, 0x12, 0x3c, 0x0a,
+	0x1b, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f,
+	0x62, 0x67, 0x70, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x17, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x42, 0x67, 0x70, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70, 0x6e, 0x12, 0x3c, 0x0a, 0x1b, 0x69,
+	0x70, 0x76, 0x36, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x67,
+	0x70, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x17, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x42,
+	0x67, 0x70, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x69, 0x70, 0x76,
+	0x34, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x70, 0x76, 0x34, 0x55,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x33,
+	0x0a, 0x16, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x69, 0x70, 0x76, 0x36, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x15, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x75, 0x6e, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x70, 0x76, 0x34, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x75,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x70, 0x76, 0x36, 0x55, 0x6e, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x73,
+	0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36,
+	0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70,
+	0x76, 0x34, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x70, 0x6c, 0x73,
+	0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f,
+	0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x70, 0x6c, 0x73, 0x41, 0x64, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c,
+	0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x1a,
+	0xe6, 0x0b, 0x0a, 0x0f, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e,
+	0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x48,
+	0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x61, 0x73, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x0a, 0x61, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65,
+	0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a,
+	0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42,
+	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x39, 0x0a, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72,
-	0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x73,
-	0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x10, 0x73, 0x72, 0x74, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0xe8, 0x08, 0x0a, 0x0c,
-	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x69, 0x70, 0x76, 0x34, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d,
-	0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
-	0x70, 0x76, 0x34, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70,
-	0x76, 0x36, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x63, 0x61, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x70, 0x6c,
-	0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x70, 0x76,
-	0x36, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x70, 0x76, 0x34,
-	0x5f, 0x6d, 0x64, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x70, 0x76, 0x34,
-	0x4d, 0x64, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x70, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x76, 0x70, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x70, 0x76, 0x34, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x56, 0x70, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
-	0x56, 0x70, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x56, 0x70, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x65, 0x76, 0x70, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x65, 0x76, 0x70, 0x6e, 0x12, 0x3c, 0x0a, 0x1b, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f,
-	0x76, 0x70, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x70, 0x76, 0x34, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x42, 0x67, 0x70, 0x4d, 0x70, 0x6c, 0x73, 0x56,
-	0x70, 0x6e, 0x12, 0x3c, 0x0a, 0x1b, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x63, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x76, 0x70,
-	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x42, 0x67, 0x70, 0x4d, 0x70, 0x6c, 0x73, 0x56, 0x70, 0x6e,
-	0x12, 0x33, 0x0a, 0x16, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x13, 0x69, 0x70, 0x76, 0x34, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x6f,
-	0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x33, 0x0a, 0x16, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x75, 0x6e,
-	0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x70, 0x76, 0x36, 0x55, 0x6e, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x15, 0x69, 0x70,
-	0x76, 0x34, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x70, 0x76, 0x34, 0x55,
-	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a,
-	0x15, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x70,
-	0x76, 0x36, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65,
-	0x78, 0x74, 0x48, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a,
-	0x10, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x73, 0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x53, 0x72, 0x74,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x76, 0x36, 0x5f,
-	0x73, 0x72, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x61,
-	0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
-	0x70, 0x76, 0x34, 0x4d, 0x70, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b,
-	0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x70, 0x76, 0x36,
-	0x4d, 0x70, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x67,
-	0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x1a, 0xe6, 0x0b, 0x0a, 0x0f, 0x53, 0x72, 0x74, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x3a,
-	0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68,
-	0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67,
-	0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f,
-	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x61, 0x73, 0x6e,
-	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x73, 0x6e,
-	0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x42, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50,
-	0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x6e,
-	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72,
-	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x45, 0x6e, 0x6c, 0x70, 0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x1a, 0x2c, 0x0a, 0x0a, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f,
+	0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x72, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x66, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x53, 0x69, 0x64, 0x12,
+	0x58, 0x0a, 0x1c, 0x66, 0x6f, 0x75, 0x72, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f, 0x73, 0x69,
+	0x64, 0x5f, 0x61, 0x73, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x17, 0x66, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x53, 0x69, 0x64, 0x41, 0x73,
+	0x4d, 0x70, 0x6c, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x08, 0x69, 0x70, 0x76,
+	0x36, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x69,
+	0x70, 0x76, 0x36, 0x53, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xdb,
+	0x03, 0x0a, 0x0b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
 	0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x45, 0x6e, 0x6c, 0x70, 0x52, 0x04, 0x65, 0x6e,
-	0x6c, 0x70, 0x1a, 0x2c, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x1a, 0x82, 0x02, 0x0a, 0x07, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0a,
-	0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x72, 0x5f, 0x6f, 0x63,
-	0x74, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e,
-	0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x6f, 0x75, 0x72, 0x4f, 0x63,
-	0x74, 0x65, 0x74, 0x53, 0x69, 0x64, 0x12, 0x58, 0x0a, 0x1c, 0x66, 0x6f, 0x75, 0x72, 0x5f, 0x6f,
-	0x63, 0x74, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x73, 0x5f, 0x6d, 0x70, 0x6c, 0x73,
-	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x63,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x17, 0x66, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74,
-	0x65, 0x74, 0x53, 0x69, 0x64, 0x41, 0x73, 0x4d, 0x70, 0x6c, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x1b, 0x0a, 0x08, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x69, 0x70, 0x76, 0x36, 0x53, 0x69, 0x64, 0x42, 0x06, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xdb, 0x03, 0x0a, 0x0b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4b, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x20, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0xf2, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x59, 0x0a, 0x08, 0x6d,
+	0x70, 0x6c, 0x73, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
 	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e,
 	0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53,
-	0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x20, 0x0a, 0x06,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0xf2,
-	0x01, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x59, 0x0a, 0x08, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42,
-	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x53, 0x72, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x53,
-	0x69, 0x64, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x70, 0x6c, 0x73, 0x53, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x08, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x07, 0x69, 0x70, 0x76, 0x36, 0x53, 0x69, 0x64, 0x1a, 0x4f, 0x0a, 0x07, 0x4d, 0x70,
-	0x6c, 0x73, 0x53, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x63, 0x12, 0x0c, 0x0a, 0x01, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x01, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x1a, 0x1a, 0x0a, 0x04, 0x45, 0x6e, 0x6c, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x65,
-	0x6e, 0x6c, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x22,
-	0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a,
-	0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01,
-	0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
-	0x4c, 0x10, 0x02, 0x22, 0xce, 0x09, 0x0a, 0x0d, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a,
-	0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x53, 0x69, 0x64, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x70, 0x6c, 0x73, 0x53, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x69, 0x70, 0x76, 0x36,
+	0x53, 0x69, 0x64, 0x1a, 0x4f, 0x0a, 0x07, 0x4d, 0x70, 0x6c, 0x73, 0x53, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x74, 0x63, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x01, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x1a, 0x0a, 0x04,
+	0x45, 0x6e, 0x6c, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x22, 0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x7f, 0x0a, 0x0b,
+	0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41,
+	0x44, 0x44, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x44,
+	0x44, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x44, 0x5f, 0x50, 0x41, 0x54,
+	0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a,
+	0x1a, 0x41, 0x44, 0x44, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x03, 0x22, 0x97, 0x0c,
+	0x0a, 0x0d, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x61,
+	0x73, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41,
+	0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x73, 0x6e, 0x53, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x73, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
 	0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x29,
-	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
-	0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x13, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x38, 0x0a, 0x0c, 0x61, 0x73, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x0a, 0x61, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x61,
-	0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
-	0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0xdb, 0x02, 0x0a, 0x11, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
-	0x12, 0x46, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0xf5, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67,
+	0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x08, 0x61, 0x64, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6d, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x52, 0x03, 0x6d, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x04, 0x61, 0x69, 0x67, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x67, 0x70, 0x52, 0x04, 0x61, 0x69, 0x67,
+	0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xdb,
+	0x02, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67,
 	0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65,
 	0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x42, 0x69, 0x74, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x42, 0x69,
-	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x62,
-	0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x42, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x61, 0x0a,
-	0x06, 0x43, 0x6f, 0x42, 0x69, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x5f, 0x42, 0x49,
-	0x54, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f, 0x30, 0x30, 0x10, 0x01,
-	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f, 0x30, 0x31, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f, 0x31, 0x30, 0x10, 0x03,
-	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f, 0x31, 0x31, 0x10, 0x04,
-	0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xe0, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x2e, 0x41, 0x73, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x73, 0x6e, 0x73, 0x22, 0x7c, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a,
-	0x19, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x43, 0x4f, 0x4e,
-	0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x22, 0x57, 0x0a, 0x06, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x47, 0x50, 0x10, 0x01, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x47, 0x50, 0x10, 0x02, 0x12, 0x15, 0x0a,
-	0x11, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x03, 0x22, 0xf1, 0x06, 0x0a, 0x0a, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30,
-	0x0a, 0x14, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x73,
-	0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x52, 0x73,
-	0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x09, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16,
-	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x62, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x72, 0x65,
-	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0xd6, 0x04, 0x0a, 0x08, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x43, 0x69, 0x64, 0x72,
-	0x12, 0x4a, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x73, 0x70, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
-	0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x6f, 0x70,
-	0x62, 0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53, 0x50, 0x52,
-	0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x73, 0x70, 0x73, 0x1a, 0xd9, 0x03, 0x0a,
-	0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53, 0x50, 0x12, 0x24, 0x0a, 0x0e, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x43, 0x69, 0x64,
-	0x72, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14,
-	0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x61, 0x73, 0x74, 0x52, 0x65, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x6f, 0x70, 0x74,
-	0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x15, 0x0a, 0x06, 0x6c, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x6c, 0x73, 0x70, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x65, 0x72, 0x6f, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
-	0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x62,
-	0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53, 0x50, 0x2e, 0x45,
-	0x52, 0x4f, 0x52, 0x04, 0x65, 0x72, 0x6f, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x72, 0x72, 0x6f, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x6c, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0xf5, 0x01, 0x0a,
+	0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x5f, 0x62, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x42, 0x69, 0x74, 0x73, 0x52, 0x06,
+	0x63, 0x6f, 0x42, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x42, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x61, 0x0a, 0x06, 0x43, 0x6f, 0x42, 0x69, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x43,
+	0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f,
+	0x30, 0x30, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f,
+	0x30, 0x31, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f,
+	0x31, 0x30, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x42, 0x49, 0x54, 0x53, 0x5f,
+	0x31, 0x31, 0x10, 0x04, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xe0, 0x01, 0x0a,
+	0x0d, 0x41, 0x73, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x73, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x73, 0x6e,
+	0x73, 0x22, 0x7c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x1a,
+	0x44, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x17, 0x0a, 0x03, 0x4d, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x65, 0x64, 0x1a, 0x1e,
+	0x0a, 0x04, 0x41, 0x69, 0x67, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x57,
+	0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x49, 0x47,
+	0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x47, 0x50, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x47, 0x50, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x22, 0xf1, 0x06, 0x0a, 0x0a, 0x52, 0x73, 0x76, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x69, 0x73, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
 	0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x6f, 0x70,
-	0x62, 0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53, 0x50, 0x2e,
-	0x52, 0x52, 0x4f, 0x52, 0x04, 0x72, 0x72, 0x6f, 0x73, 0x1a, 0x22, 0x0a, 0x03, 0x45, 0x52, 0x4f,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72, 0x1a, 0x19, 0x0a,
-	0x03, 0x52, 0x52, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x22, 0xbe, 0x05, 0x0a, 0x07, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x25, 0x0a, 0x03, 0x65, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x74,
-	0x68, 0x52, 0x03, 0x65, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x70, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x26,
-	0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x70,
-	0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x3d, 0x0a, 0x0e, 0x62, 0x67, 0x70, 0x5f, 0x61, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x62, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x69, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x50,
-	0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x04, 0x69, 0x73,
-	0x69, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0xc0, 0x02, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x12,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x10, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
-	0x28, 0x0a, 0x10, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x76,
-	0x36, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x4f, 0x55, 0x54, 0x45,
-	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52,
-	0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x4f, 0x55,
-	0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x4a, 0x55, 0x4e, 0x49, 0x50, 0x45, 0x52, 0x10, 0x02, 0x22, 0x5c, 0x0a, 0x0a, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x45, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x09, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x49, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x43, 0x69, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x07,
-	0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x72,
-	0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x77,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x73, 0x72, 0x63, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x64, 0x73, 0x74, 0x5f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x31, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72,
-	0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e,
-	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x5e, 0x0a, 0x18, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
-	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x16, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x09, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x1a, 0x82, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x72,
-	0x73, 0x76, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x08, 0x72, 0x73, 0x76, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x1a, 0xff, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x70, 0x6c, 0x73, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x73, 0x74, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x69,
-	0x70, 0x76, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x72, 0x63, 0x49, 0x70,
-	0x76, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x73, 0x74, 0x49, 0x70, 0x76, 0x34, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x72, 0x63, 0x49, 0x70, 0x76, 0x36, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f,
-	0x69, 0x70, 0x76, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x73, 0x74, 0x49,
-	0x70, 0x76, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x09, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x00, 0x52, 0x03, 0x62, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x03, 0x66, 0x70, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xdf, 0x04, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x16, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x00, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x52, 0x61, 0x6e,
-	0x64, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x40, 0x0a,
-	0x0b, 0x69, 0x6d, 0x69, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x49, 0x6d, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x74, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0x40, 0x0a, 0x0b, 0x69, 0x6d, 0x69, 0x78, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46,
-	0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x1a, 0x2c, 0x0a, 0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x4a,
-	0x0a, 0x0a, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x3c, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x09, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0xd6, 0x04, 0x0a, 0x08, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x5f, 0x63, 0x69, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x43,
+	0x69, 0x64, 0x72, 0x12, 0x4a, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c,
+	0x73, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x53, 0x50, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x73, 0x70, 0x73, 0x1a,
+	0xd9, 0x03, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53, 0x50, 0x12, 0x24,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x63, 0x69, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70,
+	0x43, 0x69, 0x64, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x14, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x73, 0x70, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x65, 0x72,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x53,
+	0x50, 0x2e, 0x45, 0x52, 0x4f, 0x52, 0x04, 0x65, 0x72, 0x6f, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x72,
+	0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x53, 0x50, 0x2e, 0x52, 0x52, 0x4f, 0x52, 0x04, 0x72, 0x72, 0x6f, 0x73, 0x1a, 0x22, 0x0a, 0x03,
+	0x45, 0x52, 0x4f, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72,
+	0x1a, 0x19, 0x0a, 0x03, 0x52, 0x52, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x22, 0xce, 0x06, 0x0a, 0x07,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x65, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x45, 0x74, 0x68, 0x52, 0x03, 0x65, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x70, 0x76,
+	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x70, 0x52, 0x04, 0x69, 0x70, 0x76,
+	0x34, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x70, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x3d, 0x0a, 0x0e, 0x62, 0x67, 0x70,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x42, 0x67, 0x70, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x62, 0x67, 0x70, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x69, 0x73, 0x69, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x49, 0x50, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x04, 0x69, 0x73, 0x69, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x21, 0x0a, 0x03, 0x6c, 0x64, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4c,
+	0x64, 0x70, 0x46, 0x65, 0x63, 0x52, 0x03, 0x6c, 0x64, 0x70, 0x12, 0x4d, 0x0a, 0x14, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0xc0, 0x02, 0x0a, 0x11, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x61, 0x0a, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70,
+	0x76, 0x34, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10,
+	0x69, 0x70, 0x76, 0x36, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4a, 0x55, 0x4e, 0x49, 0x50, 0x45, 0x52, 0x10, 0x02, 0x22, 0xab, 0x04, 0x0a,
+	0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x38, 0x0a,
+	0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x73, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x73, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x69, 0x6e, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x61, 0x73, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x61, 0x73, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73,
+	0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x73, 0x6e,
+	0x4d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x1a, 0x80, 0x02, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x71, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x1a, 0x46, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x06, 0x4c, 0x64,
+	0x70, 0x46, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5c,
+	0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x09,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x69, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xbb, 0x09, 0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x0d, 0x73, 0x72, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c,
+	0x73, 0x72, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0d,
+	0x64, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x6c,
+	0x6f, 0x77, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x73, 0x74,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x09, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x5e, 0x0a, 0x18, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x16, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x2e, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x49,
-	0x6d, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4d, 0x49,
-	0x58, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49,
-	0x4d, 0x49, 0x58, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49,
-	0x4d, 0x49, 0x58, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x49, 0x50, 0x53, 0x45, 0x43, 0x10, 0x03, 0x12, 0x0d, 0x0a,
-	0x09, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12,
-	0x49, 0x4d, 0x49, 0x58, 0x5f, 0x52, 0x50, 0x52, 0x5f, 0x51, 0x55, 0x41, 0x44, 0x4d, 0x4f, 0x44,
-	0x41, 0x4c, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x52, 0x50, 0x52,
-	0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4f, 0x44, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49,
-	0x4d, 0x49, 0x58, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x07, 0x12, 0x0c,
-	0x0a, 0x08, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a,
-	0x49, 0x4d, 0x49, 0x58, 0x5f, 0x54, 0x4f, 0x4c, 0x4c, 0x59, 0x10, 0x09, 0x42, 0x06, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x22,
-	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x70, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0b, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73,
-	0x22, 0x68, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x50,
-	0x41, 0x54, 0x54, 0x45, 0x52, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f,
-	0x55, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x55, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12,
-	0x15, 0x0a, 0x11, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x43,
-	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f,
-	0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x42, 0x10, 0x0a, 0x0e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x70, 0x22, 0x58, 0x0a, 0x0e,
-	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23,
-	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0xb2, 0x04, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x65, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x34, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x35, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x38, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x39, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x69, 0x74, 0x79, 0x1a, 0xc6, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x72, 0x73, 0x76, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x73, 0x76, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0b, 0x69, 0x67, 0x6d, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x67, 0x6d, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x1f, 0x0a, 0x0a, 0x6d, 0x6c, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x6c, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x1a, 0xff,
+	0x01, 0x0a, 0x16, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x70, 0x6c,
+	0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d,
+	0x70, 0x6c, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x72, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x64, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x49, 0x70, 0x76, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74,
+	0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x73, 0x74,
+	0x49, 0x70, 0x76, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x70, 0x76, 0x36,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x72, 0x63, 0x49, 0x70, 0x76, 0x36, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x64, 0x73, 0x74, 0x49, 0x70, 0x76, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0x9e, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x43, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49,
+	0x58, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x52, 0x42, 0x53, 0x10,
+	0x03, 0x22, 0xcf, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x42, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x47, 0x45, 0x54, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44,
+	0x50, 0x10, 0x03, 0x22, 0x57, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x03,
+	0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x03, 0x62, 0x70, 0x73,
+	0x12, 0x12, 0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x03, 0x66, 0x70, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe2, 0x05, 0x0a,
+	0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x48, 0x00, 0x52,
+	0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x0b, 0x69, 0x6d, 0x69, 0x78, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x2e, 0x49, 0x6d, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x6d, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x69, 0x6d, 0x69,
+	0x78, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x2e, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x00, 0x52,
+	0x0a, 0x69, 0x6d, 0x69, 0x78, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x3c, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x2c, 0x0a, 0x06, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x3d, 0x0a, 0x0f, 0x49, 0x6d, 0x69, 0x78, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x4a, 0x0a, 0x0a, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x12, 0x3c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x49, 0x6d, 0x69, 0x78, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x1a, 0x43, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x49, 0x6d, 0x69, 0x78,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x49, 0x58,
+	0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4d, 0x49, 0x58,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d,
+	0x49, 0x58, 0x5f, 0x49, 0x50, 0x53, 0x45, 0x43, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d,
+	0x49, 0x58, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x49,
+	0x58, 0x5f, 0x52, 0x50, 0x52, 0x5f, 0x51, 0x55, 0x41, 0x44, 0x4d, 0x4f, 0x44, 0x41, 0x4c, 0x10,
+	0x05, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x49, 0x58, 0x5f, 0x52, 0x50, 0x52, 0x5f, 0x54, 0x52,
+	0x49, 0x4d, 0x4f, 0x44, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4d, 0x49, 0x58,
+	0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x4d, 0x49, 0x58, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x49,
+	0x58, 0x5f, 0x54, 0x4f, 0x4c, 0x4c, 0x59, 0x10, 0x09, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xb6, 0x03, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x0b, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x68,
+	0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x54,
+	0x54, 0x45, 0x52, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f, 0x55, 0x53,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x55, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x44, 0x55,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x42, 0x10, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x70, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x57, 0x0a,
+	0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x54, 0x45,
+	0x52, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49,
+	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x50, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x53, 0x43, 0x50,
+	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x56, 0x4c, 0x41,
+	0x4e, 0x5f, 0x49, 0x44, 0x10, 0x03, 0x22, 0xed, 0x05, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
 	0x72, 0x12, 0x2b, 0x0a, 0x03, 0x65, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
 	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x65, 0x74, 0x68, 0x12, 0x26,
@@ -9399,368 +13360,436 @@ var file_ate_proto_rawDesc = []byte{
 	0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x70, 0x69, 0x6d, 0x12, 0x26, 0x0a, 0x03, 0x6c,
 	0x64, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
 	0x72, 0x61, 0x2e, 0x4c, 0x64, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03,
-	0x6c, 0x64, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0e,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30,
+	0x6c, 0x64, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x78, 0x6c,
+	0x61, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x76, 0x78, 0x6c, 0x61,
+	0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x76, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x65,
+	0x76, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x67, 0x74, 0x70, 0x75, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x67, 0x74, 0x70, 0x75, 0x12, 0x2f, 0x0a,
+	0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x06,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x72, 0x63,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x64,
+	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x64, 0x5f, 0x63, 0x72,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x64, 0x43, 0x72, 0x63, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x74, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2f,
+	0x0a, 0x09, 0x47, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22,
+	0xe9, 0x01, 0x0a, 0x0a, 0x49, 0x70, 0x76, 0x34, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30,
 	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72,
 	0x12, 0x30, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62,
-	0x61, 0x64, 0x5f, 0x63, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61,
-	0x64, 0x43, 0x72, 0x63, 0x22, 0x2f, 0x0a, 0x09, 0x47, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0xe9, 0x01, 0x0a, 0x0a, 0x49, 0x70, 0x76, 0x34, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6e, 0x74,
-	0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x64, 0x6f, 0x6e, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x73, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64,
-	0x73, 0x63, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x65, 0x63, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x49, 0x70, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x30, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x63, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x63, 0x6e, 0x22, 0x5a, 0x0a, 0x0a, 0x4d, 0x70,
-	0x6c, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x65, 0x78, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x7b, 0x0a, 0x09, 0x54, 0x63, 0x70, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f,
-	0x72, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x73, 0x65, 0x71, 0x22, 0x69, 0x0a, 0x09, 0x55, 0x64, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x2d, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x2d, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x6f, 0x6e, 0x74, 0x46,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x63,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x63, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x63, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xe6, 0x01, 0x0a, 0x0a,
+	0x49, 0x70, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x72,
+	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x08,
+	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x73, 0x63, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x73,
+	0x63, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x65, 0x63, 0x6e, 0x22, 0x5a, 0x0a, 0x0a, 0x4d, 0x70, 0x6c, 0x73, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x0c,
-	0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x9d, 0x0c, 0x0a,
-	0x0a, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x65,
-	0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00,
-	0x52, 0x09, 0x65, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x65, 0x0a, 0x17, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61,
-	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72,
-	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x78, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x22, 0x7b, 0x0a, 0x09, 0x54, 0x63, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a,
+	0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x08,
+	0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x69, 0x0a,
+	0x09, 0x55, 0x64, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x72,
+	0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x0c, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x9d, 0x0c, 0x0a, 0x0a, 0x49, 0x63, 0x6d, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
+	0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45,
+	0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x09, 0x65, 0x63, 0x68, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x65, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x74,
 	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64,
-	0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
-	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65,
-	0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0d, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x10,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44,
+	0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49,
+	0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e,
 	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x0b, 0x0a, 0x09, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x1a, 0x90, 0x02, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x43,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72,
-	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x4e,
-	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x48,
-	0x4f, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10,
-	0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e,
-	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50,
-	0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10,
-	0x04, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a,
-	0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x1a, 0xc4, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
-	0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x5a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x53, 0x5f, 0x41, 0x4e,
-	0x44, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x54,
-	0x4f, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x1a, 0x0d, 0x0a,
-	0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x8d, 0x01, 0x0a,
-	0x0c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x6e,
-	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x2e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
-	0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x53, 0x45, 0x4d, 0x42, 0x4c, 0x59, 0x10, 0x02, 0x1a, 0x2c, 0x0a, 0x10,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0x50, 0x0a, 0x09, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x54, 0x73, 0x1a, 0x95, 0x01, 0x0a,
-	0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65,
-	0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x54, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x54, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x5f,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
-	0x69, 0x74, 0x54, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaa, 0x0d, 0x0a,
-	0x0a, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49,
-	0x64, 0x12, 0x31, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68,
-	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x3b, 0x0a, 0x03, 0x64, 0x62, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x62,
-	0x64, 0x12, 0x38, 0x0a, 0x03, 0x6c, 0x73, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x73, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x6c,
-	0x73, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x53, 0x0a,
+	0x11, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
+	0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00,
+	0x52, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x4d, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x1a, 0x0b, 0x0a, 0x09, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x90, 0x02,
+	0x0a, 0x16, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61,
+	0x2e, 0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb0, 0x01,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x55, 0x4e,
+	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e,
+	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x46,
+	0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06,
+	0x1a, 0xc4, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x63, 0x6d,
+	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x5a, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x53, 0x5f, 0x41, 0x4e, 0x44,
+	0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x1a, 0x0d, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x8d, 0x01, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x45,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x49, 0x63, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x45,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x42, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x53, 0x45,
+	0x4d, 0x42, 0x4c, 0x59, 0x10, 0x02, 0x1a, 0x2c, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x1a, 0x50, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x73, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x54, 0x73, 0x1a, 0x95, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x54, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x54, 0x73, 0x42, 0x06,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaa, 0x0d, 0x0a, 0x0a, 0x4f, 0x73, 0x70, 0x66, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x68,
+	0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x64,
+	0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x3b,
+	0x0a, 0x03, 0x64, 0x62, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x62, 0x64, 0x12, 0x38, 0x0a, 0x03, 0x6c,
+	0x73, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74,
 	0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x03, 0x6c, 0x73, 0x75, 0x12, 0x34, 0x0a, 0x03, 0x6c, 0x73, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x73, 0x61, 0x1a, 0xcc, 0x02, 0x0a, 0x05, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x73, 0x6b, 0x4c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
-	0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x12, 0x38, 0x0a, 0x18, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x1a, 0x7f, 0x0a, 0x13, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d,
-	0x74, 0x75, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x1a, 0x9c, 0x01, 0x0a, 0x10, 0x4c,
-	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
-	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64,
-	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
-	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x1a, 0xdb, 0x01, 0x0a, 0x1c, 0x4c, 0x69,
-	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
-	0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
-	0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x1a, 0xc7, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x6e, 0x6b,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x61,
-	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73,
-	0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
-	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x59, 0x0a, 0x0d, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74,
-	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
-	0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x1a, 0x5a, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x6b, 0x12, 0x4a, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70,
+	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x03, 0x6c, 0x73, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x6c, 0x73, 0x75, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70,
 	0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x73, 0x75, 0x12, 0x34,
+	0x0a, 0x03, 0x6c, 0x73, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52,
+	0x03, 0x6c, 0x73, 0x61, 0x1a, 0xcc, 0x02, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x73, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c,
+	0x0a, 0x12, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x6c, 0x6c,
+	0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x64, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x2b,
+	0x0a, 0x11, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x1a, 0x7f, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x1a, 0x9c, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x1a, 0xdb, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd1, 0x01,
-	0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1f, 0x0a, 0x1b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73,
+	0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x1a, 0xc7, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x59, 0x0a, 0x0d, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x48, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x5a, 0x0a, 0x0c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x12, 0x4a, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4f, 0x73, 0x70, 0x66, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4e,
+	0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x4d,
+	0x41, 0x52, 0x59, 0x5f, 0x41, 0x53, 0x42, 0x52, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53,
+	0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x05, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x52, 0x73, 0x76, 0x70, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x3f,
+	0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50,
+	0x41, 0x54, 0x48, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x53, 0x56, 0x10, 0x02, 0x22,
+	0x4e, 0x0a, 0x09, 0x50, 0x69, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x05,
+	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x69, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x07,
+	0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x90, 0x02, 0x0a, 0x09, 0x4c, 0x64, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x15, 0x0a,
+	0x06, 0x6c, 0x73, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x73, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4c, 0x64,
+	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52,
+	0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x72, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12,
+	0x22, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x33, 0x0a, 0x0b, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x76, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xfd, 0x02, 0x0a, 0x0a, 0x47,
+	0x74, 0x70, 0x75, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x65, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72,
+	0x61, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x74, 0x65, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x5b, 0x0a, 0x15, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x74,
+	0x70, 0x75, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x13, 0x70, 0x64,
+	0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x1a, 0xc6, 0x01, 0x0a, 0x13, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x64, 0x75,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f, 0x6e,
+	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x50, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x64, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x70, 0x64,
+	0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x71, 0x66, 0x69, 0x22, 0x3d, 0x0a, 0x07, 0x50,
+	0x64, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x44, 0x55, 0x5f, 0x54, 0x59,
 	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d,
-	0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x03, 0x12, 0x20,
-	0x0a, 0x1c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x41, 0x53, 0x42, 0x52, 0x10, 0x04,
-	0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10,
-	0x05, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x52, 0x73,
-	0x76, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x72, 0x65,
-	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
-	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x42,
-	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x52,
-	0x73, 0x76, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x74, 0x74, 0x6c, 0x22, 0x3f, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52,
-	0x45, 0x53, 0x56, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x09, 0x50, 0x69, 0x6d, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x69, 0x6d, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68,
-	0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x07, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x42, 0x06, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x09, 0x4c, 0x64, 0x70, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x73, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x73, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x68, 0x65,
-	0x6c, 0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x64, 0x61,
-	0x74, 0x72, 0x61, 0x2e, 0x4c, 0x64, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65,
-	0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x72, 0x0a, 0x05,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x6f,
-	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x7e, 0x0a, 0x12, 0x49, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06,
-	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
-	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x49, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22,
-	0x3f, 0x0a, 0x0f, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x71, 0x0a, 0x09, 0x55, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61,
-	0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e,
-	0x64, 0x6f, 0x6d, 0x22, 0x74, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x02, 0x22, 0x22, 0x0a, 0x0c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7e,
+	0x0a, 0x12, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x49, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x25,
+	0x0a, 0x0d, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x55, 0x49, 0x6e, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61,
 	0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x3a, 0x0a, 0x0e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49,
-	0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65,
-	0x70, 0x2a, 0xe8, 0x01, 0x0a, 0x0d, 0x42, 0x67, 0x70, 0x41, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45,
-	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x41,
-	0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53,
-	0x45, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x41,
+	0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x3a, 0x0a,
+	0x0e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x2a, 0x6d, 0x0a, 0x10, 0x50, 0x6f, 0x72,
+	0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x2a, 0xe8, 0x01, 0x0a, 0x0d, 0x42,
+	0x67, 0x70, 0x41, 0x73, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x53,
+	0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41,
 	0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53,
-	0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x06, 0x42, 0x25, 0x5a, 0x23,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x51, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a,
+	0x21, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53,
+	0x5f, 0x53, 0x45, 0x51, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x53, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x10, 0x06, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6f,
+	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9775,268 +13804,372 @@ func file_ate_proto_rawDescGZIP() []byte {
 	return file_ate_proto_rawDescData
 }
 
-var file_ate_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
-var file_ate_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
+var file_ate_proto_enumTypes = make([]protoimpl.EnumInfo, 36)
+var file_ate_proto_msgTypes = make([]protoimpl.MessageInfo, 126)
 var file_ate_proto_goTypes = []interface{}{
-	(BgpAsnSetMode)(0),                                  // 0: ondatra.BgpAsnSetMode
-	(MacSec_CipherSuite)(0),                             // 1: ondatra.MacSec.CipherSuite
-	(MacSec_MKA_Capability)(0),                          // 2: ondatra.MacSec.MKA.Capability
-	(MacSec_MKA_ConfidentialityOffset)(0),               // 3: ondatra.MacSec.MKA.ConfidentialityOffset
-	(ISISConfig_Level)(0),                               // 4: ondatra.ISISConfig.Level
-	(ISISConfig_NetworkType)(0),                         // 5: ondatra.ISISConfig.NetworkType
-	(ISISConfig_AuthType)(0),                            // 6: ondatra.ISISConfig.AuthType
-	(IPReachability_RouteOrigin)(0),                     // 7: ondatra.IPReachability.RouteOrigin
-	(BgpPeer_Type)(0),                                   // 8: ondatra.BgpPeer.Type
-	(BgpAttributes_Origin)(0),                           // 9: ondatra.BgpAttributes.Origin
-	(BgpAttributes_ExtendedCommunity_Color_CoBits)(0),   // 10: ondatra.BgpAttributes.ExtendedCommunity.Color.CoBits
-	(BgpAttributes_AsPathSegment_Type)(0),               // 11: ondatra.BgpAttributes.AsPathSegment.Type
-	(Network_ImportedBgpRoutes_RouteTableFormat)(0),     // 12: ondatra.Network.ImportedBgpRoutes.RouteTableFormat
-	(FrameSize_ImixPreset)(0),                           // 13: ondatra.FrameSize.ImixPreset
-	(Transmission_Pattern)(0),                           // 14: ondatra.Transmission.Pattern
-	(IcmpHeader_DestinationUnreachable_Code)(0),         // 15: ondatra.IcmpHeader.DestinationUnreachable.Code
-	(IcmpHeader_RedirectMessage_Code)(0),                // 16: ondatra.IcmpHeader.RedirectMessage.Code
-	(IcmpHeader_TimeExceeded_Code)(0),                   // 17: ondatra.IcmpHeader.TimeExceeded.Code
-	(OspfHeader_LinkStateType)(0),                       // 18: ondatra.OspfHeader.LinkStateType
-	(RsvpHeader_MessageType)(0),                         // 19: ondatra.RsvpHeader.MessageType
-	(*Topology)(nil),                                    // 20: ondatra.Topology
-	(*Traffic)(nil),                                     // 21: ondatra.Traffic
-	(*Lag)(nil),                                         // 22: ondatra.Lag
-	(*InterfaceConfig)(nil),                             // 23: ondatra.InterfaceConfig
-	(*EthernetConfig)(nil),                              // 24: ondatra.EthernetConfig
-	(*Fec)(nil),                                         // 25: ondatra.Fec
-	(*MacSec)(nil),                                      // 26: ondatra.MacSec
-	(*RxSakPool)(nil),                                   // 27: ondatra.RxSakPool
-	(*IpConfig)(nil),                                    // 28: ondatra.IpConfig
-	(*ISISConfig)(nil),                                  // 29: ondatra.ISISConfig
-	(*ISISSegmentRouting)(nil),                          // 30: ondatra.ISISSegmentRouting
-	(*IPReachability)(nil),                              // 31: ondatra.IPReachability
-	(*ISReachability)(nil),                              // 32: ondatra.ISReachability
-	(*BgpCommunities)(nil),                              // 33: ondatra.BgpCommunities
-	(*BgpConfig)(nil),                                   // 34: ondatra.BgpConfig
-	(*BgpPeer)(nil),                                     // 35: ondatra.BgpPeer
-	(*BgpAttributes)(nil),                               // 36: ondatra.BgpAttributes
-	(*RsvpConfig)(nil),                                  // 37: ondatra.RsvpConfig
-	(*Network)(nil),                                     // 38: ondatra.Network
-	(*NetworkEth)(nil),                                  // 39: ondatra.NetworkEth
-	(*NetworkIp)(nil),                                   // 40: ondatra.NetworkIp
-	(*Flow)(nil),                                        // 41: ondatra.Flow
-	(*FrameRate)(nil),                                   // 42: ondatra.FrameRate
-	(*FrameSize)(nil),                                   // 43: ondatra.FrameSize
-	(*Transmission)(nil),                                // 44: ondatra.Transmission
-	(*EgressTracking)(nil),                              // 45: ondatra.EgressTracking
-	(*Header)(nil),                                      // 46: ondatra.Header
-	(*EthernetHeader)(nil),                              // 47: ondatra.EthernetHeader
-	(*GreHeader)(nil),                                   // 48: ondatra.GreHeader
-	(*Ipv4Header)(nil),                                  // 49: ondatra.Ipv4Header
-	(*Ipv6Header)(nil),                                  // 50: ondatra.Ipv6Header
-	(*MplsHeader)(nil),                                  // 51: ondatra.MplsHeader
-	(*TcpHeader)(nil),                                   // 52: ondatra.TcpHeader
-	(*UdpHeader)(nil),                                   // 53: ondatra.UdpHeader
-	(*HttpHeader)(nil),                                  // 54: ondatra.HttpHeader
-	(*IcmpHeader)(nil),                                  // 55: ondatra.IcmpHeader
-	(*OspfHeader)(nil),                                  // 56: ondatra.OspfHeader
-	(*RsvpHeader)(nil),                                  // 57: ondatra.RsvpHeader
-	(*PimHeader)(nil),                                   // 58: ondatra.PimHeader
-	(*LdpHeader)(nil),                                   // 59: ondatra.LdpHeader
-	(*IpAddressGenerator)(nil),                          // 60: ondatra.IpAddressGenerator
-	(*IpAddressList)(nil),                               // 61: ondatra.IpAddressList
-	(*IpAddressRandom)(nil),                             // 62: ondatra.IpAddressRandom
-	(*UIntRange)(nil),                                   // 63: ondatra.UIntRange
-	(*AddressRange)(nil),                                // 64: ondatra.AddressRange
-	(*StringIncRange)(nil),                              // 65: ondatra.StringIncRange
-	(*UInt32IncRange)(nil),                              // 66: ondatra.UInt32IncRange
-	(*Lag_Lacp)(nil),                                    // 67: ondatra.Lag.Lacp
-	(*MacSec_MKA)(nil),                                  // 68: ondatra.MacSec.MKA
-	(*MacSec_MKA_ConnectivityAssociation)(nil),          // 69: ondatra.MacSec.MKA.ConnectivityAssociation
-	(*ISISSegmentRouting_AdjacencySID)(nil),             // 70: ondatra.ISISSegmentRouting.AdjacencySID
-	(*ISISSegmentRouting_SIDRange)(nil),                 // 71: ondatra.ISISSegmentRouting.SIDRange
-	(*ISReachability_Node)(nil),                         // 72: ondatra.ISReachability.Node
-	(*ISReachability_Node_Link)(nil),                    // 73: ondatra.ISReachability.Node.Link
-	(*ISReachability_Node_Routes)(nil),                  // 74: ondatra.ISReachability.Node.Routes
-	(*BgpPeer_Capabilities)(nil),                        // 75: ondatra.BgpPeer.Capabilities
-	(*BgpPeer_SrtePolicyGroup)(nil),                     // 76: ondatra.BgpPeer.SrtePolicyGroup
-	(*BgpPeer_SrtePolicyGroup_Preference)(nil),          // 77: ondatra.BgpPeer.SrtePolicyGroup.Preference
-	(*BgpPeer_SrtePolicyGroup_Binding)(nil),             // 78: ondatra.BgpPeer.SrtePolicyGroup.Binding
-	(*BgpPeer_SrtePolicyGroup_SegmentList)(nil),         // 79: ondatra.BgpPeer.SrtePolicyGroup.SegmentList
-	(*BgpPeer_SrtePolicyGroup_Enlp)(nil),                // 80: ondatra.BgpPeer.SrtePolicyGroup.Enlp
-	(*BgpPeer_SrtePolicyGroup_SegmentList_Weight)(nil),  // 81: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Weight
-	(*BgpPeer_SrtePolicyGroup_SegmentList_Segment)(nil), // 82: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment
-	(*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid)(nil), // 83: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.MplsSid
-	(*BgpAttributes_ExtendedCommunity)(nil),                     // 84: ondatra.BgpAttributes.ExtendedCommunity
-	(*BgpAttributes_AsPathSegment)(nil),                         // 85: ondatra.BgpAttributes.AsPathSegment
-	(*BgpAttributes_ExtendedCommunity_Color)(nil),               // 86: ondatra.BgpAttributes.ExtendedCommunity.Color
-	(*RsvpConfig_Loopback)(nil),                                 // 87: ondatra.RsvpConfig.Loopback
-	(*RsvpConfig_Loopback_IngressLSP)(nil),                      // 88: ondatra.RsvpConfig.Loopback.IngressLSP
-	(*RsvpConfig_Loopback_IngressLSP_ERO)(nil),                  // 89: ondatra.RsvpConfig.Loopback.IngressLSP.ERO
-	(*RsvpConfig_Loopback_IngressLSP_RRO)(nil),                  // 90: ondatra.RsvpConfig.Loopback.IngressLSP.RRO
-	(*Network_ImportedBgpRoutes)(nil),                           // 91: ondatra.Network.ImportedBgpRoutes
-	(*Flow_Endpoint)(nil),                                       // 92: ondatra.Flow.Endpoint
-	(*Flow_IngressTrackingFilters)(nil),                         // 93: ondatra.Flow.IngressTrackingFilters
-	(*FrameSize_Random)(nil),                                    // 94: ondatra.FrameSize.Random
-	(*FrameSize_ImixCustomEntry)(nil),                           // 95: ondatra.FrameSize.ImixCustomEntry
-	(*FrameSize_ImixCustom)(nil),                                // 96: ondatra.FrameSize.ImixCustom
-	(*IcmpHeader_EchoReply)(nil),                                // 97: ondatra.IcmpHeader.EchoReply
-	(*IcmpHeader_DestinationUnreachable)(nil),                   // 98: ondatra.IcmpHeader.DestinationUnreachable
-	(*IcmpHeader_RedirectMessage)(nil),                          // 99: ondatra.IcmpHeader.RedirectMessage
-	(*IcmpHeader_EchoRequest)(nil),                              // 100: ondatra.IcmpHeader.EchoRequest
-	(*IcmpHeader_TimeExceeded)(nil),                             // 101: ondatra.IcmpHeader.TimeExceeded
-	(*IcmpHeader_ParameterProblem)(nil),                         // 102: ondatra.IcmpHeader.ParameterProblem
-	(*IcmpHeader_Timestamp)(nil),                                // 103: ondatra.IcmpHeader.Timestamp
-	(*IcmpHeader_TimestampReply)(nil),                           // 104: ondatra.IcmpHeader.TimestampReply
-	(*OspfHeader_Hello)(nil),                                    // 105: ondatra.OspfHeader.Hello
-	(*OspfHeader_DatabaseDescription)(nil),                      // 106: ondatra.OspfHeader.DatabaseDescription
-	(*OspfHeader_LinkStateRequest)(nil),                         // 107: ondatra.OspfHeader.LinkStateRequest
-	(*OspfHeader_LinkStateAdvertisementHeader)(nil),             // 108: ondatra.OspfHeader.LinkStateAdvertisementHeader
-	(*OspfHeader_LinkStateUpdate)(nil),                          // 109: ondatra.OspfHeader.LinkStateUpdate
-	(*OspfHeader_LinkStateAck)(nil),                             // 110: ondatra.OspfHeader.LinkStateAck
-	(*OspfHeader_LinkStateUpdate_Advertisement)(nil),            // 111: ondatra.OspfHeader.LinkStateUpdate.Advertisement
-	(*PimHeader_Hello)(nil),                                     // 112: ondatra.PimHeader.Hello
-	(*LdpHeader_Hello)(nil),                                     // 113: ondatra.LdpHeader.Hello
-	(*empty.Empty)(nil),                                         // 114: google.protobuf.Empty
+	(PortLoopbackMode)(0),                               // 0: ondatra.PortLoopbackMode
+	(PortLinkFault)(0),                                  // 1: ondatra.PortLinkFault
+	(BgpAsnSetMode)(0),                                  // 2: ondatra.BgpAsnSetMode
+	(IgmpHostConfig_Version)(0),                         // 3: ondatra.IgmpHostConfig.Version
+	(MldHostConfig_Version)(0),                          // 4: ondatra.MldHostConfig.Version
+	(PimConfig_JoinPrune_RangeType)(0),                  // 5: ondatra.PimConfig.JoinPrune.RangeType
+	(PtpConfig_Role)(0),                                 // 6: ondatra.PtpConfig.Role
+	(PtpConfig_Transport)(0),                            // 7: ondatra.PtpConfig.Transport
+	(PtpConfig_DelayMechanism)(0),                       // 8: ondatra.PtpConfig.DelayMechanism
+	(Dot1XConfig_EapMethod)(0),                          // 9: ondatra.Dot1xConfig.EapMethod
+	(MacSec_CipherSuite)(0),                             // 10: ondatra.MacSec.CipherSuite
+	(MacSec_MKA_Capability)(0),                          // 11: ondatra.MacSec.MKA.Capability
+	(MacSec_MKA_ConfidentialityOffset)(0),               // 12: ondatra.MacSec.MKA.ConfidentialityOffset
+	(ISISConfig_Level)(0),                               // 13: ondatra.ISISConfig.Level
+	(ISISConfig_NetworkType)(0),                         // 14: ondatra.ISISConfig.NetworkType
+	(ISISConfig_AuthType)(0),                            // 15: ondatra.ISISConfig.AuthType
+	(IPReachability_RouteOrigin)(0),                     // 16: ondatra.IPReachability.RouteOrigin
+	(PortL1Config_FecMode)(0),                           // 17: ondatra.PortL1Config.FecMode
+	(PortL1Config_AutoNegotiation)(0),                   // 18: ondatra.PortL1Config.AutoNegotiation
+	(BgpPeer_Type)(0),                                   // 19: ondatra.BgpPeer.Type
+	(BgpPeer_AddPathMode)(0),                            // 20: ondatra.BgpPeer.AddPathMode
+	(BgpAttributes_Origin)(0),                           // 21: ondatra.BgpAttributes.Origin
+	(BgpAttributes_ExtendedCommunity_Color_CoBits)(0),   // 22: ondatra.BgpAttributes.ExtendedCommunity.Color.CoBits
+	(BgpAttributes_AsPathSegment_Type)(0),               // 23: ondatra.BgpAttributes.AsPathSegment.Type
+	(Network_ImportedBgpRoutes_RouteTableFormat)(0),     // 24: ondatra.Network.ImportedBgpRoutes.RouteTableFormat
+	(Payload_Type)(0),                                   // 25: ondatra.Payload.Type
+	(ApplicationTraffic_Protocol)(0),                    // 26: ondatra.ApplicationTraffic.Protocol
+	(FrameSize_ImixPreset)(0),                           // 27: ondatra.FrameSize.ImixPreset
+	(Transmission_Pattern)(0),                           // 28: ondatra.Transmission.Pattern
+	(EgressTracking_Filter)(0),                          // 29: ondatra.EgressTracking.Filter
+	(IcmpHeader_DestinationUnreachable_Code)(0),         // 30: ondatra.IcmpHeader.DestinationUnreachable.Code
+	(IcmpHeader_RedirectMessage_Code)(0),                // 31: ondatra.IcmpHeader.RedirectMessage.Code
+	(IcmpHeader_TimeExceeded_Code)(0),                   // 32: ondatra.IcmpHeader.TimeExceeded.Code
+	(OspfHeader_LinkStateType)(0),                       // 33: ondatra.OspfHeader.LinkStateType
+	(RsvpHeader_MessageType)(0),                         // 34: ondatra.RsvpHeader.MessageType
+	(GtpuHeader_PduSessionContainer_PduType)(0),         // 35: ondatra.GtpuHeader.PduSessionContainer.PduType
+	(*Topology)(nil),                                    // 36: ondatra.Topology
+	(*Traffic)(nil),                                     // 37: ondatra.Traffic
+	(*Lag)(nil),                                         // 38: ondatra.Lag
+	(*InterfaceConfig)(nil),                             // 39: ondatra.InterfaceConfig
+	(*DhcpConfig)(nil),                                  // 40: ondatra.DhcpConfig
+	(*BfdConfig)(nil),                                   // 41: ondatra.BfdConfig
+	(*IgmpHostConfig)(nil),                              // 42: ondatra.IgmpHostConfig
+	(*MldHostConfig)(nil),                               // 43: ondatra.MldHostConfig
+	(*MulticastGroup)(nil),                              // 44: ondatra.MulticastGroup
+	(*PimConfig)(nil),                                   // 45: ondatra.PimConfig
+	(*LdpConfig)(nil),                                   // 46: ondatra.LdpConfig
+	(*PtpConfig)(nil),                                   // 47: ondatra.PtpConfig
+	(*Dot1XConfig)(nil),                                 // 48: ondatra.Dot1xConfig
+	(*EthernetConfig)(nil),                              // 49: ondatra.EthernetConfig
+	(*Fec)(nil),                                         // 50: ondatra.Fec
+	(*MacSec)(nil),                                      // 51: ondatra.MacSec
+	(*RxSakPool)(nil),                                   // 52: ondatra.RxSakPool
+	(*IpConfig)(nil),                                    // 53: ondatra.IpConfig
+	(*NeighborDiscoveryConfig)(nil),                     // 54: ondatra.NeighborDiscoveryConfig
+	(*ISISConfig)(nil),                                  // 55: ondatra.ISISConfig
+	(*ISISTrafficEngineering)(nil),                      // 56: ondatra.ISISTrafficEngineering
+	(*ISISSegmentRouting)(nil),                          // 57: ondatra.ISISSegmentRouting
+	(*IPReachability)(nil),                              // 58: ondatra.IPReachability
+	(*ISReachability)(nil),                              // 59: ondatra.ISReachability
+	(*PortL1Config)(nil),                                // 60: ondatra.PortL1Config
+	(*Impairment)(nil),                                  // 61: ondatra.Impairment
+	(*BgpCommunities)(nil),                              // 62: ondatra.BgpCommunities
+	(*BgpConfig)(nil),                                   // 63: ondatra.BgpConfig
+	(*BgpPeer)(nil),                                     // 64: ondatra.BgpPeer
+	(*BgpAttributes)(nil),                               // 65: ondatra.BgpAttributes
+	(*RsvpConfig)(nil),                                  // 66: ondatra.RsvpConfig
+	(*Network)(nil),                                     // 67: ondatra.Network
+	(*GeneratedBgpRoutes)(nil),                          // 68: ondatra.GeneratedBgpRoutes
+	(*LdpFec)(nil),                                      // 69: ondatra.LdpFec
+	(*NetworkEth)(nil),                                  // 70: ondatra.NetworkEth
+	(*NetworkIp)(nil),                                   // 71: ondatra.NetworkIp
+	(*Flow)(nil),                                        // 72: ondatra.Flow
+	(*Payload)(nil),                                     // 73: ondatra.Payload
+	(*ApplicationTraffic)(nil),                          // 74: ondatra.ApplicationTraffic
+	(*FrameRate)(nil),                                   // 75: ondatra.FrameRate
+	(*FrameSize)(nil),                                   // 76: ondatra.FrameSize
+	(*Transmission)(nil),                                // 77: ondatra.Transmission
+	(*EgressTracking)(nil),                              // 78: ondatra.EgressTracking
+	(*Header)(nil),                                      // 79: ondatra.Header
+	(*EthernetHeader)(nil),                              // 80: ondatra.EthernetHeader
+	(*GreHeader)(nil),                                   // 81: ondatra.GreHeader
+	(*Ipv4Header)(nil),                                  // 82: ondatra.Ipv4Header
+	(*Ipv6Header)(nil),                                  // 83: ondatra.Ipv6Header
+	(*MplsHeader)(nil),                                  // 84: ondatra.MplsHeader
+	(*TcpHeader)(nil),                                   // 85: ondatra.TcpHeader
+	(*UdpHeader)(nil),                                   // 86: ondatra.UdpHeader
+	(*HttpHeader)(nil),                                  // 87: ondatra.HttpHeader
+	(*IcmpHeader)(nil),                                  // 88: ondatra.IcmpHeader
+	(*OspfHeader)(nil),                                  // 89: ondatra.OspfHeader
+	(*RsvpHeader)(nil),                                  // 90: ondatra.RsvpHeader
+	(*PimHeader)(nil),                                   // 91: ondatra.PimHeader
+	(*LdpHeader)(nil),                                   // 92: ondatra.LdpHeader
+	(*VxlanHeader)(nil),                                 // 93: ondatra.VxlanHeader
+	(*GeneveHeader)(nil),                                // 94: ondatra.GeneveHeader
+	(*GtpuHeader)(nil),                                  // 95: ondatra.GtpuHeader
+	(*CustomHeader)(nil),                                // 96: ondatra.CustomHeader
+	(*IpAddressGenerator)(nil),                          // 97: ondatra.IpAddressGenerator
+	(*IpAddressList)(nil),                               // 98: ondatra.IpAddressList
+	(*IpAddressRandom)(nil),                             // 99: ondatra.IpAddressRandom
+	(*UIntRange)(nil),                                   // 100: ondatra.UIntRange
+	(*AddressRange)(nil),                                // 101: ondatra.AddressRange
+	(*StringIncRange)(nil),                              // 102: ondatra.StringIncRange
+	(*UInt32IncRange)(nil),                              // 103: ondatra.UInt32IncRange
+	(*Lag_Lacp)(nil),                                    // 104: ondatra.Lag.Lacp
+	(*DhcpConfig_Client)(nil),                           // 105: ondatra.DhcpConfig.Client
+	(*DhcpConfig_Server)(nil),                           // 106: ondatra.DhcpConfig.Server
+	(*PimConfig_JoinPrune)(nil),                         // 107: ondatra.PimConfig.JoinPrune
+	(*PimConfig_CandidateRp)(nil),                       // 108: ondatra.PimConfig.CandidateRp
+	(*MacSec_MKA)(nil),                                  // 109: ondatra.MacSec.MKA
+	(*MacSec_MKA_ConnectivityAssociation)(nil),          // 110: ondatra.MacSec.MKA.ConnectivityAssociation
+	(*ISISSegmentRouting_AdjacencySID)(nil),             // 111: ondatra.ISISSegmentRouting.AdjacencySID
+	(*ISISSegmentRouting_SIDRange)(nil),                 // 112: ondatra.ISISSegmentRouting.SIDRange
+	(*ISReachability_Node)(nil),                         // 113: ondatra.ISReachability.Node
+	(*ISReachability_Node_Link)(nil),                    // 114: ondatra.ISReachability.Node.Link
+	(*ISReachability_Node_Routes)(nil),                  // 115: ondatra.ISReachability.Node.Routes
+	(*BgpPeer_Capabilities)(nil),                        // 116: ondatra.BgpPeer.Capabilities
+	(*BgpPeer_SrtePolicyGroup)(nil),                     // 117: ondatra.BgpPeer.SrtePolicyGroup
+	(*BgpPeer_SrtePolicyGroup_Preference)(nil),          // 118: ondatra.BgpPeer.SrtePolicyGroup.Preference
+	(*BgpPeer_SrtePolicyGroup_Binding)(nil),             // 119: ondatra.BgpPeer.SrtePolicyGroup.Binding
+	(*BgpPeer_SrtePolicyGroup_SegmentList)(nil),         // 120: ondatra.BgpPeer.SrtePolicyGroup.SegmentList
+	(*BgpPeer_SrtePolicyGroup_Enlp)(nil),                // 121: ondatra.BgpPeer.SrtePolicyGroup.Enlp
+	(*BgpPeer_SrtePolicyGroup_SegmentList_Weight)(nil),  // 122: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Weight
+	(*BgpPeer_SrtePolicyGroup_SegmentList_Segment)(nil), // 123: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment
+	(*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid)(nil), // 124: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.MplsSid
+	(*BgpAttributes_ExtendedCommunity)(nil),                     // 125: ondatra.BgpAttributes.ExtendedCommunity
+	(*BgpAttributes_AsPathSegment)(nil),                         // 126: ondatra.BgpAttributes.AsPathSegment
+	(*BgpAttributes_AddPaths)(nil),                              // 127: ondatra.BgpAttributes.AddPaths
+	(*BgpAttributes_Med)(nil),                                   // 128: ondatra.BgpAttributes.Med
+	(*BgpAttributes_Aigp)(nil),                                  // 129: ondatra.BgpAttributes.Aigp
+	(*BgpAttributes_ExtendedCommunity_Color)(nil),               // 130: ondatra.BgpAttributes.ExtendedCommunity.Color
+	(*RsvpConfig_Loopback)(nil),                                 // 131: ondatra.RsvpConfig.Loopback
+	(*RsvpConfig_Loopback_IngressLSP)(nil),                      // 132: ondatra.RsvpConfig.Loopback.IngressLSP
+	(*RsvpConfig_Loopback_IngressLSP_ERO)(nil),                  // 133: ondatra.RsvpConfig.Loopback.IngressLSP.ERO
+	(*RsvpConfig_Loopback_IngressLSP_RRO)(nil),                  // 134: ondatra.RsvpConfig.Loopback.IngressLSP.RRO
+	(*Network_ImportedBgpRoutes)(nil),                           // 135: ondatra.Network.ImportedBgpRoutes
+	(*GeneratedBgpRoutes_Prefixes)(nil),                         // 136: ondatra.GeneratedBgpRoutes.Prefixes
+	nil,                                                         // 137: ondatra.GeneratedBgpRoutes.Prefixes.PrefixLengthWeightsEntry
+	(*Flow_Endpoint)(nil),                                       // 138: ondatra.Flow.Endpoint
+	(*Flow_IngressTrackingFilters)(nil),                         // 139: ondatra.Flow.IngressTrackingFilters
+	(*FrameSize_Random)(nil),                                    // 140: ondatra.FrameSize.Random
+	(*FrameSize_ImixCustomEntry)(nil),                           // 141: ondatra.FrameSize.ImixCustomEntry
+	(*FrameSize_ImixCustom)(nil),                                // 142: ondatra.FrameSize.ImixCustom
+	(*FrameSize_Increment)(nil),                                 // 143: ondatra.FrameSize.Increment
+	(*IcmpHeader_EchoReply)(nil),                                // 144: ondatra.IcmpHeader.EchoReply
+	(*IcmpHeader_DestinationUnreachable)(nil),                   // 145: ondatra.IcmpHeader.DestinationUnreachable
+	(*IcmpHeader_RedirectMessage)(nil),                          // 146: ondatra.IcmpHeader.RedirectMessage
+	(*IcmpHeader_EchoRequest)(nil),                              // 147: ondatra.IcmpHeader.EchoRequest
+	(*IcmpHeader_TimeExceeded)(nil),                             // 148: ondatra.IcmpHeader.TimeExceeded
+	(*IcmpHeader_ParameterProblem)(nil),                         // 149: ondatra.IcmpHeader.ParameterProblem
+	(*IcmpHeader_Timestamp)(nil),                                // 150: ondatra.IcmpHeader.Timestamp
+	(*IcmpHeader_TimestampReply)(nil),                           // 151: ondatra.IcmpHeader.TimestampReply
+	(*OspfHeader_Hello)(nil),                                    // 152: ondatra.OspfHeader.Hello
+	(*OspfHeader_DatabaseDescription)(nil),                      // 153: ondatra.OspfHeader.DatabaseDescription
+	(*OspfHeader_LinkStateRequest)(nil),                         // 154: ondatra.OspfHeader.LinkStateRequest
+	(*OspfHeader_LinkStateAdvertisementHeader)(nil),             // 155: ondatra.OspfHeader.LinkStateAdvertisementHeader
+	(*OspfHeader_LinkStateUpdate)(nil),                          // 156: ondatra.OspfHeader.LinkStateUpdate
+	(*OspfHeader_LinkStateAck)(nil),                             // 157: ondatra.OspfHeader.LinkStateAck
+	(*OspfHeader_LinkStateUpdate_Advertisement)(nil),            // 158: ondatra.OspfHeader.LinkStateUpdate.Advertisement
+	(*PimHeader_Hello)(nil),                                     // 159: ondatra.PimHeader.Hello
+	(*LdpHeader_Hello)(nil),                                     // 160: ondatra.LdpHeader.Hello
+	(*GtpuHeader_PduSessionContainer)(nil),                      // 161: ondatra.GtpuHeader.PduSessionContainer
+	(*empty.Empty)(nil),                                         // 162: google.protobuf.Empty
 }
 var file_ate_proto_depIdxs = []int32{
-	22,  // 0: ondatra.Topology.lags:type_name -> ondatra.Lag
-	23,  // 1: ondatra.Topology.interfaces:type_name -> ondatra.InterfaceConfig
-	41,  // 2: ondatra.Traffic.flows:type_name -> ondatra.Flow
-	67,  // 3: ondatra.Lag.lacp:type_name -> ondatra.Lag.Lacp
-	24,  // 4: ondatra.InterfaceConfig.ethernet:type_name -> ondatra.EthernetConfig
-	28,  // 5: ondatra.InterfaceConfig.ipv4:type_name -> ondatra.IpConfig
-	28,  // 6: ondatra.InterfaceConfig.ipv6:type_name -> ondatra.IpConfig
-	29,  // 7: ondatra.InterfaceConfig.isis:type_name -> ondatra.ISISConfig
-	34,  // 8: ondatra.InterfaceConfig.bgp:type_name -> ondatra.BgpConfig
-	37,  // 9: ondatra.InterfaceConfig.rsvp:type_name -> ondatra.RsvpConfig
-	38,  // 10: ondatra.InterfaceConfig.networks:type_name -> ondatra.Network
-	26,  // 11: ondatra.EthernetConfig.macsec:type_name -> ondatra.MacSec
-	25,  // 12: ondatra.EthernetConfig.fec:type_name -> ondatra.Fec
-	1,   // 13: ondatra.MacSec.cipher_suite:type_name -> ondatra.MacSec.CipherSuite
-	27,  // 14: ondatra.MacSec.rx_sak_pool:type_name -> ondatra.RxSakPool
-	68,  // 15: ondatra.MacSec.mka:type_name -> ondatra.MacSec.MKA
-	4,   // 16: ondatra.ISISConfig.level:type_name -> ondatra.ISISConfig.Level
-	5,   // 17: ondatra.ISISConfig.network_type:type_name -> ondatra.ISISConfig.NetworkType
-	6,   // 18: ondatra.ISISConfig.auth_type:type_name -> ondatra.ISISConfig.AuthType
-	31,  // 19: ondatra.ISISConfig.ip_reachability:type_name -> ondatra.IPReachability
-	32,  // 20: ondatra.ISISConfig.is_reachability:type_name -> ondatra.ISReachability
-	30,  // 21: ondatra.ISISConfig.segment_routing:type_name -> ondatra.ISISSegmentRouting
-	70,  // 22: ondatra.ISISSegmentRouting.adjacency_sid:type_name -> ondatra.ISISSegmentRouting.AdjacencySID
-	71,  // 23: ondatra.ISISSegmentRouting.srgb_range:type_name -> ondatra.ISISSegmentRouting.SIDRange
-	71,  // 24: ondatra.ISISSegmentRouting.srlb_range:type_name -> ondatra.ISISSegmentRouting.SIDRange
-	7,   // 25: ondatra.IPReachability.route_origin:type_name -> ondatra.IPReachability.RouteOrigin
-	72,  // 26: ondatra.ISReachability.nodes:type_name -> ondatra.ISReachability.Node
-	35,  // 27: ondatra.BgpConfig.bgp_peers:type_name -> ondatra.BgpPeer
-	8,   // 28: ondatra.BgpPeer.type:type_name -> ondatra.BgpPeer.Type
-	75,  // 29: ondatra.BgpPeer.capabilities:type_name -> ondatra.BgpPeer.Capabilities
-	76,  // 30: ondatra.BgpPeer.srte_policy_groups:type_name -> ondatra.BgpPeer.SrtePolicyGroup
-	9,   // 31: ondatra.BgpAttributes.origin:type_name -> ondatra.BgpAttributes.Origin
-	33,  // 32: ondatra.BgpAttributes.communities:type_name -> ondatra.BgpCommunities
-	84,  // 33: ondatra.BgpAttributes.extended_communities:type_name -> ondatra.BgpAttributes.ExtendedCommunity
-	0,   // 34: ondatra.BgpAttributes.asn_set_mode:type_name -> ondatra.BgpAsnSetMode
-	85,  // 35: ondatra.BgpAttributes.as_path_segments:type_name -> ondatra.BgpAttributes.AsPathSegment
-	65,  // 36: ondatra.BgpAttributes.originator_id:type_name -> ondatra.StringIncRange
-	87,  // 37: ondatra.RsvpConfig.loopbacks:type_name -> ondatra.RsvpConfig.Loopback
-	39,  // 38: ondatra.Network.eth:type_name -> ondatra.NetworkEth
-	40,  // 39: ondatra.Network.ipv4:type_name -> ondatra.NetworkIp
-	40,  // 40: ondatra.Network.ipv6:type_name -> ondatra.NetworkIp
-	36,  // 41: ondatra.Network.bgp_attributes:type_name -> ondatra.BgpAttributes
-	31,  // 42: ondatra.Network.isis:type_name -> ondatra.IPReachability
-	91,  // 43: ondatra.Network.imported_bgp_routes:type_name -> ondatra.Network.ImportedBgpRoutes
-	92,  // 44: ondatra.Flow.src_endpoints:type_name -> ondatra.Flow.Endpoint
-	92,  // 45: ondatra.Flow.dst_endpoints:type_name -> ondatra.Flow.Endpoint
-	46,  // 46: ondatra.Flow.headers:type_name -> ondatra.Header
-	42,  // 47: ondatra.Flow.frame_rate:type_name -> ondatra.FrameRate
-	45,  // 48: ondatra.Flow.egress_tracking:type_name -> ondatra.EgressTracking
-	93,  // 49: ondatra.Flow.ingress_tracking_filters:type_name -> ondatra.Flow.IngressTrackingFilters
-	43,  // 50: ondatra.Flow.frame_size:type_name -> ondatra.FrameSize
-	44,  // 51: ondatra.Flow.transmission:type_name -> ondatra.Transmission
-	94,  // 52: ondatra.FrameSize.random:type_name -> ondatra.FrameSize.Random
-	13,  // 53: ondatra.FrameSize.imix_preset:type_name -> ondatra.FrameSize.ImixPreset
-	96,  // 54: ondatra.FrameSize.imix_custom:type_name -> ondatra.FrameSize.ImixCustom
-	14,  // 55: ondatra.Transmission.pattern:type_name -> ondatra.Transmission.Pattern
-	47,  // 56: ondatra.Header.eth:type_name -> ondatra.EthernetHeader
-	48,  // 57: ondatra.Header.gre:type_name -> ondatra.GreHeader
-	49,  // 58: ondatra.Header.ipv4:type_name -> ondatra.Ipv4Header
-	50,  // 59: ondatra.Header.ipv6:type_name -> ondatra.Ipv6Header
-	51,  // 60: ondatra.Header.mpls:type_name -> ondatra.MplsHeader
-	52,  // 61: ondatra.Header.tcp:type_name -> ondatra.TcpHeader
-	53,  // 62: ondatra.Header.udp:type_name -> ondatra.UdpHeader
-	54,  // 63: ondatra.Header.http:type_name -> ondatra.HttpHeader
-	55,  // 64: ondatra.Header.icmp:type_name -> ondatra.IcmpHeader
-	56,  // 65: ondatra.Header.ospf:type_name -> ondatra.OspfHeader
-	57,  // 66: ondatra.Header.rsvp:type_name -> ondatra.RsvpHeader
-	58,  // 67: ondatra.Header.pim:type_name -> ondatra.PimHeader
-	59,  // 68: ondatra.Header.ldp:type_name -> ondatra.LdpHeader
-	64,  // 69: ondatra.EthernetHeader.src_addr:type_name -> ondatra.AddressRange
-	64,  // 70: ondatra.EthernetHeader.dst_addr:type_name -> ondatra.AddressRange
-	64,  // 71: ondatra.Ipv4Header.src_addr:type_name -> ondatra.AddressRange
-	64,  // 72: ondatra.Ipv4Header.dst_addr:type_name -> ondatra.AddressRange
-	64,  // 73: ondatra.Ipv6Header.src_addr:type_name -> ondatra.AddressRange
-	64,  // 74: ondatra.Ipv6Header.dst_addr:type_name -> ondatra.AddressRange
-	63,  // 75: ondatra.Ipv6Header.flow_label:type_name -> ondatra.UIntRange
-	63,  // 76: ondatra.MplsHeader.label:type_name -> ondatra.UIntRange
-	63,  // 77: ondatra.TcpHeader.src_port:type_name -> ondatra.UIntRange
-	63,  // 78: ondatra.TcpHeader.dst_port:type_name -> ondatra.UIntRange
-	63,  // 79: ondatra.UdpHeader.src_port:type_name -> ondatra.UIntRange
-	63,  // 80: ondatra.UdpHeader.dst_port:type_name -> ondatra.UIntRange
-	97,  // 81: ondatra.IcmpHeader.echo_reply:type_name -> ondatra.IcmpHeader.EchoReply
-	98,  // 82: ondatra.IcmpHeader.destination_unreachable:type_name -> ondatra.IcmpHeader.DestinationUnreachable
-	99,  // 83: ondatra.IcmpHeader.redirect_message:type_name -> ondatra.IcmpHeader.RedirectMessage
-	100, // 84: ondatra.IcmpHeader.echo_request:type_name -> ondatra.IcmpHeader.EchoRequest
-	101, // 85: ondatra.IcmpHeader.time_exceeded:type_name -> ondatra.IcmpHeader.TimeExceeded
-	102, // 86: ondatra.IcmpHeader.parameter_problem:type_name -> ondatra.IcmpHeader.ParameterProblem
-	103, // 87: ondatra.IcmpHeader.timestamp:type_name -> ondatra.IcmpHeader.Timestamp
-	104, // 88: ondatra.IcmpHeader.timestamp_reply:type_name -> ondatra.IcmpHeader.TimestampReply
-	105, // 89: ondatra.OspfHeader.hello:type_name -> ondatra.OspfHeader.Hello
-	106, // 90: ondatra.OspfHeader.dbd:type_name -> ondatra.OspfHeader.DatabaseDescription
-	107, // 91: ondatra.OspfHeader.lsr:type_name -> ondatra.OspfHeader.LinkStateRequest
-	109, // 92: ondatra.OspfHeader.lsu:type_name -> ondatra.OspfHeader.LinkStateUpdate
-	110, // 93: ondatra.OspfHeader.lsa:type_name -> ondatra.OspfHeader.LinkStateAck
-	19,  // 94: ondatra.RsvpHeader.message_type:type_name -> ondatra.RsvpHeader.MessageType
-	112, // 95: ondatra.PimHeader.hello:type_name -> ondatra.PimHeader.Hello
-	113, // 96: ondatra.LdpHeader.hello:type_name -> ondatra.LdpHeader.Hello
-	61,  // 97: ondatra.IpAddressGenerator.list:type_name -> ondatra.IpAddressList
-	62,  // 98: ondatra.IpAddressGenerator.random:type_name -> ondatra.IpAddressRandom
-	2,   // 99: ondatra.MacSec.MKA.capability:type_name -> ondatra.MacSec.MKA.Capability
-	3,   // 100: ondatra.MacSec.MKA.confidentiality_offset:type_name -> ondatra.MacSec.MKA.ConfidentialityOffset
-	1,   // 101: ondatra.MacSec.MKA.cipher_suite:type_name -> ondatra.MacSec.CipherSuite
-	69,  // 102: ondatra.MacSec.MKA.connectivity_association:type_name -> ondatra.MacSec.MKA.ConnectivityAssociation
-	73,  // 103: ondatra.ISReachability.Node.links:type_name -> ondatra.ISReachability.Node.Link
-	30,  // 104: ondatra.ISReachability.Node.segment_routing:type_name -> ondatra.ISISSegmentRouting
-	74,  // 105: ondatra.ISReachability.Node.routes_ipv4:type_name -> ondatra.ISReachability.Node.Routes
-	31,  // 106: ondatra.ISReachability.Node.Routes.reachability:type_name -> ondatra.IPReachability
-	66,  // 107: ondatra.BgpPeer.SrtePolicyGroup.policy_color:type_name -> ondatra.UInt32IncRange
-	65,  // 108: ondatra.BgpPeer.SrtePolicyGroup.originator_id:type_name -> ondatra.StringIncRange
-	33,  // 109: ondatra.BgpPeer.SrtePolicyGroup.communities:type_name -> ondatra.BgpCommunities
-	0,   // 110: ondatra.BgpPeer.SrtePolicyGroup.asn_set_mode:type_name -> ondatra.BgpAsnSetMode
-	77,  // 111: ondatra.BgpPeer.SrtePolicyGroup.preference:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Preference
-	78,  // 112: ondatra.BgpPeer.SrtePolicyGroup.binding:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Binding
-	79,  // 113: ondatra.BgpPeer.SrtePolicyGroup.segment_lists:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList
-	80,  // 114: ondatra.BgpPeer.SrtePolicyGroup.enlp:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Enlp
-	114, // 115: ondatra.BgpPeer.SrtePolicyGroup.Binding.no_binding:type_name -> google.protobuf.Empty
-	66,  // 116: ondatra.BgpPeer.SrtePolicyGroup.Binding.four_octet_sid:type_name -> ondatra.UInt32IncRange
-	66,  // 117: ondatra.BgpPeer.SrtePolicyGroup.Binding.four_octet_sid_as_mpls_label:type_name -> ondatra.UInt32IncRange
-	81,  // 118: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.weight:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Weight
-	82,  // 119: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.segments:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment
-	83,  // 120: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.mpls_sid:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.MplsSid
-	86,  // 121: ondatra.BgpAttributes.ExtendedCommunity.color:type_name -> ondatra.BgpAttributes.ExtendedCommunity.Color
-	11,  // 122: ondatra.BgpAttributes.AsPathSegment.type:type_name -> ondatra.BgpAttributes.AsPathSegment.Type
-	10,  // 123: ondatra.BgpAttributes.ExtendedCommunity.Color.co_bits:type_name -> ondatra.BgpAttributes.ExtendedCommunity.Color.CoBits
-	88,  // 124: ondatra.RsvpConfig.Loopback.ingress_lsps:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP
-	89,  // 125: ondatra.RsvpConfig.Loopback.IngressLSP.eros:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP.ERO
-	90,  // 126: ondatra.RsvpConfig.Loopback.IngressLSP.rros:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP.RRO
-	12,  // 127: ondatra.Network.ImportedBgpRoutes.route_table_format:type_name -> ondatra.Network.ImportedBgpRoutes.RouteTableFormat
-	95,  // 128: ondatra.FrameSize.ImixCustom.entries:type_name -> ondatra.FrameSize.ImixCustomEntry
-	15,  // 129: ondatra.IcmpHeader.DestinationUnreachable.code:type_name -> ondatra.IcmpHeader.DestinationUnreachable.Code
-	16,  // 130: ondatra.IcmpHeader.RedirectMessage.code:type_name -> ondatra.IcmpHeader.RedirectMessage.Code
-	17,  // 131: ondatra.IcmpHeader.TimeExceeded.code:type_name -> ondatra.IcmpHeader.TimeExceeded.Code
-	18,  // 132: ondatra.OspfHeader.LinkStateRequest.type:type_name -> ondatra.OspfHeader.LinkStateType
-	18,  // 133: ondatra.OspfHeader.LinkStateAdvertisementHeader.type:type_name -> ondatra.OspfHeader.LinkStateType
-	111, // 134: ondatra.OspfHeader.LinkStateUpdate.advertisements:type_name -> ondatra.OspfHeader.LinkStateUpdate.Advertisement
-	108, // 135: ondatra.OspfHeader.LinkStateAck.headers:type_name -> ondatra.OspfHeader.LinkStateAdvertisementHeader
-	108, // 136: ondatra.OspfHeader.LinkStateUpdate.Advertisement.header:type_name -> ondatra.OspfHeader.LinkStateAdvertisementHeader
-	137, // [137:137] is the sub-list for method output_type
-	137, // [137:137] is the sub-list for method input_type
-	137, // [137:137] is the sub-list for extension type_name
-	137, // [137:137] is the sub-list for extension extendee
-	0,   // [0:137] is the sub-list for field type_name
+	38,  // 0: ondatra.Topology.lags:type_name -> ondatra.Lag
+	39,  // 1: ondatra.Topology.interfaces:type_name -> ondatra.InterfaceConfig
+	72,  // 2: ondatra.Traffic.flows:type_name -> ondatra.Flow
+	104, // 3: ondatra.Lag.lacp:type_name -> ondatra.Lag.Lacp
+	49,  // 4: ondatra.InterfaceConfig.ethernet:type_name -> ondatra.EthernetConfig
+	53,  // 5: ondatra.InterfaceConfig.ipv4:type_name -> ondatra.IpConfig
+	53,  // 6: ondatra.InterfaceConfig.ipv6:type_name -> ondatra.IpConfig
+	55,  // 7: ondatra.InterfaceConfig.isis:type_name -> ondatra.ISISConfig
+	63,  // 8: ondatra.InterfaceConfig.bgp:type_name -> ondatra.BgpConfig
+	66,  // 9: ondatra.InterfaceConfig.rsvp:type_name -> ondatra.RsvpConfig
+	67,  // 10: ondatra.InterfaceConfig.networks:type_name -> ondatra.Network
+	40,  // 11: ondatra.InterfaceConfig.dhcp:type_name -> ondatra.DhcpConfig
+	41,  // 12: ondatra.InterfaceConfig.bfd:type_name -> ondatra.BfdConfig
+	42,  // 13: ondatra.InterfaceConfig.igmp_host:type_name -> ondatra.IgmpHostConfig
+	43,  // 14: ondatra.InterfaceConfig.mld_host:type_name -> ondatra.MldHostConfig
+	45,  // 15: ondatra.InterfaceConfig.pim:type_name -> ondatra.PimConfig
+	46,  // 16: ondatra.InterfaceConfig.ldp:type_name -> ondatra.LdpConfig
+	48,  // 17: ondatra.InterfaceConfig.dot1x:type_name -> ondatra.Dot1xConfig
+	47,  // 18: ondatra.InterfaceConfig.ptp:type_name -> ondatra.PtpConfig
+	105, // 19: ondatra.DhcpConfig.v4_client:type_name -> ondatra.DhcpConfig.Client
+	105, // 20: ondatra.DhcpConfig.v6_client:type_name -> ondatra.DhcpConfig.Client
+	106, // 21: ondatra.DhcpConfig.v4_server:type_name -> ondatra.DhcpConfig.Server
+	106, // 22: ondatra.DhcpConfig.v6_server:type_name -> ondatra.DhcpConfig.Server
+	3,   // 23: ondatra.IgmpHostConfig.version:type_name -> ondatra.IgmpHostConfig.Version
+	44,  // 24: ondatra.IgmpHostConfig.groups:type_name -> ondatra.MulticastGroup
+	4,   // 25: ondatra.MldHostConfig.version:type_name -> ondatra.MldHostConfig.Version
+	44,  // 26: ondatra.MldHostConfig.groups:type_name -> ondatra.MulticastGroup
+	107, // 27: ondatra.PimConfig.join_prunes:type_name -> ondatra.PimConfig.JoinPrune
+	108, // 28: ondatra.PimConfig.candidate_rps:type_name -> ondatra.PimConfig.CandidateRp
+	6,   // 29: ondatra.PtpConfig.role:type_name -> ondatra.PtpConfig.Role
+	7,   // 30: ondatra.PtpConfig.transport:type_name -> ondatra.PtpConfig.Transport
+	8,   // 31: ondatra.PtpConfig.delay_mechanism:type_name -> ondatra.PtpConfig.DelayMechanism
+	9,   // 32: ondatra.Dot1xConfig.eap_method:type_name -> ondatra.Dot1xConfig.EapMethod
+	51,  // 33: ondatra.EthernetConfig.macsec:type_name -> ondatra.MacSec
+	50,  // 34: ondatra.EthernetConfig.fec:type_name -> ondatra.Fec
+	10,  // 35: ondatra.MacSec.cipher_suite:type_name -> ondatra.MacSec.CipherSuite
+	52,  // 36: ondatra.MacSec.rx_sak_pool:type_name -> ondatra.RxSakPool
+	109, // 37: ondatra.MacSec.mka:type_name -> ondatra.MacSec.MKA
+	54,  // 38: ondatra.IpConfig.neighbor_discovery:type_name -> ondatra.NeighborDiscoveryConfig
+	13,  // 39: ondatra.ISISConfig.level:type_name -> ondatra.ISISConfig.Level
+	14,  // 40: ondatra.ISISConfig.network_type:type_name -> ondatra.ISISConfig.NetworkType
+	15,  // 41: ondatra.ISISConfig.auth_type:type_name -> ondatra.ISISConfig.AuthType
+	58,  // 42: ondatra.ISISConfig.ip_reachability:type_name -> ondatra.IPReachability
+	59,  // 43: ondatra.ISISConfig.is_reachability:type_name -> ondatra.ISReachability
+	57,  // 44: ondatra.ISISConfig.segment_routing:type_name -> ondatra.ISISSegmentRouting
+	15,  // 45: ondatra.ISISConfig.area_auth_type:type_name -> ondatra.ISISConfig.AuthType
+	15,  // 46: ondatra.ISISConfig.domain_auth_type:type_name -> ondatra.ISISConfig.AuthType
+	56,  // 47: ondatra.ISISConfig.te_attributes:type_name -> ondatra.ISISTrafficEngineering
+	111, // 48: ondatra.ISISSegmentRouting.adjacency_sid:type_name -> ondatra.ISISSegmentRouting.AdjacencySID
+	112, // 49: ondatra.ISISSegmentRouting.srgb_range:type_name -> ondatra.ISISSegmentRouting.SIDRange
+	112, // 50: ondatra.ISISSegmentRouting.srlb_range:type_name -> ondatra.ISISSegmentRouting.SIDRange
+	16,  // 51: ondatra.IPReachability.route_origin:type_name -> ondatra.IPReachability.RouteOrigin
+	113, // 52: ondatra.ISReachability.nodes:type_name -> ondatra.ISReachability.Node
+	17,  // 53: ondatra.PortL1Config.fec_mode:type_name -> ondatra.PortL1Config.FecMode
+	18,  // 54: ondatra.PortL1Config.auto_negotiation:type_name -> ondatra.PortL1Config.AutoNegotiation
+	72,  // 55: ondatra.Impairment.flows:type_name -> ondatra.Flow
+	64,  // 56: ondatra.BgpConfig.bgp_peers:type_name -> ondatra.BgpPeer
+	19,  // 57: ondatra.BgpPeer.type:type_name -> ondatra.BgpPeer.Type
+	116, // 58: ondatra.BgpPeer.capabilities:type_name -> ondatra.BgpPeer.Capabilities
+	117, // 59: ondatra.BgpPeer.srte_policy_groups:type_name -> ondatra.BgpPeer.SrtePolicyGroup
+	20,  // 60: ondatra.BgpPeer.add_path_mode:type_name -> ondatra.BgpPeer.AddPathMode
+	21,  // 61: ondatra.BgpAttributes.origin:type_name -> ondatra.BgpAttributes.Origin
+	62,  // 62: ondatra.BgpAttributes.communities:type_name -> ondatra.BgpCommunities
+	125, // 63: ondatra.BgpAttributes.extended_communities:type_name -> ondatra.BgpAttributes.ExtendedCommunity
+	2,   // 64: ondatra.BgpAttributes.asn_set_mode:type_name -> ondatra.BgpAsnSetMode
+	126, // 65: ondatra.BgpAttributes.as_path_segments:type_name -> ondatra.BgpAttributes.AsPathSegment
+	102, // 66: ondatra.BgpAttributes.originator_id:type_name -> ondatra.StringIncRange
+	127, // 67: ondatra.BgpAttributes.add_paths:type_name -> ondatra.BgpAttributes.AddPaths
+	128, // 68: ondatra.BgpAttributes.med:type_name -> ondatra.BgpAttributes.Med
+	129, // 69: ondatra.BgpAttributes.aigp:type_name -> ondatra.BgpAttributes.Aigp
+	131, // 70: ondatra.RsvpConfig.loopbacks:type_name -> ondatra.RsvpConfig.Loopback
+	70,  // 71: ondatra.Network.eth:type_name -> ondatra.NetworkEth
+	71,  // 72: ondatra.Network.ipv4:type_name -> ondatra.NetworkIp
+	71,  // 73: ondatra.Network.ipv6:type_name -> ondatra.NetworkIp
+	65,  // 74: ondatra.Network.bgp_attributes:type_name -> ondatra.BgpAttributes
+	58,  // 75: ondatra.Network.isis:type_name -> ondatra.IPReachability
+	135, // 76: ondatra.Network.imported_bgp_routes:type_name -> ondatra.Network.ImportedBgpRoutes
+	69,  // 77: ondatra.Network.ldp:type_name -> ondatra.LdpFec
+	68,  // 78: ondatra.Network.generated_bgp_routes:type_name -> ondatra.GeneratedBgpRoutes
+	136, // 79: ondatra.GeneratedBgpRoutes.ipv4:type_name -> ondatra.GeneratedBgpRoutes.Prefixes
+	136, // 80: ondatra.GeneratedBgpRoutes.ipv6:type_name -> ondatra.GeneratedBgpRoutes.Prefixes
+	138, // 81: ondatra.Flow.src_endpoints:type_name -> ondatra.Flow.Endpoint
+	138, // 82: ondatra.Flow.dst_endpoints:type_name -> ondatra.Flow.Endpoint
+	79,  // 83: ondatra.Flow.headers:type_name -> ondatra.Header
+	75,  // 84: ondatra.Flow.frame_rate:type_name -> ondatra.FrameRate
+	78,  // 85: ondatra.Flow.egress_tracking:type_name -> ondatra.EgressTracking
+	139, // 86: ondatra.Flow.ingress_tracking_filters:type_name -> ondatra.Flow.IngressTrackingFilters
+	76,  // 87: ondatra.Flow.frame_size:type_name -> ondatra.FrameSize
+	77,  // 88: ondatra.Flow.transmission:type_name -> ondatra.Transmission
+	74,  // 89: ondatra.Flow.application:type_name -> ondatra.ApplicationTraffic
+	73,  // 90: ondatra.Flow.payload:type_name -> ondatra.Payload
+	25,  // 91: ondatra.Payload.type:type_name -> ondatra.Payload.Type
+	26,  // 92: ondatra.ApplicationTraffic.protocols:type_name -> ondatra.ApplicationTraffic.Protocol
+	140, // 93: ondatra.FrameSize.random:type_name -> ondatra.FrameSize.Random
+	27,  // 94: ondatra.FrameSize.imix_preset:type_name -> ondatra.FrameSize.ImixPreset
+	142, // 95: ondatra.FrameSize.imix_custom:type_name -> ondatra.FrameSize.ImixCustom
+	143, // 96: ondatra.FrameSize.increment:type_name -> ondatra.FrameSize.Increment
+	28,  // 97: ondatra.Transmission.pattern:type_name -> ondatra.Transmission.Pattern
+	29,  // 98: ondatra.EgressTracking.filter:type_name -> ondatra.EgressTracking.Filter
+	80,  // 99: ondatra.Header.eth:type_name -> ondatra.EthernetHeader
+	81,  // 100: ondatra.Header.gre:type_name -> ondatra.GreHeader
+	82,  // 101: ondatra.Header.ipv4:type_name -> ondatra.Ipv4Header
+	83,  // 102: ondatra.Header.ipv6:type_name -> ondatra.Ipv6Header
+	84,  // 103: ondatra.Header.mpls:type_name -> ondatra.MplsHeader
+	85,  // 104: ondatra.Header.tcp:type_name -> ondatra.TcpHeader
+	86,  // 105: ondatra.Header.udp:type_name -> ondatra.UdpHeader
+	87,  // 106: ondatra.Header.http:type_name -> ondatra.HttpHeader
+	88,  // 107: ondatra.Header.icmp:type_name -> ondatra.IcmpHeader
+	89,  // 108: ondatra.Header.ospf:type_name -> ondatra.OspfHeader
+	90,  // 109: ondatra.Header.rsvp:type_name -> ondatra.RsvpHeader
+	91,  // 110: ondatra.Header.pim:type_name -> ondatra.PimHeader
+	92,  // 111: ondatra.Header.ldp:type_name -> ondatra.LdpHeader
+	93,  // 112: ondatra.Header.vxlan:type_name -> ondatra.VxlanHeader
+	94,  // 113: ondatra.Header.geneve:type_name -> ondatra.GeneveHeader
+	95,  // 114: ondatra.Header.gtpu:type_name -> ondatra.GtpuHeader
+	96,  // 115: ondatra.Header.custom:type_name -> ondatra.CustomHeader
+	101, // 116: ondatra.EthernetHeader.src_addr:type_name -> ondatra.AddressRange
+	101, // 117: ondatra.EthernetHeader.dst_addr:type_name -> ondatra.AddressRange
+	101, // 118: ondatra.Ipv4Header.src_addr:type_name -> ondatra.AddressRange
+	101, // 119: ondatra.Ipv4Header.dst_addr:type_name -> ondatra.AddressRange
+	101, // 120: ondatra.Ipv6Header.src_addr:type_name -> ondatra.AddressRange
+	101, // 121: ondatra.Ipv6Header.dst_addr:type_name -> ondatra.AddressRange
+	100, // 122: ondatra.Ipv6Header.flow_label:type_name -> ondatra.UIntRange
+	100, // 123: ondatra.MplsHeader.label:type_name -> ondatra.UIntRange
+	100, // 124: ondatra.TcpHeader.src_port:type_name -> ondatra.UIntRange
+	100, // 125: ondatra.TcpHeader.dst_port:type_name -> ondatra.UIntRange
+	100, // 126: ondatra.UdpHeader.src_port:type_name -> ondatra.UIntRange
+	100, // 127: ondatra.UdpHeader.dst_port:type_name -> ondatra.UIntRange
+	144, // 128: ondatra.IcmpHeader.echo_reply:type_name -> ondatra.IcmpHeader.EchoReply
+	145, // 129: ondatra.IcmpHeader.destination_unreachable:type_name -> ondatra.IcmpHeader.DestinationUnreachable
+	146, // 130: ondatra.IcmpHeader.redirect_message:type_name -> ondatra.IcmpHeader.RedirectMessage
+	147, // 131: ondatra.IcmpHeader.echo_request:type_name -> ondatra.IcmpHeader.EchoRequest
+	148, // 132: ondatra.IcmpHeader.time_exceeded:type_name -> ondatra.IcmpHeader.TimeExceeded
+	149, // 133: ondatra.IcmpHeader.parameter_problem:type_name -> ondatra.IcmpHeader.ParameterProblem
+	150, // 134: ondatra.IcmpHeader.timestamp:type_name -> ondatra.IcmpHeader.Timestamp
+	151, // 135: ondatra.IcmpHeader.timestamp_reply:type_name -> ondatra.IcmpHeader.TimestampReply
+	152, // 136: ondatra.OspfHeader.hello:type_name -> ondatra.OspfHeader.Hello
+	153, // 137: ondatra.OspfHeader.dbd:type_name -> ondatra.OspfHeader.DatabaseDescription
+	154, // 138: ondatra.OspfHeader.lsr:type_name -> ondatra.OspfHeader.LinkStateRequest
+	156, // 139: ondatra.OspfHeader.lsu:type_name -> ondatra.OspfHeader.LinkStateUpdate
+	157, // 140: ondatra.OspfHeader.lsa:type_name -> ondatra.OspfHeader.LinkStateAck
+	34,  // 141: ondatra.RsvpHeader.message_type:type_name -> ondatra.RsvpHeader.MessageType
+	159, // 142: ondatra.PimHeader.hello:type_name -> ondatra.PimHeader.Hello
+	160, // 143: ondatra.LdpHeader.hello:type_name -> ondatra.LdpHeader.Hello
+	100, // 144: ondatra.VxlanHeader.vni:type_name -> ondatra.UIntRange
+	100, // 145: ondatra.GeneveHeader.vni:type_name -> ondatra.UIntRange
+	100, // 146: ondatra.GtpuHeader.teid:type_name -> ondatra.UIntRange
+	161, // 147: ondatra.GtpuHeader.pdu_session_container:type_name -> ondatra.GtpuHeader.PduSessionContainer
+	98,  // 148: ondatra.IpAddressGenerator.list:type_name -> ondatra.IpAddressList
+	99,  // 149: ondatra.IpAddressGenerator.random:type_name -> ondatra.IpAddressRandom
+	5,   // 150: ondatra.PimConfig.JoinPrune.range_type:type_name -> ondatra.PimConfig.JoinPrune.RangeType
+	11,  // 151: ondatra.MacSec.MKA.capability:type_name -> ondatra.MacSec.MKA.Capability
+	12,  // 152: ondatra.MacSec.MKA.confidentiality_offset:type_name -> ondatra.MacSec.MKA.ConfidentialityOffset
+	10,  // 153: ondatra.MacSec.MKA.cipher_suite:type_name -> ondatra.MacSec.CipherSuite
+	110, // 154: ondatra.MacSec.MKA.connectivity_association:type_name -> ondatra.MacSec.MKA.ConnectivityAssociation
+	114, // 155: ondatra.ISReachability.Node.links:type_name -> ondatra.ISReachability.Node.Link
+	57,  // 156: ondatra.ISReachability.Node.segment_routing:type_name -> ondatra.ISISSegmentRouting
+	115, // 157: ondatra.ISReachability.Node.routes_ipv4:type_name -> ondatra.ISReachability.Node.Routes
+	56,  // 158: ondatra.ISReachability.Node.Link.te_attributes:type_name -> ondatra.ISISTrafficEngineering
+	58,  // 159: ondatra.ISReachability.Node.Routes.reachability:type_name -> ondatra.IPReachability
+	103, // 160: ondatra.BgpPeer.SrtePolicyGroup.policy_color:type_name -> ondatra.UInt32IncRange
+	102, // 161: ondatra.BgpPeer.SrtePolicyGroup.originator_id:type_name -> ondatra.StringIncRange
+	62,  // 162: ondatra.BgpPeer.SrtePolicyGroup.communities:type_name -> ondatra.BgpCommunities
+	2,   // 163: ondatra.BgpPeer.SrtePolicyGroup.asn_set_mode:type_name -> ondatra.BgpAsnSetMode
+	118, // 164: ondatra.BgpPeer.SrtePolicyGroup.preference:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Preference
+	119, // 165: ondatra.BgpPeer.SrtePolicyGroup.binding:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Binding
+	120, // 166: ondatra.BgpPeer.SrtePolicyGroup.segment_lists:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList
+	121, // 167: ondatra.BgpPeer.SrtePolicyGroup.enlp:type_name -> ondatra.BgpPeer.SrtePolicyGroup.Enlp
+	162, // 168: ondatra.BgpPeer.SrtePolicyGroup.Binding.no_binding:type_name -> google.protobuf.Empty
+	103, // 169: ondatra.BgpPeer.SrtePolicyGroup.Binding.four_octet_sid:type_name -> ondatra.UInt32IncRange
+	103, // 170: ondatra.BgpPeer.SrtePolicyGroup.Binding.four_octet_sid_as_mpls_label:type_name -> ondatra.UInt32IncRange
+	122, // 171: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.weight:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Weight
+	123, // 172: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.segments:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment
+	124, // 173: ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.mpls_sid:type_name -> ondatra.BgpPeer.SrtePolicyGroup.SegmentList.Segment.MplsSid
+	130, // 174: ondatra.BgpAttributes.ExtendedCommunity.color:type_name -> ondatra.BgpAttributes.ExtendedCommunity.Color
+	23,  // 175: ondatra.BgpAttributes.AsPathSegment.type:type_name -> ondatra.BgpAttributes.AsPathSegment.Type
+	22,  // 176: ondatra.BgpAttributes.ExtendedCommunity.Color.co_bits:type_name -> ondatra.BgpAttributes.ExtendedCommunity.Color.CoBits
+	132, // 177: ondatra.RsvpConfig.Loopback.ingress_lsps:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP
+	133, // 178: ondatra.RsvpConfig.Loopback.IngressLSP.eros:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP.ERO
+	134, // 179: ondatra.RsvpConfig.Loopback.IngressLSP.rros:type_name -> ondatra.RsvpConfig.Loopback.IngressLSP.RRO
+	24,  // 180: ondatra.Network.ImportedBgpRoutes.route_table_format:type_name -> ondatra.Network.ImportedBgpRoutes.RouteTableFormat
+	137, // 181: ondatra.GeneratedBgpRoutes.Prefixes.prefix_length_weights:type_name -> ondatra.GeneratedBgpRoutes.Prefixes.PrefixLengthWeightsEntry
+	141, // 182: ondatra.FrameSize.ImixCustom.entries:type_name -> ondatra.FrameSize.ImixCustomEntry
+	30,  // 183: ondatra.IcmpHeader.DestinationUnreachable.code:type_name -> ondatra.IcmpHeader.DestinationUnreachable.Code
+	31,  // 184: ondatra.IcmpHeader.RedirectMessage.code:type_name -> ondatra.IcmpHeader.RedirectMessage.Code
+	32,  // 185: ondatra.IcmpHeader.TimeExceeded.code:type_name -> ondatra.IcmpHeader.TimeExceeded.Code
+	33,  // 186: ondatra.OspfHeader.LinkStateRequest.type:type_name -> ondatra.OspfHeader.LinkStateType
+	33,  // 187: ondatra.OspfHeader.LinkStateAdvertisementHeader.type:type_name -> ondatra.OspfHeader.LinkStateType
+	158, // 188: ondatra.OspfHeader.LinkStateUpdate.advertisements:type_name -> ondatra.OspfHeader.LinkStateUpdate.Advertisement
+	155, // 189: ondatra.OspfHeader.LinkStateAck.headers:type_name -> ondatra.OspfHeader.LinkStateAdvertisementHeader
+	155, // 190: ondatra.OspfHeader.LinkStateUpdate.Advertisement.header:type_name -> ondatra.OspfHeader.LinkStateAdvertisementHeader
+	35,  // 191: ondatra.GtpuHeader.PduSessionContainer.pdu_type:type_name -> ondatra.GtpuHeader.PduSessionContainer.PduType
+	100, // 192: ondatra.GtpuHeader.PduSessionContainer.qfi:type_name -> ondatra.UIntRange
+	193, // [193:193] is the sub-list for method output_type
+	193, // [193:193] is the sub-list for method input_type
+	193, // [193:193] is the sub-list for extension type_name
+	193, // [193:193] is the sub-list for extension extendee
+	0,   // [0:193] is the sub-list for field type_name
 }
 
 func init() { file_ate_proto_init() }
@@ -10057,8 +14190,80 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Traffic); i {
+		file_ate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Traffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DhcpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IgmpHostConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MldHostConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10069,8 +14274,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lag); i {
+		file_ate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MulticastGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10081,8 +14286,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterfaceConfig); i {
+		file_ate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PimConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10093,7 +14298,43 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PtpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dot1XConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EthernetConfig); i {
 			case 0:
 				return &v.state
@@ -10105,7 +14346,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Fec); i {
 			case 0:
 				return &v.state
@@ -10117,7 +14358,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MacSec); i {
 			case 0:
 				return &v.state
@@ -10129,7 +14370,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RxSakPool); i {
 			case 0:
 				return &v.state
@@ -10141,7 +14382,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IpConfig); i {
 			case 0:
 				return &v.state
@@ -10153,7 +14394,19 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborDiscoveryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISISConfig); i {
 			case 0:
 				return &v.state
@@ -10165,7 +14418,19 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ISISTrafficEngineering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISISSegmentRouting); i {
 			case 0:
 				return &v.state
@@ -10177,7 +14442,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IPReachability); i {
 			case 0:
 				return &v.state
@@ -10189,7 +14454,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISReachability); i {
 			case 0:
 				return &v.state
@@ -10201,7 +14466,31 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortL1Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Impairment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpCommunities); i {
 			case 0:
 				return &v.state
@@ -10213,7 +14502,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpConfig); i {
 			case 0:
 				return &v.state
@@ -10225,8 +14514,152 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BgpPeer); i {
+		file_ate_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BgpPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BgpAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsvpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Network); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratedBgpRoutes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdpFec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkEth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkIp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationTraffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10237,8 +14670,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BgpAttributes); i {
+		file_ate_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transmission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10249,8 +14682,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsvpConfig); i {
+		file_ate_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressTracking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10261,8 +14694,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Network); i {
+		file_ate_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10273,8 +14706,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkEth); i {
+		file_ate_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthernetHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10285,8 +14718,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkIp); i {
+		file_ate_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GreHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10297,8 +14730,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Flow); i {
+		file_ate_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ipv4Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10309,8 +14742,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameRate); i {
+		file_ate_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ipv6Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10321,8 +14754,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrameSize); i {
+		file_ate_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MplsHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10333,8 +14766,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transmission); i {
+		file_ate_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10345,8 +14778,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressTracking); i {
+		file_ate_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UdpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10357,8 +14790,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Header); i {
+		file_ate_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10369,8 +14802,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthernetHeader); i {
+		file_ate_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IcmpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10381,8 +14814,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GreHeader); i {
+		file_ate_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OspfHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10393,8 +14826,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ipv4Header); i {
+		file_ate_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsvpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10405,8 +14838,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ipv6Header); i {
+		file_ate_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PimHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10417,8 +14850,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MplsHeader); i {
+		file_ate_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdpHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10429,8 +14862,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpHeader); i {
+		file_ate_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10441,8 +14874,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UdpHeader); i {
+		file_ate_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneveHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10453,8 +14886,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpHeader); i {
+		file_ate_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GtpuHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10465,8 +14898,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IcmpHeader); i {
+		file_ate_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10477,8 +14910,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OspfHeader); i {
+		file_ate_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpAddressGenerator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10489,8 +14922,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsvpHeader); i {
+		file_ate_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpAddressList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10501,8 +14934,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PimHeader); i {
+		file_ate_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpAddressRandom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10513,8 +14946,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LdpHeader); i {
+		file_ate_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UIntRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10525,8 +14958,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IpAddressGenerator); i {
+		file_ate_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10537,8 +14970,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IpAddressList); i {
+		file_ate_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringIncRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10549,8 +14982,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IpAddressRandom); i {
+		file_ate_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UInt32IncRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10561,8 +14994,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UIntRange); i {
+		file_ate_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lag_Lacp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10573,8 +15006,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressRange); i {
+		file_ate_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DhcpConfig_Client); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10585,8 +15018,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringIncRange); i {
+		file_ate_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DhcpConfig_Server); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10597,8 +15030,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UInt32IncRange); i {
+		file_ate_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PimConfig_JoinPrune); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10609,8 +15042,8 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lag_Lacp); i {
+		file_ate_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PimConfig_CandidateRp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10621,7 +15054,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MacSec_MKA); i {
 			case 0:
 				return &v.state
@@ -10633,7 +15066,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MacSec_MKA_ConnectivityAssociation); i {
 			case 0:
 				return &v.state
@@ -10645,7 +15078,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISISSegmentRouting_AdjacencySID); i {
 			case 0:
 				return &v.state
@@ -10657,7 +15090,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISISSegmentRouting_SIDRange); i {
 			case 0:
 				return &v.state
@@ -10669,7 +15102,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISReachability_Node); i {
 			case 0:
 				return &v.state
@@ -10681,7 +15114,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISReachability_Node_Link); i {
 			case 0:
 				return &v.state
@@ -10693,7 +15126,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ISReachability_Node_Routes); i {
 			case 0:
 				return &v.state
@@ -10705,7 +15138,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_Capabilities); i {
 			case 0:
 				return &v.state
@@ -10717,7 +15150,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup); i {
 			case 0:
 				return &v.state
@@ -10729,7 +15162,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_Preference); i {
 			case 0:
 				return &v.state
@@ -10741,7 +15174,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_Binding); i {
 			case 0:
 				return &v.state
@@ -10753,7 +15186,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_SegmentList); i {
 			case 0:
 				return &v.state
@@ -10765,7 +15198,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_Enlp); i {
 			case 0:
 				return &v.state
@@ -10777,7 +15210,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_SegmentList_Weight); i {
 			case 0:
 				return &v.state
@@ -10789,7 +15222,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_SegmentList_Segment); i {
 			case 0:
 				return &v.state
@@ -10801,7 +15234,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid); i {
 			case 0:
 				return &v.state
@@ -10813,7 +15246,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpAttributes_ExtendedCommunity); i {
 			case 0:
 				return &v.state
@@ -10825,7 +15258,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpAttributes_AsPathSegment); i {
 			case 0:
 				return &v.state
@@ -10837,7 +15270,43 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BgpAttributes_AddPaths); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BgpAttributes_Med); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BgpAttributes_Aigp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BgpAttributes_ExtendedCommunity_Color); i {
 			case 0:
 				return &v.state
@@ -10849,7 +15318,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsvpConfig_Loopback); i {
 			case 0:
 				return &v.state
@@ -10861,7 +15330,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsvpConfig_Loopback_IngressLSP); i {
 			case 0:
 				return &v.state
@@ -10873,7 +15342,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsvpConfig_Loopback_IngressLSP_ERO); i {
 			case 0:
 				return &v.state
@@ -10885,7 +15354,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsvpConfig_Loopback_IngressLSP_RRO); i {
 			case 0:
 				return &v.state
@@ -10897,7 +15366,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Network_ImportedBgpRoutes); i {
 			case 0:
 				return &v.state
@@ -10909,7 +15378,19 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratedBgpRoutes_Prefixes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Flow_Endpoint); i {
 			case 0:
 				return &v.state
@@ -10921,7 +15402,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Flow_IngressTrackingFilters); i {
 			case 0:
 				return &v.state
@@ -10933,7 +15414,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrameSize_Random); i {
 			case 0:
 				return &v.state
@@ -10945,7 +15426,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrameSize_ImixCustomEntry); i {
 			case 0:
 				return &v.state
@@ -10957,7 +15438,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrameSize_ImixCustom); i {
 			case 0:
 				return &v.state
@@ -10969,7 +15450,19 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSize_Increment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ate_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_EchoReply); i {
 			case 0:
 				return &v.state
@@ -10981,7 +15474,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_DestinationUnreachable); i {
 			case 0:
 				return &v.state
@@ -10993,7 +15486,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_RedirectMessage); i {
 			case 0:
 				return &v.state
@@ -11005,7 +15498,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_EchoRequest); i {
 			case 0:
 				return &v.state
@@ -11017,7 +15510,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_TimeExceeded); i {
 			case 0:
 				return &v.state
@@ -11029,7 +15522,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_ParameterProblem); i {
 			case 0:
 				return &v.state
@@ -11041,7 +15534,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_Timestamp); i {
 			case 0:
 				return &v.state
@@ -11053,7 +15546,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IcmpHeader_TimestampReply); i {
 			case 0:
 				return &v.state
@@ -11065,7 +15558,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_Hello); i {
 			case 0:
 				return &v.state
@@ -11077,7 +15570,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_DatabaseDescription); i {
 			case 0:
 				return &v.state
@@ -11089,7 +15582,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_LinkStateRequest); i {
 			case 0:
 				return &v.state
@@ -11101,7 +15594,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_LinkStateAdvertisementHeader); i {
 			case 0:
 				return &v.state
@@ -11113,7 +15606,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_LinkStateUpdate); i {
 			case 0:
 				return &v.state
@@ -11125,7 +15618,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_LinkStateAck); i {
 			case 0:
 				return &v.state
@@ -11137,7 +15630,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OspfHeader_LinkStateUpdate_Advertisement); i {
 			case 0:
 				return &v.state
@@ -11149,7 +15642,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PimHeader_Hello); i {
 			case 0:
 				return &v.state
@@ -11161,7 +15654,7 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
-		file_ate_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_ate_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LdpHeader_Hello); i {
 			case 0:
 				return &v.state
@@ -11173,27 +15666,40 @@ func file_ate_proto_init() {
 				return nil
 			}
 		}
+		file_ate_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GtpuHeader_PduSessionContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_ate_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*InterfaceConfig_Port)(nil),
 		(*InterfaceConfig_Lag)(nil),
 	}
-	file_ate_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*FrameRate_Percent)(nil),
 		(*FrameRate_Bps)(nil),
 		(*FrameRate_Fps)(nil),
 	}
-	file_ate_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[40].OneofWrappers = []interface{}{
 		(*FrameSize_Fixed)(nil),
 		(*FrameSize_Random_)(nil),
 		(*FrameSize_ImixPreset_)(nil),
 		(*FrameSize_ImixCustom_)(nil),
+		(*FrameSize_Increment_)(nil),
 	}
-	file_ate_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*Transmission_Nanoseconds)(nil),
 		(*Transmission_Bytes)(nil),
 	}
-	file_ate_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[43].OneofWrappers = []interface{}{
 		(*Header_Eth)(nil),
 		(*Header_Gre)(nil),
 		(*Header_Ipv4)(nil),
@@ -11207,8 +15713,12 @@ func file_ate_proto_init() {
 		(*Header_Rsvp)(nil),
 		(*Header_Pim)(nil),
 		(*Header_Ldp)(nil),
+		(*Header_Vxlan)(nil),
+		(*Header_Geneve)(nil),
+		(*Header_Gtpu)(nil),
+		(*Header_Custom)(nil),
 	}
-	file_ate_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[52].OneofWrappers = []interface{}{
 		(*IcmpHeader_EchoReply_)(nil),
 		(*IcmpHeader_DestinationUnreachable_)(nil),
 		(*IcmpHeader_RedirectMessage_)(nil),
@@ -11218,47 +15728,49 @@ func file_ate_proto_init() {
 		(*IcmpHeader_Timestamp_)(nil),
 		(*IcmpHeader_TimestampReply_)(nil),
 	}
-	file_ate_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*OspfHeader_Hello_)(nil),
 		(*OspfHeader_Dbd)(nil),
 		(*OspfHeader_Lsr)(nil),
 		(*OspfHeader_Lsu)(nil),
 		(*OspfHeader_Lsa)(nil),
 	}
-	file_ate_proto_msgTypes[38].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*PimHeader_Hello_)(nil),
 	}
-	file_ate_proto_msgTypes[39].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*LdpHeader_Hello_)(nil),
 	}
-	file_ate_proto_msgTypes[40].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[61].OneofWrappers = []interface{}{
 		(*IpAddressGenerator_List)(nil),
 		(*IpAddressGenerator_Random)(nil),
 	}
-	file_ate_proto_msgTypes[58].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[83].OneofWrappers = []interface{}{
 		(*BgpPeer_SrtePolicyGroup_Binding_NoBinding)(nil),
 		(*BgpPeer_SrtePolicyGroup_Binding_FourOctetSid)(nil),
 		(*BgpPeer_SrtePolicyGroup_Binding_FourOctetSidAsMplsLabel)(nil),
 		(*BgpPeer_SrtePolicyGroup_Binding_Ipv6Sid)(nil),
 	}
-	file_ate_proto_msgTypes[62].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[87].OneofWrappers = []interface{}{
 		(*BgpPeer_SrtePolicyGroup_SegmentList_Segment_MplsSid_)(nil),
 		(*BgpPeer_SrtePolicyGroup_SegmentList_Segment_Ipv6Sid)(nil),
 	}
-	file_ate_proto_msgTypes[64].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[89].OneofWrappers = []interface{}{
 		(*BgpAttributes_ExtendedCommunity_Color_)(nil),
 	}
-	file_ate_proto_msgTypes[72].OneofWrappers = []interface{}{
+	file_ate_proto_msgTypes[102].OneofWrappers = []interface{}{
 		(*Flow_Endpoint_NetworkName)(nil),
 		(*Flow_Endpoint_RsvpName)(nil),
+		(*Flow_Endpoint_IgmpGroups)(nil),
+		(*Flow_Endpoint_MldGroups)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ate_proto_rawDesc,
-			NumEnums:      20,
-			NumMessages:   94,
+			NumEnums:      36,
+			NumMessages:   126,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -242,6 +242,19 @@ type Device struct {
 	// binding supports filtering devices by a dimension named "label," the
 	// testbed could specify an extra dimensions map of {"label": "foo"}.
 	ExtraDimensions map[string]string `protobuf:"bytes,6,rep,name=extra_dimensions,json=extraDimensions,proto3" json:"extra_dimensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether the device may be absent from the reservation. Optional.
+	//
+	// A test that declares optional devices can run on multiple physical
+	// topologies, and must check which devices were reserved.
+	IsOptional bool `protobuf:"varint,7,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	// The minimum and maximum number of the ports of the device to reserve.
+	// Optional.
+	//
+	// The ports that are not optional are always reserved. By default, the
+	// minimum is the number of ports that are not optional, and the maximum is
+	// the number of ports of the device.
+	MinPorts int32 `protobuf:"varint,8,opt,name=min_ports,json=minPorts,proto3" json:"min_ports,omitempty"`
+	MaxPorts int32 `protobuf:"varint,9,opt,name=max_ports,json=maxPorts,proto3" json:"max_ports,omitempty"`
 }
 
 func (x *Device) Reset() {
@@ -318,6 +331,27 @@ func (x *Device) GetExtraDimensions() map[string]string {
 	return nil
 }
 
+func (x *Device) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *Device) GetMinPorts() int32 {
+	if x != nil {
+		return x.MinPorts
+	}
+	return 0
+}
+
+func (x *Device) GetMaxPorts() int32 {
+	if x != nil {
+		return x.MaxPorts
+	}
+	return 0
+}
+
 // A port.
 type Port struct {
 	state         protoimpl.MessageState
@@ -326,6 +360,9 @@ type Port struct {
 
 	Id    string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Speed Port_Speed `protobuf:"varint,2,opt,name=speed,proto3,enum=ondatra.Port_Speed" json:"speed,omitempty"`
+	// Whether the port may be absent from the reservation. Optional.
+	IsOptional bool           `protobuf:"varint,3,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	Breakout   *Port_Breakout `protobuf:"bytes,4,opt,name=breakout,proto3" json:"breakout,omitempty"`
 }
 
 func (x *Port) Reset() {
@@ -374,6 +411,20 @@ func (x *Port) GetSpeed() Port_Speed {
 	return Port_S_UNKNOWN
 }
 
+func (x *Port) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *Port) GetBreakout() *Port_Breakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
 // A physical link between ports on DUTs or ATEs.
 // The order does not matter: links are symmetrical.
 // A given port may be specified in at most one link (typically in exactly one
@@ -433,6 +484,65 @@ func (x *Link) GetB() string {
 	return ""
 }
 
+// The breakout of a physical port into multiple channels, such as 4x25G or
+// 2x50G. A breakout port is one of the channels of a physical port, so each
+// channel used by a test is declared as a separate port with the same
+// breakout. Optional.
+type Port_Breakout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumChannels      uint32 `protobuf:"varint,1,opt,name=num_channels,json=numChannels,proto3" json:"num_channels,omitempty"`
+	ChannelSpeedGbps uint32 `protobuf:"varint,2,opt,name=channel_speed_gbps,json=channelSpeedGbps,proto3" json:"channel_speed_gbps,omitempty"`
+}
+
+func (x *Port_Breakout) Reset() {
+	*x = Port_Breakout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testbed_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Port_Breakout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port_Breakout) ProtoMessage() {}
+
+func (x *Port_Breakout) ProtoReflect() protoreflect.Message {
+	mi := &file_testbed_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port_Breakout.ProtoReflect.Descriptor instead.
+func (*Port_Breakout) Descriptor() ([]byte, []int) {
+	return file_testbed_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Port_Breakout) GetNumChannels() uint32 {
+	if x != nil {
+		return x.NumChannels
+	}
+	return 0
+}
+
+func (x *Port_Breakout) GetChannelSpeedGbps() uint32 {
+	if x != nil {
+		return x.ChannelSpeedGbps
+	}
+	return 0
+}
+
 var File_testbed_proto protoreflect.FileDescriptor
 
 var file_testbed_proto_rawDesc = []byte{
@@ -445,7 +555,7 @@ var file_testbed_proto_rawDesc = []byte{
 	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x04, 0x61, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a,
 	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f,
 	0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e,
-	0x6b, 0x73, 0x22, 0xbd, 0x03, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a,
+	0x6b, 0x73, 0x22, 0x98, 0x04, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
 	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
 	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
@@ -462,31 +572,48 @@ var file_testbed_proto_rawDesc = []byte{
 	0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x69, 0x6d, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x42, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x69,
-	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x67, 0x0a, 0x06, 0x56, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x52, 0x49, 0x53, 0x54, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
-	0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x58, 0x49, 0x41, 0x10,
-	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x55, 0x4e, 0x49, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09,
-	0x0a, 0x05, 0x43, 0x49, 0x45, 0x4e, 0x41, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x4c,
-	0x4f, 0x41, 0x4c, 0x54, 0x4f, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f, 0x4b, 0x49, 0x41,
-	0x10, 0x07, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64,
-	0x61, 0x74, 0x72, 0x61, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52,
-	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x0d, 0x0a, 0x09, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x5f, 0x31, 0x30, 0x47, 0x42, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x5f,
-	0x31, 0x30, 0x30, 0x47, 0x42, 0x10, 0x64, 0x12, 0x0c, 0x0a, 0x07, 0x53, 0x5f, 0x34, 0x30, 0x30,
-	0x47, 0x42, 0x10, 0x90, 0x03, 0x22, 0x22, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0c, 0x0a,
-	0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2f, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x1a, 0x42, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x67, 0x0a, 0x06, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x52, 0x49, 0x53, 0x54, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x49, 0x53, 0x43, 0x4f,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x58, 0x49, 0x41, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x4a, 0x55, 0x4e, 0x49, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x49, 0x45,
+	0x4e, 0x41, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x4c, 0x4f, 0x41, 0x4c, 0x54, 0x4f,
+	0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f, 0x4b, 0x49, 0x41, 0x10, 0x07, 0x22, 0xb2, 0x02,
+	0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x12, 0x32, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x5b, 0x0a, 0x08, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x67, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x65, 0x64, 0x47,
+	0x62, 0x70, 0x73, 0x22, 0x3d, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x5f, 0x31, 0x30, 0x47, 0x42, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x5f, 0x31, 0x30, 0x30,
+	0x47, 0x42, 0x10, 0x64, 0x12, 0x0c, 0x0a, 0x07, 0x53, 0x5f, 0x34, 0x30, 0x30, 0x47, 0x42, 0x10,
+	0x90, 0x03, 0x22, 0x28, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x42, 0x25, 0x5a, 0x23,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -502,15 +629,16 @@ func file_testbed_proto_rawDescGZIP() []byte {
 }
 
 var file_testbed_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_testbed_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testbed_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_testbed_proto_goTypes = []interface{}{
-	(Device_Vendor)(0), // 0: ondatra.Device.Vendor
-	(Port_Speed)(0),    // 1: ondatra.Port.Speed
-	(*Testbed)(nil),    // 2: ondatra.Testbed
-	(*Device)(nil),     // 3: ondatra.Device
-	(*Port)(nil),       // 4: ondatra.Port
-	(*Link)(nil),       // 5: ondatra.Link
-	nil,                // 6: ondatra.Device.ExtraDimensionsEntry
+	(Device_Vendor)(0),    // 0: ondatra.Device.Vendor
+	(Port_Speed)(0),       // 1: ondatra.Port.Speed
+	(*Testbed)(nil),       // 2: ondatra.Testbed
+	(*Device)(nil),        // 3: ondatra.Device
+	(*Port)(nil),          // 4: ondatra.Port
+	(*Link)(nil),          // 5: ondatra.Link
+	nil,                   // 6: ondatra.Device.ExtraDimensionsEntry
+	(*Port_Breakout)(nil), // 7: ondatra.Port.Breakout
 }
 var file_testbed_proto_depIdxs = []int32{
 	3, // 0: ondatra.Testbed.duts:type_name -> ondatra.Device
@@ -520,11 +648,12 @@ var file_testbed_proto_depIdxs = []int32{
 	4, // 4: ondatra.Device.ports:type_name -> ondatra.Port
 	6, // 5: ondatra.Device.extra_dimensions:type_name -> ondatra.Device.ExtraDimensionsEntry
 	1, // 6: ondatra.Port.speed:type_name -> ondatra.Port.Speed
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7, // 7: ondatra.Port.breakout:type_name -> ondatra.Port.Breakout
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_testbed_proto_init() }
@@ -581,6 +710,18 @@ func file_testbed_proto_init() {
 				return nil
 			}
 		}
+		file_testbed_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Port_Breakout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -588,7 +729,7 @@ func file_testbed_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_testbed_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,112 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ondatra
+
+import (
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// PTP is a representation of a PTP (IEEE 1588) clock on the ATE.
+type PTP struct {
+	pb *opb.PtpConfig
+}
+
+// WithRoleMaster sets the clock to be a master clock.
+func (p *PTP) WithRoleMaster() *PTP {
+	p.pb.Role = opb.PtpConfig_MASTER
+	return p
+}
+
+// WithRoleSlave sets the clock to be a slave clock, which synchronizes to a
+// master clock and measures its offset from it.
+func (p *PTP) WithRoleSlave() *PTP {
+	p.pb.Role = opb.PtpConfig_SLAVE
+	return p
+}
+
+// WithTransportEthernet sets the clock to send PTP messages over Ethernet.
+func (p *PTP) WithTransportEthernet() *PTP {
+	p.pb.Transport = opb.PtpConfig_ETHERNET
+	return p
+}
+
+// WithTransportIPv4 sets the clock to send PTP messages over UDP from the
+// IPv4 address of the interface.
+func (p *PTP) WithTransportIPv4() *PTP {
+	p.pb.Transport = opb.PtpConfig_IPV4
+	return p
+}
+
+// WithTransportIPv6 sets the clock to send PTP messages over UDP from the
+// IPv6 address of the interface.
+func (p *PTP) WithTransportIPv6() *PTP {
+	p.pb.Transport = opb.PtpConfig_IPV6
+	return p
+}
+
+// WithDelayMechanismEndToEnd sets the clock to measure the path delay with
+// delay request and response messages.
+func (p *PTP) WithDelayMechanismEndToEnd() *PTP {
+	p.pb.DelayMechanism = opb.PtpConfig_END_TO_END
+	return p
+}
+
+// WithDelayMechanismPeerToPeer sets the clock to measure the path delay with
+// peer delay messages.
+func (p *PTP) WithDelayMechanismPeerToPeer() *PTP {
+	p.pb.DelayMechanism = opb.PtpConfig_PEER_TO_PEER
+	return p
+}
+
+// WithDomain sets the PTP domain number.
+func (p *PTP) WithDomain(domain uint32) *PTP {
+	p.pb.Domain = domain
+	return p
+}
+
+// WithPriority1 sets the priority1 of the clock in the best master clock
+// algorithm.
+func (p *PTP) WithPriority1(priority uint32) *PTP {
+	p.pb.Priority1 = priority
+	return p
+}
+
+// WithPriority2 sets the priority2 of the clock in the best master clock
+// algorithm.
+func (p *PTP) WithPriority2(priority uint32) *PTP {
+	p.pb.Priority2 = priority
+	return p
+}
+
+// WithLogSyncInterval sets the base 2 logarithm of the interval in seconds
+// between sync messages, e.g. -3 for eight messages per second.
+func (p *PTP) WithLogSyncInterval(logInterval int32) *PTP {
+	p.pb.LogSyncInterval = logInterval
+	return p
+}
+
+// WithLogAnnounceInterval sets the base 2 logarithm of the interval in seconds
+// between announce messages.
+func (p *PTP) WithLogAnnounceInterval(logInterval int32) *PTP {
+	p.pb.LogAnnounceInterval = logInterval
+	return p
+}
+
+// WithLogDelayReqInterval sets the base 2 logarithm of the interval in seconds
+// between delay request messages.
+func (p *PTP) WithLogDelayReqInterval(logInterval int32) *PTP {
+	p.pb.LogDelayReqInterval = logInterval
+	return p
+}
@@ -21,6 +21,9 @@ import (
 const (
 	maxFlowLabel uint32 = (1 << 20) - 1
 	maxPort      uint32 = (1 << 16) - 1
+	maxVNI       uint32 = (1 << 24) - 1
+	maxTEID      uint32 = (1 << 32) - 1
+	maxQFI       uint32 = (1 << 6) - 1
 )
 
 // UIntRange is a range of unsigned integers.
@@ -58,6 +61,14 @@ func (r *UIntRange) WithRandom() *UIntRange {
 	return r
 }
 
+// WithValues sets the values to be cycled through in order, instead of the
+// values of the range; the count must not also be set, nor the values be
+// random.
+func (r *UIntRange) WithValues(vals ...uint32) *UIntRange {
+	r.pb.Values = vals
+	return r
+}
+
 // AddressRange is a range of addresses.
 type AddressRange struct {
 	AddressIncRange
@@ -96,6 +107,14 @@ func (r *AddressRange) WithRandom() *AddressRange {
 	return r
 }
 
+// WithValues sets the addresses to be cycled through in order, instead of the
+// addresses of the range; the count must not also be set, nor the addresses be
+// random.
+func (r *AddressRange) WithValues(addrs ...string) *AddressRange {
+	r.pb.Values = addrs
+	return r
+}
+
 // AddressIncRange is a range network addresses that increment by a fixed step.
 type AddressIncRange struct {
 	pb *opb.AddressRange
@@ -177,6 +196,18 @@ func newFlowLabelRange() *opb.UIntRange {
 	return &opb.UIntRange{Max: maxFlowLabel}
 }
 
+func newVNIRange() *opb.UIntRange {
+	return &opb.UIntRange{Max: maxVNI}
+}
+
+func newTEIDRange() *opb.UIntRange {
+	return &opb.UIntRange{Min: 1, Max: maxTEID}
+}
+
+func newQFIRange() *opb.UIntRange {
+	return &opb.UIntRange{Max: maxQFI}
+}
+
 func newMACAddrRange() *opb.AddressRange {
 	return &opb.AddressRange{Min: "00:00:00:00:00:01", Max: "FF:FF:FF:FF:FF:FE"}
 }
@@ -95,7 +95,7 @@ func watch_AclPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pred
 	gs := &oc.Acl{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Acl_AclSetPath(t testing.TB, n ygot.PathStruct, duration time.Duratio
 	gs := &oc.Acl_AclSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -397,7 +397,7 @@ func watch_Acl_AclSet_AclEntryPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Acl_AclSet_AclEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -548,7 +548,7 @@ func watch_Acl_AclSet_AclEntry_ActionsPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Acl_AclSet_AclEntry_Actions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Actions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Actions", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_Actions{
 			Metadata: md,
 		}).SetVal(gs), nil
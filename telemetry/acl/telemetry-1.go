@@ -95,7 +95,7 @@ func watch_Acl_AclSet_AclEntry_InputInterfacePath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Acl_AclSet_AclEntry_InputInterface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_InputInterface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_InputInterface", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_InputInterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Acl_AclSet_AclEntry_InputInterface_InterfaceRefPath(t testing.TB, n y
 	gs := &oc.Acl_AclSet_AclEntry_InputInterface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_InputInterface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_InputInterface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_InputInterface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -703,7 +703,7 @@ func watch_Acl_AclSet_AclEntry_Ipv4Path(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.Acl_AclSet_AclEntry_Ipv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Ipv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Ipv4", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_Ipv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -707,7 +707,7 @@ func watch_Acl_AclSet_AclEntry_Ipv6Path(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.Acl_AclSet_AclEntry_Ipv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Ipv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Ipv6", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_Ipv6{
 			Metadata: md,
 		}).SetVal(gs), nil
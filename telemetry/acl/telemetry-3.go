@@ -1013,7 +1013,7 @@ func watch_Acl_AclSet_AclEntry_L2Path(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.Acl_AclSet_AclEntry_L2{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_L2", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_L2", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_L2{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Acl_AclSet_AclEntry_MplsPath(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.Acl_AclSet_AclEntry_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1011,7 +1011,7 @@ func watch_Acl_AclSet_AclEntry_TransportPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.Acl_AclSet_AclEntry_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_AclSet_AclEntry_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_AclSet_AclEntry_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
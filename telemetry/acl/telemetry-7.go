@@ -95,7 +95,7 @@ func watch_Acl_InterfacePath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Acl_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Acl_Interface_EgressAclSetPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Acl_Interface_EgressAclSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface_EgressAclSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface_EgressAclSet", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface_EgressAclSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -397,7 +397,7 @@ func watch_Acl_Interface_EgressAclSet_AclEntryPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Acl_Interface_EgressAclSet_AclEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface_EgressAclSet_AclEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface_EgressAclSet_AclEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface_EgressAclSet_AclEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -401,7 +401,7 @@ func watch_Acl_Interface_IngressAclSetPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Acl_Interface_IngressAclSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface_IngressAclSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface_IngressAclSet", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface_IngressAclSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_Acl_Interface_IngressAclSet_AclEntryPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Acl_Interface_IngressAclSet_AclEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface_IngressAclSet_AclEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface_IngressAclSet_AclEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface_IngressAclSet_AclEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
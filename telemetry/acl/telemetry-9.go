@@ -401,7 +401,7 @@ func watch_Acl_Interface_InterfaceRefPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Acl_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Acl_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Acl_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedAcl_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_FlowPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pre
 	gs := &oc.Flow{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Flow_CountersPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Flow_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
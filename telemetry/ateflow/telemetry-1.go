@@ -401,7 +401,7 @@ func watch_Flow_EgressTrackingPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Flow_EgressTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_EgressTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_EgressTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_EgressTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_Flow_EgressTracking_CountersPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.Flow_EgressTracking_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_EgressTracking_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_EgressTracking_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_EgressTracking_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -554,7 +554,7 @@ func watch_Flow_IngressTrackingPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.Flow_IngressTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_IngressTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_IngressTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_IngressTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -705,7 +705,7 @@ func watch_Flow_IngressTracking_CountersPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.Flow_IngressTracking_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_IngressTracking_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_IngressTracking_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_IngressTracking_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
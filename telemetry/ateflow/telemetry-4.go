@@ -860,7 +860,7 @@ func watch_Flow_IngressTracking_EgressTrackingPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Flow_IngressTracking_EgressTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_IngressTracking_EgressTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_IngressTracking_EgressTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_IngressTracking_EgressTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1011,7 +1011,7 @@ func watch_Flow_IngressTracking_EgressTracking_CountersPath(t testing.TB, n ygot
 	gs := &oc.Flow_IngressTracking_EgressTracking_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Flow_IngressTracking_EgressTracking_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Flow_IngressTracking_EgressTracking_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedFlow_IngressTracking_EgressTracking_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
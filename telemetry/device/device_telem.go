@@ -62,7 +62,7 @@ func watch_DevicePath(t testing.TB, n ygot.PathStruct, duration time.Duration, p
 	gs := &oc.Device{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Device", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Device", gs, queryPath, false, false)
 		return (&oc.QualifiedDevice{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5,6 +5,8 @@ package device
 // ONDATRA telemetry calls.
 
 import (
+	"time"
+
 	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
 	oc "github.com/openconfig/ondatra/telemetry"
 
@@ -25,6 +27,36 @@ func (n *DevicePath) WithSubscriptionMode(mode gpb.SubscriptionMode) *DevicePath
 	return n
 }
 
+// WithHeartbeatInterval specifies the heartbeat interval in the underlying
+// gNMI subscribe.
+func (n *DevicePath) WithHeartbeatInterval(interval time.Duration) *DevicePath {
+	genutil.PutHeartbeatInterval(n, interval)
+	return n
+}
+
+// WithUpdatesOnly specifies whether the underlying gNMI subscribe is updates
+// only, which suppresses the initial updates before the sync response.
+func (n *DevicePath) WithUpdatesOnly(updatesOnly bool) *DevicePath {
+	genutil.PutUpdatesOnly(n, updatesOnly)
+	return n
+}
+
+// WithStrictCompliance specifies whether responses that violate the gNMI spec,
+// such as by updating the same path twice in a notification, fail the call
+// rather than being normalized.
+func (n *DevicePath) WithStrictCompliance(strict bool) *DevicePath {
+	genutil.PutStrictCompliance(n, strict)
+	return n
+}
+
+// WithRetries specifies the maximum number of times that gNMI calls that fail
+// with a transient error are retried, and the time to wait before the first
+// retry, which doubles before each subsequent retry.
+func (n *DevicePath) WithRetries(maxRetries int, backoff time.Duration) *DevicePath {
+	genutil.PutRetryPolicy(n, &genutil.RetryPolicy{MaxRetries: maxRetries, Backoff: backoff})
+	return n
+}
+
 // WithClient allows the user to provide a gNMI client. This allows for creation
 // of tests for multiple gNMI clients to a single DUT.
 func (n *DevicePath) WithClient(c gpb.GNMIClient) *DevicePath {
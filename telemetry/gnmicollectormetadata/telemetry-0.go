@@ -95,7 +95,7 @@ func watch_MetaPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pre
 	gs := &oc.Meta{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Meta", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Meta", gs, queryPath, false, false)
 		return (&oc.QualifiedMeta{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -401,7 +401,7 @@ func watch_Meta_WindowPath(t testing.TB, n ygot.PathStruct, duration time.Durati
 	gs := &oc.Meta_Window{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Meta_Window", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Meta_Window", gs, queryPath, false, false)
 		return (&oc.QualifiedMeta_Window{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_InterfacePath(t testing.TB, n ygot.PathStruct, duration time.Duration
 	gs := &oc.Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -399,7 +399,7 @@ func watch_Interface_AggregationPath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.Interface_Aggregation{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Aggregation", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Aggregation", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Aggregation{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1162,7 +1162,7 @@ func watch_Interface_Aggregation_SwitchedVlanPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_Aggregation_SwitchedVlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Aggregation_SwitchedVlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Aggregation_SwitchedVlan", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Aggregation_SwitchedVlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1925,7 +1925,7 @@ func watch_Interface_CountersPath(t testing.TB, n ygot.PathStruct, duration time
 	gs := &oc.Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5291,7 +5291,7 @@ func watch_Interface_EthernetPath(t testing.TB, n ygot.PathStruct, duration time
 	gs := &oc.Interface_Ethernet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Ethernet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Ethernet", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Ethernet{
 			Metadata: md,
 		}).SetVal(gs), nil
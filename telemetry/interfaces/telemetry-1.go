@@ -250,7 +250,7 @@ func watch_Interface_Ethernet_CountersPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Interface_Ethernet_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Ethernet_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Ethernet_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Ethernet_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4842,7 +4842,7 @@ func watch_Interface_Ethernet_SwitchedVlanPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Interface_Ethernet_SwitchedVlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Ethernet_SwitchedVlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Ethernet_SwitchedVlan", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Ethernet_SwitchedVlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -248,7 +248,7 @@ func watch_Interface_HoldTimePath(t testing.TB, n ygot.PathStruct, duration time
 	gs := &oc.Interface_HoldTime{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_HoldTime", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_HoldTime", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_HoldTime{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2394,7 +2394,7 @@ func watch_Interface_RoutedVlanPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.Interface_RoutedVlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2545,7 +2545,7 @@ func watch_Interface_RoutedVlan_Ipv4Path(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.Interface_RoutedVlan_Ipv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2696,7 +2696,7 @@ func watch_Interface_RoutedVlan_Ipv4_AddressPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Interface_RoutedVlan_Ipv4_Address{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Address{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3306,7 +3306,7 @@ func watch_Interface_RoutedVlan_Ipv4_Address_VrrpGroupPath(t testing.TB, n ygot.
 	gs := &oc.Interface_RoutedVlan_Ipv4_Address_VrrpGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address_VrrpGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address_VrrpGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Address_VrrpGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3920,7 +3920,7 @@ func watch_Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTrackingPath(t t
 	gs := &oc.Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5150,7 +5150,7 @@ func watch_Interface_RoutedVlan_Ipv4_CountersPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_RoutedVlan_Ipv4_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
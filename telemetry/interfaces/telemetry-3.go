@@ -2088,7 +2088,7 @@ func watch_Interface_RoutedVlan_Ipv4_NeighborPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_RoutedVlan_Ipv4_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2698,7 +2698,7 @@ func watch_Interface_RoutedVlan_Ipv4_ProxyArpPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_RoutedVlan_Ipv4_ProxyArp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_ProxyArp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_ProxyArp", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_ProxyArp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3004,7 +3004,7 @@ func watch_Interface_RoutedVlan_Ipv4_UnnumberedPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_RoutedVlan_Ipv4_Unnumbered{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Unnumbered", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Unnumbered", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Unnumbered{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3310,7 +3310,7 @@ func watch_Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRefPath(t testing.TB, n
 	gs := &oc.Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3767,7 +3767,7 @@ func watch_Interface_RoutedVlan_Ipv6Path(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.Interface_RoutedVlan_Ipv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3918,7 +3918,7 @@ func watch_Interface_RoutedVlan_Ipv6_AddressPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Interface_RoutedVlan_Ipv6_Address{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Address{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4681,7 +4681,7 @@ func watch_Interface_RoutedVlan_Ipv6_Address_VrrpGroupPath(t testing.TB, n ygot.
 	gs := &oc.Interface_RoutedVlan_Ipv6_Address_VrrpGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address_VrrpGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address_VrrpGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Address_VrrpGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5295,7 +5295,7 @@ func watch_Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTrackingPath(t t
 	gs := &oc.Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1172,7 +1172,7 @@ func watch_Interface_RoutedVlan_Ipv6_CountersPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_RoutedVlan_Ipv6_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3777,7 +3777,7 @@ func watch_Interface_RoutedVlan_Ipv6_NeighborPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Interface_RoutedVlan_Ipv6_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4693,7 +4693,7 @@ func watch_Interface_RoutedVlan_Ipv6_RouterAdvertisementPath(t testing.TB, n ygo
 	gs := &oc.Interface_RoutedVlan_Ipv6_RouterAdvertisement{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_RouterAdvertisement", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_RouterAdvertisement", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_RouterAdvertisement{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5305,7 +5305,7 @@ func watch_Interface_RoutedVlan_Ipv6_UnnumberedPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_RoutedVlan_Ipv6_Unnumbered{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Unnumbered", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Unnumbered", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Unnumbered{
 			Metadata: md,
 		}).SetVal(gs), nil
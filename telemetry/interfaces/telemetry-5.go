@@ -95,7 +95,7 @@ func watch_Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRefPath(t testing.TB, n
 	gs := &oc.Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -705,7 +705,7 @@ func watch_Interface_SubinterfacePath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.Interface_Subinterface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1009,7 +1009,7 @@ func watch_Interface_Subinterface_CountersPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Interface_Subinterface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4683,7 +4683,7 @@ func watch_Interface_Subinterface_Ipv4Path(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Interface_Subinterface_Ipv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4834,7 +4834,7 @@ func watch_Interface_Subinterface_Ipv4_AddressPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Interface_Subinterface_Ipv4_Address{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Address{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5444,7 +5444,7 @@ func watch_Interface_Subinterface_Ipv4_Address_VrrpGroupPath(t testing.TB, n ygo
 	gs := &oc.Interface_Subinterface_Ipv4_Address_VrrpGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address_VrrpGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address_VrrpGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Address_VrrpGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
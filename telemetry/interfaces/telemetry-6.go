@@ -558,7 +558,7 @@ func watch_Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTrackingPath(t
 	gs := &oc.Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1788,7 +1788,7 @@ func watch_Interface_Subinterface_Ipv4_CountersPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv4_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4238,7 +4238,7 @@ func watch_Interface_Subinterface_Ipv4_NeighborPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv4_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4848,7 +4848,7 @@ func watch_Interface_Subinterface_Ipv4_ProxyArpPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv4_ProxyArp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_ProxyArp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_ProxyArp", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_ProxyArp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5154,7 +5154,7 @@ func watch_Interface_Subinterface_Ipv4_UnnumberedPath(t testing.TB, n ygot.PathS
 	gs := &oc.Interface_Subinterface_Ipv4_Unnumbered{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Unnumbered", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Unnumbered", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Unnumbered{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5460,7 +5460,7 @@ func watch_Interface_Subinterface_Ipv4_Unnumbered_InterfaceRefPath(t testing.TB,
 	gs := &oc.Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv4_Unnumbered_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -401,7 +401,7 @@ func watch_Interface_Subinterface_Ipv6Path(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Interface_Subinterface_Ipv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_Interface_Subinterface_Ipv6_AddressPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Interface_Subinterface_Ipv6_Address{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Address{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1315,7 +1315,7 @@ func watch_Interface_Subinterface_Ipv6_Address_VrrpGroupPath(t testing.TB, n ygo
 	gs := &oc.Interface_Subinterface_Ipv6_Address_VrrpGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address_VrrpGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address_VrrpGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Address_VrrpGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1929,7 +1929,7 @@ func watch_Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTrackingPath(t
 	gs := &oc.Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3312,7 +3312,7 @@ func watch_Interface_Subinterface_Ipv6_AutoconfPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv6_Autoconf{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Autoconf", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Autoconf", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Autoconf{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4083,7 +4083,7 @@ func watch_Interface_Subinterface_Ipv6_CountersPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv6_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1172,7 +1172,7 @@ func watch_Interface_Subinterface_Ipv6_NeighborPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Interface_Subinterface_Ipv6_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2088,7 +2088,7 @@ func watch_Interface_Subinterface_Ipv6_RouterAdvertisementPath(t testing.TB, n y
 	gs := &oc.Interface_Subinterface_Ipv6_RouterAdvertisement{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_RouterAdvertisement", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_RouterAdvertisement", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_RouterAdvertisement{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2700,7 +2700,7 @@ func watch_Interface_Subinterface_Ipv6_UnnumberedPath(t testing.TB, n ygot.PathS
 	gs := &oc.Interface_Subinterface_Ipv6_Unnumbered{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Unnumbered", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Unnumbered", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Unnumbered{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3006,7 +3006,7 @@ func watch_Interface_Subinterface_Ipv6_Unnumbered_InterfaceRefPath(t testing.TB,
 	gs := &oc.Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Ipv6_Unnumbered_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4228,7 +4228,7 @@ func watch_Interface_Subinterface_VlanPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Interface_Subinterface_Vlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4379,7 +4379,7 @@ func watch_Interface_Subinterface_Vlan_EgressMappingPath(t testing.TB, n ygot.Pa
 	gs := &oc.Interface_Subinterface_Vlan_EgressMapping{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_EgressMapping", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_EgressMapping", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_EgressMapping{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4989,7 +4989,7 @@ func watch_Interface_Subinterface_Vlan_IngressMappingPath(t testing.TB, n ygot.P
 	gs := &oc.Interface_Subinterface_Vlan_IngressMapping{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_IngressMapping", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_IngressMapping", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_IngressMapping{
 			Metadata: md,
 		}).SetVal(gs), nil
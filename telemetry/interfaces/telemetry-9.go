@@ -95,7 +95,7 @@ func watch_Interface_Subinterface_Vlan_MatchPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Interface_Subinterface_Vlan_Match{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedInnerListPath(t testing
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTaggedInnerList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -703,7 +703,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRangePath(t t
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1466,7 +1466,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRangePath(t testin
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTaggedInnerRange{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2076,7 +2076,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedOuterListPath(t testing
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTaggedOuterList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2533,7 +2533,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRangePath(t testin
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTaggedOuterRange{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3143,7 +3143,7 @@ func watch_Interface_Subinterface_Vlan_Match_DoubleTaggedPath(t testing.TB, n yg
 	gs := &oc.Interface_Subinterface_Vlan_Match_DoubleTagged{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTagged", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_DoubleTagged", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_DoubleTagged{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3600,7 +3600,7 @@ func watch_Interface_Subinterface_Vlan_Match_SingleTaggedListPath(t testing.TB,
 	gs := &oc.Interface_Subinterface_Vlan_Match_SingleTaggedList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTaggedList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTaggedList", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_SingleTaggedList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3904,7 +3904,7 @@ func watch_Interface_Subinterface_Vlan_Match_SingleTaggedPath(t testing.TB, n yg
 	gs := &oc.Interface_Subinterface_Vlan_Match_SingleTagged{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTagged", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTagged", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_SingleTagged{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4055,7 +4055,7 @@ func watch_Interface_Subinterface_Vlan_Match_SingleTaggedRangePath(t testing.TB,
 	gs := &oc.Interface_Subinterface_Vlan_Match_SingleTaggedRange{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTaggedRange", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Interface_Subinterface_Vlan_Match_SingleTaggedRange", gs, queryPath, false, false)
 		return (&oc.QualifiedInterface_Subinterface_Vlan_Match_SingleTaggedRange{
 			Metadata: md,
 		}).SetVal(gs), nil
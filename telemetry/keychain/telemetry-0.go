@@ -95,7 +95,7 @@ func watch_KeychainPath(t testing.TB, n ygot.PathStruct, duration time.Duration,
 	gs := &oc.Keychain{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Keychain", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Keychain", gs, queryPath, false, false)
 		return (&oc.QualifiedKeychain{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Keychain_KeyPath(t testing.TB, n ygot.PathStruct, duration time.Durat
 	gs := &oc.Keychain_Key{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Keychain_Key", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Keychain_Key", gs, queryPath, false, false)
 		return (&oc.QualifiedKeychain_Key{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Keychain_Key_SendLifetimePath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.Keychain_Key_SendLifetime{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Keychain_Key_SendLifetime", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Keychain_Key_SendLifetime", gs, queryPath, false, false)
 		return (&oc.QualifiedKeychain_Key_SendLifetime{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_LacpPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pre
 	gs := &oc.Lacp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lacp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lacp", gs, queryPath, false, false)
 		return (&oc.QualifiedLacp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Lacp_InterfacePath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.Lacp_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lacp_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lacp_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedLacp_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Lacp_Interface_Member_CountersPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.Lacp_Interface_Member_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lacp_Interface_Member_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lacp_Interface_Member_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedLacp_Interface_Member_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
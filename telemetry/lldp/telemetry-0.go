@@ -95,7 +95,7 @@ func watch_LldpPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pre
 	gs := &oc.Lldp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_Lldp_CountersPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Lldp_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
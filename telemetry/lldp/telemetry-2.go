@@ -556,7 +556,7 @@ func watch_Lldp_InterfacePath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.Lldp_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -707,7 +707,7 @@ func watch_Lldp_Interface_CountersPath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.Lldp_Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Interface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
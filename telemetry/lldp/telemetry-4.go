@@ -556,7 +556,7 @@ func watch_Lldp_Interface_NeighborPath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.Lldp_Interface_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Interface_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -860,7 +860,7 @@ func watch_Lldp_Interface_Neighbor_CapabilityPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Lldp_Interface_Neighbor_Capability{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor_Capability", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor_Capability", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Interface_Neighbor_Capability{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -248,7 +248,7 @@ func watch_Lldp_Interface_Neighbor_TlvPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Lldp_Interface_Neighbor_Tlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor_Tlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Lldp_Interface_Neighbor_Tlv", gs, queryPath, false, false)
 		return (&oc.QualifiedLldp_Interface_Neighbor_Tlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_LocalRoutesPath(t testing.TB, n ygot.PathStruct, duration time.Durati
 	gs := &oc.LocalRoutes{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_LocalRoutes_AggregatePath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.LocalRoutes_Aggregate{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes_Aggregate", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes_Aggregate", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes_Aggregate{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_LocalRoutes_StaticPath(t testing.TB, n ygot.PathStruct, duration time
 	gs := &oc.LocalRoutes_Static{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes_Static", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes_Static", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes_Static{
 			Metadata: md,
 		}).SetVal(gs), nil
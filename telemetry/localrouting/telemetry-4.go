@@ -95,7 +95,7 @@ func watch_LocalRoutes_Static_NextHopPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.LocalRoutes_Static_NextHop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes_Static_NextHop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_LocalRoutes_Static_NextHop_EnableBfdPath(t testing.TB, n ygot.PathStr
 	gs := &oc.LocalRoutes_Static_NextHop_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes_Static_NextHop_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
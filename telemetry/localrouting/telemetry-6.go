@@ -95,7 +95,7 @@ func watch_LocalRoutes_Static_NextHop_InterfaceRefPath(t testing.TB, n ygot.Path
 	gs := &oc.LocalRoutes_Static_NextHop_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "LocalRoutes_Static_NextHop_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedLocalRoutes_Static_NextHop_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_NetworkInstancePath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.NetworkInstance{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_NetworkInstance_AftsPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.NetworkInstance_Afts{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -397,7 +397,7 @@ func watch_NetworkInstance_Afts_Ipv4EntryPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.NetworkInstance_Afts_Ipv4Entry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_Ipv4Entry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_Ipv4Entry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_Ipv4Entry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1772,7 +1772,7 @@ func watch_NetworkInstance_Afts_Ipv6EntryPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.NetworkInstance_Afts_Ipv6Entry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_Ipv6Entry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_Ipv6Entry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_Ipv6Entry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3147,7 +3147,7 @@ func watch_NetworkInstance_Afts_LabelEntryPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_Afts_LabelEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_LabelEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_LabelEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_LabelEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4369,7 +4369,7 @@ func watch_NetworkInstance_Afts_MacEntryPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.NetworkInstance_Afts_MacEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_MacEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_MacEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_MacEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5438,7 +5438,7 @@ func watch_NetworkInstance_Afts_NextHopGroupPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.NetworkInstance_Afts_NextHopGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHopGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5895,7 +5895,7 @@ func watch_NetworkInstance_Afts_NextHopGroup_ConditionPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Afts_NextHopGroup_Condition{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_Condition", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_Condition", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHopGroup_Condition{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6352,7 +6352,7 @@ func watch_NetworkInstance_Afts_NextHopGroup_Condition_InputInterfacePath(t test
 	gs := &oc.NetworkInstance_Afts_NextHopGroup_Condition_InputInterface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_Condition_InputInterface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_Condition_InputInterface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHopGroup_Condition_InputInterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7268,7 +7268,7 @@ func watch_NetworkInstance_Afts_NextHopGroup_NextHopPath(t testing.TB, n ygot.Pa
 	gs := &oc.NetworkInstance_Afts_NextHopGroup_NextHop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_NextHop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHopGroup_NextHop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHopGroup_NextHop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7878,7 +7878,7 @@ func watch_NetworkInstance_Afts_NextHopPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.NetworkInstance_Afts_NextHop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8335,7 +8335,7 @@ func watch_NetworkInstance_Afts_NextHop_InterfaceRefPath(t testing.TB, n ygot.Pa
 	gs := &oc.NetworkInstance_Afts_NextHop_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHop_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8945,7 +8945,7 @@ func watch_NetworkInstance_Afts_NextHop_IpInIpPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_Afts_NextHop_IpInIp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop_IpInIp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_NextHop_IpInIp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_NextHop_IpInIp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10322,7 +10322,7 @@ func watch_NetworkInstance_Afts_PolicyForwardingEntryPath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_Afts_PolicyForwardingEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Afts_PolicyForwardingEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Afts_PolicyForwardingEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Afts_PolicyForwardingEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12615,7 +12615,7 @@ func watch_NetworkInstance_ConnectionPointPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_ConnectionPoint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_ConnectionPoint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12919,7 +12919,7 @@ func watch_NetworkInstance_ConnectionPoint_EndpointPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_ConnectionPoint_Endpoint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_ConnectionPoint_Endpoint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13376,7 +13376,7 @@ func watch_NetworkInstance_ConnectionPoint_Endpoint_LocalPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_ConnectionPoint_Endpoint_Local{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Local", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Local", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_ConnectionPoint_Endpoint_Local{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14445,7 +14445,7 @@ func watch_NetworkInstance_ConnectionPoint_Endpoint_RemotePath(t testing.TB, n y
 	gs := &oc.NetworkInstance_ConnectionPoint_Endpoint_Remote{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Remote", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Remote", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_ConnectionPoint_Endpoint_Remote{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15208,7 +15208,7 @@ func watch_NetworkInstance_ConnectionPoint_Endpoint_VxlanPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_ConnectionPoint_Endpoint_Vxlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Vxlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_ConnectionPoint_Endpoint_Vxlan", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_ConnectionPoint_Endpoint_Vxlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16277,7 +16277,7 @@ func watch_NetworkInstance_EncapsulationPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.NetworkInstance_Encapsulation{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Encapsulation", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Encapsulation", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Encapsulation{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16887,7 +16887,7 @@ func watch_NetworkInstance_EvpnPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.NetworkInstance_Evpn{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17038,7 +17038,7 @@ func watch_NetworkInstance_Evpn_EthernetSegmentPath(t testing.TB, n ygot.PathStr
 	gs := &oc.NetworkInstance_Evpn_EthernetSegment{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EthernetSegment", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EthernetSegment", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EthernetSegment{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17189,7 +17189,7 @@ func watch_NetworkInstance_Evpn_EthernetSegment_DfElectionPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Evpn_EthernetSegment_DfElection{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EthernetSegment_DfElection", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EthernetSegment_DfElection", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EthernetSegment_DfElection{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18874,7 +18874,7 @@ func watch_NetworkInstance_Evpn_EvpnInstancePath(t testing.TB, n ygot.PathStruct
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19025,7 +19025,7 @@ func watch_NetworkInstance_Evpn_EvpnInstance_BComponentPath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance_BComponent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_BComponent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_BComponent", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance_BComponent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19482,7 +19482,7 @@ func watch_NetworkInstance_Evpn_EvpnInstance_BComponent_IComponentPath(t testing
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance_BComponent_IComponent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20092,7 +20092,7 @@ func watch_NetworkInstance_Evpn_EvpnInstance_ImportExportPolicyPath(t testing.TB
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance_ImportExportPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21314,7 +21314,7 @@ func watch_NetworkInstance_Evpn_EvpnInstance_VxlanPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance_Vxlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_Vxlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_Vxlan", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance_Vxlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21465,7 +21465,7 @@ func watch_NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterfacePath(t
 	gs := &oc.NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22840,7 +22840,7 @@ func watch_NetworkInstance_FdbPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.NetworkInstance_Fdb{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23144,7 +23144,7 @@ func watch_NetworkInstance_Fdb_ArpProxyPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.NetworkInstance_Fdb_ArpProxy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_ArpProxy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_ArpProxy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_ArpProxy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24372,7 +24372,7 @@ func watch_NetworkInstance_Fdb_MacMobilityPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_Fdb_MacMobility{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacMobility", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacMobility", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_MacMobility{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25292,7 +25292,7 @@ func watch_NetworkInstance_Fdb_MacTablePath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.NetworkInstance_Fdb_MacTable{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_MacTable{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25443,7 +25443,7 @@ func watch_NetworkInstance_Fdb_MacTable_EntryPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.NetworkInstance_Fdb_MacTable_Entry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_MacTable_Entry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26053,7 +26053,7 @@ func watch_NetworkInstance_Fdb_MacTable_Entry_InterfacePath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Fdb_MacTable_Entry_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_MacTable_Entry_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26204,7 +26204,7 @@ func watch_NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRefPath(t testi
 	gs := &oc.NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27120,7 +27120,7 @@ func watch_NetworkInstance_Fdb_NdProxyPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.NetworkInstance_Fdb_NdProxy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_NdProxy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Fdb_NdProxy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Fdb_NdProxy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27887,7 +27887,7 @@ func watch_NetworkInstance_InterInstancePoliciesPath(t testing.TB, n ygot.PathSt
 	gs := &oc.NetworkInstance_InterInstancePolicies{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_InterInstancePolicies{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28038,7 +28038,7 @@ func watch_NetworkInstance_InterInstancePolicies_ApplyPolicyPath(t testing.TB, n
 	gs := &oc.NetworkInstance_InterInstancePolicies_ApplyPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies_ApplyPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies_ApplyPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_InterInstancePolicies_ApplyPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28805,7 +28805,7 @@ func watch_NetworkInstance_InterInstancePolicies_ImportExportPolicyPath(t testin
 	gs := &oc.NetworkInstance_InterInstancePolicies_ImportExportPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies_ImportExportPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_InterInstancePolicies_ImportExportPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_InterInstancePolicies_ImportExportPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29262,7 +29262,7 @@ func watch_NetworkInstance_InterfacePath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.NetworkInstance_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30331,7 +30331,7 @@ func watch_NetworkInstance_MplsPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.NetworkInstance_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30482,7 +30482,7 @@ func watch_NetworkInstance_Mpls_GlobalPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.NetworkInstance_Mpls_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30633,7 +30633,7 @@ func watch_NetworkInstance_Mpls_Global_InterfacePath(t testing.TB, n ygot.PathSt
 	gs := &oc.NetworkInstance_Mpls_Global_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Global_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30937,7 +30937,7 @@ func watch_NetworkInstance_Mpls_Global_Interface_InterfaceRefPath(t testing.TB,
 	gs := &oc.NetworkInstance_Mpls_Global_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Global_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31857,7 +31857,7 @@ func watch_NetworkInstance_Mpls_Global_ReservedLabelBlockPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_Mpls_Global_ReservedLabelBlock{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_ReservedLabelBlock", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Global_ReservedLabelBlock", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Global_ReservedLabelBlock{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32622,7 +32622,7 @@ func watch_NetworkInstance_Mpls_InterfacePath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.NetworkInstance_Mpls_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32926,7 +32926,7 @@ func watch_NetworkInstance_Mpls_Interface_IgpFloodingBandwidthPath(t testing.TB,
 	gs := &oc.NetworkInstance_Mpls_Interface_IgpFloodingBandwidth{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface_IgpFloodingBandwidth", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface_IgpFloodingBandwidth", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Interface_IgpFloodingBandwidth{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34148,7 +34148,7 @@ func watch_NetworkInstance_Mpls_Interface_InterfaceRefPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Mpls_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34911,7 +34911,7 @@ func watch_NetworkInstance_Mpls_LspsPath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.NetworkInstance_Mpls_Lsps{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35062,7 +35062,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPathPath(t testing.TB, n ygot.Pa
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35213,7 +35213,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPathPath(t tes
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35364,7 +35364,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitR
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36437,7 +36437,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_TunnelPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36896,7 +36896,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_BandwidthPath(t test
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37047,7 +37047,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwi
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38118,7 +38118,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwi
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38730,7 +38730,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwi
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39803,7 +39803,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_CountersPath(t testi
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41947,7 +41947,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributesP
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42251,7 +42251,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42402,7 +42402,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -43165,7 +43165,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath{
 			Metadata: md,
 		}).SetVal(gs), nil
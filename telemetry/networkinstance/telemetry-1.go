@@ -1325,7 +1325,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2551,7 +2551,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2702,7 +2702,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4542,7 +4542,7 @@ func watch_NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_
 	gs := &oc.NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7306,7 +7306,7 @@ func watch_NetworkInstance_Mpls_Lsps_StaticLspPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_Mpls_Lsps_StaticLsp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_StaticLsp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7457,7 +7457,7 @@ func watch_NetworkInstance_Mpls_Lsps_StaticLsp_EgressPath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_Mpls_Lsps_StaticLsp_Egress{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Egress", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Egress", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_StaticLsp_Egress{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8067,7 +8067,7 @@ func watch_NetworkInstance_Mpls_Lsps_StaticLsp_IngressPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Mpls_Lsps_StaticLsp_Ingress{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Ingress", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Ingress", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_StaticLsp_Ingress{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8830,7 +8830,7 @@ func watch_NetworkInstance_Mpls_Lsps_StaticLsp_TransitPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Mpls_Lsps_StaticLsp_Transit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Transit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_StaticLsp_Transit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_StaticLsp_Transit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9440,7 +9440,7 @@ func watch_NetworkInstance_Mpls_Lsps_UnconstrainedPathPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Mpls_Lsps_UnconstrainedPath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_UnconstrainedPath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9591,7 +9591,7 @@ func watch_NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocolPath(t t
 	gs := &oc.NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9742,7 +9742,7 @@ func watch_NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_LdpPath
 	gs := &oc.NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9893,7 +9893,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocolsPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10044,7 +10044,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_LdpPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10195,7 +10195,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_GlobalPath(t testing.TB,
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10346,7 +10346,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_AuthenticationPath
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10805,7 +10805,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestartPat
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11876,7 +11876,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributesPath(t
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12333,7 +12333,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Inter
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12484,7 +12484,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Inter
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12943,7 +12943,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Inter
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13553,7 +13553,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Inter
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14010,7 +14010,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_NeighborPath(t testing.TB
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14161,7 +14161,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_AuthenticationPa
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14620,7 +14620,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacencyPa
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15077,7 +15077,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_H
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15993,7 +15993,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_I
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17368,7 +17368,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_TargetedPath(t testing.TB
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17519,7 +17519,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamilyPat
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17823,7 +17823,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Ta
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19200,7 +19200,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTePath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19351,7 +19351,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_GlobalPath(t testing.T
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19502,7 +19502,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_CountersPath(t
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19653,7 +19653,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24547,7 +24547,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25159,7 +25159,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_HellosPath(t te
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25620,7 +25620,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemptionP
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26081,7 +26081,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_InterfacePath(t testin
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26232,7 +26232,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authenticati
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26691,7 +26691,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthRes
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27607,7 +27607,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_CountersPath
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27758,7 +27758,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Err
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32346,7 +32346,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_HellosPath(t
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32960,7 +32960,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33570,7 +33570,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_ProtectionPa
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34029,7 +34029,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34486,7 +34486,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_NeighborPath(t testing
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35249,7 +35249,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_SessionPath(t testing.
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35553,7 +35553,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteO
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37540,7 +37540,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObj
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38303,7 +38303,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspecPat
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39678,7 +39678,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRoutingPath(t testing.
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39829,7 +39829,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCo
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40745,7 +40745,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_InterfacePath(
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41355,7 +41355,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_Inte
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42118,7 +42118,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidC
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42269,7 +42269,7 @@ func watch_NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidC
 	gs := &oc.NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass{
 			Metadata: md,
 		}).SetVal(gs), nil
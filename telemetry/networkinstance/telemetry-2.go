@@ -401,7 +401,7 @@ func watch_NetworkInstance_Mpls_TeGlobalAttributesPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Mpls_TeGlobalAttributes{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_TeGlobalAttributes{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_NetworkInstance_Mpls_TeGlobalAttributes_AdminGroupPath(t testing.TB,
 	gs := &oc.NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_TeGlobalAttributes_AdminGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1009,7 +1009,7 @@ func watch_NetworkInstance_Mpls_TeGlobalAttributes_SrlgPath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Mpls_TeGlobalAttributes_Srlg{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_Srlg", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_Srlg", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_TeGlobalAttributes_Srlg{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1468,7 +1468,7 @@ func watch_NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersListPath(t testin
 	gs := &oc.NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2231,7 +2231,7 @@ func watch_NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimersPath(t testing.TB,
 	gs := &oc.NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3147,7 +3147,7 @@ func watch_NetworkInstance_PolicyForwardingPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_PolicyForwarding{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3298,7 +3298,7 @@ func watch_NetworkInstance_PolicyForwarding_InterfacePath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_PolicyForwarding_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3908,7 +3908,7 @@ func watch_NetworkInstance_PolicyForwarding_Interface_InterfaceRefPath(t testing
 	gs := &oc.NetworkInstance_PolicyForwarding_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4365,7 +4365,7 @@ func watch_NetworkInstance_PolicyForwarding_PathSelectionGroupPath(t testing.TB,
 	gs := &oc.NetworkInstance_PolicyForwarding_PathSelectionGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_PathSelectionGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_PathSelectionGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_PathSelectionGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4822,7 +4822,7 @@ func watch_NetworkInstance_PolicyForwarding_PolicyPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5126,7 +5126,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_RulePath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5277,7 +5277,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_ActionPath(t testing.TB,
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Action{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Action{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5738,7 +5738,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGrePat
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6042,7 +6042,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Ta
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7264,7 +7264,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4Path(t testing.TB, n
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Ipv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8333,7 +8333,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6Path(t testing.TB, n
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Ipv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9708,7 +9708,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_L2Path(t testing.TB, n y
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_L2{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_L2", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_L2", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_L2{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11083,7 +11083,7 @@ func watch_NetworkInstance_PolicyForwarding_Policy_Rule_TransportPath(t testing.
 	gs := &oc.NetworkInstance_PolicyForwarding_Policy_Rule_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_PolicyForwarding_Policy_Rule_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_PolicyForwarding_Policy_Rule_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11848,7 +11848,7 @@ func watch_NetworkInstance_ProtocolPath(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.NetworkInstance_Protocol{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11999,7 +11999,7 @@ func watch_NetworkInstance_Protocol_AggregatePath(t testing.TB, n ygot.PathStruc
 	gs := &oc.NetworkInstance_Protocol_Aggregate{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Aggregate", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Aggregate", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Aggregate{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12764,7 +12764,7 @@ func watch_NetworkInstance_Protocol_BgpPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.NetworkInstance_Protocol_Bgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -12915,7 +12915,7 @@ func watch_NetworkInstance_Protocol_Bgp_GlobalPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13066,7 +13066,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafiPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13217,7 +13217,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPathsPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14292,7 +14292,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestartPath(t tes
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14598,7 +14598,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14749,7 +14749,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15514,7 +15514,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4UnicastPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15665,7 +15665,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimitPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16585,7 +16585,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16736,7 +16736,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17501,7 +17501,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6UnicastPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17652,7 +17652,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimitPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18572,7 +18572,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpnPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18723,7 +18723,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimitPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19488,7 +19488,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVplsPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19639,7 +19639,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimitPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20404,7 +20404,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4MulticastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20555,7 +20555,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21320,7 +21320,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4UnicastPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21471,7 +21471,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22236,7 +22236,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6MulticastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22387,7 +22387,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23152,7 +23152,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6UnicastPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23303,7 +23303,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24068,7 +24068,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptionsPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25149,7 +25149,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4Path(t test
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25300,7 +25300,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26065,7 +26065,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6Path(t test
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26216,7 +26216,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27287,7 +27287,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePathsPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27438,7 +27438,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_EbgpPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28054,7 +28054,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_IbgpPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28513,7 +28513,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_ConfederationPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_Confederation{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_Confederation", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_Confederation", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_Confederation{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28970,7 +28970,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistancePath(t testin
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29427,7 +29427,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefixPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29884,7 +29884,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_GracefulRestartPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30647,7 +30647,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptionsPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32187,7 +32187,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_UseMultiplePathsPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32338,7 +32338,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_EbgpPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32954,7 +32954,7 @@ func watch_NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_IbgpPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33260,7 +33260,7 @@ func watch_NetworkInstance_Protocol_Bgp_NeighborPath(t testing.TB, n ygot.PathSt
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33411,7 +33411,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafiPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33715,7 +33715,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPathsPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34635,7 +34635,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicyPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35557,7 +35557,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestartPath(t t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36169,7 +36169,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicastPath(
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36320,7 +36320,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_Pref
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37085,7 +37085,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4UnicastPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37236,7 +37236,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38156,7 +38156,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicastPath(
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38307,7 +38307,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_Pref
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39072,7 +39072,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6UnicastPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39223,7 +39223,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40143,7 +40143,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpnPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40294,7 +40294,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimitPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41059,7 +41059,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVplsPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41210,7 +41210,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimitPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41975,7 +41975,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4MulticastPath(
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42126,7 +42126,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_Pref
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42891,7 +42891,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4UnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -43042,7 +43042,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
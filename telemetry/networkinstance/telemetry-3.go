@@ -248,7 +248,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6MulticastPath(
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -399,7 +399,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_Pref
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1164,7 +1164,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6UnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1315,7 +1315,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_Prefix
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2080,7 +2080,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_PrefixesPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2843,7 +2843,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4Path(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2994,7 +2994,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3759,7 +3759,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6Path(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3910,7 +3910,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLi
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4675,7 +4675,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePathsPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4826,7 +4826,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_EbgpPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5287,7 +5287,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicyPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6054,7 +6054,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptionsPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7131,7 +7131,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihopPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7590,7 +7590,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_EnableBfdPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8049,7 +8049,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandlingPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8661,7 +8661,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestartPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10342,7 +10342,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptionsPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10648,7 +10648,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_MessagesPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Messages{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Messages{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10799,7 +10799,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_Messages_ReceivedPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Messages_Received{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11715,7 +11715,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_Messages_SentPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13243,7 +13243,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_QueuesPath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Queues{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Queues", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Queues", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Queues{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14008,7 +14008,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_RouteReflectorPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_RouteReflector{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14928,7 +14928,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_TimersPath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Timers{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Timers", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Timers", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Timers{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15852,7 +15852,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_TransportPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17078,7 +17078,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePathsPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17229,7 +17229,7 @@ func watch_NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_EbgpPath(t tes
 	gs := &oc.NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17690,7 +17690,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroupPath(t testing.TB, n ygot.PathS
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17841,7 +17841,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafiPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17992,7 +17992,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPathsPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18912,7 +18912,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicyPath(t test
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19834,7 +19834,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestartPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20140,7 +20140,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicastPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20291,7 +20291,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_Pre
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21056,7 +21056,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4UnicastPath(t test
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21207,7 +21207,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimi
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22127,7 +22127,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicastPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22278,7 +22278,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_Pre
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23043,7 +23043,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6UnicastPath(t test
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23194,7 +23194,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimi
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24114,7 +24114,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpnPath(t testin
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24265,7 +24265,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimitP
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25030,7 +25030,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVplsPath(t testin
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25181,7 +25181,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimitP
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25946,7 +25946,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4MulticastPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26097,7 +26097,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_Pre
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26862,7 +26862,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4UnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27013,7 +27013,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_Prefi
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27778,7 +27778,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6MulticastPath
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27929,7 +27929,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_Pre
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28694,7 +28694,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6UnicastPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28845,7 +28845,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_Prefi
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29610,7 +29610,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4Path(t t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29761,7 +29761,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixL
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30526,7 +30526,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6Path(t t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30677,7 +30677,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixL
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31442,7 +31442,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePathsPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31593,7 +31593,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_EbgpP
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32209,7 +32209,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_IbgpP
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32515,7 +32515,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_ApplyPolicyPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_ApplyPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_ApplyPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_ApplyPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_ApplyPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33282,7 +33282,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_AsPathOptionsPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_AsPathOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AsPathOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_AsPathOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_AsPathOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34204,7 +34204,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_EbgpMultihopPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_EbgpMultihop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_EbgpMultihop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_EbgpMultihop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_EbgpMultihop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34663,7 +34663,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_EnableBfdPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34967,7 +34967,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_ErrorHandlingPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_ErrorHandling{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_ErrorHandling", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_ErrorHandling", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_ErrorHandling{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35273,7 +35273,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_GracefulRestartPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36189,7 +36189,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_LoggingOptionsPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_LoggingOptions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_LoggingOptions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_LoggingOptions", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_LoggingOptions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37262,7 +37262,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_RouteReflectorPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_RouteReflector{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_RouteReflector", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_RouteReflector", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_RouteReflector{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37876,7 +37876,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_TimersPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_Timers{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_Timers", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_Timers", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_Timers{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38953,7 +38953,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_TransportPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39720,7 +39720,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePathsPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39871,7 +39871,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_EbgpPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ebgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ebgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ebgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40487,7 +40487,7 @@ func watch_NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_IbgpPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ibgp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ibgp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_PeerGroup_UseMultiplePaths_Ibgp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40793,7 +40793,7 @@ func watch_NetworkInstance_Protocol_Bgp_RibPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40944,7 +40944,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafiPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41248,7 +41248,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicyPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41399,7 +41399,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRibPath(t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41550,7 +41550,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_RouteP
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42927,7 +42927,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route_
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv4SrtePolicy_LocRib_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -556,7 +556,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_LocRib_Route_Unk
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_LocRib_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_LocRib_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_LocRib_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_LocRib_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1931,7 +1931,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_NeighborPath(t t
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2082,7 +2082,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2233,7 +2233,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3608,7 +3608,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPost_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4983,7 +4983,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5134,7 +5134,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6356,7 +6356,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibI
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibInPre_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7731,7 +7731,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7882,7 +7882,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9104,7 +9104,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPost_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10479,7 +10479,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10630,7 +10630,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11852,7 +11852,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibO
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route_UnknownAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route_UnknownAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route_UnknownAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AfiSafi_Ipv6Unicast_Neighbor_AdjRibOutPre_Route_UnknownAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13380,7 +13380,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSetPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13531,7 +13531,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_AggregatorPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_Aggregator{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_Aggregator", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_Aggregator", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_Aggregator{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14294,7 +14294,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_As4SegmentPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_As4Segment{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_As4Segment", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_As4Segment", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_As4Segment{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14751,7 +14751,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_AsSegmentPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_AsSegment{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_AsSegment", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_AsSegment", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_AsSegment{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16432,7 +16432,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulationPath(t te
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16583,7 +16583,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_TunnelPa
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16734,7 +16734,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_S
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17346,7 +17346,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_S
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_RemoteEndpoint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_RemoteEndpoint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_RemoteEndpoint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_RemoteEndpoint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17803,7 +17803,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_S
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18107,7 +18107,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_S
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList_Segment{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList_Segment", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList_Segment", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_AttrSet_TunnelEncapsulation_Tunnel_Subtlv_SegmentList_Segment{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20400,7 +20400,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_CommunityPath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_Community{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_Community", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_Community", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_Community{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20857,7 +20857,7 @@ func watch_NetworkInstance_Protocol_Bgp_Rib_ExtCommunityPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Bgp_Rib_ExtCommunity{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_ExtCommunity", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Bgp_Rib_ExtCommunity", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Bgp_Rib_ExtCommunity{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21773,7 +21773,7 @@ func watch_NetworkInstance_Protocol_IgmpPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.NetworkInstance_Protocol_Igmp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21924,7 +21924,7 @@ func watch_NetworkInstance_Protocol_Igmp_GlobalPath(t testing.TB, n ygot.PathStr
 	gs := &oc.NetworkInstance_Protocol_Igmp_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22075,7 +22075,7 @@ func watch_NetworkInstance_Protocol_Igmp_Global_SsmPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_Protocol_Igmp_Global_Ssm{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global_Ssm", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global_Ssm", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Global_Ssm{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22226,7 +22226,7 @@ func watch_NetworkInstance_Protocol_Igmp_Global_Ssm_MappingPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Igmp_Global_Ssm_Mapping{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global_Ssm_Mapping", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Global_Ssm_Mapping", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Global_Ssm_Mapping{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22683,7 +22683,7 @@ func watch_NetworkInstance_Protocol_Igmp_InterfacePath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22834,7 +22834,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_CountersPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22985,7 +22985,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_Counters_QueriesPath(t testin
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Counters_Queries{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Counters_Queries{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23136,7 +23136,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_ReceivedPath
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23746,7 +23746,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_SentPath(t t
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24356,7 +24356,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_Counters_ReportsPath(t testin
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Counters_Reports{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Reports", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Counters_Reports", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Counters_Reports{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25274,7 +25274,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_GroupPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_Group{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Group", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_Group", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_Group{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26037,7 +26037,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_InterfaceRefPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -26800,7 +26800,7 @@ func watch_NetworkInstance_Protocol_Igmp_Interface_StaticGroupsPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Igmp_Interface_StaticGroups{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_StaticGroups", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Igmp_Interface_StaticGroups", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Igmp_Interface_StaticGroups{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27410,7 +27410,7 @@ func watch_NetworkInstance_Protocol_IsisPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.NetworkInstance_Protocol_Isis{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27561,7 +27561,7 @@ func watch_NetworkInstance_Protocol_Isis_GlobalPath(t testing.TB, n ygot.PathStr
 	gs := &oc.NetworkInstance_Protocol_Isis_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27712,7 +27712,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_AfPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Af{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Af", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Af", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Af{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28324,7 +28324,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_Af_MultiTopologyPath(t testing.T
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Af_MultiTopology{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Af_MultiTopology", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Af_MultiTopology", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Af_MultiTopology{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29087,7 +29087,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_AfiPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Afi{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Afi", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Afi", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Afi{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29854,7 +29854,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_GracefulRestartPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30623,7 +30623,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPoliciesPat
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30774,7 +30774,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Le
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31233,7 +31233,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Le
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31847,7 +31847,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_LspBitPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_LspBit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_LspBit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31998,7 +31998,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBitPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32459,7 +32459,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBitPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32765,7 +32765,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTriggerP
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33840,7 +33840,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_MplsPath(t testing.TB, n ygot.Pa
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33991,7 +33991,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSyncPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34603,7 +34603,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_NsrPath(t testing.TB, n ygot.Pat
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Nsr{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Nsr", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Nsr", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Nsr{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35064,7 +35064,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_ReferenceBandwidthPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_ReferenceBandwidth{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35368,7 +35368,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_SegmentRoutingPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_SegmentRouting{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_SegmentRouting", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_SegmentRouting", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_SegmentRouting{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35978,7 +35978,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_TimersPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Timers{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Timers{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36129,7 +36129,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_Timers_LspGenerationPath(t testi
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Timers_LspGeneration{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37200,7 +37200,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_Timers_SpfPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Timers_Spf{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers_Spf", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Timers_Spf", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Timers_Spf{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -37965,7 +37965,7 @@ func watch_NetworkInstance_Protocol_Isis_Global_TransportPath(t testing.TB, n yg
 	gs := &oc.NetworkInstance_Protocol_Isis_Global_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Global_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Global_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38269,7 +38269,7 @@ func watch_NetworkInstance_Protocol_Isis_InterfacePath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38420,7 +38420,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_AfPath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_Af{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Af", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Af", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_Af{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39030,7 +39030,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_AuthenticationPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_Authentication{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Authentication", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Authentication", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_Authentication{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39489,7 +39489,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_BfdPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_Bfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Bfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Bfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_Bfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39793,7 +39793,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_CircuitCountersPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_CircuitCounters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_CircuitCounters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_CircuitCounters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_CircuitCounters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41474,7 +41474,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_EnableBfdPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42239,7 +42239,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_InterfaceRefPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42696,7 +42696,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_LevelPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_Level{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Level", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Level", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_Level{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -42847,7 +42847,7 @@ func watch_NetworkInstance_Protocol_Isis_Interface_Level_AdjacencyPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Isis_Interface_Level_Adjacency{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Level_Adjacency", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Isis_Interface_Level_Adjacency", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Isis_Interface_Level_Adjacency{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -401,7 +401,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Route
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1164,7 +1164,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Route
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1927,7 +1927,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2078,7 +2078,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2229,7 +2229,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2380,7 +2380,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2531,7 +2531,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -4214,7 +4214,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5130,7 +5130,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5587,7 +5587,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -5738,7 +5738,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6348,7 +6348,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -6958,7 +6958,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -7415,7 +7415,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traff
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8178,7 +8178,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Unkno
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -8788,7 +8788,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsaPath(t
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -9704,7 +9704,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeO
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10467,7 +10467,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsaPath(
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -10771,7 +10771,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_Type
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11381,7 +11381,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_MplsPath(t testing.TB, n ygot.Pa
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -11685,7 +11685,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Area_VirtualLinkPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Area_VirtualLink{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_VirtualLink", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Area_VirtualLink", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Area_VirtualLink{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13366,7 +13366,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_GlobalPath(t testing.TB, n ygot.PathS
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -13517,7 +13517,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_GracefulRestartPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_GracefulRestart{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -14280,7 +14280,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicyPath
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15198,7 +15198,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_MplsPath(t testing.TB, n ygot.
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -15349,7 +15349,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSyncPath(t testing.
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16267,7 +16267,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_TimersPath(t testing.TB, n ygo
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Timers{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Timers{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -16418,7 +16418,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGenerationPath(t tes
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17028,7 +17028,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetricPath(t testing
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -17791,7 +17791,7 @@ func watch_NetworkInstance_Protocol_Ospfv2_Global_Timers_SpfPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Ospfv2_Global_Timers_Spf{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18401,7 +18401,7 @@ func watch_NetworkInstance_Protocol_PimPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.NetworkInstance_Protocol_Pim{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18552,7 +18552,7 @@ func watch_NetworkInstance_Protocol_Pim_GlobalPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_Protocol_Pim_Global{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Global{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -18703,7 +18703,7 @@ func watch_NetworkInstance_Protocol_Pim_Global_CountersPath(t testing.TB, n ygot
 	gs := &oc.NetworkInstance_Protocol_Pim_Global_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Global_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -19619,7 +19619,7 @@ func watch_NetworkInstance_Protocol_Pim_Global_RendezvousPointPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Pim_Global_RendezvousPoint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_RendezvousPoint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_RendezvousPoint", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Global_RendezvousPoint{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20076,7 +20076,7 @@ func watch_NetworkInstance_Protocol_Pim_Global_SourcePath(t testing.TB, n ygot.P
 	gs := &oc.NetworkInstance_Protocol_Pim_Global_Source{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Source", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Source", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Global_Source{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20686,7 +20686,7 @@ func watch_NetworkInstance_Protocol_Pim_Global_SsmPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Protocol_Pim_Global_Ssm{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Ssm", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Global_Ssm", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Global_Ssm{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -20990,7 +20990,7 @@ func watch_NetworkInstance_Protocol_Pim_InterfacePath(t testing.TB, n ygot.PathS
 	gs := &oc.NetworkInstance_Protocol_Pim_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -21451,7 +21451,7 @@ func watch_NetworkInstance_Protocol_Pim_Interface_CountersPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Protocol_Pim_Interface_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Interface_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -22367,7 +22367,7 @@ func watch_NetworkInstance_Protocol_Pim_Interface_EnableBfdPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Pim_Interface_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Interface_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -23132,7 +23132,7 @@ func watch_NetworkInstance_Protocol_Pim_Interface_InterfaceRefPath(t testing.TB,
 	gs := &oc.NetworkInstance_Protocol_Pim_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24048,7 +24048,7 @@ func watch_NetworkInstance_Protocol_Pim_Interface_NeighborPath(t testing.TB, n y
 	gs := &oc.NetworkInstance_Protocol_Pim_Interface_Neighbor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_Neighbor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Pim_Interface_Neighbor", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Pim_Interface_Neighbor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -24964,7 +24964,7 @@ func watch_NetworkInstance_Protocol_StaticPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_Protocol_Static{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Static{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25268,7 +25268,7 @@ func watch_NetworkInstance_Protocol_Static_NextHopPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_Protocol_Static_NextHop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Static_NextHop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25419,7 +25419,7 @@ func watch_NetworkInstance_Protocol_Static_NextHop_EnableBfdPath(t testing.TB, n
 	gs := &oc.NetworkInstance_Protocol_Static_NextHop_EnableBfd{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop_EnableBfd", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop_EnableBfd", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Static_NextHop_EnableBfd{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -25876,7 +25876,7 @@ func watch_NetworkInstance_Protocol_Static_NextHop_InterfaceRefPath(t testing.TB
 	gs := &oc.NetworkInstance_Protocol_Static_NextHop_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Protocol_Static_NextHop_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Protocol_Static_NextHop_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -27253,7 +27253,7 @@ func watch_NetworkInstance_RouteLimitPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.NetworkInstance_RouteLimit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_RouteLimit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_RouteLimit", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_RouteLimit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28477,7 +28477,7 @@ func watch_NetworkInstance_SegmentRoutingPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.NetworkInstance_SegmentRouting{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -28628,7 +28628,7 @@ func watch_NetworkInstance_SegmentRouting_SrgbPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_SegmentRouting_Srgb{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_Srgb", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_Srgb", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_Srgb{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -29697,7 +29697,7 @@ func watch_NetworkInstance_SegmentRouting_SrlbPath(t testing.TB, n ygot.PathStru
 	gs := &oc.NetworkInstance_SegmentRouting_Srlb{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_Srlb", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_Srlb", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_Srlb{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -30460,7 +30460,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicyPath(t testing.TB, n ygot.Path
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -31223,7 +31223,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePathPath(t testing.T
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -32904,7 +32904,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentListPath
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -33055,7 +33055,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Cou
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34124,7 +34124,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Nex
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -34275,7 +34275,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Nex
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -35344,7 +35344,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Nex
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -36721,7 +36721,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -38100,7 +38100,7 @@ func watch_NetworkInstance_SegmentRouting_TePolicy_CountersPath(t testing.TB, n
 	gs := &oc.NetworkInstance_SegmentRouting_TePolicy_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_SegmentRouting_TePolicy_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_SegmentRouting_TePolicy_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -39781,7 +39781,7 @@ func watch_NetworkInstance_TableConnectionPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.NetworkInstance_TableConnection{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_TableConnection", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_TableConnection", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_TableConnection{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -40854,7 +40854,7 @@ func watch_NetworkInstance_TablePath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.NetworkInstance_Table{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Table", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Table", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Table{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41464,7 +41464,7 @@ func watch_NetworkInstance_VlanPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.NetworkInstance_Vlan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Vlan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Vlan", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Vlan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -41615,7 +41615,7 @@ func watch_NetworkInstance_Vlan_MemberPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.NetworkInstance_Vlan_Member{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "NetworkInstance_Vlan_Member", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "NetworkInstance_Vlan_Member", gs, queryPath, false, false)
 		return (&oc.QualifiedNetworkInstance_Vlan_Member{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_ComponentPath(t testing.TB, n ygot.PathStruct, duration time.Duration
 	gs := &oc.Component{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -399,7 +399,7 @@ func watch_Component_BackplanePath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Component_Backplane{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Backplane", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Backplane", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Backplane{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -550,7 +550,7 @@ func watch_Component_ChassisPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.Component_Chassis{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Chassis", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Chassis", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Chassis{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -701,7 +701,7 @@ func watch_Component_CpuPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Component_Cpu{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Cpu", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Cpu", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Cpu{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -852,7 +852,7 @@ func watch_Component_Cpu_UtilizationPath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.Component_Cpu_Utilization{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Cpu_Utilization", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Cpu_Utilization", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Cpu_Utilization{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2692,7 +2692,7 @@ func watch_Component_FabricPath(t testing.TB, n ygot.PathStruct, duration time.D
 	gs := &oc.Component_Fabric{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Fabric", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Fabric", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Fabric{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2843,7 +2843,7 @@ func watch_Component_FanPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Component_Fan{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Fan", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Fan", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Fan{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Component_IntegratedCircuitPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Component_IntegratedCircuit{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_IntegratedCircuit", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_IntegratedCircuit", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_IntegratedCircuit{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Component_IntegratedCircuit_BackplaneFacingCapacityPath(t testing.TB,
 	gs := &oc.Component_IntegratedCircuit_BackplaneFacingCapacity{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_IntegratedCircuit_BackplaneFacingCapacity", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_IntegratedCircuit_BackplaneFacingCapacity", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_IntegratedCircuit_BackplaneFacingCapacity{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1009,7 +1009,7 @@ func watch_Component_IntegratedCircuit_MemoryPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Component_IntegratedCircuit_Memory{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_IntegratedCircuit_Memory", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_IntegratedCircuit_Memory", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_IntegratedCircuit_Memory{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1772,7 +1772,7 @@ func watch_Component_MemoryPath(t testing.TB, n ygot.PathStruct, duration time.D
 	gs := &oc.Component_Memory{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Memory", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Memory", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Memory{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3147,7 +3147,7 @@ func watch_Component_PciePath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.Component_Pcie{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Pcie", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Pcie", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Pcie{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3298,7 +3298,7 @@ func watch_Component_Pcie_CorrectableErrorsPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Component_Pcie_CorrectableErrors{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Pcie_CorrectableErrors", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Pcie_CorrectableErrors", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Pcie_CorrectableErrors{
 			Metadata: md,
 		}).SetVal(gs), nil
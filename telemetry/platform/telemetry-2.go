@@ -1472,7 +1472,7 @@ func watch_Component_Pcie_FatalErrorsPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Component_Pcie_FatalErrors{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Pcie_FatalErrors", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Pcie_FatalErrors", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Pcie_FatalErrors{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1013,7 +1013,7 @@ func watch_Component_Pcie_NonFatalErrorsPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.Component_Pcie_NonFatalErrors{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Pcie_NonFatalErrors", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Pcie_NonFatalErrors", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Pcie_NonFatalErrors{
 			Metadata: md,
 		}).SetVal(gs), nil
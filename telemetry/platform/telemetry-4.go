@@ -554,7 +554,7 @@ func watch_Component_PortPath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.Component_Port{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Port", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Port", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Port{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -705,7 +705,7 @@ func watch_Component_Port_BreakoutModePath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Component_Port_BreakoutMode{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Port_BreakoutMode", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Port_BreakoutMode", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Port_BreakoutMode{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -856,7 +856,7 @@ func watch_Component_Port_BreakoutMode_GroupPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Component_Port_BreakoutMode_Group{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Port_BreakoutMode_Group", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Port_BreakoutMode_Group", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Port_BreakoutMode_Group{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1619,7 +1619,7 @@ func watch_Component_PowerSupplyPath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.Component_PowerSupply{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_PowerSupply", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_PowerSupply", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_PowerSupply{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1770,7 +1770,7 @@ func watch_Component_PropertyPath(t testing.TB, n ygot.PathStruct, duration time
 	gs := &oc.Component_Property{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Property", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Property", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Property{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2686,7 +2686,7 @@ func watch_Component_SoftwareModulePath(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.Component_SoftwareModule{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_SoftwareModule", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_SoftwareModule", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_SoftwareModule{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3143,7 +3143,7 @@ func watch_Component_StoragePath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.Component_Storage{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Storage", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Storage", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Storage{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3294,7 +3294,7 @@ func watch_Component_SubcomponentPath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.Component_Subcomponent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Subcomponent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Subcomponent", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Subcomponent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -248,7 +248,7 @@ func watch_Component_TemperaturePath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.Component_Temperature{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Temperature", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Temperature", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Temperature{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1929,7 +1929,7 @@ func watch_Component_TransceiverPath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.Component_Transceiver{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2080,7 +2080,7 @@ func watch_Component_Transceiver_ChannelPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.Component_Transceiver_Channel{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_Channel", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_Channel", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_Channel{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2690,7 +2690,7 @@ func watch_Component_Transceiver_Channel_InputPowerPath(t testing.TB, n ygot.Pat
 	gs := &oc.Component_Transceiver_Channel_InputPower{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_InputPower", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_InputPower", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_Channel_InputPower{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -554,7 +554,7 @@ func watch_Component_Transceiver_Channel_LaserBiasCurrentPath(t testing.TB, n yg
 	gs := &oc.Component_Transceiver_Channel_LaserBiasCurrent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_LaserBiasCurrent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_LaserBiasCurrent", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_Channel_LaserBiasCurrent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1929,7 +1929,7 @@ func watch_Component_Transceiver_Channel_OutputPowerPath(t testing.TB, n ygot.Pa
 	gs := &oc.Component_Transceiver_Channel_OutputPower{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_OutputPower", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_Channel_OutputPower", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_Channel_OutputPower{
 			Metadata: md,
 		}).SetVal(gs), nil
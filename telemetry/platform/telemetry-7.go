@@ -2237,7 +2237,7 @@ func watch_Component_Transceiver_InputPowerPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Component_Transceiver_InputPower{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_InputPower", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_InputPower", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_InputPower{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Component_Transceiver_LaserBiasCurrentPath(t testing.TB, n ygot.PathS
 	gs := &oc.Component_Transceiver_LaserBiasCurrent{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_LaserBiasCurrent", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_LaserBiasCurrent", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_LaserBiasCurrent{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1623,7 +1623,7 @@ func watch_Component_Transceiver_OutputPowerPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Component_Transceiver_OutputPower{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_OutputPower", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_OutputPower", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_OutputPower{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2845,7 +2845,7 @@ func watch_Component_Transceiver_PostFecBerPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Component_Transceiver_PostFecBer{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Component_Transceiver_PostFecBer", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Component_Transceiver_PostFecBer", gs, queryPath, false, false)
 		return (&oc.QualifiedComponent_Transceiver_PostFecBer{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_QosPath(t testing.TB, n ygot.PathStruct, duration time.Duration, pred
 	gs := &oc.Qos{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos", gs, queryPath, false, false)
 		return (&oc.QualifiedQos{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_Qos_BufferAllocationProfilePath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Qos_BufferAllocationProfile{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_BufferAllocationProfile", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_BufferAllocationProfile", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_BufferAllocationProfile{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -550,7 +550,7 @@ func watch_Qos_BufferAllocationProfile_QueuePath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Qos_BufferAllocationProfile_Queue{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_BufferAllocationProfile_Queue", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_BufferAllocationProfile_Queue", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_BufferAllocationProfile_Queue{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1619,7 +1619,7 @@ func watch_Qos_ClassifierPath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.Qos_Classifier{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1923,7 +1923,7 @@ func watch_Qos_Classifier_TermPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Qos_Classifier_Term{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2074,7 +2074,7 @@ func watch_Qos_Classifier_Term_ActionsPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.Qos_Classifier_Term_Actions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Actions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Actions", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Actions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2225,7 +2225,7 @@ func watch_Qos_Classifier_Term_Actions_RemarkPath(t testing.TB, n ygot.PathStruc
 	gs := &oc.Qos_Classifier_Term_Actions_Remark{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Actions_Remark", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Actions_Remark", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Actions_Remark{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2988,7 +2988,7 @@ func watch_Qos_Classifier_Term_ConditionsPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.Qos_Classifier_Term_Conditions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3139,7 +3139,7 @@ func watch_Qos_Classifier_Term_Conditions_Ipv4Path(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_Classifier_Term_Conditions_Ipv4{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Ipv4", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Ipv4", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions_Ipv4{
 			Metadata: md,
 		}).SetVal(gs), nil
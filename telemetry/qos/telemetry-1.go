@@ -1013,7 +1013,7 @@ func watch_Qos_Classifier_Term_Conditions_Ipv6Path(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_Classifier_Term_Conditions_Ipv6{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Ipv6", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Ipv6", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions_Ipv6{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2388,7 +2388,7 @@ func watch_Qos_Classifier_Term_Conditions_L2Path(t testing.TB, n ygot.PathStruct
 	gs := &oc.Qos_Classifier_Term_Conditions_L2{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_L2", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_L2", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions_L2{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_Qos_Classifier_Term_Conditions_MplsPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_Classifier_Term_Conditions_Mpls{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Mpls", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Mpls", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions_Mpls{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -858,7 +858,7 @@ func watch_Qos_Classifier_Term_Conditions_TransportPath(t testing.TB, n ygot.Pat
 	gs := &oc.Qos_Classifier_Term_Conditions_Transport{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Transport", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Classifier_Term_Conditions_Transport", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Classifier_Term_Conditions_Transport{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1774,7 +1774,7 @@ func watch_Qos_ForwardingGroupPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Qos_ForwardingGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_ForwardingGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_ForwardingGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_ForwardingGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2690,7 +2690,7 @@ func watch_Qos_InterfacePath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.Qos_Interface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2841,7 +2841,7 @@ func watch_Qos_Interface_InputPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Qos_Interface_Input{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3145,7 +3145,7 @@ func watch_Qos_Interface_Input_ClassifierPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.Qos_Interface_Input_Classifier{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_Classifier", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_Classifier", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_Classifier{
 			Metadata: md,
 		}).SetVal(gs), nil
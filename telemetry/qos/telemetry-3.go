@@ -248,7 +248,7 @@ func watch_Qos_Interface_Input_Classifier_TermPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_Interface_Input_Classifier_Term{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_Classifier_Term", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_Classifier_Term", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_Classifier_Term{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1164,7 +1164,7 @@ func watch_Qos_Interface_Input_QueuePath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.Qos_Interface_Input_Queue{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_Queue", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_Queue", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_Queue{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2386,7 +2386,7 @@ func watch_Qos_Interface_Input_SchedulerPolicyPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_Interface_Input_SchedulerPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_SchedulerPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_SchedulerPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_SchedulerPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2690,7 +2690,7 @@ func watch_Qos_Interface_Input_SchedulerPolicy_SchedulerPath(t testing.TB, n ygo
 	gs := &oc.Qos_Interface_Input_SchedulerPolicy_Scheduler{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_SchedulerPolicy_Scheduler{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -860,7 +860,7 @@ func watch_Qos_Interface_Input_VoqInterfacePath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Qos_Interface_Input_VoqInterface{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_VoqInterface", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_VoqInterface", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_VoqInterface{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1164,7 +1164,7 @@ func watch_Qos_Interface_Input_VoqInterface_QueuePath(t testing.TB, n ygot.PathS
 	gs := &oc.Qos_Interface_Input_VoqInterface_Queue{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Input_VoqInterface_Queue", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Input_VoqInterface_Queue", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Input_VoqInterface_Queue{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2386,7 +2386,7 @@ func watch_Qos_Interface_InterfaceRefPath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Qos_Interface_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2843,7 +2843,7 @@ func watch_Qos_Interface_OutputPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.Qos_Interface_Output{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3147,7 +3147,7 @@ func watch_Qos_Interface_Output_ClassifierPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Qos_Interface_Output_Classifier{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_Classifier", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_Classifier", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_Classifier{
 			Metadata: md,
 		}).SetVal(gs), nil
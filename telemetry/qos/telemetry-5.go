@@ -248,7 +248,7 @@ func watch_Qos_Interface_Output_Classifier_TermPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Qos_Interface_Output_Classifier_Term{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_Classifier_Term", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_Classifier_Term", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_Classifier_Term{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1011,7 +1011,7 @@ func watch_Qos_Interface_Output_InterfaceRefPath(t testing.TB, n ygot.PathStruct
 	gs := &oc.Qos_Interface_Output_InterfaceRef{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_InterfaceRef", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_InterfaceRef", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_InterfaceRef{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1621,7 +1621,7 @@ func watch_Qos_Interface_Output_QueuePath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Qos_Interface_Output_Queue{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_Queue", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_Queue", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_Queue{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2843,7 +2843,7 @@ func watch_Qos_Interface_Output_SchedulerPolicyPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Qos_Interface_Output_SchedulerPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_SchedulerPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_SchedulerPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_SchedulerPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3147,7 +3147,7 @@ func watch_Qos_Interface_Output_SchedulerPolicy_SchedulerPath(t testing.TB, n yg
 	gs := &oc.Qos_Interface_Output_SchedulerPolicy_Scheduler{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Interface_Output_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Interface_Output_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Interface_Output_SchedulerPolicy_Scheduler{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1319,7 +1319,7 @@ func watch_Qos_QueueManagementProfilePath(t testing.TB, n ygot.PathStruct, durat
 	gs := &oc.Qos_QueueManagementProfile{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_QueueManagementProfile{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1623,7 +1623,7 @@ func watch_Qos_QueueManagementProfile_RedPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.Qos_QueueManagementProfile_Red{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Red", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Red", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_QueueManagementProfile_Red{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1774,7 +1774,7 @@ func watch_Qos_QueueManagementProfile_Red_UniformPath(t testing.TB, n ygot.PathS
 	gs := &oc.Qos_QueueManagementProfile_Red_Uniform{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Red_Uniform", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Red_Uniform", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_QueueManagementProfile_Red_Uniform{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2541,7 +2541,7 @@ func watch_Qos_QueueManagementProfile_WredPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.Qos_QueueManagementProfile_Wred{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Wred", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Wred", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_QueueManagementProfile_Wred{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2692,7 +2692,7 @@ func watch_Qos_QueueManagementProfile_Wred_UniformPath(t testing.TB, n ygot.Path
 	gs := &oc.Qos_QueueManagementProfile_Wred_Uniform{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Wred_Uniform", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_QueueManagementProfile_Wred_Uniform", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_QueueManagementProfile_Wred_Uniform{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -554,7 +554,7 @@ func watch_Qos_QueuePath(t testing.TB, n ygot.PathStruct, duration time.Duration
 	gs := &oc.Qos_Queue{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_Queue", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_Queue", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_Queue{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -858,7 +858,7 @@ func watch_Qos_SchedulerPolicyPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.Qos_SchedulerPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1162,7 +1162,7 @@ func watch_Qos_SchedulerPolicy_SchedulerPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.Qos_SchedulerPolicy_Scheduler{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1313,7 +1313,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_InputPath(t testing.TB, n ygot.PathStru
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_Input{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_Input", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_Input", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_Input{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2076,7 +2076,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_OneRateTwoColorPath(t testing.TB, n ygo
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_OneRateTwoColor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_OneRateTwoColor{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2839,7 +2839,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformActionPath(t tes
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction{
 			Metadata: md,
 		}).SetVal(gs), nil
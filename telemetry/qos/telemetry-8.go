@@ -248,7 +248,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedActionPath(t test
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1623,7 +1623,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_OutputPath(t testing.TB, n ygot.PathStr
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_Output{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_Output", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_Output", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_Output{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2539,7 +2539,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_TwoRateThreeColorPath(t testing.TB, n y
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_TwoRateThreeColor{
 			Metadata: md,
 		}).SetVal(gs), nil
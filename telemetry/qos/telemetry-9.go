@@ -248,7 +248,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformActionPath(t t
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -858,7 +858,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedActionPath(t te
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2080,7 +2080,7 @@ func watch_Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateActionPath(t t
 	gs := &oc.Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction", gs, queryPath, false, false)
 		return (&oc.QualifiedQos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction{
 			Metadata: md,
 		}).SetVal(gs), nil
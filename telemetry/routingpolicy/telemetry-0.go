@@ -95,7 +95,7 @@ func watch_RoutingPolicyPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.RoutingPolicy{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_RoutingPolicy_DefinedSetsPath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.RoutingPolicy_DefinedSets{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -397,7 +397,7 @@ func watch_RoutingPolicy_DefinedSets_BgpDefinedSetsPath(t testing.TB, n ygot.Pat
 	gs := &oc.RoutingPolicy_DefinedSets_BgpDefinedSets{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_BgpDefinedSets{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -548,7 +548,7 @@ func watch_RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSetPath(t testing.TB,
 	gs := &oc.RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1005,7 +1005,7 @@ func watch_RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySetPath(t testing.T
 	gs := &oc.RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet{
 			Metadata: md,
 		}).SetVal(gs), nil
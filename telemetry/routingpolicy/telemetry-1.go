@@ -95,7 +95,7 @@ func watch_RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySetPath(t testin
 	gs := &oc.RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -707,7 +707,7 @@ func watch_RoutingPolicy_DefinedSets_NeighborSetPath(t testing.TB, n ygot.PathSt
 	gs := &oc.RoutingPolicy_DefinedSets_NeighborSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_NeighborSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_NeighborSet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_NeighborSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1164,7 +1164,7 @@ func watch_RoutingPolicy_DefinedSets_PrefixSetPath(t testing.TB, n ygot.PathStru
 	gs := &oc.RoutingPolicy_DefinedSets_PrefixSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_PrefixSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_PrefixSet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_PrefixSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_RoutingPolicy_DefinedSets_PrefixSet_PrefixPath(t testing.TB, n ygot.P
 	gs := &oc.RoutingPolicy_DefinedSets_PrefixSet_Prefix{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_PrefixSet_Prefix", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_PrefixSet_Prefix", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_PrefixSet_Prefix{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -552,7 +552,7 @@ func watch_RoutingPolicy_DefinedSets_TagSetPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.RoutingPolicy_DefinedSets_TagSet{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_TagSet", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_DefinedSets_TagSet", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_DefinedSets_TagSet{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1009,7 +1009,7 @@ func watch_RoutingPolicy_PolicyDefinitionPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.RoutingPolicy_PolicyDefinition{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1313,7 +1313,7 @@ func watch_RoutingPolicy_PolicyDefinition_StatementPath(t testing.TB, n ygot.Pat
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1464,7 +1464,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_ActionsPath(t testing.TB, n
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Actions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Actions{
 			Metadata: md,
 		}).SetVal(gs), nil
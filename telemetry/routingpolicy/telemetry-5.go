@@ -554,7 +554,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Actions_SetTagPath(t testing
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Actions_SetTag{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -705,7 +705,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_InlinePath(t
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1162,7 +1162,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_ReferencePath
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1466,7 +1466,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_ConditionsPath(t testing.TB,
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Conditions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Conditions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditionsPath
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -399,7 +399,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsP
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -856,7 +856,7 @@ func watch_RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_Com
 	gs := &oc.RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount", gs, queryPath, false, false)
 		return (&oc.QualifiedRoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount{
 			Metadata: md,
 		}).SetVal(gs), nil
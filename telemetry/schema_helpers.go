@@ -22,6 +22,7 @@ import (
 	"time"
 
 	log "github.com/golang/glog"
+	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ondatra/internal/gnmigen/genutil"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/openconfig/ygot/ytypes"
@@ -46,6 +47,7 @@ func Lookup(t testing.TB, n ygot.PathStruct, goStructName string, gs ygot.GoStru
 	t.Helper()
 	datapoints, queryPath := genutil.MustGet(t, n)
 	md, ok, err := genutil.Unmarshal(datapoints, GetSchema(), goStructName, gs, queryPath, isLeaf, preferShadowPath)
+	genutil.RecordComplianceErrors(t, md.ComplianceErrors)
 	if md.ComplianceErrors != nil {
 		if isLeaf {
 			t.Fatalf("noncompliant data encountered while unmarshalling leaf: %v", md.ComplianceErrors)
@@ -58,12 +60,22 @@ func Lookup(t testing.TB, n ygot.PathStruct, goStructName string, gs ygot.GoStru
 	return md, ok
 }
 
+// schemaLoader unzips SchemaTree on first use, so that tests that never
+// unmarshal telemetry do not pay for the schema at startup.
+var schemaLoader = genutil.NewSchemaLoader(UnzipSchema, &SchemaTree)
+
+// schemaTree returns SchemaTree, unzipping it on the first call.
+// This func is used by generated code and doesn't need to be call directly.
+func schemaTree() map[string]*yang.Entry {
+	return schemaLoader.Tree()
+}
+
 // GetSchema return the generated ytypes schema used for unmarshaling datapoints.
 // This func is used by generated code and doesn't need to be call directly.
 func GetSchema() *ytypes.Schema {
 	return &ytypes.Schema{
 		Root:       &Device{},
-		SchemaTree: SchemaTree,
+		SchemaTree: schemaTree(),
 		Unmarshal:  Unmarshal,
 	}
 }
@@ -81,6 +93,54 @@ func (u *BatchWatcher) Await(t testing.TB) (*QualifiedDevice, bool) {
 	return u.lastVal, u.W.Await(t)
 }
 
+// AwaitAll waits for all the watches, which run concurrently, and returns
+// whether all their predicates evaluated to true. It returns false as soon as
+// any watch times out without its predicate becoming true. Pass the W field
+// of each watcher, e.g. AwaitAll(t, operStatusWatcher.W, sessionStateWatcher.W).
+func AwaitAll(t testing.TB, watchers ...*genutil.Watcher) bool {
+	t.Helper()
+	return genutil.AwaitAll(t, watchers...)
+}
+
+// AwaitAny waits for any of the watches, which run concurrently, and returns
+// whether any of their predicates evaluated to true. It returns true as soon as
+// any predicate becomes true. Pass the W field of each watcher.
+func AwaitAny(t testing.TB, watchers ...*genutil.Watcher) bool {
+	t.Helper()
+	return genutil.AwaitAny(t, watchers...)
+}
+
+// Lookuper is a path whose value of type T can be looked up, such as a
+// generated path of a leaf or container.
+type Lookuper[T any] interface {
+	Lookup(t testing.TB) *genutil.Qualified[T]
+}
+
+// Snapshot looks up the values at the paths, which are typically the same path
+// on different devices, as close to simultaneously as possible, so their
+// consistency can be checked within the returned skew.
+func Snapshot[T any](t testing.TB, paths ...Lookuper[T]) *genutil.Snapshot[T] {
+	t.Helper()
+	var lookups []func(testing.TB) *genutil.Qualified[T]
+	for _, p := range paths {
+		lookups = append(lookups, p.Lookup)
+	}
+	return genutil.LookupConcurrently(t, lookups...)
+}
+
+// Record starts looking up the values at the paths every interval in the
+// background, until the returned recorder is stopped or the test ends.
+// Start it at the beginning of a test to record a time series of values, such
+// as CPU or memory utilization, for inspection at the end of the test.
+func Record[T any](t testing.TB, interval time.Duration, paths ...Lookuper[T]) *genutil.Recorder[T] {
+	t.Helper()
+	var lookups []func(testing.TB) *genutil.Qualified[T]
+	for _, p := range paths {
+		lookups = append(lookups, p.Lookup)
+	}
+	return genutil.StartRecorder(t, interval, lookups...)
+}
+
 // BatchCollection is a telemetry Collection whose Await method returns a slice of Device samples.
 type BatchCollection struct {
 	W    *genutil.Watcher
@@ -94,6 +154,18 @@ func (u *BatchCollection) Await(t testing.TB) []*QualifiedDevice {
 	return u.vals
 }
 
+// ExportCSV blocks for the telemetry collection to be complete, and then writes the samples received as CSV to the file at the path.
+func (u *BatchCollection) ExportCSV(t testing.TB, path string) {
+	t.Helper()
+	genutil.ExportCSV(t, path, u.Await(t))
+}
+
+// ExportOpenMetrics blocks for the telemetry collection to be complete, and then writes the samples received in the OpenMetrics text format to the file at the path.
+func (u *BatchCollection) ExportOpenMetrics(t testing.TB, path string) {
+	t.Helper()
+	genutil.ExportOpenMetrics(t, path, u.Await(t))
+}
+
 // NewBatch creates a new batch object.
 // This doesn't need to be called directly. Use dut.Telemetry().NewBatch() instead.
 func NewBatch(root genutil.FakeRootPathStruct) *Batch {
@@ -171,7 +243,7 @@ func (b *Batch) Watch(t testing.TB, duration time.Duration, predicate func(*Qual
 	w := &BatchWatcher{}
 	w.W = genutil.MustWatch(t, b.root, b.paths, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, GetSchema(), fakeRootName, dev, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, GetSchema(), fakeRootName, dev, queryPath, false, false)
 		qd := (&QualifiedDevice{
 			Metadata: md,
 		}).SetVal(dev)
@@ -197,7 +269,7 @@ func (b *Batch) Collect(t testing.TB, duration time.Duration) *BatchCollection {
 		if err != nil {
 			t.Fatal(err)
 		}
-		md, ok, err := genutil.Unmarshal(upd, GetSchema(), fakeRootName, copy, queryPath, false, false)
+		md, ok, err := genutil.UnmarshalUpdates(upd, GetSchema(), fakeRootName, copy, queryPath, false, false)
 		if err != nil || !ok {
 			return nil, err
 		}
@@ -123,13 +123,6 @@ var (
 	SchemaTree map[string]*yang.Entry
 )
 
-func init() {
-	var err error
-	if SchemaTree, err = UnzipSchema(); err != nil {
-		panic("schema error: " + err.Error())
-	}
-}
-
 // Schema returns the details of the generated schema.
 func Schema() (*ytypes.Schema, error) {
 	uzp, err := UnzipSchema()
@@ -163,7 +156,7 @@ func UnzipSchema() (map[string]*yang.Entry, error) {
 // thrown for unknown fields in the input JSON.
 func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
 	tn := reflect.TypeOf(destStruct).Elem().Name()
-	schema, ok := SchemaTree[tn]
+	schema, ok := schemaTree()[tn]
 	if !ok {
 		return fmt.Errorf("could not find schema for type %s", tn)
 	}
@@ -477,7 +470,7 @@ func (t *Acl) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -692,7 +685,7 @@ func (t *Acl_AclSet) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -956,7 +949,7 @@ func (t *Acl_AclSet_AclEntry) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1024,7 +1017,7 @@ func (t *Acl_AclSet_AclEntry_Actions) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_Actions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_Actions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_Actions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1077,7 +1070,7 @@ func (t *Acl_AclSet_AclEntry_InputInterface) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_InputInterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_InputInterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_InputInterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1144,7 +1137,7 @@ func (t *Acl_AclSet_AclEntry_InputInterface_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_InputInterface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_InputInterface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_InputInterface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1279,7 +1272,7 @@ func (t *Acl_AclSet_AclEntry_Ipv4) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_Ipv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_Ipv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1446,7 +1439,7 @@ func (t *Acl_AclSet_AclEntry_Ipv6) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_Ipv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_Ipv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1562,7 +1555,7 @@ func (t *Acl_AclSet_AclEntry_L2) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_L2) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_L2"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_L2"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1661,7 +1654,7 @@ func (t *Acl_AclSet_AclEntry_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1743,7 +1736,7 @@ func (t *Acl_AclSet_AclEntry_Transport) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_AclSet_AclEntry_Transport) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_AclSet_AclEntry_Transport"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_AclSet_AclEntry_Transport"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2112,7 +2105,7 @@ func (t *Acl_Interface) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2310,7 +2303,7 @@ func (t *Acl_Interface_EgressAclSet) ΛListKeyMap() (map[string]interface{}, err
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface_EgressAclSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface_EgressAclSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface_EgressAclSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2403,7 +2396,7 @@ func (t *Acl_Interface_EgressAclSet_AclEntry) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface_EgressAclSet_AclEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface_EgressAclSet_AclEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface_EgressAclSet_AclEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2603,7 +2596,7 @@ func (t *Acl_Interface_IngressAclSet) ΛListKeyMap() (map[string]interface{}, er
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface_IngressAclSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface_IngressAclSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface_IngressAclSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2696,7 +2689,7 @@ func (t *Acl_Interface_IngressAclSet_AclEntry) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface_IngressAclSet_AclEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface_IngressAclSet_AclEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface_IngressAclSet_AclEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2763,7 +2756,7 @@ func (t *Acl_Interface_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Acl_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Acl_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Acl_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3707,7 +3700,7 @@ func (t *Component) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3738,7 +3731,7 @@ func (t *Component_Backplane) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Backplane) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Backplane"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Backplane"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3769,7 +3762,7 @@ func (t *Component_Chassis) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Chassis) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Chassis"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Chassis"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3822,7 +3815,7 @@ func (t *Component_Cpu) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Cpu) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Cpu"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Cpu"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3972,7 +3965,7 @@ func (t *Component_Cpu_Utilization) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Cpu_Utilization) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Cpu_Utilization"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Cpu_Utilization"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4003,7 +3996,7 @@ func (t *Component_Fabric) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Fabric) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Fabric"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Fabric"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4034,7 +4027,7 @@ func (t *Component_Fan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Fan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Fan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Fan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4109,7 +4102,7 @@ func (t *Component_IntegratedCircuit) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_IntegratedCircuit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_IntegratedCircuit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_IntegratedCircuit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4208,7 +4201,7 @@ func (t *Component_IntegratedCircuit_BackplaneFacingCapacity) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_IntegratedCircuit_BackplaneFacingCapacity) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_IntegratedCircuit_BackplaneFacingCapacity"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_IntegratedCircuit_BackplaneFacingCapacity"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4292,7 +4285,7 @@ func (t *Component_IntegratedCircuit_Memory) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_IntegratedCircuit_Memory) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_IntegratedCircuit_Memory"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_IntegratedCircuit_Memory"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4359,7 +4352,7 @@ func (t *Component_Memory) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Memory) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Memory"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Memory"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4456,7 +4449,7 @@ func (t *Component_Pcie) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Pcie) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Pcie"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Pcie"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4640,7 +4633,7 @@ func (t *Component_Pcie_CorrectableErrors) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Pcie_CorrectableErrors) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Pcie_CorrectableErrors"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Pcie_CorrectableErrors"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4979,7 +4972,7 @@ func (t *Component_Pcie_FatalErrors) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Pcie_FatalErrors) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Pcie_FatalErrors"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Pcie_FatalErrors"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5316,7 +5309,7 @@ func (t *Component_Pcie_NonFatalErrors) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Pcie_NonFatalErrors) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Pcie_NonFatalErrors"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Pcie_NonFatalErrors"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5369,7 +5362,7 @@ func (t *Component_Port) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Port) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Port"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Port"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5521,7 +5514,7 @@ func (t *Component_Port_BreakoutMode) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Port_BreakoutMode) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Port_BreakoutMode"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Port_BreakoutMode"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5631,7 +5624,7 @@ func (t *Component_Port_BreakoutMode_Group) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Port_BreakoutMode_Group) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Port_BreakoutMode_Group"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Port_BreakoutMode_Group"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5664,7 +5657,7 @@ func (t *Component_PowerSupply) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_PowerSupply) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_PowerSupply"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_PowerSupply"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5757,7 +5750,7 @@ func (t *Component_Property) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Property) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Property"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Property"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5805,7 +5798,7 @@ func (t *Component_SoftwareModule) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_SoftwareModule) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_SoftwareModule"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_SoftwareModule"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5836,7 +5829,7 @@ func (t *Component_Storage) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Storage) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Storage"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Storage"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5895,7 +5888,7 @@ func (t *Component_Subcomponent) ΛListKeyMap() (map[string]interface{}, error)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Subcomponent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Subcomponent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Subcomponent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6096,7 +6089,7 @@ func (t *Component_Temperature) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Temperature) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Temperature"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Temperature"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6732,7 +6725,7 @@ func (t *Component_Transceiver) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6942,7 +6935,7 @@ func (t *Component_Transceiver_Channel) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_Channel) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_Channel"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_Channel"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7092,7 +7085,7 @@ func (t *Component_Transceiver_Channel_InputPower) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_Channel_InputPower) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_Channel_InputPower"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_Channel_InputPower"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7244,7 +7237,7 @@ func (t *Component_Transceiver_Channel_LaserBiasCurrent) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_Channel_LaserBiasCurrent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_Channel_LaserBiasCurrent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_Channel_LaserBiasCurrent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7396,7 +7389,7 @@ func (t *Component_Transceiver_Channel_OutputPower) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_Channel_OutputPower) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_Channel_OutputPower"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_Channel_OutputPower"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7548,7 +7541,7 @@ func (t *Component_Transceiver_InputPower) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_InputPower) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_InputPower"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_InputPower"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7700,7 +7693,7 @@ func (t *Component_Transceiver_LaserBiasCurrent) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_LaserBiasCurrent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_LaserBiasCurrent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_LaserBiasCurrent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7852,7 +7845,7 @@ func (t *Component_Transceiver_OutputPower) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_OutputPower) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_OutputPower"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_OutputPower"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8004,7 +7997,7 @@ func (t *Component_Transceiver_PostFecBer) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_PostFecBer) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_PostFecBer"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_PostFecBer"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8156,7 +8149,7 @@ func (t *Component_Transceiver_PreFecBer) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Component_Transceiver_PreFecBer) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Component_Transceiver_PreFecBer"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Component_Transceiver_PreFecBer"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8970,7 +8963,7 @@ func (t *Device) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Device) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Device"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9597,7 +9590,7 @@ func (t *Flow) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9696,7 +9689,7 @@ func (t *Flow_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9862,7 +9855,7 @@ func (t *Flow_EgressTracking) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_EgressTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_EgressTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_EgressTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9961,7 +9954,7 @@ func (t *Flow_EgressTracking_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_EgressTracking_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_EgressTracking_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_EgressTracking_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10410,7 +10403,7 @@ func (t *Flow_IngressTracking) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_IngressTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_IngressTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_IngressTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10509,7 +10502,7 @@ func (t *Flow_IngressTracking_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_IngressTracking_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_IngressTracking_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_IngressTracking_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10675,7 +10668,7 @@ func (t *Flow_IngressTracking_EgressTracking) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_IngressTracking_EgressTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_IngressTracking_EgressTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_IngressTracking_EgressTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10776,7 +10769,7 @@ func (t *Flow_IngressTracking_EgressTracking_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Flow_IngressTracking_EgressTracking_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Flow_IngressTracking_EgressTracking_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Flow_IngressTracking_EgressTracking_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11385,7 +11378,7 @@ func (t *Interface) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11506,7 +11499,7 @@ func (t *Interface_Aggregation) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Aggregation) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Aggregation"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Aggregation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11605,7 +11598,7 @@ func (t *Interface_Aggregation_SwitchedVlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Aggregation_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Aggregation_SwitchedVlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Aggregation_SwitchedVlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11944,7 +11937,7 @@ func (t *Interface_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12218,7 +12211,7 @@ func (t *Interface_Ethernet) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Ethernet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Ethernet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12589,7 +12582,7 @@ func (t *Interface_Ethernet_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Ethernet_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Ethernet_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12688,7 +12681,7 @@ func (t *Interface_Ethernet_SwitchedVlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Ethernet_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_SwitchedVlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Ethernet_SwitchedVlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12763,7 +12756,7 @@ func (t *Interface_HoldTime) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_HoldTime) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_HoldTime"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_HoldTime"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12855,7 +12848,7 @@ func (t *Interface_RoutedVlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13253,7 +13246,7 @@ func (t *Interface_RoutedVlan_Ipv4) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13467,7 +13460,7 @@ func (t *Interface_RoutedVlan_Ipv4_Address) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Address) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Address"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13689,7 +13682,7 @@ func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[string
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13760,7 +13753,7 @@ func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13997,7 +13990,7 @@ func (t *Interface_RoutedVlan_Ipv4_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14092,7 +14085,7 @@ func (t *Interface_RoutedVlan_Ipv4_Neighbor) ΛListKeyMap() (map[string]interfac
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14145,7 +14138,7 @@ func (t *Interface_RoutedVlan_Ipv4_ProxyArp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_ProxyArp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_ProxyArp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_ProxyArp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14221,7 +14214,7 @@ func (t *Interface_RoutedVlan_Ipv4_Unnumbered) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Unnumbered"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Unnumbered"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14288,7 +14281,7 @@ func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14709,7 +14702,7 @@ func (t *Interface_RoutedVlan_Ipv6) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14940,7 +14933,7 @@ func (t *Interface_RoutedVlan_Ipv6_Address) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Address) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Address"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15179,7 +15172,7 @@ func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[string
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15250,7 +15243,7 @@ func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15487,7 +15480,7 @@ func (t *Interface_RoutedVlan_Ipv6_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15616,7 +15609,7 @@ func (t *Interface_RoutedVlan_Ipv6_Neighbor) ΛListKeyMap() (map[string]interfac
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15704,7 +15697,7 @@ func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_RouterAdvertisement"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_RouterAdvertisement"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15780,7 +15773,7 @@ func (t *Interface_RoutedVlan_Ipv6_Unnumbered) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Unnumbered"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Unnumbered"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15847,7 +15840,7 @@ func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16174,7 +16167,7 @@ func (t *Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16511,7 +16504,7 @@ func (t *Interface_Subinterface_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16911,7 +16904,7 @@ func (t *Interface_Subinterface_Ipv4) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17125,7 +17118,7 @@ func (t *Interface_Subinterface_Ipv4_Address) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Address) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Address"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17347,7 +17340,7 @@ func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17418,7 +17411,7 @@ func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17655,7 +17648,7 @@ func (t *Interface_Subinterface_Ipv4_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17750,7 +17743,7 @@ func (t *Interface_Subinterface_Ipv4_Neighbor) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17803,7 +17796,7 @@ func (t *Interface_Subinterface_Ipv4_ProxyArp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_ProxyArp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_ProxyArp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_ProxyArp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17879,7 +17872,7 @@ func (t *Interface_Subinterface_Ipv4_Unnumbered) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Unnumbered"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Unnumbered"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17946,7 +17939,7 @@ func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -18389,7 +18382,7 @@ func (t *Interface_Subinterface_Ipv6) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -18620,7 +18613,7 @@ func (t *Interface_Subinterface_Ipv6_Address) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Address) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Address"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -18859,7 +18852,7 @@ func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -126,7 +126,7 @@ func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -243,7 +243,7 @@ func (t *Interface_Subinterface_Ipv6_Autoconf) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Autoconf) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Autoconf"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Autoconf"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -480,7 +480,7 @@ func (t *Interface_Subinterface_Ipv6_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -609,7 +609,7 @@ func (t *Interface_Subinterface_Ipv6_Neighbor) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -697,7 +697,7 @@ func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_RouterAdvertisement"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_RouterAdvertisement"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -773,7 +773,7 @@ func (t *Interface_Subinterface_Ipv6_Unnumbered) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Unnumbered"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Unnumbered"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -840,7 +840,7 @@ func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -956,7 +956,7 @@ func (t *Interface_Subinterface_Vlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1038,7 +1038,7 @@ func (t *Interface_Subinterface_Vlan_EgressMapping) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_EgressMapping) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_EgressMapping"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_EgressMapping"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1122,7 +1122,7 @@ func (t *Interface_Subinterface_Vlan_IngressMapping) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_IngressMapping) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_IngressMapping"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_IngressMapping"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1353,7 +1353,7 @@ func (t *Interface_Subinterface_Vlan_Match) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1420,7 +1420,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTagged) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTagged) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTagged"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTagged"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1487,7 +1487,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1588,7 +1588,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1672,7 +1672,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1739,7 +1739,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1823,7 +1823,7 @@ func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1873,7 +1873,7 @@ func (t *Interface_Subinterface_Vlan_Match_SingleTagged) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_SingleTagged) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_SingleTagged"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_SingleTagged"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1923,7 +1923,7 @@ func (t *Interface_Subinterface_Vlan_Match_SingleTaggedList) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_SingleTaggedList) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_SingleTaggedList"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_SingleTaggedList"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1990,7 +1990,7 @@ func (t *Interface_Subinterface_Vlan_Match_SingleTaggedRange) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Interface_Subinterface_Vlan_Match_SingleTaggedRange) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan_Match_SingleTaggedRange"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Interface_Subinterface_Vlan_Match_SingleTaggedRange"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2189,7 +2189,7 @@ func (t *Keychain) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Keychain) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Keychain"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Keychain"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2326,7 +2326,7 @@ func (t *Keychain_Key) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Keychain_Key) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Keychain_Key"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Keychain_Key"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2391,7 +2391,7 @@ func (t *Keychain_Key_ReceiveLifetime) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Keychain_Key_ReceiveLifetime) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Keychain_Key_ReceiveLifetime"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Keychain_Key_ReceiveLifetime"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2477,7 +2477,7 @@ func (t *Keychain_Key_SendLifetime) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Keychain_Key_SendLifetime) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Keychain_Key_SendLifetime"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Keychain_Key_SendLifetime"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2646,7 +2646,7 @@ func (t *Lacp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lacp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lacp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lacp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2900,7 +2900,7 @@ func (t *Lacp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lacp_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lacp_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lacp_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3202,7 +3202,7 @@ func (t *Lacp_Interface_Member) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lacp_Interface_Member) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lacp_Interface_Member"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lacp_Interface_Member"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3352,7 +3352,7 @@ func (t *Lacp_Interface_Member_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lacp_Interface_Member_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lacp_Interface_Member_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lacp_Interface_Member_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3651,7 +3651,7 @@ func (t *Lldp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3835,7 +3835,7 @@ func (t *Lldp_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4058,7 +4058,7 @@ func (t *Lldp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4225,7 +4225,7 @@ func (t *Lldp_Interface_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Interface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4765,7 +4765,7 @@ func (t *Lldp_Interface_Neighbor) ΛListKeyMap() (map[string]interface{}, error)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Interface_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Interface_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4838,7 +4838,7 @@ func (t *Lldp_Interface_Neighbor_Capability) ΛListKeyMap() (map[string]interfac
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Interface_Neighbor_Capability) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor_Capability"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Interface_Neighbor_Capability"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4960,7 +4960,7 @@ func (t *Lldp_Interface_Neighbor_Tlv) ΛListKeyMap() (map[string]interface{}, er
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Lldp_Interface_Neighbor_Tlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor_Tlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Lldp_Interface_Neighbor_Tlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5233,7 +5233,7 @@ func (t *LocalRoutes) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5347,7 +5347,7 @@ func (t *LocalRoutes_Aggregate) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes_Aggregate) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes_Aggregate"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes_Aggregate"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5561,7 +5561,7 @@ func (t *LocalRoutes_Static) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes_Static) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes_Static"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes_Static"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5719,7 +5719,7 @@ func (t *LocalRoutes_Static_NextHop) ΛListKeyMap() (map[string]interface{}, err
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes_Static_NextHop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes_Static_NextHop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes_Static_NextHop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5767,7 +5767,7 @@ func (t *LocalRoutes_Static_NextHop_EnableBfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes_Static_NextHop_EnableBfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes_Static_NextHop_EnableBfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes_Static_NextHop_EnableBfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5834,7 +5834,7 @@ func (t *LocalRoutes_Static_NextHop_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *LocalRoutes_Static_NextHop_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["LocalRoutes_Static_NextHop_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["LocalRoutes_Static_NextHop_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6260,7 +6260,7 @@ func (t *Meta) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Meta) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Meta"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Meta"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6370,7 +6370,7 @@ func (t *Meta_Window) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Meta_Window) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Meta_Window"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Meta_Window"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7636,7 +7636,7 @@ func (t *NetworkInstance) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8510,7 +8510,7 @@ func (t *NetworkInstance_Afts) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8688,7 +8688,7 @@ func (t *NetworkInstance_Afts_Ipv4Entry) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_Ipv4Entry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_Ipv4Entry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_Ipv4Entry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8868,7 +8868,7 @@ func (t *NetworkInstance_Afts_Ipv6Entry) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_Ipv6Entry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_Ipv6Entry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_Ipv6Entry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9028,7 +9028,7 @@ func (t *NetworkInstance_Afts_LabelEntry) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_LabelEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_LabelEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_LabelEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9174,7 +9174,7 @@ func (t *NetworkInstance_Afts_MacEntry) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_MacEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_MacEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_MacEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9417,7 +9417,7 @@ func (t *NetworkInstance_Afts_NextHop) ΛListKeyMap() (map[string]interface{}, e
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9769,7 +9769,7 @@ func (t *NetworkInstance_Afts_NextHopGroup) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHopGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHopGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHopGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9985,7 +9985,7 @@ func (t *NetworkInstance_Afts_NextHopGroup_Condition) ΛListKeyMap() (map[string
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHopGroup_Condition) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHopGroup_Condition"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHopGroup_Condition"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10080,7 +10080,7 @@ func (t *NetworkInstance_Afts_NextHopGroup_Condition_InputInterface) ΛListKeyMa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHopGroup_Condition_InputInterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHopGroup_Condition_InputInterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHopGroup_Condition_InputInterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10158,7 +10158,7 @@ func (t *NetworkInstance_Afts_NextHopGroup_NextHop) ΛListKeyMap() (map[string]i
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHopGroup_NextHop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHopGroup_NextHop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHopGroup_NextHop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10225,7 +10225,7 @@ func (t *NetworkInstance_Afts_NextHop_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHop_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHop_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHop_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10292,7 +10292,7 @@ func (t *NetworkInstance_Afts_NextHop_IpInIp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_NextHop_IpInIp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_NextHop_IpInIp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_NextHop_IpInIp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10574,7 +10574,7 @@ func (t *NetworkInstance_Afts_PolicyForwardingEntry) ΛListKeyMap() (map[string]
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Afts_PolicyForwardingEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Afts_PolicyForwardingEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Afts_PolicyForwardingEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10756,7 +10756,7 @@ func (t *NetworkInstance_ConnectionPoint) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_ConnectionPoint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_ConnectionPoint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_ConnectionPoint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10934,7 +10934,7 @@ func (t *NetworkInstance_ConnectionPoint_Endpoint) ΛListKeyMap() (map[string]in
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_ConnectionPoint_Endpoint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_ConnectionPoint_Endpoint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_ConnectionPoint_Endpoint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11052,7 +11052,7 @@ func (t *NetworkInstance_ConnectionPoint_Endpoint_Local) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_ConnectionPoint_Endpoint_Local) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_ConnectionPoint_Endpoint_Local"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_ConnectionPoint_Endpoint_Local"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11136,7 +11136,7 @@ func (t *NetworkInstance_ConnectionPoint_Endpoint_Remote) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_ConnectionPoint_Endpoint_Remote) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_ConnectionPoint_Endpoint_Remote"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_ConnectionPoint_Endpoint_Remote"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11220,7 +11220,7 @@ func (t *NetworkInstance_ConnectionPoint_Endpoint_Vxlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_ConnectionPoint_Endpoint_Vxlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_ConnectionPoint_Endpoint_Vxlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_ConnectionPoint_Endpoint_Vxlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11304,7 +11304,7 @@ func (t *NetworkInstance_Encapsulation) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Encapsulation) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Encapsulation"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Encapsulation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11577,7 +11577,7 @@ func (t *NetworkInstance_Evpn) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11746,7 +11746,7 @@ func (t *NetworkInstance_Evpn_EthernetSegment) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EthernetSegment) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EthernetSegment"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EthernetSegment"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11851,7 +11851,7 @@ func (t *NetworkInstance_Evpn_EthernetSegment_DfElection) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EthernetSegment_DfElection) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EthernetSegment_DfElection"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EthernetSegment_DfElection"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12179,7 +12179,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12378,7 +12378,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance_BComponent) ΛListKeyMap() (map[strin
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance_BComponent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance_BComponent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance_BComponent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12439,7 +12439,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent) ΛListKeyMap()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance_BComponent_IComponent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12506,7 +12506,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance_ImportExportPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12663,7 +12663,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance_Vxlan) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance_Vxlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance_Vxlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance_Vxlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12730,7 +12730,7 @@ func (t *NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Evpn_EvpnInstance_Vxlan_AnycastSourceInterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12940,7 +12940,7 @@ func (t *NetworkInstance_Fdb) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13047,7 +13047,7 @@ func (t *NetworkInstance_Fdb_ArpProxy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_ArpProxy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_ArpProxy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_ArpProxy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13171,7 +13171,7 @@ func (t *NetworkInstance_Fdb_MacMobility) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_MacMobility) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_MacMobility"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_MacMobility"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13352,7 +13352,7 @@ func (t *NetworkInstance_Fdb_MacTable) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_MacTable) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_MacTable"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_MacTable"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13506,7 +13506,7 @@ func (t *NetworkInstance_Fdb_MacTable_Entry) ΛListKeyMap() (map[string]interfac
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_MacTable_Entry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_MacTable_Entry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_MacTable_Entry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13561,7 +13561,7 @@ func (t *NetworkInstance_Fdb_MacTable_Entry_Interface) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_MacTable_Entry_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_MacTable_Entry_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_MacTable_Entry_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13628,7 +13628,7 @@ func (t *NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_MacTable_Entry_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13737,7 +13737,7 @@ func (t *NetworkInstance_Fdb_NdProxy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Fdb_NdProxy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Fdb_NdProxy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Fdb_NdProxy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13812,7 +13812,7 @@ func (t *NetworkInstance_InterInstancePolicies) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_InterInstancePolicies) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_InterInstancePolicies"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_InterInstancePolicies"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13919,7 +13919,7 @@ func (t *NetworkInstance_InterInstancePolicies_ApplyPolicy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_InterInstancePolicies_ApplyPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_InterInstancePolicies_ApplyPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_InterInstancePolicies_ApplyPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13986,7 +13986,7 @@ func (t *NetworkInstance_InterInstancePolicies_ImportExportPolicy) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_InterInstancePolicies_ImportExportPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_InterInstancePolicies_ImportExportPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_InterInstancePolicies_ImportExportPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14132,7 +14132,7 @@ func (t *NetworkInstance_Interface) ΛListKeyMap() (map[string]interface{}, erro
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14372,7 +14372,7 @@ func (t *NetworkInstance_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14703,7 +14703,7 @@ func (t *NetworkInstance_Mpls_Global) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14805,7 +14805,7 @@ func (t *NetworkInstance_Mpls_Global_Interface) ΛListKeyMap() (map[string]inter
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Global_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Global_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Global_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14872,7 +14872,7 @@ func (t *NetworkInstance_Mpls_Global_Interface_InterfaceRef) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Global_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Global_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Global_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14967,7 +14967,7 @@ func (t *NetworkInstance_Mpls_Global_ReservedLabelBlock) ΛListKeyMap() (map[str
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Global_ReservedLabelBlock) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Global_ReservedLabelBlock"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Global_ReservedLabelBlock"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15123,7 +15123,7 @@ func (t *NetworkInstance_Mpls_Interface) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15258,7 +15258,7 @@ func (t *NetworkInstance_Mpls_Interface_IgpFloodingBandwidth) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Interface_IgpFloodingBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Interface_IgpFloodingBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Interface_IgpFloodingBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15325,7 +15325,7 @@ func (t *NetworkInstance_Mpls_Interface_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15523,7 +15523,7 @@ func (t *NetworkInstance_Mpls_Lsps) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15796,7 +15796,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16019,7 +16019,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath) ΛListKeyM
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16115,7 +16115,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRou
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_NamedExplicitPath_ExplicitRouteObject"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16556,7 +16556,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel) ΛListKeyMap() (map[s
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16665,7 +16665,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16848,7 +16848,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidt
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -16937,7 +16937,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidt
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Overflow"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -17026,7 +17026,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidt
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Bandwidth_AutoBandwidth_Underflow"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -207,7 +207,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -499,7 +499,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1039,7 +1039,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1124,7 +1124,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_AdminGroups"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1220,7 +1220,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_CandidateSecondaryPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1300,7 +1300,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PPrimaryPath_PathMetricBoundConstraint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1719,7 +1719,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1804,7 +1804,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_AdminGroups"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1884,7 +1884,7 @@ func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_ConstrainedPath_Tunnel_P2PTunnelAttributes_P2PSecondaryPath_PathMetricBoundConstraint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2011,7 +2011,7 @@ func (t *NetworkInstance_Mpls_Lsps_StaticLsp) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_StaticLsp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_StaticLsp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_StaticLsp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2095,7 +2095,7 @@ func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Egress) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Egress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_StaticLsp_Egress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_StaticLsp_Egress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2179,7 +2179,7 @@ func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Ingress) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Ingress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_StaticLsp_Ingress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_StaticLsp_Ingress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2263,7 +2263,7 @@ func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Transit) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_StaticLsp_Transit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_StaticLsp_Transit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_StaticLsp_Transit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2318,7 +2318,7 @@ func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_UnconstrainedPath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_UnconstrainedPath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2373,7 +2373,7 @@ func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2406,7 +2406,7 @@ func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_Lsps_UnconstrainedPath_PathSetupProtocol_Ldp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2505,7 +2505,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2752,7 +2752,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2846,7 +2846,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2917,7 +2917,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3039,7 +3039,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Global_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3227,7 +3227,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3483,7 +3483,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3563,7 +3563,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_AddressFamily"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3597,7 +3597,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3665,7 +3665,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_InterfaceAttributes_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3935,7 +3935,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor) ΛListKeyMap() (m
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4006,7 +4006,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4201,7 +4201,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency) Λ
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4303,7 +4303,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_Hel
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_HelloHoldtime"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4371,7 +4371,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_Int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Neighbor_HelloAdjacency_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4572,7 +4572,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4751,7 +4751,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily) ΛL
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4884,7 +4884,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Targ
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_Ldp_Targeted_AddressFamily_Target"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5302,7 +5302,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5423,7 +5423,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global) PopulateDefaults
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5835,7 +5835,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6038,7 +6038,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Counters_Errors"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6126,7 +6126,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6201,7 +6201,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_Hellos"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6276,7 +6276,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Global_SoftPreemption"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6603,7 +6603,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface) ΛListKeyMap(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6674,7 +6674,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6801,7 +6801,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReser
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_BandwidthReservation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7179,7 +7179,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7382,7 +7382,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Error
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Counters_Errors"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7457,7 +7457,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Hellos"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7524,7 +7524,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7594,7 +7594,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Protection"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7661,7 +7661,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Interface_Subscription"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7773,7 +7773,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor) ΛListKeyMap()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8268,7 +8268,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session) ΛListKeyMap()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8431,7 +8431,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObj
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_ExplicitRouteObject"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8543,7 +8543,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObjec
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_RecordRouteObject"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8627,7 +8627,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_RsvpTe_Session_SenderTspec"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8898,7 +8898,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9024,7 +9024,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCoun
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_AggregateSidCounter"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9292,7 +9292,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface) ΛLis
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9360,7 +9360,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_Interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9608,7 +9608,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCou
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9738,7 +9738,7 @@ func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCou
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_SignalingProtocols_SegmentRouting_Interface_SidCounter_ForwardingClass"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10035,7 +10035,7 @@ func (t *NetworkInstance_Mpls_TeGlobalAttributes) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_TeGlobalAttributes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_TeGlobalAttributes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_TeGlobalAttributes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10113,7 +10113,7 @@ func (t *NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup) ΛListKeyMap() (map
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_TeGlobalAttributes_AdminGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10349,7 +10349,7 @@ func (t *NetworkInstance_Mpls_TeGlobalAttributes_Srlg) ΛListKeyMap() (map[strin
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_TeGlobalAttributes_Srlg) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_TeGlobalAttributes_Srlg"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_TeGlobalAttributes_Srlg"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10427,7 +10427,7 @@ func (t *NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList) ΛListKeyMap(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_TeGlobalAttributes_Srlg_MembersList"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10511,7 +10511,7 @@ func (t *NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Mpls_TeGlobalAttributes_TeLspTimers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10907,7 +10907,7 @@ func (t *NetworkInstance_PolicyForwarding) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11024,7 +11024,7 @@ func (t *NetworkInstance_PolicyForwarding_Interface) ΛListKeyMap() (map[string]
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11091,7 +11091,7 @@ func (t *NetworkInstance_PolicyForwarding_Interface_InterfaceRef) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11169,7 +11169,7 @@ func (t *NetworkInstance_PolicyForwarding_PathSelectionGroup) ΛListKeyMap() (ma
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_PathSelectionGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_PathSelectionGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_PathSelectionGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11371,7 +11371,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11576,7 +11576,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule) ΛListKeyMap() (map[strin
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11724,7 +11724,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Action"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Action"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11895,7 +11895,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12007,7 +12007,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Targ
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Action_EncapsulateGre_Target"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12142,7 +12142,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Ipv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12311,7 +12311,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Ipv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12429,7 +12429,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_L2) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_L2) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_L2"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_L2"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12513,7 +12513,7 @@ func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Transport) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_PolicyForwarding_Policy_Rule_Transport) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_PolicyForwarding_Policy_Rule_Transport"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_PolicyForwarding_Policy_Rule_Transport"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12979,7 +12979,7 @@ func (t *NetworkInstance_Protocol) ΛListKeyMap() (map[string]interface{}, error
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13093,7 +13093,7 @@ func (t *NetworkInstance_Protocol_Aggregate) ΛListKeyMap() (map[string]interfac
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Aggregate) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Aggregate"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Aggregate"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13412,7 +13412,7 @@ func (t *NetworkInstance_Protocol_Bgp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13859,7 +13859,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14324,7 +14324,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi) ΛListKeyMap() (map[string
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14433,7 +14433,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_AddPaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14487,7 +14487,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart) PopulateDe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14542,7 +14542,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14647,7 +14647,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14723,7 +14723,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14828,7 +14828,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14883,7 +14883,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14988,7 +14988,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15064,7 +15064,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15169,7 +15169,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15224,7 +15224,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn) PopulateDefaults
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15329,7 +15329,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15384,7 +15384,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls) PopulateDefaults
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15489,7 +15489,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15544,7 +15544,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15649,7 +15649,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -15704,7 +15704,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
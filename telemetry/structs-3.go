@@ -160,7 +160,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -215,7 +215,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -320,7 +320,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -375,7 +375,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -480,7 +480,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -639,7 +639,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_RouteSelectionOptions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -694,7 +694,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -799,7 +799,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -854,7 +854,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -959,7 +959,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1057,7 +1057,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1132,7 +1132,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1186,7 +1186,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_AfiSafi_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1253,7 +1253,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_Confederation) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_Confederation) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_Confederation"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_Confederation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1320,7 +1320,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_DefaultRouteDistance"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1398,7 +1398,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix) ΛListKeyMap
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_DynamicNeighborPrefix"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1499,7 +1499,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_GracefulRestart) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1658,7 +1658,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_RouteSelectionOptions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1756,7 +1756,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1831,7 +1831,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1885,7 +1885,7 @@ func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Global_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2619,7 +2619,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor) ΛListKeyMap() (map[string]inter
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3089,7 +3089,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3198,7 +3198,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_AddPaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3305,7 +3305,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_ApplyPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3393,7 +3393,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3448,7 +3448,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3554,7 +3554,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_Prefix
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3630,7 +3630,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3735,7 +3735,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3790,7 +3790,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3896,7 +3896,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_Prefix
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3972,7 +3972,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4077,7 +4077,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4132,7 +4132,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4237,7 +4237,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4292,7 +4292,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4397,7 +4397,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4452,7 +4452,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4558,7 +4558,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_Prefix
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4613,7 +4613,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4718,7 +4718,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4773,7 +4773,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4879,7 +4879,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_Prefix
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4934,7 +4934,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5039,7 +5039,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5140,7 +5140,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_Prefixes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5195,7 +5195,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5300,7 +5300,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5355,7 +5355,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5460,7 +5460,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5536,7 +5536,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5590,7 +5590,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp) Po
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5697,7 +5697,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_ApplyPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5793,7 +5793,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_AsPathOptions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5864,7 +5864,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_EbgpMultihop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5914,7 +5914,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_EnableBfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5985,7 +5985,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_ErrorHandling"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6154,7 +6154,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart) PopulateDefaults
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6208,7 +6208,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_LoggingOptions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6285,7 +6285,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Messages"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Messages"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6403,7 +6403,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Messages_Received"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6521,7 +6521,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Messages_Sent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6588,7 +6588,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Queues) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Queues) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Queues"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Queues"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6659,7 +6659,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_RouteReflector"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6793,7 +6793,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Timers) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Timers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Timers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Timers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6953,7 +6953,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_Transport) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_Transport) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_Transport"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_Transport"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7029,7 +7029,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7083,7 +7083,7 @@ func (t *NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp) PopulateDe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_Neighbor_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7680,7 +7680,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup) ΛListKeyMap() (map[string]inte
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8111,7 +8111,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi) ΛListKeyMap() (map[str
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8220,7 +8220,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_AddPaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8327,7 +8327,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_ApplyPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8381,7 +8381,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8436,7 +8436,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8542,7 +8542,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_Prefi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8618,7 +8618,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8723,7 +8723,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8778,7 +8778,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8884,7 +8884,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_Prefi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6LabeledUnicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8960,7 +8960,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9065,7 +9065,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_Ipv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9120,7 +9120,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9225,7 +9225,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnEvpn_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9280,7 +9280,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9385,7 +9385,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L2VpnVpls_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9440,7 +9440,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9546,7 +9546,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_Prefi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9601,7 +9601,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9707,7 +9707,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixL
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv4Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9762,7 +9762,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9868,7 +9868,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_Prefi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Multicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9923,7 +9923,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10029,7 +10029,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixL
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_L3VpnIpv6Unicast_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10084,7 +10084,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10189,7 +10189,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLim
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv4_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10244,7 +10244,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10349,7 +10349,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLim
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_SrtePolicyIpv6_PrefixLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10447,7 +10447,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10522,7 +10522,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ebgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10576,7 +10576,7 @@ func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Bgp_PeerGroup_AfiSafi_UseMultiplePaths_Ibgp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -132,7 +132,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -216,7 +216,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Received"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -300,7 +300,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_Counters_Queries_Sent"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -384,7 +384,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Reports) PopulateDefau
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_Counters_Reports) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_Counters_Reports"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_Counters_Reports"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -479,7 +479,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_Group) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_Group) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_Group"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_Group"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -546,7 +546,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_InterfaceRef) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -624,7 +624,7 @@ func (t *NetworkInstance_Protocol_Igmp_Interface_StaticGroups) ΛListKeyMap() (m
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Igmp_Interface_StaticGroups) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Igmp_Interface_StaticGroups"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Igmp_Interface_StaticGroups"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -921,7 +921,7 @@ func (t *NetworkInstance_Protocol_Isis) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1587,7 +1587,7 @@ func (t *NetworkInstance_Protocol_Isis_Global) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1723,7 +1723,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Af) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Af) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Af"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Af"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1807,7 +1807,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Af_MultiTopology) PopulateDefaults
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Af_MultiTopology) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Af_MultiTopology"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Af_MultiTopology"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1882,7 +1882,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Afi) ΛListKeyMap() (map[string]in
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Afi) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Afi"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Afi"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1953,7 +1953,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_GracefulRestart) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2030,7 +2030,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2101,7 +2101,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Leve
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level1ToLevel2"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2172,7 +2172,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Leve
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_InterLevelPropagationPolicies_Level2ToLevel1"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2249,7 +2249,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_LspBit) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_LspBit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_LspBit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_LspBit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2324,7 +2324,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_LspBit_AttachedBit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2537,7 +2537,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2612,7 +2612,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger) 
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_LspBit_OverloadBit_ResetTrigger"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2667,7 +2667,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2738,7 +2738,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Mpls_IgpLdpSync"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2792,7 +2792,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Nsr) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Nsr) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Nsr"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Nsr"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2842,7 +2842,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_ReferenceBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2926,7 +2926,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_SegmentRouting) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_SegmentRouting) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_SegmentRouting"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_SegmentRouting"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3041,7 +3041,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Timers) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Timers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Timers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Timers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3142,7 +3142,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration) PopulateDefa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Timers_LspGeneration"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3247,7 +3247,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Timers_Spf) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Timers_Spf) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Timers_Spf"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Timers_Spf"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3297,7 +3297,7 @@ func (t *NetworkInstance_Protocol_Isis_Global_Transport) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Global_Transport) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Global_Transport"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Global_Transport"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3849,7 +3849,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3942,7 +3942,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Af) ΛListKeyMap() (map[string]
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Af) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Af"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Af"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4013,7 +4013,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Authentication) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4063,7 +4063,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Bfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Bfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Bfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Bfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4249,7 +4249,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_CircuitCounters) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_CircuitCounters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_CircuitCounters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_CircuitCounters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4299,7 +4299,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_EnableBfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_EnableBfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_EnableBfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_EnableBfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4366,7 +4366,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_InterfaceRef) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4813,7 +4813,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5180,7 +5180,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Adjacency) ΛListKeyMap()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Adjacency) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Adjacency"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Adjacency"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5316,7 +5316,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af) ΛListKeyMap() (map[s
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Af"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Af"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5614,7 +5614,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5753,7 +5753,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_Adjacen
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_AdjacencySid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_AdjacencySid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_AdjacencySid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5848,7 +5848,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_PrefixS
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_PrefixSid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_PrefixSid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Af_SegmentRouting_PrefixSid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5919,7 +5919,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_HelloAuthentication) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_HelloAuthentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_HelloAuthentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_HelloAuthentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6106,7 +6106,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6224,7 +6224,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Csnp) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Csnp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Csnp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Csnp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6342,7 +6342,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Esh) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Esh) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Esh"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Esh"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6460,7 +6460,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Iih) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Iih) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Iih"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Iih"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6578,7 +6578,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Ish) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Ish) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Ish"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Ish"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6696,7 +6696,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Lsp) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Lsp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Lsp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Lsp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6814,7 +6814,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Psnp) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Psnp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Psnp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Psnp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6932,7 +6932,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Unknown) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Unknown) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Unknown"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_PacketCounters_Unknown"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6999,7 +6999,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Level_Timers) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Level_Timers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Level_Timers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Level_Timers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7054,7 +7054,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7125,7 +7125,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Mpls_IgpLdpSync) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Mpls_IgpLdpSync) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Mpls_IgpLdpSync"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Mpls_IgpLdpSync"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7192,7 +7192,7 @@ func (t *NetworkInstance_Protocol_Isis_Interface_Timers) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Interface_Timers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Interface_Timers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Interface_Timers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7521,7 +7521,7 @@ func (t *NetworkInstance_Protocol_Isis_Level) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7655,7 +7655,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Authentication) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8149,7 +8149,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9197,7 +9197,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv) ΛListKeyMap() (map[string
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9247,7 +9247,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_AreaAddress) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_AreaAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_AreaAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_AreaAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9314,7 +9314,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Authentication) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9647,7 +9647,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability) ΛListKeyMap()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9749,7 +9749,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv) ΛListKe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9800,7 +9800,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRo
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingAlgorithms) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingAlgorithms"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingAlgorithms"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9972,7 +9972,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRo
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10051,7 +10051,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRo
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability_SrgbDescriptor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability_SrgbDescriptor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_Subtlv_SegmentRoutingCapability_SrgbDescriptor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10146,7 +10146,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_UndefinedSubtlv)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_UndefinedSubtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_UndefinedSubtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_Capability_UndefinedSubtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10300,7 +10300,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10651,7 +10651,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10941,7 +10941,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11009,7 +11009,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Flags) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Flags"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Flags"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11077,7 +11077,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv4SourceRouterId) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv4SourceRouterId"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv4SourceRouterId"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11145,7 +11145,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv6SourceRouterId) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv6SourceRouterId"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Ipv6SourceRouterId"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11241,7 +11241,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_PrefixSid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_PrefixSid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_PrefixSid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11292,7 +11292,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11343,7 +11343,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag64) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag64"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_Subtlv_Tag64"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11439,7 +11439,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Pr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_UndefinedSubtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_UndefinedSubtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIpv4Reachability_Prefix_UndefinedSubtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11593,7 +11593,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11776,7 +11776,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12093,7 +12093,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13076,7 +13076,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13172,7 +13172,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdjacencySid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdjacencySid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdjacencySid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13223,7 +13223,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdminGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdminGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AdminGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13291,7 +13291,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AvailableBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AvailableBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_AvailableBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13474,7 +13474,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13553,7 +13553,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint_Constraint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint_Constraint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_BandwidthConstraint_Constraint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13604,7 +13604,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_ExtendedAdminGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_ExtendedAdminGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_ExtendedAdminGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13655,7 +13655,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4InterfaceAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4InterfaceAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4InterfaceAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13706,7 +13706,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4NeighborAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4NeighborAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv4NeighborAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13757,7 +13757,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6InterfaceAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6InterfaceAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6InterfaceAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13808,7 +13808,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6NeighborAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6NeighborAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_Ipv6NeighborAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13921,7 +13921,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LanAdjacencySid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LanAdjacencySid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LanAdjacencySid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13972,7 +13972,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkAttributes) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkAttributes"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkAttributes"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14040,7 +14040,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelay) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelay"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelay"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14091,7 +14091,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelayVariation) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelayVariation"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkDelayVariation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14159,7 +14159,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkId) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkId"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkId"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14227,7 +14227,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkLoss) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkLoss"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkLoss"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14278,7 +14278,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkProtectionType) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkProtectionType"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_LinkProtectionType"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14329,7 +14329,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxLinkBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxLinkBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxLinkBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14380,7 +14380,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxReservableLinkBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxReservableLinkBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MaxReservableLinkBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14465,7 +14465,7 @@ func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neig
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MinMaxLinkDelay) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MinMaxLinkDelay"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Isis_Level_Lsp_Tlv_ExtendedIsReachability_Neighbor_Instance_Subtlv_MinMaxLinkDelay"], t, opts...); err != nil {
 		return err
 	}
 	return nil
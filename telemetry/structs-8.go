@@ -216,7 +216,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -376,7 +376,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_InformationalCapabilities) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_InformationalCapabilities"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_InformationalCapabilities"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -427,7 +427,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_NodeAdministrativeTags) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_NodeAdministrativeTags"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_NodeAdministrativeTags"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -478,7 +478,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingAlgorithm) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingAlgorithm"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingAlgorithm"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -516,7 +516,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -628,7 +628,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -696,7 +696,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_SidLabel) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_SidLabel"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_SidLabel"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -781,7 +781,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_SegmentRoutingSidLabelRange_Tlv_UnknownTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -866,7 +866,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterI
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_RouterInformation_Tlv_UnknownTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -904,7 +904,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1043,7 +1043,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1081,7 +1081,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1549,7 +1549,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1632,7 +1632,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_AdminGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1717,7 +1717,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnknownSubtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1796,7 +1796,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_Link_SubTlv_UnreservedBandwidth"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1834,7 +1834,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1941,7 +1941,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2026,7 +2026,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_NodeAttribute_SubTlv_UnknownSubtlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2077,7 +2077,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_RouterAddress"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2162,7 +2162,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Traffic
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_TrafficEngineering_Tlv_UnknownTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2246,7 +2246,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_Unknown
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_OpaqueLsa_UnknownTlv"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2502,7 +2502,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2581,7 +2581,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfS
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_RouterLsa_TypeOfService"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2752,7 +2752,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa) Popul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2831,7 +2831,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Lsdb_LsaType_Lsa_SummaryLsa_TypeOfService"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2881,7 +2881,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3095,7 +3095,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Area_VirtualLink) ΛListKeyMap() (map[s
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Area_VirtualLink) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Area_VirtualLink"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Area_VirtualLink"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3422,7 +3422,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3489,7 +3489,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_GracefulRestart"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3602,7 +3602,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy) ΛLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_InterAreaPropagationPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3674,7 +3674,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3741,7 +3741,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync) PopulateDefault
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Mpls_IgpLdpSync"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3840,7 +3840,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Timers"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Timers"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3924,7 +3924,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration) PopulateDe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Timers_LsaGeneration"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4025,7 +4025,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Timers_MaxMetric"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4109,7 +4109,7 @@ func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Ospfv2_Global_Timers_Spf"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4285,7 +4285,7 @@ func (t *NetworkInstance_Protocol_Pim) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4636,7 +4636,7 @@ func (t *NetworkInstance_Protocol_Pim_Global) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Global) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Global"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Global"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4720,7 +4720,7 @@ func (t *NetworkInstance_Protocol_Pim_Global_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Global_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Global_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Global_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4798,7 +4798,7 @@ func (t *NetworkInstance_Protocol_Pim_Global_RendezvousPoint) ΛListKeyMap() (ma
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Global_RendezvousPoint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Global_RendezvousPoint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Global_RendezvousPoint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4893,7 +4893,7 @@ func (t *NetworkInstance_Protocol_Pim_Global_Source) ΛListKeyMap() (map[string]
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Global_Source) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Global_Source"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Global_Source"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4943,7 +4943,7 @@ func (t *NetworkInstance_Protocol_Pim_Global_Ssm) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Global_Ssm) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Global_Ssm"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Global_Ssm"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5356,7 +5356,7 @@ func (t *NetworkInstance_Protocol_Pim_Interface) ΛListKeyMap() (map[string]inte
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5440,7 +5440,7 @@ func (t *NetworkInstance_Protocol_Pim_Interface_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Interface_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Interface_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5490,7 +5490,7 @@ func (t *NetworkInstance_Protocol_Pim_Interface_EnableBfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Interface_EnableBfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Interface_EnableBfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Interface_EnableBfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5557,7 +5557,7 @@ func (t *NetworkInstance_Protocol_Pim_Interface_InterfaceRef) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5686,7 +5686,7 @@ func (t *NetworkInstance_Protocol_Pim_Interface_Neighbor) ΛListKeyMap() (map[st
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Pim_Interface_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Pim_Interface_Neighbor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Pim_Interface_Neighbor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5902,7 +5902,7 @@ func (t *NetworkInstance_Protocol_Static) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Static) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Static"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Static"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6062,7 +6062,7 @@ func (t *NetworkInstance_Protocol_Static_NextHop) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Static_NextHop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Static_NextHop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Static_NextHop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6112,7 +6112,7 @@ func (t *NetworkInstance_Protocol_Static_NextHop_EnableBfd) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Static_NextHop_EnableBfd) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Static_NextHop_EnableBfd"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Static_NextHop_EnableBfd"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6179,7 +6179,7 @@ func (t *NetworkInstance_Protocol_Static_NextHop_InterfaceRef) PopulateDefaults(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Protocol_Static_NextHop_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Protocol_Static_NextHop_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Protocol_Static_NextHop_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6326,7 +6326,7 @@ func (t *NetworkInstance_RouteLimit) ΛListKeyMap() (map[string]interface{}, err
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_RouteLimit) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_RouteLimit"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_RouteLimit"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6747,7 +6747,7 @@ func (t *NetworkInstance_SegmentRouting) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6893,7 +6893,7 @@ func (t *NetworkInstance_SegmentRouting_Srgb) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_Srgb) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_Srgb"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_Srgb"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7005,7 +7005,7 @@ func (t *NetworkInstance_SegmentRouting_Srlb) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_Srlb) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_Srlb"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_Srlb"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7363,7 +7363,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy) ΛListKeyMap() (map[string]int
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7726,7 +7726,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath) ΛListKeyMap() (
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8102,7 +8102,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList) ΛLi
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8203,7 +8203,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Count
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8431,7 +8431,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextH
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8533,7 +8533,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextH
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8601,7 +8601,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextH
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_NextHop_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8721,7 +8721,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_CandidatePath_SegmentList_Sid"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8890,7 +8890,7 @@ func (t *NetworkInstance_SegmentRouting_TePolicy_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_SegmentRouting_TePolicy_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_SegmentRouting_TePolicy_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_SegmentRouting_TePolicy_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8966,7 +8966,7 @@ func (t *NetworkInstance_Table) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Table) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Table"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Table"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9116,7 +9116,7 @@ func (t *NetworkInstance_TableConnection) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_TableConnection) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_TableConnection"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_TableConnection"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9218,7 +9218,7 @@ func (t *NetworkInstance_Vlan) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Vlan) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Vlan"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Vlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9266,7 +9266,7 @@ func (t *NetworkInstance_Vlan_Member) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *NetworkInstance_Vlan_Member) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["NetworkInstance_Vlan_Member"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["NetworkInstance_Vlan_Member"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10144,7 +10144,7 @@ func (t *Qos) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10324,7 +10324,7 @@ func (t *Qos_BufferAllocationProfile) ΛListKeyMap() (map[string]interface{}, er
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_BufferAllocationProfile) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_BufferAllocationProfile"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_BufferAllocationProfile"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10468,7 +10468,7 @@ func (t *Qos_BufferAllocationProfile_Queue) ΛListKeyMap() (map[string]interface
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_BufferAllocationProfile_Queue) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_BufferAllocationProfile_Queue"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_BufferAllocationProfile_Queue"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10667,7 +10667,7 @@ func (t *Qos_Classifier) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10770,7 +10770,7 @@ func (t *Qos_Classifier_Term) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10840,7 +10840,7 @@ func (t *Qos_Classifier_Term_Actions) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Actions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Actions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Actions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10922,7 +10922,7 @@ func (t *Qos_Classifier_Term_Actions_Remark) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Actions_Remark) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Actions_Remark"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Actions_Remark"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11065,7 +11065,7 @@ func (t *Qos_Classifier_Term_Conditions) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11200,7 +11200,7 @@ func (t *Qos_Classifier_Term_Conditions_Ipv4) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions_Ipv4"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions_Ipv4"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11369,7 +11369,7 @@ func (t *Qos_Classifier_Term_Conditions_Ipv6) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions_Ipv6"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions_Ipv6"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11487,7 +11487,7 @@ func (t *Qos_Classifier_Term_Conditions_L2) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions_L2) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions_L2"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions_L2"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11588,7 +11588,7 @@ func (t *Qos_Classifier_Term_Conditions_Mpls) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions_Mpls) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions_Mpls"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions_Mpls"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11672,7 +11672,7 @@ func (t *Qos_Classifier_Term_Conditions_Transport) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Classifier_Term_Conditions_Transport) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Classifier_Term_Conditions_Transport"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Classifier_Term_Conditions_Transport"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11801,7 +11801,7 @@ func (t *Qos_ForwardingGroup) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_ForwardingGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_ForwardingGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_ForwardingGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11926,7 +11926,7 @@ func (t *Qos_Interface) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12389,7 +12389,7 @@ func (t *Qos_Interface_Input) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12583,7 +12583,7 @@ func (t *Qos_Interface_Input_Classifier) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_Classifier) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_Classifier"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_Classifier"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12678,7 +12678,7 @@ func (t *Qos_Interface_Input_Classifier_Term) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_Classifier_Term) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_Classifier_Term"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_Classifier_Term"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12841,7 +12841,7 @@ func (t *Qos_Interface_Input_Queue) ΛListKeyMap() (map[string]interface{}, erro
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_Queue) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_Queue"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_Queue"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13010,7 +13010,7 @@ func (t *Qos_Interface_Input_SchedulerPolicy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_SchedulerPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_SchedulerPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_SchedulerPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13173,7 +13173,7 @@ func (t *Qos_Interface_Input_SchedulerPolicy_Scheduler) ΛListKeyMap() (map[stri
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_SchedulerPolicy_Scheduler) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13355,7 +13355,7 @@ func (t *Qos_Interface_Input_VoqInterface) ΛListKeyMap() (map[string]interface{
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_VoqInterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_VoqInterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_VoqInterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13501,7 +13501,7 @@ func (t *Qos_Interface_Input_VoqInterface_Queue) ΛListKeyMap() (map[string]inte
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Input_VoqInterface_Queue) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Input_VoqInterface_Queue"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Input_VoqInterface_Queue"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13568,7 +13568,7 @@ func (t *Qos_Interface_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13932,7 +13932,7 @@ func (t *Qos_Interface_Output) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14126,7 +14126,7 @@ func (t *Qos_Interface_Output_Classifier) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_Classifier) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_Classifier"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_Classifier"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14221,7 +14221,7 @@ func (t *Qos_Interface_Output_Classifier_Term) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_Classifier_Term) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_Classifier_Term"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_Classifier_Term"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14288,7 +14288,7 @@ func (t *Qos_Interface_Output_InterfaceRef) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_InterfaceRef"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_InterfaceRef"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14451,7 +14451,7 @@ func (t *Qos_Interface_Output_Queue) ΛListKeyMap() (map[string]interface{}, err
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_Queue) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_Queue"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_Queue"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14620,7 +14620,7 @@ func (t *Qos_Interface_Output_SchedulerPolicy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_SchedulerPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_SchedulerPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_SchedulerPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14783,7 +14783,7 @@ func (t *Qos_Interface_Output_SchedulerPolicy_Scheduler) ΛListKeyMap() (map[str
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Interface_Output_SchedulerPolicy_Scheduler) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Interface_Output_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Interface_Output_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -14844,7 +14844,7 @@ func (t *Qos_Queue) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_Queue) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_Queue"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_Queue"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -160,7 +160,7 @@ func (t *Qos_QueueManagementProfile) ΛListKeyMap() (map[string]interface{}, err
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_QueueManagementProfile) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_QueueManagementProfile"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_QueueManagementProfile"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -213,7 +213,7 @@ func (t *Qos_QueueManagementProfile_Red) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_QueueManagementProfile_Red) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_QueueManagementProfile_Red"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_QueueManagementProfile_Red"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -322,7 +322,7 @@ func (t *Qos_QueueManagementProfile_Red_Uniform) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_QueueManagementProfile_Red_Uniform) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_QueueManagementProfile_Red_Uniform"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_QueueManagementProfile_Red_Uniform"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -377,7 +377,7 @@ func (t *Qos_QueueManagementProfile_Wred) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_QueueManagementProfile_Wred) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_QueueManagementProfile_Wred"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_QueueManagementProfile_Wred"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -520,7 +520,7 @@ func (t *Qos_QueueManagementProfile_Wred_Uniform) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_QueueManagementProfile_Wred_Uniform) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_QueueManagementProfile_Wred_Uniform"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_QueueManagementProfile_Wred_Uniform"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -702,7 +702,7 @@ func (t *Qos_SchedulerPolicy) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -982,7 +982,7 @@ func (t *Qos_SchedulerPolicy_Scheduler) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1092,7 +1092,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_Input) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_Input) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_Input"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_Input"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1305,7 +1305,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1389,7 +1389,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction) PopulateDe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ConformAction"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1490,7 +1490,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction) PopulateDef
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_OneRateTwoColor_ExceedAction"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1574,7 +1574,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_Output) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_Output) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_Output"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_Output"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1809,7 +1809,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1893,7 +1893,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ConformAction"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -1994,7 +1994,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction) PopulateD
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ExceedAction"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2095,7 +2095,7 @@ func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction) Populate
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["Qos_SchedulerPolicy_Scheduler_TwoRateThreeColor_ViolateAction"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2271,7 +2271,7 @@ func (t *RoutingPolicy) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -2687,7 +2687,7 @@ func (t *RoutingPolicy_DefinedSets) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3081,7 +3081,7 @@ func (t *RoutingPolicy_DefinedSets_BgpDefinedSets) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_BgpDefinedSets) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_BgpDefinedSets"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_BgpDefinedSets"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3159,7 +3159,7 @@ func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet) ΛListKeyMap() (map
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_BgpDefinedSets_AsPathSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3257,7 +3257,7 @@ func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet) ΛListKeyMap() (
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_BgpDefinedSets_CommunitySet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3355,7 +3355,7 @@ func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet) ΛListKeyMap(
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_BgpDefinedSets_ExtCommunitySet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3433,7 +3433,7 @@ func (t *RoutingPolicy_DefinedSets_NeighborSet) ΛListKeyMap() (map[string]inter
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_NeighborSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_NeighborSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_NeighborSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3659,7 +3659,7 @@ func (t *RoutingPolicy_DefinedSets_PrefixSet) ΛListKeyMap() (map[string]interfa
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_PrefixSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_PrefixSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_PrefixSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3742,7 +3742,7 @@ func (t *RoutingPolicy_DefinedSets_PrefixSet_Prefix) ΛListKeyMap() (map[string]
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_PrefixSet_Prefix) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_PrefixSet_Prefix"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_PrefixSet_Prefix"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -3820,7 +3820,7 @@ func (t *RoutingPolicy_DefinedSets_TagSet) ΛListKeyMap() (map[string]interface{
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_DefinedSets_TagSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_DefinedSets_TagSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_DefinedSets_TagSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4002,7 +4002,7 @@ func (t *RoutingPolicy_PolicyDefinition) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4107,7 +4107,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement) ΛListKeyMap() (map[string]in
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4201,7 +4201,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4368,7 +4368,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions) PopulateDe
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4436,7 +4436,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetAsPathPr
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetAsPathPrepend) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetAsPathPrepend"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetAsPathPrepend"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4547,7 +4547,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunit
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4598,7 +4598,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunit
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Inline) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Inline"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Inline"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4649,7 +4649,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunit
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Reference) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Reference"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetCommunity_Reference"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4761,7 +4761,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4812,7 +4812,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Inline) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Inline"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Inline"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4863,7 +4863,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Reference) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Reference"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_BgpActions_SetExtCommunity_Reference"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -4957,7 +4957,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag) PopulateDefaul
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5007,7 +5007,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline) Populat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Inline"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5057,7 +5057,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Actions_SetTag_Reference"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5234,7 +5234,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions) PopulateDefaults()
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5469,7 +5469,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions) Popu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5537,7 +5537,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPat
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_AsPathLength"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5605,7 +5605,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_Commu
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_CommunityCount"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5676,7 +5676,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_Match
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_MatchAsPathSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_MatchAsPathSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_BgpConditions_MatchAsPathSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5743,7 +5743,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchInterface) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchInterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchInterface"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchInterface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5813,7 +5813,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchNeighborSet) P
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchNeighborSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchNeighborSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchNeighborSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5883,7 +5883,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchPrefixSet) Pop
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchPrefixSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchPrefixSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchPrefixSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -5953,7 +5953,7 @@ func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchTagSet) Popula
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchTagSet) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchTagSet"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["RoutingPolicy_PolicyDefinition_Statement_Conditions_MatchTagSet"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -6788,7 +6788,7 @@ func (t *System) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7006,7 +7006,7 @@ func (t *System_Aaa) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7171,7 +7171,7 @@ func (t *System_Aaa_Accounting) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Accounting) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Accounting"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Accounting"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7244,7 +7244,7 @@ func (t *System_Aaa_Accounting_Event) ΛListKeyMap() (map[string]interface{}, er
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Accounting_Event) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Accounting_Event"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Accounting_Event"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7435,7 +7435,7 @@ func (t *System_Aaa_Authentication) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Authentication) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Authentication"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Authentication"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7517,7 +7517,7 @@ func (t *System_Aaa_Authentication_AdminUser) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Authentication_AdminUser) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Authentication_AdminUser"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Authentication_AdminUser"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7646,7 +7646,7 @@ func (t *System_Aaa_Authentication_User) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Authentication_User) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Authentication_User"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Authentication_User"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7813,7 +7813,7 @@ func (t *System_Aaa_Authorization) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Authorization) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Authorization"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Authorization"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -7869,7 +7869,7 @@ func (t *System_Aaa_Authorization_Event) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_Authorization_Event) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_Authorization_Event"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_Authorization_Event"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8068,7 +8068,7 @@ func (t *System_Aaa_ServerGroup) ΛListKeyMap() (map[string]interface{}, error)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_ServerGroup) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_ServerGroup"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_ServerGroup"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8341,7 +8341,7 @@ func (t *System_Aaa_ServerGroup_Server) ΛListKeyMap() (map[string]interface{},
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_ServerGroup_Server) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_ServerGroup_Server"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_ServerGroup_Server"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8504,7 +8504,7 @@ func (t *System_Aaa_ServerGroup_Server_Radius) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_ServerGroup_Server_Radius) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_ServerGroup_Server_Radius"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_ServerGroup_Server_Radius"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8605,7 +8605,7 @@ func (t *System_Aaa_ServerGroup_Server_Radius_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_ServerGroup_Server_Radius_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_ServerGroup_Server_Radius_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_ServerGroup_Server_Radius_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8710,7 +8710,7 @@ func (t *System_Aaa_ServerGroup_Server_Tacacs) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Aaa_ServerGroup_Server_Tacacs) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Aaa_ServerGroup_Server_Tacacs"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Aaa_ServerGroup_Server_Tacacs"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8856,7 +8856,7 @@ func (t *System_Alarm) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Alarm) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Alarm"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Alarm"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -8904,7 +8904,7 @@ func (t *System_Clock) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Clock) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Clock"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Clock"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9136,7 +9136,7 @@ func (t *System_Cpu) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9286,7 +9286,7 @@ func (t *System_Cpu_HardwareInterrupt) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_HardwareInterrupt) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_HardwareInterrupt"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_HardwareInterrupt"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9436,7 +9436,7 @@ func (t *System_Cpu_Idle) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_Idle) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_Idle"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_Idle"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9586,7 +9586,7 @@ func (t *System_Cpu_Kernel) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_Kernel) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_Kernel"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_Kernel"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9736,7 +9736,7 @@ func (t *System_Cpu_Nice) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_Nice) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_Nice"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_Nice"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -9886,7 +9886,7 @@ func (t *System_Cpu_SoftwareInterrupt) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_SoftwareInterrupt) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_SoftwareInterrupt"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_SoftwareInterrupt"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10036,7 +10036,7 @@ func (t *System_Cpu_Total) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_Total) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_Total"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_Total"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10186,7 +10186,7 @@ func (t *System_Cpu_User) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_User) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_User"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_User"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10336,7 +10336,7 @@ func (t *System_Cpu_Wait) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Cpu_Wait) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Cpu_Wait"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Cpu_Wait"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10626,7 +10626,7 @@ func (t *System_Dns) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Dns) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Dns"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Dns"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10736,7 +10736,7 @@ func (t *System_Dns_HostEntry) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Dns_HostEntry) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Dns_HostEntry"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Dns_HostEntry"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10816,7 +10816,7 @@ func (t *System_Dns_Server) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Dns_Server) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Dns_Server"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Dns_Server"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -10968,7 +10968,7 @@ func (t *System_License) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_License) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_License"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_License"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11167,7 +11167,7 @@ func (t *System_License_License) ΛListKeyMap() (map[string]interface{}, error)
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_License_License) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_License_License"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_License_License"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11341,7 +11341,7 @@ func (t *System_Logging) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Logging) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Logging"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Logging"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11512,7 +11512,7 @@ func (t *System_Logging_Console) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Logging_Console) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Logging_Console"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Logging_Console"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11586,7 +11586,7 @@ func (t *System_Logging_Console_Selector) ΛListKeyMap() (map[string]interface{}
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Logging_Console_Selector) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Logging_Console_Selector"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Logging_Console_Selector"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11825,7 +11825,7 @@ func (t *System_Logging_RemoteServer) ΛListKeyMap() (map[string]interface{}, er
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Logging_RemoteServer) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Logging_RemoteServer"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Logging_RemoteServer"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -11899,7 +11899,7 @@ func (t *System_Logging_RemoteServer_Selector) ΛListKeyMap() (map[string]interf
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Logging_RemoteServer_Selector) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Logging_RemoteServer_Selector"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Logging_RemoteServer_Selector"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12022,7 +12022,7 @@ func (t *System_Memory) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Memory) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Memory"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Memory"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12104,7 +12104,7 @@ func (t *System_Memory_Counters) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Memory_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Memory_Counters"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Memory_Counters"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12291,7 +12291,7 @@ func (t *System_Messages) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Messages) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Messages"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Messages"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12368,7 +12368,7 @@ func (t *System_Messages_DebugService) ΛListKeyMap() (map[string]interface{}, e
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Messages_DebugService) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Messages_DebugService"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Messages_DebugService"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12484,7 +12484,7 @@ func (t *System_Messages_Message) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Messages_Message) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Messages_Message"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Messages_Message"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12611,7 +12611,7 @@ func (t *System_MountPoint) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_MountPoint) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_MountPoint"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_MountPoint"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -12960,7 +12960,7 @@ func (t *System_Ntp) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Ntp) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Ntp"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Ntp"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13053,7 +13053,7 @@ func (t *System_Ntp_NtpKey) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Ntp_NtpKey) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Ntp_NtpKey"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Ntp_NtpKey"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13301,7 +13301,7 @@ func (t *System_Ntp_Server) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Ntp_Server) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Ntp_Server"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Ntp_Server"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13496,7 +13496,7 @@ func (t *System_Process) ΛListKeyMap() (map[string]interface{}, error) {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_Process) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_Process"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_Process"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13619,7 +13619,7 @@ func (t *System_SshServer) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_SshServer) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_SshServer"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_SshServer"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -13722,7 +13722,7 @@ func (t *System_TelnetServer) PopulateDefaults() {
 
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *System_TelnetServer) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["System_TelnetServer"], t, opts...); err != nil {
+	if err := ytypes.Validate(schemaTree()["System_TelnetServer"], t, opts...); err != nil {
 		return err
 	}
 	return nil
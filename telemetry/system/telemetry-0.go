@@ -95,7 +95,7 @@ func watch_SystemPath(t testing.TB, n ygot.PathStruct, duration time.Duration, p
 	gs := &oc.System{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_System_AaaPath(t testing.TB, n ygot.PathStruct, duration time.Duratio
 	gs := &oc.System_Aaa{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -397,7 +397,7 @@ func watch_System_Aaa_AccountingPath(t testing.TB, n ygot.PathStruct, duration t
 	gs := &oc.System_Aaa_Accounting{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Accounting", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Accounting", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Accounting{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -701,7 +701,7 @@ func watch_System_Aaa_Accounting_EventPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.System_Aaa_Accounting_Event{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Accounting_Event", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Accounting_Event", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Accounting_Event{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1158,7 +1158,7 @@ func watch_System_Aaa_AuthenticationPath(t testing.TB, n ygot.PathStruct, durati
 	gs := &oc.System_Aaa_Authentication{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Authentication", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Authentication", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Authentication{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1309,7 +1309,7 @@ func watch_System_Aaa_Authentication_AdminUserPath(t testing.TB, n ygot.PathStru
 	gs := &oc.System_Aaa_Authentication_AdminUser{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Authentication_AdminUser", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Authentication_AdminUser", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Authentication_AdminUser{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2072,7 +2072,7 @@ func watch_System_Aaa_Authentication_UserPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.System_Aaa_Authentication_User{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Authentication_User", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Authentication_User", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Authentication_User{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2988,7 +2988,7 @@ func watch_System_Aaa_AuthorizationPath(t testing.TB, n ygot.PathStruct, duratio
 	gs := &oc.System_Aaa_Authorization{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Authorization", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Authorization", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Authorization{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3292,7 +3292,7 @@ func watch_System_Aaa_Authorization_EventPath(t testing.TB, n ygot.PathStruct, d
 	gs := &oc.System_Aaa_Authorization_Event{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_Authorization_Event", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_Authorization_Event", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_Authorization_Event{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3596,7 +3596,7 @@ func watch_System_Aaa_ServerGroupPath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.System_Aaa_ServerGroup{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_ServerGroup{
 			Metadata: md,
 		}).SetVal(gs), nil
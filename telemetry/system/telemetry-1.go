@@ -248,7 +248,7 @@ func watch_System_Aaa_ServerGroup_ServerPath(t testing.TB, n ygot.PathStruct, du
 	gs := &oc.System_Aaa_ServerGroup_Server{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_ServerGroup_Server{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1929,7 +1929,7 @@ func watch_System_Aaa_ServerGroup_Server_RadiusPath(t testing.TB, n ygot.PathStr
 	gs := &oc.System_Aaa_ServerGroup_Server_Radius{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Radius", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Radius", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_ServerGroup_Server_Radius{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2390,7 +2390,7 @@ func watch_System_Aaa_ServerGroup_Server_Radius_CountersPath(t testing.TB, n ygo
 	gs := &oc.System_Aaa_ServerGroup_Server_Radius_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Radius_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Radius_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_ServerGroup_Server_Radius_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_System_Aaa_ServerGroup_Server_TacacsPath(t testing.TB, n ygot.PathStr
 	gs := &oc.System_Aaa_ServerGroup_Server_Tacacs{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Tacacs", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Aaa_ServerGroup_Server_Tacacs", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Aaa_ServerGroup_Server_Tacacs{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1166,7 +1166,7 @@ func watch_System_AlarmPath(t testing.TB, n ygot.PathStruct, duration time.Durat
 	gs := &oc.System_Alarm{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Alarm", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Alarm", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Alarm{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2388,7 +2388,7 @@ func watch_System_ClockPath(t testing.TB, n ygot.PathStruct, duration time.Durat
 	gs := &oc.System_Clock{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Clock", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Clock", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Clock{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2692,7 +2692,7 @@ func watch_System_CpuPath(t testing.TB, n ygot.PathStruct, duration time.Duratio
 	gs := &oc.System_Cpu{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2843,7 +2843,7 @@ func watch_System_Cpu_HardwareInterruptPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.System_Cpu_HardwareInterrupt{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_HardwareInterrupt", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_HardwareInterrupt", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_HardwareInterrupt{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -401,7 +401,7 @@ func watch_System_Cpu_IdlePath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.System_Cpu_Idle{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_Idle", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_Idle", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_Idle{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1776,7 +1776,7 @@ func watch_System_Cpu_KernelPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.System_Cpu_Kernel{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_Kernel", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_Kernel", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_Kernel{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2998,7 +2998,7 @@ func watch_System_Cpu_NicePath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.System_Cpu_Nice{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_Nice", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_Nice", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_Nice{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -554,7 +554,7 @@ func watch_System_Cpu_SoftwareInterruptPath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.System_Cpu_SoftwareInterrupt{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_SoftwareInterrupt", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_SoftwareInterrupt", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_SoftwareInterrupt{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1776,7 +1776,7 @@ func watch_System_Cpu_TotalPath(t testing.TB, n ygot.PathStruct, duration time.D
 	gs := &oc.System_Cpu_Total{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_Total", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_Total", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_Total{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2998,7 +2998,7 @@ func watch_System_Cpu_UserPath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.System_Cpu_User{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_User", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_User", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_User{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -554,7 +554,7 @@ func watch_System_Cpu_WaitPath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.System_Cpu_Wait{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Cpu_Wait", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Cpu_Wait", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Cpu_Wait{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1929,7 +1929,7 @@ func watch_System_DnsPath(t testing.TB, n ygot.PathStruct, duration time.Duratio
 	gs := &oc.System_Dns{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Dns", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Dns", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Dns{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2080,7 +2080,7 @@ func watch_System_Dns_HostEntryPath(t testing.TB, n ygot.PathStruct, duration ti
 	gs := &oc.System_Dns_HostEntry{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Dns_HostEntry", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Dns_HostEntry", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Dns_HostEntry{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2996,7 +2996,7 @@ func watch_System_Dns_ServerPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.System_Dns_Server{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Dns_Server", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Dns_Server", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Dns_Server{
 			Metadata: md,
 		}).SetVal(gs), nil
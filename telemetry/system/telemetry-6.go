@@ -95,7 +95,7 @@ func watch_System_LicensePath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.System_License{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_License", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_License", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_License{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_System_License_LicensePath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.System_License_License{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_License_License", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_License_License", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_License_License{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1776,7 +1776,7 @@ func watch_System_LoggingPath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.System_Logging{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Logging", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Logging", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Logging{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1927,7 +1927,7 @@ func watch_System_Logging_ConsolePath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.System_Logging_Console{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Logging_Console", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Logging_Console", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Logging_Console{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2078,7 +2078,7 @@ func watch_System_Logging_Console_SelectorPath(t testing.TB, n ygot.PathStruct,
 	gs := &oc.System_Logging_Console_Selector{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Logging_Console_Selector", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Logging_Console_Selector", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Logging_Console_Selector{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2535,7 +2535,7 @@ func watch_System_Logging_RemoteServerPath(t testing.TB, n ygot.PathStruct, dura
 	gs := &oc.System_Logging_RemoteServer{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Logging_RemoteServer", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Logging_RemoteServer", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Logging_RemoteServer{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2994,7 +2994,7 @@ func watch_System_Logging_RemoteServer_SelectorPath(t testing.TB, n ygot.PathStr
 	gs := &oc.System_Logging_RemoteServer_Selector{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Logging_RemoteServer_Selector", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Logging_RemoteServer_Selector", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Logging_RemoteServer_Selector{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_System_MemoryPath(t testing.TB, n ygot.PathStruct, duration time.Dura
 	gs := &oc.System_Memory{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Memory", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Memory", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Memory{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -246,7 +246,7 @@ func watch_System_Memory_CountersPath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.System_Memory_Counters{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Memory_Counters", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Memory_Counters", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Memory_Counters{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1468,7 +1468,7 @@ func watch_System_MessagesPath(t testing.TB, n ygot.PathStruct, duration time.Du
 	gs := &oc.System_Messages{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Messages", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Messages", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Messages{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1619,7 +1619,7 @@ func watch_System_Messages_DebugServicePath(t testing.TB, n ygot.PathStruct, dur
 	gs := &oc.System_Messages_DebugService{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Messages_DebugService", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Messages_DebugService", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Messages_DebugService{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2078,7 +2078,7 @@ func watch_System_Messages_MessagePath(t testing.TB, n ygot.PathStruct, duration
 	gs := &oc.System_Messages_Message{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Messages_Message", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Messages_Message", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Messages_Message{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -3300,7 +3300,7 @@ func watch_System_MountPointPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.System_MountPoint{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_MountPoint", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_MountPoint", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_MountPoint{
 			Metadata: md,
 		}).SetVal(gs), nil
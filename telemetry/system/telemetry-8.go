@@ -554,7 +554,7 @@ func watch_System_NtpPath(t testing.TB, n ygot.PathStruct, duration time.Duratio
 	gs := &oc.System_Ntp{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Ntp", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Ntp", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Ntp{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1168,7 +1168,7 @@ func watch_System_Ntp_NtpKeyPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.System_Ntp_NtpKey{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Ntp_NtpKey", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Ntp_NtpKey", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Ntp_NtpKey{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1931,7 +1931,7 @@ func watch_System_Ntp_ServerPath(t testing.TB, n ygot.PathStruct, duration time.
 	gs := &oc.System_Ntp_Server{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Ntp_Server", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Ntp_Server", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Ntp_Server{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -95,7 +95,7 @@ func watch_System_ProcessPath(t testing.TB, n ygot.PathStruct, duration time.Dur
 	gs := &oc.System_Process{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_Process", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_Process", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_Process{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -1623,7 +1623,7 @@ func watch_System_SshServerPath(t testing.TB, n ygot.PathStruct, duration time.D
 	gs := &oc.System_SshServer{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_SshServer", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_SshServer", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_SshServer{
 			Metadata: md,
 		}).SetVal(gs), nil
@@ -2543,7 +2543,7 @@ func watch_System_TelnetServerPath(t testing.TB, n ygot.PathStruct, duration tim
 	gs := &oc.System_TelnetServer{}
 	w.W = genutil.MustWatch(t, n, nil, duration, false, func(upd []*genutil.DataPoint, queryPath *gpb.Path) (genutil.QualifiedValue, error) {
 		t.Helper()
-		md, _ := genutil.MustUnmarshal(t, upd, oc.GetSchema(), "System_TelnetServer", gs, queryPath, false, false)
+		md, _ := genutil.MustUnmarshalUpdates(t, upd, oc.GetSchema(), "System_TelnetServer", gs, queryPath, false, false)
 		return (&oc.QualifiedSystem_TelnetServer{
 			Metadata: md,
 		}).SetVal(gs), nil
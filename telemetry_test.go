@@ -2235,6 +2235,199 @@ func TestWatch(t *testing.T) {
 	}
 }
 
+func TestWatchSubscriptionOptions(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	startTime := time.Now()
+	fakeGNMI.Stub().Sync().Notification(&gpb.Notification{
+		Timestamp: startTime.Add(time.Minute).UnixNano(),
+	})
+
+	dut.Telemetry().WithHeartbeatInterval(10*time.Second).WithUpdatesOnly(true).Interface("Ethernet3/1/1").Counters().OutOctets().Watch(t, time.Second, func(*telemetry.QualifiedUint64) bool {
+		return false
+	}).Await(t)
+
+	requests := fakeGNMI.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Number of subscription requests sent is not 1: %v", requests)
+	}
+	req := requests[0].GetSubscribe()
+	if !req.GetUpdatesOnly() {
+		t.Errorf("Got updates_only false, want true")
+	}
+	for _, sub := range req.GetSubscription() {
+		if got, want := sub.GetHeartbeatInterval(), uint64(10*time.Second); got != want {
+			t.Errorf("Got heartbeat_interval %d, want %d", got, want)
+		}
+	}
+}
+
+func TestLookupStrictCompliance(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	intfPath := gnmiPath(t, "interfaces/interface[name=Ethernet3/1/1]")
+	stub := func() {
+		fakeGNMI.Stub().Notification(&gpb.Notification{
+			Timestamp: 100,
+			Prefix:    intfPath,
+			Update: []*gpb.Update{{
+				Path: gnmiPath(t, "interfaces/interface[name=Ethernet3/1/1]/state/mtu"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1500}},
+			}},
+		}).Sync()
+	}
+
+	stub()
+	if got, want := dut.Telemetry().Interface("Ethernet3/1/1").Mtu().Get(t), uint16(1500); got != want {
+		t.Errorf("Mtu().Get(t) got %d, want %d", got, want)
+	}
+
+	stub()
+	got := negtest.ExpectFatal(t, func(t testing.TB) {
+		dut.Telemetry().WithStrictCompliance(true).Interface("Ethernet3/1/1").Mtu().Get(t)
+	})
+	if want := "repeats the notification prefix"; !strings.Contains(got, want) {
+		t.Errorf("Mtu().Get(t) got fatal %q, want it to contain %q", got, want)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	fakeGNMI.Stub().Notification(&gpb.Notification{
+		Timestamp: 100,
+		Update: []*gpb.Update{{
+			Path: gnmiPath(t, "interfaces/interface[name=Ethernet3/1/1]/state/oper-status"),
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "UP"}},
+		}},
+	}).Sync()
+
+	status := dut.Telemetry().Interface("Ethernet3/1/1").OperStatus()
+	got := telemetry.Snapshot[telemetry.E_Interface_OperStatus](t, status, status)
+	if len(got.Values) != 2 {
+		t.Fatalf("Snapshot(t) got %d values, want 2", len(got.Values))
+	}
+	for i, v := range got.Values {
+		if got, want := v.Val(t), telemetry.Interface_OperStatus_UP; got != want {
+			t.Errorf("Snapshot(t) value %d got %v, want %v", i, got, want)
+		}
+	}
+	if got.Skew < 0 {
+		t.Errorf("Snapshot(t) got negative skew %v", got.Skew)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	fakeGNMI.Stub().Notification(&gpb.Notification{
+		Timestamp: 100,
+		Update: []*gpb.Update{{
+			Path: gnmiPath(t, "interfaces/interface[name=Ethernet3/1/1]/state/oper-status"),
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "UP"}},
+		}},
+	}).Sync()
+
+	r := telemetry.Record[telemetry.E_Interface_OperStatus](t, time.Millisecond, dut.Telemetry().Interface("Ethernet3/1/1").OperStatus())
+	for len(r.Series()) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	got := r.Stop(t)
+	for i, v := range got {
+		if got, want := v.Val(t), telemetry.Interface_OperStatus_UP; got != want {
+			t.Errorf("Record(t) value %d got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestAttachRoot(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	statusPath := gnmiPath(t, "interfaces/interface[name=Ethernet3/1/1]/state/oper-status")
+	fakeGNMI.Stub().Notification(&gpb.Notification{
+		Timestamp: 100,
+		Update: []*gpb.Update{{
+			Path: statusPath,
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "UP"}},
+		}},
+	}).Sync()
+
+	root := device.DeviceRoot(dut.ID())
+	dut.AttachRoot(t, root)
+	if got, want := root.Interface("Ethernet3/1/1").OperStatus().Get(t), telemetry.Interface_OperStatus_UP; got != want {
+		t.Errorf("OperStatus().Get(t) got %v, want %v", got, want)
+	}
+	verifySubscriptionPathsSent(t, statusPath)
+
+	got := negtest.CaptureFatal(t, func(t testing.TB) {
+		dut.AttachRoot(t, device.DeviceRoot("other"))
+	})
+	if got == nil || !strings.Contains(*got, `root has ID "other"`) {
+		t.Errorf("AttachRoot(t) with wrong ID got fatal %v, want ID mismatch", got)
+	}
+}
+
+func TestGNMISubscription(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	nativePath := &gpb.Path{
+		Origin: "native",
+		Elem:   []*gpb.PathElem{{Name: "system"}, {Name: "uptime"}},
+	}
+	want := []*gpb.Notification{{
+		Timestamp: 100,
+		Update: []*gpb.Update{{
+			Path: nativePath,
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 42}},
+		}},
+	}, {
+		Timestamp: 200,
+		Update: []*gpb.Update{{
+			Path: nativePath,
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 43}},
+		}},
+	}}
+	fakeGNMI.Stub().Notification(want[0]).Notification(want[1]).Sync()
+
+	stream := dut.RawAPIs().GNMI().NewSubscription("/system/uptime").
+		WithOrigin("native").
+		WithMode(gpb.SubscriptionList_ONCE).
+		Start(t)
+	defer stream.Close()
+	got := stream.Collect(t, 5*time.Second)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Collect(t) got unexpected notifications (-want,+got):\n %s", diff)
+	}
+	if n, ok := stream.Next(t, time.Second); ok {
+		t.Errorf("Next(t) after end of subscription got %v, want none", n)
+	}
+
+	requests := fakeGNMI.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Number of subscription requests sent is not 1: %v", requests)
+	}
+	wantReq := &gpb.SubscriptionList{
+		Prefix:       &gpb.Path{Target: dut.Name()},
+		Subscription: []*gpb.Subscription{{Path: &gpb.Path{Origin: "native", Elem: nativePath.GetElem()}}},
+		Mode:         gpb.SubscriptionList_ONCE,
+		Encoding:     gpb.Encoding_PROTO,
+	}
+	if diff := cmp.Diff(wantReq, requests[0].GetSubscribe(), protocmp.Transform()); diff != "" {
+		t.Errorf("Got unexpected subscription request (-want,+got):\n %s", diff)
+	}
+}
+
+func TestGNMISubscriptionBadPath(t *testing.T) {
+	initTelemetryFakes(t)
+	dut := DUT(t, "dut")
+	got := negtest.CaptureFatal(t, func(t testing.TB) {
+		dut.RawAPIs().GNMI().NewSubscription("/system]").Start(t)
+	})
+	if got == nil || !strings.Contains(*got, "invalid path") {
+		t.Errorf("Start(t) got fatal %v, want invalid path", got)
+	}
+}
+
 func TestWildcardWatch(t *testing.T) {
 	initTelemetryFakes(t)
 	dut := DUT(t, "dut")
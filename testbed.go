@@ -18,19 +18,53 @@ import (
 	"golang.org/x/net/context"
 	"testing"
 
+	log "github.com/golang/glog"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/internal/ate"
+	"github.com/openconfig/ondatra/internal/capability"
+	"github.com/openconfig/ondatra/internal/closer"
 	"github.com/openconfig/ondatra/internal/flags"
 	"github.com/openconfig/ondatra/internal/testbed"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	opb "github.com/openconfig/ondatra/proto"
 )
 
 func reserve(fv *flags.Values) error {
 	return testbed.Reserve(context.Background(), fv)
 }
 
-func release() error {
-	return testbed.Release(context.Background())
+func reserveTestbed(tb *opb.Testbed, fv *flags.Values) error {
+	return testbed.ReserveTestbed(context.Background(), tb, fv)
+}
+
+// discover probes the reserved DUTs for their capabilities. Probe errors are
+// only logged, because the capabilities are probed again when queried.
+func discover() {
+	res, err := testbed.Reservation()
+	if err != nil {
+		log.Errorf("Error discovering DUT capabilities: %v", err)
+		return
+	}
+	var duts []*binding.DUT
+	for _, dut := range res.DUTs {
+		duts = append(duts, dut)
+	}
+	if err := capability.Discover(context.Background(), duts...); err != nil {
+		log.Warningf("Error discovering DUT capabilities: %v", err)
+	}
+}
+
+func release() (rerr error) {
+	// The ports of a fetched reservation are owned by whoever reserved it.
+	if !testbed.Owned() {
+		return nil
+	}
+	ctx := context.Background()
+	defer closer.Close(&rerr, func() error {
+		return testbed.Release(ctx)
+	}, "error releasing testbed")
+	return ate.ReleaseAllPorts(ctx)
 }
 
 func checkRes(t testing.TB) *binding.Reservation {
@@ -63,6 +97,17 @@ func DUTs(t testing.TB) map[string]*DUTDevice {
 	return m
 }
 
+// LookupDUT returns the DUT in the testbed with a given id, and whether it was
+// reserved, such as when the testbed declares the DUT as optional.
+func LookupDUT(t testing.TB, id string) (*DUTDevice, bool) {
+	t.Helper()
+	rd, err := testbed.DUT(checkRes(t), id)
+	if err != nil {
+		return nil, false
+	}
+	return newDUT(id, rd), true
+}
+
 func newDUT(id string, res *binding.DUT) *DUTDevice {
 	return &DUTDevice{&Device{
 		id:       id,
@@ -92,6 +137,17 @@ func ATEs(t testing.TB) map[string]*ATEDevice {
 	return m
 }
 
+// LookupATE returns the ATE in the testbed with a given id, and whether it was
+// reserved, such as when the testbed declares the ATE as optional.
+func LookupATE(t testing.TB, id string) (*ATEDevice, bool) {
+	t.Helper()
+	ra, err := testbed.ATE(checkRes(t), id)
+	if err != nil {
+		return nil, false
+	}
+	return newATE(id, ra), true
+}
+
 func newATE(id string, res *binding.ATE) *ATEDevice {
 	return &ATEDevice{Device: &Device{
 		id:       id,
@@ -21,8 +21,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/flags"
+	"github.com/openconfig/ondatra/internal/testbed"
 	"github.com/openconfig/ondatra/negtest"
 
 	opb "github.com/openconfig/ondatra/proto"
@@ -97,6 +100,17 @@ func TestReserveErrors(t *testing.T) {
 		name:    "Two links to same port on ATE",
 		tbProto: `duts{id:"dut" ports{id:"port1"} ports:{id:"port2"}} ates{vendor:IXIA, id:"ate1" ports:{id:"port1"}} links{a:"dut:port1", b:"ate1:port1"} links{a:"dut:port2", b:"ate1:port1"}`,
 		wantErr: `from "ate1:port1" to "dut:port1" and "dut:port2"`,
+	}, {
+		name:    "Invalid breakout",
+		tbProto: `duts{id:"dut" ports{id:"port1" breakout{num_channels:1 channel_speed_gbps:25}}}`,
+		wantErr: "invalid breakout",
+	}, {
+		name:    "Reserved port without breakout",
+		tbProto: `duts{id:"dut" ports{id:"port1" breakout{num_channels:4 channel_speed_gbps:25}}}`,
+		res: &binding.Reservation{DUTs: map[string]*binding.DUT{"dut": &binding.DUT{
+			&binding.Dims{Name: "d1", Ports: map[string]*binding.Port{"port1": {Name: "p1"}}},
+		}}},
+		wantErr: "has breakout",
 	}, {
 		name:    "No device name",
 		tbProto: `duts{id:"dut"}`,
@@ -104,6 +118,21 @@ func TestReserveErrors(t *testing.T) {
 			&binding.Dims{Vendor: opb.Device_ARISTA, HardwareModel: "m", SoftwareVersion: "v"},
 		}}},
 		wantErr: "no name",
+	}, {
+		name:    "Negative port bounds",
+		tbProto: `duts{id:"dut" min_ports:-1 ports{id:"port1"}}`,
+		wantErr: "negative port bounds",
+	}, {
+		name:    "Unsatisfiable port bounds",
+		tbProto: `duts{id:"dut" max_ports:1 ports{id:"port1"} ports{id:"port2"}}`,
+		wantErr: "requires at least 2 ports",
+	}, {
+		name:    "Too few reserved ports",
+		tbProto: `duts{id:"dut" min_ports:2 ports{id:"port1"} ports{id:"port2" is_optional:true}}`,
+		res: &binding.Reservation{DUTs: map[string]*binding.DUT{"dut": &binding.DUT{
+			&binding.Dims{Name: "d1", Ports: map[string]*binding.Port{"port1": {Name: "p1"}}},
+		}}},
+		wantErr: "1 ports reserved",
 	}}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -242,6 +271,213 @@ func TestReserve(t *testing.T) {
 	})
 }
 
+func TestDeviceInfo(t *testing.T) {
+	initFakeBinding(t)
+	reserveFakeTestbed(t)
+	want := &DeviceInfo{
+		ID:            "dut",
+		Name:          "pf01.xxx01",
+		Vendor:        ARISTA,
+		Model:         "aristaModel",
+		Version:       "aristaVersion",
+		ReservationID: "resv1",
+		Lab:           "lab1",
+		PortSpeeds:    map[string]Speed{"port1": Speed10Gb, "port2": Speed100Gb},
+		Tags:          map[string]string{"rack": "r1"},
+	}
+	if diff := cmp.Diff(want, DUT(t, "dut").Info()); diff != "" {
+		t.Errorf("Info() got unexpected diff (-want,+got): %s", diff)
+	}
+	if got, want := ATE(t, "ate").Info().Model, "ixiaModel"; got != want {
+		t.Errorf("Info().Model got %q, want %q", got, want)
+	}
+}
+
+func TestReserveOptional(t *testing.T) {
+	initFakeBinding(t)
+	fakeBind.Reservation = &binding.Reservation{
+		DUTs: map[string]*binding.DUT{"dut": &binding.DUT{&binding.Dims{
+			Name:  "d1",
+			Ports: map[string]*binding.Port{"port1": {Name: "p1"}},
+		}}},
+	}
+	tbProto := `
+		duts{id:"dut" ports{id:"port1"} ports{id:"port2" is_optional:true}}
+		duts{id:"dut2" is_optional:true ports{id:"port1"}}
+		ates{id:"ate" is_optional:true ports{id:"port1"}}
+		links{a:"dut:port1" b:"dut2:port1"}
+		links{a:"dut:port2" b:"ate:port1"}
+	`
+	if err := reserve(&flags.Values{TestbedPath: writeTemp(t, tbProto)}); err != nil {
+		t.Fatalf("Reserve() failed: %v", err)
+	}
+	defer release()
+
+	d, ok := LookupDUT(t, "dut")
+	if !ok {
+		t.Fatalf("LookupDUT(t, %q) got not reserved, want reserved", "dut")
+	}
+	if _, ok := d.LookupPort("port1"); !ok {
+		t.Errorf("LookupPort(%q) got not reserved, want reserved", "port1")
+	}
+	if _, ok := d.LookupPort("port2"); ok {
+		t.Errorf("LookupPort(%q) got reserved, want not reserved", "port2")
+	}
+	if _, ok := LookupDUT(t, "dut2"); ok {
+		t.Errorf("LookupDUT(t, %q) got reserved, want not reserved", "dut2")
+	}
+	if _, ok := LookupATE(t, "ate"); ok {
+		t.Errorf("LookupATE(t, %q) got reserved, want not reserved", "ate")
+	}
+}
+
+func TestReserveTestbed(t *testing.T) {
+	initFakeBinding(t)
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut", Ports: []*opb.Port{{Id: "port1"}}}}}
+	if err := reserveTestbed(tb, &flags.Values{}); err != nil {
+		t.Fatalf("reserveTestbed() failed: %v", err)
+	}
+	defer release()
+	if got, want := DUT(t, "dut").Port(t, "port1").Name(), "Et1/2/3"; got != want {
+		t.Errorf("Port name = %q, want %q", got, want)
+	}
+}
+
+func TestDUTATELinks(t *testing.T) {
+	initFakeBinding(t)
+	tb := &opb.Testbed{
+		Duts: []*opb.Device{{Id: "dut", Ports: []*opb.Port{{Id: "port1"}, {Id: "port2"}}}},
+		Ates: []*opb.Device{{Id: "ate", Ports: []*opb.Port{{Id: "port1"}, {Id: "port2"}}}},
+		Links: []*opb.Link{
+			{A: "dut:port2", B: "ate:port1"},
+			{A: "ate:port2", B: "dut:port1"},
+		},
+	}
+	if err := reserveTestbed(tb, &flags.Values{}); err != nil {
+		t.Fatalf("reserveTestbed() failed: %v", err)
+	}
+	defer release()
+
+	type linkAddrs struct {
+		DUTPort, ATEPort                   string
+		DUTIPv4, ATEIPv4, DUTIPv6, ATEIPv6 string
+	}
+	want := []linkAddrs{{
+		DUTPort: "port1",
+		ATEPort: "port2",
+		DUTIPv4: "198.18.0.0",
+		ATEIPv4: "198.18.0.1",
+		DUTIPv6: "2001:db8::",
+		ATEIPv6: "2001:db8::1",
+	}, {
+		DUTPort: "port2",
+		ATEPort: "port1",
+		DUTIPv4: "198.18.0.2",
+		ATEIPv4: "198.18.0.3",
+		DUTIPv6: "2001:db8::2",
+		ATEIPv6: "2001:db8::3",
+	}}
+	links := DUTATELinks(t, DUT(t, "dut"), ATE(t, "ate"))
+	var got []linkAddrs
+	for _, l := range links {
+		got = append(got, linkAddrs{
+			DUTPort: l.DUTPort.ID(),
+			ATEPort: l.ATEPort.ID(),
+			DUTIPv4: l.DUTIPv4,
+			ATEIPv4: l.ATEIPv4,
+			DUTIPv6: l.DUTIPv6,
+			ATEIPv6: l.ATEIPv6,
+		})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DUTATELinks() got unexpected diff (-want,+got): %s", diff)
+	}
+
+	intf := links[0].AddATEInterface(ATE(t, "ate").Topology().New(), "intf1")
+	if got, want := intf.IPv4().pb.GetAddressCidr(), "198.18.0.1/31"; got != want {
+		t.Errorf("AddATEInterface() got IPv4 address %q, want %q", got, want)
+	}
+	if got, want := intf.IPv6().pb.GetDefaultGateway(), "2001:db8::"; got != want {
+		t.Errorf("AddATEInterface() got IPv6 gateway %q, want %q", got, want)
+	}
+	dutIntf := links[0].DUTInterface()
+	if got, want := dutIntf.GetName(), "Et1/2/3"; got != want {
+		t.Errorf("DUTInterface() got name %q, want %q", got, want)
+	}
+	if got, want := dutIntf.GetSubinterface(0).GetIpv4().GetAddress("198.18.0.0").GetPrefixLength(), uint8(31); got != want {
+		t.Errorf("DUTInterface() got IPv4 prefix length %d, want %d", got, want)
+	}
+
+	msg := negtest.ExpectFatal(t, func(t testing.TB) {
+		DUTATELinks(t, DUT(t, "dut_cisco"), ATE(t, "ate"))
+	})
+	if !strings.Contains(msg, "no reserved links") {
+		t.Errorf("DUTATELinks() for unlinked DUT failed with message %q, want no reserved links", msg)
+	}
+}
+
+func TestReserveSetupTeardown(t *testing.T) {
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+	tests := []struct {
+		desc          string
+		setupErr      error
+		resvFail      bool
+		wantTeardowns int
+		wantErr       string
+	}{{
+		desc:          "success",
+		wantTeardowns: 1,
+	}, {
+		desc:     "setup error",
+		setupErr: errors.New("setup failed"),
+		wantErr:  "setup failed",
+	}, {
+		desc:          "reserve error",
+		resvFail:      true,
+		wantTeardowns: 1,
+		wantErr:       "not found",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			initFakeBinding(t)
+			var gotSetupTB *opb.Testbed
+			var gotTeardowns int
+			fakeBind.TestbedSetup = func(_ context.Context, tb *opb.Testbed) error {
+				gotSetupTB = tb
+				return tt.setupErr
+			}
+			fakeBind.TestbedTeardown = func(context.Context) error {
+				gotTeardowns++
+				return nil
+			}
+			if tt.resvFail {
+				fakeBind.Reservation = &binding.Reservation{DUTs: map[string]*binding.DUT{"gaga": &binding.DUT{&binding.Dims{}}}}
+			}
+			err := reserveTestbed(tb, &flags.Values{})
+			if err == nil {
+				err = release()
+			}
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("reserveTestbed() and release() got err %v, want %q", err, tt.wantErr)
+			}
+			if gotSetupTB != tb {
+				t.Errorf("SetupTestbed() got testbed %v, want %v", gotSetupTB, tb)
+			}
+			if gotTeardowns != tt.wantTeardowns {
+				t.Errorf("TeardownTestbed() called %d times, want %d", gotTeardowns, tt.wantTeardowns)
+			}
+		})
+	}
+}
+
+func TestReserveNoTestbedPath(t *testing.T) {
+	initFakeBinding(t)
+	if err := reserve(&flags.Values{}); err == nil || !strings.Contains(err.Error(), "not specified") {
+		release()
+		t.Errorf("reserve() with no testbed path got err %v, want not specified", err)
+	}
+}
+
 func TestFetch(t *testing.T) {
 	initFakeBinding(t)
 	fakeBind.ResvFetcher = func(context.Context, string) (*binding.Reservation, error) {
@@ -254,6 +490,9 @@ func TestFetch(t *testing.T) {
 	if duts := DUTs(t); len(duts) == 0 {
 		t.Errorf("No DUTS reserved")
 	}
+	if testbed.Owned() {
+		t.Errorf("Fetched reservation is owned, so its ports would be released")
+	}
 }
 
 func writeTemp(t *testing.T, c string) string {
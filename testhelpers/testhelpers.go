@@ -0,0 +1,33 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testhelpers provides helpers for conditionally running Ondatra tests.
+package testhelpers
+
+import (
+	"testing"
+
+	"github.com/openconfig/ondatra"
+)
+
+// SkipIfUnsupported skips the test if the DUT does not support all of the
+// specified capabilities.
+func SkipIfUnsupported(t testing.TB, dut *ondatra.DUTDevice, caps ...ondatra.Capability) {
+	t.Helper()
+	for _, c := range caps {
+		if !dut.Supports(t, c) {
+			t.Skipf("%v does not support %v", dut, c)
+		}
+	}
+}
@@ -34,6 +34,8 @@ var (
 	fakeTBPath = filepath.Join("testdata", "testbed.pb.txt")
 
 	fakeRes = &binding.Reservation{
+		ID:  "resv1",
+		Lab: "lab1",
 		DUTs: map[string]*binding.DUT{
 			"dut": &binding.DUT{&binding.Dims{
 				Name:            "pf01.xxx01",
@@ -44,6 +46,7 @@ var (
 					"port1": &binding.Port{Name: "Et1/2/3", Speed: opb.Port_S_10GB},
 					"port2": &binding.Port{Name: "Et4/5/6", Speed: opb.Port_S_100GB},
 				},
+				Tags: map[string]string{"rack": "r1"},
 			}},
 			"dut_cisco": &binding.DUT{&binding.Dims{
 				Name:            "pf02.xxx01",
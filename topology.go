@@ -18,6 +18,7 @@ import (
 	"golang.org/x/net/context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/internal/ate"
@@ -41,6 +42,222 @@ func (tp *Topology) New() *ATETopology {
 	return &ATETopology{ate: tp.ate, top: &opb.Topology{}}
 }
 
+// ProtocolSessions are the states of the protocol sessions emulated by the ATE.
+type ProtocolSessions struct {
+	BGP  []*BGPSession
+	ISIS []*ISISAdjacency
+}
+
+// BGPSession is the state of a BGP session emulated by the ATE.
+type BGPSession struct {
+	Interface   string
+	PeerAddress string
+	// Up is whether the session is established.
+	Up bool
+	// State is the vendor-specific session state, such as "up" or "down".
+	State string
+	// LearnedRoutes is the number of routes learned from the peer, which is
+	// only populated for sessions that are up.
+	LearnedRoutes int
+}
+
+// ISISAdjacency is the state of an IS-IS adjacency emulated by the ATE.
+type ISISAdjacency struct {
+	Interface string
+	// Up is whether the adjacency is up.
+	Up bool
+	// State is the vendor-specific adjacency state, such as "up" or "down".
+	State string
+}
+
+// ProtocolSessions returns the states of the BGP sessions and IS-IS
+// adjacencies emulated by the ATE, and the number of routes learned over each
+// BGP session.
+func (tp *Topology) ProtocolSessions(t testing.TB) *ProtocolSessions {
+	t.Helper()
+	sessions, err := ate.FetchProtocolSessions(context.Background(), tp.ate)
+	if err != nil {
+		t.Fatalf("ProtocolSessions(t) on %s: %v", tp, err)
+	}
+	ps := &ProtocolSessions{}
+	for _, s := range sessions.BGP {
+		ps.BGP = append(ps.BGP, &BGPSession{
+			Interface:     s.Interface,
+			PeerAddress:   s.PeerAddress,
+			Up:            s.Up(),
+			State:         s.State,
+			LearnedRoutes: s.LearnedRoutes,
+		})
+	}
+	for _, a := range sessions.ISIS {
+		ps.ISIS = append(ps.ISIS, &ISISAdjacency{
+			Interface: a.Interface,
+			Up:        a.Up(),
+			State:     a.State,
+		})
+	}
+	return ps
+}
+
+// LearnedRoute is a route learned by a routing protocol emulated by the ATE.
+type LearnedRoute struct {
+	Interface string
+	// Protocol is either "BGP" or "ISIS".
+	Protocol string
+	// Peer is the address of the BGP peer the route was learned from, or empty
+	// for IS-IS routes.
+	Peer    string
+	Prefix  string
+	NextHop string
+	// Attrs are the other vendor-specific attributes of the route, such as
+	// "AS Path" or "Metric", keyed by attribute name.
+	Attrs map[string]string
+}
+
+// LearnedRoutes returns the routes learned by the BGP peers and IS-IS
+// interfaces emulated by the ATE, which are the routes that the DUT actually
+// advertised to them.
+func (tp *Topology) LearnedRoutes(t testing.TB) []*LearnedRoute {
+	t.Helper()
+	logAction(t, "Fetching learned routes from %s", tp.ate)
+	routes, err := ate.FetchLearnedRoutes(context.Background(), tp.ate)
+	if err != nil {
+		t.Fatalf("LearnedRoutes(t) on %s: %v", tp, err)
+	}
+	var lrs []*LearnedRoute
+	for _, r := range routes {
+		lrs = append(lrs, &LearnedRoute{
+			Interface: r.Interface,
+			Protocol:  r.Protocol,
+			Peer:      r.Peer,
+			Prefix:    r.Prefix,
+			NextHop:   r.NextHop,
+			Attrs:     r.Attrs,
+		})
+	}
+	return lrs
+}
+
+// AwaitProtocolSessionsUp waits up to the specified timeout for all BGP
+// sessions and IS-IS adjacencies emulated by the ATE to come up.
+func (tp *Topology) AwaitProtocolSessionsUp(t testing.TB, timeout time.Duration) {
+	t.Helper()
+	logAction(t, "Awaiting protocol sessions up on %s", tp.ate)
+	if err := ate.AwaitProtocolSessionsUp(context.Background(), tp.ate, timeout); err != nil {
+		t.Fatalf("AwaitProtocolSessionsUp(t, %v) on %s: %v", timeout, tp, err)
+	}
+}
+
+// NeighborResolution is the state of the resolution of the default gateways
+// of an interface emulated by the ATE.
+type NeighborResolution struct {
+	Interface string
+	// IPv4GatewayMAC and IPv6GatewayMAC are the resolved MAC addresses of the
+	// default gateways, or empty if they are unresolved or not configured.
+	IPv4GatewayMAC string
+	IPv6GatewayMAC string
+	// Resolved is whether all the configured default gateways are resolved.
+	Resolved bool
+}
+
+// NeighborResolutions returns the ARP and IPv6 neighbor discovery resolution
+// states of the default gateways of the interfaces emulated by the ATE, so
+// tests can tell traffic dropped by the DUT from the ATE never resolving its
+// gateway.
+func (tp *Topology) NeighborResolutions(t testing.TB) []*NeighborResolution {
+	t.Helper()
+	nrs, err := ate.FetchNeighborResolutions(context.Background(), tp.ate)
+	if err != nil {
+		t.Fatalf("NeighborResolutions(t) on %s: %v", tp, err)
+	}
+	var res []*NeighborResolution
+	for _, nr := range nrs {
+		res = append(res, &NeighborResolution{
+			Interface:      nr.Interface,
+			IPv4GatewayMAC: nr.IPv4GatewayMAC,
+			IPv6GatewayMAC: nr.IPv6GatewayMAC,
+			Resolved:       nr.Resolved(),
+		})
+	}
+	return res
+}
+
+// ResolveNeighbors sends ARP requests and IPv6 neighbor solicitations for the
+// default gateways of all the interfaces emulated by the ATE, forcing them to
+// be resolved again.
+func (tp *Topology) ResolveNeighbors(t testing.TB) {
+	t.Helper()
+	logAction(t, "Resolving neighbors on %s", tp.ate)
+	if err := ate.ResolveNeighbors(context.Background(), tp.ate); err != nil {
+		t.Fatalf("ResolveNeighbors(t) on %s: %v", tp, err)
+	}
+}
+
+// PTPMeasurement is the offset from the master clock and the mean path delay
+// to it, as measured by a PTP clock emulated on an interface of the ATE.
+type PTPMeasurement struct {
+	Interface string
+	Offset    time.Duration
+	PathDelay time.Duration
+}
+
+// PTPMeasurements returns the measurements of the PTP clocks emulated by the
+// ATE. Clocks that have no measurement yet, such as those not synchronized to
+// a master, are omitted.
+func (tp *Topology) PTPMeasurements(t testing.TB) []*PTPMeasurement {
+	t.Helper()
+	pms, err := ate.FetchPTPMeasurements(context.Background(), tp.ate)
+	if err != nil {
+		t.Fatalf("PTPMeasurements(t) on %s: %v", tp, err)
+	}
+	var res []*PTPMeasurement
+	for _, pm := range pms {
+		res = append(res, &PTPMeasurement{
+			Interface: pm.Interface,
+			Offset:    pm.Offset,
+			PathDelay: pm.PathDelay,
+		})
+	}
+	return res
+}
+
+// DHCPServerLeases are the lease counts of the DHCP servers emulated on a port
+// of the ATE.
+type DHCPServerLeases struct {
+	// Current is the number of leases currently allocated to clients.
+	Current uint64
+	// Allocated and Renewed are the total numbers of leases allocated and
+	// renewed since the servers started.
+	Allocated, Renewed uint64
+}
+
+// DHCPv4ServerLeases returns the lease counts of the DHCPv4 servers emulated
+// by the ATE, keyed by port name.
+func (tp *Topology) DHCPv4ServerLeases(t testing.TB) map[string]*DHCPServerLeases {
+	t.Helper()
+	return tp.dhcpServerLeases(t, "DHCPv4ServerLeases", false)
+}
+
+// DHCPv6ServerLeases returns the lease counts of the DHCPv6 servers emulated
+// by the ATE, keyed by port name.
+func (tp *Topology) DHCPv6ServerLeases(t testing.TB) map[string]*DHCPServerLeases {
+	t.Helper()
+	return tp.dhcpServerLeases(t, "DHCPv6ServerLeases", true)
+}
+
+func (tp *Topology) dhcpServerLeases(t testing.TB, method string, isV6 bool) map[string]*DHCPServerLeases {
+	t.Helper()
+	leases, err := ate.FetchDHCPServerLeases(context.Background(), tp.ate, isV6)
+	if err != nil {
+		t.Fatalf("%s(t) on %s: %v", method, tp, err)
+	}
+	m := make(map[string]*DHCPServerLeases)
+	for port, l := range leases {
+		m[port] = &DHCPServerLeases{Current: l.Current, Allocated: l.Allocated, Renewed: l.Renewed}
+	}
+	return m
+}
+
 // ATETopology is an ATE topology.
 type ATETopology struct {
 	ate *binding.ATE
@@ -67,6 +284,19 @@ func (at *ATETopology) AddInterface(name string) *Interface {
 	return &Interface{pb: ipb}
 }
 
+// RemoveInterface removes the interface with the specified name from the ATE
+// topology, if it exists.
+func (at *ATETopology) RemoveInterface(name string) *ATETopology {
+	var ifs []*opb.InterfaceConfig
+	for _, ipb := range at.top.Interfaces {
+		if ipb.GetName() != name {
+			ifs = append(ifs, ipb)
+		}
+	}
+	at.top.Interfaces = ifs
+	return at
+}
+
 // ClearInterfaces clear interfaces from the ATE topology.
 func (at *ATETopology) ClearInterfaces() *ATETopology {
 	at.top.Interfaces = nil
@@ -105,6 +335,19 @@ func (at *ATETopology) Push(t testing.TB) *ATETopology {
 	return at
 }
 
+// DryRun returns the IxNetwork JSON config that Push would import for this
+// topology, without contacting the ATE. Callers can save or diff the returned
+// config, e.g. to attach it to bug reports. Ports are located on a chassis host
+// named after the ATE.
+func (at *ATETopology) DryRun(t testing.TB) []byte {
+	t.Helper()
+	cfg, err := ate.DryRunTopology(at.ate, at.top)
+	if err != nil {
+		t.Fatalf("DryRun(t) on %s: %v", at, err)
+	}
+	return cfg
+}
+
 // Update updates the topology on the ATE to this one.
 // Currently running protocols will continue running.
 func (at *ATETopology) Update(t testing.TB) {
@@ -115,6 +358,20 @@ func (at *ATETopology) Update(t testing.TB) {
 	}
 }
 
+// UpdateInterfaces updates the topology on the ATE to this one by adding and
+// removing only the interfaces that were added, removed, or changed since the
+// topology was last pushed or updated, such as with AddInterface and
+// RemoveInterface. Protocols keep running on the other interfaces, and are
+// started on the added interfaces if they are running. Unlike Update, it does
+// not apply changes to the LAGs of the topology.
+func (at *ATETopology) UpdateInterfaces(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating interfaces on %s", at.ate)
+	if err := ate.UpdateInterfaces(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateInterfaces(t) on %s: %v", at, err)
+	}
+}
+
 // UpdateBGPPeerStates is equivalent to Update() but only updates the BGP peer state.
 // This is provided as a temporary workaround for the high overhead of Update().
 // TODO: Remove this method once new Ixia config binding is used.
@@ -125,6 +382,163 @@ func (at *ATETopology) UpdateBGPPeerStates(t testing.TB) {
 	}
 }
 
+// UpdateNetworks updates the networks of the topology on the ATE on the fly,
+// without restarting protocols. It assumes the only changes since the topology
+// was last pushed are to network configuration, such as routes withdrawn or
+// re-advertised with Network.WithAdvertised.
+func (at *ATETopology) UpdateNetworks(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating networks on %s", at.ate)
+	if err := ate.UpdateNetworks(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateNetworks(t) on %s: %v", at, err)
+	}
+}
+
+// UpdateBFD updates the BFD sessions of the topology on the ATE on the fly,
+// without restarting protocols. It assumes the only changes since the topology
+// was last pushed are to BFD configuration, such as sessions brought
+// administratively down with BFD.WithAdminDown.
+func (at *ATETopology) UpdateBFD(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating BFD on %s", at.ate)
+	if err := ate.UpdateBFD(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateBFD(t) on %s: %v", at, err)
+	}
+}
+
+// UpdateMulticastGroups updates the IGMP and MLD host groups of the topology on
+// the ATE on the fly, without restarting protocols. It assumes the only changes
+// since the topology was last pushed are to the groups, such as groups joined
+// or left with MulticastGroup.WithJoined.
+func (at *ATETopology) UpdateMulticastGroups(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating multicast groups on %s", at.ate)
+	if err := ate.UpdateMulticastGroups(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateMulticastGroups(t) on %s: %v", at, err)
+	}
+}
+
+// UpdatePIM updates the PIM join/prunes of the topology on the ATE on the fly,
+// without restarting protocols. It assumes the only changes since the topology
+// was last pushed are to PIM configuration, such as groups joined or pruned
+// with PIMJoinPrune.WithPruned.
+func (at *ATETopology) UpdatePIM(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating PIM on %s", at.ate)
+	if err := ate.UpdatePIM(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdatePIM(t) on %s: %v", at, err)
+	}
+}
+
+// UpdateISIS updates the IS-IS routers of the topology on the ATE on the fly,
+// without restarting protocols. It assumes the only changes since the topology
+// was last pushed are to IS-IS router configuration, such as the overload bit
+// set or cleared with ISIS.WithOverloaded.
+func (at *ATETopology) UpdateISIS(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating IS-IS on %s", at.ate)
+	if err := ate.UpdateISIS(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateISIS(t) on %s: %v", at, err)
+	}
+}
+
+// UpdateBGPRoutes updates the BGP attributes of the routes of the topology on
+// the ATE and re-advertises them on the fly, without restarting protocols or
+// peers. It assumes the only changes since the topology was last pushed are to
+// the BGP attributes of networks, such as the AS path or local preference.
+func (at *ATETopology) UpdateBGPRoutes(t testing.TB) {
+	t.Helper()
+	logAction(t, "Updating BGP routes on %s", at.ate)
+	if err := ate.UpdateBGPRoutes(context.Background(), at.ate, at.top); err != nil {
+		t.Fatalf("UpdateBGPRoutes(t) on %s: %v", at, err)
+	}
+}
+
+// SetLAGMemberState sets the state of a member port of a LAG on the ATE, such
+// as to flap a single member to exercise LAG convergence on the DUT.
+func (at *ATETopology) SetLAGMemberState(t testing.TB, lag *LAG, port *Port, enabled bool) {
+	t.Helper()
+	logAction(t, "Setting LAG member state on %s", at.ate)
+	if err := ate.SetLAGMemberState(context.Background(), at.ate, lag.pb.GetName(), port.Name(), enabled); err != nil {
+		t.Fatalf("SetLAGMemberState(t) on %s: %v", at, err)
+	}
+}
+
+// LoopbackMode is the loopback mode of an ATE port.
+type LoopbackMode int
+
+const (
+	// LoopbackNone disables loopback on the port.
+	LoopbackNone = LoopbackMode(opb.PortLoopbackMode_PORT_LOOPBACK_MODE_NONE)
+	// LoopbackInternal loops the frames transmitted by the port back to its own
+	// receiver, isolating the port from the DUT.
+	LoopbackInternal = LoopbackMode(opb.PortLoopbackMode_PORT_LOOPBACK_MODE_INTERNAL)
+	// LoopbackLine loops the frames received by the port back to the DUT.
+	LoopbackLine = LoopbackMode(opb.PortLoopbackMode_PORT_LOOPBACK_MODE_LINE)
+)
+
+// SetPortLoopback sets the loopback mode of a port on the ATE.
+func (at *ATETopology) SetPortLoopback(t testing.TB, port *Port, mode LoopbackMode) {
+	t.Helper()
+	logAction(t, "Setting port loopback on %s", at.ate)
+	if err := ate.SetPortLoopback(context.Background(), at.ate, port.Name(), opb.PortLoopbackMode(mode)); err != nil {
+		t.Fatalf("SetPortLoopback(t) on %s: %v", at, err)
+	}
+}
+
+// LinkFault is a link fault signaled by an ATE port.
+type LinkFault int
+
+const (
+	// LinkFaultNone stops signaling a link fault.
+	LinkFaultNone = LinkFault(opb.PortLinkFault_PORT_LINK_FAULT_NONE)
+	// LinkFaultLocal signals a local fault to the DUT.
+	LinkFaultLocal = LinkFault(opb.PortLinkFault_PORT_LINK_FAULT_LOCAL)
+	// LinkFaultRemote signals a remote fault to the DUT.
+	LinkFaultRemote = LinkFault(opb.PortLinkFault_PORT_LINK_FAULT_REMOTE)
+)
+
+// SetPortLinkFault starts or stops signaling a link fault from a port on the
+// ATE to the DUT, which simulates a link failure without changing the cabling.
+// Use LinkFaultNone to stop signaling the fault.
+func (at *ATETopology) SetPortLinkFault(t testing.TB, port *Port, fault LinkFault) {
+	t.Helper()
+	logAction(t, "Setting port link fault on %s", at.ate)
+	if err := ate.SetPortLinkFault(context.Background(), at.ate, port.Name(), opb.PortLinkFault(fault)); err != nil {
+		t.Fatalf("SetPortLinkFault(t) on %s: %v", at, err)
+	}
+}
+
+// TakePortOwnership takes ownership of the chassis port of a port on the ATE,
+// even if the chassis port is owned by another user of a shared chassis.
+func (at *ATETopology) TakePortOwnership(t testing.TB, port *Port) {
+	t.Helper()
+	logAction(t, "Taking port ownership on %s", at.ate)
+	if err := ate.TakePortOwnership(context.Background(), at.ate, port.Name()); err != nil {
+		t.Fatalf("TakePortOwnership(t) on %s: %v", at, err)
+	}
+}
+
+// ReleasePort releases the chassis port of a port on the ATE. Ports are also
+// released automatically when the testbed is released.
+func (at *ATETopology) ReleasePort(t testing.TB, port *Port) {
+	t.Helper()
+	logAction(t, "Releasing port on %s", at.ate)
+	if err := ate.ReleasePort(context.Background(), at.ate, port.Name()); err != nil {
+		t.Fatalf("ReleasePort(t) on %s: %v", at, err)
+	}
+}
+
+// RebootPortCPU reboots the CPU of the chassis port of a port on the ATE, such
+// as to recover a port that is stuck.
+func (at *ATETopology) RebootPortCPU(t testing.TB, port *Port) {
+	t.Helper()
+	logAction(t, "Rebooting port CPU on %s", at.ate)
+	if err := ate.RebootPortCPU(context.Background(), at.ate, port.Name()); err != nil {
+		t.Fatalf("RebootPortCPU(t) on %s: %v", at, err)
+	}
+}
+
 // StartProtocols starts the control plane protocols on the ATE.
 func (at *ATETopology) StartProtocols(t testing.TB) *ATETopology {
 	t.Helper()
@@ -18,6 +18,7 @@ import (
 	"golang.org/x/net/context"
 	"fmt"
 	"testing"
+	"time"
 
 	log "github.com/golang/glog"
 	"github.com/openconfig/ondatra/binding"
@@ -79,6 +80,28 @@ func (tr *Traffic) update(flows []*Flow) error {
 	return ate.UpdateTraffic(context.Background(), tr.ate, pbs)
 }
 
+// UpdateFrameRates changes the frame rates of already-running flows on the ATE
+// on the fly, without stopping or regenerating traffic, such as to ramp the
+// load up or down. Unlike Update, the frame sizes of the flows are left
+// unchanged. Each flow must specify a frame rate, which may be of a different
+// type than before, such as frames per second instead of a percent of the line
+// rate.
+func (tr *Traffic) UpdateFrameRates(t testing.TB, flows ...*Flow) {
+	t.Helper()
+	logAction(t, "Updating frame rates on %s", tr.ate)
+	if err := tr.updateFrameRates(flows); err != nil {
+		t.Fatalf("UpdateFrameRates(t) on %s: %v", tr, err)
+	}
+}
+
+func (tr *Traffic) updateFrameRates(flows []*Flow) error {
+	var pbs []*opb.Flow
+	for _, f := range flows {
+		pbs = append(pbs, f.pb)
+	}
+	return ate.UpdateFrameRates(context.Background(), tr.ate, pbs)
+}
+
 // Stop stops all traffic flows on the ATE.
 func (tr *Traffic) Stop(t testing.TB) {
 	t.Helper()
@@ -88,6 +111,143 @@ func (tr *Traffic) Stop(t testing.TB) {
 	}
 }
 
+// AwaitStopped waits up to the specified timeout for all traffic flows on the
+// ATE to stop on their own, such as when every running flow has a fixed packet
+// count or duration. Use it before checking exact packet counts, rather than
+// stopping the traffic after a fixed amount of time.
+func (tr *Traffic) AwaitStopped(t testing.TB, timeout time.Duration) {
+	t.Helper()
+	logAction(t, "Awaiting traffic stop on %s", tr.ate)
+	if err := ate.AwaitTrafficStopped(context.Background(), tr.ate, timeout); err != nil {
+		t.Fatalf("AwaitStopped(t, %v) on %s: %v", timeout, tr, err)
+	}
+}
+
+// AwaitNoLoss waits up to the specified window for the transmitted and
+// received frame counts of the flows to stabilize, so that frames still in
+// flight are not counted as lost, and then checks that no flow lost more than
+// tolerancePct percent of the frames it transmitted. Use it after stopping the
+// traffic, rather than sleeping for a fixed time before comparing counters.
+func (tr *Traffic) AwaitNoLoss(t testing.TB, flows []*Flow, tolerancePct float64, window time.Duration) {
+	t.Helper()
+	logAction(t, "Awaiting no traffic loss on %s", tr.ate)
+	var names []string
+	for _, f := range flows {
+		names = append(names, f.Name())
+	}
+	if err := ate.AwaitNoLoss(context.Background(), tr.ate, names, tolerancePct, window); err != nil {
+		t.Fatalf("AwaitNoLoss(t, %v, %v, %v) on %s: %v", names, tolerancePct, window, tr, err)
+	}
+}
+
+// LoadDistribution is the distribution of the frames of a flow received across
+// a set of ATE ports, such as the ports connected to the members of a DUT LAG
+// or ECMP group.
+type LoadDistribution struct {
+	// Frames is the number of frames received on each port, keyed by port name.
+	Frames map[string]uint64
+	// ChiSquare is the chi-square statistic of the frame counts against an even
+	// distribution across the ports.
+	ChiSquare float64
+	// MaxDeviationPct is the largest deviation of the frames received on a port
+	// from an even share, as a percentage of the even share.
+	MaxDeviationPct float64
+}
+
+// LoadDistribution returns the distribution of the frames of the flow received
+// across the ports, so hashing tests can check that the load is roughly even.
+// The flow must be ingress tracked by ports.
+func (tr *Traffic) LoadDistribution(t testing.TB, flow *Flow, ports ...*Port) *LoadDistribution {
+	t.Helper()
+	var names []string
+	for _, p := range ports {
+		names = append(names, p.Name())
+	}
+	ld, err := ate.FetchLoadDistribution(context.Background(), tr.ate, flow.Name(), names)
+	if err != nil {
+		t.Fatalf("LoadDistribution(t, %q, %v) on %s: %v", flow.Name(), names, tr, err)
+	}
+	return &LoadDistribution{
+		Frames:          ld.Frames,
+		ChiSquare:       ld.ChiSquare,
+		MaxDeviationPct: ld.MaxDeviationPct,
+	}
+}
+
+// ConvergenceTimes returns the control plane/data plane convergence times of
+// the flows that measure convergence, keyed by flow name. The convergence time
+// of a flow is the time from a control plane event, such as a link going down
+// or a route being withdrawn, until the receive rate of the flow recovers.
+func (tr *Traffic) ConvergenceTimes(t testing.TB) map[string]time.Duration {
+	t.Helper()
+	times, err := ate.FetchConvergenceTimes(context.Background(), tr.ate)
+	if err != nil {
+		t.Fatalf("ConvergenceTimes(t) on %s: %v", tr, err)
+	}
+	return times
+}
+
+// FlowLatency is the store-and-forward latency and jitter of a flow.
+type FlowLatency struct {
+	Min, Avg, Max time.Duration
+	// Jitter is the average variation of the latency.
+	Jitter time.Duration
+}
+
+// Latencies returns the store-and-forward latency and jitter of the flows that
+// measure latency, keyed by flow name.
+func (tr *Traffic) Latencies(t testing.TB) map[string]*FlowLatency {
+	t.Helper()
+	lats, err := ate.FetchFlowLatencies(context.Background(), tr.ate)
+	if err != nil {
+		t.Fatalf("Latencies(t) on %s: %v", tr, err)
+	}
+	m := make(map[string]*FlowLatency)
+	for name, l := range lats {
+		m[name] = &FlowLatency{Min: l.Min, Avg: l.Avg, Max: l.Max, Jitter: l.Jitter}
+	}
+	return m
+}
+
+// IntegrityErrors returns the number of received frames that failed the
+// payload integrity check, keyed by flow name, for the flows configured with
+// an integrity check.
+func (tr *Traffic) IntegrityErrors(t testing.TB) map[string]uint64 {
+	t.Helper()
+	errs, err := ate.FetchIntegrityErrors(context.Background(), tr.ate)
+	if err != nil {
+		t.Fatalf("IntegrityErrors(t) on %s: %v", tr, err)
+	}
+	return errs
+}
+
+// StartCapture starts capturing the frames received on the ports of the ATE,
+// such as the responses of the DUT to crafted packets sent by a flow with a
+// custom header.
+func (tr *Traffic) StartCapture(t testing.TB, ports ...*Port) {
+	t.Helper()
+	logAction(t, "Starting capture on %s", tr.ate)
+	var names []string
+	for _, p := range ports {
+		names = append(names, p.Name())
+	}
+	if err := ate.StartCapture(context.Background(), tr.ate, names); err != nil {
+		t.Fatalf("StartCapture(t, %v) on %s: %v", names, tr, err)
+	}
+}
+
+// StopCapture stops the capture started by StartCapture and returns the
+// frames captured on each port in pcap format, keyed by port name.
+func (tr *Traffic) StopCapture(t testing.TB) map[string][]byte {
+	t.Helper()
+	logAction(t, "Stopping capture on %s", tr.ate)
+	captures, err := ate.StopCapture(context.Background(), tr.ate)
+	if err != nil {
+		t.Fatalf("StopCapture(t) on %s: %v", tr, err)
+	}
+	return captures
+}
+
 // IMIXCustom is an representation of custom IMIX entries to be configured for a flow on the ATE.
 type IMIXCustom struct {
 	pb *opb.FrameSize_ImixCustom
@@ -101,6 +261,8 @@ type Flow struct {
 
 // Endpoint is a potential source or destination of a flow.
 // There are two types of endpoints: Interfaces and Networks.
+// All the endpoints of a flow must be on the ATE that starts the flow, as
+// flows cannot span the chassis of different ATEs.
 type Endpoint interface {
 	isEndpoint()
 }
@@ -156,6 +318,16 @@ func endpointPBs(eps []Endpoint) []*opb.Flow_Endpoint {
 				InterfaceName: e.pb.GetInterfaceName(),
 				Generated:     &opb.Flow_Endpoint_RsvpName{RsvpName: e.pb.GetName()},
 			}
+		case *IGMPHost:
+			epPB = &opb.Flow_Endpoint{
+				InterfaceName: e.intfName,
+				Generated:     &opb.Flow_Endpoint_IgmpGroups{IgmpGroups: true},
+			}
+		case *MLDHost:
+			epPB = &opb.Flow_Endpoint{
+				InterfaceName: e.intfName,
+				Generated:     &opb.Flow_Endpoint_MldGroups{MldGroups: true},
+			}
 		default:
 			log.Fatalf("unknown endpoint type: %v (%T)", e, e)
 		}
@@ -192,12 +364,80 @@ func (f *Flow) WithFrameRateBPS(n uint64) *Flow {
 	return f
 }
 
+// WithLatencyMeasurement sets whether to measure the store-and-forward
+// latency and jitter of the flow, as returned by Traffic.Latencies. Some ATEs
+// can only measure latency for all flows, so enabling it on one flow may
+// enable it on all flows.
+func (f *Flow) WithLatencyMeasurement(enable bool) *Flow {
+	f.pb.MeasureLatency = enable
+	return f
+}
+
+// WithConvergenceMeasurement sets whether to measure the control plane/data
+// plane convergence time of the flow, as returned by Traffic.ConvergenceTimes.
+// Some ATEs can only measure convergence for all flows, so enabling it on one
+// flow may enable it on all flows.
+func (f *Flow) WithConvergenceMeasurement(enable bool) *Flow {
+	f.pb.MeasureConvergence = enable
+	return f
+}
+
+// WithPayloadFixed sets the flow's payload to the pattern, specified as an
+// even number of hex digits, repeated to fill the payload.
+func (f *Flow) WithPayloadFixed(pattern string) *Flow {
+	f.pb.Payload = &opb.Payload{Type: opb.Payload_FIXED, FixedPattern: pattern}
+	return f
+}
+
+// WithPayloadIncrementing sets the flow's payload to bytes incrementing from
+// zero.
+func (f *Flow) WithPayloadIncrementing() *Flow {
+	f.pb.Payload = &opb.Payload{Type: opb.Payload_INCREMENTING}
+	return f
+}
+
+// WithPayloadPRBS sets the flow's payload to a pseudo-random bit sequence.
+func (f *Flow) WithPayloadPRBS() *Flow {
+	f.pb.Payload = &opb.Payload{Type: opb.Payload_PRBS}
+	return f
+}
+
+// WithIntegrityCheck sets whether to check the integrity of the payload of
+// received packets, so that corruption of packets can be detected, as returned
+// by Traffic.IntegrityErrors. Some ATEs can only check integrity for all
+// flows, so enabling it on one flow may enable it on all flows.
+func (f *Flow) WithIntegrityCheck(enable bool) *Flow {
+	f.pb.CheckIntegrity = enable
+	return f
+}
+
 // WithEgressTrackingEnabled enables egress tracking with custom offset and width bits.
 func (f *Flow) WithEgressTrackingEnabled(customOffset, customWidth uint32) *Flow {
 	f.pb.EgressTracking = &opb.EgressTracking{CustomOffset: customOffset, CustomWidth: customWidth}
 	return f
 }
 
+// WithEgressTrackingByMplsLabel enables egress tracking by the outermost MPLS
+// label of received packets.
+func (f *Flow) WithEgressTrackingByMplsLabel() *Flow {
+	f.pb.EgressTracking = &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_MPLS_LABEL}
+	return f
+}
+
+// WithEgressTrackingByDSCP enables egress tracking by the DSCP of the first IP
+// header of received packets.
+func (f *Flow) WithEgressTrackingByDSCP() *Flow {
+	f.pb.EgressTracking = &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_DSCP}
+	return f
+}
+
+// WithEgressTrackingByVLANID enables egress tracking by the VLAN ID of
+// received packets.
+func (f *Flow) WithEgressTrackingByVLANID() *Flow {
+	f.pb.EgressTracking = &opb.EgressTracking{Filter: opb.EgressTracking_FILTER_VLAN_ID}
+	return f
+}
+
 // WithEgressTrackingDisabled disables egress tracking.
 func (f *Flow) WithEgressTrackingDisabled() *Flow {
 	f.pb.EgressTracking = nil
@@ -295,6 +535,18 @@ func (f *Flow) WithFrameSizeRandom(min, max uint32) *Flow {
 	return f
 }
 
+// WithFrameSizeIncrement sets the frame size for this flow to increment from
+// min to max by the given step, which is useful for path MTU discovery and
+// fragmentation tests.
+func (f *Flow) WithFrameSizeIncrement(min, max, step uint32) *Flow {
+	f.pb.FrameSize = &opb.FrameSize{
+		Type: &opb.FrameSize_Increment_{
+			Increment: &opb.FrameSize_Increment{Min: min, Max: max, Step: step},
+		},
+	}
+	return f
+}
+
 // WithFrameSizeIMIXCisco configures this IMIX to use the Cisco preset (64:7, 594:4 and 1518:1).
 func (f *Flow) WithFrameSizeIMIXCisco() *Flow {
 	f.pb.FrameSize = &opb.FrameSize{
@@ -335,6 +587,49 @@ func (f *Flow) WithFrameSizeIMIXIPv6() *Flow {
 	return f
 }
 
+// ApplicationTraffic is the stateful application traffic of a flow.
+type ApplicationTraffic struct {
+	pb *opb.ApplicationTraffic
+}
+
+// Application returns the application traffic configuration for this flow.
+// A flow with application traffic emulates stateful sessions between its
+// endpoints, such as TCP connections with handshakes, for testing features
+// like NAT and firewalls. The packets are built by the ATE, so the flow must
+// have no headers, and its frame rate, frame size and tracking do not apply.
+// The endpoints must all be interfaces with an address of the same IP family.
+func (f *Flow) Application() *ApplicationTraffic {
+	if f.pb.Application == nil {
+		f.pb.Application = &opb.ApplicationTraffic{}
+	}
+	return &ApplicationTraffic{pb: f.pb.Application}
+}
+
+// WithHTTPGet adds HTTP GET requests over TCP connections to the sessions.
+func (a *ApplicationTraffic) WithHTTPGet() *ApplicationTraffic {
+	a.pb.Protocols = append(a.pb.Protocols, opb.ApplicationTraffic_HTTP_GET)
+	return a
+}
+
+// WithTCP adds bulk data over TCP connections to the sessions.
+func (a *ApplicationTraffic) WithTCP() *ApplicationTraffic {
+	a.pb.Protocols = append(a.pb.Protocols, opb.ApplicationTraffic_TCP)
+	return a
+}
+
+// WithUDP adds request and response datagrams over UDP to the sessions.
+func (a *ApplicationTraffic) WithUDP() *ApplicationTraffic {
+	a.pb.Protocols = append(a.pb.Protocols, opb.ApplicationTraffic_UDP)
+	return a
+}
+
+// WithConcurrentSessions sets the number of concurrent sessions on each
+// source port.
+func (a *ApplicationTraffic) WithConcurrentSessions(n uint32) *ApplicationTraffic {
+	a.pb.ConcurrentSessions = n
+	return a
+}
+
 // Transmission returns the transmission configuration for this flow.
 // By default the transmission will have the following properties:
 // pattern: continuous
@@ -362,6 +657,7 @@ func (t *Transmission) WithPatternBurst() *Transmission {
 }
 
 // WithPatternFixedPacketCount configures the transmission to send a specified number of packets.
+// Use Traffic.AwaitStopped to wait for all the packets to be sent.
 func (t *Transmission) WithPatternFixedPacketCount(packetsCount uint32) *Transmission {
 	t.pb.Pattern = opb.Transmission_FIXED_FRAME_COUNT
 	t.pb.FrameCount = packetsCount
@@ -388,6 +684,14 @@ func (t *Transmission) WithPacketsPerBurst(packetsPerBurst uint32) *Transmission
 	return t
 }
 
+// WithBurstCount sets the number of bursts to transmit, after which the
+// transmission stops. If unset, bursts are transmitted until traffic is stopped.
+// May only be set when the transmission pattern is burst.
+func (t *Transmission) WithBurstCount(count uint32) *Transmission {
+	t.pb.BurstCount = count
+	return t
+}
+
 // WithInterburstGapNanoseconds sets the gap (in nanoseconds) between transmission bursts.
 // May only be set when the transmission pattern is burst.
 func (t *Transmission) WithInterburstGapNanoseconds(nanoseconds uint32) *Transmission {